@@ -6,9 +6,9 @@ import (
 	"os"
 	"syscall"
 
+	"github.com/maisiq/go-ugc-service/internal/clickhouse"
 	"github.com/maisiq/go-ugc-service/internal/closer"
 	"github.com/maisiq/go-ugc-service/internal/etl"
-	"github.com/maisiq/go-ugc-service/internal/etl/clickhouse"
 	"github.com/maisiq/go-ugc-service/pkg/config"
 	"github.com/maisiq/go-ugc-service/pkg/logger"
 	"github.com/segmentio/kafka-go"
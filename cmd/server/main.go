@@ -54,15 +54,18 @@ func runSwagger(ctx context.Context, cfg *config.Config) {
 
 	gwMux := runtime.NewServeMux()
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
-	err := ugcv1pb.RegisterUGCServiceHandlerFromEndpoint(
-		ctx,
-		gwMux,
-		fmt.Sprintf("%v:%d", cfg.Server.Host, cfg.Server.Port),
-		opts,
-	)
+	endpoint := fmt.Sprintf("%v:%d", cfg.Server.Host, cfg.Server.Port)
 
-	if err != nil {
-		log.Errorf("grpc-gateway: %v", err)
+	registers := []func(context.Context, *runtime.ServeMux, string, []grpc.DialOption) error{
+		ugcv1pb.RegisterUGCServiceHandlerFromEndpoint,
+		ugcv1pb.RegisterProgressServiceHandlerFromEndpoint,
+		ugcv1pb.RegisterBookmarkServiceHandlerFromEndpoint,
+	}
+
+	for _, register := range registers {
+		if err := register(ctx, gwMux, endpoint, opts); err != nil {
+			log.Errorf("grpc-gateway: %v", err)
+		}
 	}
 
 	httpMux := http.NewServeMux()
@@ -2,6 +2,39 @@ CREATE DATABASE IF NOT EXISTS movies;
 CREATE TABLE IF NOT EXISTS movies.analytics (
     user_id UUID,
     movie_id String,
-    timestamp_ms Int32
+    timestamp_ms Int64,
+    event LowCardinality(String) DEFAULT 'review_created'
 ) ENGINE = MergeTree()
 ORDER BY movie_id;
+CREATE TABLE IF NOT EXISTS movies.review_votes (
+    voter_id UUID,
+    user_id UUID,
+    movie_id String,
+    vote Int8,
+    timestamp_ms Int64,
+    event LowCardinality(String)
+) ENGINE = MergeTree()
+ORDER BY (movie_id, user_id);
+CREATE TABLE IF NOT EXISTS movies.review_reports (
+    reporter_id UUID,
+    user_id UUID,
+    movie_id String,
+    reason LowCardinality(String),
+    timestamp_ms Int64
+) ENGINE = MergeTree()
+ORDER BY (movie_id, user_id);
+CREATE TABLE IF NOT EXISTS movies.watch_events (
+    user_id UUID,
+    movie_id String,
+    position_ms Int64,
+    duration_ms Int64,
+    timestamp_ms Int64
+) ENGINE = MergeTree()
+ORDER BY (user_id, movie_id, timestamp_ms);
+CREATE TABLE IF NOT EXISTS movies.bookmark_events (
+    user_id UUID,
+    movie_id String,
+    timestamp_ms Int64,
+    event LowCardinality(String)
+) ENGINE = MergeTree()
+ORDER BY (user_id, movie_id, timestamp_ms);
@@ -0,0 +1,4 @@
+-- Adds the event column to analytics on databases created before init.sql declared it.
+-- Rows loaded so far were all review creations. Apply before 0001, which reads it.
+-- Apply once with: clickhouse-client --multiquery < 0000_analytics_event.sql
+ALTER TABLE movies.analytics ADD COLUMN IF NOT EXISTS event LowCardinality(String) DEFAULT 'review_created';
@@ -0,0 +1,87 @@
+-- Widens timestamp_ms to Int64 Unix milliseconds on databases created before
+-- init.sql declared it so. Rows loaded so far hold Unix seconds and are converted.
+-- Apply once with: clickhouse-client --multiquery < 0001_timestamp_ms_int64.sql
+--
+-- timestamp_ms is in the sorting key of watch_events and cannot be altered in place,
+-- every table is copied into the new shape and exchanged with the old one.
+--
+-- Databases created before the event column and the vote, report and watch tables
+-- were declared get them first, so there is something to copy from.
+ALTER TABLE movies.analytics ADD COLUMN IF NOT EXISTS event LowCardinality(String) DEFAULT 'review_created';
+CREATE TABLE IF NOT EXISTS movies.review_votes (
+    voter_id UUID,
+    user_id UUID,
+    movie_id String,
+    vote Int8,
+    timestamp_ms Int64,
+    event LowCardinality(String)
+) ENGINE = MergeTree()
+ORDER BY (movie_id, user_id);
+CREATE TABLE IF NOT EXISTS movies.review_reports (
+    reporter_id UUID,
+    user_id UUID,
+    movie_id String,
+    reason LowCardinality(String),
+    timestamp_ms Int64
+) ENGINE = MergeTree()
+ORDER BY (movie_id, user_id);
+CREATE TABLE IF NOT EXISTS movies.watch_events (
+    user_id UUID,
+    movie_id String,
+    position_ms Int64,
+    duration_ms Int64,
+    timestamp_ms Int64
+) ENGINE = MergeTree()
+ORDER BY (user_id, movie_id, timestamp_ms);
+
+CREATE TABLE IF NOT EXISTS movies.analytics_new (
+    user_id UUID,
+    movie_id String,
+    timestamp_ms Int64,
+    event LowCardinality(String) DEFAULT 'review_created'
+) ENGINE = MergeTree()
+ORDER BY movie_id;
+INSERT INTO movies.analytics_new
+SELECT user_id, movie_id, toInt64(timestamp_ms) * 1000, event FROM movies.analytics;
+EXCHANGE TABLES movies.analytics AND movies.analytics_new;
+DROP TABLE movies.analytics_new;
+
+CREATE TABLE IF NOT EXISTS movies.review_votes_new (
+    voter_id UUID,
+    user_id UUID,
+    movie_id String,
+    vote Int8,
+    timestamp_ms Int64,
+    event LowCardinality(String)
+) ENGINE = MergeTree()
+ORDER BY (movie_id, user_id);
+INSERT INTO movies.review_votes_new
+SELECT voter_id, user_id, movie_id, vote, toInt64(timestamp_ms) * 1000, event FROM movies.review_votes;
+EXCHANGE TABLES movies.review_votes AND movies.review_votes_new;
+DROP TABLE movies.review_votes_new;
+
+CREATE TABLE IF NOT EXISTS movies.review_reports_new (
+    reporter_id UUID,
+    user_id UUID,
+    movie_id String,
+    reason LowCardinality(String),
+    timestamp_ms Int64
+) ENGINE = MergeTree()
+ORDER BY (movie_id, user_id);
+INSERT INTO movies.review_reports_new
+SELECT reporter_id, user_id, movie_id, reason, toInt64(timestamp_ms) * 1000 FROM movies.review_reports;
+EXCHANGE TABLES movies.review_reports AND movies.review_reports_new;
+DROP TABLE movies.review_reports_new;
+
+CREATE TABLE IF NOT EXISTS movies.watch_events_new (
+    user_id UUID,
+    movie_id String,
+    position_ms Int64,
+    duration_ms Int64,
+    timestamp_ms Int64
+) ENGINE = MergeTree()
+ORDER BY (user_id, movie_id, timestamp_ms);
+INSERT INTO movies.watch_events_new
+SELECT user_id, movie_id, position_ms, duration_ms, toInt64(timestamp_ms) * 1000 FROM movies.watch_events;
+EXCHANGE TABLES movies.watch_events AND movies.watch_events_new;
+DROP TABLE movies.watch_events_new;
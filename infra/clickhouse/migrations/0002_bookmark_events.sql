@@ -0,0 +1,9 @@
+-- Adds the table of bookmark events on databases created before init.sql declared it.
+-- Apply once with: clickhouse-client --multiquery < 0002_bookmark_events.sql
+CREATE TABLE IF NOT EXISTS movies.bookmark_events (
+    user_id UUID,
+    movie_id String,
+    timestamp_ms Int64,
+    event LowCardinality(String)
+) ENGINE = MergeTree()
+ORDER BY (user_id, movie_id, timestamp_ms);
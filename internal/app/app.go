@@ -19,6 +19,8 @@ type App struct {
 	cfg             *config.Config
 	serviceProvider *serviceProvider
 	grpcServer      *grpc.Server
+	// adminServer serves the AdminService on the internal listener only.
+	adminServer *grpc.Server
 }
 
 func NewApp(ctx context.Context, cfg *config.Config) (*App, error) {
@@ -39,6 +41,8 @@ func (a *App) Run() error {
 		closer.Wait()
 	}()
 
+	go a.runAdminServer()
+
 	return a.runGRPCServer()
 
 }
@@ -48,6 +52,11 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initLogger,
 		a.initServiceProvider,
 		a.initGRPCServer,
+		a.initPurgeJob,
+		a.initErasureResume,
+		a.initProgressFlush,
+		a.initOutboxRelay,
+		a.initSearchBackfill,
 	}
 
 	for _, f := range inits {
@@ -70,17 +79,78 @@ func (a *App) initServiceProvider(_ context.Context) error {
 }
 
 func (a *App) initGRPCServer(ctx context.Context) error {
-	a.grpcServer = grpc.NewServer(
+	a.grpcServer = newGRPCServer()
+	ugcv1pb.RegisterUGCServiceServer(a.grpcServer, a.serviceProvider.UGCServiceServer(ctx))
+	ugcv1pb.RegisterProgressServiceServer(a.grpcServer, a.serviceProvider.ProgressServiceServer(ctx))
+	ugcv1pb.RegisterBookmarkServiceServer(a.grpcServer, a.serviceProvider.BookmarkServiceServer(ctx))
+
+	a.adminServer = newGRPCServer()
+	ugcv1pb.RegisterAdminServiceServer(a.adminServer, a.serviceProvider.AdminServiceServer(ctx))
+	return nil
+}
+
+func newGRPCServer() *grpc.Server {
+	s := grpc.NewServer(
 		grpc.Creds(insecure.NewCredentials()),
 		grpc.UnaryInterceptor(server.ValidateInterceptor),
+		grpc.StreamInterceptor(server.StreamValidateInterceptor),
 	)
 
-	reflection.Register(a.grpcServer)
+	reflection.Register(s)
+	return s
+}
 
-	ugcv1pb.RegisterUGCServiceServer(a.grpcServer, a.serviceProvider.UGCServiceServer(ctx))
+func (a *App) initPurgeJob(ctx context.Context) error {
+	a.serviceProvider.PurgeService(ctx).Start()
+	return nil
+}
+
+func (a *App) initErasureResume(ctx context.Context) error {
+	a.serviceProvider.ErasureService(ctx).Resume()
+	return nil
+}
+
+func (a *App) initProgressFlush(ctx context.Context) error {
+	a.serviceProvider.ProgressService(ctx).Start()
+	return nil
+}
+
+func (a *App) initOutboxRelay(ctx context.Context) error {
+	a.serviceProvider.OutboxRelay(ctx).Start()
+	return nil
+}
+
+func (a *App) initSearchBackfill(ctx context.Context) error {
+	if a.cfg.Search.Backfill {
+		a.serviceProvider.BackfillSearch(ctx)
+	}
 	return nil
 }
 
+func (a *App) runAdminServer() {
+	log := a.serviceProvider.Logger()
+	log.Infof("Admin GRPC server is running on %v:%v", a.cfg.AdminServer.Host, a.cfg.AdminServer.Port)
+
+	lis, err := net.Listen(
+		"tcp",
+		net.JoinHostPort(a.cfg.AdminServer.Host, strconv.Itoa(a.cfg.AdminServer.Port)),
+	)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	closer.Add(func() error {
+		log.Info("Admin GRPC: Graceful shutdown")
+		a.adminServer.GracefulStop()
+		return nil
+	})
+
+	if err := a.adminServer.Serve(lis); err != nil {
+		log.Errorf("Failed to serve admin server: %v", err)
+	}
+}
+
 func (a *App) runGRPCServer() error {
 	log := a.serviceProvider.Logger()
 	log.Infof("GRPC server is running on %v:%v", a.cfg.Server.Host, a.cfg.Server.Port)
@@ -5,10 +5,14 @@ import (
 	"errors"
 	"io/fs"
 
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
 	"github.com/maisiq/go-ugc-service/internal/cache"
+	"github.com/maisiq/go-ugc-service/internal/clickhouse"
 	"github.com/maisiq/go-ugc-service/internal/closer"
 	"github.com/maisiq/go-ugc-service/internal/db"
+	"github.com/maisiq/go-ugc-service/internal/filter"
 	"github.com/maisiq/go-ugc-service/internal/handler"
+	"github.com/maisiq/go-ugc-service/internal/pagination"
 	"github.com/maisiq/go-ugc-service/internal/producer"
 	"github.com/maisiq/go-ugc-service/internal/repository"
 	"github.com/maisiq/go-ugc-service/internal/service"
@@ -19,16 +23,48 @@ import (
 )
 
 type serviceProvider struct {
-	cfg        *config.Config
-	userRepo   repository.ReviewRepository
-	movieRepo  repository.ReviewRepository
-	cacher     cache.Cache
-	dbConnPool *mongo.Client
-	service    *service.UGCService
-	broker     *producer.KafkaProducer
-	ugcImpl    *handler.UGCServiceServer
-	log        *zap.SugaredLogger
-	uow        db.UOW
+	cfg          *config.Config
+	userRepo     repository.ReviewRepository
+	movieRepo    repository.ReviewRepository
+	ratingRepo   repository.RatingRepository
+	voteRepo     repository.VoteRepository
+	commentRepo  repository.CommentRepository
+	modRepo      repository.ModerationRepository
+	reportRepo   repository.ReportRepository
+	searchRepo   repository.SearchRepository
+	revRepo      repository.RevisionRepository
+	analytics    repository.AnalyticsRepository
+	erasureRepo  repository.ErasureRepository
+	progRepo     repository.ProgressRepository
+	bookRepo     repository.BookmarkRepository
+	outboxRepo   repository.OutboxRepository
+	watcher      repository.ReviewWatcher
+	cacher       cache.Cache
+	dbConnPool   *mongo.Client
+	chConn       driver.Conn
+	service      *service.UGCService
+	votes        *service.VoteService
+	comments     *service.CommentService
+	moderation   *service.ModerationService
+	reports      *service.ReportService
+	search       *service.SearchService
+	feed         *service.FeedService
+	imports      *service.ImportService
+	purge        *service.PurgeService
+	exports      *service.ExportService
+	erasures     *service.ErasureService
+	progress     *service.ProgressService
+	bookmarks    *service.BookmarkService
+	relay        *service.OutboxRelay
+	broker       *producer.KafkaProducer
+	ugcImpl      *handler.UGCServiceServer
+	progressImpl *handler.ProgressServiceServer
+	bookmarkImpl *handler.BookmarkServiceServer
+	adminImpl    *handler.AdminServiceServer
+	log          *zap.SugaredLogger
+	uow          db.UOW
+	paginator    *pagination.Paginator
+	filters      filter.Chain
 }
 
 func newServiceProvider(cfg *config.Config) *serviceProvider {
@@ -94,6 +130,199 @@ func (s *serviceProvider) getMovieRepo(ctx context.Context) repository.ReviewRep
 	return s.movieRepo
 }
 
+func (s *serviceProvider) getRatingRepo(ctx context.Context) repository.RatingRepository {
+	if s.ratingRepo == nil {
+		dbName := s.cfg.Database.Name
+		collName := s.cfg.Database.Collections.Movies
+		collection := s.DBConnPool(ctx).Database(dbName).Collection(collName)
+		s.ratingRepo = repository.NewMovieRatingRepository(collection)
+	}
+	return s.ratingRepo
+}
+
+func (s *serviceProvider) getVoteRepo(ctx context.Context) repository.VoteRepository {
+	if s.voteRepo == nil {
+		dbName := s.cfg.Database.Name
+		collName := s.cfg.Database.Collections.Votes
+		collection := s.DBConnPool(ctx).Database(dbName).Collection(collName)
+
+		if err := repository.CreateVoteIndexes(ctx, collection); err != nil {
+			s.Logger().Warnf("Failed to create vote indexes: %v", err)
+		}
+		s.voteRepo = repository.NewReviewVoteRepository(collection)
+	}
+	return s.voteRepo
+}
+
+func (s *serviceProvider) getCommentRepo(ctx context.Context) repository.CommentRepository {
+	if s.commentRepo == nil {
+		dbName := s.cfg.Database.Name
+		collName := s.cfg.Database.Collections.Comments
+		collection := s.DBConnPool(ctx).Database(dbName).Collection(collName)
+
+		if err := repository.CreateCommentIndexes(ctx, collection); err != nil {
+			s.Logger().Warnf("Failed to create comment indexes: %v", err)
+		}
+		s.commentRepo = repository.NewReviewCommentRepository(collection)
+	}
+	return s.commentRepo
+}
+
+func (s *serviceProvider) getModerationRepo(ctx context.Context) repository.ModerationRepository {
+	if s.modRepo == nil {
+		dbName := s.cfg.Database.Name
+		collName := s.cfg.Database.Collections.Movies
+		collection := s.DBConnPool(ctx).Database(dbName).Collection(collName)
+
+		if err := repository.CreateModerationIndexes(ctx, collection); err != nil {
+			s.Logger().Warnf("Failed to create moderation indexes: %v", err)
+		}
+		s.modRepo = repository.NewMovieModerationRepository(collection)
+	}
+	return s.modRepo
+}
+
+func (s *serviceProvider) getReportRepo(ctx context.Context) repository.ReportRepository {
+	if s.reportRepo == nil {
+		dbName := s.cfg.Database.Name
+		collName := s.cfg.Database.Collections.Reports
+		collection := s.DBConnPool(ctx).Database(dbName).Collection(collName)
+
+		if err := repository.CreateReportIndexes(ctx, collection); err != nil {
+			s.Logger().Warnf("Failed to create report indexes: %v", err)
+		}
+		s.reportRepo = repository.NewReviewReportRepository(collection)
+	}
+	return s.reportRepo
+}
+
+func (s *serviceProvider) getRevisionRepo(ctx context.Context) repository.RevisionRepository {
+	if s.revRepo == nil {
+		dbName := s.cfg.Database.Name
+		collName := s.cfg.Database.Collections.Revisions
+		collection := s.DBConnPool(ctx).Database(dbName).Collection(collName)
+
+		if err := repository.CreateRevisionIndexes(ctx, collection); err != nil {
+			s.Logger().Warnf("Failed to create revision indexes: %v", err)
+		}
+		s.revRepo = repository.NewReviewRevisionRepository(collection, s.cfg.History.MaxRevisions)
+	}
+	return s.revRepo
+}
+
+func (s *serviceProvider) getOutboxRepo(ctx context.Context) repository.OutboxRepository {
+	if s.outboxRepo == nil {
+		dbName := s.cfg.Database.Name
+		collName := s.cfg.Database.Collections.Outbox
+		collection := s.DBConnPool(ctx).Database(dbName).Collection(collName)
+
+		if err := repository.CreateOutboxIndexes(ctx, collection, s.cfg.Outbox.Retention); err != nil {
+			s.Logger().Warnf("Failed to create outbox indexes: %v", err)
+		}
+		s.outboxRepo = repository.NewMongoOutboxRepository(collection)
+	}
+	return s.outboxRepo
+}
+
+func (s *serviceProvider) getSearchRepo(ctx context.Context) repository.SearchRepository {
+	if s.searchRepo == nil {
+		dbName := s.cfg.Database.Name
+		collName := s.cfg.Database.Collections.Search
+		collection := s.DBConnPool(ctx).Database(dbName).Collection(collName)
+
+		if err := repository.CreateSearchIndexes(ctx, collection); err != nil {
+			s.Logger().Warnf("Failed to create search indexes: %v", err)
+		}
+		s.searchRepo = repository.NewReviewSearchRepository(collection)
+	}
+	return s.searchRepo
+}
+
+// BackfillSearch indexes the reviews missing from the search collection.
+func (s *serviceProvider) BackfillSearch(ctx context.Context) {
+	db := s.DBConnPool(ctx).Database(s.cfg.Database.Name)
+	movies := db.Collection(s.cfg.Database.Collections.Movies)
+	search := db.Collection(s.cfg.Database.Collections.Search)
+
+	if err := repository.BackfillSearch(ctx, movies, search); err != nil {
+		s.Logger().Warnf("Failed to backfill search index: %v", err)
+		return
+	}
+	s.Logger().Info("Search index backfilled")
+}
+
+func (s *serviceProvider) getAnalyticsRepo(ctx context.Context) repository.AnalyticsRepository {
+	if s.analytics == nil {
+		s.analytics = repository.NewClickhouseAnalyticsRepository(s.Clickhouse(ctx))
+	}
+	return s.analytics
+}
+
+func (s *serviceProvider) getErasureRepo(ctx context.Context) repository.ErasureRepository {
+	if s.erasureRepo == nil {
+		dbName := s.cfg.Database.Name
+		collName := s.cfg.Database.Collections.Erasures
+		collection := s.DBConnPool(ctx).Database(dbName).Collection(collName)
+		s.erasureRepo = repository.NewUserErasureRepository(collection)
+	}
+	return s.erasureRepo
+}
+
+func (s *serviceProvider) getProgressRepo(ctx context.Context) repository.ProgressRepository {
+	if s.progRepo == nil {
+		dbName := s.cfg.Database.Name
+		collName := s.cfg.Database.Collections.Progress
+		collection := s.DBConnPool(ctx).Database(dbName).Collection(collName)
+
+		if err := repository.CreateProgressIndexes(ctx, collection); err != nil {
+			s.Logger().Warnf("Failed to create progress indexes: %v", err)
+		}
+		s.progRepo = repository.NewPlaybackProgressRepository(collection)
+	}
+	return s.progRepo
+}
+
+func (s *serviceProvider) getBookmarkRepo(ctx context.Context) repository.BookmarkRepository {
+	if s.bookRepo == nil {
+		dbName := s.cfg.Database.Name
+		collName := s.cfg.Database.Collections.Bookmarks
+		collection := s.DBConnPool(ctx).Database(dbName).Collection(collName)
+
+		if err := repository.CreateBookmarkIndexes(ctx, collection); err != nil {
+			s.Logger().Warnf("Failed to create bookmark indexes: %v", err)
+		}
+		s.bookRepo = repository.NewUserBookmarkRepository(collection)
+	}
+	return s.bookRepo
+}
+
+func (s *serviceProvider) getReviewWatcher(ctx context.Context) repository.ReviewWatcher {
+	if s.watcher == nil {
+		dbName := s.cfg.Database.Name
+		collName := s.cfg.Database.Collections.Search
+		collection := s.DBConnPool(ctx).Database(dbName).Collection(collName)
+		s.watcher = repository.NewReviewChangeWatcher(collection)
+	}
+	return s.watcher
+}
+
+func (s *serviceProvider) Clickhouse(ctx context.Context) driver.Conn {
+	if s.chConn == nil {
+		conn, err := clickhouse.InitClickhouseClient(ctx, &s.cfg.Clickhouse)
+
+		if err != nil {
+			s.Logger().Fatalf("Failed to connect to clickhouse: %v", err)
+		}
+		s.chConn = conn
+
+		closer.Add(func() error {
+			s.Logger().Info("Closing clickhouse connection")
+			return s.chConn.Close()
+		})
+	}
+	return s.chConn
+}
+
 func (s *serviceProvider) Producer() *producer.KafkaProducer {
 	if s.broker == nil {
 		s.broker = producer.New(s.cfg.Kafka, s.Logger())
@@ -128,18 +357,195 @@ func (s *serviceProvider) Cache() *cache.Cache {
 	return &s.cacher
 }
 
+func (s *serviceProvider) Paginator() *pagination.Paginator {
+	if s.paginator == nil {
+		s.paginator = pagination.New(s.cfg.Pagination)
+	}
+	return s.paginator
+}
+
+func (s *serviceProvider) ContentFilters() filter.Chain {
+	if s.filters == nil {
+		chain, err := filter.NewChain(s.cfg.Filters)
+		if err != nil {
+			s.Logger().Fatalf("Failed to build content filters: %v", err)
+		}
+		s.filters = chain
+	}
+	return s.filters
+}
+
 func (s *serviceProvider) Service(ctx context.Context) *service.UGCService {
 	if s.service == nil {
 		s.service = service.NewUGCService(
-			s.getUserRepo(ctx), s.getMovieRepo(ctx), s.Logger(), s.Producer(), s.Cache(), s.UOW(ctx),
+			s.getUserRepo(ctx), s.getMovieRepo(ctx), s.getRatingRepo(ctx), s.getSearchRepo(ctx), s.getRevisionRepo(ctx),
+			s.getOutboxRepo(ctx), s.getVoteRepo(ctx), s.getCommentRepo(ctx), s.Logger(), s.Producer(), s.Cache(), s.UOW(ctx), s.Paginator(), s.cfg.Moderation, s.ContentFilters(),
 		)
 	}
 	return s.service
 }
 
+func (s *serviceProvider) VoteService(ctx context.Context) *service.VoteService {
+	if s.votes == nil {
+		s.votes = service.NewVoteService(
+			s.getUserRepo(ctx), s.getMovieRepo(ctx), s.getVoteRepo(ctx), s.getOutboxRepo(ctx), s.Logger(), s.Cache(), s.UOW(ctx),
+		)
+	}
+	return s.votes
+}
+
+func (s *serviceProvider) CommentService(ctx context.Context) *service.CommentService {
+	if s.comments == nil {
+		s.comments = service.NewCommentService(
+			s.getMovieRepo(ctx), s.getCommentRepo(ctx), s.Logger(), s.Cache(), s.Paginator(),
+		)
+	}
+	return s.comments
+}
+
+func (s *serviceProvider) ModerationService(ctx context.Context) *service.ModerationService {
+	if s.moderation == nil {
+		s.moderation = service.NewModerationService(
+			s.getUserRepo(ctx), s.getMovieRepo(ctx), s.getModerationRepo(ctx), s.getRatingRepo(ctx), s.getSearchRepo(ctx),
+			s.getRevisionRepo(ctx), s.Logger(), s.Cache(), s.UOW(ctx), s.Paginator(),
+		)
+	}
+	return s.moderation
+}
+
+func (s *serviceProvider) ReportService(ctx context.Context) *service.ReportService {
+	if s.reports == nil {
+		s.reports = service.NewReportService(
+			s.getMovieRepo(ctx), s.getReportRepo(ctx), s.getOutboxRepo(ctx), s.ModerationService(ctx), s.Logger(), s.UOW(ctx),
+			s.cfg.Moderation.ReportThreshold,
+		)
+	}
+	return s.reports
+}
+
+func (s *serviceProvider) SearchService(ctx context.Context) *service.SearchService {
+	if s.search == nil {
+		s.search = service.NewSearchService(s.getSearchRepo(ctx), s.Logger(), s.Paginator())
+	}
+	return s.search
+}
+
+func (s *serviceProvider) FeedService(ctx context.Context) *service.FeedService {
+	if s.feed == nil {
+		s.feed = service.NewFeedService(s.getReviewWatcher(ctx), s.Logger())
+
+		closer.Add(func() error {
+			s.Logger().Info("Closing review feeds")
+			return s.feed.Close()
+		})
+	}
+	return s.feed
+}
+
+func (s *serviceProvider) ImportService(ctx context.Context) *service.ImportService {
+	if s.imports == nil {
+		s.imports = service.NewImportService(s.Service(ctx), s.cfg.Import.BatchSize)
+	}
+	return s.imports
+}
+
+func (s *serviceProvider) PurgeService(ctx context.Context) *service.PurgeService {
+	if s.purge == nil {
+		s.purge = service.NewPurgeService(
+			s.getUserRepo(ctx), s.getMovieRepo(ctx), s.getModerationRepo(ctx), s.getVoteRepo(ctx), s.getCommentRepo(ctx), s.Logger(),
+			s.UOW(ctx), s.cfg.SoftDelete,
+		)
+
+		closer.Add(func() error {
+			s.Logger().Info("Stopping purge job")
+			return s.purge.Close()
+		})
+	}
+	return s.purge
+}
+
+func (s *serviceProvider) ExportService(ctx context.Context) *service.ExportService {
+	if s.exports == nil {
+		s.exports = service.NewExportService(
+			s.getUserRepo(ctx), s.getVoteRepo(ctx), s.getCommentRepo(ctx), s.getReportRepo(ctx), s.getAnalyticsRepo(ctx), s.Logger(),
+		)
+	}
+	return s.exports
+}
+
+func (s *serviceProvider) ErasureService(ctx context.Context) *service.ErasureService {
+	if s.erasures == nil {
+		s.erasures = service.NewErasureService(
+			s.Service(ctx), s.getVoteRepo(ctx), s.getCommentRepo(ctx), s.getReportRepo(ctx), s.getBookmarkRepo(ctx),
+			s.getProgressRepo(ctx), s.getErasureRepo(ctx), s.getAnalyticsRepo(ctx),
+		)
+
+		closer.Add(func() error {
+			s.Logger().Info("Stopping erasure resume")
+			return s.erasures.Close()
+		})
+	}
+	return s.erasures
+}
+
+func (s *serviceProvider) ProgressService(ctx context.Context) *service.ProgressService {
+	if s.progress == nil {
+		s.progress = service.NewProgressService(s.getProgressRepo(ctx), s.getOutboxRepo(ctx), s.Logger(), s.Cache(), s.UOW(ctx), s.cfg.Progress)
+
+		closer.Add(func() error {
+			s.Logger().Info("Stopping progress flush")
+			return s.progress.Close()
+		})
+	}
+	return s.progress
+}
+
+func (s *serviceProvider) BookmarkService(ctx context.Context) *service.BookmarkService {
+	if s.bookmarks == nil {
+		s.bookmarks = service.NewBookmarkService(s.getBookmarkRepo(ctx), s.getOutboxRepo(ctx), s.Logger(), s.Cache(), s.UOW(ctx), s.Paginator())
+	}
+	return s.bookmarks
+}
+
+func (s *serviceProvider) OutboxRelay(ctx context.Context) *service.OutboxRelay {
+	if s.relay == nil {
+		s.relay = service.NewOutboxRelay(s.getOutboxRepo(ctx), s.Producer(), s.Logger(), s.cfg.Outbox)
+
+		closer.Add(func() error {
+			s.Logger().Info("Draining outbox")
+			return s.relay.Close()
+		})
+	}
+	return s.relay
+}
+
 func (s *serviceProvider) UGCServiceServer(ctx context.Context) *handler.UGCServiceServer {
 	if s.ugcImpl == nil {
-		s.ugcImpl = handler.NewServer(s.Service(ctx))
+		s.ugcImpl = handler.NewServer(
+			s.Service(ctx), s.VoteService(ctx), s.CommentService(ctx), s.ReportService(ctx), s.SearchService(ctx),
+			s.FeedService(ctx), s.ImportService(ctx),
+		)
 	}
 	return s.ugcImpl
 }
+
+func (s *serviceProvider) ProgressServiceServer(ctx context.Context) *handler.ProgressServiceServer {
+	if s.progressImpl == nil {
+		s.progressImpl = handler.NewProgressServer(s.ProgressService(ctx))
+	}
+	return s.progressImpl
+}
+
+func (s *serviceProvider) BookmarkServiceServer(ctx context.Context) *handler.BookmarkServiceServer {
+	if s.bookmarkImpl == nil {
+		s.bookmarkImpl = handler.NewBookmarkServer(s.BookmarkService(ctx))
+	}
+	return s.bookmarkImpl
+}
+
+func (s *serviceProvider) AdminServiceServer(ctx context.Context) *handler.AdminServiceServer {
+	if s.adminImpl == nil {
+		s.adminImpl = handler.NewAdminServer(s.ModerationService(ctx), s.ErasureService(ctx), s.ExportService(ctx))
+	}
+	return s.adminImpl
+}
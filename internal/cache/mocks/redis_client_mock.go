@@ -2,7 +2,7 @@
 
 package mocks
 
-//go:generate minimock -i github.com/maisiq/go-ugc-service/internal/repository/cache.RedisClient -o redis_client_mock.go -n RedisClientMock -p mocks
+//go:generate minimock -i github.com/maisiq/go-ugc-service/internal/cache.RedisClient -o redis_client_mock.go -n RedisClientMock -p mocks
 
 import (
 	"context"
@@ -27,6 +27,20 @@ type RedisClientMock struct {
 	beforeCloseCounter uint64
 	CloseMock          mRedisClientMockClose
 
+	funcDel          func(ctx context.Context, keys ...string) (ip1 *redis.IntCmd)
+	funcDelOrigin    string
+	inspectFuncDel   func(ctx context.Context, keys ...string)
+	afterDelCounter  uint64
+	beforeDelCounter uint64
+	DelMock          mRedisClientMockDel
+
+	funcExpire          func(ctx context.Context, key string, expiration time.Duration) (bp1 *redis.BoolCmd)
+	funcExpireOrigin    string
+	inspectFuncExpire   func(ctx context.Context, key string, expiration time.Duration)
+	afterExpireCounter  uint64
+	beforeExpireCounter uint64
+	ExpireMock          mRedisClientMockExpire
+
 	funcGet          func(ctx context.Context, key string) (sp1 *redis.StringCmd)
 	funcGetOrigin    string
 	inspectFuncGet   func(ctx context.Context, key string)
@@ -34,6 +48,55 @@ type RedisClientMock struct {
 	beforeGetCounter uint64
 	GetMock          mRedisClientMockGet
 
+	funcHGet          func(ctx context.Context, key string, field string) (sp1 *redis.StringCmd)
+	funcHGetOrigin    string
+	inspectFuncHGet   func(ctx context.Context, key string, field string)
+	afterHGetCounter  uint64
+	beforeHGetCounter uint64
+	HGetMock          mRedisClientMockHGet
+
+	funcHGetAll          func(ctx context.Context, key string) (mp1 *redis.MapStringStringCmd)
+	funcHGetAllOrigin    string
+	inspectFuncHGetAll   func(ctx context.Context, key string)
+	afterHGetAllCounter  uint64
+	beforeHGetAllCounter uint64
+	HGetAllMock          mRedisClientMockHGetAll
+
+	funcHSet          func(ctx context.Context, key string, values ...interface{}) (ip1 *redis.IntCmd)
+	funcHSetOrigin    string
+	inspectFuncHSet   func(ctx context.Context, key string, values ...interface{})
+	afterHSetCounter  uint64
+	beforeHSetCounter uint64
+	HSetMock          mRedisClientMockHSet
+
+	funcMGet          func(ctx context.Context, keys ...string) (sp1 *redis.SliceCmd)
+	funcMGetOrigin    string
+	inspectFuncMGet   func(ctx context.Context, keys ...string)
+	afterMGetCounter  uint64
+	beforeMGetCounter uint64
+	MGetMock          mRedisClientMockMGet
+
+	funcPipelined          func(ctx context.Context, fn func(redis.Pipeliner) error) (ca1 []redis.Cmder, err error)
+	funcPipelinedOrigin    string
+	inspectFuncPipelined   func(ctx context.Context, fn func(redis.Pipeliner) error)
+	afterPipelinedCounter  uint64
+	beforePipelinedCounter uint64
+	PipelinedMock          mRedisClientMockPipelined
+
+	funcSAdd          func(ctx context.Context, key string, members ...interface{}) (ip1 *redis.IntCmd)
+	funcSAddOrigin    string
+	inspectFuncSAdd   func(ctx context.Context, key string, members ...interface{})
+	afterSAddCounter  uint64
+	beforeSAddCounter uint64
+	SAddMock          mRedisClientMockSAdd
+
+	funcSPopN          func(ctx context.Context, key string, count int64) (sp1 *redis.StringSliceCmd)
+	funcSPopNOrigin    string
+	inspectFuncSPopN   func(ctx context.Context, key string, count int64)
+	afterSPopNCounter  uint64
+	beforeSPopNCounter uint64
+	SPopNMock          mRedisClientMockSPopN
+
 	funcSet          func(ctx context.Context, key string, value interface{}, expiration time.Duration) (sp1 *redis.StatusCmd)
 	funcSetOrigin    string
 	inspectFuncSet   func(ctx context.Context, key string, value interface{}, expiration time.Duration)
@@ -52,9 +115,36 @@ func NewRedisClientMock(t minimock.Tester) *RedisClientMock {
 
 	m.CloseMock = mRedisClientMockClose{mock: m}
 
+	m.DelMock = mRedisClientMockDel{mock: m}
+	m.DelMock.callArgs = []*RedisClientMockDelParams{}
+
+	m.ExpireMock = mRedisClientMockExpire{mock: m}
+	m.ExpireMock.callArgs = []*RedisClientMockExpireParams{}
+
 	m.GetMock = mRedisClientMockGet{mock: m}
 	m.GetMock.callArgs = []*RedisClientMockGetParams{}
 
+	m.HGetMock = mRedisClientMockHGet{mock: m}
+	m.HGetMock.callArgs = []*RedisClientMockHGetParams{}
+
+	m.HGetAllMock = mRedisClientMockHGetAll{mock: m}
+	m.HGetAllMock.callArgs = []*RedisClientMockHGetAllParams{}
+
+	m.HSetMock = mRedisClientMockHSet{mock: m}
+	m.HSetMock.callArgs = []*RedisClientMockHSetParams{}
+
+	m.MGetMock = mRedisClientMockMGet{mock: m}
+	m.MGetMock.callArgs = []*RedisClientMockMGetParams{}
+
+	m.PipelinedMock = mRedisClientMockPipelined{mock: m}
+	m.PipelinedMock.callArgs = []*RedisClientMockPipelinedParams{}
+
+	m.SAddMock = mRedisClientMockSAdd{mock: m}
+	m.SAddMock.callArgs = []*RedisClientMockSAddParams{}
+
+	m.SPopNMock = mRedisClientMockSPopN{mock: m}
+	m.SPopNMock.callArgs = []*RedisClientMockSPopNParams{}
+
 	m.SetMock = mRedisClientMockSet{mock: m}
 	m.SetMock.callArgs = []*RedisClientMockSetParams{}
 
@@ -165,136 +255,3342 @@ func (mmClose *mRedisClientMockClose) invocationsDone() bool {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmClose.mock.afterCloseCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmClose.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmClose.mock.afterCloseCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClose.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Close implements mm_cache.RedisClient
+func (mmClose *RedisClientMock) Close() (err error) {
+	mm_atomic.AddUint64(&mmClose.beforeCloseCounter, 1)
+	defer mm_atomic.AddUint64(&mmClose.afterCloseCounter, 1)
+
+	mmClose.t.Helper()
+
+	if mmClose.inspectFuncClose != nil {
+		mmClose.inspectFuncClose()
+	}
+
+	if mmClose.CloseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClose.CloseMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmClose.CloseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClose.t.Fatal("No results are set for the RedisClientMock.Close")
+		}
+		return (*mm_results).err
+	}
+	if mmClose.funcClose != nil {
+		return mmClose.funcClose()
+	}
+	mmClose.t.Fatalf("Unexpected call to RedisClientMock.Close.")
+	return
+}
+
+// CloseAfterCounter returns a count of finished RedisClientMock.Close invocations
+func (mmClose *RedisClientMock) CloseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClose.afterCloseCounter)
+}
+
+// CloseBeforeCounter returns a count of RedisClientMock.Close invocations
+func (mmClose *RedisClientMock) CloseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClose.beforeCloseCounter)
+}
+
+// MinimockCloseDone returns true if the count of the Close invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockCloseDone() bool {
+	if m.CloseMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CloseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CloseMock.invocationsDone()
+}
+
+// MinimockCloseInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockCloseInspect() {
+	for _, e := range m.CloseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to RedisClientMock.Close")
+		}
+	}
+
+	afterCloseCounter := mm_atomic.LoadUint64(&m.afterCloseCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CloseMock.defaultExpectation != nil && afterCloseCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.Close at\n%s", m.CloseMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClose != nil && afterCloseCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.Close at\n%s", m.funcCloseOrigin)
+	}
+
+	if !m.CloseMock.invocationsDone() && afterCloseCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.Close at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CloseMock.expectedInvocations), m.CloseMock.expectedInvocationsOrigin, afterCloseCounter)
+	}
+}
+
+type mRedisClientMockDel struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockDelExpectation
+	expectations       []*RedisClientMockDelExpectation
+
+	callArgs []*RedisClientMockDelParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockDelExpectation specifies expectation struct of the RedisClient.Del
+type RedisClientMockDelExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockDelParams
+	paramPtrs          *RedisClientMockDelParamPtrs
+	expectationOrigins RedisClientMockDelExpectationOrigins
+	results            *RedisClientMockDelResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockDelParams contains parameters of the RedisClient.Del
+type RedisClientMockDelParams struct {
+	ctx  context.Context
+	keys []string
+}
+
+// RedisClientMockDelParamPtrs contains pointers to parameters of the RedisClient.Del
+type RedisClientMockDelParamPtrs struct {
+	ctx  *context.Context
+	keys *[]string
+}
+
+// RedisClientMockDelResults contains results of the RedisClient.Del
+type RedisClientMockDelResults struct {
+	ip1 *redis.IntCmd
+}
+
+// RedisClientMockDelOrigins contains origins of expectations of the RedisClient.Del
+type RedisClientMockDelExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originKeys string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDel *mRedisClientMockDel) Optional() *mRedisClientMockDel {
+	mmDel.optional = true
+	return mmDel
+}
+
+// Expect sets up expected params for RedisClient.Del
+func (mmDel *mRedisClientMockDel) Expect(ctx context.Context, keys ...string) *mRedisClientMockDel {
+	if mmDel.mock.funcDel != nil {
+		mmDel.mock.t.Fatalf("RedisClientMock.Del mock is already set by Set")
+	}
+
+	if mmDel.defaultExpectation == nil {
+		mmDel.defaultExpectation = &RedisClientMockDelExpectation{}
+	}
+
+	if mmDel.defaultExpectation.paramPtrs != nil {
+		mmDel.mock.t.Fatalf("RedisClientMock.Del mock is already set by ExpectParams functions")
+	}
+
+	mmDel.defaultExpectation.params = &RedisClientMockDelParams{ctx, keys}
+	mmDel.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDel.expectations {
+		if minimock.Equal(e.params, mmDel.defaultExpectation.params) {
+			mmDel.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDel.defaultExpectation.params)
+		}
+	}
+
+	return mmDel
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.Del
+func (mmDel *mRedisClientMockDel) ExpectCtxParam1(ctx context.Context) *mRedisClientMockDel {
+	if mmDel.mock.funcDel != nil {
+		mmDel.mock.t.Fatalf("RedisClientMock.Del mock is already set by Set")
+	}
+
+	if mmDel.defaultExpectation == nil {
+		mmDel.defaultExpectation = &RedisClientMockDelExpectation{}
+	}
+
+	if mmDel.defaultExpectation.params != nil {
+		mmDel.mock.t.Fatalf("RedisClientMock.Del mock is already set by Expect")
+	}
+
+	if mmDel.defaultExpectation.paramPtrs == nil {
+		mmDel.defaultExpectation.paramPtrs = &RedisClientMockDelParamPtrs{}
+	}
+	mmDel.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDel.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDel
+}
+
+// ExpectKeysParam2 sets up expected param keys for RedisClient.Del
+func (mmDel *mRedisClientMockDel) ExpectKeysParam2(keys ...string) *mRedisClientMockDel {
+	if mmDel.mock.funcDel != nil {
+		mmDel.mock.t.Fatalf("RedisClientMock.Del mock is already set by Set")
+	}
+
+	if mmDel.defaultExpectation == nil {
+		mmDel.defaultExpectation = &RedisClientMockDelExpectation{}
+	}
+
+	if mmDel.defaultExpectation.params != nil {
+		mmDel.mock.t.Fatalf("RedisClientMock.Del mock is already set by Expect")
+	}
+
+	if mmDel.defaultExpectation.paramPtrs == nil {
+		mmDel.defaultExpectation.paramPtrs = &RedisClientMockDelParamPtrs{}
+	}
+	mmDel.defaultExpectation.paramPtrs.keys = &keys
+	mmDel.defaultExpectation.expectationOrigins.originKeys = minimock.CallerInfo(1)
+
+	return mmDel
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.Del
+func (mmDel *mRedisClientMockDel) Inspect(f func(ctx context.Context, keys ...string)) *mRedisClientMockDel {
+	if mmDel.mock.inspectFuncDel != nil {
+		mmDel.mock.t.Fatalf("Inspect function is already set for RedisClientMock.Del")
+	}
+
+	mmDel.mock.inspectFuncDel = f
+
+	return mmDel
+}
+
+// Return sets up results that will be returned by RedisClient.Del
+func (mmDel *mRedisClientMockDel) Return(ip1 *redis.IntCmd) *RedisClientMock {
+	if mmDel.mock.funcDel != nil {
+		mmDel.mock.t.Fatalf("RedisClientMock.Del mock is already set by Set")
+	}
+
+	if mmDel.defaultExpectation == nil {
+		mmDel.defaultExpectation = &RedisClientMockDelExpectation{mock: mmDel.mock}
+	}
+	mmDel.defaultExpectation.results = &RedisClientMockDelResults{ip1}
+	mmDel.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDel.mock
+}
+
+// Set uses given function f to mock the RedisClient.Del method
+func (mmDel *mRedisClientMockDel) Set(f func(ctx context.Context, keys ...string) (ip1 *redis.IntCmd)) *RedisClientMock {
+	if mmDel.defaultExpectation != nil {
+		mmDel.mock.t.Fatalf("Default expectation is already set for the RedisClient.Del method")
+	}
+
+	if len(mmDel.expectations) > 0 {
+		mmDel.mock.t.Fatalf("Some expectations are already set for the RedisClient.Del method")
+	}
+
+	mmDel.mock.funcDel = f
+	mmDel.mock.funcDelOrigin = minimock.CallerInfo(1)
+	return mmDel.mock
+}
+
+// When sets expectation for the RedisClient.Del which will trigger the result defined by the following
+// Then helper
+func (mmDel *mRedisClientMockDel) When(ctx context.Context, keys ...string) *RedisClientMockDelExpectation {
+	if mmDel.mock.funcDel != nil {
+		mmDel.mock.t.Fatalf("RedisClientMock.Del mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockDelExpectation{
+		mock:               mmDel.mock,
+		params:             &RedisClientMockDelParams{ctx, keys},
+		expectationOrigins: RedisClientMockDelExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDel.expectations = append(mmDel.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.Del return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockDelExpectation) Then(ip1 *redis.IntCmd) *RedisClientMock {
+	e.results = &RedisClientMockDelResults{ip1}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.Del should be invoked
+func (mmDel *mRedisClientMockDel) Times(n uint64) *mRedisClientMockDel {
+	if n == 0 {
+		mmDel.mock.t.Fatalf("Times of RedisClientMock.Del mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDel.expectedInvocations, n)
+	mmDel.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDel
+}
+
+func (mmDel *mRedisClientMockDel) invocationsDone() bool {
+	if len(mmDel.expectations) == 0 && mmDel.defaultExpectation == nil && mmDel.mock.funcDel == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDel.mock.afterDelCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDel.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Del implements mm_cache.RedisClient
+func (mmDel *RedisClientMock) Del(ctx context.Context, keys ...string) (ip1 *redis.IntCmd) {
+	mm_atomic.AddUint64(&mmDel.beforeDelCounter, 1)
+	defer mm_atomic.AddUint64(&mmDel.afterDelCounter, 1)
+
+	mmDel.t.Helper()
+
+	if mmDel.inspectFuncDel != nil {
+		mmDel.inspectFuncDel(ctx, keys...)
+	}
+
+	mm_params := RedisClientMockDelParams{ctx, keys}
+
+	// Record call args
+	mmDel.DelMock.mutex.Lock()
+	mmDel.DelMock.callArgs = append(mmDel.DelMock.callArgs, &mm_params)
+	mmDel.DelMock.mutex.Unlock()
+
+	for _, e := range mmDel.DelMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ip1
+		}
+	}
+
+	if mmDel.DelMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDel.DelMock.defaultExpectation.Counter, 1)
+		mm_want := mmDel.DelMock.defaultExpectation.params
+		mm_want_ptrs := mmDel.DelMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockDelParams{ctx, keys}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDel.t.Errorf("RedisClientMock.Del got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDel.DelMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.keys != nil && !minimock.Equal(*mm_want_ptrs.keys, mm_got.keys) {
+				mmDel.t.Errorf("RedisClientMock.Del got unexpected parameter keys, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDel.DelMock.defaultExpectation.expectationOrigins.originKeys, *mm_want_ptrs.keys, mm_got.keys, minimock.Diff(*mm_want_ptrs.keys, mm_got.keys))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDel.t.Errorf("RedisClientMock.Del got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDel.DelMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDel.DelMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDel.t.Fatal("No results are set for the RedisClientMock.Del")
+		}
+		return (*mm_results).ip1
+	}
+	if mmDel.funcDel != nil {
+		return mmDel.funcDel(ctx, keys...)
+	}
+	mmDel.t.Fatalf("Unexpected call to RedisClientMock.Del. %v %v", ctx, keys)
+	return
+}
+
+// DelAfterCounter returns a count of finished RedisClientMock.Del invocations
+func (mmDel *RedisClientMock) DelAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDel.afterDelCounter)
+}
+
+// DelBeforeCounter returns a count of RedisClientMock.Del invocations
+func (mmDel *RedisClientMock) DelBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDel.beforeDelCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.Del.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDel *mRedisClientMockDel) Calls() []*RedisClientMockDelParams {
+	mmDel.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockDelParams, len(mmDel.callArgs))
+	copy(argCopy, mmDel.callArgs)
+
+	mmDel.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDelDone returns true if the count of the Del invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockDelDone() bool {
+	if m.DelMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DelMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DelMock.invocationsDone()
+}
+
+// MinimockDelInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockDelInspect() {
+	for _, e := range m.DelMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.Del at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDelCounter := mm_atomic.LoadUint64(&m.afterDelCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DelMock.defaultExpectation != nil && afterDelCounter < 1 {
+		if m.DelMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.Del at\n%s", m.DelMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.Del at\n%s with params: %#v", m.DelMock.defaultExpectation.expectationOrigins.origin, *m.DelMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDel != nil && afterDelCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.Del at\n%s", m.funcDelOrigin)
+	}
+
+	if !m.DelMock.invocationsDone() && afterDelCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.Del at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DelMock.expectedInvocations), m.DelMock.expectedInvocationsOrigin, afterDelCounter)
+	}
+}
+
+type mRedisClientMockExpire struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockExpireExpectation
+	expectations       []*RedisClientMockExpireExpectation
+
+	callArgs []*RedisClientMockExpireParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockExpireExpectation specifies expectation struct of the RedisClient.Expire
+type RedisClientMockExpireExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockExpireParams
+	paramPtrs          *RedisClientMockExpireParamPtrs
+	expectationOrigins RedisClientMockExpireExpectationOrigins
+	results            *RedisClientMockExpireResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockExpireParams contains parameters of the RedisClient.Expire
+type RedisClientMockExpireParams struct {
+	ctx        context.Context
+	key        string
+	expiration time.Duration
+}
+
+// RedisClientMockExpireParamPtrs contains pointers to parameters of the RedisClient.Expire
+type RedisClientMockExpireParamPtrs struct {
+	ctx        *context.Context
+	key        *string
+	expiration *time.Duration
+}
+
+// RedisClientMockExpireResults contains results of the RedisClient.Expire
+type RedisClientMockExpireResults struct {
+	bp1 *redis.BoolCmd
+}
+
+// RedisClientMockExpireOrigins contains origins of expectations of the RedisClient.Expire
+type RedisClientMockExpireExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originKey        string
+	originExpiration string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExpire *mRedisClientMockExpire) Optional() *mRedisClientMockExpire {
+	mmExpire.optional = true
+	return mmExpire
+}
+
+// Expect sets up expected params for RedisClient.Expire
+func (mmExpire *mRedisClientMockExpire) Expect(ctx context.Context, key string, expiration time.Duration) *mRedisClientMockExpire {
+	if mmExpire.mock.funcExpire != nil {
+		mmExpire.mock.t.Fatalf("RedisClientMock.Expire mock is already set by Set")
+	}
+
+	if mmExpire.defaultExpectation == nil {
+		mmExpire.defaultExpectation = &RedisClientMockExpireExpectation{}
+	}
+
+	if mmExpire.defaultExpectation.paramPtrs != nil {
+		mmExpire.mock.t.Fatalf("RedisClientMock.Expire mock is already set by ExpectParams functions")
+	}
+
+	mmExpire.defaultExpectation.params = &RedisClientMockExpireParams{ctx, key, expiration}
+	mmExpire.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExpire.expectations {
+		if minimock.Equal(e.params, mmExpire.defaultExpectation.params) {
+			mmExpire.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExpire.defaultExpectation.params)
+		}
+	}
+
+	return mmExpire
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.Expire
+func (mmExpire *mRedisClientMockExpire) ExpectCtxParam1(ctx context.Context) *mRedisClientMockExpire {
+	if mmExpire.mock.funcExpire != nil {
+		mmExpire.mock.t.Fatalf("RedisClientMock.Expire mock is already set by Set")
+	}
+
+	if mmExpire.defaultExpectation == nil {
+		mmExpire.defaultExpectation = &RedisClientMockExpireExpectation{}
+	}
+
+	if mmExpire.defaultExpectation.params != nil {
+		mmExpire.mock.t.Fatalf("RedisClientMock.Expire mock is already set by Expect")
+	}
+
+	if mmExpire.defaultExpectation.paramPtrs == nil {
+		mmExpire.defaultExpectation.paramPtrs = &RedisClientMockExpireParamPtrs{}
+	}
+	mmExpire.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExpire.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExpire
+}
+
+// ExpectKeyParam2 sets up expected param key for RedisClient.Expire
+func (mmExpire *mRedisClientMockExpire) ExpectKeyParam2(key string) *mRedisClientMockExpire {
+	if mmExpire.mock.funcExpire != nil {
+		mmExpire.mock.t.Fatalf("RedisClientMock.Expire mock is already set by Set")
+	}
+
+	if mmExpire.defaultExpectation == nil {
+		mmExpire.defaultExpectation = &RedisClientMockExpireExpectation{}
+	}
+
+	if mmExpire.defaultExpectation.params != nil {
+		mmExpire.mock.t.Fatalf("RedisClientMock.Expire mock is already set by Expect")
+	}
+
+	if mmExpire.defaultExpectation.paramPtrs == nil {
+		mmExpire.defaultExpectation.paramPtrs = &RedisClientMockExpireParamPtrs{}
+	}
+	mmExpire.defaultExpectation.paramPtrs.key = &key
+	mmExpire.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmExpire
+}
+
+// ExpectExpirationParam3 sets up expected param expiration for RedisClient.Expire
+func (mmExpire *mRedisClientMockExpire) ExpectExpirationParam3(expiration time.Duration) *mRedisClientMockExpire {
+	if mmExpire.mock.funcExpire != nil {
+		mmExpire.mock.t.Fatalf("RedisClientMock.Expire mock is already set by Set")
+	}
+
+	if mmExpire.defaultExpectation == nil {
+		mmExpire.defaultExpectation = &RedisClientMockExpireExpectation{}
+	}
+
+	if mmExpire.defaultExpectation.params != nil {
+		mmExpire.mock.t.Fatalf("RedisClientMock.Expire mock is already set by Expect")
+	}
+
+	if mmExpire.defaultExpectation.paramPtrs == nil {
+		mmExpire.defaultExpectation.paramPtrs = &RedisClientMockExpireParamPtrs{}
+	}
+	mmExpire.defaultExpectation.paramPtrs.expiration = &expiration
+	mmExpire.defaultExpectation.expectationOrigins.originExpiration = minimock.CallerInfo(1)
+
+	return mmExpire
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.Expire
+func (mmExpire *mRedisClientMockExpire) Inspect(f func(ctx context.Context, key string, expiration time.Duration)) *mRedisClientMockExpire {
+	if mmExpire.mock.inspectFuncExpire != nil {
+		mmExpire.mock.t.Fatalf("Inspect function is already set for RedisClientMock.Expire")
+	}
+
+	mmExpire.mock.inspectFuncExpire = f
+
+	return mmExpire
+}
+
+// Return sets up results that will be returned by RedisClient.Expire
+func (mmExpire *mRedisClientMockExpire) Return(bp1 *redis.BoolCmd) *RedisClientMock {
+	if mmExpire.mock.funcExpire != nil {
+		mmExpire.mock.t.Fatalf("RedisClientMock.Expire mock is already set by Set")
+	}
+
+	if mmExpire.defaultExpectation == nil {
+		mmExpire.defaultExpectation = &RedisClientMockExpireExpectation{mock: mmExpire.mock}
+	}
+	mmExpire.defaultExpectation.results = &RedisClientMockExpireResults{bp1}
+	mmExpire.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExpire.mock
+}
+
+// Set uses given function f to mock the RedisClient.Expire method
+func (mmExpire *mRedisClientMockExpire) Set(f func(ctx context.Context, key string, expiration time.Duration) (bp1 *redis.BoolCmd)) *RedisClientMock {
+	if mmExpire.defaultExpectation != nil {
+		mmExpire.mock.t.Fatalf("Default expectation is already set for the RedisClient.Expire method")
+	}
+
+	if len(mmExpire.expectations) > 0 {
+		mmExpire.mock.t.Fatalf("Some expectations are already set for the RedisClient.Expire method")
+	}
+
+	mmExpire.mock.funcExpire = f
+	mmExpire.mock.funcExpireOrigin = minimock.CallerInfo(1)
+	return mmExpire.mock
+}
+
+// When sets expectation for the RedisClient.Expire which will trigger the result defined by the following
+// Then helper
+func (mmExpire *mRedisClientMockExpire) When(ctx context.Context, key string, expiration time.Duration) *RedisClientMockExpireExpectation {
+	if mmExpire.mock.funcExpire != nil {
+		mmExpire.mock.t.Fatalf("RedisClientMock.Expire mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockExpireExpectation{
+		mock:               mmExpire.mock,
+		params:             &RedisClientMockExpireParams{ctx, key, expiration},
+		expectationOrigins: RedisClientMockExpireExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExpire.expectations = append(mmExpire.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.Expire return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockExpireExpectation) Then(bp1 *redis.BoolCmd) *RedisClientMock {
+	e.results = &RedisClientMockExpireResults{bp1}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.Expire should be invoked
+func (mmExpire *mRedisClientMockExpire) Times(n uint64) *mRedisClientMockExpire {
+	if n == 0 {
+		mmExpire.mock.t.Fatalf("Times of RedisClientMock.Expire mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExpire.expectedInvocations, n)
+	mmExpire.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExpire
+}
+
+func (mmExpire *mRedisClientMockExpire) invocationsDone() bool {
+	if len(mmExpire.expectations) == 0 && mmExpire.defaultExpectation == nil && mmExpire.mock.funcExpire == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExpire.mock.afterExpireCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExpire.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Expire implements mm_cache.RedisClient
+func (mmExpire *RedisClientMock) Expire(ctx context.Context, key string, expiration time.Duration) (bp1 *redis.BoolCmd) {
+	mm_atomic.AddUint64(&mmExpire.beforeExpireCounter, 1)
+	defer mm_atomic.AddUint64(&mmExpire.afterExpireCounter, 1)
+
+	mmExpire.t.Helper()
+
+	if mmExpire.inspectFuncExpire != nil {
+		mmExpire.inspectFuncExpire(ctx, key, expiration)
+	}
+
+	mm_params := RedisClientMockExpireParams{ctx, key, expiration}
+
+	// Record call args
+	mmExpire.ExpireMock.mutex.Lock()
+	mmExpire.ExpireMock.callArgs = append(mmExpire.ExpireMock.callArgs, &mm_params)
+	mmExpire.ExpireMock.mutex.Unlock()
+
+	for _, e := range mmExpire.ExpireMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.bp1
+		}
+	}
+
+	if mmExpire.ExpireMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExpire.ExpireMock.defaultExpectation.Counter, 1)
+		mm_want := mmExpire.ExpireMock.defaultExpectation.params
+		mm_want_ptrs := mmExpire.ExpireMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockExpireParams{ctx, key, expiration}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExpire.t.Errorf("RedisClientMock.Expire got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpire.ExpireMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmExpire.t.Errorf("RedisClientMock.Expire got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpire.ExpireMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.expiration != nil && !minimock.Equal(*mm_want_ptrs.expiration, mm_got.expiration) {
+				mmExpire.t.Errorf("RedisClientMock.Expire got unexpected parameter expiration, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpire.ExpireMock.defaultExpectation.expectationOrigins.originExpiration, *mm_want_ptrs.expiration, mm_got.expiration, minimock.Diff(*mm_want_ptrs.expiration, mm_got.expiration))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExpire.t.Errorf("RedisClientMock.Expire got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExpire.ExpireMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExpire.ExpireMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExpire.t.Fatal("No results are set for the RedisClientMock.Expire")
+		}
+		return (*mm_results).bp1
+	}
+	if mmExpire.funcExpire != nil {
+		return mmExpire.funcExpire(ctx, key, expiration)
+	}
+	mmExpire.t.Fatalf("Unexpected call to RedisClientMock.Expire. %v %v %v", ctx, key, expiration)
+	return
+}
+
+// ExpireAfterCounter returns a count of finished RedisClientMock.Expire invocations
+func (mmExpire *RedisClientMock) ExpireAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpire.afterExpireCounter)
+}
+
+// ExpireBeforeCounter returns a count of RedisClientMock.Expire invocations
+func (mmExpire *RedisClientMock) ExpireBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpire.beforeExpireCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.Expire.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExpire *mRedisClientMockExpire) Calls() []*RedisClientMockExpireParams {
+	mmExpire.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockExpireParams, len(mmExpire.callArgs))
+	copy(argCopy, mmExpire.callArgs)
+
+	mmExpire.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExpireDone returns true if the count of the Expire invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockExpireDone() bool {
+	if m.ExpireMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExpireMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExpireMock.invocationsDone()
+}
+
+// MinimockExpireInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockExpireInspect() {
+	for _, e := range m.ExpireMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.Expire at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExpireCounter := mm_atomic.LoadUint64(&m.afterExpireCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExpireMock.defaultExpectation != nil && afterExpireCounter < 1 {
+		if m.ExpireMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.Expire at\n%s", m.ExpireMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.Expire at\n%s with params: %#v", m.ExpireMock.defaultExpectation.expectationOrigins.origin, *m.ExpireMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExpire != nil && afterExpireCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.Expire at\n%s", m.funcExpireOrigin)
+	}
+
+	if !m.ExpireMock.invocationsDone() && afterExpireCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.Expire at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExpireMock.expectedInvocations), m.ExpireMock.expectedInvocationsOrigin, afterExpireCounter)
+	}
+}
+
+type mRedisClientMockGet struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockGetExpectation
+	expectations       []*RedisClientMockGetExpectation
+
+	callArgs []*RedisClientMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockGetExpectation specifies expectation struct of the RedisClient.Get
+type RedisClientMockGetExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockGetParams
+	paramPtrs          *RedisClientMockGetParamPtrs
+	expectationOrigins RedisClientMockGetExpectationOrigins
+	results            *RedisClientMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockGetParams contains parameters of the RedisClient.Get
+type RedisClientMockGetParams struct {
+	ctx context.Context
+	key string
+}
+
+// RedisClientMockGetParamPtrs contains pointers to parameters of the RedisClient.Get
+type RedisClientMockGetParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// RedisClientMockGetResults contains results of the RedisClient.Get
+type RedisClientMockGetResults struct {
+	sp1 *redis.StringCmd
+}
+
+// RedisClientMockGetOrigins contains origins of expectations of the RedisClient.Get
+type RedisClientMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mRedisClientMockGet) Optional() *mRedisClientMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for RedisClient.Get
+func (mmGet *mRedisClientMockGet) Expect(ctx context.Context, key string) *mRedisClientMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RedisClientMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &RedisClientMockGetParams{ctx, key}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.Get
+func (mmGet *mRedisClientMockGet) ExpectCtxParam1(ctx context.Context) *mRedisClientMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RedisClientMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &RedisClientMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectKeyParam2 sets up expected param key for RedisClient.Get
+func (mmGet *mRedisClientMockGet) ExpectKeyParam2(key string) *mRedisClientMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RedisClientMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &RedisClientMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.key = &key
+	mmGet.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.Get
+func (mmGet *mRedisClientMockGet) Inspect(f func(ctx context.Context, key string)) *mRedisClientMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for RedisClientMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by RedisClient.Get
+func (mmGet *mRedisClientMockGet) Return(sp1 *redis.StringCmd) *RedisClientMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RedisClientMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &RedisClientMockGetResults{sp1}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the RedisClient.Get method
+func (mmGet *mRedisClientMockGet) Set(f func(ctx context.Context, key string) (sp1 *redis.StringCmd)) *RedisClientMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the RedisClient.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the RedisClient.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the RedisClient.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mRedisClientMockGet) When(ctx context.Context, key string) *RedisClientMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &RedisClientMockGetParams{ctx, key},
+		expectationOrigins: RedisClientMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.Get return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockGetExpectation) Then(sp1 *redis.StringCmd) *RedisClientMock {
+	e.results = &RedisClientMockGetResults{sp1}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.Get should be invoked
+func (mmGet *mRedisClientMockGet) Times(n uint64) *mRedisClientMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of RedisClientMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mRedisClientMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_cache.RedisClient
+func (mmGet *RedisClientMock) Get(ctx context.Context, key string) (sp1 *redis.StringCmd) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, key)
+	}
+
+	mm_params := RedisClientMockGetParams{ctx, key}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockGetParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("RedisClientMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmGet.t.Errorf("RedisClientMock.Get got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("RedisClientMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the RedisClientMock.Get")
+		}
+		return (*mm_results).sp1
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, key)
+	}
+	mmGet.t.Fatalf("Unexpected call to RedisClientMock.Get. %v %v", ctx, key)
+	return
+}
+
+// GetAfterCounter returns a count of finished RedisClientMock.Get invocations
+func (mmGet *RedisClientMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of RedisClientMock.Get invocations
+func (mmGet *RedisClientMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mRedisClientMockGet) Calls() []*RedisClientMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mRedisClientMockHGet struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockHGetExpectation
+	expectations       []*RedisClientMockHGetExpectation
+
+	callArgs []*RedisClientMockHGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockHGetExpectation specifies expectation struct of the RedisClient.HGet
+type RedisClientMockHGetExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockHGetParams
+	paramPtrs          *RedisClientMockHGetParamPtrs
+	expectationOrigins RedisClientMockHGetExpectationOrigins
+	results            *RedisClientMockHGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockHGetParams contains parameters of the RedisClient.HGet
+type RedisClientMockHGetParams struct {
+	ctx   context.Context
+	key   string
+	field string
+}
+
+// RedisClientMockHGetParamPtrs contains pointers to parameters of the RedisClient.HGet
+type RedisClientMockHGetParamPtrs struct {
+	ctx   *context.Context
+	key   *string
+	field *string
+}
+
+// RedisClientMockHGetResults contains results of the RedisClient.HGet
+type RedisClientMockHGetResults struct {
+	sp1 *redis.StringCmd
+}
+
+// RedisClientMockHGetOrigins contains origins of expectations of the RedisClient.HGet
+type RedisClientMockHGetExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originKey   string
+	originField string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmHGet *mRedisClientMockHGet) Optional() *mRedisClientMockHGet {
+	mmHGet.optional = true
+	return mmHGet
+}
+
+// Expect sets up expected params for RedisClient.HGet
+func (mmHGet *mRedisClientMockHGet) Expect(ctx context.Context, key string, field string) *mRedisClientMockHGet {
+	if mmHGet.mock.funcHGet != nil {
+		mmHGet.mock.t.Fatalf("RedisClientMock.HGet mock is already set by Set")
+	}
+
+	if mmHGet.defaultExpectation == nil {
+		mmHGet.defaultExpectation = &RedisClientMockHGetExpectation{}
+	}
+
+	if mmHGet.defaultExpectation.paramPtrs != nil {
+		mmHGet.mock.t.Fatalf("RedisClientMock.HGet mock is already set by ExpectParams functions")
+	}
+
+	mmHGet.defaultExpectation.params = &RedisClientMockHGetParams{ctx, key, field}
+	mmHGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmHGet.expectations {
+		if minimock.Equal(e.params, mmHGet.defaultExpectation.params) {
+			mmHGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHGet.defaultExpectation.params)
+		}
+	}
+
+	return mmHGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.HGet
+func (mmHGet *mRedisClientMockHGet) ExpectCtxParam1(ctx context.Context) *mRedisClientMockHGet {
+	if mmHGet.mock.funcHGet != nil {
+		mmHGet.mock.t.Fatalf("RedisClientMock.HGet mock is already set by Set")
+	}
+
+	if mmHGet.defaultExpectation == nil {
+		mmHGet.defaultExpectation = &RedisClientMockHGetExpectation{}
+	}
+
+	if mmHGet.defaultExpectation.params != nil {
+		mmHGet.mock.t.Fatalf("RedisClientMock.HGet mock is already set by Expect")
+	}
+
+	if mmHGet.defaultExpectation.paramPtrs == nil {
+		mmHGet.defaultExpectation.paramPtrs = &RedisClientMockHGetParamPtrs{}
+	}
+	mmHGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmHGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmHGet
+}
+
+// ExpectKeyParam2 sets up expected param key for RedisClient.HGet
+func (mmHGet *mRedisClientMockHGet) ExpectKeyParam2(key string) *mRedisClientMockHGet {
+	if mmHGet.mock.funcHGet != nil {
+		mmHGet.mock.t.Fatalf("RedisClientMock.HGet mock is already set by Set")
+	}
+
+	if mmHGet.defaultExpectation == nil {
+		mmHGet.defaultExpectation = &RedisClientMockHGetExpectation{}
+	}
+
+	if mmHGet.defaultExpectation.params != nil {
+		mmHGet.mock.t.Fatalf("RedisClientMock.HGet mock is already set by Expect")
+	}
+
+	if mmHGet.defaultExpectation.paramPtrs == nil {
+		mmHGet.defaultExpectation.paramPtrs = &RedisClientMockHGetParamPtrs{}
+	}
+	mmHGet.defaultExpectation.paramPtrs.key = &key
+	mmHGet.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmHGet
+}
+
+// ExpectFieldParam3 sets up expected param field for RedisClient.HGet
+func (mmHGet *mRedisClientMockHGet) ExpectFieldParam3(field string) *mRedisClientMockHGet {
+	if mmHGet.mock.funcHGet != nil {
+		mmHGet.mock.t.Fatalf("RedisClientMock.HGet mock is already set by Set")
+	}
+
+	if mmHGet.defaultExpectation == nil {
+		mmHGet.defaultExpectation = &RedisClientMockHGetExpectation{}
+	}
+
+	if mmHGet.defaultExpectation.params != nil {
+		mmHGet.mock.t.Fatalf("RedisClientMock.HGet mock is already set by Expect")
+	}
+
+	if mmHGet.defaultExpectation.paramPtrs == nil {
+		mmHGet.defaultExpectation.paramPtrs = &RedisClientMockHGetParamPtrs{}
+	}
+	mmHGet.defaultExpectation.paramPtrs.field = &field
+	mmHGet.defaultExpectation.expectationOrigins.originField = minimock.CallerInfo(1)
+
+	return mmHGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.HGet
+func (mmHGet *mRedisClientMockHGet) Inspect(f func(ctx context.Context, key string, field string)) *mRedisClientMockHGet {
+	if mmHGet.mock.inspectFuncHGet != nil {
+		mmHGet.mock.t.Fatalf("Inspect function is already set for RedisClientMock.HGet")
+	}
+
+	mmHGet.mock.inspectFuncHGet = f
+
+	return mmHGet
+}
+
+// Return sets up results that will be returned by RedisClient.HGet
+func (mmHGet *mRedisClientMockHGet) Return(sp1 *redis.StringCmd) *RedisClientMock {
+	if mmHGet.mock.funcHGet != nil {
+		mmHGet.mock.t.Fatalf("RedisClientMock.HGet mock is already set by Set")
+	}
+
+	if mmHGet.defaultExpectation == nil {
+		mmHGet.defaultExpectation = &RedisClientMockHGetExpectation{mock: mmHGet.mock}
+	}
+	mmHGet.defaultExpectation.results = &RedisClientMockHGetResults{sp1}
+	mmHGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmHGet.mock
+}
+
+// Set uses given function f to mock the RedisClient.HGet method
+func (mmHGet *mRedisClientMockHGet) Set(f func(ctx context.Context, key string, field string) (sp1 *redis.StringCmd)) *RedisClientMock {
+	if mmHGet.defaultExpectation != nil {
+		mmHGet.mock.t.Fatalf("Default expectation is already set for the RedisClient.HGet method")
+	}
+
+	if len(mmHGet.expectations) > 0 {
+		mmHGet.mock.t.Fatalf("Some expectations are already set for the RedisClient.HGet method")
+	}
+
+	mmHGet.mock.funcHGet = f
+	mmHGet.mock.funcHGetOrigin = minimock.CallerInfo(1)
+	return mmHGet.mock
+}
+
+// When sets expectation for the RedisClient.HGet which will trigger the result defined by the following
+// Then helper
+func (mmHGet *mRedisClientMockHGet) When(ctx context.Context, key string, field string) *RedisClientMockHGetExpectation {
+	if mmHGet.mock.funcHGet != nil {
+		mmHGet.mock.t.Fatalf("RedisClientMock.HGet mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockHGetExpectation{
+		mock:               mmHGet.mock,
+		params:             &RedisClientMockHGetParams{ctx, key, field},
+		expectationOrigins: RedisClientMockHGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmHGet.expectations = append(mmHGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.HGet return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockHGetExpectation) Then(sp1 *redis.StringCmd) *RedisClientMock {
+	e.results = &RedisClientMockHGetResults{sp1}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.HGet should be invoked
+func (mmHGet *mRedisClientMockHGet) Times(n uint64) *mRedisClientMockHGet {
+	if n == 0 {
+		mmHGet.mock.t.Fatalf("Times of RedisClientMock.HGet mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmHGet.expectedInvocations, n)
+	mmHGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmHGet
+}
+
+func (mmHGet *mRedisClientMockHGet) invocationsDone() bool {
+	if len(mmHGet.expectations) == 0 && mmHGet.defaultExpectation == nil && mmHGet.mock.funcHGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmHGet.mock.afterHGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmHGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// HGet implements mm_cache.RedisClient
+func (mmHGet *RedisClientMock) HGet(ctx context.Context, key string, field string) (sp1 *redis.StringCmd) {
+	mm_atomic.AddUint64(&mmHGet.beforeHGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmHGet.afterHGetCounter, 1)
+
+	mmHGet.t.Helper()
+
+	if mmHGet.inspectFuncHGet != nil {
+		mmHGet.inspectFuncHGet(ctx, key, field)
+	}
+
+	mm_params := RedisClientMockHGetParams{ctx, key, field}
+
+	// Record call args
+	mmHGet.HGetMock.mutex.Lock()
+	mmHGet.HGetMock.callArgs = append(mmHGet.HGetMock.callArgs, &mm_params)
+	mmHGet.HGetMock.mutex.Unlock()
+
+	for _, e := range mmHGet.HGetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1
+		}
+	}
+
+	if mmHGet.HGetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHGet.HGetMock.defaultExpectation.Counter, 1)
+		mm_want := mmHGet.HGetMock.defaultExpectation.params
+		mm_want_ptrs := mmHGet.HGetMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockHGetParams{ctx, key, field}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmHGet.t.Errorf("RedisClientMock.HGet got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHGet.HGetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmHGet.t.Errorf("RedisClientMock.HGet got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHGet.HGetMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.field != nil && !minimock.Equal(*mm_want_ptrs.field, mm_got.field) {
+				mmHGet.t.Errorf("RedisClientMock.HGet got unexpected parameter field, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHGet.HGetMock.defaultExpectation.expectationOrigins.originField, *mm_want_ptrs.field, mm_got.field, minimock.Diff(*mm_want_ptrs.field, mm_got.field))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHGet.t.Errorf("RedisClientMock.HGet got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmHGet.HGetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmHGet.HGetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmHGet.t.Fatal("No results are set for the RedisClientMock.HGet")
+		}
+		return (*mm_results).sp1
+	}
+	if mmHGet.funcHGet != nil {
+		return mmHGet.funcHGet(ctx, key, field)
+	}
+	mmHGet.t.Fatalf("Unexpected call to RedisClientMock.HGet. %v %v %v", ctx, key, field)
+	return
+}
+
+// HGetAfterCounter returns a count of finished RedisClientMock.HGet invocations
+func (mmHGet *RedisClientMock) HGetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHGet.afterHGetCounter)
+}
+
+// HGetBeforeCounter returns a count of RedisClientMock.HGet invocations
+func (mmHGet *RedisClientMock) HGetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHGet.beforeHGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.HGet.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmHGet *mRedisClientMockHGet) Calls() []*RedisClientMockHGetParams {
+	mmHGet.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockHGetParams, len(mmHGet.callArgs))
+	copy(argCopy, mmHGet.callArgs)
+
+	mmHGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockHGetDone returns true if the count of the HGet invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockHGetDone() bool {
+	if m.HGetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.HGetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.HGetMock.invocationsDone()
+}
+
+// MinimockHGetInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockHGetInspect() {
+	for _, e := range m.HGetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.HGet at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterHGetCounter := mm_atomic.LoadUint64(&m.afterHGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HGetMock.defaultExpectation != nil && afterHGetCounter < 1 {
+		if m.HGetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.HGet at\n%s", m.HGetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.HGet at\n%s with params: %#v", m.HGetMock.defaultExpectation.expectationOrigins.origin, *m.HGetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHGet != nil && afterHGetCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.HGet at\n%s", m.funcHGetOrigin)
+	}
+
+	if !m.HGetMock.invocationsDone() && afterHGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.HGet at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.HGetMock.expectedInvocations), m.HGetMock.expectedInvocationsOrigin, afterHGetCounter)
+	}
+}
+
+type mRedisClientMockHGetAll struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockHGetAllExpectation
+	expectations       []*RedisClientMockHGetAllExpectation
+
+	callArgs []*RedisClientMockHGetAllParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockHGetAllExpectation specifies expectation struct of the RedisClient.HGetAll
+type RedisClientMockHGetAllExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockHGetAllParams
+	paramPtrs          *RedisClientMockHGetAllParamPtrs
+	expectationOrigins RedisClientMockHGetAllExpectationOrigins
+	results            *RedisClientMockHGetAllResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockHGetAllParams contains parameters of the RedisClient.HGetAll
+type RedisClientMockHGetAllParams struct {
+	ctx context.Context
+	key string
+}
+
+// RedisClientMockHGetAllParamPtrs contains pointers to parameters of the RedisClient.HGetAll
+type RedisClientMockHGetAllParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// RedisClientMockHGetAllResults contains results of the RedisClient.HGetAll
+type RedisClientMockHGetAllResults struct {
+	mp1 *redis.MapStringStringCmd
+}
+
+// RedisClientMockHGetAllOrigins contains origins of expectations of the RedisClient.HGetAll
+type RedisClientMockHGetAllExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmHGetAll *mRedisClientMockHGetAll) Optional() *mRedisClientMockHGetAll {
+	mmHGetAll.optional = true
+	return mmHGetAll
+}
+
+// Expect sets up expected params for RedisClient.HGetAll
+func (mmHGetAll *mRedisClientMockHGetAll) Expect(ctx context.Context, key string) *mRedisClientMockHGetAll {
+	if mmHGetAll.mock.funcHGetAll != nil {
+		mmHGetAll.mock.t.Fatalf("RedisClientMock.HGetAll mock is already set by Set")
+	}
+
+	if mmHGetAll.defaultExpectation == nil {
+		mmHGetAll.defaultExpectation = &RedisClientMockHGetAllExpectation{}
+	}
+
+	if mmHGetAll.defaultExpectation.paramPtrs != nil {
+		mmHGetAll.mock.t.Fatalf("RedisClientMock.HGetAll mock is already set by ExpectParams functions")
+	}
+
+	mmHGetAll.defaultExpectation.params = &RedisClientMockHGetAllParams{ctx, key}
+	mmHGetAll.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmHGetAll.expectations {
+		if minimock.Equal(e.params, mmHGetAll.defaultExpectation.params) {
+			mmHGetAll.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHGetAll.defaultExpectation.params)
+		}
+	}
+
+	return mmHGetAll
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.HGetAll
+func (mmHGetAll *mRedisClientMockHGetAll) ExpectCtxParam1(ctx context.Context) *mRedisClientMockHGetAll {
+	if mmHGetAll.mock.funcHGetAll != nil {
+		mmHGetAll.mock.t.Fatalf("RedisClientMock.HGetAll mock is already set by Set")
+	}
+
+	if mmHGetAll.defaultExpectation == nil {
+		mmHGetAll.defaultExpectation = &RedisClientMockHGetAllExpectation{}
+	}
+
+	if mmHGetAll.defaultExpectation.params != nil {
+		mmHGetAll.mock.t.Fatalf("RedisClientMock.HGetAll mock is already set by Expect")
+	}
+
+	if mmHGetAll.defaultExpectation.paramPtrs == nil {
+		mmHGetAll.defaultExpectation.paramPtrs = &RedisClientMockHGetAllParamPtrs{}
+	}
+	mmHGetAll.defaultExpectation.paramPtrs.ctx = &ctx
+	mmHGetAll.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmHGetAll
+}
+
+// ExpectKeyParam2 sets up expected param key for RedisClient.HGetAll
+func (mmHGetAll *mRedisClientMockHGetAll) ExpectKeyParam2(key string) *mRedisClientMockHGetAll {
+	if mmHGetAll.mock.funcHGetAll != nil {
+		mmHGetAll.mock.t.Fatalf("RedisClientMock.HGetAll mock is already set by Set")
+	}
+
+	if mmHGetAll.defaultExpectation == nil {
+		mmHGetAll.defaultExpectation = &RedisClientMockHGetAllExpectation{}
+	}
+
+	if mmHGetAll.defaultExpectation.params != nil {
+		mmHGetAll.mock.t.Fatalf("RedisClientMock.HGetAll mock is already set by Expect")
+	}
+
+	if mmHGetAll.defaultExpectation.paramPtrs == nil {
+		mmHGetAll.defaultExpectation.paramPtrs = &RedisClientMockHGetAllParamPtrs{}
+	}
+	mmHGetAll.defaultExpectation.paramPtrs.key = &key
+	mmHGetAll.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmHGetAll
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.HGetAll
+func (mmHGetAll *mRedisClientMockHGetAll) Inspect(f func(ctx context.Context, key string)) *mRedisClientMockHGetAll {
+	if mmHGetAll.mock.inspectFuncHGetAll != nil {
+		mmHGetAll.mock.t.Fatalf("Inspect function is already set for RedisClientMock.HGetAll")
+	}
+
+	mmHGetAll.mock.inspectFuncHGetAll = f
+
+	return mmHGetAll
+}
+
+// Return sets up results that will be returned by RedisClient.HGetAll
+func (mmHGetAll *mRedisClientMockHGetAll) Return(mp1 *redis.MapStringStringCmd) *RedisClientMock {
+	if mmHGetAll.mock.funcHGetAll != nil {
+		mmHGetAll.mock.t.Fatalf("RedisClientMock.HGetAll mock is already set by Set")
+	}
+
+	if mmHGetAll.defaultExpectation == nil {
+		mmHGetAll.defaultExpectation = &RedisClientMockHGetAllExpectation{mock: mmHGetAll.mock}
+	}
+	mmHGetAll.defaultExpectation.results = &RedisClientMockHGetAllResults{mp1}
+	mmHGetAll.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmHGetAll.mock
+}
+
+// Set uses given function f to mock the RedisClient.HGetAll method
+func (mmHGetAll *mRedisClientMockHGetAll) Set(f func(ctx context.Context, key string) (mp1 *redis.MapStringStringCmd)) *RedisClientMock {
+	if mmHGetAll.defaultExpectation != nil {
+		mmHGetAll.mock.t.Fatalf("Default expectation is already set for the RedisClient.HGetAll method")
+	}
+
+	if len(mmHGetAll.expectations) > 0 {
+		mmHGetAll.mock.t.Fatalf("Some expectations are already set for the RedisClient.HGetAll method")
+	}
+
+	mmHGetAll.mock.funcHGetAll = f
+	mmHGetAll.mock.funcHGetAllOrigin = minimock.CallerInfo(1)
+	return mmHGetAll.mock
+}
+
+// When sets expectation for the RedisClient.HGetAll which will trigger the result defined by the following
+// Then helper
+func (mmHGetAll *mRedisClientMockHGetAll) When(ctx context.Context, key string) *RedisClientMockHGetAllExpectation {
+	if mmHGetAll.mock.funcHGetAll != nil {
+		mmHGetAll.mock.t.Fatalf("RedisClientMock.HGetAll mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockHGetAllExpectation{
+		mock:               mmHGetAll.mock,
+		params:             &RedisClientMockHGetAllParams{ctx, key},
+		expectationOrigins: RedisClientMockHGetAllExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmHGetAll.expectations = append(mmHGetAll.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.HGetAll return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockHGetAllExpectation) Then(mp1 *redis.MapStringStringCmd) *RedisClientMock {
+	e.results = &RedisClientMockHGetAllResults{mp1}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.HGetAll should be invoked
+func (mmHGetAll *mRedisClientMockHGetAll) Times(n uint64) *mRedisClientMockHGetAll {
+	if n == 0 {
+		mmHGetAll.mock.t.Fatalf("Times of RedisClientMock.HGetAll mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmHGetAll.expectedInvocations, n)
+	mmHGetAll.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmHGetAll
+}
+
+func (mmHGetAll *mRedisClientMockHGetAll) invocationsDone() bool {
+	if len(mmHGetAll.expectations) == 0 && mmHGetAll.defaultExpectation == nil && mmHGetAll.mock.funcHGetAll == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmHGetAll.mock.afterHGetAllCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmHGetAll.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// HGetAll implements mm_cache.RedisClient
+func (mmHGetAll *RedisClientMock) HGetAll(ctx context.Context, key string) (mp1 *redis.MapStringStringCmd) {
+	mm_atomic.AddUint64(&mmHGetAll.beforeHGetAllCounter, 1)
+	defer mm_atomic.AddUint64(&mmHGetAll.afterHGetAllCounter, 1)
+
+	mmHGetAll.t.Helper()
+
+	if mmHGetAll.inspectFuncHGetAll != nil {
+		mmHGetAll.inspectFuncHGetAll(ctx, key)
+	}
+
+	mm_params := RedisClientMockHGetAllParams{ctx, key}
+
+	// Record call args
+	mmHGetAll.HGetAllMock.mutex.Lock()
+	mmHGetAll.HGetAllMock.callArgs = append(mmHGetAll.HGetAllMock.callArgs, &mm_params)
+	mmHGetAll.HGetAllMock.mutex.Unlock()
+
+	for _, e := range mmHGetAll.HGetAllMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1
+		}
+	}
+
+	if mmHGetAll.HGetAllMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHGetAll.HGetAllMock.defaultExpectation.Counter, 1)
+		mm_want := mmHGetAll.HGetAllMock.defaultExpectation.params
+		mm_want_ptrs := mmHGetAll.HGetAllMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockHGetAllParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmHGetAll.t.Errorf("RedisClientMock.HGetAll got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHGetAll.HGetAllMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmHGetAll.t.Errorf("RedisClientMock.HGetAll got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHGetAll.HGetAllMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHGetAll.t.Errorf("RedisClientMock.HGetAll got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmHGetAll.HGetAllMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmHGetAll.HGetAllMock.defaultExpectation.results
+		if mm_results == nil {
+			mmHGetAll.t.Fatal("No results are set for the RedisClientMock.HGetAll")
+		}
+		return (*mm_results).mp1
+	}
+	if mmHGetAll.funcHGetAll != nil {
+		return mmHGetAll.funcHGetAll(ctx, key)
+	}
+	mmHGetAll.t.Fatalf("Unexpected call to RedisClientMock.HGetAll. %v %v", ctx, key)
+	return
+}
+
+// HGetAllAfterCounter returns a count of finished RedisClientMock.HGetAll invocations
+func (mmHGetAll *RedisClientMock) HGetAllAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHGetAll.afterHGetAllCounter)
+}
+
+// HGetAllBeforeCounter returns a count of RedisClientMock.HGetAll invocations
+func (mmHGetAll *RedisClientMock) HGetAllBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHGetAll.beforeHGetAllCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.HGetAll.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmHGetAll *mRedisClientMockHGetAll) Calls() []*RedisClientMockHGetAllParams {
+	mmHGetAll.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockHGetAllParams, len(mmHGetAll.callArgs))
+	copy(argCopy, mmHGetAll.callArgs)
+
+	mmHGetAll.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockHGetAllDone returns true if the count of the HGetAll invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockHGetAllDone() bool {
+	if m.HGetAllMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.HGetAllMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.HGetAllMock.invocationsDone()
+}
+
+// MinimockHGetAllInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockHGetAllInspect() {
+	for _, e := range m.HGetAllMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.HGetAll at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterHGetAllCounter := mm_atomic.LoadUint64(&m.afterHGetAllCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HGetAllMock.defaultExpectation != nil && afterHGetAllCounter < 1 {
+		if m.HGetAllMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.HGetAll at\n%s", m.HGetAllMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.HGetAll at\n%s with params: %#v", m.HGetAllMock.defaultExpectation.expectationOrigins.origin, *m.HGetAllMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHGetAll != nil && afterHGetAllCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.HGetAll at\n%s", m.funcHGetAllOrigin)
+	}
+
+	if !m.HGetAllMock.invocationsDone() && afterHGetAllCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.HGetAll at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.HGetAllMock.expectedInvocations), m.HGetAllMock.expectedInvocationsOrigin, afterHGetAllCounter)
+	}
+}
+
+type mRedisClientMockHSet struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockHSetExpectation
+	expectations       []*RedisClientMockHSetExpectation
+
+	callArgs []*RedisClientMockHSetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockHSetExpectation specifies expectation struct of the RedisClient.HSet
+type RedisClientMockHSetExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockHSetParams
+	paramPtrs          *RedisClientMockHSetParamPtrs
+	expectationOrigins RedisClientMockHSetExpectationOrigins
+	results            *RedisClientMockHSetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockHSetParams contains parameters of the RedisClient.HSet
+type RedisClientMockHSetParams struct {
+	ctx    context.Context
+	key    string
+	values []interface{}
+}
+
+// RedisClientMockHSetParamPtrs contains pointers to parameters of the RedisClient.HSet
+type RedisClientMockHSetParamPtrs struct {
+	ctx    *context.Context
+	key    *string
+	values *[]interface{}
+}
+
+// RedisClientMockHSetResults contains results of the RedisClient.HSet
+type RedisClientMockHSetResults struct {
+	ip1 *redis.IntCmd
+}
+
+// RedisClientMockHSetOrigins contains origins of expectations of the RedisClient.HSet
+type RedisClientMockHSetExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originKey    string
+	originValues string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmHSet *mRedisClientMockHSet) Optional() *mRedisClientMockHSet {
+	mmHSet.optional = true
+	return mmHSet
+}
+
+// Expect sets up expected params for RedisClient.HSet
+func (mmHSet *mRedisClientMockHSet) Expect(ctx context.Context, key string, values ...interface{}) *mRedisClientMockHSet {
+	if mmHSet.mock.funcHSet != nil {
+		mmHSet.mock.t.Fatalf("RedisClientMock.HSet mock is already set by Set")
+	}
+
+	if mmHSet.defaultExpectation == nil {
+		mmHSet.defaultExpectation = &RedisClientMockHSetExpectation{}
+	}
+
+	if mmHSet.defaultExpectation.paramPtrs != nil {
+		mmHSet.mock.t.Fatalf("RedisClientMock.HSet mock is already set by ExpectParams functions")
+	}
+
+	mmHSet.defaultExpectation.params = &RedisClientMockHSetParams{ctx, key, values}
+	mmHSet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmHSet.expectations {
+		if minimock.Equal(e.params, mmHSet.defaultExpectation.params) {
+			mmHSet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHSet.defaultExpectation.params)
+		}
+	}
+
+	return mmHSet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.HSet
+func (mmHSet *mRedisClientMockHSet) ExpectCtxParam1(ctx context.Context) *mRedisClientMockHSet {
+	if mmHSet.mock.funcHSet != nil {
+		mmHSet.mock.t.Fatalf("RedisClientMock.HSet mock is already set by Set")
+	}
+
+	if mmHSet.defaultExpectation == nil {
+		mmHSet.defaultExpectation = &RedisClientMockHSetExpectation{}
+	}
+
+	if mmHSet.defaultExpectation.params != nil {
+		mmHSet.mock.t.Fatalf("RedisClientMock.HSet mock is already set by Expect")
+	}
+
+	if mmHSet.defaultExpectation.paramPtrs == nil {
+		mmHSet.defaultExpectation.paramPtrs = &RedisClientMockHSetParamPtrs{}
+	}
+	mmHSet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmHSet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmHSet
+}
+
+// ExpectKeyParam2 sets up expected param key for RedisClient.HSet
+func (mmHSet *mRedisClientMockHSet) ExpectKeyParam2(key string) *mRedisClientMockHSet {
+	if mmHSet.mock.funcHSet != nil {
+		mmHSet.mock.t.Fatalf("RedisClientMock.HSet mock is already set by Set")
+	}
+
+	if mmHSet.defaultExpectation == nil {
+		mmHSet.defaultExpectation = &RedisClientMockHSetExpectation{}
+	}
+
+	if mmHSet.defaultExpectation.params != nil {
+		mmHSet.mock.t.Fatalf("RedisClientMock.HSet mock is already set by Expect")
+	}
+
+	if mmHSet.defaultExpectation.paramPtrs == nil {
+		mmHSet.defaultExpectation.paramPtrs = &RedisClientMockHSetParamPtrs{}
+	}
+	mmHSet.defaultExpectation.paramPtrs.key = &key
+	mmHSet.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmHSet
+}
+
+// ExpectValuesParam3 sets up expected param values for RedisClient.HSet
+func (mmHSet *mRedisClientMockHSet) ExpectValuesParam3(values ...interface{}) *mRedisClientMockHSet {
+	if mmHSet.mock.funcHSet != nil {
+		mmHSet.mock.t.Fatalf("RedisClientMock.HSet mock is already set by Set")
+	}
+
+	if mmHSet.defaultExpectation == nil {
+		mmHSet.defaultExpectation = &RedisClientMockHSetExpectation{}
+	}
+
+	if mmHSet.defaultExpectation.params != nil {
+		mmHSet.mock.t.Fatalf("RedisClientMock.HSet mock is already set by Expect")
+	}
+
+	if mmHSet.defaultExpectation.paramPtrs == nil {
+		mmHSet.defaultExpectation.paramPtrs = &RedisClientMockHSetParamPtrs{}
+	}
+	mmHSet.defaultExpectation.paramPtrs.values = &values
+	mmHSet.defaultExpectation.expectationOrigins.originValues = minimock.CallerInfo(1)
+
+	return mmHSet
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.HSet
+func (mmHSet *mRedisClientMockHSet) Inspect(f func(ctx context.Context, key string, values ...interface{})) *mRedisClientMockHSet {
+	if mmHSet.mock.inspectFuncHSet != nil {
+		mmHSet.mock.t.Fatalf("Inspect function is already set for RedisClientMock.HSet")
+	}
+
+	mmHSet.mock.inspectFuncHSet = f
+
+	return mmHSet
+}
+
+// Return sets up results that will be returned by RedisClient.HSet
+func (mmHSet *mRedisClientMockHSet) Return(ip1 *redis.IntCmd) *RedisClientMock {
+	if mmHSet.mock.funcHSet != nil {
+		mmHSet.mock.t.Fatalf("RedisClientMock.HSet mock is already set by Set")
+	}
+
+	if mmHSet.defaultExpectation == nil {
+		mmHSet.defaultExpectation = &RedisClientMockHSetExpectation{mock: mmHSet.mock}
+	}
+	mmHSet.defaultExpectation.results = &RedisClientMockHSetResults{ip1}
+	mmHSet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmHSet.mock
+}
+
+// Set uses given function f to mock the RedisClient.HSet method
+func (mmHSet *mRedisClientMockHSet) Set(f func(ctx context.Context, key string, values ...interface{}) (ip1 *redis.IntCmd)) *RedisClientMock {
+	if mmHSet.defaultExpectation != nil {
+		mmHSet.mock.t.Fatalf("Default expectation is already set for the RedisClient.HSet method")
+	}
+
+	if len(mmHSet.expectations) > 0 {
+		mmHSet.mock.t.Fatalf("Some expectations are already set for the RedisClient.HSet method")
+	}
+
+	mmHSet.mock.funcHSet = f
+	mmHSet.mock.funcHSetOrigin = minimock.CallerInfo(1)
+	return mmHSet.mock
+}
+
+// When sets expectation for the RedisClient.HSet which will trigger the result defined by the following
+// Then helper
+func (mmHSet *mRedisClientMockHSet) When(ctx context.Context, key string, values ...interface{}) *RedisClientMockHSetExpectation {
+	if mmHSet.mock.funcHSet != nil {
+		mmHSet.mock.t.Fatalf("RedisClientMock.HSet mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockHSetExpectation{
+		mock:               mmHSet.mock,
+		params:             &RedisClientMockHSetParams{ctx, key, values},
+		expectationOrigins: RedisClientMockHSetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmHSet.expectations = append(mmHSet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.HSet return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockHSetExpectation) Then(ip1 *redis.IntCmd) *RedisClientMock {
+	e.results = &RedisClientMockHSetResults{ip1}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.HSet should be invoked
+func (mmHSet *mRedisClientMockHSet) Times(n uint64) *mRedisClientMockHSet {
+	if n == 0 {
+		mmHSet.mock.t.Fatalf("Times of RedisClientMock.HSet mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmHSet.expectedInvocations, n)
+	mmHSet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmHSet
+}
+
+func (mmHSet *mRedisClientMockHSet) invocationsDone() bool {
+	if len(mmHSet.expectations) == 0 && mmHSet.defaultExpectation == nil && mmHSet.mock.funcHSet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmHSet.mock.afterHSetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmHSet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// HSet implements mm_cache.RedisClient
+func (mmHSet *RedisClientMock) HSet(ctx context.Context, key string, values ...interface{}) (ip1 *redis.IntCmd) {
+	mm_atomic.AddUint64(&mmHSet.beforeHSetCounter, 1)
+	defer mm_atomic.AddUint64(&mmHSet.afterHSetCounter, 1)
+
+	mmHSet.t.Helper()
+
+	if mmHSet.inspectFuncHSet != nil {
+		mmHSet.inspectFuncHSet(ctx, key, values...)
+	}
+
+	mm_params := RedisClientMockHSetParams{ctx, key, values}
+
+	// Record call args
+	mmHSet.HSetMock.mutex.Lock()
+	mmHSet.HSetMock.callArgs = append(mmHSet.HSetMock.callArgs, &mm_params)
+	mmHSet.HSetMock.mutex.Unlock()
+
+	for _, e := range mmHSet.HSetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ip1
+		}
+	}
+
+	if mmHSet.HSetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHSet.HSetMock.defaultExpectation.Counter, 1)
+		mm_want := mmHSet.HSetMock.defaultExpectation.params
+		mm_want_ptrs := mmHSet.HSetMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockHSetParams{ctx, key, values}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmHSet.t.Errorf("RedisClientMock.HSet got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHSet.HSetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmHSet.t.Errorf("RedisClientMock.HSet got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHSet.HSetMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.values != nil && !minimock.Equal(*mm_want_ptrs.values, mm_got.values) {
+				mmHSet.t.Errorf("RedisClientMock.HSet got unexpected parameter values, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHSet.HSetMock.defaultExpectation.expectationOrigins.originValues, *mm_want_ptrs.values, mm_got.values, minimock.Diff(*mm_want_ptrs.values, mm_got.values))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHSet.t.Errorf("RedisClientMock.HSet got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmHSet.HSetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmHSet.HSetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmHSet.t.Fatal("No results are set for the RedisClientMock.HSet")
+		}
+		return (*mm_results).ip1
+	}
+	if mmHSet.funcHSet != nil {
+		return mmHSet.funcHSet(ctx, key, values...)
+	}
+	mmHSet.t.Fatalf("Unexpected call to RedisClientMock.HSet. %v %v %v", ctx, key, values)
+	return
+}
+
+// HSetAfterCounter returns a count of finished RedisClientMock.HSet invocations
+func (mmHSet *RedisClientMock) HSetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHSet.afterHSetCounter)
+}
+
+// HSetBeforeCounter returns a count of RedisClientMock.HSet invocations
+func (mmHSet *RedisClientMock) HSetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHSet.beforeHSetCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.HSet.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmHSet *mRedisClientMockHSet) Calls() []*RedisClientMockHSetParams {
+	mmHSet.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockHSetParams, len(mmHSet.callArgs))
+	copy(argCopy, mmHSet.callArgs)
+
+	mmHSet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockHSetDone returns true if the count of the HSet invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockHSetDone() bool {
+	if m.HSetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.HSetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.HSetMock.invocationsDone()
+}
+
+// MinimockHSetInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockHSetInspect() {
+	for _, e := range m.HSetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.HSet at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterHSetCounter := mm_atomic.LoadUint64(&m.afterHSetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HSetMock.defaultExpectation != nil && afterHSetCounter < 1 {
+		if m.HSetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.HSet at\n%s", m.HSetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.HSet at\n%s with params: %#v", m.HSetMock.defaultExpectation.expectationOrigins.origin, *m.HSetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHSet != nil && afterHSetCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.HSet at\n%s", m.funcHSetOrigin)
+	}
+
+	if !m.HSetMock.invocationsDone() && afterHSetCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.HSet at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.HSetMock.expectedInvocations), m.HSetMock.expectedInvocationsOrigin, afterHSetCounter)
+	}
+}
+
+type mRedisClientMockMGet struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockMGetExpectation
+	expectations       []*RedisClientMockMGetExpectation
+
+	callArgs []*RedisClientMockMGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockMGetExpectation specifies expectation struct of the RedisClient.MGet
+type RedisClientMockMGetExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockMGetParams
+	paramPtrs          *RedisClientMockMGetParamPtrs
+	expectationOrigins RedisClientMockMGetExpectationOrigins
+	results            *RedisClientMockMGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockMGetParams contains parameters of the RedisClient.MGet
+type RedisClientMockMGetParams struct {
+	ctx  context.Context
+	keys []string
+}
+
+// RedisClientMockMGetParamPtrs contains pointers to parameters of the RedisClient.MGet
+type RedisClientMockMGetParamPtrs struct {
+	ctx  *context.Context
+	keys *[]string
+}
+
+// RedisClientMockMGetResults contains results of the RedisClient.MGet
+type RedisClientMockMGetResults struct {
+	sp1 *redis.SliceCmd
+}
+
+// RedisClientMockMGetOrigins contains origins of expectations of the RedisClient.MGet
+type RedisClientMockMGetExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originKeys string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMGet *mRedisClientMockMGet) Optional() *mRedisClientMockMGet {
+	mmMGet.optional = true
+	return mmMGet
+}
+
+// Expect sets up expected params for RedisClient.MGet
+func (mmMGet *mRedisClientMockMGet) Expect(ctx context.Context, keys ...string) *mRedisClientMockMGet {
+	if mmMGet.mock.funcMGet != nil {
+		mmMGet.mock.t.Fatalf("RedisClientMock.MGet mock is already set by Set")
+	}
+
+	if mmMGet.defaultExpectation == nil {
+		mmMGet.defaultExpectation = &RedisClientMockMGetExpectation{}
+	}
+
+	if mmMGet.defaultExpectation.paramPtrs != nil {
+		mmMGet.mock.t.Fatalf("RedisClientMock.MGet mock is already set by ExpectParams functions")
+	}
+
+	mmMGet.defaultExpectation.params = &RedisClientMockMGetParams{ctx, keys}
+	mmMGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMGet.expectations {
+		if minimock.Equal(e.params, mmMGet.defaultExpectation.params) {
+			mmMGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMGet.defaultExpectation.params)
+		}
+	}
+
+	return mmMGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.MGet
+func (mmMGet *mRedisClientMockMGet) ExpectCtxParam1(ctx context.Context) *mRedisClientMockMGet {
+	if mmMGet.mock.funcMGet != nil {
+		mmMGet.mock.t.Fatalf("RedisClientMock.MGet mock is already set by Set")
+	}
+
+	if mmMGet.defaultExpectation == nil {
+		mmMGet.defaultExpectation = &RedisClientMockMGetExpectation{}
+	}
+
+	if mmMGet.defaultExpectation.params != nil {
+		mmMGet.mock.t.Fatalf("RedisClientMock.MGet mock is already set by Expect")
+	}
+
+	if mmMGet.defaultExpectation.paramPtrs == nil {
+		mmMGet.defaultExpectation.paramPtrs = &RedisClientMockMGetParamPtrs{}
+	}
+	mmMGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMGet
+}
+
+// ExpectKeysParam2 sets up expected param keys for RedisClient.MGet
+func (mmMGet *mRedisClientMockMGet) ExpectKeysParam2(keys ...string) *mRedisClientMockMGet {
+	if mmMGet.mock.funcMGet != nil {
+		mmMGet.mock.t.Fatalf("RedisClientMock.MGet mock is already set by Set")
+	}
+
+	if mmMGet.defaultExpectation == nil {
+		mmMGet.defaultExpectation = &RedisClientMockMGetExpectation{}
+	}
+
+	if mmMGet.defaultExpectation.params != nil {
+		mmMGet.mock.t.Fatalf("RedisClientMock.MGet mock is already set by Expect")
+	}
+
+	if mmMGet.defaultExpectation.paramPtrs == nil {
+		mmMGet.defaultExpectation.paramPtrs = &RedisClientMockMGetParamPtrs{}
+	}
+	mmMGet.defaultExpectation.paramPtrs.keys = &keys
+	mmMGet.defaultExpectation.expectationOrigins.originKeys = minimock.CallerInfo(1)
+
+	return mmMGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.MGet
+func (mmMGet *mRedisClientMockMGet) Inspect(f func(ctx context.Context, keys ...string)) *mRedisClientMockMGet {
+	if mmMGet.mock.inspectFuncMGet != nil {
+		mmMGet.mock.t.Fatalf("Inspect function is already set for RedisClientMock.MGet")
+	}
+
+	mmMGet.mock.inspectFuncMGet = f
+
+	return mmMGet
+}
+
+// Return sets up results that will be returned by RedisClient.MGet
+func (mmMGet *mRedisClientMockMGet) Return(sp1 *redis.SliceCmd) *RedisClientMock {
+	if mmMGet.mock.funcMGet != nil {
+		mmMGet.mock.t.Fatalf("RedisClientMock.MGet mock is already set by Set")
+	}
+
+	if mmMGet.defaultExpectation == nil {
+		mmMGet.defaultExpectation = &RedisClientMockMGetExpectation{mock: mmMGet.mock}
+	}
+	mmMGet.defaultExpectation.results = &RedisClientMockMGetResults{sp1}
+	mmMGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMGet.mock
+}
+
+// Set uses given function f to mock the RedisClient.MGet method
+func (mmMGet *mRedisClientMockMGet) Set(f func(ctx context.Context, keys ...string) (sp1 *redis.SliceCmd)) *RedisClientMock {
+	if mmMGet.defaultExpectation != nil {
+		mmMGet.mock.t.Fatalf("Default expectation is already set for the RedisClient.MGet method")
+	}
+
+	if len(mmMGet.expectations) > 0 {
+		mmMGet.mock.t.Fatalf("Some expectations are already set for the RedisClient.MGet method")
+	}
+
+	mmMGet.mock.funcMGet = f
+	mmMGet.mock.funcMGetOrigin = minimock.CallerInfo(1)
+	return mmMGet.mock
+}
+
+// When sets expectation for the RedisClient.MGet which will trigger the result defined by the following
+// Then helper
+func (mmMGet *mRedisClientMockMGet) When(ctx context.Context, keys ...string) *RedisClientMockMGetExpectation {
+	if mmMGet.mock.funcMGet != nil {
+		mmMGet.mock.t.Fatalf("RedisClientMock.MGet mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockMGetExpectation{
+		mock:               mmMGet.mock,
+		params:             &RedisClientMockMGetParams{ctx, keys},
+		expectationOrigins: RedisClientMockMGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMGet.expectations = append(mmMGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.MGet return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockMGetExpectation) Then(sp1 *redis.SliceCmd) *RedisClientMock {
+	e.results = &RedisClientMockMGetResults{sp1}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.MGet should be invoked
+func (mmMGet *mRedisClientMockMGet) Times(n uint64) *mRedisClientMockMGet {
+	if n == 0 {
+		mmMGet.mock.t.Fatalf("Times of RedisClientMock.MGet mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMGet.expectedInvocations, n)
+	mmMGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMGet
+}
+
+func (mmMGet *mRedisClientMockMGet) invocationsDone() bool {
+	if len(mmMGet.expectations) == 0 && mmMGet.defaultExpectation == nil && mmMGet.mock.funcMGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMGet.mock.afterMGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MGet implements mm_cache.RedisClient
+func (mmMGet *RedisClientMock) MGet(ctx context.Context, keys ...string) (sp1 *redis.SliceCmd) {
+	mm_atomic.AddUint64(&mmMGet.beforeMGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmMGet.afterMGetCounter, 1)
+
+	mmMGet.t.Helper()
+
+	if mmMGet.inspectFuncMGet != nil {
+		mmMGet.inspectFuncMGet(ctx, keys...)
+	}
+
+	mm_params := RedisClientMockMGetParams{ctx, keys}
+
+	// Record call args
+	mmMGet.MGetMock.mutex.Lock()
+	mmMGet.MGetMock.callArgs = append(mmMGet.MGetMock.callArgs, &mm_params)
+	mmMGet.MGetMock.mutex.Unlock()
+
+	for _, e := range mmMGet.MGetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1
+		}
+	}
+
+	if mmMGet.MGetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMGet.MGetMock.defaultExpectation.Counter, 1)
+		mm_want := mmMGet.MGetMock.defaultExpectation.params
+		mm_want_ptrs := mmMGet.MGetMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockMGetParams{ctx, keys}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMGet.t.Errorf("RedisClientMock.MGet got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMGet.MGetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.keys != nil && !minimock.Equal(*mm_want_ptrs.keys, mm_got.keys) {
+				mmMGet.t.Errorf("RedisClientMock.MGet got unexpected parameter keys, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMGet.MGetMock.defaultExpectation.expectationOrigins.originKeys, *mm_want_ptrs.keys, mm_got.keys, minimock.Diff(*mm_want_ptrs.keys, mm_got.keys))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMGet.t.Errorf("RedisClientMock.MGet got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMGet.MGetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMGet.MGetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMGet.t.Fatal("No results are set for the RedisClientMock.MGet")
+		}
+		return (*mm_results).sp1
+	}
+	if mmMGet.funcMGet != nil {
+		return mmMGet.funcMGet(ctx, keys...)
+	}
+	mmMGet.t.Fatalf("Unexpected call to RedisClientMock.MGet. %v %v", ctx, keys)
+	return
+}
+
+// MGetAfterCounter returns a count of finished RedisClientMock.MGet invocations
+func (mmMGet *RedisClientMock) MGetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMGet.afterMGetCounter)
+}
+
+// MGetBeforeCounter returns a count of RedisClientMock.MGet invocations
+func (mmMGet *RedisClientMock) MGetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMGet.beforeMGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.MGet.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMGet *mRedisClientMockMGet) Calls() []*RedisClientMockMGetParams {
+	mmMGet.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockMGetParams, len(mmMGet.callArgs))
+	copy(argCopy, mmMGet.callArgs)
+
+	mmMGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMGetDone returns true if the count of the MGet invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockMGetDone() bool {
+	if m.MGetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MGetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MGetMock.invocationsDone()
+}
+
+// MinimockMGetInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockMGetInspect() {
+	for _, e := range m.MGetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.MGet at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMGetCounter := mm_atomic.LoadUint64(&m.afterMGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MGetMock.defaultExpectation != nil && afterMGetCounter < 1 {
+		if m.MGetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.MGet at\n%s", m.MGetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.MGet at\n%s with params: %#v", m.MGetMock.defaultExpectation.expectationOrigins.origin, *m.MGetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMGet != nil && afterMGetCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.MGet at\n%s", m.funcMGetOrigin)
+	}
+
+	if !m.MGetMock.invocationsDone() && afterMGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.MGet at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MGetMock.expectedInvocations), m.MGetMock.expectedInvocationsOrigin, afterMGetCounter)
+	}
+}
+
+type mRedisClientMockPipelined struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockPipelinedExpectation
+	expectations       []*RedisClientMockPipelinedExpectation
+
+	callArgs []*RedisClientMockPipelinedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockPipelinedExpectation specifies expectation struct of the RedisClient.Pipelined
+type RedisClientMockPipelinedExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockPipelinedParams
+	paramPtrs          *RedisClientMockPipelinedParamPtrs
+	expectationOrigins RedisClientMockPipelinedExpectationOrigins
+	results            *RedisClientMockPipelinedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockPipelinedParams contains parameters of the RedisClient.Pipelined
+type RedisClientMockPipelinedParams struct {
+	ctx context.Context
+	fn  func(redis.Pipeliner) error
+}
+
+// RedisClientMockPipelinedParamPtrs contains pointers to parameters of the RedisClient.Pipelined
+type RedisClientMockPipelinedParamPtrs struct {
+	ctx *context.Context
+	fn  *func(redis.Pipeliner) error
+}
+
+// RedisClientMockPipelinedResults contains results of the RedisClient.Pipelined
+type RedisClientMockPipelinedResults struct {
+	ca1 []redis.Cmder
+	err error
+}
+
+// RedisClientMockPipelinedOrigins contains origins of expectations of the RedisClient.Pipelined
+type RedisClientMockPipelinedExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originFn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPipelined *mRedisClientMockPipelined) Optional() *mRedisClientMockPipelined {
+	mmPipelined.optional = true
+	return mmPipelined
+}
+
+// Expect sets up expected params for RedisClient.Pipelined
+func (mmPipelined *mRedisClientMockPipelined) Expect(ctx context.Context, fn func(redis.Pipeliner) error) *mRedisClientMockPipelined {
+	if mmPipelined.mock.funcPipelined != nil {
+		mmPipelined.mock.t.Fatalf("RedisClientMock.Pipelined mock is already set by Set")
+	}
+
+	if mmPipelined.defaultExpectation == nil {
+		mmPipelined.defaultExpectation = &RedisClientMockPipelinedExpectation{}
+	}
+
+	if mmPipelined.defaultExpectation.paramPtrs != nil {
+		mmPipelined.mock.t.Fatalf("RedisClientMock.Pipelined mock is already set by ExpectParams functions")
+	}
+
+	mmPipelined.defaultExpectation.params = &RedisClientMockPipelinedParams{ctx, fn}
+	mmPipelined.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPipelined.expectations {
+		if minimock.Equal(e.params, mmPipelined.defaultExpectation.params) {
+			mmPipelined.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPipelined.defaultExpectation.params)
+		}
+	}
+
+	return mmPipelined
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.Pipelined
+func (mmPipelined *mRedisClientMockPipelined) ExpectCtxParam1(ctx context.Context) *mRedisClientMockPipelined {
+	if mmPipelined.mock.funcPipelined != nil {
+		mmPipelined.mock.t.Fatalf("RedisClientMock.Pipelined mock is already set by Set")
+	}
+
+	if mmPipelined.defaultExpectation == nil {
+		mmPipelined.defaultExpectation = &RedisClientMockPipelinedExpectation{}
+	}
+
+	if mmPipelined.defaultExpectation.params != nil {
+		mmPipelined.mock.t.Fatalf("RedisClientMock.Pipelined mock is already set by Expect")
+	}
+
+	if mmPipelined.defaultExpectation.paramPtrs == nil {
+		mmPipelined.defaultExpectation.paramPtrs = &RedisClientMockPipelinedParamPtrs{}
+	}
+	mmPipelined.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPipelined.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPipelined
+}
+
+// ExpectFnParam2 sets up expected param fn for RedisClient.Pipelined
+func (mmPipelined *mRedisClientMockPipelined) ExpectFnParam2(fn func(redis.Pipeliner) error) *mRedisClientMockPipelined {
+	if mmPipelined.mock.funcPipelined != nil {
+		mmPipelined.mock.t.Fatalf("RedisClientMock.Pipelined mock is already set by Set")
+	}
+
+	if mmPipelined.defaultExpectation == nil {
+		mmPipelined.defaultExpectation = &RedisClientMockPipelinedExpectation{}
+	}
+
+	if mmPipelined.defaultExpectation.params != nil {
+		mmPipelined.mock.t.Fatalf("RedisClientMock.Pipelined mock is already set by Expect")
+	}
+
+	if mmPipelined.defaultExpectation.paramPtrs == nil {
+		mmPipelined.defaultExpectation.paramPtrs = &RedisClientMockPipelinedParamPtrs{}
+	}
+	mmPipelined.defaultExpectation.paramPtrs.fn = &fn
+	mmPipelined.defaultExpectation.expectationOrigins.originFn = minimock.CallerInfo(1)
+
+	return mmPipelined
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.Pipelined
+func (mmPipelined *mRedisClientMockPipelined) Inspect(f func(ctx context.Context, fn func(redis.Pipeliner) error)) *mRedisClientMockPipelined {
+	if mmPipelined.mock.inspectFuncPipelined != nil {
+		mmPipelined.mock.t.Fatalf("Inspect function is already set for RedisClientMock.Pipelined")
+	}
+
+	mmPipelined.mock.inspectFuncPipelined = f
+
+	return mmPipelined
+}
+
+// Return sets up results that will be returned by RedisClient.Pipelined
+func (mmPipelined *mRedisClientMockPipelined) Return(ca1 []redis.Cmder, err error) *RedisClientMock {
+	if mmPipelined.mock.funcPipelined != nil {
+		mmPipelined.mock.t.Fatalf("RedisClientMock.Pipelined mock is already set by Set")
+	}
+
+	if mmPipelined.defaultExpectation == nil {
+		mmPipelined.defaultExpectation = &RedisClientMockPipelinedExpectation{mock: mmPipelined.mock}
+	}
+	mmPipelined.defaultExpectation.results = &RedisClientMockPipelinedResults{ca1, err}
+	mmPipelined.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPipelined.mock
+}
+
+// Set uses given function f to mock the RedisClient.Pipelined method
+func (mmPipelined *mRedisClientMockPipelined) Set(f func(ctx context.Context, fn func(redis.Pipeliner) error) (ca1 []redis.Cmder, err error)) *RedisClientMock {
+	if mmPipelined.defaultExpectation != nil {
+		mmPipelined.mock.t.Fatalf("Default expectation is already set for the RedisClient.Pipelined method")
+	}
+
+	if len(mmPipelined.expectations) > 0 {
+		mmPipelined.mock.t.Fatalf("Some expectations are already set for the RedisClient.Pipelined method")
+	}
+
+	mmPipelined.mock.funcPipelined = f
+	mmPipelined.mock.funcPipelinedOrigin = minimock.CallerInfo(1)
+	return mmPipelined.mock
+}
+
+// When sets expectation for the RedisClient.Pipelined which will trigger the result defined by the following
+// Then helper
+func (mmPipelined *mRedisClientMockPipelined) When(ctx context.Context, fn func(redis.Pipeliner) error) *RedisClientMockPipelinedExpectation {
+	if mmPipelined.mock.funcPipelined != nil {
+		mmPipelined.mock.t.Fatalf("RedisClientMock.Pipelined mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockPipelinedExpectation{
+		mock:               mmPipelined.mock,
+		params:             &RedisClientMockPipelinedParams{ctx, fn},
+		expectationOrigins: RedisClientMockPipelinedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPipelined.expectations = append(mmPipelined.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.Pipelined return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockPipelinedExpectation) Then(ca1 []redis.Cmder, err error) *RedisClientMock {
+	e.results = &RedisClientMockPipelinedResults{ca1, err}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.Pipelined should be invoked
+func (mmPipelined *mRedisClientMockPipelined) Times(n uint64) *mRedisClientMockPipelined {
+	if n == 0 {
+		mmPipelined.mock.t.Fatalf("Times of RedisClientMock.Pipelined mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPipelined.expectedInvocations, n)
+	mmPipelined.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPipelined
+}
+
+func (mmPipelined *mRedisClientMockPipelined) invocationsDone() bool {
+	if len(mmPipelined.expectations) == 0 && mmPipelined.defaultExpectation == nil && mmPipelined.mock.funcPipelined == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPipelined.mock.afterPipelinedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPipelined.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Pipelined implements mm_cache.RedisClient
+func (mmPipelined *RedisClientMock) Pipelined(ctx context.Context, fn func(redis.Pipeliner) error) (ca1 []redis.Cmder, err error) {
+	mm_atomic.AddUint64(&mmPipelined.beforePipelinedCounter, 1)
+	defer mm_atomic.AddUint64(&mmPipelined.afterPipelinedCounter, 1)
+
+	mmPipelined.t.Helper()
+
+	if mmPipelined.inspectFuncPipelined != nil {
+		mmPipelined.inspectFuncPipelined(ctx, fn)
+	}
+
+	mm_params := RedisClientMockPipelinedParams{ctx, fn}
+
+	// Record call args
+	mmPipelined.PipelinedMock.mutex.Lock()
+	mmPipelined.PipelinedMock.callArgs = append(mmPipelined.PipelinedMock.callArgs, &mm_params)
+	mmPipelined.PipelinedMock.mutex.Unlock()
+
+	for _, e := range mmPipelined.PipelinedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ca1, e.results.err
+		}
+	}
+
+	if mmPipelined.PipelinedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPipelined.PipelinedMock.defaultExpectation.Counter, 1)
+		mm_want := mmPipelined.PipelinedMock.defaultExpectation.params
+		mm_want_ptrs := mmPipelined.PipelinedMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockPipelinedParams{ctx, fn}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPipelined.t.Errorf("RedisClientMock.Pipelined got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPipelined.PipelinedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.fn != nil && !minimock.Equal(*mm_want_ptrs.fn, mm_got.fn) {
+				mmPipelined.t.Errorf("RedisClientMock.Pipelined got unexpected parameter fn, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPipelined.PipelinedMock.defaultExpectation.expectationOrigins.originFn, *mm_want_ptrs.fn, mm_got.fn, minimock.Diff(*mm_want_ptrs.fn, mm_got.fn))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPipelined.t.Errorf("RedisClientMock.Pipelined got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPipelined.PipelinedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPipelined.PipelinedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPipelined.t.Fatal("No results are set for the RedisClientMock.Pipelined")
+		}
+		return (*mm_results).ca1, (*mm_results).err
+	}
+	if mmPipelined.funcPipelined != nil {
+		return mmPipelined.funcPipelined(ctx, fn)
+	}
+	mmPipelined.t.Fatalf("Unexpected call to RedisClientMock.Pipelined. %v %v", ctx, fn)
+	return
+}
+
+// PipelinedAfterCounter returns a count of finished RedisClientMock.Pipelined invocations
+func (mmPipelined *RedisClientMock) PipelinedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPipelined.afterPipelinedCounter)
+}
+
+// PipelinedBeforeCounter returns a count of RedisClientMock.Pipelined invocations
+func (mmPipelined *RedisClientMock) PipelinedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPipelined.beforePipelinedCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.Pipelined.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPipelined *mRedisClientMockPipelined) Calls() []*RedisClientMockPipelinedParams {
+	mmPipelined.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockPipelinedParams, len(mmPipelined.callArgs))
+	copy(argCopy, mmPipelined.callArgs)
+
+	mmPipelined.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPipelinedDone returns true if the count of the Pipelined invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockPipelinedDone() bool {
+	if m.PipelinedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PipelinedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PipelinedMock.invocationsDone()
+}
+
+// MinimockPipelinedInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockPipelinedInspect() {
+	for _, e := range m.PipelinedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.Pipelined at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPipelinedCounter := mm_atomic.LoadUint64(&m.afterPipelinedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PipelinedMock.defaultExpectation != nil && afterPipelinedCounter < 1 {
+		if m.PipelinedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.Pipelined at\n%s", m.PipelinedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.Pipelined at\n%s with params: %#v", m.PipelinedMock.defaultExpectation.expectationOrigins.origin, *m.PipelinedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPipelined != nil && afterPipelinedCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.Pipelined at\n%s", m.funcPipelinedOrigin)
+	}
+
+	if !m.PipelinedMock.invocationsDone() && afterPipelinedCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.Pipelined at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PipelinedMock.expectedInvocations), m.PipelinedMock.expectedInvocationsOrigin, afterPipelinedCounter)
+	}
+}
+
+type mRedisClientMockSAdd struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockSAddExpectation
+	expectations       []*RedisClientMockSAddExpectation
+
+	callArgs []*RedisClientMockSAddParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockSAddExpectation specifies expectation struct of the RedisClient.SAdd
+type RedisClientMockSAddExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockSAddParams
+	paramPtrs          *RedisClientMockSAddParamPtrs
+	expectationOrigins RedisClientMockSAddExpectationOrigins
+	results            *RedisClientMockSAddResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockSAddParams contains parameters of the RedisClient.SAdd
+type RedisClientMockSAddParams struct {
+	ctx     context.Context
+	key     string
+	members []interface{}
+}
+
+// RedisClientMockSAddParamPtrs contains pointers to parameters of the RedisClient.SAdd
+type RedisClientMockSAddParamPtrs struct {
+	ctx     *context.Context
+	key     *string
+	members *[]interface{}
+}
+
+// RedisClientMockSAddResults contains results of the RedisClient.SAdd
+type RedisClientMockSAddResults struct {
+	ip1 *redis.IntCmd
+}
+
+// RedisClientMockSAddOrigins contains origins of expectations of the RedisClient.SAdd
+type RedisClientMockSAddExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originKey     string
+	originMembers string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSAdd *mRedisClientMockSAdd) Optional() *mRedisClientMockSAdd {
+	mmSAdd.optional = true
+	return mmSAdd
+}
+
+// Expect sets up expected params for RedisClient.SAdd
+func (mmSAdd *mRedisClientMockSAdd) Expect(ctx context.Context, key string, members ...interface{}) *mRedisClientMockSAdd {
+	if mmSAdd.mock.funcSAdd != nil {
+		mmSAdd.mock.t.Fatalf("RedisClientMock.SAdd mock is already set by Set")
+	}
+
+	if mmSAdd.defaultExpectation == nil {
+		mmSAdd.defaultExpectation = &RedisClientMockSAddExpectation{}
+	}
+
+	if mmSAdd.defaultExpectation.paramPtrs != nil {
+		mmSAdd.mock.t.Fatalf("RedisClientMock.SAdd mock is already set by ExpectParams functions")
+	}
+
+	mmSAdd.defaultExpectation.params = &RedisClientMockSAddParams{ctx, key, members}
+	mmSAdd.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSAdd.expectations {
+		if minimock.Equal(e.params, mmSAdd.defaultExpectation.params) {
+			mmSAdd.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSAdd.defaultExpectation.params)
+		}
+	}
+
+	return mmSAdd
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.SAdd
+func (mmSAdd *mRedisClientMockSAdd) ExpectCtxParam1(ctx context.Context) *mRedisClientMockSAdd {
+	if mmSAdd.mock.funcSAdd != nil {
+		mmSAdd.mock.t.Fatalf("RedisClientMock.SAdd mock is already set by Set")
+	}
+
+	if mmSAdd.defaultExpectation == nil {
+		mmSAdd.defaultExpectation = &RedisClientMockSAddExpectation{}
+	}
+
+	if mmSAdd.defaultExpectation.params != nil {
+		mmSAdd.mock.t.Fatalf("RedisClientMock.SAdd mock is already set by Expect")
+	}
+
+	if mmSAdd.defaultExpectation.paramPtrs == nil {
+		mmSAdd.defaultExpectation.paramPtrs = &RedisClientMockSAddParamPtrs{}
+	}
+	mmSAdd.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSAdd.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSAdd
+}
+
+// ExpectKeyParam2 sets up expected param key for RedisClient.SAdd
+func (mmSAdd *mRedisClientMockSAdd) ExpectKeyParam2(key string) *mRedisClientMockSAdd {
+	if mmSAdd.mock.funcSAdd != nil {
+		mmSAdd.mock.t.Fatalf("RedisClientMock.SAdd mock is already set by Set")
+	}
+
+	if mmSAdd.defaultExpectation == nil {
+		mmSAdd.defaultExpectation = &RedisClientMockSAddExpectation{}
+	}
+
+	if mmSAdd.defaultExpectation.params != nil {
+		mmSAdd.mock.t.Fatalf("RedisClientMock.SAdd mock is already set by Expect")
+	}
+
+	if mmSAdd.defaultExpectation.paramPtrs == nil {
+		mmSAdd.defaultExpectation.paramPtrs = &RedisClientMockSAddParamPtrs{}
+	}
+	mmSAdd.defaultExpectation.paramPtrs.key = &key
+	mmSAdd.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmSAdd
+}
+
+// ExpectMembersParam3 sets up expected param members for RedisClient.SAdd
+func (mmSAdd *mRedisClientMockSAdd) ExpectMembersParam3(members ...interface{}) *mRedisClientMockSAdd {
+	if mmSAdd.mock.funcSAdd != nil {
+		mmSAdd.mock.t.Fatalf("RedisClientMock.SAdd mock is already set by Set")
+	}
+
+	if mmSAdd.defaultExpectation == nil {
+		mmSAdd.defaultExpectation = &RedisClientMockSAddExpectation{}
+	}
+
+	if mmSAdd.defaultExpectation.params != nil {
+		mmSAdd.mock.t.Fatalf("RedisClientMock.SAdd mock is already set by Expect")
+	}
+
+	if mmSAdd.defaultExpectation.paramPtrs == nil {
+		mmSAdd.defaultExpectation.paramPtrs = &RedisClientMockSAddParamPtrs{}
+	}
+	mmSAdd.defaultExpectation.paramPtrs.members = &members
+	mmSAdd.defaultExpectation.expectationOrigins.originMembers = minimock.CallerInfo(1)
+
+	return mmSAdd
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.SAdd
+func (mmSAdd *mRedisClientMockSAdd) Inspect(f func(ctx context.Context, key string, members ...interface{})) *mRedisClientMockSAdd {
+	if mmSAdd.mock.inspectFuncSAdd != nil {
+		mmSAdd.mock.t.Fatalf("Inspect function is already set for RedisClientMock.SAdd")
+	}
+
+	mmSAdd.mock.inspectFuncSAdd = f
+
+	return mmSAdd
+}
+
+// Return sets up results that will be returned by RedisClient.SAdd
+func (mmSAdd *mRedisClientMockSAdd) Return(ip1 *redis.IntCmd) *RedisClientMock {
+	if mmSAdd.mock.funcSAdd != nil {
+		mmSAdd.mock.t.Fatalf("RedisClientMock.SAdd mock is already set by Set")
+	}
+
+	if mmSAdd.defaultExpectation == nil {
+		mmSAdd.defaultExpectation = &RedisClientMockSAddExpectation{mock: mmSAdd.mock}
+	}
+	mmSAdd.defaultExpectation.results = &RedisClientMockSAddResults{ip1}
+	mmSAdd.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSAdd.mock
+}
+
+// Set uses given function f to mock the RedisClient.SAdd method
+func (mmSAdd *mRedisClientMockSAdd) Set(f func(ctx context.Context, key string, members ...interface{}) (ip1 *redis.IntCmd)) *RedisClientMock {
+	if mmSAdd.defaultExpectation != nil {
+		mmSAdd.mock.t.Fatalf("Default expectation is already set for the RedisClient.SAdd method")
+	}
+
+	if len(mmSAdd.expectations) > 0 {
+		mmSAdd.mock.t.Fatalf("Some expectations are already set for the RedisClient.SAdd method")
+	}
+
+	mmSAdd.mock.funcSAdd = f
+	mmSAdd.mock.funcSAddOrigin = minimock.CallerInfo(1)
+	return mmSAdd.mock
+}
+
+// When sets expectation for the RedisClient.SAdd which will trigger the result defined by the following
+// Then helper
+func (mmSAdd *mRedisClientMockSAdd) When(ctx context.Context, key string, members ...interface{}) *RedisClientMockSAddExpectation {
+	if mmSAdd.mock.funcSAdd != nil {
+		mmSAdd.mock.t.Fatalf("RedisClientMock.SAdd mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockSAddExpectation{
+		mock:               mmSAdd.mock,
+		params:             &RedisClientMockSAddParams{ctx, key, members},
+		expectationOrigins: RedisClientMockSAddExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSAdd.expectations = append(mmSAdd.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.SAdd return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockSAddExpectation) Then(ip1 *redis.IntCmd) *RedisClientMock {
+	e.results = &RedisClientMockSAddResults{ip1}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.SAdd should be invoked
+func (mmSAdd *mRedisClientMockSAdd) Times(n uint64) *mRedisClientMockSAdd {
+	if n == 0 {
+		mmSAdd.mock.t.Fatalf("Times of RedisClientMock.SAdd mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSAdd.expectedInvocations, n)
+	mmSAdd.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSAdd
+}
+
+func (mmSAdd *mRedisClientMockSAdd) invocationsDone() bool {
+	if len(mmSAdd.expectations) == 0 && mmSAdd.defaultExpectation == nil && mmSAdd.mock.funcSAdd == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSAdd.mock.afterSAddCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSAdd.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SAdd implements mm_cache.RedisClient
+func (mmSAdd *RedisClientMock) SAdd(ctx context.Context, key string, members ...interface{}) (ip1 *redis.IntCmd) {
+	mm_atomic.AddUint64(&mmSAdd.beforeSAddCounter, 1)
+	defer mm_atomic.AddUint64(&mmSAdd.afterSAddCounter, 1)
+
+	mmSAdd.t.Helper()
+
+	if mmSAdd.inspectFuncSAdd != nil {
+		mmSAdd.inspectFuncSAdd(ctx, key, members...)
+	}
+
+	mm_params := RedisClientMockSAddParams{ctx, key, members}
+
+	// Record call args
+	mmSAdd.SAddMock.mutex.Lock()
+	mmSAdd.SAddMock.callArgs = append(mmSAdd.SAddMock.callArgs, &mm_params)
+	mmSAdd.SAddMock.mutex.Unlock()
+
+	for _, e := range mmSAdd.SAddMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ip1
+		}
+	}
+
+	if mmSAdd.SAddMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSAdd.SAddMock.defaultExpectation.Counter, 1)
+		mm_want := mmSAdd.SAddMock.defaultExpectation.params
+		mm_want_ptrs := mmSAdd.SAddMock.defaultExpectation.paramPtrs
 
-	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
-}
+		mm_got := RedisClientMockSAddParams{ctx, key, members}
 
-// Close implements mm_cache.RedisClient
-func (mmClose *RedisClientMock) Close() (err error) {
-	mm_atomic.AddUint64(&mmClose.beforeCloseCounter, 1)
-	defer mm_atomic.AddUint64(&mmClose.afterCloseCounter, 1)
+		if mm_want_ptrs != nil {
 
-	mmClose.t.Helper()
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSAdd.t.Errorf("RedisClientMock.SAdd got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSAdd.SAddMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
 
-	if mmClose.inspectFuncClose != nil {
-		mmClose.inspectFuncClose()
-	}
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmSAdd.t.Errorf("RedisClientMock.SAdd got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSAdd.SAddMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
 
-	if mmClose.CloseMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmClose.CloseMock.defaultExpectation.Counter, 1)
+			if mm_want_ptrs.members != nil && !minimock.Equal(*mm_want_ptrs.members, mm_got.members) {
+				mmSAdd.t.Errorf("RedisClientMock.SAdd got unexpected parameter members, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSAdd.SAddMock.defaultExpectation.expectationOrigins.originMembers, *mm_want_ptrs.members, mm_got.members, minimock.Diff(*mm_want_ptrs.members, mm_got.members))
+			}
 
-		mm_results := mmClose.CloseMock.defaultExpectation.results
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSAdd.t.Errorf("RedisClientMock.SAdd got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSAdd.SAddMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSAdd.SAddMock.defaultExpectation.results
 		if mm_results == nil {
-			mmClose.t.Fatal("No results are set for the RedisClientMock.Close")
+			mmSAdd.t.Fatal("No results are set for the RedisClientMock.SAdd")
 		}
-		return (*mm_results).err
+		return (*mm_results).ip1
 	}
-	if mmClose.funcClose != nil {
-		return mmClose.funcClose()
+	if mmSAdd.funcSAdd != nil {
+		return mmSAdd.funcSAdd(ctx, key, members...)
 	}
-	mmClose.t.Fatalf("Unexpected call to RedisClientMock.Close.")
+	mmSAdd.t.Fatalf("Unexpected call to RedisClientMock.SAdd. %v %v %v", ctx, key, members)
 	return
 }
 
-// CloseAfterCounter returns a count of finished RedisClientMock.Close invocations
-func (mmClose *RedisClientMock) CloseAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmClose.afterCloseCounter)
+// SAddAfterCounter returns a count of finished RedisClientMock.SAdd invocations
+func (mmSAdd *RedisClientMock) SAddAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSAdd.afterSAddCounter)
 }
 
-// CloseBeforeCounter returns a count of RedisClientMock.Close invocations
-func (mmClose *RedisClientMock) CloseBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmClose.beforeCloseCounter)
+// SAddBeforeCounter returns a count of RedisClientMock.SAdd invocations
+func (mmSAdd *RedisClientMock) SAddBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSAdd.beforeSAddCounter)
 }
 
-// MinimockCloseDone returns true if the count of the Close invocations corresponds
+// Calls returns a list of arguments used in each call to RedisClientMock.SAdd.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSAdd *mRedisClientMockSAdd) Calls() []*RedisClientMockSAddParams {
+	mmSAdd.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockSAddParams, len(mmSAdd.callArgs))
+	copy(argCopy, mmSAdd.callArgs)
+
+	mmSAdd.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSAddDone returns true if the count of the SAdd invocations corresponds
 // the number of defined expectations
-func (m *RedisClientMock) MinimockCloseDone() bool {
-	if m.CloseMock.optional {
+func (m *RedisClientMock) MinimockSAddDone() bool {
+	if m.SAddMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CloseMock.expectations {
+	for _, e := range m.SAddMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CloseMock.invocationsDone()
+	return m.SAddMock.invocationsDone()
 }
 
-// MinimockCloseInspect logs each unmet expectation
-func (m *RedisClientMock) MinimockCloseInspect() {
-	for _, e := range m.CloseMock.expectations {
+// MinimockSAddInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockSAddInspect() {
+	for _, e := range m.SAddMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Error("Expected call to RedisClientMock.Close")
+			m.t.Errorf("Expected call to RedisClientMock.SAdd at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCloseCounter := mm_atomic.LoadUint64(&m.afterCloseCounter)
+	afterSAddCounter := mm_atomic.LoadUint64(&m.afterSAddCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CloseMock.defaultExpectation != nil && afterCloseCounter < 1 {
-		m.t.Errorf("Expected call to RedisClientMock.Close at\n%s", m.CloseMock.defaultExpectation.returnOrigin)
+	if m.SAddMock.defaultExpectation != nil && afterSAddCounter < 1 {
+		if m.SAddMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.SAdd at\n%s", m.SAddMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.SAdd at\n%s with params: %#v", m.SAddMock.defaultExpectation.expectationOrigins.origin, *m.SAddMock.defaultExpectation.params)
+		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcClose != nil && afterCloseCounter < 1 {
-		m.t.Errorf("Expected call to RedisClientMock.Close at\n%s", m.funcCloseOrigin)
+	if m.funcSAdd != nil && afterSAddCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.SAdd at\n%s", m.funcSAddOrigin)
 	}
 
-	if !m.CloseMock.invocationsDone() && afterCloseCounter > 0 {
-		m.t.Errorf("Expected %d calls to RedisClientMock.Close at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CloseMock.expectedInvocations), m.CloseMock.expectedInvocationsOrigin, afterCloseCounter)
+	if !m.SAddMock.invocationsDone() && afterSAddCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.SAdd at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SAddMock.expectedInvocations), m.SAddMock.expectedInvocationsOrigin, afterSAddCounter)
 	}
 }
 
-type mRedisClientMockGet struct {
+type mRedisClientMockSPopN struct {
 	optional           bool
 	mock               *RedisClientMock
-	defaultExpectation *RedisClientMockGetExpectation
-	expectations       []*RedisClientMockGetExpectation
+	defaultExpectation *RedisClientMockSPopNExpectation
+	expectations       []*RedisClientMockSPopNExpectation
 
-	callArgs []*RedisClientMockGetParams
+	callArgs []*RedisClientMockSPopNParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// RedisClientMockGetExpectation specifies expectation struct of the RedisClient.Get
-type RedisClientMockGetExpectation struct {
+// RedisClientMockSPopNExpectation specifies expectation struct of the RedisClient.SPopN
+type RedisClientMockSPopNExpectation struct {
 	mock               *RedisClientMock
-	params             *RedisClientMockGetParams
-	paramPtrs          *RedisClientMockGetParamPtrs
-	expectationOrigins RedisClientMockGetExpectationOrigins
-	results            *RedisClientMockGetResults
+	params             *RedisClientMockSPopNParams
+	paramPtrs          *RedisClientMockSPopNParamPtrs
+	expectationOrigins RedisClientMockSPopNExpectationOrigins
+	results            *RedisClientMockSPopNResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// RedisClientMockGetParams contains parameters of the RedisClient.Get
-type RedisClientMockGetParams struct {
-	ctx context.Context
-	key string
+// RedisClientMockSPopNParams contains parameters of the RedisClient.SPopN
+type RedisClientMockSPopNParams struct {
+	ctx   context.Context
+	key   string
+	count int64
 }
 
-// RedisClientMockGetParamPtrs contains pointers to parameters of the RedisClient.Get
-type RedisClientMockGetParamPtrs struct {
-	ctx *context.Context
-	key *string
+// RedisClientMockSPopNParamPtrs contains pointers to parameters of the RedisClient.SPopN
+type RedisClientMockSPopNParamPtrs struct {
+	ctx   *context.Context
+	key   *string
+	count *int64
 }
 
-// RedisClientMockGetResults contains results of the RedisClient.Get
-type RedisClientMockGetResults struct {
-	sp1 *redis.StringCmd
+// RedisClientMockSPopNResults contains results of the RedisClient.SPopN
+type RedisClientMockSPopNResults struct {
+	sp1 *redis.StringSliceCmd
 }
 
-// RedisClientMockGetOrigins contains origins of expectations of the RedisClient.Get
-type RedisClientMockGetExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originKey string
+// RedisClientMockSPopNOrigins contains origins of expectations of the RedisClient.SPopN
+type RedisClientMockSPopNExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originKey   string
+	originCount string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -302,292 +3598,320 @@ type RedisClientMockGetExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGet *mRedisClientMockGet) Optional() *mRedisClientMockGet {
-	mmGet.optional = true
-	return mmGet
+func (mmSPopN *mRedisClientMockSPopN) Optional() *mRedisClientMockSPopN {
+	mmSPopN.optional = true
+	return mmSPopN
 }
 
-// Expect sets up expected params for RedisClient.Get
-func (mmGet *mRedisClientMockGet) Expect(ctx context.Context, key string) *mRedisClientMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by Set")
+// Expect sets up expected params for RedisClient.SPopN
+func (mmSPopN *mRedisClientMockSPopN) Expect(ctx context.Context, key string, count int64) *mRedisClientMockSPopN {
+	if mmSPopN.mock.funcSPopN != nil {
+		mmSPopN.mock.t.Fatalf("RedisClientMock.SPopN mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &RedisClientMockGetExpectation{}
+	if mmSPopN.defaultExpectation == nil {
+		mmSPopN.defaultExpectation = &RedisClientMockSPopNExpectation{}
 	}
 
-	if mmGet.defaultExpectation.paramPtrs != nil {
-		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by ExpectParams functions")
+	if mmSPopN.defaultExpectation.paramPtrs != nil {
+		mmSPopN.mock.t.Fatalf("RedisClientMock.SPopN mock is already set by ExpectParams functions")
 	}
 
-	mmGet.defaultExpectation.params = &RedisClientMockGetParams{ctx, key}
-	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGet.expectations {
-		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
-			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+	mmSPopN.defaultExpectation.params = &RedisClientMockSPopNParams{ctx, key, count}
+	mmSPopN.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSPopN.expectations {
+		if minimock.Equal(e.params, mmSPopN.defaultExpectation.params) {
+			mmSPopN.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSPopN.defaultExpectation.params)
 		}
 	}
 
-	return mmGet
+	return mmSPopN
 }
 
-// ExpectCtxParam1 sets up expected param ctx for RedisClient.Get
-func (mmGet *mRedisClientMockGet) ExpectCtxParam1(ctx context.Context) *mRedisClientMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.SPopN
+func (mmSPopN *mRedisClientMockSPopN) ExpectCtxParam1(ctx context.Context) *mRedisClientMockSPopN {
+	if mmSPopN.mock.funcSPopN != nil {
+		mmSPopN.mock.t.Fatalf("RedisClientMock.SPopN mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &RedisClientMockGetExpectation{}
+	if mmSPopN.defaultExpectation == nil {
+		mmSPopN.defaultExpectation = &RedisClientMockSPopNExpectation{}
 	}
 
-	if mmGet.defaultExpectation.params != nil {
-		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by Expect")
+	if mmSPopN.defaultExpectation.params != nil {
+		mmSPopN.mock.t.Fatalf("RedisClientMock.SPopN mock is already set by Expect")
 	}
 
-	if mmGet.defaultExpectation.paramPtrs == nil {
-		mmGet.defaultExpectation.paramPtrs = &RedisClientMockGetParamPtrs{}
+	if mmSPopN.defaultExpectation.paramPtrs == nil {
+		mmSPopN.defaultExpectation.paramPtrs = &RedisClientMockSPopNParamPtrs{}
 	}
-	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmSPopN.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSPopN.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGet
+	return mmSPopN
 }
 
-// ExpectKeyParam2 sets up expected param key for RedisClient.Get
-func (mmGet *mRedisClientMockGet) ExpectKeyParam2(key string) *mRedisClientMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by Set")
+// ExpectKeyParam2 sets up expected param key for RedisClient.SPopN
+func (mmSPopN *mRedisClientMockSPopN) ExpectKeyParam2(key string) *mRedisClientMockSPopN {
+	if mmSPopN.mock.funcSPopN != nil {
+		mmSPopN.mock.t.Fatalf("RedisClientMock.SPopN mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &RedisClientMockGetExpectation{}
+	if mmSPopN.defaultExpectation == nil {
+		mmSPopN.defaultExpectation = &RedisClientMockSPopNExpectation{}
 	}
 
-	if mmGet.defaultExpectation.params != nil {
-		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by Expect")
+	if mmSPopN.defaultExpectation.params != nil {
+		mmSPopN.mock.t.Fatalf("RedisClientMock.SPopN mock is already set by Expect")
 	}
 
-	if mmGet.defaultExpectation.paramPtrs == nil {
-		mmGet.defaultExpectation.paramPtrs = &RedisClientMockGetParamPtrs{}
+	if mmSPopN.defaultExpectation.paramPtrs == nil {
+		mmSPopN.defaultExpectation.paramPtrs = &RedisClientMockSPopNParamPtrs{}
 	}
-	mmGet.defaultExpectation.paramPtrs.key = &key
-	mmGet.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+	mmSPopN.defaultExpectation.paramPtrs.key = &key
+	mmSPopN.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
 
-	return mmGet
+	return mmSPopN
 }
 
-// Inspect accepts an inspector function that has same arguments as the RedisClient.Get
-func (mmGet *mRedisClientMockGet) Inspect(f func(ctx context.Context, key string)) *mRedisClientMockGet {
-	if mmGet.mock.inspectFuncGet != nil {
-		mmGet.mock.t.Fatalf("Inspect function is already set for RedisClientMock.Get")
+// ExpectCountParam3 sets up expected param count for RedisClient.SPopN
+func (mmSPopN *mRedisClientMockSPopN) ExpectCountParam3(count int64) *mRedisClientMockSPopN {
+	if mmSPopN.mock.funcSPopN != nil {
+		mmSPopN.mock.t.Fatalf("RedisClientMock.SPopN mock is already set by Set")
 	}
 
-	mmGet.mock.inspectFuncGet = f
+	if mmSPopN.defaultExpectation == nil {
+		mmSPopN.defaultExpectation = &RedisClientMockSPopNExpectation{}
+	}
 
-	return mmGet
+	if mmSPopN.defaultExpectation.params != nil {
+		mmSPopN.mock.t.Fatalf("RedisClientMock.SPopN mock is already set by Expect")
+	}
+
+	if mmSPopN.defaultExpectation.paramPtrs == nil {
+		mmSPopN.defaultExpectation.paramPtrs = &RedisClientMockSPopNParamPtrs{}
+	}
+	mmSPopN.defaultExpectation.paramPtrs.count = &count
+	mmSPopN.defaultExpectation.expectationOrigins.originCount = minimock.CallerInfo(1)
+
+	return mmSPopN
 }
 
-// Return sets up results that will be returned by RedisClient.Get
-func (mmGet *mRedisClientMockGet) Return(sp1 *redis.StringCmd) *RedisClientMock {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the RedisClient.SPopN
+func (mmSPopN *mRedisClientMockSPopN) Inspect(f func(ctx context.Context, key string, count int64)) *mRedisClientMockSPopN {
+	if mmSPopN.mock.inspectFuncSPopN != nil {
+		mmSPopN.mock.t.Fatalf("Inspect function is already set for RedisClientMock.SPopN")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &RedisClientMockGetExpectation{mock: mmGet.mock}
+	mmSPopN.mock.inspectFuncSPopN = f
+
+	return mmSPopN
+}
+
+// Return sets up results that will be returned by RedisClient.SPopN
+func (mmSPopN *mRedisClientMockSPopN) Return(sp1 *redis.StringSliceCmd) *RedisClientMock {
+	if mmSPopN.mock.funcSPopN != nil {
+		mmSPopN.mock.t.Fatalf("RedisClientMock.SPopN mock is already set by Set")
 	}
-	mmGet.defaultExpectation.results = &RedisClientMockGetResults{sp1}
-	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGet.mock
+
+	if mmSPopN.defaultExpectation == nil {
+		mmSPopN.defaultExpectation = &RedisClientMockSPopNExpectation{mock: mmSPopN.mock}
+	}
+	mmSPopN.defaultExpectation.results = &RedisClientMockSPopNResults{sp1}
+	mmSPopN.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSPopN.mock
 }
 
-// Set uses given function f to mock the RedisClient.Get method
-func (mmGet *mRedisClientMockGet) Set(f func(ctx context.Context, key string) (sp1 *redis.StringCmd)) *RedisClientMock {
-	if mmGet.defaultExpectation != nil {
-		mmGet.mock.t.Fatalf("Default expectation is already set for the RedisClient.Get method")
+// Set uses given function f to mock the RedisClient.SPopN method
+func (mmSPopN *mRedisClientMockSPopN) Set(f func(ctx context.Context, key string, count int64) (sp1 *redis.StringSliceCmd)) *RedisClientMock {
+	if mmSPopN.defaultExpectation != nil {
+		mmSPopN.mock.t.Fatalf("Default expectation is already set for the RedisClient.SPopN method")
 	}
 
-	if len(mmGet.expectations) > 0 {
-		mmGet.mock.t.Fatalf("Some expectations are already set for the RedisClient.Get method")
+	if len(mmSPopN.expectations) > 0 {
+		mmSPopN.mock.t.Fatalf("Some expectations are already set for the RedisClient.SPopN method")
 	}
 
-	mmGet.mock.funcGet = f
-	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
-	return mmGet.mock
+	mmSPopN.mock.funcSPopN = f
+	mmSPopN.mock.funcSPopNOrigin = minimock.CallerInfo(1)
+	return mmSPopN.mock
 }
 
-// When sets expectation for the RedisClient.Get which will trigger the result defined by the following
+// When sets expectation for the RedisClient.SPopN which will trigger the result defined by the following
 // Then helper
-func (mmGet *mRedisClientMockGet) When(ctx context.Context, key string) *RedisClientMockGetExpectation {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by Set")
+func (mmSPopN *mRedisClientMockSPopN) When(ctx context.Context, key string, count int64) *RedisClientMockSPopNExpectation {
+	if mmSPopN.mock.funcSPopN != nil {
+		mmSPopN.mock.t.Fatalf("RedisClientMock.SPopN mock is already set by Set")
 	}
 
-	expectation := &RedisClientMockGetExpectation{
-		mock:               mmGet.mock,
-		params:             &RedisClientMockGetParams{ctx, key},
-		expectationOrigins: RedisClientMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &RedisClientMockSPopNExpectation{
+		mock:               mmSPopN.mock,
+		params:             &RedisClientMockSPopNParams{ctx, key, count},
+		expectationOrigins: RedisClientMockSPopNExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGet.expectations = append(mmGet.expectations, expectation)
+	mmSPopN.expectations = append(mmSPopN.expectations, expectation)
 	return expectation
 }
 
-// Then sets up RedisClient.Get return parameters for the expectation previously defined by the When method
-func (e *RedisClientMockGetExpectation) Then(sp1 *redis.StringCmd) *RedisClientMock {
-	e.results = &RedisClientMockGetResults{sp1}
+// Then sets up RedisClient.SPopN return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockSPopNExpectation) Then(sp1 *redis.StringSliceCmd) *RedisClientMock {
+	e.results = &RedisClientMockSPopNResults{sp1}
 	return e.mock
 }
 
-// Times sets number of times RedisClient.Get should be invoked
-func (mmGet *mRedisClientMockGet) Times(n uint64) *mRedisClientMockGet {
+// Times sets number of times RedisClient.SPopN should be invoked
+func (mmSPopN *mRedisClientMockSPopN) Times(n uint64) *mRedisClientMockSPopN {
 	if n == 0 {
-		mmGet.mock.t.Fatalf("Times of RedisClientMock.Get mock can not be zero")
+		mmSPopN.mock.t.Fatalf("Times of RedisClientMock.SPopN mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
-	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGet
+	mm_atomic.StoreUint64(&mmSPopN.expectedInvocations, n)
+	mmSPopN.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSPopN
 }
 
-func (mmGet *mRedisClientMockGet) invocationsDone() bool {
-	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+func (mmSPopN *mRedisClientMockSPopN) invocationsDone() bool {
+	if len(mmSPopN.expectations) == 0 && mmSPopN.defaultExpectation == nil && mmSPopN.mock.funcSPopN == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSPopN.mock.afterSPopNCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSPopN.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Get implements mm_cache.RedisClient
-func (mmGet *RedisClientMock) Get(ctx context.Context, key string) (sp1 *redis.StringCmd) {
-	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
-	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+// SPopN implements mm_cache.RedisClient
+func (mmSPopN *RedisClientMock) SPopN(ctx context.Context, key string, count int64) (sp1 *redis.StringSliceCmd) {
+	mm_atomic.AddUint64(&mmSPopN.beforeSPopNCounter, 1)
+	defer mm_atomic.AddUint64(&mmSPopN.afterSPopNCounter, 1)
 
-	mmGet.t.Helper()
+	mmSPopN.t.Helper()
 
-	if mmGet.inspectFuncGet != nil {
-		mmGet.inspectFuncGet(ctx, key)
+	if mmSPopN.inspectFuncSPopN != nil {
+		mmSPopN.inspectFuncSPopN(ctx, key, count)
 	}
 
-	mm_params := RedisClientMockGetParams{ctx, key}
+	mm_params := RedisClientMockSPopNParams{ctx, key, count}
 
 	// Record call args
-	mmGet.GetMock.mutex.Lock()
-	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
-	mmGet.GetMock.mutex.Unlock()
+	mmSPopN.SPopNMock.mutex.Lock()
+	mmSPopN.SPopNMock.callArgs = append(mmSPopN.SPopNMock.callArgs, &mm_params)
+	mmSPopN.SPopNMock.mutex.Unlock()
 
-	for _, e := range mmGet.GetMock.expectations {
+	for _, e := range mmSPopN.SPopNMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.sp1
 		}
 	}
 
-	if mmGet.GetMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
-		mm_want := mmGet.GetMock.defaultExpectation.params
-		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+	if mmSPopN.SPopNMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSPopN.SPopNMock.defaultExpectation.Counter, 1)
+		mm_want := mmSPopN.SPopNMock.defaultExpectation.params
+		mm_want_ptrs := mmSPopN.SPopNMock.defaultExpectation.paramPtrs
 
-		mm_got := RedisClientMockGetParams{ctx, key}
+		mm_got := RedisClientMockSPopNParams{ctx, key, count}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGet.t.Errorf("RedisClientMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSPopN.t.Errorf("RedisClientMock.SPopN got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSPopN.SPopNMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
-				mmGet.t.Errorf("RedisClientMock.Get got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGet.GetMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+				mmSPopN.t.Errorf("RedisClientMock.SPopN got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSPopN.SPopNMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.count != nil && !minimock.Equal(*mm_want_ptrs.count, mm_got.count) {
+				mmSPopN.t.Errorf("RedisClientMock.SPopN got unexpected parameter count, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSPopN.SPopNMock.defaultExpectation.expectationOrigins.originCount, *mm_want_ptrs.count, mm_got.count, minimock.Diff(*mm_want_ptrs.count, mm_got.count))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGet.t.Errorf("RedisClientMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSPopN.t.Errorf("RedisClientMock.SPopN got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSPopN.SPopNMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGet.GetMock.defaultExpectation.results
+		mm_results := mmSPopN.SPopNMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGet.t.Fatal("No results are set for the RedisClientMock.Get")
+			mmSPopN.t.Fatal("No results are set for the RedisClientMock.SPopN")
 		}
 		return (*mm_results).sp1
 	}
-	if mmGet.funcGet != nil {
-		return mmGet.funcGet(ctx, key)
+	if mmSPopN.funcSPopN != nil {
+		return mmSPopN.funcSPopN(ctx, key, count)
 	}
-	mmGet.t.Fatalf("Unexpected call to RedisClientMock.Get. %v %v", ctx, key)
+	mmSPopN.t.Fatalf("Unexpected call to RedisClientMock.SPopN. %v %v %v", ctx, key, count)
 	return
 }
 
-// GetAfterCounter returns a count of finished RedisClientMock.Get invocations
-func (mmGet *RedisClientMock) GetAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+// SPopNAfterCounter returns a count of finished RedisClientMock.SPopN invocations
+func (mmSPopN *RedisClientMock) SPopNAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSPopN.afterSPopNCounter)
 }
 
-// GetBeforeCounter returns a count of RedisClientMock.Get invocations
-func (mmGet *RedisClientMock) GetBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+// SPopNBeforeCounter returns a count of RedisClientMock.SPopN invocations
+func (mmSPopN *RedisClientMock) SPopNBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSPopN.beforeSPopNCounter)
 }
 
-// Calls returns a list of arguments used in each call to RedisClientMock.Get.
+// Calls returns a list of arguments used in each call to RedisClientMock.SPopN.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGet *mRedisClientMockGet) Calls() []*RedisClientMockGetParams {
-	mmGet.mutex.RLock()
+func (mmSPopN *mRedisClientMockSPopN) Calls() []*RedisClientMockSPopNParams {
+	mmSPopN.mutex.RLock()
 
-	argCopy := make([]*RedisClientMockGetParams, len(mmGet.callArgs))
-	copy(argCopy, mmGet.callArgs)
+	argCopy := make([]*RedisClientMockSPopNParams, len(mmSPopN.callArgs))
+	copy(argCopy, mmSPopN.callArgs)
 
-	mmGet.mutex.RUnlock()
+	mmSPopN.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetDone returns true if the count of the Get invocations corresponds
+// MinimockSPopNDone returns true if the count of the SPopN invocations corresponds
 // the number of defined expectations
-func (m *RedisClientMock) MinimockGetDone() bool {
-	if m.GetMock.optional {
+func (m *RedisClientMock) MinimockSPopNDone() bool {
+	if m.SPopNMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetMock.expectations {
+	for _, e := range m.SPopNMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetMock.invocationsDone()
+	return m.SPopNMock.invocationsDone()
 }
 
-// MinimockGetInspect logs each unmet expectation
-func (m *RedisClientMock) MinimockGetInspect() {
-	for _, e := range m.GetMock.expectations {
+// MinimockSPopNInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockSPopNInspect() {
+	for _, e := range m.SPopNMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RedisClientMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to RedisClientMock.SPopN at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	afterSPopNCounter := mm_atomic.LoadUint64(&m.afterSPopNCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
-		if m.GetMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to RedisClientMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+	if m.SPopNMock.defaultExpectation != nil && afterSPopNCounter < 1 {
+		if m.SPopNMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.SPopN at\n%s", m.SPopNMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to RedisClientMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RedisClientMock.SPopN at\n%s with params: %#v", m.SPopNMock.defaultExpectation.expectationOrigins.origin, *m.SPopNMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGet != nil && afterGetCounter < 1 {
-		m.t.Errorf("Expected call to RedisClientMock.Get at\n%s", m.funcGetOrigin)
+	if m.funcSPopN != nil && afterSPopNCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.SPopN at\n%s", m.funcSPopNOrigin)
 	}
 
-	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
-		m.t.Errorf("Expected %d calls to RedisClientMock.Get at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	if !m.SPopNMock.invocationsDone() && afterSPopNCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.SPopN at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SPopNMock.expectedInvocations), m.SPopNMock.expectedInvocationsOrigin, afterSPopNCounter)
 	}
 }
 
@@ -1001,8 +4325,26 @@ func (m *RedisClientMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockCloseInspect()
 
+			m.MinimockDelInspect()
+
+			m.MinimockExpireInspect()
+
 			m.MinimockGetInspect()
 
+			m.MinimockHGetInspect()
+
+			m.MinimockHGetAllInspect()
+
+			m.MinimockHSetInspect()
+
+			m.MinimockMGetInspect()
+
+			m.MinimockPipelinedInspect()
+
+			m.MinimockSAddInspect()
+
+			m.MinimockSPopNInspect()
+
 			m.MinimockSetInspect()
 		}
 	})
@@ -1028,6 +4370,15 @@ func (m *RedisClientMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockCloseDone() &&
+		m.MinimockDelDone() &&
+		m.MinimockExpireDone() &&
 		m.MinimockGetDone() &&
+		m.MinimockHGetDone() &&
+		m.MinimockHGetAllDone() &&
+		m.MinimockHSetDone() &&
+		m.MinimockMGetDone() &&
+		m.MinimockPipelinedDone() &&
+		m.MinimockSAddDone() &&
+		m.MinimockSPopNDone() &&
 		m.MinimockSetDone()
 }
@@ -11,7 +11,16 @@ import (
 //go:generate minimock -i RedisClient -o ./mocks/ -s "_mock.go"
 type RedisClient interface {
 	Get(ctx context.Context, key string) *redis.StringCmd
+	MGet(ctx context.Context, keys ...string) *redis.SliceCmd
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.StatusCmd
+	Del(ctx context.Context, keys ...string) *redis.IntCmd
+	Pipelined(ctx context.Context, fn func(redis.Pipeliner) error) ([]redis.Cmder, error)
+	Expire(ctx context.Context, key string, expiration time.Duration) *redis.BoolCmd
+	HSet(ctx context.Context, key string, values ...interface{}) *redis.IntCmd
+	HGet(ctx context.Context, key, field string) *redis.StringCmd
+	HGetAll(ctx context.Context, key string) *redis.MapStringStringCmd
+	SAdd(ctx context.Context, key string, members ...interface{}) *redis.IntCmd
+	SPopN(ctx context.Context, key string, count int64) *redis.StringSliceCmd
 	Close() error
 }
 
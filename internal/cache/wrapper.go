@@ -3,6 +3,7 @@ package cache
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -42,3 +43,90 @@ func GetOrSet[T any](c *Cache, ctx context.Context, key string, ttl time.Duratio
 
 	return dto, nil
 }
+
+// GetOrSetMany reads keys with one MGET and calls fetch once with the keys that were
+// missing. The fetched values are written back in a single pipeline, keys absent from
+// the fetched map are neither returned nor cached.
+func GetOrSetMany[T any](c *Cache, ctx context.Context, keys []string, ttl time.Duration, fetch func(missing []string) (map[string]T, error)) (map[string]T, error) {
+	result := make(map[string]T, len(keys))
+
+	vals, clientErr := c.Client.MGet(ctx, keys...).Result()
+	if clientErr != nil {
+		return nil, clientErr
+	}
+
+	var missing []string
+
+	for i, val := range vals {
+		raw, ok := val.(string)
+		if !ok {
+			missing = append(missing, keys[i])
+			continue
+		}
+
+		var dto T
+		if convertErr := json.Unmarshal([]byte(raw), &dto); convertErr != nil {
+			return nil, convertErr
+		}
+		result[keys[i]] = dto
+	}
+
+	if len(missing) == 0 {
+		return result, nil
+	}
+
+	fetched, storageErr := fetch(missing)
+	if storageErr != nil {
+		return nil, storageErr
+	}
+
+	_, _ = c.Client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for key, dto := range fetched {
+			result[key] = dto
+
+			if data, err := json.Marshal(dto); err == nil {
+				pipe.Set(ctx, key, data, ttl)
+			}
+		}
+		return nil
+	})
+
+	return result, nil
+}
+
+func (c *Cache) Delete(ctx context.Context, keys ...string) error {
+	return c.Client.Del(ctx, keys...).Err()
+}
+
+// generationTTL outlives every cached entry, a generation that expired has nothing
+// left under it when it starts over from zero.
+const generationTTL = 24 * time.Hour
+
+func generationKey(scope string) string {
+	return "generation:" + scope
+}
+
+// Generation returns the current generation of scope. Keys built with it are all dropped
+// by a single Bump: they are never read again and left to expire, nothing is scanned.
+func (c *Cache) Generation(ctx context.Context, scope string) (string, error) {
+	gen, err := c.Client.Get(ctx, generationKey(scope)).Result()
+
+	if errors.Is(err, redis.Nil) {
+		return "0", nil
+	}
+
+	return gen, err
+}
+
+// Bump moves every scope to its next generation.
+func (c *Cache) Bump(ctx context.Context, scopes ...string) error {
+	_, err := c.Client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, scope := range scopes {
+			pipe.Incr(ctx, generationKey(scope))
+			pipe.Expire(ctx, generationKey(scope), generationTTL)
+		}
+		return nil
+	})
+
+	return err
+}
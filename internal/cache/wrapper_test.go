@@ -74,4 +74,46 @@ func TestCache(t *testing.T) {
 
 	})
 
+	t.Run("Cache bumps the generation of a scope", func(t *testing.T) {
+		rs := miniredis.RunT(t)
+		c := redis.NewClient(&redis.Options{Addr: rs.Addr()})
+		cache := &Cache{Client: c}
+
+		gen, err := cache.Generation(ctx, "cache:review:1")
+		require.NoError(t, err)
+		require.Equal(t, "0", gen)
+
+		require.NoError(t, cache.Bump(ctx, "cache:review:1", "cache:review:2"))
+
+		gen, err = cache.Generation(ctx, "cache:review:1")
+		require.NoError(t, err)
+		require.Equal(t, "1", gen)
+		require.Positive(t, rs.TTL("generation:cache:review:2"))
+		// a scope sharing the prefix keeps its generation
+		gen, err = cache.Generation(ctx, "cache:review:10")
+		require.NoError(t, err)
+		require.Equal(t, "0", gen)
+	})
+
+	t.Run("Cache fetches only missing keys and stores them", func(t *testing.T) {
+		rs := miniredis.RunT(t)
+		c := redis.NewClient(&redis.Options{Addr: rs.Addr()})
+		cache := &Cache{Client: c}
+		expBytes, _ := json.Marshal(expectedStruct)
+		_ = rs.Set("key:1", string(expBytes))
+
+		var requested []string
+		values, err := GetOrSetMany(cache, ctx, []string{"key:1", "key:2", "key:3"}, time.Minute, func(missing []string) (map[string]tStruct, error) {
+			requested = missing
+			return map[string]tStruct{"key:2": {Param1: 2}}, nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"key:2", "key:3"}, requested)
+		require.Equal(t, map[string]tStruct{"key:1": expectedStruct, "key:2": {Param1: 2}}, values)
+		require.True(t, rs.Exists("key:2"))
+		require.False(t, rs.Exists("key:3"))
+		require.Positive(t, rs.TTL("key:2"))
+	})
+
 }
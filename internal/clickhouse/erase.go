@@ -0,0 +1,35 @@
+package clickhouse
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+)
+
+// userDeletes are the statements removing every row of a user: review events,
+// votes the user left or got, reports the user filed or got, watch and bookmark events.
+var userDeletes = []string{
+	"ALTER TABLE analytics DELETE WHERE user_id = toUUID(?)",
+	"ALTER TABLE review_votes DELETE WHERE voter_id = toUUID(?) OR user_id = toUUID(?)",
+	"ALTER TABLE review_reports DELETE WHERE reporter_id = toUUID(?) OR user_id = toUUID(?)",
+	"ALTER TABLE watch_events DELETE WHERE user_id = toUUID(?)",
+	"ALTER TABLE bookmark_events DELETE WHERE user_id = toUUID(?)",
+}
+
+// DeleteUserRows schedules removal of the rows of the user from every analytics table.
+// Clickhouse applies the deletions in the background.
+func DeleteUserRows(ctx context.Context, conn driver.Conn, userID string) error {
+	for _, query := range userDeletes {
+		args := make([]any, strings.Count(query, "?"))
+		for i := range args {
+			args[i] = userID
+		}
+
+		if err := conn.Exec(ctx, query, args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/maisiq/go-ugc-service/internal/repository"
 	"github.com/maisiq/go-ugc-service/pkg/config"
 	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.uber.org/zap"
 )
 
@@ -59,7 +60,7 @@ func TestUOW(t *testing.T) {
 		})
 
 		require.ErrorIs(t, err, repository.ErrAlreadyExists)
-		res, err := userRepo.GetReviews(ctx, review.UserID)
+		res, err := userRepo.GetReviews(ctx, review.UserID, []repository.ReviewStatus{repository.StatusApproved}, repository.SortDefault, nil, 10)
 
 		require.Len(t, res, 0)
 		require.ErrorIs(t, err, repository.ErrNotFound)
@@ -80,7 +81,7 @@ func TestUOW(t *testing.T) {
 		})
 
 		require.ErrorIs(t, err, repository.ErrAlreadyExists)
-		res, err := movieRepo.GetReviews(ctx, review.MovieID)
+		res, err := movieRepo.GetReviews(ctx, review.MovieID, []repository.ReviewStatus{repository.StatusApproved}, repository.SortDefault, nil, 10)
 
 		require.Len(t, res, 0)
 		require.ErrorIs(t, err, repository.ErrNotFound)
@@ -104,16 +105,105 @@ func TestUOW(t *testing.T) {
 		})
 
 		require.NoError(t, err)
-		res, err := movieRepo.GetReviews(ctx, review.MovieID)
+		res, err := movieRepo.GetReviews(ctx, review.MovieID, []repository.ReviewStatus{repository.StatusApproved}, repository.SortDefault, nil, 10)
 
 		require.Len(t, res, 1)
 		require.NoError(t, err)
 
-		res, err = userRepo.GetReviews(ctx, review.UserID)
+		res, err = userRepo.GetReviews(ctx, review.UserID, []repository.ReviewStatus{repository.StatusApproved}, repository.SortDefault, nil, 10)
 
 		require.Len(t, res, 1)
 		require.NoError(t, err)
 
 	})
 
+	t.Run("Moderation reason of a new review survives a round trip", func(t *testing.T) {
+		t.Parallel()
+
+		userRepo := repository.NewUserReviewRepository(usersCollection)
+		movieRepo := repository.NewMovieReviewRepository(moviesCollection)
+		flagged := repository.Review{
+			UserID:           gofakeit.UUID(),
+			MovieID:          gofakeit.UUID(),
+			Text:             reviewText,
+			Status:           repository.StatusPending,
+			ModerationReason: "contains a link",
+		}
+
+		err := uow.RunWithinTx(ctx, func(ctx context.Context) error {
+			if err := userRepo.CreateReview(ctx, flagged); err != nil {
+				return err
+			}
+			return movieRepo.CreateReview(ctx, flagged)
+		})
+		require.NoError(t, err)
+
+		for _, repo := range []repository.ReviewRepository{userRepo, movieRepo} {
+			got, err := repo.GetReview(ctx, flagged.UserID, flagged.MovieID)
+
+			require.NoError(t, err)
+			require.Equal(t, repository.StatusPending, got.Status)
+			require.Equal(t, flagged.ModerationReason, got.ModerationReason)
+		}
+	})
+
+	t.Run("Newest pages move past reviews stored without a timestamp", func(t *testing.T) {
+		t.Parallel()
+
+		movies := db.Collection("test-legacy-movies")
+		movieRepo := repository.NewMovieReviewRepository(movies)
+		legacy := bson.A{}
+		for range 3 {
+			legacy = append(legacy, bson.M{"userID": gofakeit.UUID(), "movieID": movieID, "text": reviewText})
+		}
+		_, err := movies.InsertOne(ctx, bson.M{"_id": movieID, "reviews": legacy})
+		require.NoError(t, err)
+
+		seen := map[string]bool{}
+		var after *repository.ReviewCursor
+		for {
+			page, err := movieRepo.GetReviews(ctx, movieID, []repository.ReviewStatus{repository.StatusApproved}, repository.SortNewest, after, 2)
+			require.NoError(t, err)
+
+			for _, review := range page {
+				require.False(t, seen[review.UserID], "review %v repeated", review.UserID)
+				seen[review.UserID] = true
+			}
+			if len(page) < 2 {
+				break
+			}
+			cursor := repository.CursorAfter(page[len(page)-1])
+			after = &cursor
+		}
+		require.Len(t, seen, 3)
+	})
+
+	t.Run("Search backfill indexes missing reviews and keeps indexed ones", func(t *testing.T) {
+		t.Parallel()
+
+		movies := db.Collection("test-backfill-movies")
+		search := db.Collection("test-backfill-search")
+		movieRepo := repository.NewMovieReviewRepository(movies)
+		searchRepo := repository.NewReviewSearchRepository(search)
+
+		old := repository.Review{UserID: gofakeit.UUID(), MovieID: movieID, Text: "written before search"}
+		indexed := repository.Review{UserID: gofakeit.UUID(), MovieID: movieID, Text: "stale"}
+		require.NoError(t, movieRepo.CreateReview(ctx, old))
+		require.NoError(t, movieRepo.CreateReview(ctx, indexed))
+
+		indexed.Text = "edited"
+		require.NoError(t, searchRepo.IndexReview(ctx, indexed))
+
+		require.NoError(t, repository.BackfillSearch(ctx, movies, search))
+
+		for _, want := range []repository.Review{old, indexed} {
+			var got repository.Review
+			err := search.FindOne(ctx, bson.M{"_id": want.MovieID + ":" + want.UserID}).Decode(&got)
+
+			require.NoError(t, err)
+			require.Equal(t, want.Text, got.Text)
+			require.Equal(t, want.MovieID, got.MovieID)
+		}
+	})
+
 }
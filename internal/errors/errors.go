@@ -1,9 +1,34 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
-	ErrNotFound      = errors.New("not found")
-	ErrAlreadyExists = errors.New("already exists")
-	ErrInternal      = errors.New("internal error")
+	ErrNotFound         = errors.New("not found")
+	ErrAlreadyExists    = errors.New("already exists")
+	ErrInternal         = errors.New("internal error")
+	ErrInvalidArgument  = errors.New("invalid argument")
+	ErrPermissionDenied = errors.New("permission denied")
+	// ErrContentRejected is wrapped together with the reason a content filter gave.
+	ErrContentRejected = errors.New("content rejected")
+	// ErrFailedPrecondition is returned when the resource is not in a state the operation can be applied to.
+	ErrFailedPrecondition = errors.New("failed precondition")
+	// ErrVersionConflict is returned when the resource was changed since the client read it.
+	ErrVersionConflict = errors.New("version conflict")
 )
+
+// VersionConflictError carries the current version of the resource, so the client can
+// re-read it and retry.
+type VersionConflictError struct {
+	Current int64
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("%v: current version is %d", ErrVersionConflict, e.Current)
+}
+
+func (e *VersionConflictError) Is(target error) bool {
+	return target == ErrVersionConflict
+}
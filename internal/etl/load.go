@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/maisiq/go-ugc-service/internal/clickhouse"
 	"github.com/maisiq/go-ugc-service/internal/etl/models"
 )
 
@@ -19,22 +20,43 @@ func (r *ETLRunner) loader(ctx context.Context, in <-chan models.Msg, batchSize
 				return
 			}
 
-			b, err := r.clickhouseConn.PrepareBatch(ctx, "INSERT INTO analytics (user_id, movie_id, timestamp_ms)")
-
-			if err != nil {
-				r.log.Errorf("Could not prepare: %v", err)
-			}
+			var reviews, votes, reports, watches, bookmarks, erasures []models.Msg
 
 			for _, res := range batch {
-				_ = b.Append(res.Event.UserID, res.Event.MovieID, res.Event.TimestampMS)
-			}
-
-			if err := b.Send(); err != nil {
-				for _, res := range batch {
-					res.Err = err
-					out <- res
+				switch {
+				case res.Event.IsErasure():
+					erasures = append(erasures, res)
+				case res.Event.IsVote():
+					votes = append(votes, res)
+				case res.Event.IsReport():
+					reports = append(reports, res)
+				case res.Event.IsProgress():
+					watches = append(watches, res)
+				case res.Event.IsBookmark():
+					bookmarks = append(bookmarks, res)
+				default:
+					reviews = append(reviews, res)
 				}
 			}
+
+			r.send(ctx, out, "INSERT INTO analytics (user_id, movie_id, timestamp_ms, event)", reviews, func(e models.AnalyticsEvent) []any {
+				return []any{e.UserID, e.MovieID, e.TimestampMS, e.Event}
+			})
+			r.send(ctx, out, "INSERT INTO review_votes (voter_id, user_id, movie_id, vote, timestamp_ms, event)", votes, func(e models.AnalyticsEvent) []any {
+				return []any{e.VoterID, e.UserID, e.MovieID, int8(e.Vote), e.TimestampMS, e.Event}
+			})
+			r.send(ctx, out, "INSERT INTO review_reports (reporter_id, user_id, movie_id, reason, timestamp_ms)", reports, func(e models.AnalyticsEvent) []any {
+				return []any{e.ReporterID, e.UserID, e.MovieID, e.Reason, e.TimestampMS}
+			})
+			r.send(ctx, out, "INSERT INTO watch_events (user_id, movie_id, position_ms, duration_ms, timestamp_ms)", watches, func(e models.AnalyticsEvent) []any {
+				return []any{e.UserID, e.MovieID, e.PositionMS, e.DurationMS, e.TimestampMS}
+			})
+			r.send(ctx, out, "INSERT INTO bookmark_events (user_id, movie_id, timestamp_ms, event)", bookmarks, func(e models.AnalyticsEvent) []any {
+				return []any{e.UserID, e.MovieID, e.TimestampMS, e.Event}
+			})
+			// erasures go last, events of the user loaded in this batch are removed as well
+			r.erase(ctx, out, erasures)
+
 			batch = batch[:0]
 
 		}
@@ -64,3 +86,46 @@ func (r *ETLRunner) loader(ctx context.Context, in <-chan models.Msg, batchSize
 	}()
 	return out
 }
+
+// send writes msgs to clickhouse in one batch, row maps an event to the query columns.
+func (r *ETLRunner) send(ctx context.Context, out chan<- models.Msg, query string, msgs []models.Msg, row func(models.AnalyticsEvent) []any) {
+	if len(msgs) == 0 {
+		return
+	}
+
+	b, err := r.clickhouseConn.PrepareBatch(ctx, query)
+
+	if err != nil {
+		// the other tables of the flush are still loaded
+		r.log.Errorf("Could not prepare: %v", err)
+		fail(out, msgs, err)
+		return
+	}
+
+	for _, res := range msgs {
+		_ = b.Append(row(res.Event)...)
+	}
+
+	if err := b.Send(); err != nil {
+		fail(out, msgs, err)
+	}
+}
+
+// fail reports msgs as not loaded.
+func fail(out chan<- models.Msg, msgs []models.Msg, err error) {
+	for _, res := range msgs {
+		res.Err = err
+		out <- res
+	}
+}
+
+// erase removes the rows of the erased users from every table. The API deletes them too,
+// this catches the events that were still in the topic at that moment.
+func (r *ETLRunner) erase(ctx context.Context, out chan<- models.Msg, msgs []models.Msg) {
+	for _, res := range msgs {
+		if err := clickhouse.DeleteUserRows(ctx, r.clickhouseConn, res.Event.UserID); err != nil {
+			res.Err = err
+			out <- res
+		}
+	}
+}
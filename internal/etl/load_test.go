@@ -0,0 +1,167 @@
+package etl
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"github.com/maisiq/go-ugc-service/internal/etl/models"
+	analyticsv1 "github.com/maisiq/go-ugc-service/pkg/pb/analytics/v1"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeConn records the rows appended to batches and the executed statements,
+// methods the loader does not call are left nil. Batches into the missing table fail.
+type fakeConn struct {
+	driver.Conn
+	rows    map[string][][]any
+	execs   []string
+	missing string
+}
+
+func (c *fakeConn) Exec(ctx context.Context, query string, args ...any) error {
+	c.execs = append(c.execs, query)
+	return nil
+}
+
+func (c *fakeConn) PrepareBatch(ctx context.Context, query string, opts ...driver.PrepareBatchOption) (driver.Batch, error) {
+	if c.missing != "" && strings.HasPrefix(query, "INSERT INTO "+c.missing+" ") {
+		return nil, errors.New("table " + c.missing + " does not exist")
+	}
+	return &fakeBatch{conn: c, query: query}, nil
+}
+
+type fakeBatch struct {
+	driver.Batch
+	conn  *fakeConn
+	query string
+}
+
+func (b *fakeBatch) Append(v ...any) error {
+	b.conn.rows[b.query] = append(b.conn.rows[b.query], v)
+	return nil
+}
+
+func (b *fakeBatch) Send() error {
+	return nil
+}
+
+func TestLoader(t *testing.T) {
+	// loadAll loads the events in one batch and returns the ones that failed
+	loadAll := func(t *testing.T, conn *fakeConn, events ...*analyticsv1.AnalyticsEvent) []models.Msg {
+		r := NewRunner(zap.NewNop().Sugar(), conn, nil, nil)
+
+		in := make(chan models.Msg, len(events))
+		for _, event := range events {
+			value, err := proto.Marshal(event)
+			require.NoError(t, err)
+
+			in <- models.Msg{KafkaMsg: kafka.Message{Value: value, Headers: []kafka.Header{
+				{Key: models.HeaderContentType, Value: []byte(models.ContentTypeProtobuf)},
+				{Key: models.HeaderSchemaVersion, Value: []byte("2")},
+			}}}
+		}
+		close(in)
+
+		var failed []models.Msg
+		for res := range r.loader(context.Background(), r.transform(in), 10, time.Hour) {
+			failed = append(failed, res)
+		}
+		return failed
+	}
+
+	load := func(t *testing.T, conn *fakeConn, event *analyticsv1.AnalyticsEvent) {
+		for _, res := range loadAll(t, conn, event) {
+			require.NoError(t, res.Err)
+		}
+	}
+
+	t.Run("Occurred at is loaded as Unix milliseconds", func(t *testing.T) {
+		conn := &fakeConn{rows: map[string][][]any{}}
+		occurredAt := time.Date(2026, 10, 18, 9, 30, 15, 123_000_000, time.UTC)
+
+		load(t, conn, &analyticsv1.AnalyticsEvent{
+			EventId:       "id",
+			EventType:     models.EventReviewCreated,
+			SchemaVersion: 2,
+			OccurredAt:    timestamppb.New(occurredAt),
+			Payload:       &analyticsv1.AnalyticsEvent_Review{Review: &analyticsv1.ReviewPayload{UserId: "u", MovieId: "m"}},
+		})
+
+		rows := conn.rows["INSERT INTO analytics (user_id, movie_id, timestamp_ms, event)"]
+		require.Len(t, rows, 1)
+
+		timestampMS, ok := rows[0][2].(int64)
+		require.True(t, ok, "timestamp_ms is an Int64 column")
+		require.Equal(t, occurredAt, time.UnixMilli(timestampMS).UTC())
+	})
+
+	t.Run("Bookmark events are loaded to their own table", func(t *testing.T) {
+		conn := &fakeConn{rows: map[string][][]any{}}
+
+		load(t, conn, &analyticsv1.AnalyticsEvent{
+			EventId:       "id",
+			EventType:     models.EventBookmarkRemoved,
+			SchemaVersion: 2,
+			OccurredAt:    timestamppb.Now(),
+			Payload:       &analyticsv1.AnalyticsEvent_Bookmark{Bookmark: &analyticsv1.BookmarkPayload{UserId: "u", MovieId: "m"}},
+		})
+
+		require.Len(t, conn.rows, 1)
+		rows := conn.rows["INSERT INTO bookmark_events (user_id, movie_id, timestamp_ms, event)"]
+		require.Len(t, rows, 1)
+		require.Equal(t, "m", rows[0][1])
+		require.Equal(t, models.EventBookmarkRemoved, rows[0][3])
+	})
+
+	t.Run("A missing table fails only its own events", func(t *testing.T) {
+		conn := &fakeConn{rows: map[string][][]any{}, missing: "bookmark_events"}
+
+		failed := loadAll(t, conn,
+			&analyticsv1.AnalyticsEvent{
+				EventId:       "review",
+				EventType:     models.EventReviewCreated,
+				SchemaVersion: 2,
+				OccurredAt:    timestamppb.Now(),
+				Payload:       &analyticsv1.AnalyticsEvent_Review{Review: &analyticsv1.ReviewPayload{UserId: "u", MovieId: "m"}},
+			},
+			&analyticsv1.AnalyticsEvent{
+				EventId:       "bookmark",
+				EventType:     models.EventBookmarkAdded,
+				SchemaVersion: 2,
+				OccurredAt:    timestamppb.Now(),
+				Payload:       &analyticsv1.AnalyticsEvent_Bookmark{Bookmark: &analyticsv1.BookmarkPayload{UserId: "u", MovieId: "m"}},
+			},
+		)
+
+		require.Len(t, failed, 1)
+		require.Error(t, failed[0].Err)
+		require.Equal(t, models.EventBookmarkAdded, failed[0].Event.Event)
+		require.Len(t, conn.rows["INSERT INTO analytics (user_id, movie_id, timestamp_ms, event)"], 1)
+	})
+
+	t.Run("Erasure deletes the user rows from every table", func(t *testing.T) {
+		conn := &fakeConn{rows: map[string][][]any{}}
+
+		load(t, conn, &analyticsv1.AnalyticsEvent{
+			EventId:       "id",
+			EventType:     models.EventUserErased,
+			SchemaVersion: 2,
+			OccurredAt:    timestamppb.Now(),
+			Payload:       &analyticsv1.AnalyticsEvent_User{User: &analyticsv1.UserPayload{UserId: "u"}},
+		})
+
+		tables := []string{"analytics", "review_votes", "review_reports", "watch_events", "bookmark_events"}
+		require.Len(t, conn.execs, len(tables))
+		for i, table := range tables {
+			require.Contains(t, conn.execs[i], "ALTER TABLE "+table+" DELETE")
+		}
+	})
+}
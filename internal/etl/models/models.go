@@ -1,12 +1,255 @@
 package models
 
-import "github.com/segmentio/kafka-go"
+import (
+	"fmt"
+	"strconv"
+	"time"
 
+	"github.com/mailru/easyjson"
+	analyticsv1 "github.com/maisiq/go-ugc-service/pkg/pb/analytics/v1"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	EventReviewCreated = "review_created"
+	EventReviewUpdated = "review_updated"
+	EventReviewDeleted = "review_deleted"
+
+	EventReviewVoted       = "review_voted"
+	EventReviewVoteRemoved = "review_vote_removed"
+
+	EventReviewReported = "review_reported"
+
+	EventProgressReported = "progress_reported"
+
+	EventBookmarkAdded   = "bookmark_added"
+	EventBookmarkRemoved = "bookmark_removed"
+
+	EventUserErased = "user_erased"
+)
+
+// Headers of the messages, they have to match the ones of the producer.
+const (
+	HeaderContentType   = "content-type"
+	HeaderSchemaVersion = "schema-version"
+
+	ContentTypeJSON     = "application/json"
+	ContentTypeProtobuf = "application/x-protobuf"
+)
+
+// AnalyticsEvent is an event as it is loaded to clickhouse. Messages of every supported
+// schema version are decoded into it, version 1 messages have exactly its shape.
+// TimestampMS is always Unix milliseconds.
+//
 //easyjson:json
 type AnalyticsEvent struct {
 	UserID      string `json:"user_id"`
 	MovieID     string `json:"movie_id"`
 	TimestampMS int64  `json:"timestamp_ms"`
+	Event       string `json:"event"`
+	VoterID     string `json:"voter_id"`
+	Vote        int32  `json:"vote"`
+	ReporterID  string `json:"reporter_id"`
+	Reason      string `json:"reason"`
+	PositionMS  int64  `json:"position_ms"`
+	DurationMS  int64  `json:"duration_ms"`
+}
+
+// Envelope is a message of schema version 2, the event fields are in the payload
+// matching EventType.
+//
+//easyjson:json
+type Envelope struct {
+	EventID       string           `json:"event_id"`
+	EventType     string           `json:"event_type"`
+	SchemaVersion int32            `json:"schema_version"`
+	OccurredAt    time.Time        `json:"occurred_at"`
+	Review        *ReviewPayload   `json:"review"`
+	Vote          *VotePayload     `json:"vote"`
+	Report        *ReportPayload   `json:"report"`
+	Progress      *ProgressPayload `json:"progress"`
+	Bookmark      *BookmarkPayload `json:"bookmark"`
+	User          *UserPayload     `json:"user"`
+}
+
+type ReviewPayload struct {
+	UserID  string `json:"user_id"`
+	MovieID string `json:"movie_id"`
+}
+
+type VotePayload struct {
+	VoterID string `json:"voter_id"`
+	UserID  string `json:"user_id"`
+	MovieID string `json:"movie_id"`
+	Vote    int32  `json:"vote"`
+}
+
+type ReportPayload struct {
+	ReporterID string `json:"reporter_id"`
+	UserID     string `json:"user_id"`
+	MovieID    string `json:"movie_id"`
+	Reason     string `json:"reason"`
+}
+
+type ProgressPayload struct {
+	UserID     string `json:"user_id"`
+	MovieID    string `json:"movie_id"`
+	PositionMS int64  `json:"position_ms"`
+	DurationMS int64  `json:"duration_ms"`
+}
+
+type BookmarkPayload struct {
+	UserID  string `json:"user_id"`
+	MovieID string `json:"movie_id"`
+}
+
+type UserPayload struct {
+	UserID string `json:"user_id"`
+}
+
+// Event flattens the envelope. It fails when the payload of EventType is missing.
+func (e Envelope) Event() (AnalyticsEvent, error) {
+	event := AnalyticsEvent{Event: e.EventType, TimestampMS: e.OccurredAt.UnixMilli()}
+
+	switch {
+	case e.Review != nil:
+		event.UserID, event.MovieID = e.Review.UserID, e.Review.MovieID
+	case e.Vote != nil:
+		event.VoterID, event.UserID, event.MovieID, event.Vote = e.Vote.VoterID, e.Vote.UserID, e.Vote.MovieID, e.Vote.Vote
+	case e.Report != nil:
+		event.ReporterID, event.UserID, event.MovieID, event.Reason = e.Report.ReporterID, e.Report.UserID, e.Report.MovieID, e.Report.Reason
+	case e.Progress != nil:
+		event.UserID, event.MovieID = e.Progress.UserID, e.Progress.MovieID
+		event.PositionMS, event.DurationMS = e.Progress.PositionMS, e.Progress.DurationMS
+	case e.Bookmark != nil:
+		event.UserID, event.MovieID = e.Bookmark.UserID, e.Bookmark.MovieID
+	case e.User != nil:
+		event.UserID = e.User.UserID
+	default:
+		return AnalyticsEvent{}, fmt.Errorf("event %v of type %v has no payload", e.EventID, e.EventType)
+	}
+
+	return event, nil
+}
+
+// DecodeMessage picks the decoder of the message from its headers. Messages
+// without a content type were written as JSON before protobuf was introduced.
+func DecodeMessage(m kafka.Message) (AnalyticsEvent, error) {
+	var contentType, version string
+
+	for _, h := range m.Headers {
+		switch h.Key {
+		case HeaderContentType:
+			contentType = string(h.Value)
+		case HeaderSchemaVersion:
+			version = string(h.Value)
+		}
+	}
+
+	switch contentType {
+	case "", ContentTypeJSON:
+		return DecodeJSON(m.Value)
+	case ContentTypeProtobuf:
+		v, err := strconv.Atoi(version)
+		if err != nil {
+			return AnalyticsEvent{}, fmt.Errorf("invalid schema version %q: %w", version, err)
+		}
+		return DecodeProto(m.Value, v)
+	default:
+		return AnalyticsEvent{}, fmt.Errorf("unsupported content type %q", contentType)
+	}
+}
+
+// DecodeProto decodes a protobuf message of the schema version.
+func DecodeProto(data []byte, version int) (AnalyticsEvent, error) {
+	if version != 2 {
+		return AnalyticsEvent{}, fmt.Errorf("unsupported protobuf schema version %d", version)
+	}
+
+	var pb analyticsv1.AnalyticsEvent
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return AnalyticsEvent{}, err
+	}
+
+	envelope := Envelope{
+		EventID:       pb.GetEventId(),
+		EventType:     pb.GetEventType(),
+		SchemaVersion: pb.GetSchemaVersion(),
+		OccurredAt:    pb.GetOccurredAt().AsTime(),
+	}
+
+	switch payload := pb.GetPayload().(type) {
+	case *analyticsv1.AnalyticsEvent_Review:
+		envelope.Review = &ReviewPayload{UserID: payload.Review.GetUserId(), MovieID: payload.Review.GetMovieId()}
+	case *analyticsv1.AnalyticsEvent_Vote:
+		envelope.Vote = &VotePayload{
+			VoterID: payload.Vote.GetVoterId(), UserID: payload.Vote.GetUserId(), MovieID: payload.Vote.GetMovieId(), Vote: payload.Vote.GetVote(),
+		}
+	case *analyticsv1.AnalyticsEvent_Report:
+		envelope.Report = &ReportPayload{
+			ReporterID: payload.Report.GetReporterId(), UserID: payload.Report.GetUserId(), MovieID: payload.Report.GetMovieId(), Reason: payload.Report.GetReason(),
+		}
+	case *analyticsv1.AnalyticsEvent_Progress:
+		envelope.Progress = &ProgressPayload{
+			UserID: payload.Progress.GetUserId(), MovieID: payload.Progress.GetMovieId(),
+			PositionMS: payload.Progress.GetPositionMs(), DurationMS: payload.Progress.GetDurationMs(),
+		}
+	case *analyticsv1.AnalyticsEvent_Bookmark:
+		envelope.Bookmark = &BookmarkPayload{UserID: payload.Bookmark.GetUserId(), MovieID: payload.Bookmark.GetMovieId()}
+	case *analyticsv1.AnalyticsEvent_User:
+		envelope.User = &UserPayload{UserID: payload.User.GetUserId()}
+	}
+
+	return envelope.Event()
+}
+
+// DecodeJSON decodes a JSON message of any supported schema version. Messages
+// without a version predate the envelope and are version 1.
+func DecodeJSON(data []byte) (AnalyticsEvent, error) {
+	var envelope Envelope
+	if err := easyjson.Unmarshal(data, &envelope); err != nil {
+		return AnalyticsEvent{}, err
+	}
+
+	switch envelope.SchemaVersion {
+	case 0, 1:
+		var e AnalyticsEvent
+		if err := easyjson.Unmarshal(data, &e); err != nil {
+			return AnalyticsEvent{}, err
+		}
+		// messages produced before event types were introduced are all creations
+		if e.Event == "" {
+			e.Event = EventReviewCreated
+		}
+		// version 1 producers wrote Unix seconds despite the name, they are stored as milliseconds
+		e.TimestampMS *= 1000
+		return e, nil
+	case 2:
+		return envelope.Event()
+	default:
+		return AnalyticsEvent{}, fmt.Errorf("unsupported schema version %d", envelope.SchemaVersion)
+	}
+}
+
+func (e AnalyticsEvent) IsVote() bool {
+	return e.Event == EventReviewVoted || e.Event == EventReviewVoteRemoved
+}
+
+func (e AnalyticsEvent) IsReport() bool {
+	return e.Event == EventReviewReported
+}
+
+func (e AnalyticsEvent) IsProgress() bool {
+	return e.Event == EventProgressReported
+}
+
+func (e AnalyticsEvent) IsBookmark() bool {
+	return e.Event == EventBookmarkAdded || e.Event == EventBookmarkRemoved
+}
+
+func (e AnalyticsEvent) IsErasure() bool {
+	return e.Event == EventUserErased
 }
 
 type Msg struct {
@@ -17,7 +17,539 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtl(in *jlexer.Lexer, out *AnalyticsEvent) {
+func easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtlModels(in *jlexer.Lexer, out *Envelope) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "event_id":
+			out.EventID = string(in.String())
+		case "event_type":
+			out.EventType = string(in.String())
+		case "schema_version":
+			out.SchemaVersion = int32(in.Int32())
+		case "occurred_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.OccurredAt).UnmarshalJSON(data))
+			}
+		case "review":
+			if in.IsNull() {
+				in.Skip()
+				out.Review = nil
+			} else {
+				if out.Review == nil {
+					out.Review = new(ReviewPayload)
+				}
+				easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtlModels1(in, out.Review)
+			}
+		case "vote":
+			if in.IsNull() {
+				in.Skip()
+				out.Vote = nil
+			} else {
+				if out.Vote == nil {
+					out.Vote = new(VotePayload)
+				}
+				easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtlModels2(in, out.Vote)
+			}
+		case "report":
+			if in.IsNull() {
+				in.Skip()
+				out.Report = nil
+			} else {
+				if out.Report == nil {
+					out.Report = new(ReportPayload)
+				}
+				easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtlModels3(in, out.Report)
+			}
+		case "progress":
+			if in.IsNull() {
+				in.Skip()
+				out.Progress = nil
+			} else {
+				if out.Progress == nil {
+					out.Progress = new(ProgressPayload)
+				}
+				easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtlModels4(in, out.Progress)
+			}
+		case "bookmark":
+			if in.IsNull() {
+				in.Skip()
+				out.Bookmark = nil
+			} else {
+				if out.Bookmark == nil {
+					out.Bookmark = new(BookmarkPayload)
+				}
+				easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtlModels5(in, out.Bookmark)
+			}
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(UserPayload)
+				}
+				easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtlModels6(in, out.User)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComMaisiqGoUgcServiceInternalEtlModels(out *jwriter.Writer, in Envelope) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"event_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.EventID))
+	}
+	{
+		const prefix string = ",\"event_type\":"
+		out.RawString(prefix)
+		out.String(string(in.EventType))
+	}
+	{
+		const prefix string = ",\"schema_version\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.SchemaVersion))
+	}
+	{
+		const prefix string = ",\"occurred_at\":"
+		out.RawString(prefix)
+		out.Raw((in.OccurredAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"review\":"
+		out.RawString(prefix)
+		if in.Review == nil {
+			out.RawString("null")
+		} else {
+			easyjsonD2b7633eEncodeGithubComMaisiqGoUgcServiceInternalEtlModels1(out, *in.Review)
+		}
+	}
+	{
+		const prefix string = ",\"vote\":"
+		out.RawString(prefix)
+		if in.Vote == nil {
+			out.RawString("null")
+		} else {
+			easyjsonD2b7633eEncodeGithubComMaisiqGoUgcServiceInternalEtlModels2(out, *in.Vote)
+		}
+	}
+	{
+		const prefix string = ",\"report\":"
+		out.RawString(prefix)
+		if in.Report == nil {
+			out.RawString("null")
+		} else {
+			easyjsonD2b7633eEncodeGithubComMaisiqGoUgcServiceInternalEtlModels3(out, *in.Report)
+		}
+	}
+	{
+		const prefix string = ",\"progress\":"
+		out.RawString(prefix)
+		if in.Progress == nil {
+			out.RawString("null")
+		} else {
+			easyjsonD2b7633eEncodeGithubComMaisiqGoUgcServiceInternalEtlModels4(out, *in.Progress)
+		}
+	}
+	{
+		const prefix string = ",\"bookmark\":"
+		out.RawString(prefix)
+		if in.Bookmark == nil {
+			out.RawString("null")
+		} else {
+			easyjsonD2b7633eEncodeGithubComMaisiqGoUgcServiceInternalEtlModels5(out, *in.Bookmark)
+		}
+	}
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		if in.User == nil {
+			out.RawString("null")
+		} else {
+			easyjsonD2b7633eEncodeGithubComMaisiqGoUgcServiceInternalEtlModels6(out, *in.User)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Envelope) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComMaisiqGoUgcServiceInternalEtlModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Envelope) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComMaisiqGoUgcServiceInternalEtlModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Envelope) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtlModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Envelope) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtlModels(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtlModels6(in *jlexer.Lexer, out *UserPayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComMaisiqGoUgcServiceInternalEtlModels6(out *jwriter.Writer, in UserPayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UserID))
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtlModels5(in *jlexer.Lexer, out *BookmarkPayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = string(in.String())
+		case "movie_id":
+			out.MovieID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComMaisiqGoUgcServiceInternalEtlModels5(out *jwriter.Writer, in BookmarkPayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"movie_id\":"
+		out.RawString(prefix)
+		out.String(string(in.MovieID))
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtlModels4(in *jlexer.Lexer, out *ProgressPayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = string(in.String())
+		case "movie_id":
+			out.MovieID = string(in.String())
+		case "position_ms":
+			out.PositionMS = int64(in.Int64())
+		case "duration_ms":
+			out.DurationMS = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComMaisiqGoUgcServiceInternalEtlModels4(out *jwriter.Writer, in ProgressPayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"movie_id\":"
+		out.RawString(prefix)
+		out.String(string(in.MovieID))
+	}
+	{
+		const prefix string = ",\"position_ms\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PositionMS))
+	}
+	{
+		const prefix string = ",\"duration_ms\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.DurationMS))
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtlModels3(in *jlexer.Lexer, out *ReportPayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "reporter_id":
+			out.ReporterID = string(in.String())
+		case "user_id":
+			out.UserID = string(in.String())
+		case "movie_id":
+			out.MovieID = string(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComMaisiqGoUgcServiceInternalEtlModels3(out *jwriter.Writer, in ReportPayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"reporter_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ReporterID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"movie_id\":"
+		out.RawString(prefix)
+		out.String(string(in.MovieID))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtlModels2(in *jlexer.Lexer, out *VotePayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "voter_id":
+			out.VoterID = string(in.String())
+		case "user_id":
+			out.UserID = string(in.String())
+		case "movie_id":
+			out.MovieID = string(in.String())
+		case "vote":
+			out.Vote = int32(in.Int32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComMaisiqGoUgcServiceInternalEtlModels2(out *jwriter.Writer, in VotePayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"voter_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.VoterID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"movie_id\":"
+		out.RawString(prefix)
+		out.String(string(in.MovieID))
+	}
+	{
+		const prefix string = ",\"vote\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Vote))
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtlModels1(in *jlexer.Lexer, out *ReviewPayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = string(in.String())
+		case "movie_id":
+			out.MovieID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComMaisiqGoUgcServiceInternalEtlModels1(out *jwriter.Writer, in ReviewPayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"movie_id\":"
+		out.RawString(prefix)
+		out.String(string(in.MovieID))
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtlModels7(in *jlexer.Lexer, out *AnalyticsEvent) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -42,6 +574,20 @@ func easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtl(in *jlexer.Lex
 			out.MovieID = string(in.String())
 		case "timestamp_ms":
 			out.TimestampMS = int64(in.Int64())
+		case "event":
+			out.Event = string(in.String())
+		case "voter_id":
+			out.VoterID = string(in.String())
+		case "vote":
+			out.Vote = int32(in.Int32())
+		case "reporter_id":
+			out.ReporterID = string(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		case "position_ms":
+			out.PositionMS = int64(in.Int64())
+		case "duration_ms":
+			out.DurationMS = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -52,7 +598,7 @@ func easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtl(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComMaisiqGoUgcServiceInternalEtl(out *jwriter.Writer, in AnalyticsEvent) {
+func easyjsonD2b7633eEncodeGithubComMaisiqGoUgcServiceInternalEtlModels7(out *jwriter.Writer, in AnalyticsEvent) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -71,29 +617,64 @@ func easyjsonD2b7633eEncodeGithubComMaisiqGoUgcServiceInternalEtl(out *jwriter.W
 		out.RawString(prefix)
 		out.Int64(int64(in.TimestampMS))
 	}
+	{
+		const prefix string = ",\"event\":"
+		out.RawString(prefix)
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"voter_id\":"
+		out.RawString(prefix)
+		out.String(string(in.VoterID))
+	}
+	{
+		const prefix string = ",\"vote\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Vote))
+	}
+	{
+		const prefix string = ",\"reporter_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ReporterID))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	{
+		const prefix string = ",\"position_ms\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PositionMS))
+	}
+	{
+		const prefix string = ",\"duration_ms\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.DurationMS))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v AnalyticsEvent) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComMaisiqGoUgcServiceInternalEtl(&w, v)
+	easyjsonD2b7633eEncodeGithubComMaisiqGoUgcServiceInternalEtlModels7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AnalyticsEvent) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComMaisiqGoUgcServiceInternalEtl(w, v)
+	easyjsonD2b7633eEncodeGithubComMaisiqGoUgcServiceInternalEtlModels7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AnalyticsEvent) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtl(&r, v)
+	easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtlModels7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AnalyticsEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtl(l, v)
+	easyjsonD2b7633eDecodeGithubComMaisiqGoUgcServiceInternalEtlModels7(l, v)
 }
@@ -0,0 +1,96 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	analyticsv1 "github.com/maisiq/go-ugc-service/pkg/pb/analytics/v1"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDecodeMessage(t *testing.T) {
+	occurredAt := time.UnixMilli(1767323045006)
+
+	protoHeaders := func(version string) []kafka.Header {
+		return []kafka.Header{
+			{Key: HeaderContentType, Value: []byte(ContentTypeProtobuf)},
+			{Key: HeaderSchemaVersion, Value: []byte(version)},
+		}
+	}
+
+	value, err := proto.Marshal(&analyticsv1.AnalyticsEvent{
+		EventId:       "id",
+		EventType:     EventProgressReported,
+		SchemaVersion: 2,
+		OccurredAt:    timestamppb.New(occurredAt),
+		Payload: &analyticsv1.AnalyticsEvent_Progress{Progress: &analyticsv1.ProgressPayload{
+			UserId: "u", MovieId: "m", PositionMs: 1000, DurationMs: 5000,
+		}},
+	})
+	require.NoError(t, err)
+
+	t.Run("Protobuf message is decoded by its schema version", func(t *testing.T) {
+		e, err := DecodeMessage(kafka.Message{Value: value, Headers: protoHeaders("2")})
+
+		require.NoError(t, err)
+		require.Equal(t, AnalyticsEvent{
+			UserID: "u", MovieID: "m", PositionMS: 1000, DurationMS: 5000, Event: EventProgressReported, TimestampMS: occurredAt.UnixMilli(),
+		}, e)
+	})
+
+	t.Run("Protobuf message of an unknown schema version fails", func(t *testing.T) {
+		_, err := DecodeMessage(kafka.Message{Value: value, Headers: protoHeaders("3")})
+
+		require.Error(t, err)
+	})
+
+	t.Run("Message without headers is legacy JSON", func(t *testing.T) {
+		e, err := DecodeMessage(kafka.Message{Value: []byte(`{"user_id":"u","movie_id":"m","event":"review_deleted"}`)})
+
+		require.NoError(t, err)
+		require.Equal(t, EventReviewDeleted, e.Event)
+	})
+
+	t.Run("Unknown content type fails", func(t *testing.T) {
+		_, err := DecodeMessage(kafka.Message{Value: value, Headers: []kafka.Header{{Key: HeaderContentType, Value: []byte("text/plain")}}})
+
+		require.Error(t, err)
+	})
+}
+
+func TestDecodeJSON(t *testing.T) {
+	t.Run("Version 1 message without event is a creation with its seconds turned into milliseconds", func(t *testing.T) {
+		e, err := DecodeJSON([]byte(`{"user_id":"u","movie_id":"m","timestamp_ms":10}`))
+
+		require.NoError(t, err)
+		require.Equal(t, AnalyticsEvent{UserID: "u", MovieID: "m", TimestampMS: 10000, Event: EventReviewCreated}, e)
+	})
+
+	t.Run("Version 2 message is flattened", func(t *testing.T) {
+		e, err := DecodeJSON([]byte(`{
+			"event_id":"id","event_type":"review_voted","schema_version":2,"occurred_at":"2026-01-02T03:04:05.006Z",
+			"vote":{"voter_id":"v","user_id":"u","movie_id":"m","vote":-1}
+		}`))
+
+		require.NoError(t, err)
+		require.Equal(t, AnalyticsEvent{
+			VoterID: "v", UserID: "u", MovieID: "m", Vote: -1, Event: EventReviewVoted, TimestampMS: 1767323045006,
+		}, e)
+		require.True(t, e.IsVote())
+	})
+
+	t.Run("Version 2 message without payload fails", func(t *testing.T) {
+		_, err := DecodeJSON([]byte(`{"event_id":"id","event_type":"review_created","schema_version":2}`))
+
+		require.Error(t, err)
+	})
+
+	t.Run("Unknown version fails", func(t *testing.T) {
+		_, err := DecodeJSON([]byte(`{"schema_version":3}`))
+
+		require.Error(t, err)
+	})
+}
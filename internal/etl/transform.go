@@ -1,7 +1,6 @@
 package etl
 
 import (
-	"github.com/mailru/easyjson"
 	"github.com/maisiq/go-ugc-service/internal/etl/models"
 )
 
@@ -14,8 +13,8 @@ func (r *ETLRunner) transform(in <-chan models.Msg) <-chan models.Msg {
 				out <- msg
 				continue
 			}
-			var e models.AnalyticsEvent
-			if err := easyjson.Unmarshal(msg.KafkaMsg.Value, &e); err != nil {
+			e, err := models.DecodeMessage(msg.KafkaMsg)
+			if err != nil {
 				msg.Err = err
 			} else {
 				msg.Event = e
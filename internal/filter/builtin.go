@@ -0,0 +1,142 @@
+package filter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/maisiq/go-ugc-service/pkg/config"
+)
+
+// LengthFilter limits the number of characters in a review.
+type LengthFilter struct {
+	action    Decision
+	minLength int
+	maxLength int
+}
+
+func newLengthFilter(cfg config.ContentFilterConfig) (*LengthFilter, error) {
+	action, err := decision(cfg.Action, Reject)
+	if err != nil {
+		return nil, err
+	}
+	return &LengthFilter{action: action, minLength: cfg.MinLength, maxLength: cfg.MaxLength}, nil
+}
+
+func (f *LengthFilter) Check(text string) Verdict {
+	n := utf8.RuneCountInString(text)
+
+	if n < f.minLength {
+		return Verdict{Decision: f.action, Reason: fmt.Sprintf("review is shorter than %d characters", f.minLength)}
+	}
+	if f.maxLength > 0 && n > f.maxLength {
+		return Verdict{Decision: f.action, Reason: fmt.Sprintf("review is longer than %d characters", f.maxLength)}
+	}
+	return Verdict{Decision: Allow}
+}
+
+// ProfanityFilter looks for whole words from the configured list, ignoring case.
+type ProfanityFilter struct {
+	action Decision
+	words  map[string]struct{}
+}
+
+func newProfanityFilter(cfg config.ContentFilterConfig) (*ProfanityFilter, error) {
+	action, err := decision(cfg.Action, Moderate)
+	if err != nil {
+		return nil, err
+	}
+
+	words := make(map[string]struct{}, len(cfg.Words))
+	for _, w := range cfg.Words {
+		words[strings.ToLower(w)] = struct{}{}
+	}
+	return &ProfanityFilter{action: action, words: words}, nil
+}
+
+func (f *ProfanityFilter) Check(text string) Verdict {
+	tokens := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for _, t := range tokens {
+		if _, ok := f.words[t]; ok {
+			return Verdict{Decision: f.action, Reason: "review contains profanity"}
+		}
+	}
+	return Verdict{Decision: Allow}
+}
+
+var linkRe = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+`)
+
+// LinkFilter treats reviews with too many links as spam.
+type LinkFilter struct {
+	action   Decision
+	maxLinks int
+}
+
+func newLinkFilter(cfg config.ContentFilterConfig) (*LinkFilter, error) {
+	action, err := decision(cfg.Action, Moderate)
+	if err != nil {
+		return nil, err
+	}
+	return &LinkFilter{action: action, maxLinks: cfg.MaxLinks}, nil
+}
+
+func (f *LinkFilter) Check(text string) Verdict {
+	if n := len(linkRe.FindAllStringIndex(text, -1)); n > f.maxLinks {
+		return Verdict{Decision: f.action, Reason: fmt.Sprintf("review contains more than %d links", f.maxLinks)}
+	}
+	return Verdict{Decision: Allow}
+}
+
+// shoutingMinLetters keeps short reviews like "WOW" from counting as shouting.
+const shoutingMinLetters = 20
+
+// ShoutingFilter detects long runs of one character and mostly upper case text.
+type ShoutingFilter struct {
+	action        Decision
+	maxRepeated   int
+	maxUpperRatio float64
+}
+
+func newShoutingFilter(cfg config.ContentFilterConfig) (*ShoutingFilter, error) {
+	action, err := decision(cfg.Action, Moderate)
+	if err != nil {
+		return nil, err
+	}
+	return &ShoutingFilter{action: action, maxRepeated: cfg.MaxRepeatedChars, maxUpperRatio: cfg.MaxUpperRatio}, nil
+}
+
+func (f *ShoutingFilter) Check(text string) Verdict {
+	var (
+		prev           rune
+		run            int
+		letters, upper int
+	)
+
+	for _, r := range text {
+		if r == prev {
+			run++
+		} else {
+			prev, run = r, 1
+		}
+		if f.maxRepeated > 0 && run > f.maxRepeated && !unicode.IsSpace(r) {
+			return Verdict{Decision: f.action, Reason: fmt.Sprintf("review repeats a character more than %d times", f.maxRepeated)}
+		}
+
+		if unicode.IsLetter(r) {
+			letters++
+			if unicode.IsUpper(r) {
+				upper++
+			}
+		}
+	}
+
+	if f.maxUpperRatio > 0 && letters >= shoutingMinLetters && float64(upper)/float64(letters) > f.maxUpperRatio {
+		return Verdict{Decision: f.action, Reason: "review is mostly written in capitals"}
+	}
+	return Verdict{Decision: Allow}
+}
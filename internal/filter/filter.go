@@ -0,0 +1,99 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/maisiq/go-ugc-service/pkg/config"
+)
+
+type Decision int
+
+const (
+	Allow Decision = iota
+	// Moderate lets the review through but holds it as pending until a moderator approves it.
+	Moderate
+	// Reject refuses the write altogether.
+	Reject
+)
+
+// Verdict is the outcome of a filter check. Reason explains anything but Allow.
+type Verdict struct {
+	Decision Decision
+	Reason   string
+}
+
+// ContentFilter checks the text of a review before it is written.
+type ContentFilter interface {
+	Check(text string) Verdict
+}
+
+// Chain runs filters in order. The first rejection wins, otherwise the reasons
+// of every filter that asked for moderation are joined.
+type Chain []ContentFilter
+
+func (c Chain) Check(text string) Verdict {
+	var reasons []string
+
+	for _, f := range c {
+		verdict := f.Check(text)
+
+		switch verdict.Decision {
+		case Reject:
+			return verdict
+		case Moderate:
+			reasons = append(reasons, verdict.Reason)
+		}
+	}
+
+	if len(reasons) > 0 {
+		return Verdict{Decision: Moderate, Reason: strings.Join(reasons, "; ")}
+	}
+	return Verdict{Decision: Allow}
+}
+
+// NewChain builds the filters listed in the config in the same order.
+func NewChain(cfg []config.ContentFilterConfig) (Chain, error) {
+	chain := make(Chain, 0, len(cfg))
+
+	for _, fc := range cfg {
+		var (
+			f   ContentFilter
+			err error
+		)
+
+		switch fc.Name {
+		case "length":
+			f, err = newLengthFilter(fc)
+		case "profanity":
+			f, err = newProfanityFilter(fc)
+		case "links":
+			f, err = newLinkFilter(fc)
+		case "shouting":
+			f, err = newShoutingFilter(fc)
+		default:
+			err = fmt.Errorf("unknown content filter %q", fc.Name)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, f)
+	}
+
+	return chain, nil
+}
+
+// decision parses the configured action, falling back to def when it is not set.
+func decision(action string, def Decision) (Decision, error) {
+	switch action {
+	case "":
+		return def, nil
+	case "moderate":
+		return Moderate, nil
+	case "reject":
+		return Reject, nil
+	default:
+		return Allow, fmt.Errorf("unknown content filter action %q", action)
+	}
+}
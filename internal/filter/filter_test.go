@@ -0,0 +1,60 @@
+package filter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/maisiq/go-ugc-service/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChain(t *testing.T) {
+	chain, err := NewChain([]config.ContentFilterConfig{
+		{Name: "length", MaxLength: 100},
+		{Name: "profanity", Words: []string{"Darn"}},
+		{Name: "links", MaxLinks: 1},
+		{Name: "shouting", MaxRepeatedChars: 4, MaxUpperRatio: 0.7},
+	})
+	require.NoError(t, err)
+
+	t.Run("Clean review is allowed", func(t *testing.T) {
+		require.Equal(t, Allow, chain.Check("A quiet film, see https://example.com for the trailer.").Decision)
+	})
+
+	t.Run("Long review is rejected", func(t *testing.T) {
+		verdict := chain.Check(strings.Repeat("a", 101))
+
+		require.Equal(t, Reject, verdict.Decision)
+		require.NotEmpty(t, verdict.Reason)
+	})
+
+	t.Run("Profanity is matched as a whole word ignoring case", func(t *testing.T) {
+		require.Equal(t, Moderate, chain.Check("DARN it").Decision)
+		require.Equal(t, Allow, chain.Check("darning socks").Decision)
+	})
+
+	t.Run("Too many links are held for moderation", func(t *testing.T) {
+		require.Equal(t, Moderate, chain.Check("http://a.example www.b.example").Decision)
+	})
+
+	t.Run("Shouting is held for moderation", func(t *testing.T) {
+		require.Equal(t, Moderate, chain.Check("soooooo good").Decision)
+		require.Equal(t, Moderate, chain.Check("THIS IS THE BEST MOVIE EVER MADE").Decision)
+		require.Equal(t, Allow, chain.Check("WOW").Decision)
+	})
+
+	t.Run("Reasons of every moderating filter are joined", func(t *testing.T) {
+		verdict := chain.Check("darn!!!!!!")
+
+		require.Equal(t, Moderate, verdict.Decision)
+		require.Len(t, strings.Split(verdict.Reason, "; "), 2)
+	})
+
+	t.Run("Unknown filter or action is a config error", func(t *testing.T) {
+		_, err := NewChain([]config.ContentFilterConfig{{Name: "captcha"}})
+		require.Error(t, err)
+
+		_, err = NewChain([]config.ContentFilterConfig{{Name: "links", Action: "ban"}})
+		require.Error(t, err)
+	})
+}
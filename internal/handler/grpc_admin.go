@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/mapper"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	ugcv1pb "github.com/maisiq/go-ugc-service/pkg/pb/ugcservice/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type AdminServiceServer struct {
+	ugcv1pb.UnimplementedAdminServiceServer
+	moderation *service.ModerationService
+	erasures   *service.ErasureService
+	exports    *service.ExportService
+}
+
+func NewAdminServer(
+	moderation *service.ModerationService,
+	erasures *service.ErasureService,
+	exports *service.ExportService,
+) *AdminServiceServer {
+	return &AdminServiceServer{
+		moderation: moderation,
+		erasures:   erasures,
+		exports:    exports,
+	}
+}
+
+func (s *AdminServiceServer) ListPendingReviews(ctx context.Context, req *ugcv1pb.ListPendingReviewsRequest) (*ugcv1pb.ListPendingReviewsResponse, error) {
+	reviews, nextPageToken, err := s.moderation.ListPendingReviews(ctx, req.GetPageSize(), req.GetPageToken())
+
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrInvalidArgument):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return mapper.FromPendingReviewsToPb(reviews, nextPageToken), nil
+}
+
+func (s *AdminServiceServer) ApproveReview(ctx context.Context, req *ugcv1pb.ApproveReviewRequest) (*emptypb.Empty, error) {
+	err := s.moderation.ApproveReview(ctx, req.GetUserId(), req.GetMovieId())
+
+	if err != nil {
+		return nil, moderationError(err, "approved")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AdminServiceServer) RejectReview(ctx context.Context, req *ugcv1pb.RejectReviewRequest) (*emptypb.Empty, error) {
+	err := s.moderation.RejectReview(ctx, req.GetUserId(), req.GetMovieId(), req.GetReason())
+
+	if err != nil {
+		return nil, moderationError(err, "rejected")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AdminServiceServer) RestoreReview(ctx context.Context, req *ugcv1pb.RestoreReviewRequest) (*emptypb.Empty, error) {
+	err := s.moderation.RestoreReview(ctx, req.GetUserId(), req.GetMovieId())
+
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "could not find a deleted review with this params")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AdminServiceServer) ListReviewRevisions(ctx context.Context, req *ugcv1pb.ListReviewRevisionsRequest) (*ugcv1pb.ListReviewRevisionsResponse, error) {
+	revisions, nextPageToken, err := s.moderation.ListReviewRevisions(ctx, req.GetUserId(), req.GetMovieId(), req.GetPageSize(), req.GetPageToken())
+
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrInvalidArgument):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return mapper.FromRevisionsToPb(revisions, nextPageToken), nil
+}
+
+func moderationError(err error, action string) error {
+	switch {
+	case errors.Is(err, apperrors.ErrNotFound):
+		return status.Errorf(codes.NotFound, "could not find the review with this params")
+	case errors.Is(err, apperrors.ErrFailedPrecondition):
+		return status.Errorf(codes.FailedPrecondition, "the review can not be %v in its current status", action)
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func (s *AdminServiceServer) EraseUser(ctx context.Context, req *ugcv1pb.EraseUserRequest) (*emptypb.Empty, error) {
+	if err := s.erasures.EraseUser(ctx, req.GetUserId()); err != nil {
+		return nil, status.Error(codes.Internal, "erasure is incomplete, retry to resume it")
+	}
+
+	return &emptypb.Empty{}, nil
+}
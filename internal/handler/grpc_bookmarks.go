@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/mapper"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	ugcv1pb "github.com/maisiq/go-ugc-service/pkg/pb/ugcservice/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type BookmarkServiceServer struct {
+	ugcv1pb.UnimplementedBookmarkServiceServer
+	bookmarks *service.BookmarkService
+}
+
+func NewBookmarkServer(bookmarks *service.BookmarkService) *BookmarkServiceServer {
+	return &BookmarkServiceServer{bookmarks: bookmarks}
+}
+
+func (s *BookmarkServiceServer) AddBookmark(ctx context.Context, req *ugcv1pb.AddBookmarkRequest) (*emptypb.Empty, error) {
+	err := s.bookmarks.AddBookmark(ctx, req.GetUserId(), req.GetMovieId())
+
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrAlreadyExists):
+			return nil, status.Errorf(codes.AlreadyExists, "the movie is bookmarked already")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *BookmarkServiceServer) RemoveBookmark(ctx context.Context, req *ugcv1pb.RemoveBookmarkRequest) (*emptypb.Empty, error) {
+	err := s.bookmarks.RemoveBookmark(ctx, req.GetUserId(), req.GetMovieId())
+
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "the movie is not bookmarked")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *BookmarkServiceServer) ListBookmarks(ctx context.Context, req *ugcv1pb.ListBookmarksRequest) (*ugcv1pb.ListBookmarksResponse, error) {
+	bookmarks, nextPageToken, err := s.bookmarks.ListBookmarks(ctx, req.GetUserId(), req.GetPageSize(), req.GetPageToken())
+
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrInvalidArgument):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return mapper.FromBookmarksToPb(bookmarks, nextPageToken), nil
+}
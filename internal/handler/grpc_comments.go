@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/mapper"
+	ugcv1pb "github.com/maisiq/go-ugc-service/pkg/pb/ugcservice/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *UGCServiceServer) AddComment(ctx context.Context, req *ugcv1pb.AddCommentRequest) (*ugcv1pb.Comment, error) {
+	comment, err := s.comments.AddComment(
+		ctx, req.GetReviewUserId(), req.GetMovieId(), req.GetAuthorId(), req.GetParentId(), req.GetText(),
+	)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrInvalidArgument):
+			return nil, status.Errorf(codes.InvalidArgument, "replies can only be added to top level comments of the same review")
+		case errors.Is(err, apperrors.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "could not find the review or the parent comment")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return mapper.FromCommentToPb(comment), nil
+}
+
+func (s *UGCServiceServer) ListComments(ctx context.Context, req *ugcv1pb.ListCommentsRequest) (*ugcv1pb.ListCommentsResponse, error) {
+	comments, nextPageToken, err := s.comments.ListComments(
+		ctx, req.GetReviewUserId(), req.GetMovieId(), req.GetPageSize(), req.GetPageToken(),
+	)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrInvalidArgument):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return mapper.FromCommentsToPb(comments, nextPageToken), nil
+}
+
+func (s *UGCServiceServer) EditComment(ctx context.Context, req *ugcv1pb.EditCommentRequest) (*ugcv1pb.Comment, error) {
+	comment, err := s.comments.EditComment(ctx, req.GetCommentId(), req.GetAuthorId(), req.GetText())
+
+	if err != nil {
+		return nil, commentError(err)
+	}
+
+	return mapper.FromCommentToPb(comment), nil
+}
+
+func (s *UGCServiceServer) DeleteComment(ctx context.Context, req *ugcv1pb.DeleteCommentRequest) (*emptypb.Empty, error) {
+	var empty emptypb.Empty
+
+	err := s.comments.DeleteComment(ctx, req.GetCommentId(), req.GetAuthorId())
+
+	if err != nil {
+		return nil, commentError(err)
+	}
+
+	return &empty, nil
+}
+
+func commentError(err error) error {
+	switch {
+	case errors.Is(err, apperrors.ErrNotFound):
+		return status.Errorf(codes.NotFound, "could not find the comment")
+	case errors.Is(err, apperrors.ErrPermissionDenied):
+		return status.Errorf(codes.PermissionDenied, "only the author can change the comment")
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
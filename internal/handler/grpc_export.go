@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"errors"
+
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/mapper"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	ugcv1pb "github.com/maisiq/go-ugc-service/pkg/pb/ugcservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *AdminServiceServer) ExportUserData(req *ugcv1pb.ExportUserDataRequest, stream grpc.ServerStreamingServer[ugcv1pb.UserDataRecord]) error {
+	err := s.exports.ExportUserData(stream.Context(), req.GetUserId(), func(record service.UserDataRecord) error {
+		return stream.Send(mapper.FromUserDataRecordToPb(record))
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrInternal):
+			return status.Error(codes.Internal, "internal error")
+		default:
+			// the client went away, the error already carries the stream status
+			return err
+		}
+	}
+
+	return nil
+}
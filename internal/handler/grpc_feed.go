@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"errors"
+
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/mapper"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	ugcv1pb "github.com/maisiq/go-ugc-service/pkg/pb/ugcservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *UGCServiceServer) WatchMovieReviews(req *ugcv1pb.WatchMovieReviewsRequest, stream grpc.ServerStreamingServer[ugcv1pb.ReviewEvent]) error {
+	err := s.feed.WatchMovieReviews(stream.Context(), req.GetMovieId(), req.GetResumeToken(), func(event repository.ReviewEvent) error {
+		return stream.Send(mapper.FromReviewEventToPb(event))
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrInvalidArgument):
+			return status.Errorf(codes.InvalidArgument, "invalid resume token")
+		case errors.Is(err, apperrors.ErrInternal):
+			return status.Error(codes.Internal, "internal error")
+		default:
+			// the client went away, the error already carries the stream status
+			return err
+		}
+	}
+
+	return nil
+}
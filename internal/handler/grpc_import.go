@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"github.com/maisiq/go-ugc-service/internal/mapper"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	ugcv1pb "github.com/maisiq/go-ugc-service/pkg/pb/ugcservice/v1"
+	"google.golang.org/grpc"
+)
+
+func (s *UGCServiceServer) ImportReviews(stream grpc.ClientStreamingServer[ugcv1pb.ImportReviewsRequest, ugcv1pb.ImportReviewsResponse]) error {
+	report, err := s.imports.ImportReviews(stream.Context(), func() (service.ImportItem, error) {
+		req, err := stream.Recv()
+		if err != nil {
+			return service.ImportItem{}, err
+		}
+		return mapper.FromPbToImportItem(req), nil
+	})
+
+	if err != nil {
+		// the stream broke, the error already carries its status
+		return err
+	}
+
+	return stream.SendAndClose(mapper.FromImportReportToPb(report))
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/mapper"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	ugcv1pb "github.com/maisiq/go-ugc-service/pkg/pb/ugcservice/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type ProgressServiceServer struct {
+	ugcv1pb.UnimplementedProgressServiceServer
+	progress *service.ProgressService
+}
+
+func NewProgressServer(progress *service.ProgressService) *ProgressServiceServer {
+	return &ProgressServiceServer{progress: progress}
+}
+
+func (s *ProgressServiceServer) ReportProgress(ctx context.Context, req *ugcv1pb.ReportProgressRequest) (*emptypb.Empty, error) {
+	err := s.progress.ReportProgress(ctx, req.GetUserId(), req.GetMovieId(), req.GetPositionMs(), req.GetDurationMs())
+
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrInvalidArgument):
+			return nil, status.Errorf(codes.InvalidArgument, "position can't be past the duration")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ProgressServiceServer) GetProgress(ctx context.Context, req *ugcv1pb.GetProgressRequest) (*ugcv1pb.PlaybackProgress, error) {
+	progress, err := s.progress.GetProgress(ctx, req.GetUserId(), req.GetMovieId())
+
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "the user has not watched this movie")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return mapper.FromProgressToPb(progress), nil
+}
+
+func (s *ProgressServiceServer) ListInProgress(ctx context.Context, req *ugcv1pb.ListInProgressRequest) (*ugcv1pb.ListInProgressResponse, error) {
+	progress, err := s.progress.ListInProgress(ctx, req.GetUserId())
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return mapper.FromInProgressToPb(progress), nil
+}
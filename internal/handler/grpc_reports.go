@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/mapper"
+	ugcv1pb "github.com/maisiq/go-ugc-service/pkg/pb/ugcservice/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *UGCServiceServer) ReportReview(ctx context.Context, req *ugcv1pb.ReportReviewRequest) (*emptypb.Empty, error) {
+	var empty emptypb.Empty
+
+	err := s.reports.ReportReview(
+		ctx, req.GetReporterId(), req.GetUserId(), req.GetMovieId(), mapper.FromPbToReportReason(req.GetReason()), req.GetText(),
+	)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrInvalidArgument):
+			return nil, status.Errorf(codes.InvalidArgument, "users cannot report their own reviews")
+		case errors.Is(err, apperrors.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "could not find the review with this params")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &empty, nil
+}
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/mapper"
+	ugcv1pb "github.com/maisiq/go-ugc-service/pkg/pb/ugcservice/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *UGCServiceServer) SearchReviews(ctx context.Context, req *ugcv1pb.SearchReviewsRequest) (*ugcv1pb.SearchReviewsResponse, error) {
+	hits, nextPageToken, err := s.search.SearchReviews(
+		ctx, req.GetQuery(), req.GetUserId(), req.GetMovieId(), req.GetPageSize(), req.GetPageToken(),
+	)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrInvalidArgument):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return mapper.FromSearchHitsToPb(hits, nextPageToken), nil
+}
@@ -2,13 +2,16 @@ package handler
 
 import (
 	"context"
+	"strconv"
 
 	"errors"
 
 	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
 	"github.com/maisiq/go-ugc-service/internal/mapper"
+	"github.com/maisiq/go-ugc-service/internal/repository"
 	"github.com/maisiq/go-ugc-service/internal/service"
 	ugcv1pb "github.com/maisiq/go-ugc-service/pkg/pb/ugcservice/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -16,24 +19,46 @@ import (
 
 type UGCServiceServer struct {
 	ugcv1pb.UnimplementedUGCServiceServer
-	service *service.UGCService
+	service  *service.UGCService
+	votes    *service.VoteService
+	comments *service.CommentService
+	reports  *service.ReportService
+	search   *service.SearchService
+	feed     *service.FeedService
+	imports  *service.ImportService
 }
 
-func NewServer(service *service.UGCService) *UGCServiceServer {
+func NewServer(
+	service *service.UGCService,
+	votes *service.VoteService,
+	comments *service.CommentService,
+	reports *service.ReportService,
+	search *service.SearchService,
+	feed *service.FeedService,
+	imports *service.ImportService,
+) *UGCServiceServer {
 	return &UGCServiceServer{
-		service: service,
+		service:  service,
+		votes:    votes,
+		comments: comments,
+		reports:  reports,
+		search:   search,
+		feed:     feed,
+		imports:  imports,
 	}
 }
 
 func (s *UGCServiceServer) CreateReview(ctx context.Context, req *ugcv1pb.CreateReviewRequest) (*emptypb.Empty, error) {
 	var empty emptypb.Empty
 
-	err := s.service.CreateReview(ctx, req.Review.UserId, req.Review.MovieId, req.Review.Text)
+	err := s.service.CreateReview(ctx, req.Review.UserId, req.Review.MovieId, req.Review.Text, req.Review.Rating)
 
 	if err != nil {
 		switch {
 		case errors.Is(err, apperrors.ErrAlreadyExists):
 			return nil, status.Errorf(codes.AlreadyExists, "this review already exists")
+		case errors.Is(err, apperrors.ErrContentRejected):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		default:
 			return nil, status.Errorf(codes.Internal, "internal error")
 		}
@@ -43,28 +68,68 @@ func (s *UGCServiceServer) CreateReview(ctx context.Context, req *ugcv1pb.Create
 }
 
 func (s *UGCServiceServer) GetReviews(ctx context.Context, req *ugcv1pb.GetReviewsRequest) (*ugcv1pb.GetReviewsResponse, error) {
-	reviews, err := s.service.GetReviews(ctx, req.GetUserId(), req.GetMovieId())
+	reviews, nextPageToken, err := s.service.GetReviews(
+		ctx, req.GetUserId(), req.GetMovieId(), req.GetViewerId(), mapper.FromPbToReviewSort(req.GetSort()), req.GetPageSize(), req.GetPageToken(),
+	)
 
 	if err != nil {
 		switch {
+		case errors.Is(err, apperrors.ErrInvalidArgument):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token")
 		case errors.Is(err, apperrors.ErrNotFound):
 			return nil, status.Errorf(codes.NotFound, "not found")
 		default:
 			return nil, status.Errorf(codes.Internal, "internal error")
 		}
 	}
-	return mapper.FromReviewToPb(reviews), nil
+
+	response := mapper.FromReviewToPb(reviews)
+	response.NextPageToken = nextPageToken
+
+	return response, nil
+}
+
+func (s *UGCServiceServer) GetReview(ctx context.Context, req *ugcv1pb.GetReviewRequest) (*ugcv1pb.GetReviewResponse, error) {
+	review, err := s.service.GetReview(ctx, req.GetUserId(), req.GetMovieId(), req.GetViewerId())
+
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "review not found")
+		default:
+			return nil, status.Errorf(codes.Internal, "internal error")
+		}
+	}
+
+	return mapper.FromSingleReviewToPb(review), nil
 }
 
 func (s *UGCServiceServer) UpdateReview(ctx context.Context, req *ugcv1pb.UpdateReviewRequest) (*emptypb.Empty, error) {
 	var empty emptypb.Empty
 
-	err := s.service.UpdateReview(ctx, req.Review.GetUserId(), req.Review.GetMovieId(), req.Review.GetText())
+	fields, err := mapper.FromPbToReviewFields(req.GetUpdateMask())
+	if err != nil {
+		return &empty, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := validateReviewUpdate(req.GetReview(), fields); err != nil {
+		return &empty, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.service.UpdateReview(
+		ctx, req.Review.GetUserId(), req.Review.GetMovieId(), req.Review.GetText(), req.Review.GetRating(), req.Review.GetVersion(), fields,
+	)
 
 	if err != nil {
+		var conflict *apperrors.VersionConflictError
+
 		switch {
+		case errors.As(err, &conflict):
+			return &empty, versionConflictStatus(conflict)
 		case errors.Is(err, apperrors.ErrNotFound):
 			return &empty, status.Errorf(codes.NotFound, "could not find the review with this params")
+		case errors.Is(err, apperrors.ErrContentRejected):
+			return &empty, status.Error(codes.InvalidArgument, err.Error())
 		default:
 			return &empty, status.Error(codes.Internal, "internal error")
 		}
@@ -72,3 +137,89 @@ func (s *UGCServiceServer) UpdateReview(ctx context.Context, req *ugcv1pb.Update
 
 	return &empty, nil
 }
+
+func (s *UGCServiceServer) DeleteReview(ctx context.Context, req *ugcv1pb.DeleteReviewRequest) (*emptypb.Empty, error) {
+	var empty emptypb.Empty
+
+	err := s.service.DeleteReview(ctx, req.GetUserId(), req.GetMovieId(), req.GetPermanent())
+
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "could not find the review with this params")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &empty, nil
+}
+
+func (s *UGCServiceServer) GetMovieRating(ctx context.Context, req *ugcv1pb.GetMovieRatingRequest) (*ugcv1pb.GetMovieRatingResponse, error) {
+	rating, err := s.service.GetMovieRating(ctx, req.GetMovieId())
+
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "movie has no reviews")
+		default:
+			return nil, status.Errorf(codes.Internal, "internal error")
+		}
+	}
+	return mapper.FromMovieRatingToPb(rating), nil
+}
+
+func (s *UGCServiceServer) BatchGetMovieReviewSummaries(ctx context.Context, req *ugcv1pb.BatchGetMovieReviewSummariesRequest) (*ugcv1pb.BatchGetMovieReviewSummariesResponse, error) {
+	summaries, err := s.service.BatchGetMovieReviewSummaries(ctx, req.GetMovieIds())
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+	return mapper.FromReviewSummariesToPb(summaries), nil
+}
+
+// validateReviewUpdate checks the review identity and the updated fields only,
+// the rest of the review may be left unset by partial updates.
+func validateReviewUpdate(review *ugcv1pb.Review, fields []repository.ReviewField) error {
+	checked := map[string]bool{"UserId": true, "MovieId": true}
+
+	if len(fields) == 0 {
+		fields = repository.EditableFields
+	}
+	for _, field := range fields {
+		switch field {
+		case repository.FieldText:
+			checked["Text"] = true
+		case repository.FieldRating:
+			checked["Rating"] = true
+		}
+	}
+
+	var multi ugcv1pb.ReviewMultiError
+	if !errors.As(review.ValidateAll(), &multi) {
+		return nil
+	}
+
+	for _, err := range multi {
+		var fieldErr ugcv1pb.ReviewValidationError
+		if errors.As(err, &fieldErr) && checked[fieldErr.Field()] {
+			return fieldErr
+		}
+	}
+	return nil
+}
+
+// versionConflictStatus reports the current version both in the message and
+// as error details, so clients don't have to parse the message.
+func versionConflictStatus(conflict *apperrors.VersionConflictError) error {
+	st := status.New(codes.Aborted, conflict.Error())
+
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   "VERSION_CONFLICT",
+		Metadata: map[string]string{"current_version": strconv.FormatInt(conflict.Current, 10)},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,26 @@
+package mapper
+
+import (
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	ugcv1pb "github.com/maisiq/go-ugc-service/pkg/pb/ugcservice/v1"
+)
+
+func FromBookmarkToPb(bookmark repository.Bookmark) *ugcv1pb.Bookmark {
+	return &ugcv1pb.Bookmark{
+		MovieId:   bookmark.MovieID,
+		CreatedAt: toTimestampPb(bookmark.CreatedAt),
+	}
+}
+
+func FromBookmarksToPb(bookmarks []repository.Bookmark, nextPageToken string) *ugcv1pb.ListBookmarksResponse {
+	var bookmarksPb []*ugcv1pb.Bookmark
+
+	for _, bookmark := range bookmarks {
+		bookmarksPb = append(bookmarksPb, FromBookmarkToPb(bookmark))
+	}
+
+	return &ugcv1pb.ListBookmarksResponse{
+		Bookmarks:     bookmarksPb,
+		NextPageToken: nextPageToken,
+	}
+}
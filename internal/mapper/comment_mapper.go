@@ -0,0 +1,38 @@
+package mapper
+
+import (
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	ugcv1pb "github.com/maisiq/go-ugc-service/pkg/pb/ugcservice/v1"
+)
+
+func FromCommentToPb(comment repository.Comment) *ugcv1pb.Comment {
+	commentPb := &ugcv1pb.Comment{
+		Id:           comment.ID,
+		ReviewUserId: comment.ReviewUserID,
+		MovieId:      comment.MovieID,
+		AuthorId:     comment.AuthorID,
+		ParentId:     comment.ParentID,
+		Text:         comment.Text,
+		CreatedAt:    toTimestampPb(comment.CreatedAt),
+		UpdatedAt:    toTimestampPb(comment.UpdatedAt),
+	}
+
+	for _, reply := range comment.Replies {
+		commentPb.Replies = append(commentPb.Replies, FromCommentToPb(reply))
+	}
+
+	return commentPb
+}
+
+func FromCommentsToPb(comments []repository.Comment, nextPageToken string) *ugcv1pb.ListCommentsResponse {
+	var commentsPb []*ugcv1pb.Comment
+
+	for _, comment := range comments {
+		commentsPb = append(commentsPb, FromCommentToPb(comment))
+	}
+
+	return &ugcv1pb.ListCommentsResponse{
+		Comments:      commentsPb,
+		NextPageToken: nextPageToken,
+	}
+}
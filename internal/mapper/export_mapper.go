@@ -0,0 +1,44 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/maisiq/go-ugc-service/internal/service"
+	ugcv1pb "github.com/maisiq/go-ugc-service/pkg/pb/ugcservice/v1"
+)
+
+func FromUserDataRecordToPb(record service.UserDataRecord) *ugcv1pb.UserDataRecord {
+	switch {
+	case record.Review != nil:
+		review := fromReviewToPb(*record.Review)
+		if record.Review.DeletedAt != nil {
+			review.DeletedAt = toTimestampPb(*record.Review.DeletedAt)
+		}
+		return &ugcv1pb.UserDataRecord{Record: &ugcv1pb.UserDataRecord_Review{Review: review}}
+	case record.Vote != nil:
+		return &ugcv1pb.UserDataRecord{Record: &ugcv1pb.UserDataRecord_Vote{Vote: &ugcv1pb.ExportedVote{
+			UserId:    record.Vote.UserID,
+			MovieId:   record.Vote.MovieID,
+			Vote:      fromVoteToPb(record.Vote.Value),
+			CreatedAt: toTimestampPb(record.Vote.CreatedAt),
+		}}}
+	case record.Comment != nil:
+		return &ugcv1pb.UserDataRecord{Record: &ugcv1pb.UserDataRecord_Comment{Comment: FromCommentToPb(*record.Comment)}}
+	case record.Report != nil:
+		return &ugcv1pb.UserDataRecord{Record: &ugcv1pb.UserDataRecord_Report{Report: &ugcv1pb.ExportedReport{
+			UserId:    record.Report.UserID,
+			MovieId:   record.Report.MovieID,
+			Reason:    fromReportReasonToPb(record.Report.Reason),
+			Text:      record.Report.Text,
+			CreatedAt: toTimestampPb(record.Report.CreatedAt),
+		}}}
+	case record.Event != nil:
+		return &ugcv1pb.UserDataRecord{Record: &ugcv1pb.UserDataRecord_AnalyticsEvent{AnalyticsEvent: &ugcv1pb.AnalyticsEvent{
+			MovieId:    record.Event.MovieID,
+			Event:      record.Event.Event,
+			OccurredAt: toTimestampPb(time.UnixMilli(record.Event.TimestampMS)),
+		}}}
+	default:
+		return &ugcv1pb.UserDataRecord{}
+	}
+}
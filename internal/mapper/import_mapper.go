@@ -0,0 +1,63 @@
+package mapper
+
+import (
+	"errors"
+
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	ugcv1pb "github.com/maisiq/go-ugc-service/pkg/pb/ugcservice/v1"
+)
+
+var errMissingReview = errors.New("review is required")
+
+// FromPbToImportItem converts one message of an import stream. Reviews that fail
+// validation are passed on as invalid items, so the rest of the stream still gets imported.
+func FromPbToImportItem(req *ugcv1pb.ImportReviewsRequest) service.ImportItem {
+	review := req.GetReview()
+
+	item := service.ImportItem{
+		Overwrite: req.GetMode() == ugcv1pb.ImportMode_IMPORT_MODE_OVERWRITE,
+	}
+
+	if review == nil {
+		item.Invalid = errMissingReview
+		return item
+	}
+
+	item.Review = repository.Review{
+		UserID:  review.GetUserId(),
+		MovieID: review.GetMovieId(),
+		Text:    review.GetText(),
+		Rating:  review.GetRating(),
+	}
+	if review.GetCreatedAt() != nil {
+		item.Review.CreatedAt = review.GetCreatedAt().AsTime()
+	}
+	if review.GetUpdatedAt() != nil {
+		item.Review.UpdatedAt = review.GetUpdatedAt().AsTime()
+	}
+	item.Invalid = review.Validate()
+
+	return item
+}
+
+func FromImportReportToPb(report service.ImportReport) *ugcv1pb.ImportReviewsResponse {
+	errs := make([]*ugcv1pb.ImportError, 0, len(report.Errors))
+
+	for _, e := range report.Errors {
+		errs = append(errs, &ugcv1pb.ImportError{
+			Index:   e.Index,
+			UserId:  e.UserID,
+			MovieId: e.MovieID,
+			Message: e.Err.Error(),
+		})
+	}
+
+	return &ugcv1pb.ImportReviewsResponse{
+		Created:     report.Created,
+		Overwritten: report.Overwritten,
+		Skipped:     report.Skipped,
+		Failed:      report.Failed,
+		Errors:      errs,
+	}
+}
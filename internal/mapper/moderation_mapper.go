@@ -0,0 +1,52 @@
+package mapper
+
+import (
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	ugcv1pb "github.com/maisiq/go-ugc-service/pkg/pb/ugcservice/v1"
+)
+
+func FromPendingReviewsToPb(reviews []repository.Review, nextPageToken string) *ugcv1pb.ListPendingReviewsResponse {
+	reviewsPb := make([]*ugcv1pb.Review, 0, len(reviews))
+
+	for _, review := range reviews {
+		reviewsPb = append(reviewsPb, fromReviewToPb(review))
+	}
+
+	return &ugcv1pb.ListPendingReviewsResponse{
+		Reviews:       reviewsPb,
+		NextPageToken: nextPageToken,
+	}
+}
+
+func FromRevisionsToPb(revisions []repository.Revision, nextPageToken string) *ugcv1pb.ListReviewRevisionsResponse {
+	revisionsPb := make([]*ugcv1pb.ReviewRevision, 0, len(revisions))
+
+	for _, revision := range revisions {
+		revisionsPb = append(revisionsPb, &ugcv1pb.ReviewRevision{
+			Version:  revision.Version,
+			Text:     revision.Text,
+			Rating:   revision.Rating,
+			EditorId: revision.EditorID,
+			EditedAt: toTimestampPb(revision.EditedAt),
+		})
+	}
+
+	return &ugcv1pb.ListReviewRevisionsResponse{
+		Revisions:     revisionsPb,
+		NextPageToken: nextPageToken,
+	}
+}
+
+// fromReviewStatusToPb reports reviews stored before moderation was introduced as approved.
+func fromReviewStatusToPb(status repository.ReviewStatus) ugcv1pb.ReviewStatus {
+	switch status {
+	case repository.StatusPending:
+		return ugcv1pb.ReviewStatus_REVIEW_STATUS_PENDING
+	case repository.StatusRejected:
+		return ugcv1pb.ReviewStatus_REVIEW_STATUS_REJECTED
+	case repository.StatusHidden:
+		return ugcv1pb.ReviewStatus_REVIEW_STATUS_HIDDEN
+	default:
+		return ugcv1pb.ReviewStatus_REVIEW_STATUS_APPROVED
+	}
+}
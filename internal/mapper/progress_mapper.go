@@ -0,0 +1,25 @@
+package mapper
+
+import (
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	ugcv1pb "github.com/maisiq/go-ugc-service/pkg/pb/ugcservice/v1"
+)
+
+func FromProgressToPb(progress repository.Progress) *ugcv1pb.PlaybackProgress {
+	return &ugcv1pb.PlaybackProgress{
+		MovieId:    progress.MovieID,
+		PositionMs: progress.PositionMS,
+		DurationMs: progress.DurationMS,
+		UpdatedAt:  toTimestampPb(progress.UpdatedAt),
+	}
+}
+
+func FromInProgressToPb(progress []repository.Progress) *ugcv1pb.ListInProgressResponse {
+	response := &ugcv1pb.ListInProgressResponse{}
+
+	for _, p := range progress {
+		response.Items = append(response.Items, FromProgressToPb(p))
+	}
+
+	return response
+}
@@ -0,0 +1,45 @@
+package mapper
+
+import (
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	ugcv1pb "github.com/maisiq/go-ugc-service/pkg/pb/ugcservice/v1"
+)
+
+const (
+	minRating = 1
+	maxRating = 10
+)
+
+func FromMovieRatingToPb(rating repository.MovieRating) *ugcv1pb.GetMovieRatingResponse {
+	histogram := make([]*ugcv1pb.RatingBucket, 0, maxRating)
+
+	for r := int32(minRating); r <= maxRating; r++ {
+		histogram = append(histogram, &ugcv1pb.RatingBucket{
+			Rating: r,
+			Count:  rating.CountOf(r),
+		})
+	}
+
+	return &ugcv1pb.GetMovieRatingResponse{
+		MovieId:   rating.MovieID,
+		Average:   rating.Average(),
+		Count:     rating.Count,
+		Histogram: histogram,
+	}
+}
+
+func FromReviewSummariesToPb(summaries []repository.ReviewSummary) *ugcv1pb.BatchGetMovieReviewSummariesResponse {
+	summariesPb := make([]*ugcv1pb.MovieReviewSummary, 0, len(summaries))
+
+	for _, summary := range summaries {
+		summariesPb = append(summariesPb, &ugcv1pb.MovieReviewSummary{
+			MovieId:       summary.MovieID,
+			ReviewCount:   summary.Count,
+			AverageRating: summary.Average(),
+		})
+	}
+
+	return &ugcv1pb.BatchGetMovieReviewSummariesResponse{
+		Summaries: summariesPb,
+	}
+}
@@ -0,0 +1,34 @@
+package mapper
+
+import (
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	ugcv1pb "github.com/maisiq/go-ugc-service/pkg/pb/ugcservice/v1"
+)
+
+func FromPbToReportReason(reason ugcv1pb.ReportReason) repository.ReportReason {
+	switch reason {
+	case ugcv1pb.ReportReason_REPORT_REASON_SPAM:
+		return repository.ReportSpam
+	case ugcv1pb.ReportReason_REPORT_REASON_SPOILER:
+		return repository.ReportSpoiler
+	case ugcv1pb.ReportReason_REPORT_REASON_HATE:
+		return repository.ReportHate
+	default:
+		return repository.ReportOffTopic
+	}
+}
+
+func fromReportReasonToPb(reason repository.ReportReason) ugcv1pb.ReportReason {
+	switch reason {
+	case repository.ReportSpam:
+		return ugcv1pb.ReportReason_REPORT_REASON_SPAM
+	case repository.ReportSpoiler:
+		return ugcv1pb.ReportReason_REPORT_REASON_SPOILER
+	case repository.ReportHate:
+		return ugcv1pb.ReportReason_REPORT_REASON_HATE
+	case repository.ReportOffTopic:
+		return ugcv1pb.ReportReason_REPORT_REASON_OFF_TOPIC
+	default:
+		return ugcv1pb.ReportReason_REPORT_REASON_UNSPECIFIED
+	}
+}
@@ -1,19 +1,21 @@
 package mapper
 
 import (
+	"fmt"
+	"slices"
+	"time"
+
 	"github.com/maisiq/go-ugc-service/internal/repository"
 	ugcv1pb "github.com/maisiq/go-ugc-service/pkg/pb/ugcservice/v1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func FromReviewToPb(reviews []repository.Review) *ugcv1pb.GetReviewsResponse {
 	var reviewsPb []*ugcv1pb.Review
 
 	for _, review := range reviews {
-		reviewsPb = append(reviewsPb, &ugcv1pb.Review{
-			MovieId: review.MovieID,
-			UserId:  review.UserID,
-			Text:    review.Text,
-		})
+		reviewsPb = append(reviewsPb, fromReviewToPb(review))
 	}
 	response := ugcv1pb.GetReviewsResponse{
 		Reviews: reviewsPb,
@@ -21,3 +23,90 @@ func FromReviewToPb(reviews []repository.Review) *ugcv1pb.GetReviewsResponse {
 
 	return &response
 }
+
+func FromSingleReviewToPb(review repository.Review) *ugcv1pb.GetReviewResponse {
+	return &ugcv1pb.GetReviewResponse{
+		Review: fromReviewToPb(review),
+	}
+}
+
+func fromReviewToPb(review repository.Review) *ugcv1pb.Review {
+	return &ugcv1pb.Review{
+		MovieId:          review.MovieID,
+		UserId:           review.UserID,
+		Text:             review.Text,
+		Rating:           review.Rating,
+		CreatedAt:        toTimestampPb(review.CreatedAt),
+		UpdatedAt:        toTimestampPb(review.UpdatedAt),
+		Likes:            review.Likes,
+		Dislikes:         review.Dislikes,
+		Status:           fromReviewStatusToPb(review.Status),
+		ModerationReason: review.ModerationReason,
+		Version:          review.Version,
+	}
+}
+
+// updatableFields maps the update mask paths of a review to the fields they change.
+var updatableFields = map[string]repository.ReviewField{
+	"text":   repository.FieldText,
+	"rating": repository.FieldRating,
+}
+
+// FromPbToReviewFields returns nil for an empty mask, which updates all editable fields.
+func FromPbToReviewFields(mask *fieldmaskpb.FieldMask) ([]repository.ReviewField, error) {
+	var fields []repository.ReviewField
+
+	for _, path := range mask.GetPaths() {
+		field, ok := updatableFields[path]
+		if !ok {
+			return nil, fmt.Errorf("field %q can't be updated", path)
+		}
+		if !slices.Contains(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields, nil
+}
+
+func FromPbToReviewSort(sort ugcv1pb.ReviewSort) repository.ReviewSort {
+	switch sort {
+	case ugcv1pb.ReviewSort_REVIEW_SORT_NEWEST:
+		return repository.SortNewest
+	case ugcv1pb.ReviewSort_REVIEW_SORT_OLDEST:
+		return repository.SortOldest
+	case ugcv1pb.ReviewSort_REVIEW_SORT_MOST_HELPFUL:
+		return repository.SortMostHelpful
+	case ugcv1pb.ReviewSort_REVIEW_SORT_HIGHEST_RATED:
+		return repository.SortHighestRated
+	default:
+		return repository.SortDefault
+	}
+}
+
+// toTimestampPb leaves the field unset for reviews stored before timestamps were introduced.
+func toTimestampPb(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func FromReviewEventToPb(event repository.ReviewEvent) *ugcv1pb.ReviewEvent {
+	var eventType ugcv1pb.ReviewEventType
+
+	switch event.Type {
+	case repository.ReviewCreated:
+		eventType = ugcv1pb.ReviewEventType_REVIEW_EVENT_TYPE_CREATED
+	case repository.ReviewUpdated:
+		eventType = ugcv1pb.ReviewEventType_REVIEW_EVENT_TYPE_UPDATED
+	case repository.ReviewDeleted:
+		eventType = ugcv1pb.ReviewEventType_REVIEW_EVENT_TYPE_DELETED
+	}
+
+	return &ugcv1pb.ReviewEvent{
+		Type:        eventType,
+		Review:      fromReviewToPb(event.Review),
+		ResumeToken: event.ResumeToken,
+	}
+}
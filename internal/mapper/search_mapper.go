@@ -0,0 +1,23 @@
+package mapper
+
+import (
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	ugcv1pb "github.com/maisiq/go-ugc-service/pkg/pb/ugcservice/v1"
+)
+
+func FromSearchHitsToPb(hits []repository.SearchHit, nextPageToken string) *ugcv1pb.SearchReviewsResponse {
+	results := make([]*ugcv1pb.SearchResult, 0, len(hits))
+
+	for _, hit := range hits {
+		results = append(results, &ugcv1pb.SearchResult{
+			Review:  fromReviewToPb(hit.Review),
+			Snippet: hit.Snippet,
+			Score:   hit.Score,
+		})
+	}
+
+	return &ugcv1pb.SearchReviewsResponse{
+		Results:       results,
+		NextPageToken: nextPageToken,
+	}
+}
@@ -0,0 +1,28 @@
+package mapper
+
+import (
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	ugcv1pb "github.com/maisiq/go-ugc-service/pkg/pb/ugcservice/v1"
+)
+
+func FromPbToVote(vote ugcv1pb.Vote) int32 {
+	switch vote {
+	case ugcv1pb.Vote_VOTE_UP:
+		return repository.VoteUp
+	case ugcv1pb.Vote_VOTE_DOWN:
+		return repository.VoteDown
+	default:
+		return 0
+	}
+}
+
+func fromVoteToPb(vote int32) ugcv1pb.Vote {
+	switch vote {
+	case repository.VoteUp:
+		return ugcv1pb.Vote_VOTE_UP
+	case repository.VoteDown:
+		return ugcv1pb.Vote_VOTE_DOWN
+	default:
+		return ugcv1pb.Vote_VOTE_UNSPECIFIED
+	}
+}
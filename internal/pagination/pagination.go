@@ -0,0 +1,144 @@
+package pagination
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/maisiq/go-ugc-service/pkg/config"
+)
+
+var ErrInvalidToken = errors.New("invalid page token")
+
+// Paginator issues opaque page tokens. A token carries the offset or the cursor
+// of the next page and a fingerprint of the query it was issued for, and is signed
+// so clients can neither forge positions nor reuse a token for another query.
+type Paginator struct {
+	secret      []byte
+	defaultSize int
+	maxSize     int
+}
+
+type token struct {
+	Offset int             `json:"o"`
+	Cursor json.RawMessage `json:"c,omitempty"`
+	Query  string          `json:"q"`
+}
+
+func New(cfg config.PaginationConfig) *Paginator {
+	return &Paginator{
+		secret:      []byte(cfg.TokenSecret),
+		defaultSize: cfg.DefaultPageSize,
+		maxSize:     cfg.MaxPageSize,
+	}
+}
+
+// PageSize returns the requested size clamped to the server limit.
+func (p *Paginator) PageSize(requested int32) int {
+	size := int(requested)
+
+	if size <= 0 {
+		size = p.defaultSize
+	}
+	if size > p.maxSize {
+		size = p.maxSize
+	}
+	return size
+}
+
+// Offset decodes the token issued for the same query. Empty token means the first page.
+func (p *Paginator) Offset(raw string, query ...string) (int, error) {
+	if raw == "" {
+		return 0, nil
+	}
+
+	t, err := p.decode(raw, query)
+	if err != nil {
+		return 0, err
+	}
+
+	if t.Offset < 0 {
+		return 0, ErrInvalidToken
+	}
+
+	return t.Offset, nil
+}
+
+// NextToken builds the token pointing to offset for the given query.
+func (p *Paginator) NextToken(offset int, query ...string) string {
+	return p.encode(token{Offset: offset, Query: fingerprint(query)})
+}
+
+// Cursor decodes into dst the cursor of the token issued for the same query by
+// CursorToken. Empty token means the first page, dst is left as it is and false is returned.
+func (p *Paginator) Cursor(raw string, dst any, query ...string) (bool, error) {
+	if raw == "" {
+		return false, nil
+	}
+
+	t, err := p.decode(raw, query)
+	if err != nil {
+		return false, err
+	}
+
+	if len(t.Cursor) == 0 || json.Unmarshal(t.Cursor, dst) != nil {
+		return false, ErrInvalidToken
+	}
+
+	return true, nil
+}
+
+// CursorToken builds the token carrying cursor for the given query.
+func (p *Paginator) CursorToken(cursor any, query ...string) string {
+	data, _ := json.Marshal(cursor)
+	return p.encode(token{Cursor: data, Query: fingerprint(query)})
+}
+
+func (p *Paginator) decode(raw string, query []string) (token, error) {
+	payload, sig, ok := strings.Cut(raw, ".")
+	if !ok {
+		return token{}, ErrInvalidToken
+	}
+
+	gotSig, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(gotSig, p.sign(payload)) {
+		return token{}, ErrInvalidToken
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return token{}, ErrInvalidToken
+	}
+
+	var t token
+	if err := json.Unmarshal(data, &t); err != nil {
+		return token{}, ErrInvalidToken
+	}
+
+	if t.Query != fingerprint(query) {
+		return token{}, ErrInvalidToken
+	}
+
+	return t, nil
+}
+
+func (p *Paginator) encode(t token) string {
+	data, _ := json.Marshal(t)
+	payload := base64.RawURLEncoding.EncodeToString(data)
+
+	return payload + "." + base64.RawURLEncoding.EncodeToString(p.sign(payload))
+}
+
+func (p *Paginator) sign(payload string) []byte {
+	mac := hmac.New(sha256.New, p.secret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+func fingerprint(query []string) string {
+	h := sha256.Sum256([]byte(strings.Join(query, "\x00")))
+	return base64.RawURLEncoding.EncodeToString(h[:8])
+}
@@ -0,0 +1,74 @@
+package pagination
+
+import (
+	"testing"
+
+	"github.com/maisiq/go-ugc-service/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginator(t *testing.T) {
+	p := New(config.PaginationConfig{DefaultPageSize: 20, MaxPageSize: 100, TokenSecret: "secret"})
+
+	t.Run("Page size falls back to default and is capped", func(t *testing.T) {
+		require.Equal(t, 20, p.PageSize(0))
+		require.Equal(t, 5, p.PageSize(5))
+		require.Equal(t, 100, p.PageSize(1000))
+	})
+
+	t.Run("Empty token is the first page", func(t *testing.T) {
+		offset, err := p.Offset("", "movie")
+
+		require.NoError(t, err)
+		require.Equal(t, 0, offset)
+	})
+
+	t.Run("Token round trip", func(t *testing.T) {
+		offset, err := p.Offset(p.NextToken(40, "movie"), "movie")
+
+		require.NoError(t, err)
+		require.Equal(t, 40, offset)
+	})
+
+	t.Run("Token issued for another query is rejected", func(t *testing.T) {
+		_, err := p.Offset(p.NextToken(40, "movie"), "user")
+
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("Tampered token is rejected", func(t *testing.T) {
+		forged := New(config.PaginationConfig{TokenSecret: "other"}).NextToken(40, "movie")
+
+		_, err := p.Offset(forged, "movie")
+		require.ErrorIs(t, err, ErrInvalidToken)
+
+		_, err = p.Offset("garbage", "movie")
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("Cursor token round trip", func(t *testing.T) {
+		type cursor struct {
+			ID string
+		}
+		var got cursor
+
+		ok, err := p.Cursor(p.CursorToken(cursor{ID: "42"}, "movie"), &got, "movie")
+
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, cursor{ID: "42"}, got)
+
+		ok, err = p.Cursor("", &got, "movie")
+
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+
+	t.Run("Offset token is not a cursor", func(t *testing.T) {
+		var got struct{}
+
+		_, err := p.Cursor(p.NextToken(40, "movie"), &got, "movie")
+
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+}
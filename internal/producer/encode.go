@@ -0,0 +1,89 @@
+package producer
+
+import (
+	"strconv"
+
+	analyticsv1 "github.com/maisiq/go-ugc-service/pkg/pb/analytics/v1"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Consumers pick the decoder of a message from its headers. Messages without
+// them were written as JSON before protobuf was introduced.
+const (
+	HeaderContentType   = "content-type"
+	HeaderSchemaVersion = "schema-version"
+
+	ContentTypeProtobuf = "application/x-protobuf"
+)
+
+// encode turns the message into a kafka message with a protobuf value.
+func encode(msg AnalyticsMessage) (kafka.Message, error) {
+	value, err := proto.Marshal(toProto(msg))
+	if err != nil {
+		return kafka.Message{}, err
+	}
+
+	return kafka.Message{
+		Value: value,
+		Headers: []kafka.Header{
+			{Key: HeaderContentType, Value: []byte(ContentTypeProtobuf)},
+			{Key: HeaderSchemaVersion, Value: []byte(strconv.Itoa(int(msg.SchemaVersion)))},
+		},
+	}, nil
+}
+
+func toProto(msg AnalyticsMessage) *analyticsv1.AnalyticsEvent {
+	event := &analyticsv1.AnalyticsEvent{
+		EventId:       msg.EventID,
+		EventType:     msg.EventType,
+		SchemaVersion: msg.SchemaVersion,
+		OccurredAt:    timestamppb.New(msg.OccurredAt),
+	}
+
+	switch {
+	case msg.Review != nil:
+		event.Payload = &analyticsv1.AnalyticsEvent_Review{Review: &analyticsv1.ReviewPayload{
+			UserId:    msg.Review.UserID,
+			MovieId:   msg.Review.MovieID,
+			Rating:    msg.Review.Rating,
+			Status:    msg.Review.Status,
+			Version:   msg.Review.Version,
+			Fields:    msg.Review.Fields,
+			Permanent: msg.Review.Permanent,
+		}}
+	case msg.Vote != nil:
+		event.Payload = &analyticsv1.AnalyticsEvent_Vote{Vote: &analyticsv1.VotePayload{
+			VoterId: msg.Vote.VoterID,
+			UserId:  msg.Vote.UserID,
+			MovieId: msg.Vote.MovieID,
+			Vote:    msg.Vote.Vote,
+		}}
+	case msg.Report != nil:
+		event.Payload = &analyticsv1.AnalyticsEvent_Report{Report: &analyticsv1.ReportPayload{
+			ReporterId: msg.Report.ReporterID,
+			UserId:     msg.Report.UserID,
+			MovieId:    msg.Report.MovieID,
+			Reason:     msg.Report.Reason,
+		}}
+	case msg.Progress != nil:
+		event.Payload = &analyticsv1.AnalyticsEvent_Progress{Progress: &analyticsv1.ProgressPayload{
+			UserId:     msg.Progress.UserID,
+			MovieId:    msg.Progress.MovieID,
+			PositionMs: msg.Progress.PositionMS,
+			DurationMs: msg.Progress.DurationMS,
+		}}
+	case msg.Bookmark != nil:
+		event.Payload = &analyticsv1.AnalyticsEvent_Bookmark{Bookmark: &analyticsv1.BookmarkPayload{
+			UserId:  msg.Bookmark.UserID,
+			MovieId: msg.Bookmark.MovieID,
+		}}
+	case msg.User != nil:
+		event.Payload = &analyticsv1.AnalyticsEvent_User{User: &analyticsv1.UserPayload{
+			UserId: msg.User.UserID,
+		}}
+	}
+
+	return event
+}
@@ -19,6 +19,13 @@ type ProducerMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcSend          func(ctx context.Context, messages []mm_producer.AnalyticsMessage) (err error)
+	funcSendOrigin    string
+	inspectFuncSend   func(ctx context.Context, messages []mm_producer.AnalyticsMessage)
+	afterSendCounter  uint64
+	beforeSendCounter uint64
+	SendMock          mProducerMockSend
+
 	funcWriteMessages          func(ctx context.Context, cancel context.CancelFunc, messages []mm_producer.AnalyticsMessage)
 	funcWriteMessagesOrigin    string
 	inspectFuncWriteMessages   func(ctx context.Context, cancel context.CancelFunc, messages []mm_producer.AnalyticsMessage)
@@ -35,6 +42,9 @@ func NewProducerMock(t minimock.Tester) *ProducerMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.SendMock = mProducerMockSend{mock: m}
+	m.SendMock.callArgs = []*ProducerMockSendParams{}
+
 	m.WriteMessagesMock = mProducerMockWriteMessages{mock: m}
 	m.WriteMessagesMock.callArgs = []*ProducerMockWriteMessagesParams{}
 
@@ -43,6 +53,348 @@ func NewProducerMock(t minimock.Tester) *ProducerMock {
 	return m
 }
 
+type mProducerMockSend struct {
+	optional           bool
+	mock               *ProducerMock
+	defaultExpectation *ProducerMockSendExpectation
+	expectations       []*ProducerMockSendExpectation
+
+	callArgs []*ProducerMockSendParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ProducerMockSendExpectation specifies expectation struct of the Producer.Send
+type ProducerMockSendExpectation struct {
+	mock               *ProducerMock
+	params             *ProducerMockSendParams
+	paramPtrs          *ProducerMockSendParamPtrs
+	expectationOrigins ProducerMockSendExpectationOrigins
+	results            *ProducerMockSendResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ProducerMockSendParams contains parameters of the Producer.Send
+type ProducerMockSendParams struct {
+	ctx      context.Context
+	messages []mm_producer.AnalyticsMessage
+}
+
+// ProducerMockSendParamPtrs contains pointers to parameters of the Producer.Send
+type ProducerMockSendParamPtrs struct {
+	ctx      *context.Context
+	messages *[]mm_producer.AnalyticsMessage
+}
+
+// ProducerMockSendResults contains results of the Producer.Send
+type ProducerMockSendResults struct {
+	err error
+}
+
+// ProducerMockSendOrigins contains origins of expectations of the Producer.Send
+type ProducerMockSendExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originMessages string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSend *mProducerMockSend) Optional() *mProducerMockSend {
+	mmSend.optional = true
+	return mmSend
+}
+
+// Expect sets up expected params for Producer.Send
+func (mmSend *mProducerMockSend) Expect(ctx context.Context, messages []mm_producer.AnalyticsMessage) *mProducerMockSend {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("ProducerMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &ProducerMockSendExpectation{}
+	}
+
+	if mmSend.defaultExpectation.paramPtrs != nil {
+		mmSend.mock.t.Fatalf("ProducerMock.Send mock is already set by ExpectParams functions")
+	}
+
+	mmSend.defaultExpectation.params = &ProducerMockSendParams{ctx, messages}
+	mmSend.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSend.expectations {
+		if minimock.Equal(e.params, mmSend.defaultExpectation.params) {
+			mmSend.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSend.defaultExpectation.params)
+		}
+	}
+
+	return mmSend
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Producer.Send
+func (mmSend *mProducerMockSend) ExpectCtxParam1(ctx context.Context) *mProducerMockSend {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("ProducerMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &ProducerMockSendExpectation{}
+	}
+
+	if mmSend.defaultExpectation.params != nil {
+		mmSend.mock.t.Fatalf("ProducerMock.Send mock is already set by Expect")
+	}
+
+	if mmSend.defaultExpectation.paramPtrs == nil {
+		mmSend.defaultExpectation.paramPtrs = &ProducerMockSendParamPtrs{}
+	}
+	mmSend.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSend.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSend
+}
+
+// ExpectMessagesParam2 sets up expected param messages for Producer.Send
+func (mmSend *mProducerMockSend) ExpectMessagesParam2(messages []mm_producer.AnalyticsMessage) *mProducerMockSend {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("ProducerMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &ProducerMockSendExpectation{}
+	}
+
+	if mmSend.defaultExpectation.params != nil {
+		mmSend.mock.t.Fatalf("ProducerMock.Send mock is already set by Expect")
+	}
+
+	if mmSend.defaultExpectation.paramPtrs == nil {
+		mmSend.defaultExpectation.paramPtrs = &ProducerMockSendParamPtrs{}
+	}
+	mmSend.defaultExpectation.paramPtrs.messages = &messages
+	mmSend.defaultExpectation.expectationOrigins.originMessages = minimock.CallerInfo(1)
+
+	return mmSend
+}
+
+// Inspect accepts an inspector function that has same arguments as the Producer.Send
+func (mmSend *mProducerMockSend) Inspect(f func(ctx context.Context, messages []mm_producer.AnalyticsMessage)) *mProducerMockSend {
+	if mmSend.mock.inspectFuncSend != nil {
+		mmSend.mock.t.Fatalf("Inspect function is already set for ProducerMock.Send")
+	}
+
+	mmSend.mock.inspectFuncSend = f
+
+	return mmSend
+}
+
+// Return sets up results that will be returned by Producer.Send
+func (mmSend *mProducerMockSend) Return(err error) *ProducerMock {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("ProducerMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &ProducerMockSendExpectation{mock: mmSend.mock}
+	}
+	mmSend.defaultExpectation.results = &ProducerMockSendResults{err}
+	mmSend.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSend.mock
+}
+
+// Set uses given function f to mock the Producer.Send method
+func (mmSend *mProducerMockSend) Set(f func(ctx context.Context, messages []mm_producer.AnalyticsMessage) (err error)) *ProducerMock {
+	if mmSend.defaultExpectation != nil {
+		mmSend.mock.t.Fatalf("Default expectation is already set for the Producer.Send method")
+	}
+
+	if len(mmSend.expectations) > 0 {
+		mmSend.mock.t.Fatalf("Some expectations are already set for the Producer.Send method")
+	}
+
+	mmSend.mock.funcSend = f
+	mmSend.mock.funcSendOrigin = minimock.CallerInfo(1)
+	return mmSend.mock
+}
+
+// When sets expectation for the Producer.Send which will trigger the result defined by the following
+// Then helper
+func (mmSend *mProducerMockSend) When(ctx context.Context, messages []mm_producer.AnalyticsMessage) *ProducerMockSendExpectation {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("ProducerMock.Send mock is already set by Set")
+	}
+
+	expectation := &ProducerMockSendExpectation{
+		mock:               mmSend.mock,
+		params:             &ProducerMockSendParams{ctx, messages},
+		expectationOrigins: ProducerMockSendExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSend.expectations = append(mmSend.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Producer.Send return parameters for the expectation previously defined by the When method
+func (e *ProducerMockSendExpectation) Then(err error) *ProducerMock {
+	e.results = &ProducerMockSendResults{err}
+	return e.mock
+}
+
+// Times sets number of times Producer.Send should be invoked
+func (mmSend *mProducerMockSend) Times(n uint64) *mProducerMockSend {
+	if n == 0 {
+		mmSend.mock.t.Fatalf("Times of ProducerMock.Send mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSend.expectedInvocations, n)
+	mmSend.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSend
+}
+
+func (mmSend *mProducerMockSend) invocationsDone() bool {
+	if len(mmSend.expectations) == 0 && mmSend.defaultExpectation == nil && mmSend.mock.funcSend == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSend.mock.afterSendCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSend.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Send implements mm_producer.Producer
+func (mmSend *ProducerMock) Send(ctx context.Context, messages []mm_producer.AnalyticsMessage) (err error) {
+	mm_atomic.AddUint64(&mmSend.beforeSendCounter, 1)
+	defer mm_atomic.AddUint64(&mmSend.afterSendCounter, 1)
+
+	mmSend.t.Helper()
+
+	if mmSend.inspectFuncSend != nil {
+		mmSend.inspectFuncSend(ctx, messages)
+	}
+
+	mm_params := ProducerMockSendParams{ctx, messages}
+
+	// Record call args
+	mmSend.SendMock.mutex.Lock()
+	mmSend.SendMock.callArgs = append(mmSend.SendMock.callArgs, &mm_params)
+	mmSend.SendMock.mutex.Unlock()
+
+	for _, e := range mmSend.SendMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSend.SendMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSend.SendMock.defaultExpectation.Counter, 1)
+		mm_want := mmSend.SendMock.defaultExpectation.params
+		mm_want_ptrs := mmSend.SendMock.defaultExpectation.paramPtrs
+
+		mm_got := ProducerMockSendParams{ctx, messages}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSend.t.Errorf("ProducerMock.Send got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSend.SendMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messages != nil && !minimock.Equal(*mm_want_ptrs.messages, mm_got.messages) {
+				mmSend.t.Errorf("ProducerMock.Send got unexpected parameter messages, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSend.SendMock.defaultExpectation.expectationOrigins.originMessages, *mm_want_ptrs.messages, mm_got.messages, minimock.Diff(*mm_want_ptrs.messages, mm_got.messages))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSend.t.Errorf("ProducerMock.Send got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSend.SendMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSend.SendMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSend.t.Fatal("No results are set for the ProducerMock.Send")
+		}
+		return (*mm_results).err
+	}
+	if mmSend.funcSend != nil {
+		return mmSend.funcSend(ctx, messages)
+	}
+	mmSend.t.Fatalf("Unexpected call to ProducerMock.Send. %v %v", ctx, messages)
+	return
+}
+
+// SendAfterCounter returns a count of finished ProducerMock.Send invocations
+func (mmSend *ProducerMock) SendAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSend.afterSendCounter)
+}
+
+// SendBeforeCounter returns a count of ProducerMock.Send invocations
+func (mmSend *ProducerMock) SendBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSend.beforeSendCounter)
+}
+
+// Calls returns a list of arguments used in each call to ProducerMock.Send.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSend *mProducerMockSend) Calls() []*ProducerMockSendParams {
+	mmSend.mutex.RLock()
+
+	argCopy := make([]*ProducerMockSendParams, len(mmSend.callArgs))
+	copy(argCopy, mmSend.callArgs)
+
+	mmSend.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendDone returns true if the count of the Send invocations corresponds
+// the number of defined expectations
+func (m *ProducerMock) MinimockSendDone() bool {
+	if m.SendMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendMock.invocationsDone()
+}
+
+// MinimockSendInspect logs each unmet expectation
+func (m *ProducerMock) MinimockSendInspect() {
+	for _, e := range m.SendMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ProducerMock.Send at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSendCounter := mm_atomic.LoadUint64(&m.afterSendCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendMock.defaultExpectation != nil && afterSendCounter < 1 {
+		if m.SendMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ProducerMock.Send at\n%s", m.SendMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ProducerMock.Send at\n%s with params: %#v", m.SendMock.defaultExpectation.expectationOrigins.origin, *m.SendMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSend != nil && afterSendCounter < 1 {
+		m.t.Errorf("Expected call to ProducerMock.Send at\n%s", m.funcSendOrigin)
+	}
+
+	if !m.SendMock.invocationsDone() && afterSendCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProducerMock.Send at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SendMock.expectedInvocations), m.SendMock.expectedInvocationsOrigin, afterSendCounter)
+	}
+}
+
 type mProducerMockWriteMessages struct {
 	optional           bool
 	mock               *ProducerMock
@@ -413,6 +765,8 @@ func (m *ProducerMock) MinimockWriteMessagesInspect() {
 func (m *ProducerMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockSendInspect()
+
 			m.MinimockWriteMessagesInspect()
 		}
 	})
@@ -437,5 +791,6 @@ func (m *ProducerMock) MinimockWait(timeout mm_time.Duration) {
 func (m *ProducerMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockSendDone() &&
 		m.MinimockWriteMessagesDone()
 }
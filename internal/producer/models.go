@@ -1,7 +1,133 @@
 package producer
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventReviewCreated = "review_created"
+	EventReviewUpdated = "review_updated"
+	EventReviewDeleted = "review_deleted"
+
+	EventReviewVoted       = "review_voted"
+	EventReviewVoteRemoved = "review_vote_removed"
+
+	EventReviewReported = "review_reported"
+
+	EventProgressReported = "progress_reported"
+
+	EventBookmarkAdded   = "bookmark_added"
+	EventBookmarkRemoved = "bookmark_removed"
+
+	// EventUserErased asks consumers to remove everything they keep about the user.
+	EventUserErased = "user_erased"
+)
+
+// SchemaVersion is the version of the messages written by the producer. Version 1
+// messages had no envelope, their fields were at the top level.
+const SchemaVersion = 2
+
+// AnalyticsMessage is the envelope of an analytics event. Exactly one payload is set,
+// which one depends on EventType.
 type AnalyticsMessage struct {
-	UserID      string `json:"user_id"`
-	MovieID     string `json:"movie_id"`
-	TimestampMS int64  `json:"timestamp_ms"`
+	EventID       string    `json:"event_id"`
+	EventType     string    `json:"event_type"`
+	SchemaVersion int32     `json:"schema_version"`
+	OccurredAt    time.Time `json:"occurred_at"`
+
+	Review   *ReviewPayload   `json:"review,omitempty"`
+	Vote     *VotePayload     `json:"vote,omitempty"`
+	Report   *ReportPayload   `json:"report,omitempty"`
+	Progress *ProgressPayload `json:"progress,omitempty"`
+	Bookmark *BookmarkPayload `json:"bookmark,omitempty"`
+	User     *UserPayload     `json:"user,omitempty"`
+}
+
+type ReviewPayload struct {
+	UserID  string `json:"user_id"`
+	MovieID string `json:"movie_id"`
+	Rating  int32  `json:"rating,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Version int64  `json:"version,omitempty"`
+	// Fields are the fields changed by an update.
+	Fields []string `json:"fields,omitempty"`
+	// Permanent is set when a deleted review cannot be restored.
+	Permanent bool `json:"permanent,omitempty"`
+}
+
+type VotePayload struct {
+	VoterID string `json:"voter_id"`
+	UserID  string `json:"user_id"`
+	MovieID string `json:"movie_id"`
+	Vote    int32  `json:"vote"`
+}
+
+type ReportPayload struct {
+	ReporterID string `json:"reporter_id"`
+	UserID     string `json:"user_id"`
+	MovieID    string `json:"movie_id"`
+	Reason     string `json:"reason"`
+}
+
+type ProgressPayload struct {
+	UserID     string `json:"user_id"`
+	MovieID    string `json:"movie_id"`
+	PositionMS int64  `json:"position_ms"`
+	DurationMS int64  `json:"duration_ms"`
+}
+
+type BookmarkPayload struct {
+	UserID  string `json:"user_id"`
+	MovieID string `json:"movie_id"`
+}
+
+type UserPayload struct {
+	UserID string `json:"user_id"`
+}
+
+func newMessage(eventType string, occurredAt time.Time) AnalyticsMessage {
+	return AnalyticsMessage{
+		EventID:       uuid.NewString(),
+		EventType:     eventType,
+		SchemaVersion: SchemaVersion,
+		OccurredAt:    occurredAt.UTC(),
+	}
+}
+
+func NewReviewMessage(eventType string, occurredAt time.Time, payload ReviewPayload) AnalyticsMessage {
+	msg := newMessage(eventType, occurredAt)
+	msg.Review = &payload
+	return msg
+}
+
+func NewVoteMessage(eventType string, occurredAt time.Time, payload VotePayload) AnalyticsMessage {
+	msg := newMessage(eventType, occurredAt)
+	msg.Vote = &payload
+	return msg
+}
+
+func NewReportMessage(occurredAt time.Time, payload ReportPayload) AnalyticsMessage {
+	msg := newMessage(EventReviewReported, occurredAt)
+	msg.Report = &payload
+	return msg
+}
+
+func NewProgressMessage(occurredAt time.Time, payload ProgressPayload) AnalyticsMessage {
+	msg := newMessage(EventProgressReported, occurredAt)
+	msg.Progress = &payload
+	return msg
+}
+
+func NewBookmarkMessage(eventType string, occurredAt time.Time, payload BookmarkPayload) AnalyticsMessage {
+	msg := newMessage(eventType, occurredAt)
+	msg.Bookmark = &payload
+	return msg
+}
+
+func NewUserErasedMessage(occurredAt time.Time, UserID string) AnalyticsMessage {
+	msg := newMessage(EventUserErased, occurredAt)
+	msg.User = &UserPayload{UserID: UserID}
+	return msg
 }
@@ -2,7 +2,7 @@ package producer
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/maisiq/go-ugc-service/pkg/config"
@@ -13,6 +13,7 @@ import (
 //go:generate minimock -i Producer -o mocks/producer_mock.go
 type Producer interface {
 	WriteMessages(ctx context.Context, cancel context.CancelFunc, messages []AnalyticsMessage)
+	Send(ctx context.Context, messages []AnalyticsMessage) error
 }
 
 type KafkaProducer struct {
@@ -35,27 +36,29 @@ func New(cfg config.KafkaConfig, log *zap.SugaredLogger) *KafkaProducer {
 
 func (p *KafkaProducer) WriteMessages(ctx context.Context, cancel context.CancelFunc, messages []AnalyticsMessage) {
 	defer cancel()
-	var KafkaMessages []kafka.Message
+
+	if err := p.Send(ctx, messages); err != nil {
+		p.log.Errorf("failed to write messages: %v", err)
+		return
+	}
+
+	p.log.Debug("Wrote to the broker")
+}
+
+// Send writes the messages and returns the error instead of logging it,
+// for callers that have to know the broker got them.
+func (p *KafkaProducer) Send(ctx context.Context, messages []AnalyticsMessage) error {
+	KafkaMessages := make([]kafka.Message, 0, len(messages))
 
 	for _, msg := range messages {
-		rawMsg, err := json.Marshal(msg)
+		kafkaMsg, err := encode(msg)
 
 		if err != nil {
-			p.log.Errorf("Failed to parse message: %+v", msg)
+			return fmt.Errorf("failed to marshal message %+v: %w", msg, err)
 		}
 
-		KafkaMessages = append(KafkaMessages, kafka.Message{Value: rawMsg})
+		KafkaMessages = append(KafkaMessages, kafkaMsg)
 	}
 
-	err := p.Writer.WriteMessages(
-		ctx,
-		KafkaMessages...,
-	)
-
-	if err != nil {
-		p.log.Errorf("failed to write messages:", err)
-	}
-
-	p.log.Debug("Wrote to the broker")
-
+	return p.Writer.WriteMessages(ctx, KafkaMessages...)
 }
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"github.com/maisiq/go-ugc-service/internal/clickhouse"
+)
+
+// ClickhouseAnalyticsRepository reads the events the ETL loaded into clickhouse.
+// Writes only happen in the ETL.
+type ClickhouseAnalyticsRepository struct {
+	conn driver.Conn
+}
+
+func NewClickhouseAnalyticsRepository(conn driver.Conn) AnalyticsRepository {
+	return &ClickhouseAnalyticsRepository{
+		conn: conn,
+	}
+}
+
+func (r *ClickhouseAnalyticsRepository) ListUserEvents(ctx context.Context, userID string) ([]AnalyticsEvent, error) {
+	rows, err := r.conn.Query(ctx,
+		"SELECT toString(user_id), movie_id, event, timestamp_ms FROM analytics WHERE user_id = toUUID(?) ORDER BY timestamp_ms",
+		userID,
+	)
+
+	if err != nil {
+		return []AnalyticsEvent{}, fmt.Errorf("failed to query analytics events of user %v: %w", userID, err)
+	}
+	defer rows.Close()
+
+	events := []AnalyticsEvent{}
+
+	for rows.Next() {
+		var event AnalyticsEvent
+
+		if err := rows.Scan(&event.UserID, &event.MovieID, &event.Event, &event.TimestampMS); err != nil {
+			return []AnalyticsEvent{}, fmt.Errorf("failed to scan analytics event of user %v: %w", userID, err)
+		}
+
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return []AnalyticsEvent{}, fmt.Errorf("failed to read analytics events of user %v: %w", userID, err)
+	}
+
+	return events, nil
+}
+
+func (r *ClickhouseAnalyticsRepository) DeleteUserEvents(ctx context.Context, userID string) error {
+	if err := clickhouse.DeleteUserRows(ctx, r.conn, userID); err != nil {
+		return fmt.Errorf("failed to delete analytics events of user %v: %w", userID, err)
+	}
+
+	return nil
+}
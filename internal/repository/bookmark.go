@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// UserBookmarkRepository stores one document per user and bookmarked movie, the _id
+// is built from both ids, so a movie can't be bookmarked twice.
+type UserBookmarkRepository struct {
+	coll *mongo.Collection
+}
+
+func NewUserBookmarkRepository(c *mongo.Collection) BookmarkRepository {
+	return &UserBookmarkRepository{
+		coll: c,
+	}
+}
+
+// CreateBookmarkIndexes creates the index used to list bookmarks of a user in order.
+func CreateBookmarkIndexes(ctx context.Context, c *mongo.Collection) error {
+	_, err := c.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "userID", Value: 1}, {Key: "createdAt", Value: -1}},
+	})
+	return err
+}
+
+func bookmarkID(userID, movieID string) string {
+	return movieID + ":" + userID
+}
+
+func (r *UserBookmarkRepository) AddBookmark(ctx context.Context, bookmark Bookmark) error {
+	filter := bson.M{"_id": bookmarkID(bookmark.UserID, bookmark.MovieID)}
+	update := bson.M{"$setOnInsert": bookmark}
+	opts := options.UpdateOne().SetUpsert(true)
+
+	result, err := r.coll.UpdateOne(ctx, filter, update, opts)
+
+	if err != nil {
+		return fmt.Errorf("failed to add bookmark %v: %w", bookmark, err)
+	}
+
+	if result.UpsertedCount == 0 {
+		return ErrAlreadyExists
+	}
+
+	return nil
+}
+
+func (r *UserBookmarkRepository) RemoveBookmark(ctx context.Context, userID, movieID string) error {
+	result, err := r.coll.DeleteOne(ctx, bson.M{"_id": bookmarkID(userID, movieID)})
+
+	if err != nil {
+		return fmt.Errorf("failed to remove bookmark of user %v for movie %v: %w", userID, movieID, err)
+	}
+
+	if result.DeletedCount == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *UserBookmarkRepository) ListBookmarks(ctx context.Context, userID string, offset, limit int) ([]Bookmark, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "createdAt", Value: -1}, {Key: "_id", Value: 1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+
+	cursor, err := r.coll.Find(ctx, bson.M{"userID": userID}, opts)
+	if err != nil {
+		return []Bookmark{}, fmt.Errorf("failed to find bookmarks of %v: %w", userID, err)
+	}
+
+	bookmarks := []Bookmark{}
+	if err := cursor.All(ctx, &bookmarks); err != nil {
+		return []Bookmark{}, fmt.Errorf("failed to decode bookmarks of %v: %w", userID, err)
+	}
+
+	return bookmarks, nil
+}
+
+func (r *UserBookmarkRepository) DeleteUserBookmarks(ctx context.Context, userID string) error {
+	if _, err := r.coll.DeleteMany(ctx, bson.M{"userID": userID}); err != nil {
+		return fmt.Errorf("failed to delete bookmarks of user %v: %w", userID, err)
+	}
+
+	return nil
+}
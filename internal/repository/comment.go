@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type ReviewCommentRepository struct {
+	coll *mongo.Collection
+}
+
+func NewReviewCommentRepository(c *mongo.Collection) CommentRepository {
+	return &ReviewCommentRepository{
+		coll: c,
+	}
+}
+
+// CreateCommentIndexes creates the indexes used to list comments of a review
+// and of an author in order.
+func CreateCommentIndexes(ctx context.Context, c *mongo.Collection) error {
+	_, err := c.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "movieID", Value: 1},
+				{Key: "reviewUserID", Value: 1},
+				{Key: "parentID", Value: 1},
+				{Key: "createdAt", Value: 1},
+			},
+		},
+		{Keys: bson.D{{Key: "authorID", Value: 1}, {Key: "createdAt", Value: 1}}},
+	})
+	return err
+}
+
+func (r *ReviewCommentRepository) AddComment(ctx context.Context, comment Comment) (Comment, error) {
+	comment.ID = bson.NewObjectID().Hex()
+
+	_, err := r.coll.InsertOne(ctx, comment)
+
+	if err != nil {
+		return Comment{}, fmt.Errorf("failed to insert comment %v: %w", comment, err)
+	}
+
+	return comment, nil
+}
+
+func (r *ReviewCommentRepository) GetComment(ctx context.Context, ID string) (Comment, error) {
+	var comment Comment
+
+	err := r.coll.FindOne(ctx, bson.M{"_id": ID}).Decode(&comment)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return Comment{}, ErrNotFound
+	} else if err != nil {
+		return Comment{}, fmt.Errorf("failed to find comment %v: %w", ID, err)
+	}
+
+	return comment, nil
+}
+
+func (r *ReviewCommentRepository) ListComments(ctx context.Context, reviewUserID, movieID string, offset, limit int) ([]Comment, error) {
+	filter := bson.M{"movieID": movieID, "reviewUserID": reviewUserID, "parentID": ""}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+
+	return r.find(ctx, filter, opts)
+}
+
+func (r *ReviewCommentRepository) ListReplies(ctx context.Context, parentIDs []string) ([]Comment, error) {
+	if len(parentIDs) == 0 {
+		return []Comment{}, nil
+	}
+
+	filter := bson.M{"parentID": bson.M{"$in": parentIDs}}
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}})
+
+	return r.find(ctx, filter, opts)
+}
+
+func (r *ReviewCommentRepository) UpdateComment(ctx context.Context, ID, text string, updatedAt time.Time) (Comment, error) {
+	var comment Comment
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err := r.coll.FindOneAndUpdate(ctx, bson.M{"_id": ID}, bson.M{
+		"$set": bson.M{
+			"text":      text,
+			"updatedAt": updatedAt,
+		},
+	}, opts).Decode(&comment)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return Comment{}, ErrNotFound
+	} else if err != nil {
+		return Comment{}, fmt.Errorf("failed to update comment %v: %w", ID, err)
+	}
+
+	return comment, nil
+}
+
+func (r *ReviewCommentRepository) DeleteComment(ctx context.Context, ID string) error {
+	filter := bson.M{"$or": bson.A{bson.M{"_id": ID}, bson.M{"parentID": ID}}}
+
+	result, err := r.coll.DeleteMany(ctx, filter)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete comment %v: %w", ID, err)
+	}
+
+	if result.DeletedCount == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *ReviewCommentRepository) ListCommentsBy(ctx context.Context, authorID string) ([]Comment, error) {
+	filter := bson.M{"authorID": authorID}
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}})
+
+	return r.find(ctx, filter, opts)
+}
+
+func (r *ReviewCommentRepository) find(ctx context.Context, filter bson.M, opts *options.FindOptionsBuilder) ([]Comment, error) {
+	cursor, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return []Comment{}, fmt.Errorf("failed to find comments: %w", err)
+	}
+
+	comments := []Comment{}
+	if err := cursor.All(ctx, &comments); err != nil {
+		return []Comment{}, fmt.Errorf("failed to decode comments: %w", err)
+	}
+
+	return comments, nil
+}
+
+func (r *ReviewCommentRepository) DeleteUserComments(ctx context.Context, userID string) error {
+	written, err := r.ListCommentsBy(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	IDs := make(bson.A, 0, len(written))
+	for _, comment := range written {
+		IDs = append(IDs, comment.ID)
+	}
+
+	filter := bson.M{"$or": bson.A{
+		bson.M{"authorID": userID},
+		bson.M{"reviewUserID": userID},
+		bson.M{"parentID": bson.M{"$in": IDs}},
+	}}
+
+	if _, err := r.coll.DeleteMany(ctx, filter); err != nil {
+		return fmt.Errorf("failed to delete comments of user %v: %w", userID, err)
+	}
+
+	return nil
+}
+
+func (r *ReviewCommentRepository) DeleteReviewComments(ctx context.Context, reviewUserID, movieID string) error {
+	// replies carry the ids of the review as well
+	if _, err := r.coll.DeleteMany(ctx, bson.M{"movieID": movieID, "reviewUserID": reviewUserID}); err != nil {
+		return fmt.Errorf("failed to delete comments on user %v review for movie %v: %w", reviewUserID, movieID, err)
+	}
+
+	return nil
+}
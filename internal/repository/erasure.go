@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// UserErasureRepository stores one document per user being erased, keyed by the
+// user id. The document lives only until the erasure is finished.
+type UserErasureRepository struct {
+	coll *mongo.Collection
+}
+
+func NewUserErasureRepository(c *mongo.Collection) ErasureRepository {
+	return &UserErasureRepository{
+		coll: c,
+	}
+}
+
+func (r *UserErasureRepository) StartErasure(ctx context.Context, userID string, startedAt time.Time) (Erasure, error) {
+	var erasure Erasure
+
+	update := bson.M{"$setOnInsert": bson.M{
+		"movieIDs":  bson.A{},
+		"done":      bson.A{},
+		"startedAt": startedAt,
+	}}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	err := r.coll.FindOneAndUpdate(ctx, bson.M{"_id": userID}, update, opts).Decode(&erasure)
+
+	if err != nil {
+		return Erasure{}, fmt.Errorf("failed to start erasure of user %v: %w", userID, err)
+	}
+
+	return erasure, nil
+}
+
+func (r *UserErasureRepository) AddMovies(ctx context.Context, userID string, movieIDs []string) error {
+	if len(movieIDs) == 0 {
+		return nil
+	}
+
+	update := bson.M{"$addToSet": bson.M{"movieIDs": bson.M{"$each": movieIDs}}}
+
+	if _, err := r.coll.UpdateOne(ctx, bson.M{"_id": userID}, update); err != nil {
+		return fmt.Errorf("failed to add movies to erasure of user %v: %w", userID, err)
+	}
+
+	return nil
+}
+
+func (r *UserErasureRepository) CompleteStep(ctx context.Context, userID string, step ErasureStep) error {
+	update := bson.M{"$addToSet": bson.M{"done": step}}
+
+	if _, err := r.coll.UpdateOne(ctx, bson.M{"_id": userID}, update); err != nil {
+		return fmt.Errorf("failed to complete %v step of user %v erasure: %w", step, userID, err)
+	}
+
+	return nil
+}
+
+func (r *UserErasureRepository) FinishErasure(ctx context.Context, userID string) error {
+	if _, err := r.coll.DeleteOne(ctx, bson.M{"_id": userID}); err != nil {
+		return fmt.Errorf("failed to finish erasure of user %v: %w", userID, err)
+	}
+
+	return nil
+}
+
+func (r *UserErasureRepository) ListErasures(ctx context.Context) ([]Erasure, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "startedAt", Value: 1}})
+
+	cursor, err := r.coll.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return []Erasure{}, fmt.Errorf("failed to find erasures: %w", err)
+	}
+
+	erasures := []Erasure{}
+	if err := cursor.All(ctx, &erasures); err != nil {
+		return []Erasure{}, fmt.Errorf("failed to decode erasures: %w", err)
+	}
+
+	return erasures, nil
+}
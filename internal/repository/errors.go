@@ -5,4 +5,6 @@ import "errors"
 var (
 	ErrNotFound      = errors.New("not found")
 	ErrAlreadyExists = errors.New("already exists")
+	// ErrVersionConflict is returned when the review was changed since the given version was read.
+	ErrVersionConflict = errors.New("version conflict")
 )
@@ -0,0 +1,773 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/maisiq/go-ugc-service/internal/repository.AnalyticsRepository -o analytics_repository_mock.go -n AnalyticsRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_repository "github.com/maisiq/go-ugc-service/internal/repository"
+)
+
+// AnalyticsRepositoryMock implements mm_repository.AnalyticsRepository
+type AnalyticsRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDeleteUserEvents          func(ctx context.Context, userID string) (err error)
+	funcDeleteUserEventsOrigin    string
+	inspectFuncDeleteUserEvents   func(ctx context.Context, userID string)
+	afterDeleteUserEventsCounter  uint64
+	beforeDeleteUserEventsCounter uint64
+	DeleteUserEventsMock          mAnalyticsRepositoryMockDeleteUserEvents
+
+	funcListUserEvents          func(ctx context.Context, userID string) (aa1 []mm_repository.AnalyticsEvent, err error)
+	funcListUserEventsOrigin    string
+	inspectFuncListUserEvents   func(ctx context.Context, userID string)
+	afterListUserEventsCounter  uint64
+	beforeListUserEventsCounter uint64
+	ListUserEventsMock          mAnalyticsRepositoryMockListUserEvents
+}
+
+// NewAnalyticsRepositoryMock returns a mock for mm_repository.AnalyticsRepository
+func NewAnalyticsRepositoryMock(t minimock.Tester) *AnalyticsRepositoryMock {
+	m := &AnalyticsRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteUserEventsMock = mAnalyticsRepositoryMockDeleteUserEvents{mock: m}
+	m.DeleteUserEventsMock.callArgs = []*AnalyticsRepositoryMockDeleteUserEventsParams{}
+
+	m.ListUserEventsMock = mAnalyticsRepositoryMockListUserEvents{mock: m}
+	m.ListUserEventsMock.callArgs = []*AnalyticsRepositoryMockListUserEventsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAnalyticsRepositoryMockDeleteUserEvents struct {
+	optional           bool
+	mock               *AnalyticsRepositoryMock
+	defaultExpectation *AnalyticsRepositoryMockDeleteUserEventsExpectation
+	expectations       []*AnalyticsRepositoryMockDeleteUserEventsExpectation
+
+	callArgs []*AnalyticsRepositoryMockDeleteUserEventsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AnalyticsRepositoryMockDeleteUserEventsExpectation specifies expectation struct of the AnalyticsRepository.DeleteUserEvents
+type AnalyticsRepositoryMockDeleteUserEventsExpectation struct {
+	mock               *AnalyticsRepositoryMock
+	params             *AnalyticsRepositoryMockDeleteUserEventsParams
+	paramPtrs          *AnalyticsRepositoryMockDeleteUserEventsParamPtrs
+	expectationOrigins AnalyticsRepositoryMockDeleteUserEventsExpectationOrigins
+	results            *AnalyticsRepositoryMockDeleteUserEventsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AnalyticsRepositoryMockDeleteUserEventsParams contains parameters of the AnalyticsRepository.DeleteUserEvents
+type AnalyticsRepositoryMockDeleteUserEventsParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// AnalyticsRepositoryMockDeleteUserEventsParamPtrs contains pointers to parameters of the AnalyticsRepository.DeleteUserEvents
+type AnalyticsRepositoryMockDeleteUserEventsParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// AnalyticsRepositoryMockDeleteUserEventsResults contains results of the AnalyticsRepository.DeleteUserEvents
+type AnalyticsRepositoryMockDeleteUserEventsResults struct {
+	err error
+}
+
+// AnalyticsRepositoryMockDeleteUserEventsOrigins contains origins of expectations of the AnalyticsRepository.DeleteUserEvents
+type AnalyticsRepositoryMockDeleteUserEventsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteUserEvents *mAnalyticsRepositoryMockDeleteUserEvents) Optional() *mAnalyticsRepositoryMockDeleteUserEvents {
+	mmDeleteUserEvents.optional = true
+	return mmDeleteUserEvents
+}
+
+// Expect sets up expected params for AnalyticsRepository.DeleteUserEvents
+func (mmDeleteUserEvents *mAnalyticsRepositoryMockDeleteUserEvents) Expect(ctx context.Context, userID string) *mAnalyticsRepositoryMockDeleteUserEvents {
+	if mmDeleteUserEvents.mock.funcDeleteUserEvents != nil {
+		mmDeleteUserEvents.mock.t.Fatalf("AnalyticsRepositoryMock.DeleteUserEvents mock is already set by Set")
+	}
+
+	if mmDeleteUserEvents.defaultExpectation == nil {
+		mmDeleteUserEvents.defaultExpectation = &AnalyticsRepositoryMockDeleteUserEventsExpectation{}
+	}
+
+	if mmDeleteUserEvents.defaultExpectation.paramPtrs != nil {
+		mmDeleteUserEvents.mock.t.Fatalf("AnalyticsRepositoryMock.DeleteUserEvents mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteUserEvents.defaultExpectation.params = &AnalyticsRepositoryMockDeleteUserEventsParams{ctx, userID}
+	mmDeleteUserEvents.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteUserEvents.expectations {
+		if minimock.Equal(e.params, mmDeleteUserEvents.defaultExpectation.params) {
+			mmDeleteUserEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUserEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteUserEvents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AnalyticsRepository.DeleteUserEvents
+func (mmDeleteUserEvents *mAnalyticsRepositoryMockDeleteUserEvents) ExpectCtxParam1(ctx context.Context) *mAnalyticsRepositoryMockDeleteUserEvents {
+	if mmDeleteUserEvents.mock.funcDeleteUserEvents != nil {
+		mmDeleteUserEvents.mock.t.Fatalf("AnalyticsRepositoryMock.DeleteUserEvents mock is already set by Set")
+	}
+
+	if mmDeleteUserEvents.defaultExpectation == nil {
+		mmDeleteUserEvents.defaultExpectation = &AnalyticsRepositoryMockDeleteUserEventsExpectation{}
+	}
+
+	if mmDeleteUserEvents.defaultExpectation.params != nil {
+		mmDeleteUserEvents.mock.t.Fatalf("AnalyticsRepositoryMock.DeleteUserEvents mock is already set by Expect")
+	}
+
+	if mmDeleteUserEvents.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserEvents.defaultExpectation.paramPtrs = &AnalyticsRepositoryMockDeleteUserEventsParamPtrs{}
+	}
+	mmDeleteUserEvents.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteUserEvents.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteUserEvents
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AnalyticsRepository.DeleteUserEvents
+func (mmDeleteUserEvents *mAnalyticsRepositoryMockDeleteUserEvents) ExpectUserIDParam2(userID string) *mAnalyticsRepositoryMockDeleteUserEvents {
+	if mmDeleteUserEvents.mock.funcDeleteUserEvents != nil {
+		mmDeleteUserEvents.mock.t.Fatalf("AnalyticsRepositoryMock.DeleteUserEvents mock is already set by Set")
+	}
+
+	if mmDeleteUserEvents.defaultExpectation == nil {
+		mmDeleteUserEvents.defaultExpectation = &AnalyticsRepositoryMockDeleteUserEventsExpectation{}
+	}
+
+	if mmDeleteUserEvents.defaultExpectation.params != nil {
+		mmDeleteUserEvents.mock.t.Fatalf("AnalyticsRepositoryMock.DeleteUserEvents mock is already set by Expect")
+	}
+
+	if mmDeleteUserEvents.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserEvents.defaultExpectation.paramPtrs = &AnalyticsRepositoryMockDeleteUserEventsParamPtrs{}
+	}
+	mmDeleteUserEvents.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteUserEvents.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteUserEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the AnalyticsRepository.DeleteUserEvents
+func (mmDeleteUserEvents *mAnalyticsRepositoryMockDeleteUserEvents) Inspect(f func(ctx context.Context, userID string)) *mAnalyticsRepositoryMockDeleteUserEvents {
+	if mmDeleteUserEvents.mock.inspectFuncDeleteUserEvents != nil {
+		mmDeleteUserEvents.mock.t.Fatalf("Inspect function is already set for AnalyticsRepositoryMock.DeleteUserEvents")
+	}
+
+	mmDeleteUserEvents.mock.inspectFuncDeleteUserEvents = f
+
+	return mmDeleteUserEvents
+}
+
+// Return sets up results that will be returned by AnalyticsRepository.DeleteUserEvents
+func (mmDeleteUserEvents *mAnalyticsRepositoryMockDeleteUserEvents) Return(err error) *AnalyticsRepositoryMock {
+	if mmDeleteUserEvents.mock.funcDeleteUserEvents != nil {
+		mmDeleteUserEvents.mock.t.Fatalf("AnalyticsRepositoryMock.DeleteUserEvents mock is already set by Set")
+	}
+
+	if mmDeleteUserEvents.defaultExpectation == nil {
+		mmDeleteUserEvents.defaultExpectation = &AnalyticsRepositoryMockDeleteUserEventsExpectation{mock: mmDeleteUserEvents.mock}
+	}
+	mmDeleteUserEvents.defaultExpectation.results = &AnalyticsRepositoryMockDeleteUserEventsResults{err}
+	mmDeleteUserEvents.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserEvents.mock
+}
+
+// Set uses given function f to mock the AnalyticsRepository.DeleteUserEvents method
+func (mmDeleteUserEvents *mAnalyticsRepositoryMockDeleteUserEvents) Set(f func(ctx context.Context, userID string) (err error)) *AnalyticsRepositoryMock {
+	if mmDeleteUserEvents.defaultExpectation != nil {
+		mmDeleteUserEvents.mock.t.Fatalf("Default expectation is already set for the AnalyticsRepository.DeleteUserEvents method")
+	}
+
+	if len(mmDeleteUserEvents.expectations) > 0 {
+		mmDeleteUserEvents.mock.t.Fatalf("Some expectations are already set for the AnalyticsRepository.DeleteUserEvents method")
+	}
+
+	mmDeleteUserEvents.mock.funcDeleteUserEvents = f
+	mmDeleteUserEvents.mock.funcDeleteUserEventsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserEvents.mock
+}
+
+// When sets expectation for the AnalyticsRepository.DeleteUserEvents which will trigger the result defined by the following
+// Then helper
+func (mmDeleteUserEvents *mAnalyticsRepositoryMockDeleteUserEvents) When(ctx context.Context, userID string) *AnalyticsRepositoryMockDeleteUserEventsExpectation {
+	if mmDeleteUserEvents.mock.funcDeleteUserEvents != nil {
+		mmDeleteUserEvents.mock.t.Fatalf("AnalyticsRepositoryMock.DeleteUserEvents mock is already set by Set")
+	}
+
+	expectation := &AnalyticsRepositoryMockDeleteUserEventsExpectation{
+		mock:               mmDeleteUserEvents.mock,
+		params:             &AnalyticsRepositoryMockDeleteUserEventsParams{ctx, userID},
+		expectationOrigins: AnalyticsRepositoryMockDeleteUserEventsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteUserEvents.expectations = append(mmDeleteUserEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AnalyticsRepository.DeleteUserEvents return parameters for the expectation previously defined by the When method
+func (e *AnalyticsRepositoryMockDeleteUserEventsExpectation) Then(err error) *AnalyticsRepositoryMock {
+	e.results = &AnalyticsRepositoryMockDeleteUserEventsResults{err}
+	return e.mock
+}
+
+// Times sets number of times AnalyticsRepository.DeleteUserEvents should be invoked
+func (mmDeleteUserEvents *mAnalyticsRepositoryMockDeleteUserEvents) Times(n uint64) *mAnalyticsRepositoryMockDeleteUserEvents {
+	if n == 0 {
+		mmDeleteUserEvents.mock.t.Fatalf("Times of AnalyticsRepositoryMock.DeleteUserEvents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteUserEvents.expectedInvocations, n)
+	mmDeleteUserEvents.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserEvents
+}
+
+func (mmDeleteUserEvents *mAnalyticsRepositoryMockDeleteUserEvents) invocationsDone() bool {
+	if len(mmDeleteUserEvents.expectations) == 0 && mmDeleteUserEvents.defaultExpectation == nil && mmDeleteUserEvents.mock.funcDeleteUserEvents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUserEvents.mock.afterDeleteUserEventsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUserEvents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteUserEvents implements mm_repository.AnalyticsRepository
+func (mmDeleteUserEvents *AnalyticsRepositoryMock) DeleteUserEvents(ctx context.Context, userID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteUserEvents.beforeDeleteUserEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUserEvents.afterDeleteUserEventsCounter, 1)
+
+	mmDeleteUserEvents.t.Helper()
+
+	if mmDeleteUserEvents.inspectFuncDeleteUserEvents != nil {
+		mmDeleteUserEvents.inspectFuncDeleteUserEvents(ctx, userID)
+	}
+
+	mm_params := AnalyticsRepositoryMockDeleteUserEventsParams{ctx, userID}
+
+	// Record call args
+	mmDeleteUserEvents.DeleteUserEventsMock.mutex.Lock()
+	mmDeleteUserEvents.DeleteUserEventsMock.callArgs = append(mmDeleteUserEvents.DeleteUserEventsMock.callArgs, &mm_params)
+	mmDeleteUserEvents.DeleteUserEventsMock.mutex.Unlock()
+
+	for _, e := range mmDeleteUserEvents.DeleteUserEventsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteUserEvents.DeleteUserEventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUserEvents.DeleteUserEventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUserEvents.DeleteUserEventsMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUserEvents.DeleteUserEventsMock.defaultExpectation.paramPtrs
+
+		mm_got := AnalyticsRepositoryMockDeleteUserEventsParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteUserEvents.t.Errorf("AnalyticsRepositoryMock.DeleteUserEvents got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserEvents.DeleteUserEventsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteUserEvents.t.Errorf("AnalyticsRepositoryMock.DeleteUserEvents got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserEvents.DeleteUserEventsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteUserEvents.t.Errorf("AnalyticsRepositoryMock.DeleteUserEvents got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteUserEvents.DeleteUserEventsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteUserEvents.DeleteUserEventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteUserEvents.t.Fatal("No results are set for the AnalyticsRepositoryMock.DeleteUserEvents")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteUserEvents.funcDeleteUserEvents != nil {
+		return mmDeleteUserEvents.funcDeleteUserEvents(ctx, userID)
+	}
+	mmDeleteUserEvents.t.Fatalf("Unexpected call to AnalyticsRepositoryMock.DeleteUserEvents. %v %v", ctx, userID)
+	return
+}
+
+// DeleteUserEventsAfterCounter returns a count of finished AnalyticsRepositoryMock.DeleteUserEvents invocations
+func (mmDeleteUserEvents *AnalyticsRepositoryMock) DeleteUserEventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserEvents.afterDeleteUserEventsCounter)
+}
+
+// DeleteUserEventsBeforeCounter returns a count of AnalyticsRepositoryMock.DeleteUserEvents invocations
+func (mmDeleteUserEvents *AnalyticsRepositoryMock) DeleteUserEventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserEvents.beforeDeleteUserEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AnalyticsRepositoryMock.DeleteUserEvents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteUserEvents *mAnalyticsRepositoryMockDeleteUserEvents) Calls() []*AnalyticsRepositoryMockDeleteUserEventsParams {
+	mmDeleteUserEvents.mutex.RLock()
+
+	argCopy := make([]*AnalyticsRepositoryMockDeleteUserEventsParams, len(mmDeleteUserEvents.callArgs))
+	copy(argCopy, mmDeleteUserEvents.callArgs)
+
+	mmDeleteUserEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteUserEventsDone returns true if the count of the DeleteUserEvents invocations corresponds
+// the number of defined expectations
+func (m *AnalyticsRepositoryMock) MinimockDeleteUserEventsDone() bool {
+	if m.DeleteUserEventsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteUserEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteUserEventsMock.invocationsDone()
+}
+
+// MinimockDeleteUserEventsInspect logs each unmet expectation
+func (m *AnalyticsRepositoryMock) MinimockDeleteUserEventsInspect() {
+	for _, e := range m.DeleteUserEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AnalyticsRepositoryMock.DeleteUserEvents at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteUserEventsCounter := mm_atomic.LoadUint64(&m.afterDeleteUserEventsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteUserEventsMock.defaultExpectation != nil && afterDeleteUserEventsCounter < 1 {
+		if m.DeleteUserEventsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AnalyticsRepositoryMock.DeleteUserEvents at\n%s", m.DeleteUserEventsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AnalyticsRepositoryMock.DeleteUserEvents at\n%s with params: %#v", m.DeleteUserEventsMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserEventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteUserEvents != nil && afterDeleteUserEventsCounter < 1 {
+		m.t.Errorf("Expected call to AnalyticsRepositoryMock.DeleteUserEvents at\n%s", m.funcDeleteUserEventsOrigin)
+	}
+
+	if !m.DeleteUserEventsMock.invocationsDone() && afterDeleteUserEventsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AnalyticsRepositoryMock.DeleteUserEvents at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUserEventsMock.expectedInvocations), m.DeleteUserEventsMock.expectedInvocationsOrigin, afterDeleteUserEventsCounter)
+	}
+}
+
+type mAnalyticsRepositoryMockListUserEvents struct {
+	optional           bool
+	mock               *AnalyticsRepositoryMock
+	defaultExpectation *AnalyticsRepositoryMockListUserEventsExpectation
+	expectations       []*AnalyticsRepositoryMockListUserEventsExpectation
+
+	callArgs []*AnalyticsRepositoryMockListUserEventsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AnalyticsRepositoryMockListUserEventsExpectation specifies expectation struct of the AnalyticsRepository.ListUserEvents
+type AnalyticsRepositoryMockListUserEventsExpectation struct {
+	mock               *AnalyticsRepositoryMock
+	params             *AnalyticsRepositoryMockListUserEventsParams
+	paramPtrs          *AnalyticsRepositoryMockListUserEventsParamPtrs
+	expectationOrigins AnalyticsRepositoryMockListUserEventsExpectationOrigins
+	results            *AnalyticsRepositoryMockListUserEventsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AnalyticsRepositoryMockListUserEventsParams contains parameters of the AnalyticsRepository.ListUserEvents
+type AnalyticsRepositoryMockListUserEventsParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// AnalyticsRepositoryMockListUserEventsParamPtrs contains pointers to parameters of the AnalyticsRepository.ListUserEvents
+type AnalyticsRepositoryMockListUserEventsParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// AnalyticsRepositoryMockListUserEventsResults contains results of the AnalyticsRepository.ListUserEvents
+type AnalyticsRepositoryMockListUserEventsResults struct {
+	aa1 []mm_repository.AnalyticsEvent
+	err error
+}
+
+// AnalyticsRepositoryMockListUserEventsOrigins contains origins of expectations of the AnalyticsRepository.ListUserEvents
+type AnalyticsRepositoryMockListUserEventsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListUserEvents *mAnalyticsRepositoryMockListUserEvents) Optional() *mAnalyticsRepositoryMockListUserEvents {
+	mmListUserEvents.optional = true
+	return mmListUserEvents
+}
+
+// Expect sets up expected params for AnalyticsRepository.ListUserEvents
+func (mmListUserEvents *mAnalyticsRepositoryMockListUserEvents) Expect(ctx context.Context, userID string) *mAnalyticsRepositoryMockListUserEvents {
+	if mmListUserEvents.mock.funcListUserEvents != nil {
+		mmListUserEvents.mock.t.Fatalf("AnalyticsRepositoryMock.ListUserEvents mock is already set by Set")
+	}
+
+	if mmListUserEvents.defaultExpectation == nil {
+		mmListUserEvents.defaultExpectation = &AnalyticsRepositoryMockListUserEventsExpectation{}
+	}
+
+	if mmListUserEvents.defaultExpectation.paramPtrs != nil {
+		mmListUserEvents.mock.t.Fatalf("AnalyticsRepositoryMock.ListUserEvents mock is already set by ExpectParams functions")
+	}
+
+	mmListUserEvents.defaultExpectation.params = &AnalyticsRepositoryMockListUserEventsParams{ctx, userID}
+	mmListUserEvents.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListUserEvents.expectations {
+		if minimock.Equal(e.params, mmListUserEvents.defaultExpectation.params) {
+			mmListUserEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListUserEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmListUserEvents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AnalyticsRepository.ListUserEvents
+func (mmListUserEvents *mAnalyticsRepositoryMockListUserEvents) ExpectCtxParam1(ctx context.Context) *mAnalyticsRepositoryMockListUserEvents {
+	if mmListUserEvents.mock.funcListUserEvents != nil {
+		mmListUserEvents.mock.t.Fatalf("AnalyticsRepositoryMock.ListUserEvents mock is already set by Set")
+	}
+
+	if mmListUserEvents.defaultExpectation == nil {
+		mmListUserEvents.defaultExpectation = &AnalyticsRepositoryMockListUserEventsExpectation{}
+	}
+
+	if mmListUserEvents.defaultExpectation.params != nil {
+		mmListUserEvents.mock.t.Fatalf("AnalyticsRepositoryMock.ListUserEvents mock is already set by Expect")
+	}
+
+	if mmListUserEvents.defaultExpectation.paramPtrs == nil {
+		mmListUserEvents.defaultExpectation.paramPtrs = &AnalyticsRepositoryMockListUserEventsParamPtrs{}
+	}
+	mmListUserEvents.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListUserEvents.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListUserEvents
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AnalyticsRepository.ListUserEvents
+func (mmListUserEvents *mAnalyticsRepositoryMockListUserEvents) ExpectUserIDParam2(userID string) *mAnalyticsRepositoryMockListUserEvents {
+	if mmListUserEvents.mock.funcListUserEvents != nil {
+		mmListUserEvents.mock.t.Fatalf("AnalyticsRepositoryMock.ListUserEvents mock is already set by Set")
+	}
+
+	if mmListUserEvents.defaultExpectation == nil {
+		mmListUserEvents.defaultExpectation = &AnalyticsRepositoryMockListUserEventsExpectation{}
+	}
+
+	if mmListUserEvents.defaultExpectation.params != nil {
+		mmListUserEvents.mock.t.Fatalf("AnalyticsRepositoryMock.ListUserEvents mock is already set by Expect")
+	}
+
+	if mmListUserEvents.defaultExpectation.paramPtrs == nil {
+		mmListUserEvents.defaultExpectation.paramPtrs = &AnalyticsRepositoryMockListUserEventsParamPtrs{}
+	}
+	mmListUserEvents.defaultExpectation.paramPtrs.userID = &userID
+	mmListUserEvents.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListUserEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the AnalyticsRepository.ListUserEvents
+func (mmListUserEvents *mAnalyticsRepositoryMockListUserEvents) Inspect(f func(ctx context.Context, userID string)) *mAnalyticsRepositoryMockListUserEvents {
+	if mmListUserEvents.mock.inspectFuncListUserEvents != nil {
+		mmListUserEvents.mock.t.Fatalf("Inspect function is already set for AnalyticsRepositoryMock.ListUserEvents")
+	}
+
+	mmListUserEvents.mock.inspectFuncListUserEvents = f
+
+	return mmListUserEvents
+}
+
+// Return sets up results that will be returned by AnalyticsRepository.ListUserEvents
+func (mmListUserEvents *mAnalyticsRepositoryMockListUserEvents) Return(aa1 []mm_repository.AnalyticsEvent, err error) *AnalyticsRepositoryMock {
+	if mmListUserEvents.mock.funcListUserEvents != nil {
+		mmListUserEvents.mock.t.Fatalf("AnalyticsRepositoryMock.ListUserEvents mock is already set by Set")
+	}
+
+	if mmListUserEvents.defaultExpectation == nil {
+		mmListUserEvents.defaultExpectation = &AnalyticsRepositoryMockListUserEventsExpectation{mock: mmListUserEvents.mock}
+	}
+	mmListUserEvents.defaultExpectation.results = &AnalyticsRepositoryMockListUserEventsResults{aa1, err}
+	mmListUserEvents.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListUserEvents.mock
+}
+
+// Set uses given function f to mock the AnalyticsRepository.ListUserEvents method
+func (mmListUserEvents *mAnalyticsRepositoryMockListUserEvents) Set(f func(ctx context.Context, userID string) (aa1 []mm_repository.AnalyticsEvent, err error)) *AnalyticsRepositoryMock {
+	if mmListUserEvents.defaultExpectation != nil {
+		mmListUserEvents.mock.t.Fatalf("Default expectation is already set for the AnalyticsRepository.ListUserEvents method")
+	}
+
+	if len(mmListUserEvents.expectations) > 0 {
+		mmListUserEvents.mock.t.Fatalf("Some expectations are already set for the AnalyticsRepository.ListUserEvents method")
+	}
+
+	mmListUserEvents.mock.funcListUserEvents = f
+	mmListUserEvents.mock.funcListUserEventsOrigin = minimock.CallerInfo(1)
+	return mmListUserEvents.mock
+}
+
+// When sets expectation for the AnalyticsRepository.ListUserEvents which will trigger the result defined by the following
+// Then helper
+func (mmListUserEvents *mAnalyticsRepositoryMockListUserEvents) When(ctx context.Context, userID string) *AnalyticsRepositoryMockListUserEventsExpectation {
+	if mmListUserEvents.mock.funcListUserEvents != nil {
+		mmListUserEvents.mock.t.Fatalf("AnalyticsRepositoryMock.ListUserEvents mock is already set by Set")
+	}
+
+	expectation := &AnalyticsRepositoryMockListUserEventsExpectation{
+		mock:               mmListUserEvents.mock,
+		params:             &AnalyticsRepositoryMockListUserEventsParams{ctx, userID},
+		expectationOrigins: AnalyticsRepositoryMockListUserEventsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListUserEvents.expectations = append(mmListUserEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AnalyticsRepository.ListUserEvents return parameters for the expectation previously defined by the When method
+func (e *AnalyticsRepositoryMockListUserEventsExpectation) Then(aa1 []mm_repository.AnalyticsEvent, err error) *AnalyticsRepositoryMock {
+	e.results = &AnalyticsRepositoryMockListUserEventsResults{aa1, err}
+	return e.mock
+}
+
+// Times sets number of times AnalyticsRepository.ListUserEvents should be invoked
+func (mmListUserEvents *mAnalyticsRepositoryMockListUserEvents) Times(n uint64) *mAnalyticsRepositoryMockListUserEvents {
+	if n == 0 {
+		mmListUserEvents.mock.t.Fatalf("Times of AnalyticsRepositoryMock.ListUserEvents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListUserEvents.expectedInvocations, n)
+	mmListUserEvents.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListUserEvents
+}
+
+func (mmListUserEvents *mAnalyticsRepositoryMockListUserEvents) invocationsDone() bool {
+	if len(mmListUserEvents.expectations) == 0 && mmListUserEvents.defaultExpectation == nil && mmListUserEvents.mock.funcListUserEvents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListUserEvents.mock.afterListUserEventsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListUserEvents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListUserEvents implements mm_repository.AnalyticsRepository
+func (mmListUserEvents *AnalyticsRepositoryMock) ListUserEvents(ctx context.Context, userID string) (aa1 []mm_repository.AnalyticsEvent, err error) {
+	mm_atomic.AddUint64(&mmListUserEvents.beforeListUserEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListUserEvents.afterListUserEventsCounter, 1)
+
+	mmListUserEvents.t.Helper()
+
+	if mmListUserEvents.inspectFuncListUserEvents != nil {
+		mmListUserEvents.inspectFuncListUserEvents(ctx, userID)
+	}
+
+	mm_params := AnalyticsRepositoryMockListUserEventsParams{ctx, userID}
+
+	// Record call args
+	mmListUserEvents.ListUserEventsMock.mutex.Lock()
+	mmListUserEvents.ListUserEventsMock.callArgs = append(mmListUserEvents.ListUserEventsMock.callArgs, &mm_params)
+	mmListUserEvents.ListUserEventsMock.mutex.Unlock()
+
+	for _, e := range mmListUserEvents.ListUserEventsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.aa1, e.results.err
+		}
+	}
+
+	if mmListUserEvents.ListUserEventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListUserEvents.ListUserEventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListUserEvents.ListUserEventsMock.defaultExpectation.params
+		mm_want_ptrs := mmListUserEvents.ListUserEventsMock.defaultExpectation.paramPtrs
+
+		mm_got := AnalyticsRepositoryMockListUserEventsParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListUserEvents.t.Errorf("AnalyticsRepositoryMock.ListUserEvents got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUserEvents.ListUserEventsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListUserEvents.t.Errorf("AnalyticsRepositoryMock.ListUserEvents got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUserEvents.ListUserEventsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListUserEvents.t.Errorf("AnalyticsRepositoryMock.ListUserEvents got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListUserEvents.ListUserEventsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListUserEvents.ListUserEventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListUserEvents.t.Fatal("No results are set for the AnalyticsRepositoryMock.ListUserEvents")
+		}
+		return (*mm_results).aa1, (*mm_results).err
+	}
+	if mmListUserEvents.funcListUserEvents != nil {
+		return mmListUserEvents.funcListUserEvents(ctx, userID)
+	}
+	mmListUserEvents.t.Fatalf("Unexpected call to AnalyticsRepositoryMock.ListUserEvents. %v %v", ctx, userID)
+	return
+}
+
+// ListUserEventsAfterCounter returns a count of finished AnalyticsRepositoryMock.ListUserEvents invocations
+func (mmListUserEvents *AnalyticsRepositoryMock) ListUserEventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUserEvents.afterListUserEventsCounter)
+}
+
+// ListUserEventsBeforeCounter returns a count of AnalyticsRepositoryMock.ListUserEvents invocations
+func (mmListUserEvents *AnalyticsRepositoryMock) ListUserEventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUserEvents.beforeListUserEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AnalyticsRepositoryMock.ListUserEvents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListUserEvents *mAnalyticsRepositoryMockListUserEvents) Calls() []*AnalyticsRepositoryMockListUserEventsParams {
+	mmListUserEvents.mutex.RLock()
+
+	argCopy := make([]*AnalyticsRepositoryMockListUserEventsParams, len(mmListUserEvents.callArgs))
+	copy(argCopy, mmListUserEvents.callArgs)
+
+	mmListUserEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListUserEventsDone returns true if the count of the ListUserEvents invocations corresponds
+// the number of defined expectations
+func (m *AnalyticsRepositoryMock) MinimockListUserEventsDone() bool {
+	if m.ListUserEventsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListUserEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListUserEventsMock.invocationsDone()
+}
+
+// MinimockListUserEventsInspect logs each unmet expectation
+func (m *AnalyticsRepositoryMock) MinimockListUserEventsInspect() {
+	for _, e := range m.ListUserEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AnalyticsRepositoryMock.ListUserEvents at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListUserEventsCounter := mm_atomic.LoadUint64(&m.afterListUserEventsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListUserEventsMock.defaultExpectation != nil && afterListUserEventsCounter < 1 {
+		if m.ListUserEventsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AnalyticsRepositoryMock.ListUserEvents at\n%s", m.ListUserEventsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AnalyticsRepositoryMock.ListUserEvents at\n%s with params: %#v", m.ListUserEventsMock.defaultExpectation.expectationOrigins.origin, *m.ListUserEventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListUserEvents != nil && afterListUserEventsCounter < 1 {
+		m.t.Errorf("Expected call to AnalyticsRepositoryMock.ListUserEvents at\n%s", m.funcListUserEventsOrigin)
+	}
+
+	if !m.ListUserEventsMock.invocationsDone() && afterListUserEventsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AnalyticsRepositoryMock.ListUserEvents at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListUserEventsMock.expectedInvocations), m.ListUserEventsMock.expectedInvocationsOrigin, afterListUserEventsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AnalyticsRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDeleteUserEventsInspect()
+
+			m.MinimockListUserEventsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AnalyticsRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AnalyticsRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeleteUserEventsDone() &&
+		m.MinimockListUserEventsDone()
+}
@@ -0,0 +1,1576 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/maisiq/go-ugc-service/internal/repository.BookmarkRepository -o bookmark_repository_mock.go -n BookmarkRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_repository "github.com/maisiq/go-ugc-service/internal/repository"
+)
+
+// BookmarkRepositoryMock implements mm_repository.BookmarkRepository
+type BookmarkRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddBookmark          func(ctx context.Context, bookmark mm_repository.Bookmark) (err error)
+	funcAddBookmarkOrigin    string
+	inspectFuncAddBookmark   func(ctx context.Context, bookmark mm_repository.Bookmark)
+	afterAddBookmarkCounter  uint64
+	beforeAddBookmarkCounter uint64
+	AddBookmarkMock          mBookmarkRepositoryMockAddBookmark
+
+	funcDeleteUserBookmarks          func(ctx context.Context, userID string) (err error)
+	funcDeleteUserBookmarksOrigin    string
+	inspectFuncDeleteUserBookmarks   func(ctx context.Context, userID string)
+	afterDeleteUserBookmarksCounter  uint64
+	beforeDeleteUserBookmarksCounter uint64
+	DeleteUserBookmarksMock          mBookmarkRepositoryMockDeleteUserBookmarks
+
+	funcListBookmarks          func(ctx context.Context, userID string, offset int, limit int) (ba1 []mm_repository.Bookmark, err error)
+	funcListBookmarksOrigin    string
+	inspectFuncListBookmarks   func(ctx context.Context, userID string, offset int, limit int)
+	afterListBookmarksCounter  uint64
+	beforeListBookmarksCounter uint64
+	ListBookmarksMock          mBookmarkRepositoryMockListBookmarks
+
+	funcRemoveBookmark          func(ctx context.Context, userID string, movieID string) (err error)
+	funcRemoveBookmarkOrigin    string
+	inspectFuncRemoveBookmark   func(ctx context.Context, userID string, movieID string)
+	afterRemoveBookmarkCounter  uint64
+	beforeRemoveBookmarkCounter uint64
+	RemoveBookmarkMock          mBookmarkRepositoryMockRemoveBookmark
+}
+
+// NewBookmarkRepositoryMock returns a mock for mm_repository.BookmarkRepository
+func NewBookmarkRepositoryMock(t minimock.Tester) *BookmarkRepositoryMock {
+	m := &BookmarkRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddBookmarkMock = mBookmarkRepositoryMockAddBookmark{mock: m}
+	m.AddBookmarkMock.callArgs = []*BookmarkRepositoryMockAddBookmarkParams{}
+
+	m.DeleteUserBookmarksMock = mBookmarkRepositoryMockDeleteUserBookmarks{mock: m}
+	m.DeleteUserBookmarksMock.callArgs = []*BookmarkRepositoryMockDeleteUserBookmarksParams{}
+
+	m.ListBookmarksMock = mBookmarkRepositoryMockListBookmarks{mock: m}
+	m.ListBookmarksMock.callArgs = []*BookmarkRepositoryMockListBookmarksParams{}
+
+	m.RemoveBookmarkMock = mBookmarkRepositoryMockRemoveBookmark{mock: m}
+	m.RemoveBookmarkMock.callArgs = []*BookmarkRepositoryMockRemoveBookmarkParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mBookmarkRepositoryMockAddBookmark struct {
+	optional           bool
+	mock               *BookmarkRepositoryMock
+	defaultExpectation *BookmarkRepositoryMockAddBookmarkExpectation
+	expectations       []*BookmarkRepositoryMockAddBookmarkExpectation
+
+	callArgs []*BookmarkRepositoryMockAddBookmarkParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BookmarkRepositoryMockAddBookmarkExpectation specifies expectation struct of the BookmarkRepository.AddBookmark
+type BookmarkRepositoryMockAddBookmarkExpectation struct {
+	mock               *BookmarkRepositoryMock
+	params             *BookmarkRepositoryMockAddBookmarkParams
+	paramPtrs          *BookmarkRepositoryMockAddBookmarkParamPtrs
+	expectationOrigins BookmarkRepositoryMockAddBookmarkExpectationOrigins
+	results            *BookmarkRepositoryMockAddBookmarkResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BookmarkRepositoryMockAddBookmarkParams contains parameters of the BookmarkRepository.AddBookmark
+type BookmarkRepositoryMockAddBookmarkParams struct {
+	ctx      context.Context
+	bookmark mm_repository.Bookmark
+}
+
+// BookmarkRepositoryMockAddBookmarkParamPtrs contains pointers to parameters of the BookmarkRepository.AddBookmark
+type BookmarkRepositoryMockAddBookmarkParamPtrs struct {
+	ctx      *context.Context
+	bookmark *mm_repository.Bookmark
+}
+
+// BookmarkRepositoryMockAddBookmarkResults contains results of the BookmarkRepository.AddBookmark
+type BookmarkRepositoryMockAddBookmarkResults struct {
+	err error
+}
+
+// BookmarkRepositoryMockAddBookmarkOrigins contains origins of expectations of the BookmarkRepository.AddBookmark
+type BookmarkRepositoryMockAddBookmarkExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originBookmark string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddBookmark *mBookmarkRepositoryMockAddBookmark) Optional() *mBookmarkRepositoryMockAddBookmark {
+	mmAddBookmark.optional = true
+	return mmAddBookmark
+}
+
+// Expect sets up expected params for BookmarkRepository.AddBookmark
+func (mmAddBookmark *mBookmarkRepositoryMockAddBookmark) Expect(ctx context.Context, bookmark mm_repository.Bookmark) *mBookmarkRepositoryMockAddBookmark {
+	if mmAddBookmark.mock.funcAddBookmark != nil {
+		mmAddBookmark.mock.t.Fatalf("BookmarkRepositoryMock.AddBookmark mock is already set by Set")
+	}
+
+	if mmAddBookmark.defaultExpectation == nil {
+		mmAddBookmark.defaultExpectation = &BookmarkRepositoryMockAddBookmarkExpectation{}
+	}
+
+	if mmAddBookmark.defaultExpectation.paramPtrs != nil {
+		mmAddBookmark.mock.t.Fatalf("BookmarkRepositoryMock.AddBookmark mock is already set by ExpectParams functions")
+	}
+
+	mmAddBookmark.defaultExpectation.params = &BookmarkRepositoryMockAddBookmarkParams{ctx, bookmark}
+	mmAddBookmark.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddBookmark.expectations {
+		if minimock.Equal(e.params, mmAddBookmark.defaultExpectation.params) {
+			mmAddBookmark.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddBookmark.defaultExpectation.params)
+		}
+	}
+
+	return mmAddBookmark
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BookmarkRepository.AddBookmark
+func (mmAddBookmark *mBookmarkRepositoryMockAddBookmark) ExpectCtxParam1(ctx context.Context) *mBookmarkRepositoryMockAddBookmark {
+	if mmAddBookmark.mock.funcAddBookmark != nil {
+		mmAddBookmark.mock.t.Fatalf("BookmarkRepositoryMock.AddBookmark mock is already set by Set")
+	}
+
+	if mmAddBookmark.defaultExpectation == nil {
+		mmAddBookmark.defaultExpectation = &BookmarkRepositoryMockAddBookmarkExpectation{}
+	}
+
+	if mmAddBookmark.defaultExpectation.params != nil {
+		mmAddBookmark.mock.t.Fatalf("BookmarkRepositoryMock.AddBookmark mock is already set by Expect")
+	}
+
+	if mmAddBookmark.defaultExpectation.paramPtrs == nil {
+		mmAddBookmark.defaultExpectation.paramPtrs = &BookmarkRepositoryMockAddBookmarkParamPtrs{}
+	}
+	mmAddBookmark.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddBookmark.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddBookmark
+}
+
+// ExpectBookmarkParam2 sets up expected param bookmark for BookmarkRepository.AddBookmark
+func (mmAddBookmark *mBookmarkRepositoryMockAddBookmark) ExpectBookmarkParam2(bookmark mm_repository.Bookmark) *mBookmarkRepositoryMockAddBookmark {
+	if mmAddBookmark.mock.funcAddBookmark != nil {
+		mmAddBookmark.mock.t.Fatalf("BookmarkRepositoryMock.AddBookmark mock is already set by Set")
+	}
+
+	if mmAddBookmark.defaultExpectation == nil {
+		mmAddBookmark.defaultExpectation = &BookmarkRepositoryMockAddBookmarkExpectation{}
+	}
+
+	if mmAddBookmark.defaultExpectation.params != nil {
+		mmAddBookmark.mock.t.Fatalf("BookmarkRepositoryMock.AddBookmark mock is already set by Expect")
+	}
+
+	if mmAddBookmark.defaultExpectation.paramPtrs == nil {
+		mmAddBookmark.defaultExpectation.paramPtrs = &BookmarkRepositoryMockAddBookmarkParamPtrs{}
+	}
+	mmAddBookmark.defaultExpectation.paramPtrs.bookmark = &bookmark
+	mmAddBookmark.defaultExpectation.expectationOrigins.originBookmark = minimock.CallerInfo(1)
+
+	return mmAddBookmark
+}
+
+// Inspect accepts an inspector function that has same arguments as the BookmarkRepository.AddBookmark
+func (mmAddBookmark *mBookmarkRepositoryMockAddBookmark) Inspect(f func(ctx context.Context, bookmark mm_repository.Bookmark)) *mBookmarkRepositoryMockAddBookmark {
+	if mmAddBookmark.mock.inspectFuncAddBookmark != nil {
+		mmAddBookmark.mock.t.Fatalf("Inspect function is already set for BookmarkRepositoryMock.AddBookmark")
+	}
+
+	mmAddBookmark.mock.inspectFuncAddBookmark = f
+
+	return mmAddBookmark
+}
+
+// Return sets up results that will be returned by BookmarkRepository.AddBookmark
+func (mmAddBookmark *mBookmarkRepositoryMockAddBookmark) Return(err error) *BookmarkRepositoryMock {
+	if mmAddBookmark.mock.funcAddBookmark != nil {
+		mmAddBookmark.mock.t.Fatalf("BookmarkRepositoryMock.AddBookmark mock is already set by Set")
+	}
+
+	if mmAddBookmark.defaultExpectation == nil {
+		mmAddBookmark.defaultExpectation = &BookmarkRepositoryMockAddBookmarkExpectation{mock: mmAddBookmark.mock}
+	}
+	mmAddBookmark.defaultExpectation.results = &BookmarkRepositoryMockAddBookmarkResults{err}
+	mmAddBookmark.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddBookmark.mock
+}
+
+// Set uses given function f to mock the BookmarkRepository.AddBookmark method
+func (mmAddBookmark *mBookmarkRepositoryMockAddBookmark) Set(f func(ctx context.Context, bookmark mm_repository.Bookmark) (err error)) *BookmarkRepositoryMock {
+	if mmAddBookmark.defaultExpectation != nil {
+		mmAddBookmark.mock.t.Fatalf("Default expectation is already set for the BookmarkRepository.AddBookmark method")
+	}
+
+	if len(mmAddBookmark.expectations) > 0 {
+		mmAddBookmark.mock.t.Fatalf("Some expectations are already set for the BookmarkRepository.AddBookmark method")
+	}
+
+	mmAddBookmark.mock.funcAddBookmark = f
+	mmAddBookmark.mock.funcAddBookmarkOrigin = minimock.CallerInfo(1)
+	return mmAddBookmark.mock
+}
+
+// When sets expectation for the BookmarkRepository.AddBookmark which will trigger the result defined by the following
+// Then helper
+func (mmAddBookmark *mBookmarkRepositoryMockAddBookmark) When(ctx context.Context, bookmark mm_repository.Bookmark) *BookmarkRepositoryMockAddBookmarkExpectation {
+	if mmAddBookmark.mock.funcAddBookmark != nil {
+		mmAddBookmark.mock.t.Fatalf("BookmarkRepositoryMock.AddBookmark mock is already set by Set")
+	}
+
+	expectation := &BookmarkRepositoryMockAddBookmarkExpectation{
+		mock:               mmAddBookmark.mock,
+		params:             &BookmarkRepositoryMockAddBookmarkParams{ctx, bookmark},
+		expectationOrigins: BookmarkRepositoryMockAddBookmarkExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddBookmark.expectations = append(mmAddBookmark.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BookmarkRepository.AddBookmark return parameters for the expectation previously defined by the When method
+func (e *BookmarkRepositoryMockAddBookmarkExpectation) Then(err error) *BookmarkRepositoryMock {
+	e.results = &BookmarkRepositoryMockAddBookmarkResults{err}
+	return e.mock
+}
+
+// Times sets number of times BookmarkRepository.AddBookmark should be invoked
+func (mmAddBookmark *mBookmarkRepositoryMockAddBookmark) Times(n uint64) *mBookmarkRepositoryMockAddBookmark {
+	if n == 0 {
+		mmAddBookmark.mock.t.Fatalf("Times of BookmarkRepositoryMock.AddBookmark mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddBookmark.expectedInvocations, n)
+	mmAddBookmark.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddBookmark
+}
+
+func (mmAddBookmark *mBookmarkRepositoryMockAddBookmark) invocationsDone() bool {
+	if len(mmAddBookmark.expectations) == 0 && mmAddBookmark.defaultExpectation == nil && mmAddBookmark.mock.funcAddBookmark == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddBookmark.mock.afterAddBookmarkCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddBookmark.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddBookmark implements mm_repository.BookmarkRepository
+func (mmAddBookmark *BookmarkRepositoryMock) AddBookmark(ctx context.Context, bookmark mm_repository.Bookmark) (err error) {
+	mm_atomic.AddUint64(&mmAddBookmark.beforeAddBookmarkCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddBookmark.afterAddBookmarkCounter, 1)
+
+	mmAddBookmark.t.Helper()
+
+	if mmAddBookmark.inspectFuncAddBookmark != nil {
+		mmAddBookmark.inspectFuncAddBookmark(ctx, bookmark)
+	}
+
+	mm_params := BookmarkRepositoryMockAddBookmarkParams{ctx, bookmark}
+
+	// Record call args
+	mmAddBookmark.AddBookmarkMock.mutex.Lock()
+	mmAddBookmark.AddBookmarkMock.callArgs = append(mmAddBookmark.AddBookmarkMock.callArgs, &mm_params)
+	mmAddBookmark.AddBookmarkMock.mutex.Unlock()
+
+	for _, e := range mmAddBookmark.AddBookmarkMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddBookmark.AddBookmarkMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddBookmark.AddBookmarkMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddBookmark.AddBookmarkMock.defaultExpectation.params
+		mm_want_ptrs := mmAddBookmark.AddBookmarkMock.defaultExpectation.paramPtrs
+
+		mm_got := BookmarkRepositoryMockAddBookmarkParams{ctx, bookmark}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddBookmark.t.Errorf("BookmarkRepositoryMock.AddBookmark got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddBookmark.AddBookmarkMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.bookmark != nil && !minimock.Equal(*mm_want_ptrs.bookmark, mm_got.bookmark) {
+				mmAddBookmark.t.Errorf("BookmarkRepositoryMock.AddBookmark got unexpected parameter bookmark, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddBookmark.AddBookmarkMock.defaultExpectation.expectationOrigins.originBookmark, *mm_want_ptrs.bookmark, mm_got.bookmark, minimock.Diff(*mm_want_ptrs.bookmark, mm_got.bookmark))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddBookmark.t.Errorf("BookmarkRepositoryMock.AddBookmark got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddBookmark.AddBookmarkMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddBookmark.AddBookmarkMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddBookmark.t.Fatal("No results are set for the BookmarkRepositoryMock.AddBookmark")
+		}
+		return (*mm_results).err
+	}
+	if mmAddBookmark.funcAddBookmark != nil {
+		return mmAddBookmark.funcAddBookmark(ctx, bookmark)
+	}
+	mmAddBookmark.t.Fatalf("Unexpected call to BookmarkRepositoryMock.AddBookmark. %v %v", ctx, bookmark)
+	return
+}
+
+// AddBookmarkAfterCounter returns a count of finished BookmarkRepositoryMock.AddBookmark invocations
+func (mmAddBookmark *BookmarkRepositoryMock) AddBookmarkAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddBookmark.afterAddBookmarkCounter)
+}
+
+// AddBookmarkBeforeCounter returns a count of BookmarkRepositoryMock.AddBookmark invocations
+func (mmAddBookmark *BookmarkRepositoryMock) AddBookmarkBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddBookmark.beforeAddBookmarkCounter)
+}
+
+// Calls returns a list of arguments used in each call to BookmarkRepositoryMock.AddBookmark.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddBookmark *mBookmarkRepositoryMockAddBookmark) Calls() []*BookmarkRepositoryMockAddBookmarkParams {
+	mmAddBookmark.mutex.RLock()
+
+	argCopy := make([]*BookmarkRepositoryMockAddBookmarkParams, len(mmAddBookmark.callArgs))
+	copy(argCopy, mmAddBookmark.callArgs)
+
+	mmAddBookmark.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddBookmarkDone returns true if the count of the AddBookmark invocations corresponds
+// the number of defined expectations
+func (m *BookmarkRepositoryMock) MinimockAddBookmarkDone() bool {
+	if m.AddBookmarkMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddBookmarkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddBookmarkMock.invocationsDone()
+}
+
+// MinimockAddBookmarkInspect logs each unmet expectation
+func (m *BookmarkRepositoryMock) MinimockAddBookmarkInspect() {
+	for _, e := range m.AddBookmarkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BookmarkRepositoryMock.AddBookmark at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddBookmarkCounter := mm_atomic.LoadUint64(&m.afterAddBookmarkCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddBookmarkMock.defaultExpectation != nil && afterAddBookmarkCounter < 1 {
+		if m.AddBookmarkMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BookmarkRepositoryMock.AddBookmark at\n%s", m.AddBookmarkMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BookmarkRepositoryMock.AddBookmark at\n%s with params: %#v", m.AddBookmarkMock.defaultExpectation.expectationOrigins.origin, *m.AddBookmarkMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddBookmark != nil && afterAddBookmarkCounter < 1 {
+		m.t.Errorf("Expected call to BookmarkRepositoryMock.AddBookmark at\n%s", m.funcAddBookmarkOrigin)
+	}
+
+	if !m.AddBookmarkMock.invocationsDone() && afterAddBookmarkCounter > 0 {
+		m.t.Errorf("Expected %d calls to BookmarkRepositoryMock.AddBookmark at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddBookmarkMock.expectedInvocations), m.AddBookmarkMock.expectedInvocationsOrigin, afterAddBookmarkCounter)
+	}
+}
+
+type mBookmarkRepositoryMockDeleteUserBookmarks struct {
+	optional           bool
+	mock               *BookmarkRepositoryMock
+	defaultExpectation *BookmarkRepositoryMockDeleteUserBookmarksExpectation
+	expectations       []*BookmarkRepositoryMockDeleteUserBookmarksExpectation
+
+	callArgs []*BookmarkRepositoryMockDeleteUserBookmarksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BookmarkRepositoryMockDeleteUserBookmarksExpectation specifies expectation struct of the BookmarkRepository.DeleteUserBookmarks
+type BookmarkRepositoryMockDeleteUserBookmarksExpectation struct {
+	mock               *BookmarkRepositoryMock
+	params             *BookmarkRepositoryMockDeleteUserBookmarksParams
+	paramPtrs          *BookmarkRepositoryMockDeleteUserBookmarksParamPtrs
+	expectationOrigins BookmarkRepositoryMockDeleteUserBookmarksExpectationOrigins
+	results            *BookmarkRepositoryMockDeleteUserBookmarksResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BookmarkRepositoryMockDeleteUserBookmarksParams contains parameters of the BookmarkRepository.DeleteUserBookmarks
+type BookmarkRepositoryMockDeleteUserBookmarksParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// BookmarkRepositoryMockDeleteUserBookmarksParamPtrs contains pointers to parameters of the BookmarkRepository.DeleteUserBookmarks
+type BookmarkRepositoryMockDeleteUserBookmarksParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// BookmarkRepositoryMockDeleteUserBookmarksResults contains results of the BookmarkRepository.DeleteUserBookmarks
+type BookmarkRepositoryMockDeleteUserBookmarksResults struct {
+	err error
+}
+
+// BookmarkRepositoryMockDeleteUserBookmarksOrigins contains origins of expectations of the BookmarkRepository.DeleteUserBookmarks
+type BookmarkRepositoryMockDeleteUserBookmarksExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteUserBookmarks *mBookmarkRepositoryMockDeleteUserBookmarks) Optional() *mBookmarkRepositoryMockDeleteUserBookmarks {
+	mmDeleteUserBookmarks.optional = true
+	return mmDeleteUserBookmarks
+}
+
+// Expect sets up expected params for BookmarkRepository.DeleteUserBookmarks
+func (mmDeleteUserBookmarks *mBookmarkRepositoryMockDeleteUserBookmarks) Expect(ctx context.Context, userID string) *mBookmarkRepositoryMockDeleteUserBookmarks {
+	if mmDeleteUserBookmarks.mock.funcDeleteUserBookmarks != nil {
+		mmDeleteUserBookmarks.mock.t.Fatalf("BookmarkRepositoryMock.DeleteUserBookmarks mock is already set by Set")
+	}
+
+	if mmDeleteUserBookmarks.defaultExpectation == nil {
+		mmDeleteUserBookmarks.defaultExpectation = &BookmarkRepositoryMockDeleteUserBookmarksExpectation{}
+	}
+
+	if mmDeleteUserBookmarks.defaultExpectation.paramPtrs != nil {
+		mmDeleteUserBookmarks.mock.t.Fatalf("BookmarkRepositoryMock.DeleteUserBookmarks mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteUserBookmarks.defaultExpectation.params = &BookmarkRepositoryMockDeleteUserBookmarksParams{ctx, userID}
+	mmDeleteUserBookmarks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteUserBookmarks.expectations {
+		if minimock.Equal(e.params, mmDeleteUserBookmarks.defaultExpectation.params) {
+			mmDeleteUserBookmarks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUserBookmarks.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteUserBookmarks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BookmarkRepository.DeleteUserBookmarks
+func (mmDeleteUserBookmarks *mBookmarkRepositoryMockDeleteUserBookmarks) ExpectCtxParam1(ctx context.Context) *mBookmarkRepositoryMockDeleteUserBookmarks {
+	if mmDeleteUserBookmarks.mock.funcDeleteUserBookmarks != nil {
+		mmDeleteUserBookmarks.mock.t.Fatalf("BookmarkRepositoryMock.DeleteUserBookmarks mock is already set by Set")
+	}
+
+	if mmDeleteUserBookmarks.defaultExpectation == nil {
+		mmDeleteUserBookmarks.defaultExpectation = &BookmarkRepositoryMockDeleteUserBookmarksExpectation{}
+	}
+
+	if mmDeleteUserBookmarks.defaultExpectation.params != nil {
+		mmDeleteUserBookmarks.mock.t.Fatalf("BookmarkRepositoryMock.DeleteUserBookmarks mock is already set by Expect")
+	}
+
+	if mmDeleteUserBookmarks.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserBookmarks.defaultExpectation.paramPtrs = &BookmarkRepositoryMockDeleteUserBookmarksParamPtrs{}
+	}
+	mmDeleteUserBookmarks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteUserBookmarks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteUserBookmarks
+}
+
+// ExpectUserIDParam2 sets up expected param userID for BookmarkRepository.DeleteUserBookmarks
+func (mmDeleteUserBookmarks *mBookmarkRepositoryMockDeleteUserBookmarks) ExpectUserIDParam2(userID string) *mBookmarkRepositoryMockDeleteUserBookmarks {
+	if mmDeleteUserBookmarks.mock.funcDeleteUserBookmarks != nil {
+		mmDeleteUserBookmarks.mock.t.Fatalf("BookmarkRepositoryMock.DeleteUserBookmarks mock is already set by Set")
+	}
+
+	if mmDeleteUserBookmarks.defaultExpectation == nil {
+		mmDeleteUserBookmarks.defaultExpectation = &BookmarkRepositoryMockDeleteUserBookmarksExpectation{}
+	}
+
+	if mmDeleteUserBookmarks.defaultExpectation.params != nil {
+		mmDeleteUserBookmarks.mock.t.Fatalf("BookmarkRepositoryMock.DeleteUserBookmarks mock is already set by Expect")
+	}
+
+	if mmDeleteUserBookmarks.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserBookmarks.defaultExpectation.paramPtrs = &BookmarkRepositoryMockDeleteUserBookmarksParamPtrs{}
+	}
+	mmDeleteUserBookmarks.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteUserBookmarks.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteUserBookmarks
+}
+
+// Inspect accepts an inspector function that has same arguments as the BookmarkRepository.DeleteUserBookmarks
+func (mmDeleteUserBookmarks *mBookmarkRepositoryMockDeleteUserBookmarks) Inspect(f func(ctx context.Context, userID string)) *mBookmarkRepositoryMockDeleteUserBookmarks {
+	if mmDeleteUserBookmarks.mock.inspectFuncDeleteUserBookmarks != nil {
+		mmDeleteUserBookmarks.mock.t.Fatalf("Inspect function is already set for BookmarkRepositoryMock.DeleteUserBookmarks")
+	}
+
+	mmDeleteUserBookmarks.mock.inspectFuncDeleteUserBookmarks = f
+
+	return mmDeleteUserBookmarks
+}
+
+// Return sets up results that will be returned by BookmarkRepository.DeleteUserBookmarks
+func (mmDeleteUserBookmarks *mBookmarkRepositoryMockDeleteUserBookmarks) Return(err error) *BookmarkRepositoryMock {
+	if mmDeleteUserBookmarks.mock.funcDeleteUserBookmarks != nil {
+		mmDeleteUserBookmarks.mock.t.Fatalf("BookmarkRepositoryMock.DeleteUserBookmarks mock is already set by Set")
+	}
+
+	if mmDeleteUserBookmarks.defaultExpectation == nil {
+		mmDeleteUserBookmarks.defaultExpectation = &BookmarkRepositoryMockDeleteUserBookmarksExpectation{mock: mmDeleteUserBookmarks.mock}
+	}
+	mmDeleteUserBookmarks.defaultExpectation.results = &BookmarkRepositoryMockDeleteUserBookmarksResults{err}
+	mmDeleteUserBookmarks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserBookmarks.mock
+}
+
+// Set uses given function f to mock the BookmarkRepository.DeleteUserBookmarks method
+func (mmDeleteUserBookmarks *mBookmarkRepositoryMockDeleteUserBookmarks) Set(f func(ctx context.Context, userID string) (err error)) *BookmarkRepositoryMock {
+	if mmDeleteUserBookmarks.defaultExpectation != nil {
+		mmDeleteUserBookmarks.mock.t.Fatalf("Default expectation is already set for the BookmarkRepository.DeleteUserBookmarks method")
+	}
+
+	if len(mmDeleteUserBookmarks.expectations) > 0 {
+		mmDeleteUserBookmarks.mock.t.Fatalf("Some expectations are already set for the BookmarkRepository.DeleteUserBookmarks method")
+	}
+
+	mmDeleteUserBookmarks.mock.funcDeleteUserBookmarks = f
+	mmDeleteUserBookmarks.mock.funcDeleteUserBookmarksOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserBookmarks.mock
+}
+
+// When sets expectation for the BookmarkRepository.DeleteUserBookmarks which will trigger the result defined by the following
+// Then helper
+func (mmDeleteUserBookmarks *mBookmarkRepositoryMockDeleteUserBookmarks) When(ctx context.Context, userID string) *BookmarkRepositoryMockDeleteUserBookmarksExpectation {
+	if mmDeleteUserBookmarks.mock.funcDeleteUserBookmarks != nil {
+		mmDeleteUserBookmarks.mock.t.Fatalf("BookmarkRepositoryMock.DeleteUserBookmarks mock is already set by Set")
+	}
+
+	expectation := &BookmarkRepositoryMockDeleteUserBookmarksExpectation{
+		mock:               mmDeleteUserBookmarks.mock,
+		params:             &BookmarkRepositoryMockDeleteUserBookmarksParams{ctx, userID},
+		expectationOrigins: BookmarkRepositoryMockDeleteUserBookmarksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteUserBookmarks.expectations = append(mmDeleteUserBookmarks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BookmarkRepository.DeleteUserBookmarks return parameters for the expectation previously defined by the When method
+func (e *BookmarkRepositoryMockDeleteUserBookmarksExpectation) Then(err error) *BookmarkRepositoryMock {
+	e.results = &BookmarkRepositoryMockDeleteUserBookmarksResults{err}
+	return e.mock
+}
+
+// Times sets number of times BookmarkRepository.DeleteUserBookmarks should be invoked
+func (mmDeleteUserBookmarks *mBookmarkRepositoryMockDeleteUserBookmarks) Times(n uint64) *mBookmarkRepositoryMockDeleteUserBookmarks {
+	if n == 0 {
+		mmDeleteUserBookmarks.mock.t.Fatalf("Times of BookmarkRepositoryMock.DeleteUserBookmarks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteUserBookmarks.expectedInvocations, n)
+	mmDeleteUserBookmarks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserBookmarks
+}
+
+func (mmDeleteUserBookmarks *mBookmarkRepositoryMockDeleteUserBookmarks) invocationsDone() bool {
+	if len(mmDeleteUserBookmarks.expectations) == 0 && mmDeleteUserBookmarks.defaultExpectation == nil && mmDeleteUserBookmarks.mock.funcDeleteUserBookmarks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUserBookmarks.mock.afterDeleteUserBookmarksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUserBookmarks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteUserBookmarks implements mm_repository.BookmarkRepository
+func (mmDeleteUserBookmarks *BookmarkRepositoryMock) DeleteUserBookmarks(ctx context.Context, userID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteUserBookmarks.beforeDeleteUserBookmarksCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUserBookmarks.afterDeleteUserBookmarksCounter, 1)
+
+	mmDeleteUserBookmarks.t.Helper()
+
+	if mmDeleteUserBookmarks.inspectFuncDeleteUserBookmarks != nil {
+		mmDeleteUserBookmarks.inspectFuncDeleteUserBookmarks(ctx, userID)
+	}
+
+	mm_params := BookmarkRepositoryMockDeleteUserBookmarksParams{ctx, userID}
+
+	// Record call args
+	mmDeleteUserBookmarks.DeleteUserBookmarksMock.mutex.Lock()
+	mmDeleteUserBookmarks.DeleteUserBookmarksMock.callArgs = append(mmDeleteUserBookmarks.DeleteUserBookmarksMock.callArgs, &mm_params)
+	mmDeleteUserBookmarks.DeleteUserBookmarksMock.mutex.Unlock()
+
+	for _, e := range mmDeleteUserBookmarks.DeleteUserBookmarksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteUserBookmarks.DeleteUserBookmarksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUserBookmarks.DeleteUserBookmarksMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUserBookmarks.DeleteUserBookmarksMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUserBookmarks.DeleteUserBookmarksMock.defaultExpectation.paramPtrs
+
+		mm_got := BookmarkRepositoryMockDeleteUserBookmarksParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteUserBookmarks.t.Errorf("BookmarkRepositoryMock.DeleteUserBookmarks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserBookmarks.DeleteUserBookmarksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteUserBookmarks.t.Errorf("BookmarkRepositoryMock.DeleteUserBookmarks got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserBookmarks.DeleteUserBookmarksMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteUserBookmarks.t.Errorf("BookmarkRepositoryMock.DeleteUserBookmarks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteUserBookmarks.DeleteUserBookmarksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteUserBookmarks.DeleteUserBookmarksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteUserBookmarks.t.Fatal("No results are set for the BookmarkRepositoryMock.DeleteUserBookmarks")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteUserBookmarks.funcDeleteUserBookmarks != nil {
+		return mmDeleteUserBookmarks.funcDeleteUserBookmarks(ctx, userID)
+	}
+	mmDeleteUserBookmarks.t.Fatalf("Unexpected call to BookmarkRepositoryMock.DeleteUserBookmarks. %v %v", ctx, userID)
+	return
+}
+
+// DeleteUserBookmarksAfterCounter returns a count of finished BookmarkRepositoryMock.DeleteUserBookmarks invocations
+func (mmDeleteUserBookmarks *BookmarkRepositoryMock) DeleteUserBookmarksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserBookmarks.afterDeleteUserBookmarksCounter)
+}
+
+// DeleteUserBookmarksBeforeCounter returns a count of BookmarkRepositoryMock.DeleteUserBookmarks invocations
+func (mmDeleteUserBookmarks *BookmarkRepositoryMock) DeleteUserBookmarksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserBookmarks.beforeDeleteUserBookmarksCounter)
+}
+
+// Calls returns a list of arguments used in each call to BookmarkRepositoryMock.DeleteUserBookmarks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteUserBookmarks *mBookmarkRepositoryMockDeleteUserBookmarks) Calls() []*BookmarkRepositoryMockDeleteUserBookmarksParams {
+	mmDeleteUserBookmarks.mutex.RLock()
+
+	argCopy := make([]*BookmarkRepositoryMockDeleteUserBookmarksParams, len(mmDeleteUserBookmarks.callArgs))
+	copy(argCopy, mmDeleteUserBookmarks.callArgs)
+
+	mmDeleteUserBookmarks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteUserBookmarksDone returns true if the count of the DeleteUserBookmarks invocations corresponds
+// the number of defined expectations
+func (m *BookmarkRepositoryMock) MinimockDeleteUserBookmarksDone() bool {
+	if m.DeleteUserBookmarksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteUserBookmarksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteUserBookmarksMock.invocationsDone()
+}
+
+// MinimockDeleteUserBookmarksInspect logs each unmet expectation
+func (m *BookmarkRepositoryMock) MinimockDeleteUserBookmarksInspect() {
+	for _, e := range m.DeleteUserBookmarksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BookmarkRepositoryMock.DeleteUserBookmarks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteUserBookmarksCounter := mm_atomic.LoadUint64(&m.afterDeleteUserBookmarksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteUserBookmarksMock.defaultExpectation != nil && afterDeleteUserBookmarksCounter < 1 {
+		if m.DeleteUserBookmarksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BookmarkRepositoryMock.DeleteUserBookmarks at\n%s", m.DeleteUserBookmarksMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BookmarkRepositoryMock.DeleteUserBookmarks at\n%s with params: %#v", m.DeleteUserBookmarksMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserBookmarksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteUserBookmarks != nil && afterDeleteUserBookmarksCounter < 1 {
+		m.t.Errorf("Expected call to BookmarkRepositoryMock.DeleteUserBookmarks at\n%s", m.funcDeleteUserBookmarksOrigin)
+	}
+
+	if !m.DeleteUserBookmarksMock.invocationsDone() && afterDeleteUserBookmarksCounter > 0 {
+		m.t.Errorf("Expected %d calls to BookmarkRepositoryMock.DeleteUserBookmarks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUserBookmarksMock.expectedInvocations), m.DeleteUserBookmarksMock.expectedInvocationsOrigin, afterDeleteUserBookmarksCounter)
+	}
+}
+
+type mBookmarkRepositoryMockListBookmarks struct {
+	optional           bool
+	mock               *BookmarkRepositoryMock
+	defaultExpectation *BookmarkRepositoryMockListBookmarksExpectation
+	expectations       []*BookmarkRepositoryMockListBookmarksExpectation
+
+	callArgs []*BookmarkRepositoryMockListBookmarksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BookmarkRepositoryMockListBookmarksExpectation specifies expectation struct of the BookmarkRepository.ListBookmarks
+type BookmarkRepositoryMockListBookmarksExpectation struct {
+	mock               *BookmarkRepositoryMock
+	params             *BookmarkRepositoryMockListBookmarksParams
+	paramPtrs          *BookmarkRepositoryMockListBookmarksParamPtrs
+	expectationOrigins BookmarkRepositoryMockListBookmarksExpectationOrigins
+	results            *BookmarkRepositoryMockListBookmarksResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BookmarkRepositoryMockListBookmarksParams contains parameters of the BookmarkRepository.ListBookmarks
+type BookmarkRepositoryMockListBookmarksParams struct {
+	ctx    context.Context
+	userID string
+	offset int
+	limit  int
+}
+
+// BookmarkRepositoryMockListBookmarksParamPtrs contains pointers to parameters of the BookmarkRepository.ListBookmarks
+type BookmarkRepositoryMockListBookmarksParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+	offset *int
+	limit  *int
+}
+
+// BookmarkRepositoryMockListBookmarksResults contains results of the BookmarkRepository.ListBookmarks
+type BookmarkRepositoryMockListBookmarksResults struct {
+	ba1 []mm_repository.Bookmark
+	err error
+}
+
+// BookmarkRepositoryMockListBookmarksOrigins contains origins of expectations of the BookmarkRepository.ListBookmarks
+type BookmarkRepositoryMockListBookmarksExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originOffset string
+	originLimit  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListBookmarks *mBookmarkRepositoryMockListBookmarks) Optional() *mBookmarkRepositoryMockListBookmarks {
+	mmListBookmarks.optional = true
+	return mmListBookmarks
+}
+
+// Expect sets up expected params for BookmarkRepository.ListBookmarks
+func (mmListBookmarks *mBookmarkRepositoryMockListBookmarks) Expect(ctx context.Context, userID string, offset int, limit int) *mBookmarkRepositoryMockListBookmarks {
+	if mmListBookmarks.mock.funcListBookmarks != nil {
+		mmListBookmarks.mock.t.Fatalf("BookmarkRepositoryMock.ListBookmarks mock is already set by Set")
+	}
+
+	if mmListBookmarks.defaultExpectation == nil {
+		mmListBookmarks.defaultExpectation = &BookmarkRepositoryMockListBookmarksExpectation{}
+	}
+
+	if mmListBookmarks.defaultExpectation.paramPtrs != nil {
+		mmListBookmarks.mock.t.Fatalf("BookmarkRepositoryMock.ListBookmarks mock is already set by ExpectParams functions")
+	}
+
+	mmListBookmarks.defaultExpectation.params = &BookmarkRepositoryMockListBookmarksParams{ctx, userID, offset, limit}
+	mmListBookmarks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListBookmarks.expectations {
+		if minimock.Equal(e.params, mmListBookmarks.defaultExpectation.params) {
+			mmListBookmarks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListBookmarks.defaultExpectation.params)
+		}
+	}
+
+	return mmListBookmarks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BookmarkRepository.ListBookmarks
+func (mmListBookmarks *mBookmarkRepositoryMockListBookmarks) ExpectCtxParam1(ctx context.Context) *mBookmarkRepositoryMockListBookmarks {
+	if mmListBookmarks.mock.funcListBookmarks != nil {
+		mmListBookmarks.mock.t.Fatalf("BookmarkRepositoryMock.ListBookmarks mock is already set by Set")
+	}
+
+	if mmListBookmarks.defaultExpectation == nil {
+		mmListBookmarks.defaultExpectation = &BookmarkRepositoryMockListBookmarksExpectation{}
+	}
+
+	if mmListBookmarks.defaultExpectation.params != nil {
+		mmListBookmarks.mock.t.Fatalf("BookmarkRepositoryMock.ListBookmarks mock is already set by Expect")
+	}
+
+	if mmListBookmarks.defaultExpectation.paramPtrs == nil {
+		mmListBookmarks.defaultExpectation.paramPtrs = &BookmarkRepositoryMockListBookmarksParamPtrs{}
+	}
+	mmListBookmarks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListBookmarks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListBookmarks
+}
+
+// ExpectUserIDParam2 sets up expected param userID for BookmarkRepository.ListBookmarks
+func (mmListBookmarks *mBookmarkRepositoryMockListBookmarks) ExpectUserIDParam2(userID string) *mBookmarkRepositoryMockListBookmarks {
+	if mmListBookmarks.mock.funcListBookmarks != nil {
+		mmListBookmarks.mock.t.Fatalf("BookmarkRepositoryMock.ListBookmarks mock is already set by Set")
+	}
+
+	if mmListBookmarks.defaultExpectation == nil {
+		mmListBookmarks.defaultExpectation = &BookmarkRepositoryMockListBookmarksExpectation{}
+	}
+
+	if mmListBookmarks.defaultExpectation.params != nil {
+		mmListBookmarks.mock.t.Fatalf("BookmarkRepositoryMock.ListBookmarks mock is already set by Expect")
+	}
+
+	if mmListBookmarks.defaultExpectation.paramPtrs == nil {
+		mmListBookmarks.defaultExpectation.paramPtrs = &BookmarkRepositoryMockListBookmarksParamPtrs{}
+	}
+	mmListBookmarks.defaultExpectation.paramPtrs.userID = &userID
+	mmListBookmarks.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListBookmarks
+}
+
+// ExpectOffsetParam3 sets up expected param offset for BookmarkRepository.ListBookmarks
+func (mmListBookmarks *mBookmarkRepositoryMockListBookmarks) ExpectOffsetParam3(offset int) *mBookmarkRepositoryMockListBookmarks {
+	if mmListBookmarks.mock.funcListBookmarks != nil {
+		mmListBookmarks.mock.t.Fatalf("BookmarkRepositoryMock.ListBookmarks mock is already set by Set")
+	}
+
+	if mmListBookmarks.defaultExpectation == nil {
+		mmListBookmarks.defaultExpectation = &BookmarkRepositoryMockListBookmarksExpectation{}
+	}
+
+	if mmListBookmarks.defaultExpectation.params != nil {
+		mmListBookmarks.mock.t.Fatalf("BookmarkRepositoryMock.ListBookmarks mock is already set by Expect")
+	}
+
+	if mmListBookmarks.defaultExpectation.paramPtrs == nil {
+		mmListBookmarks.defaultExpectation.paramPtrs = &BookmarkRepositoryMockListBookmarksParamPtrs{}
+	}
+	mmListBookmarks.defaultExpectation.paramPtrs.offset = &offset
+	mmListBookmarks.defaultExpectation.expectationOrigins.originOffset = minimock.CallerInfo(1)
+
+	return mmListBookmarks
+}
+
+// ExpectLimitParam4 sets up expected param limit for BookmarkRepository.ListBookmarks
+func (mmListBookmarks *mBookmarkRepositoryMockListBookmarks) ExpectLimitParam4(limit int) *mBookmarkRepositoryMockListBookmarks {
+	if mmListBookmarks.mock.funcListBookmarks != nil {
+		mmListBookmarks.mock.t.Fatalf("BookmarkRepositoryMock.ListBookmarks mock is already set by Set")
+	}
+
+	if mmListBookmarks.defaultExpectation == nil {
+		mmListBookmarks.defaultExpectation = &BookmarkRepositoryMockListBookmarksExpectation{}
+	}
+
+	if mmListBookmarks.defaultExpectation.params != nil {
+		mmListBookmarks.mock.t.Fatalf("BookmarkRepositoryMock.ListBookmarks mock is already set by Expect")
+	}
+
+	if mmListBookmarks.defaultExpectation.paramPtrs == nil {
+		mmListBookmarks.defaultExpectation.paramPtrs = &BookmarkRepositoryMockListBookmarksParamPtrs{}
+	}
+	mmListBookmarks.defaultExpectation.paramPtrs.limit = &limit
+	mmListBookmarks.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListBookmarks
+}
+
+// Inspect accepts an inspector function that has same arguments as the BookmarkRepository.ListBookmarks
+func (mmListBookmarks *mBookmarkRepositoryMockListBookmarks) Inspect(f func(ctx context.Context, userID string, offset int, limit int)) *mBookmarkRepositoryMockListBookmarks {
+	if mmListBookmarks.mock.inspectFuncListBookmarks != nil {
+		mmListBookmarks.mock.t.Fatalf("Inspect function is already set for BookmarkRepositoryMock.ListBookmarks")
+	}
+
+	mmListBookmarks.mock.inspectFuncListBookmarks = f
+
+	return mmListBookmarks
+}
+
+// Return sets up results that will be returned by BookmarkRepository.ListBookmarks
+func (mmListBookmarks *mBookmarkRepositoryMockListBookmarks) Return(ba1 []mm_repository.Bookmark, err error) *BookmarkRepositoryMock {
+	if mmListBookmarks.mock.funcListBookmarks != nil {
+		mmListBookmarks.mock.t.Fatalf("BookmarkRepositoryMock.ListBookmarks mock is already set by Set")
+	}
+
+	if mmListBookmarks.defaultExpectation == nil {
+		mmListBookmarks.defaultExpectation = &BookmarkRepositoryMockListBookmarksExpectation{mock: mmListBookmarks.mock}
+	}
+	mmListBookmarks.defaultExpectation.results = &BookmarkRepositoryMockListBookmarksResults{ba1, err}
+	mmListBookmarks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListBookmarks.mock
+}
+
+// Set uses given function f to mock the BookmarkRepository.ListBookmarks method
+func (mmListBookmarks *mBookmarkRepositoryMockListBookmarks) Set(f func(ctx context.Context, userID string, offset int, limit int) (ba1 []mm_repository.Bookmark, err error)) *BookmarkRepositoryMock {
+	if mmListBookmarks.defaultExpectation != nil {
+		mmListBookmarks.mock.t.Fatalf("Default expectation is already set for the BookmarkRepository.ListBookmarks method")
+	}
+
+	if len(mmListBookmarks.expectations) > 0 {
+		mmListBookmarks.mock.t.Fatalf("Some expectations are already set for the BookmarkRepository.ListBookmarks method")
+	}
+
+	mmListBookmarks.mock.funcListBookmarks = f
+	mmListBookmarks.mock.funcListBookmarksOrigin = minimock.CallerInfo(1)
+	return mmListBookmarks.mock
+}
+
+// When sets expectation for the BookmarkRepository.ListBookmarks which will trigger the result defined by the following
+// Then helper
+func (mmListBookmarks *mBookmarkRepositoryMockListBookmarks) When(ctx context.Context, userID string, offset int, limit int) *BookmarkRepositoryMockListBookmarksExpectation {
+	if mmListBookmarks.mock.funcListBookmarks != nil {
+		mmListBookmarks.mock.t.Fatalf("BookmarkRepositoryMock.ListBookmarks mock is already set by Set")
+	}
+
+	expectation := &BookmarkRepositoryMockListBookmarksExpectation{
+		mock:               mmListBookmarks.mock,
+		params:             &BookmarkRepositoryMockListBookmarksParams{ctx, userID, offset, limit},
+		expectationOrigins: BookmarkRepositoryMockListBookmarksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListBookmarks.expectations = append(mmListBookmarks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BookmarkRepository.ListBookmarks return parameters for the expectation previously defined by the When method
+func (e *BookmarkRepositoryMockListBookmarksExpectation) Then(ba1 []mm_repository.Bookmark, err error) *BookmarkRepositoryMock {
+	e.results = &BookmarkRepositoryMockListBookmarksResults{ba1, err}
+	return e.mock
+}
+
+// Times sets number of times BookmarkRepository.ListBookmarks should be invoked
+func (mmListBookmarks *mBookmarkRepositoryMockListBookmarks) Times(n uint64) *mBookmarkRepositoryMockListBookmarks {
+	if n == 0 {
+		mmListBookmarks.mock.t.Fatalf("Times of BookmarkRepositoryMock.ListBookmarks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListBookmarks.expectedInvocations, n)
+	mmListBookmarks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListBookmarks
+}
+
+func (mmListBookmarks *mBookmarkRepositoryMockListBookmarks) invocationsDone() bool {
+	if len(mmListBookmarks.expectations) == 0 && mmListBookmarks.defaultExpectation == nil && mmListBookmarks.mock.funcListBookmarks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListBookmarks.mock.afterListBookmarksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListBookmarks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListBookmarks implements mm_repository.BookmarkRepository
+func (mmListBookmarks *BookmarkRepositoryMock) ListBookmarks(ctx context.Context, userID string, offset int, limit int) (ba1 []mm_repository.Bookmark, err error) {
+	mm_atomic.AddUint64(&mmListBookmarks.beforeListBookmarksCounter, 1)
+	defer mm_atomic.AddUint64(&mmListBookmarks.afterListBookmarksCounter, 1)
+
+	mmListBookmarks.t.Helper()
+
+	if mmListBookmarks.inspectFuncListBookmarks != nil {
+		mmListBookmarks.inspectFuncListBookmarks(ctx, userID, offset, limit)
+	}
+
+	mm_params := BookmarkRepositoryMockListBookmarksParams{ctx, userID, offset, limit}
+
+	// Record call args
+	mmListBookmarks.ListBookmarksMock.mutex.Lock()
+	mmListBookmarks.ListBookmarksMock.callArgs = append(mmListBookmarks.ListBookmarksMock.callArgs, &mm_params)
+	mmListBookmarks.ListBookmarksMock.mutex.Unlock()
+
+	for _, e := range mmListBookmarks.ListBookmarksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ba1, e.results.err
+		}
+	}
+
+	if mmListBookmarks.ListBookmarksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListBookmarks.ListBookmarksMock.defaultExpectation.Counter, 1)
+		mm_want := mmListBookmarks.ListBookmarksMock.defaultExpectation.params
+		mm_want_ptrs := mmListBookmarks.ListBookmarksMock.defaultExpectation.paramPtrs
+
+		mm_got := BookmarkRepositoryMockListBookmarksParams{ctx, userID, offset, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListBookmarks.t.Errorf("BookmarkRepositoryMock.ListBookmarks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListBookmarks.ListBookmarksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListBookmarks.t.Errorf("BookmarkRepositoryMock.ListBookmarks got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListBookmarks.ListBookmarksMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.offset != nil && !minimock.Equal(*mm_want_ptrs.offset, mm_got.offset) {
+				mmListBookmarks.t.Errorf("BookmarkRepositoryMock.ListBookmarks got unexpected parameter offset, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListBookmarks.ListBookmarksMock.defaultExpectation.expectationOrigins.originOffset, *mm_want_ptrs.offset, mm_got.offset, minimock.Diff(*mm_want_ptrs.offset, mm_got.offset))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListBookmarks.t.Errorf("BookmarkRepositoryMock.ListBookmarks got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListBookmarks.ListBookmarksMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListBookmarks.t.Errorf("BookmarkRepositoryMock.ListBookmarks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListBookmarks.ListBookmarksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListBookmarks.ListBookmarksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListBookmarks.t.Fatal("No results are set for the BookmarkRepositoryMock.ListBookmarks")
+		}
+		return (*mm_results).ba1, (*mm_results).err
+	}
+	if mmListBookmarks.funcListBookmarks != nil {
+		return mmListBookmarks.funcListBookmarks(ctx, userID, offset, limit)
+	}
+	mmListBookmarks.t.Fatalf("Unexpected call to BookmarkRepositoryMock.ListBookmarks. %v %v %v %v", ctx, userID, offset, limit)
+	return
+}
+
+// ListBookmarksAfterCounter returns a count of finished BookmarkRepositoryMock.ListBookmarks invocations
+func (mmListBookmarks *BookmarkRepositoryMock) ListBookmarksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListBookmarks.afterListBookmarksCounter)
+}
+
+// ListBookmarksBeforeCounter returns a count of BookmarkRepositoryMock.ListBookmarks invocations
+func (mmListBookmarks *BookmarkRepositoryMock) ListBookmarksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListBookmarks.beforeListBookmarksCounter)
+}
+
+// Calls returns a list of arguments used in each call to BookmarkRepositoryMock.ListBookmarks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListBookmarks *mBookmarkRepositoryMockListBookmarks) Calls() []*BookmarkRepositoryMockListBookmarksParams {
+	mmListBookmarks.mutex.RLock()
+
+	argCopy := make([]*BookmarkRepositoryMockListBookmarksParams, len(mmListBookmarks.callArgs))
+	copy(argCopy, mmListBookmarks.callArgs)
+
+	mmListBookmarks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListBookmarksDone returns true if the count of the ListBookmarks invocations corresponds
+// the number of defined expectations
+func (m *BookmarkRepositoryMock) MinimockListBookmarksDone() bool {
+	if m.ListBookmarksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListBookmarksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListBookmarksMock.invocationsDone()
+}
+
+// MinimockListBookmarksInspect logs each unmet expectation
+func (m *BookmarkRepositoryMock) MinimockListBookmarksInspect() {
+	for _, e := range m.ListBookmarksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BookmarkRepositoryMock.ListBookmarks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListBookmarksCounter := mm_atomic.LoadUint64(&m.afterListBookmarksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListBookmarksMock.defaultExpectation != nil && afterListBookmarksCounter < 1 {
+		if m.ListBookmarksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BookmarkRepositoryMock.ListBookmarks at\n%s", m.ListBookmarksMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BookmarkRepositoryMock.ListBookmarks at\n%s with params: %#v", m.ListBookmarksMock.defaultExpectation.expectationOrigins.origin, *m.ListBookmarksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListBookmarks != nil && afterListBookmarksCounter < 1 {
+		m.t.Errorf("Expected call to BookmarkRepositoryMock.ListBookmarks at\n%s", m.funcListBookmarksOrigin)
+	}
+
+	if !m.ListBookmarksMock.invocationsDone() && afterListBookmarksCounter > 0 {
+		m.t.Errorf("Expected %d calls to BookmarkRepositoryMock.ListBookmarks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListBookmarksMock.expectedInvocations), m.ListBookmarksMock.expectedInvocationsOrigin, afterListBookmarksCounter)
+	}
+}
+
+type mBookmarkRepositoryMockRemoveBookmark struct {
+	optional           bool
+	mock               *BookmarkRepositoryMock
+	defaultExpectation *BookmarkRepositoryMockRemoveBookmarkExpectation
+	expectations       []*BookmarkRepositoryMockRemoveBookmarkExpectation
+
+	callArgs []*BookmarkRepositoryMockRemoveBookmarkParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BookmarkRepositoryMockRemoveBookmarkExpectation specifies expectation struct of the BookmarkRepository.RemoveBookmark
+type BookmarkRepositoryMockRemoveBookmarkExpectation struct {
+	mock               *BookmarkRepositoryMock
+	params             *BookmarkRepositoryMockRemoveBookmarkParams
+	paramPtrs          *BookmarkRepositoryMockRemoveBookmarkParamPtrs
+	expectationOrigins BookmarkRepositoryMockRemoveBookmarkExpectationOrigins
+	results            *BookmarkRepositoryMockRemoveBookmarkResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BookmarkRepositoryMockRemoveBookmarkParams contains parameters of the BookmarkRepository.RemoveBookmark
+type BookmarkRepositoryMockRemoveBookmarkParams struct {
+	ctx     context.Context
+	userID  string
+	movieID string
+}
+
+// BookmarkRepositoryMockRemoveBookmarkParamPtrs contains pointers to parameters of the BookmarkRepository.RemoveBookmark
+type BookmarkRepositoryMockRemoveBookmarkParamPtrs struct {
+	ctx     *context.Context
+	userID  *string
+	movieID *string
+}
+
+// BookmarkRepositoryMockRemoveBookmarkResults contains results of the BookmarkRepository.RemoveBookmark
+type BookmarkRepositoryMockRemoveBookmarkResults struct {
+	err error
+}
+
+// BookmarkRepositoryMockRemoveBookmarkOrigins contains origins of expectations of the BookmarkRepository.RemoveBookmark
+type BookmarkRepositoryMockRemoveBookmarkExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originMovieID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveBookmark *mBookmarkRepositoryMockRemoveBookmark) Optional() *mBookmarkRepositoryMockRemoveBookmark {
+	mmRemoveBookmark.optional = true
+	return mmRemoveBookmark
+}
+
+// Expect sets up expected params for BookmarkRepository.RemoveBookmark
+func (mmRemoveBookmark *mBookmarkRepositoryMockRemoveBookmark) Expect(ctx context.Context, userID string, movieID string) *mBookmarkRepositoryMockRemoveBookmark {
+	if mmRemoveBookmark.mock.funcRemoveBookmark != nil {
+		mmRemoveBookmark.mock.t.Fatalf("BookmarkRepositoryMock.RemoveBookmark mock is already set by Set")
+	}
+
+	if mmRemoveBookmark.defaultExpectation == nil {
+		mmRemoveBookmark.defaultExpectation = &BookmarkRepositoryMockRemoveBookmarkExpectation{}
+	}
+
+	if mmRemoveBookmark.defaultExpectation.paramPtrs != nil {
+		mmRemoveBookmark.mock.t.Fatalf("BookmarkRepositoryMock.RemoveBookmark mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveBookmark.defaultExpectation.params = &BookmarkRepositoryMockRemoveBookmarkParams{ctx, userID, movieID}
+	mmRemoveBookmark.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveBookmark.expectations {
+		if minimock.Equal(e.params, mmRemoveBookmark.defaultExpectation.params) {
+			mmRemoveBookmark.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveBookmark.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveBookmark
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BookmarkRepository.RemoveBookmark
+func (mmRemoveBookmark *mBookmarkRepositoryMockRemoveBookmark) ExpectCtxParam1(ctx context.Context) *mBookmarkRepositoryMockRemoveBookmark {
+	if mmRemoveBookmark.mock.funcRemoveBookmark != nil {
+		mmRemoveBookmark.mock.t.Fatalf("BookmarkRepositoryMock.RemoveBookmark mock is already set by Set")
+	}
+
+	if mmRemoveBookmark.defaultExpectation == nil {
+		mmRemoveBookmark.defaultExpectation = &BookmarkRepositoryMockRemoveBookmarkExpectation{}
+	}
+
+	if mmRemoveBookmark.defaultExpectation.params != nil {
+		mmRemoveBookmark.mock.t.Fatalf("BookmarkRepositoryMock.RemoveBookmark mock is already set by Expect")
+	}
+
+	if mmRemoveBookmark.defaultExpectation.paramPtrs == nil {
+		mmRemoveBookmark.defaultExpectation.paramPtrs = &BookmarkRepositoryMockRemoveBookmarkParamPtrs{}
+	}
+	mmRemoveBookmark.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveBookmark.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveBookmark
+}
+
+// ExpectUserIDParam2 sets up expected param userID for BookmarkRepository.RemoveBookmark
+func (mmRemoveBookmark *mBookmarkRepositoryMockRemoveBookmark) ExpectUserIDParam2(userID string) *mBookmarkRepositoryMockRemoveBookmark {
+	if mmRemoveBookmark.mock.funcRemoveBookmark != nil {
+		mmRemoveBookmark.mock.t.Fatalf("BookmarkRepositoryMock.RemoveBookmark mock is already set by Set")
+	}
+
+	if mmRemoveBookmark.defaultExpectation == nil {
+		mmRemoveBookmark.defaultExpectation = &BookmarkRepositoryMockRemoveBookmarkExpectation{}
+	}
+
+	if mmRemoveBookmark.defaultExpectation.params != nil {
+		mmRemoveBookmark.mock.t.Fatalf("BookmarkRepositoryMock.RemoveBookmark mock is already set by Expect")
+	}
+
+	if mmRemoveBookmark.defaultExpectation.paramPtrs == nil {
+		mmRemoveBookmark.defaultExpectation.paramPtrs = &BookmarkRepositoryMockRemoveBookmarkParamPtrs{}
+	}
+	mmRemoveBookmark.defaultExpectation.paramPtrs.userID = &userID
+	mmRemoveBookmark.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRemoveBookmark
+}
+
+// ExpectMovieIDParam3 sets up expected param movieID for BookmarkRepository.RemoveBookmark
+func (mmRemoveBookmark *mBookmarkRepositoryMockRemoveBookmark) ExpectMovieIDParam3(movieID string) *mBookmarkRepositoryMockRemoveBookmark {
+	if mmRemoveBookmark.mock.funcRemoveBookmark != nil {
+		mmRemoveBookmark.mock.t.Fatalf("BookmarkRepositoryMock.RemoveBookmark mock is already set by Set")
+	}
+
+	if mmRemoveBookmark.defaultExpectation == nil {
+		mmRemoveBookmark.defaultExpectation = &BookmarkRepositoryMockRemoveBookmarkExpectation{}
+	}
+
+	if mmRemoveBookmark.defaultExpectation.params != nil {
+		mmRemoveBookmark.mock.t.Fatalf("BookmarkRepositoryMock.RemoveBookmark mock is already set by Expect")
+	}
+
+	if mmRemoveBookmark.defaultExpectation.paramPtrs == nil {
+		mmRemoveBookmark.defaultExpectation.paramPtrs = &BookmarkRepositoryMockRemoveBookmarkParamPtrs{}
+	}
+	mmRemoveBookmark.defaultExpectation.paramPtrs.movieID = &movieID
+	mmRemoveBookmark.defaultExpectation.expectationOrigins.originMovieID = minimock.CallerInfo(1)
+
+	return mmRemoveBookmark
+}
+
+// Inspect accepts an inspector function that has same arguments as the BookmarkRepository.RemoveBookmark
+func (mmRemoveBookmark *mBookmarkRepositoryMockRemoveBookmark) Inspect(f func(ctx context.Context, userID string, movieID string)) *mBookmarkRepositoryMockRemoveBookmark {
+	if mmRemoveBookmark.mock.inspectFuncRemoveBookmark != nil {
+		mmRemoveBookmark.mock.t.Fatalf("Inspect function is already set for BookmarkRepositoryMock.RemoveBookmark")
+	}
+
+	mmRemoveBookmark.mock.inspectFuncRemoveBookmark = f
+
+	return mmRemoveBookmark
+}
+
+// Return sets up results that will be returned by BookmarkRepository.RemoveBookmark
+func (mmRemoveBookmark *mBookmarkRepositoryMockRemoveBookmark) Return(err error) *BookmarkRepositoryMock {
+	if mmRemoveBookmark.mock.funcRemoveBookmark != nil {
+		mmRemoveBookmark.mock.t.Fatalf("BookmarkRepositoryMock.RemoveBookmark mock is already set by Set")
+	}
+
+	if mmRemoveBookmark.defaultExpectation == nil {
+		mmRemoveBookmark.defaultExpectation = &BookmarkRepositoryMockRemoveBookmarkExpectation{mock: mmRemoveBookmark.mock}
+	}
+	mmRemoveBookmark.defaultExpectation.results = &BookmarkRepositoryMockRemoveBookmarkResults{err}
+	mmRemoveBookmark.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveBookmark.mock
+}
+
+// Set uses given function f to mock the BookmarkRepository.RemoveBookmark method
+func (mmRemoveBookmark *mBookmarkRepositoryMockRemoveBookmark) Set(f func(ctx context.Context, userID string, movieID string) (err error)) *BookmarkRepositoryMock {
+	if mmRemoveBookmark.defaultExpectation != nil {
+		mmRemoveBookmark.mock.t.Fatalf("Default expectation is already set for the BookmarkRepository.RemoveBookmark method")
+	}
+
+	if len(mmRemoveBookmark.expectations) > 0 {
+		mmRemoveBookmark.mock.t.Fatalf("Some expectations are already set for the BookmarkRepository.RemoveBookmark method")
+	}
+
+	mmRemoveBookmark.mock.funcRemoveBookmark = f
+	mmRemoveBookmark.mock.funcRemoveBookmarkOrigin = minimock.CallerInfo(1)
+	return mmRemoveBookmark.mock
+}
+
+// When sets expectation for the BookmarkRepository.RemoveBookmark which will trigger the result defined by the following
+// Then helper
+func (mmRemoveBookmark *mBookmarkRepositoryMockRemoveBookmark) When(ctx context.Context, userID string, movieID string) *BookmarkRepositoryMockRemoveBookmarkExpectation {
+	if mmRemoveBookmark.mock.funcRemoveBookmark != nil {
+		mmRemoveBookmark.mock.t.Fatalf("BookmarkRepositoryMock.RemoveBookmark mock is already set by Set")
+	}
+
+	expectation := &BookmarkRepositoryMockRemoveBookmarkExpectation{
+		mock:               mmRemoveBookmark.mock,
+		params:             &BookmarkRepositoryMockRemoveBookmarkParams{ctx, userID, movieID},
+		expectationOrigins: BookmarkRepositoryMockRemoveBookmarkExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveBookmark.expectations = append(mmRemoveBookmark.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BookmarkRepository.RemoveBookmark return parameters for the expectation previously defined by the When method
+func (e *BookmarkRepositoryMockRemoveBookmarkExpectation) Then(err error) *BookmarkRepositoryMock {
+	e.results = &BookmarkRepositoryMockRemoveBookmarkResults{err}
+	return e.mock
+}
+
+// Times sets number of times BookmarkRepository.RemoveBookmark should be invoked
+func (mmRemoveBookmark *mBookmarkRepositoryMockRemoveBookmark) Times(n uint64) *mBookmarkRepositoryMockRemoveBookmark {
+	if n == 0 {
+		mmRemoveBookmark.mock.t.Fatalf("Times of BookmarkRepositoryMock.RemoveBookmark mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveBookmark.expectedInvocations, n)
+	mmRemoveBookmark.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveBookmark
+}
+
+func (mmRemoveBookmark *mBookmarkRepositoryMockRemoveBookmark) invocationsDone() bool {
+	if len(mmRemoveBookmark.expectations) == 0 && mmRemoveBookmark.defaultExpectation == nil && mmRemoveBookmark.mock.funcRemoveBookmark == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveBookmark.mock.afterRemoveBookmarkCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveBookmark.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveBookmark implements mm_repository.BookmarkRepository
+func (mmRemoveBookmark *BookmarkRepositoryMock) RemoveBookmark(ctx context.Context, userID string, movieID string) (err error) {
+	mm_atomic.AddUint64(&mmRemoveBookmark.beforeRemoveBookmarkCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveBookmark.afterRemoveBookmarkCounter, 1)
+
+	mmRemoveBookmark.t.Helper()
+
+	if mmRemoveBookmark.inspectFuncRemoveBookmark != nil {
+		mmRemoveBookmark.inspectFuncRemoveBookmark(ctx, userID, movieID)
+	}
+
+	mm_params := BookmarkRepositoryMockRemoveBookmarkParams{ctx, userID, movieID}
+
+	// Record call args
+	mmRemoveBookmark.RemoveBookmarkMock.mutex.Lock()
+	mmRemoveBookmark.RemoveBookmarkMock.callArgs = append(mmRemoveBookmark.RemoveBookmarkMock.callArgs, &mm_params)
+	mmRemoveBookmark.RemoveBookmarkMock.mutex.Unlock()
+
+	for _, e := range mmRemoveBookmark.RemoveBookmarkMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveBookmark.RemoveBookmarkMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveBookmark.RemoveBookmarkMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveBookmark.RemoveBookmarkMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveBookmark.RemoveBookmarkMock.defaultExpectation.paramPtrs
+
+		mm_got := BookmarkRepositoryMockRemoveBookmarkParams{ctx, userID, movieID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveBookmark.t.Errorf("BookmarkRepositoryMock.RemoveBookmark got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveBookmark.RemoveBookmarkMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRemoveBookmark.t.Errorf("BookmarkRepositoryMock.RemoveBookmark got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveBookmark.RemoveBookmarkMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.movieID != nil && !minimock.Equal(*mm_want_ptrs.movieID, mm_got.movieID) {
+				mmRemoveBookmark.t.Errorf("BookmarkRepositoryMock.RemoveBookmark got unexpected parameter movieID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveBookmark.RemoveBookmarkMock.defaultExpectation.expectationOrigins.originMovieID, *mm_want_ptrs.movieID, mm_got.movieID, minimock.Diff(*mm_want_ptrs.movieID, mm_got.movieID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveBookmark.t.Errorf("BookmarkRepositoryMock.RemoveBookmark got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveBookmark.RemoveBookmarkMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveBookmark.RemoveBookmarkMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveBookmark.t.Fatal("No results are set for the BookmarkRepositoryMock.RemoveBookmark")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveBookmark.funcRemoveBookmark != nil {
+		return mmRemoveBookmark.funcRemoveBookmark(ctx, userID, movieID)
+	}
+	mmRemoveBookmark.t.Fatalf("Unexpected call to BookmarkRepositoryMock.RemoveBookmark. %v %v %v", ctx, userID, movieID)
+	return
+}
+
+// RemoveBookmarkAfterCounter returns a count of finished BookmarkRepositoryMock.RemoveBookmark invocations
+func (mmRemoveBookmark *BookmarkRepositoryMock) RemoveBookmarkAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveBookmark.afterRemoveBookmarkCounter)
+}
+
+// RemoveBookmarkBeforeCounter returns a count of BookmarkRepositoryMock.RemoveBookmark invocations
+func (mmRemoveBookmark *BookmarkRepositoryMock) RemoveBookmarkBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveBookmark.beforeRemoveBookmarkCounter)
+}
+
+// Calls returns a list of arguments used in each call to BookmarkRepositoryMock.RemoveBookmark.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveBookmark *mBookmarkRepositoryMockRemoveBookmark) Calls() []*BookmarkRepositoryMockRemoveBookmarkParams {
+	mmRemoveBookmark.mutex.RLock()
+
+	argCopy := make([]*BookmarkRepositoryMockRemoveBookmarkParams, len(mmRemoveBookmark.callArgs))
+	copy(argCopy, mmRemoveBookmark.callArgs)
+
+	mmRemoveBookmark.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveBookmarkDone returns true if the count of the RemoveBookmark invocations corresponds
+// the number of defined expectations
+func (m *BookmarkRepositoryMock) MinimockRemoveBookmarkDone() bool {
+	if m.RemoveBookmarkMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveBookmarkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveBookmarkMock.invocationsDone()
+}
+
+// MinimockRemoveBookmarkInspect logs each unmet expectation
+func (m *BookmarkRepositoryMock) MinimockRemoveBookmarkInspect() {
+	for _, e := range m.RemoveBookmarkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BookmarkRepositoryMock.RemoveBookmark at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveBookmarkCounter := mm_atomic.LoadUint64(&m.afterRemoveBookmarkCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveBookmarkMock.defaultExpectation != nil && afterRemoveBookmarkCounter < 1 {
+		if m.RemoveBookmarkMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BookmarkRepositoryMock.RemoveBookmark at\n%s", m.RemoveBookmarkMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BookmarkRepositoryMock.RemoveBookmark at\n%s with params: %#v", m.RemoveBookmarkMock.defaultExpectation.expectationOrigins.origin, *m.RemoveBookmarkMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveBookmark != nil && afterRemoveBookmarkCounter < 1 {
+		m.t.Errorf("Expected call to BookmarkRepositoryMock.RemoveBookmark at\n%s", m.funcRemoveBookmarkOrigin)
+	}
+
+	if !m.RemoveBookmarkMock.invocationsDone() && afterRemoveBookmarkCounter > 0 {
+		m.t.Errorf("Expected %d calls to BookmarkRepositoryMock.RemoveBookmark at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveBookmarkMock.expectedInvocations), m.RemoveBookmarkMock.expectedInvocationsOrigin, afterRemoveBookmarkCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *BookmarkRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddBookmarkInspect()
+
+			m.MinimockDeleteUserBookmarksInspect()
+
+			m.MinimockListBookmarksInspect()
+
+			m.MinimockRemoveBookmarkInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *BookmarkRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *BookmarkRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddBookmarkDone() &&
+		m.MinimockDeleteUserBookmarksDone() &&
+		m.MinimockListBookmarksDone() &&
+		m.MinimockRemoveBookmarkDone()
+}
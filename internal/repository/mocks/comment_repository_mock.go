@@ -0,0 +1,3450 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/maisiq/go-ugc-service/internal/repository.CommentRepository -o comment_repository_mock.go -n CommentRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_repository "github.com/maisiq/go-ugc-service/internal/repository"
+)
+
+// CommentRepositoryMock implements mm_repository.CommentRepository
+type CommentRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddComment          func(ctx context.Context, comment mm_repository.Comment) (c2 mm_repository.Comment, err error)
+	funcAddCommentOrigin    string
+	inspectFuncAddComment   func(ctx context.Context, comment mm_repository.Comment)
+	afterAddCommentCounter  uint64
+	beforeAddCommentCounter uint64
+	AddCommentMock          mCommentRepositoryMockAddComment
+
+	funcDeleteComment          func(ctx context.Context, ID string) (err error)
+	funcDeleteCommentOrigin    string
+	inspectFuncDeleteComment   func(ctx context.Context, ID string)
+	afterDeleteCommentCounter  uint64
+	beforeDeleteCommentCounter uint64
+	DeleteCommentMock          mCommentRepositoryMockDeleteComment
+
+	funcDeleteReviewComments          func(ctx context.Context, reviewUserID string, movieID string) (err error)
+	funcDeleteReviewCommentsOrigin    string
+	inspectFuncDeleteReviewComments   func(ctx context.Context, reviewUserID string, movieID string)
+	afterDeleteReviewCommentsCounter  uint64
+	beforeDeleteReviewCommentsCounter uint64
+	DeleteReviewCommentsMock          mCommentRepositoryMockDeleteReviewComments
+
+	funcDeleteUserComments          func(ctx context.Context, userID string) (err error)
+	funcDeleteUserCommentsOrigin    string
+	inspectFuncDeleteUserComments   func(ctx context.Context, userID string)
+	afterDeleteUserCommentsCounter  uint64
+	beforeDeleteUserCommentsCounter uint64
+	DeleteUserCommentsMock          mCommentRepositoryMockDeleteUserComments
+
+	funcGetComment          func(ctx context.Context, ID string) (c2 mm_repository.Comment, err error)
+	funcGetCommentOrigin    string
+	inspectFuncGetComment   func(ctx context.Context, ID string)
+	afterGetCommentCounter  uint64
+	beforeGetCommentCounter uint64
+	GetCommentMock          mCommentRepositoryMockGetComment
+
+	funcListComments          func(ctx context.Context, reviewUserID string, movieID string, offset int, limit int) (ca1 []mm_repository.Comment, err error)
+	funcListCommentsOrigin    string
+	inspectFuncListComments   func(ctx context.Context, reviewUserID string, movieID string, offset int, limit int)
+	afterListCommentsCounter  uint64
+	beforeListCommentsCounter uint64
+	ListCommentsMock          mCommentRepositoryMockListComments
+
+	funcListCommentsBy          func(ctx context.Context, authorID string) (ca1 []mm_repository.Comment, err error)
+	funcListCommentsByOrigin    string
+	inspectFuncListCommentsBy   func(ctx context.Context, authorID string)
+	afterListCommentsByCounter  uint64
+	beforeListCommentsByCounter uint64
+	ListCommentsByMock          mCommentRepositoryMockListCommentsBy
+
+	funcListReplies          func(ctx context.Context, parentIDs []string) (ca1 []mm_repository.Comment, err error)
+	funcListRepliesOrigin    string
+	inspectFuncListReplies   func(ctx context.Context, parentIDs []string)
+	afterListRepliesCounter  uint64
+	beforeListRepliesCounter uint64
+	ListRepliesMock          mCommentRepositoryMockListReplies
+
+	funcUpdateComment          func(ctx context.Context, ID string, text string, updatedAt time.Time) (c2 mm_repository.Comment, err error)
+	funcUpdateCommentOrigin    string
+	inspectFuncUpdateComment   func(ctx context.Context, ID string, text string, updatedAt time.Time)
+	afterUpdateCommentCounter  uint64
+	beforeUpdateCommentCounter uint64
+	UpdateCommentMock          mCommentRepositoryMockUpdateComment
+}
+
+// NewCommentRepositoryMock returns a mock for mm_repository.CommentRepository
+func NewCommentRepositoryMock(t minimock.Tester) *CommentRepositoryMock {
+	m := &CommentRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddCommentMock = mCommentRepositoryMockAddComment{mock: m}
+	m.AddCommentMock.callArgs = []*CommentRepositoryMockAddCommentParams{}
+
+	m.DeleteCommentMock = mCommentRepositoryMockDeleteComment{mock: m}
+	m.DeleteCommentMock.callArgs = []*CommentRepositoryMockDeleteCommentParams{}
+
+	m.DeleteReviewCommentsMock = mCommentRepositoryMockDeleteReviewComments{mock: m}
+	m.DeleteReviewCommentsMock.callArgs = []*CommentRepositoryMockDeleteReviewCommentsParams{}
+
+	m.DeleteUserCommentsMock = mCommentRepositoryMockDeleteUserComments{mock: m}
+	m.DeleteUserCommentsMock.callArgs = []*CommentRepositoryMockDeleteUserCommentsParams{}
+
+	m.GetCommentMock = mCommentRepositoryMockGetComment{mock: m}
+	m.GetCommentMock.callArgs = []*CommentRepositoryMockGetCommentParams{}
+
+	m.ListCommentsMock = mCommentRepositoryMockListComments{mock: m}
+	m.ListCommentsMock.callArgs = []*CommentRepositoryMockListCommentsParams{}
+
+	m.ListCommentsByMock = mCommentRepositoryMockListCommentsBy{mock: m}
+	m.ListCommentsByMock.callArgs = []*CommentRepositoryMockListCommentsByParams{}
+
+	m.ListRepliesMock = mCommentRepositoryMockListReplies{mock: m}
+	m.ListRepliesMock.callArgs = []*CommentRepositoryMockListRepliesParams{}
+
+	m.UpdateCommentMock = mCommentRepositoryMockUpdateComment{mock: m}
+	m.UpdateCommentMock.callArgs = []*CommentRepositoryMockUpdateCommentParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCommentRepositoryMockAddComment struct {
+	optional           bool
+	mock               *CommentRepositoryMock
+	defaultExpectation *CommentRepositoryMockAddCommentExpectation
+	expectations       []*CommentRepositoryMockAddCommentExpectation
+
+	callArgs []*CommentRepositoryMockAddCommentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CommentRepositoryMockAddCommentExpectation specifies expectation struct of the CommentRepository.AddComment
+type CommentRepositoryMockAddCommentExpectation struct {
+	mock               *CommentRepositoryMock
+	params             *CommentRepositoryMockAddCommentParams
+	paramPtrs          *CommentRepositoryMockAddCommentParamPtrs
+	expectationOrigins CommentRepositoryMockAddCommentExpectationOrigins
+	results            *CommentRepositoryMockAddCommentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CommentRepositoryMockAddCommentParams contains parameters of the CommentRepository.AddComment
+type CommentRepositoryMockAddCommentParams struct {
+	ctx     context.Context
+	comment mm_repository.Comment
+}
+
+// CommentRepositoryMockAddCommentParamPtrs contains pointers to parameters of the CommentRepository.AddComment
+type CommentRepositoryMockAddCommentParamPtrs struct {
+	ctx     *context.Context
+	comment *mm_repository.Comment
+}
+
+// CommentRepositoryMockAddCommentResults contains results of the CommentRepository.AddComment
+type CommentRepositoryMockAddCommentResults struct {
+	c2  mm_repository.Comment
+	err error
+}
+
+// CommentRepositoryMockAddCommentOrigins contains origins of expectations of the CommentRepository.AddComment
+type CommentRepositoryMockAddCommentExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originComment string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddComment *mCommentRepositoryMockAddComment) Optional() *mCommentRepositoryMockAddComment {
+	mmAddComment.optional = true
+	return mmAddComment
+}
+
+// Expect sets up expected params for CommentRepository.AddComment
+func (mmAddComment *mCommentRepositoryMockAddComment) Expect(ctx context.Context, comment mm_repository.Comment) *mCommentRepositoryMockAddComment {
+	if mmAddComment.mock.funcAddComment != nil {
+		mmAddComment.mock.t.Fatalf("CommentRepositoryMock.AddComment mock is already set by Set")
+	}
+
+	if mmAddComment.defaultExpectation == nil {
+		mmAddComment.defaultExpectation = &CommentRepositoryMockAddCommentExpectation{}
+	}
+
+	if mmAddComment.defaultExpectation.paramPtrs != nil {
+		mmAddComment.mock.t.Fatalf("CommentRepositoryMock.AddComment mock is already set by ExpectParams functions")
+	}
+
+	mmAddComment.defaultExpectation.params = &CommentRepositoryMockAddCommentParams{ctx, comment}
+	mmAddComment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddComment.expectations {
+		if minimock.Equal(e.params, mmAddComment.defaultExpectation.params) {
+			mmAddComment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddComment.defaultExpectation.params)
+		}
+	}
+
+	return mmAddComment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CommentRepository.AddComment
+func (mmAddComment *mCommentRepositoryMockAddComment) ExpectCtxParam1(ctx context.Context) *mCommentRepositoryMockAddComment {
+	if mmAddComment.mock.funcAddComment != nil {
+		mmAddComment.mock.t.Fatalf("CommentRepositoryMock.AddComment mock is already set by Set")
+	}
+
+	if mmAddComment.defaultExpectation == nil {
+		mmAddComment.defaultExpectation = &CommentRepositoryMockAddCommentExpectation{}
+	}
+
+	if mmAddComment.defaultExpectation.params != nil {
+		mmAddComment.mock.t.Fatalf("CommentRepositoryMock.AddComment mock is already set by Expect")
+	}
+
+	if mmAddComment.defaultExpectation.paramPtrs == nil {
+		mmAddComment.defaultExpectation.paramPtrs = &CommentRepositoryMockAddCommentParamPtrs{}
+	}
+	mmAddComment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddComment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddComment
+}
+
+// ExpectCommentParam2 sets up expected param comment for CommentRepository.AddComment
+func (mmAddComment *mCommentRepositoryMockAddComment) ExpectCommentParam2(comment mm_repository.Comment) *mCommentRepositoryMockAddComment {
+	if mmAddComment.mock.funcAddComment != nil {
+		mmAddComment.mock.t.Fatalf("CommentRepositoryMock.AddComment mock is already set by Set")
+	}
+
+	if mmAddComment.defaultExpectation == nil {
+		mmAddComment.defaultExpectation = &CommentRepositoryMockAddCommentExpectation{}
+	}
+
+	if mmAddComment.defaultExpectation.params != nil {
+		mmAddComment.mock.t.Fatalf("CommentRepositoryMock.AddComment mock is already set by Expect")
+	}
+
+	if mmAddComment.defaultExpectation.paramPtrs == nil {
+		mmAddComment.defaultExpectation.paramPtrs = &CommentRepositoryMockAddCommentParamPtrs{}
+	}
+	mmAddComment.defaultExpectation.paramPtrs.comment = &comment
+	mmAddComment.defaultExpectation.expectationOrigins.originComment = minimock.CallerInfo(1)
+
+	return mmAddComment
+}
+
+// Inspect accepts an inspector function that has same arguments as the CommentRepository.AddComment
+func (mmAddComment *mCommentRepositoryMockAddComment) Inspect(f func(ctx context.Context, comment mm_repository.Comment)) *mCommentRepositoryMockAddComment {
+	if mmAddComment.mock.inspectFuncAddComment != nil {
+		mmAddComment.mock.t.Fatalf("Inspect function is already set for CommentRepositoryMock.AddComment")
+	}
+
+	mmAddComment.mock.inspectFuncAddComment = f
+
+	return mmAddComment
+}
+
+// Return sets up results that will be returned by CommentRepository.AddComment
+func (mmAddComment *mCommentRepositoryMockAddComment) Return(c2 mm_repository.Comment, err error) *CommentRepositoryMock {
+	if mmAddComment.mock.funcAddComment != nil {
+		mmAddComment.mock.t.Fatalf("CommentRepositoryMock.AddComment mock is already set by Set")
+	}
+
+	if mmAddComment.defaultExpectation == nil {
+		mmAddComment.defaultExpectation = &CommentRepositoryMockAddCommentExpectation{mock: mmAddComment.mock}
+	}
+	mmAddComment.defaultExpectation.results = &CommentRepositoryMockAddCommentResults{c2, err}
+	mmAddComment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddComment.mock
+}
+
+// Set uses given function f to mock the CommentRepository.AddComment method
+func (mmAddComment *mCommentRepositoryMockAddComment) Set(f func(ctx context.Context, comment mm_repository.Comment) (c2 mm_repository.Comment, err error)) *CommentRepositoryMock {
+	if mmAddComment.defaultExpectation != nil {
+		mmAddComment.mock.t.Fatalf("Default expectation is already set for the CommentRepository.AddComment method")
+	}
+
+	if len(mmAddComment.expectations) > 0 {
+		mmAddComment.mock.t.Fatalf("Some expectations are already set for the CommentRepository.AddComment method")
+	}
+
+	mmAddComment.mock.funcAddComment = f
+	mmAddComment.mock.funcAddCommentOrigin = minimock.CallerInfo(1)
+	return mmAddComment.mock
+}
+
+// When sets expectation for the CommentRepository.AddComment which will trigger the result defined by the following
+// Then helper
+func (mmAddComment *mCommentRepositoryMockAddComment) When(ctx context.Context, comment mm_repository.Comment) *CommentRepositoryMockAddCommentExpectation {
+	if mmAddComment.mock.funcAddComment != nil {
+		mmAddComment.mock.t.Fatalf("CommentRepositoryMock.AddComment mock is already set by Set")
+	}
+
+	expectation := &CommentRepositoryMockAddCommentExpectation{
+		mock:               mmAddComment.mock,
+		params:             &CommentRepositoryMockAddCommentParams{ctx, comment},
+		expectationOrigins: CommentRepositoryMockAddCommentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddComment.expectations = append(mmAddComment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CommentRepository.AddComment return parameters for the expectation previously defined by the When method
+func (e *CommentRepositoryMockAddCommentExpectation) Then(c2 mm_repository.Comment, err error) *CommentRepositoryMock {
+	e.results = &CommentRepositoryMockAddCommentResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times CommentRepository.AddComment should be invoked
+func (mmAddComment *mCommentRepositoryMockAddComment) Times(n uint64) *mCommentRepositoryMockAddComment {
+	if n == 0 {
+		mmAddComment.mock.t.Fatalf("Times of CommentRepositoryMock.AddComment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddComment.expectedInvocations, n)
+	mmAddComment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddComment
+}
+
+func (mmAddComment *mCommentRepositoryMockAddComment) invocationsDone() bool {
+	if len(mmAddComment.expectations) == 0 && mmAddComment.defaultExpectation == nil && mmAddComment.mock.funcAddComment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddComment.mock.afterAddCommentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddComment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddComment implements mm_repository.CommentRepository
+func (mmAddComment *CommentRepositoryMock) AddComment(ctx context.Context, comment mm_repository.Comment) (c2 mm_repository.Comment, err error) {
+	mm_atomic.AddUint64(&mmAddComment.beforeAddCommentCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddComment.afterAddCommentCounter, 1)
+
+	mmAddComment.t.Helper()
+
+	if mmAddComment.inspectFuncAddComment != nil {
+		mmAddComment.inspectFuncAddComment(ctx, comment)
+	}
+
+	mm_params := CommentRepositoryMockAddCommentParams{ctx, comment}
+
+	// Record call args
+	mmAddComment.AddCommentMock.mutex.Lock()
+	mmAddComment.AddCommentMock.callArgs = append(mmAddComment.AddCommentMock.callArgs, &mm_params)
+	mmAddComment.AddCommentMock.mutex.Unlock()
+
+	for _, e := range mmAddComment.AddCommentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmAddComment.AddCommentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddComment.AddCommentMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddComment.AddCommentMock.defaultExpectation.params
+		mm_want_ptrs := mmAddComment.AddCommentMock.defaultExpectation.paramPtrs
+
+		mm_got := CommentRepositoryMockAddCommentParams{ctx, comment}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddComment.t.Errorf("CommentRepositoryMock.AddComment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddComment.AddCommentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.comment != nil && !minimock.Equal(*mm_want_ptrs.comment, mm_got.comment) {
+				mmAddComment.t.Errorf("CommentRepositoryMock.AddComment got unexpected parameter comment, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddComment.AddCommentMock.defaultExpectation.expectationOrigins.originComment, *mm_want_ptrs.comment, mm_got.comment, minimock.Diff(*mm_want_ptrs.comment, mm_got.comment))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddComment.t.Errorf("CommentRepositoryMock.AddComment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddComment.AddCommentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddComment.AddCommentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddComment.t.Fatal("No results are set for the CommentRepositoryMock.AddComment")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmAddComment.funcAddComment != nil {
+		return mmAddComment.funcAddComment(ctx, comment)
+	}
+	mmAddComment.t.Fatalf("Unexpected call to CommentRepositoryMock.AddComment. %v %v", ctx, comment)
+	return
+}
+
+// AddCommentAfterCounter returns a count of finished CommentRepositoryMock.AddComment invocations
+func (mmAddComment *CommentRepositoryMock) AddCommentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddComment.afterAddCommentCounter)
+}
+
+// AddCommentBeforeCounter returns a count of CommentRepositoryMock.AddComment invocations
+func (mmAddComment *CommentRepositoryMock) AddCommentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddComment.beforeAddCommentCounter)
+}
+
+// Calls returns a list of arguments used in each call to CommentRepositoryMock.AddComment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddComment *mCommentRepositoryMockAddComment) Calls() []*CommentRepositoryMockAddCommentParams {
+	mmAddComment.mutex.RLock()
+
+	argCopy := make([]*CommentRepositoryMockAddCommentParams, len(mmAddComment.callArgs))
+	copy(argCopy, mmAddComment.callArgs)
+
+	mmAddComment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddCommentDone returns true if the count of the AddComment invocations corresponds
+// the number of defined expectations
+func (m *CommentRepositoryMock) MinimockAddCommentDone() bool {
+	if m.AddCommentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddCommentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddCommentMock.invocationsDone()
+}
+
+// MinimockAddCommentInspect logs each unmet expectation
+func (m *CommentRepositoryMock) MinimockAddCommentInspect() {
+	for _, e := range m.AddCommentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CommentRepositoryMock.AddComment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddCommentCounter := mm_atomic.LoadUint64(&m.afterAddCommentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddCommentMock.defaultExpectation != nil && afterAddCommentCounter < 1 {
+		if m.AddCommentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CommentRepositoryMock.AddComment at\n%s", m.AddCommentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CommentRepositoryMock.AddComment at\n%s with params: %#v", m.AddCommentMock.defaultExpectation.expectationOrigins.origin, *m.AddCommentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddComment != nil && afterAddCommentCounter < 1 {
+		m.t.Errorf("Expected call to CommentRepositoryMock.AddComment at\n%s", m.funcAddCommentOrigin)
+	}
+
+	if !m.AddCommentMock.invocationsDone() && afterAddCommentCounter > 0 {
+		m.t.Errorf("Expected %d calls to CommentRepositoryMock.AddComment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddCommentMock.expectedInvocations), m.AddCommentMock.expectedInvocationsOrigin, afterAddCommentCounter)
+	}
+}
+
+type mCommentRepositoryMockDeleteComment struct {
+	optional           bool
+	mock               *CommentRepositoryMock
+	defaultExpectation *CommentRepositoryMockDeleteCommentExpectation
+	expectations       []*CommentRepositoryMockDeleteCommentExpectation
+
+	callArgs []*CommentRepositoryMockDeleteCommentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CommentRepositoryMockDeleteCommentExpectation specifies expectation struct of the CommentRepository.DeleteComment
+type CommentRepositoryMockDeleteCommentExpectation struct {
+	mock               *CommentRepositoryMock
+	params             *CommentRepositoryMockDeleteCommentParams
+	paramPtrs          *CommentRepositoryMockDeleteCommentParamPtrs
+	expectationOrigins CommentRepositoryMockDeleteCommentExpectationOrigins
+	results            *CommentRepositoryMockDeleteCommentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CommentRepositoryMockDeleteCommentParams contains parameters of the CommentRepository.DeleteComment
+type CommentRepositoryMockDeleteCommentParams struct {
+	ctx context.Context
+	ID  string
+}
+
+// CommentRepositoryMockDeleteCommentParamPtrs contains pointers to parameters of the CommentRepository.DeleteComment
+type CommentRepositoryMockDeleteCommentParamPtrs struct {
+	ctx *context.Context
+	ID  *string
+}
+
+// CommentRepositoryMockDeleteCommentResults contains results of the CommentRepository.DeleteComment
+type CommentRepositoryMockDeleteCommentResults struct {
+	err error
+}
+
+// CommentRepositoryMockDeleteCommentOrigins contains origins of expectations of the CommentRepository.DeleteComment
+type CommentRepositoryMockDeleteCommentExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originID  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteComment *mCommentRepositoryMockDeleteComment) Optional() *mCommentRepositoryMockDeleteComment {
+	mmDeleteComment.optional = true
+	return mmDeleteComment
+}
+
+// Expect sets up expected params for CommentRepository.DeleteComment
+func (mmDeleteComment *mCommentRepositoryMockDeleteComment) Expect(ctx context.Context, ID string) *mCommentRepositoryMockDeleteComment {
+	if mmDeleteComment.mock.funcDeleteComment != nil {
+		mmDeleteComment.mock.t.Fatalf("CommentRepositoryMock.DeleteComment mock is already set by Set")
+	}
+
+	if mmDeleteComment.defaultExpectation == nil {
+		mmDeleteComment.defaultExpectation = &CommentRepositoryMockDeleteCommentExpectation{}
+	}
+
+	if mmDeleteComment.defaultExpectation.paramPtrs != nil {
+		mmDeleteComment.mock.t.Fatalf("CommentRepositoryMock.DeleteComment mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteComment.defaultExpectation.params = &CommentRepositoryMockDeleteCommentParams{ctx, ID}
+	mmDeleteComment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteComment.expectations {
+		if minimock.Equal(e.params, mmDeleteComment.defaultExpectation.params) {
+			mmDeleteComment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteComment.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteComment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CommentRepository.DeleteComment
+func (mmDeleteComment *mCommentRepositoryMockDeleteComment) ExpectCtxParam1(ctx context.Context) *mCommentRepositoryMockDeleteComment {
+	if mmDeleteComment.mock.funcDeleteComment != nil {
+		mmDeleteComment.mock.t.Fatalf("CommentRepositoryMock.DeleteComment mock is already set by Set")
+	}
+
+	if mmDeleteComment.defaultExpectation == nil {
+		mmDeleteComment.defaultExpectation = &CommentRepositoryMockDeleteCommentExpectation{}
+	}
+
+	if mmDeleteComment.defaultExpectation.params != nil {
+		mmDeleteComment.mock.t.Fatalf("CommentRepositoryMock.DeleteComment mock is already set by Expect")
+	}
+
+	if mmDeleteComment.defaultExpectation.paramPtrs == nil {
+		mmDeleteComment.defaultExpectation.paramPtrs = &CommentRepositoryMockDeleteCommentParamPtrs{}
+	}
+	mmDeleteComment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteComment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteComment
+}
+
+// ExpectIDParam2 sets up expected param ID for CommentRepository.DeleteComment
+func (mmDeleteComment *mCommentRepositoryMockDeleteComment) ExpectIDParam2(ID string) *mCommentRepositoryMockDeleteComment {
+	if mmDeleteComment.mock.funcDeleteComment != nil {
+		mmDeleteComment.mock.t.Fatalf("CommentRepositoryMock.DeleteComment mock is already set by Set")
+	}
+
+	if mmDeleteComment.defaultExpectation == nil {
+		mmDeleteComment.defaultExpectation = &CommentRepositoryMockDeleteCommentExpectation{}
+	}
+
+	if mmDeleteComment.defaultExpectation.params != nil {
+		mmDeleteComment.mock.t.Fatalf("CommentRepositoryMock.DeleteComment mock is already set by Expect")
+	}
+
+	if mmDeleteComment.defaultExpectation.paramPtrs == nil {
+		mmDeleteComment.defaultExpectation.paramPtrs = &CommentRepositoryMockDeleteCommentParamPtrs{}
+	}
+	mmDeleteComment.defaultExpectation.paramPtrs.ID = &ID
+	mmDeleteComment.defaultExpectation.expectationOrigins.originID = minimock.CallerInfo(1)
+
+	return mmDeleteComment
+}
+
+// Inspect accepts an inspector function that has same arguments as the CommentRepository.DeleteComment
+func (mmDeleteComment *mCommentRepositoryMockDeleteComment) Inspect(f func(ctx context.Context, ID string)) *mCommentRepositoryMockDeleteComment {
+	if mmDeleteComment.mock.inspectFuncDeleteComment != nil {
+		mmDeleteComment.mock.t.Fatalf("Inspect function is already set for CommentRepositoryMock.DeleteComment")
+	}
+
+	mmDeleteComment.mock.inspectFuncDeleteComment = f
+
+	return mmDeleteComment
+}
+
+// Return sets up results that will be returned by CommentRepository.DeleteComment
+func (mmDeleteComment *mCommentRepositoryMockDeleteComment) Return(err error) *CommentRepositoryMock {
+	if mmDeleteComment.mock.funcDeleteComment != nil {
+		mmDeleteComment.mock.t.Fatalf("CommentRepositoryMock.DeleteComment mock is already set by Set")
+	}
+
+	if mmDeleteComment.defaultExpectation == nil {
+		mmDeleteComment.defaultExpectation = &CommentRepositoryMockDeleteCommentExpectation{mock: mmDeleteComment.mock}
+	}
+	mmDeleteComment.defaultExpectation.results = &CommentRepositoryMockDeleteCommentResults{err}
+	mmDeleteComment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteComment.mock
+}
+
+// Set uses given function f to mock the CommentRepository.DeleteComment method
+func (mmDeleteComment *mCommentRepositoryMockDeleteComment) Set(f func(ctx context.Context, ID string) (err error)) *CommentRepositoryMock {
+	if mmDeleteComment.defaultExpectation != nil {
+		mmDeleteComment.mock.t.Fatalf("Default expectation is already set for the CommentRepository.DeleteComment method")
+	}
+
+	if len(mmDeleteComment.expectations) > 0 {
+		mmDeleteComment.mock.t.Fatalf("Some expectations are already set for the CommentRepository.DeleteComment method")
+	}
+
+	mmDeleteComment.mock.funcDeleteComment = f
+	mmDeleteComment.mock.funcDeleteCommentOrigin = minimock.CallerInfo(1)
+	return mmDeleteComment.mock
+}
+
+// When sets expectation for the CommentRepository.DeleteComment which will trigger the result defined by the following
+// Then helper
+func (mmDeleteComment *mCommentRepositoryMockDeleteComment) When(ctx context.Context, ID string) *CommentRepositoryMockDeleteCommentExpectation {
+	if mmDeleteComment.mock.funcDeleteComment != nil {
+		mmDeleteComment.mock.t.Fatalf("CommentRepositoryMock.DeleteComment mock is already set by Set")
+	}
+
+	expectation := &CommentRepositoryMockDeleteCommentExpectation{
+		mock:               mmDeleteComment.mock,
+		params:             &CommentRepositoryMockDeleteCommentParams{ctx, ID},
+		expectationOrigins: CommentRepositoryMockDeleteCommentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteComment.expectations = append(mmDeleteComment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CommentRepository.DeleteComment return parameters for the expectation previously defined by the When method
+func (e *CommentRepositoryMockDeleteCommentExpectation) Then(err error) *CommentRepositoryMock {
+	e.results = &CommentRepositoryMockDeleteCommentResults{err}
+	return e.mock
+}
+
+// Times sets number of times CommentRepository.DeleteComment should be invoked
+func (mmDeleteComment *mCommentRepositoryMockDeleteComment) Times(n uint64) *mCommentRepositoryMockDeleteComment {
+	if n == 0 {
+		mmDeleteComment.mock.t.Fatalf("Times of CommentRepositoryMock.DeleteComment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteComment.expectedInvocations, n)
+	mmDeleteComment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteComment
+}
+
+func (mmDeleteComment *mCommentRepositoryMockDeleteComment) invocationsDone() bool {
+	if len(mmDeleteComment.expectations) == 0 && mmDeleteComment.defaultExpectation == nil && mmDeleteComment.mock.funcDeleteComment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteComment.mock.afterDeleteCommentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteComment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteComment implements mm_repository.CommentRepository
+func (mmDeleteComment *CommentRepositoryMock) DeleteComment(ctx context.Context, ID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteComment.beforeDeleteCommentCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteComment.afterDeleteCommentCounter, 1)
+
+	mmDeleteComment.t.Helper()
+
+	if mmDeleteComment.inspectFuncDeleteComment != nil {
+		mmDeleteComment.inspectFuncDeleteComment(ctx, ID)
+	}
+
+	mm_params := CommentRepositoryMockDeleteCommentParams{ctx, ID}
+
+	// Record call args
+	mmDeleteComment.DeleteCommentMock.mutex.Lock()
+	mmDeleteComment.DeleteCommentMock.callArgs = append(mmDeleteComment.DeleteCommentMock.callArgs, &mm_params)
+	mmDeleteComment.DeleteCommentMock.mutex.Unlock()
+
+	for _, e := range mmDeleteComment.DeleteCommentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteComment.DeleteCommentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteComment.DeleteCommentMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteComment.DeleteCommentMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteComment.DeleteCommentMock.defaultExpectation.paramPtrs
+
+		mm_got := CommentRepositoryMockDeleteCommentParams{ctx, ID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteComment.t.Errorf("CommentRepositoryMock.DeleteComment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteComment.DeleteCommentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ID != nil && !minimock.Equal(*mm_want_ptrs.ID, mm_got.ID) {
+				mmDeleteComment.t.Errorf("CommentRepositoryMock.DeleteComment got unexpected parameter ID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteComment.DeleteCommentMock.defaultExpectation.expectationOrigins.originID, *mm_want_ptrs.ID, mm_got.ID, minimock.Diff(*mm_want_ptrs.ID, mm_got.ID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteComment.t.Errorf("CommentRepositoryMock.DeleteComment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteComment.DeleteCommentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteComment.DeleteCommentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteComment.t.Fatal("No results are set for the CommentRepositoryMock.DeleteComment")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteComment.funcDeleteComment != nil {
+		return mmDeleteComment.funcDeleteComment(ctx, ID)
+	}
+	mmDeleteComment.t.Fatalf("Unexpected call to CommentRepositoryMock.DeleteComment. %v %v", ctx, ID)
+	return
+}
+
+// DeleteCommentAfterCounter returns a count of finished CommentRepositoryMock.DeleteComment invocations
+func (mmDeleteComment *CommentRepositoryMock) DeleteCommentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteComment.afterDeleteCommentCounter)
+}
+
+// DeleteCommentBeforeCounter returns a count of CommentRepositoryMock.DeleteComment invocations
+func (mmDeleteComment *CommentRepositoryMock) DeleteCommentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteComment.beforeDeleteCommentCounter)
+}
+
+// Calls returns a list of arguments used in each call to CommentRepositoryMock.DeleteComment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteComment *mCommentRepositoryMockDeleteComment) Calls() []*CommentRepositoryMockDeleteCommentParams {
+	mmDeleteComment.mutex.RLock()
+
+	argCopy := make([]*CommentRepositoryMockDeleteCommentParams, len(mmDeleteComment.callArgs))
+	copy(argCopy, mmDeleteComment.callArgs)
+
+	mmDeleteComment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteCommentDone returns true if the count of the DeleteComment invocations corresponds
+// the number of defined expectations
+func (m *CommentRepositoryMock) MinimockDeleteCommentDone() bool {
+	if m.DeleteCommentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteCommentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteCommentMock.invocationsDone()
+}
+
+// MinimockDeleteCommentInspect logs each unmet expectation
+func (m *CommentRepositoryMock) MinimockDeleteCommentInspect() {
+	for _, e := range m.DeleteCommentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CommentRepositoryMock.DeleteComment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCommentCounter := mm_atomic.LoadUint64(&m.afterDeleteCommentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteCommentMock.defaultExpectation != nil && afterDeleteCommentCounter < 1 {
+		if m.DeleteCommentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CommentRepositoryMock.DeleteComment at\n%s", m.DeleteCommentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CommentRepositoryMock.DeleteComment at\n%s with params: %#v", m.DeleteCommentMock.defaultExpectation.expectationOrigins.origin, *m.DeleteCommentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteComment != nil && afterDeleteCommentCounter < 1 {
+		m.t.Errorf("Expected call to CommentRepositoryMock.DeleteComment at\n%s", m.funcDeleteCommentOrigin)
+	}
+
+	if !m.DeleteCommentMock.invocationsDone() && afterDeleteCommentCounter > 0 {
+		m.t.Errorf("Expected %d calls to CommentRepositoryMock.DeleteComment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteCommentMock.expectedInvocations), m.DeleteCommentMock.expectedInvocationsOrigin, afterDeleteCommentCounter)
+	}
+}
+
+type mCommentRepositoryMockDeleteReviewComments struct {
+	optional           bool
+	mock               *CommentRepositoryMock
+	defaultExpectation *CommentRepositoryMockDeleteReviewCommentsExpectation
+	expectations       []*CommentRepositoryMockDeleteReviewCommentsExpectation
+
+	callArgs []*CommentRepositoryMockDeleteReviewCommentsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CommentRepositoryMockDeleteReviewCommentsExpectation specifies expectation struct of the CommentRepository.DeleteReviewComments
+type CommentRepositoryMockDeleteReviewCommentsExpectation struct {
+	mock               *CommentRepositoryMock
+	params             *CommentRepositoryMockDeleteReviewCommentsParams
+	paramPtrs          *CommentRepositoryMockDeleteReviewCommentsParamPtrs
+	expectationOrigins CommentRepositoryMockDeleteReviewCommentsExpectationOrigins
+	results            *CommentRepositoryMockDeleteReviewCommentsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CommentRepositoryMockDeleteReviewCommentsParams contains parameters of the CommentRepository.DeleteReviewComments
+type CommentRepositoryMockDeleteReviewCommentsParams struct {
+	ctx          context.Context
+	reviewUserID string
+	movieID      string
+}
+
+// CommentRepositoryMockDeleteReviewCommentsParamPtrs contains pointers to parameters of the CommentRepository.DeleteReviewComments
+type CommentRepositoryMockDeleteReviewCommentsParamPtrs struct {
+	ctx          *context.Context
+	reviewUserID *string
+	movieID      *string
+}
+
+// CommentRepositoryMockDeleteReviewCommentsResults contains results of the CommentRepository.DeleteReviewComments
+type CommentRepositoryMockDeleteReviewCommentsResults struct {
+	err error
+}
+
+// CommentRepositoryMockDeleteReviewCommentsOrigins contains origins of expectations of the CommentRepository.DeleteReviewComments
+type CommentRepositoryMockDeleteReviewCommentsExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originReviewUserID string
+	originMovieID      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteReviewComments *mCommentRepositoryMockDeleteReviewComments) Optional() *mCommentRepositoryMockDeleteReviewComments {
+	mmDeleteReviewComments.optional = true
+	return mmDeleteReviewComments
+}
+
+// Expect sets up expected params for CommentRepository.DeleteReviewComments
+func (mmDeleteReviewComments *mCommentRepositoryMockDeleteReviewComments) Expect(ctx context.Context, reviewUserID string, movieID string) *mCommentRepositoryMockDeleteReviewComments {
+	if mmDeleteReviewComments.mock.funcDeleteReviewComments != nil {
+		mmDeleteReviewComments.mock.t.Fatalf("CommentRepositoryMock.DeleteReviewComments mock is already set by Set")
+	}
+
+	if mmDeleteReviewComments.defaultExpectation == nil {
+		mmDeleteReviewComments.defaultExpectation = &CommentRepositoryMockDeleteReviewCommentsExpectation{}
+	}
+
+	if mmDeleteReviewComments.defaultExpectation.paramPtrs != nil {
+		mmDeleteReviewComments.mock.t.Fatalf("CommentRepositoryMock.DeleteReviewComments mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteReviewComments.defaultExpectation.params = &CommentRepositoryMockDeleteReviewCommentsParams{ctx, reviewUserID, movieID}
+	mmDeleteReviewComments.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteReviewComments.expectations {
+		if minimock.Equal(e.params, mmDeleteReviewComments.defaultExpectation.params) {
+			mmDeleteReviewComments.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteReviewComments.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteReviewComments
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CommentRepository.DeleteReviewComments
+func (mmDeleteReviewComments *mCommentRepositoryMockDeleteReviewComments) ExpectCtxParam1(ctx context.Context) *mCommentRepositoryMockDeleteReviewComments {
+	if mmDeleteReviewComments.mock.funcDeleteReviewComments != nil {
+		mmDeleteReviewComments.mock.t.Fatalf("CommentRepositoryMock.DeleteReviewComments mock is already set by Set")
+	}
+
+	if mmDeleteReviewComments.defaultExpectation == nil {
+		mmDeleteReviewComments.defaultExpectation = &CommentRepositoryMockDeleteReviewCommentsExpectation{}
+	}
+
+	if mmDeleteReviewComments.defaultExpectation.params != nil {
+		mmDeleteReviewComments.mock.t.Fatalf("CommentRepositoryMock.DeleteReviewComments mock is already set by Expect")
+	}
+
+	if mmDeleteReviewComments.defaultExpectation.paramPtrs == nil {
+		mmDeleteReviewComments.defaultExpectation.paramPtrs = &CommentRepositoryMockDeleteReviewCommentsParamPtrs{}
+	}
+	mmDeleteReviewComments.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteReviewComments.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteReviewComments
+}
+
+// ExpectReviewUserIDParam2 sets up expected param reviewUserID for CommentRepository.DeleteReviewComments
+func (mmDeleteReviewComments *mCommentRepositoryMockDeleteReviewComments) ExpectReviewUserIDParam2(reviewUserID string) *mCommentRepositoryMockDeleteReviewComments {
+	if mmDeleteReviewComments.mock.funcDeleteReviewComments != nil {
+		mmDeleteReviewComments.mock.t.Fatalf("CommentRepositoryMock.DeleteReviewComments mock is already set by Set")
+	}
+
+	if mmDeleteReviewComments.defaultExpectation == nil {
+		mmDeleteReviewComments.defaultExpectation = &CommentRepositoryMockDeleteReviewCommentsExpectation{}
+	}
+
+	if mmDeleteReviewComments.defaultExpectation.params != nil {
+		mmDeleteReviewComments.mock.t.Fatalf("CommentRepositoryMock.DeleteReviewComments mock is already set by Expect")
+	}
+
+	if mmDeleteReviewComments.defaultExpectation.paramPtrs == nil {
+		mmDeleteReviewComments.defaultExpectation.paramPtrs = &CommentRepositoryMockDeleteReviewCommentsParamPtrs{}
+	}
+	mmDeleteReviewComments.defaultExpectation.paramPtrs.reviewUserID = &reviewUserID
+	mmDeleteReviewComments.defaultExpectation.expectationOrigins.originReviewUserID = minimock.CallerInfo(1)
+
+	return mmDeleteReviewComments
+}
+
+// ExpectMovieIDParam3 sets up expected param movieID for CommentRepository.DeleteReviewComments
+func (mmDeleteReviewComments *mCommentRepositoryMockDeleteReviewComments) ExpectMovieIDParam3(movieID string) *mCommentRepositoryMockDeleteReviewComments {
+	if mmDeleteReviewComments.mock.funcDeleteReviewComments != nil {
+		mmDeleteReviewComments.mock.t.Fatalf("CommentRepositoryMock.DeleteReviewComments mock is already set by Set")
+	}
+
+	if mmDeleteReviewComments.defaultExpectation == nil {
+		mmDeleteReviewComments.defaultExpectation = &CommentRepositoryMockDeleteReviewCommentsExpectation{}
+	}
+
+	if mmDeleteReviewComments.defaultExpectation.params != nil {
+		mmDeleteReviewComments.mock.t.Fatalf("CommentRepositoryMock.DeleteReviewComments mock is already set by Expect")
+	}
+
+	if mmDeleteReviewComments.defaultExpectation.paramPtrs == nil {
+		mmDeleteReviewComments.defaultExpectation.paramPtrs = &CommentRepositoryMockDeleteReviewCommentsParamPtrs{}
+	}
+	mmDeleteReviewComments.defaultExpectation.paramPtrs.movieID = &movieID
+	mmDeleteReviewComments.defaultExpectation.expectationOrigins.originMovieID = minimock.CallerInfo(1)
+
+	return mmDeleteReviewComments
+}
+
+// Inspect accepts an inspector function that has same arguments as the CommentRepository.DeleteReviewComments
+func (mmDeleteReviewComments *mCommentRepositoryMockDeleteReviewComments) Inspect(f func(ctx context.Context, reviewUserID string, movieID string)) *mCommentRepositoryMockDeleteReviewComments {
+	if mmDeleteReviewComments.mock.inspectFuncDeleteReviewComments != nil {
+		mmDeleteReviewComments.mock.t.Fatalf("Inspect function is already set for CommentRepositoryMock.DeleteReviewComments")
+	}
+
+	mmDeleteReviewComments.mock.inspectFuncDeleteReviewComments = f
+
+	return mmDeleteReviewComments
+}
+
+// Return sets up results that will be returned by CommentRepository.DeleteReviewComments
+func (mmDeleteReviewComments *mCommentRepositoryMockDeleteReviewComments) Return(err error) *CommentRepositoryMock {
+	if mmDeleteReviewComments.mock.funcDeleteReviewComments != nil {
+		mmDeleteReviewComments.mock.t.Fatalf("CommentRepositoryMock.DeleteReviewComments mock is already set by Set")
+	}
+
+	if mmDeleteReviewComments.defaultExpectation == nil {
+		mmDeleteReviewComments.defaultExpectation = &CommentRepositoryMockDeleteReviewCommentsExpectation{mock: mmDeleteReviewComments.mock}
+	}
+	mmDeleteReviewComments.defaultExpectation.results = &CommentRepositoryMockDeleteReviewCommentsResults{err}
+	mmDeleteReviewComments.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteReviewComments.mock
+}
+
+// Set uses given function f to mock the CommentRepository.DeleteReviewComments method
+func (mmDeleteReviewComments *mCommentRepositoryMockDeleteReviewComments) Set(f func(ctx context.Context, reviewUserID string, movieID string) (err error)) *CommentRepositoryMock {
+	if mmDeleteReviewComments.defaultExpectation != nil {
+		mmDeleteReviewComments.mock.t.Fatalf("Default expectation is already set for the CommentRepository.DeleteReviewComments method")
+	}
+
+	if len(mmDeleteReviewComments.expectations) > 0 {
+		mmDeleteReviewComments.mock.t.Fatalf("Some expectations are already set for the CommentRepository.DeleteReviewComments method")
+	}
+
+	mmDeleteReviewComments.mock.funcDeleteReviewComments = f
+	mmDeleteReviewComments.mock.funcDeleteReviewCommentsOrigin = minimock.CallerInfo(1)
+	return mmDeleteReviewComments.mock
+}
+
+// When sets expectation for the CommentRepository.DeleteReviewComments which will trigger the result defined by the following
+// Then helper
+func (mmDeleteReviewComments *mCommentRepositoryMockDeleteReviewComments) When(ctx context.Context, reviewUserID string, movieID string) *CommentRepositoryMockDeleteReviewCommentsExpectation {
+	if mmDeleteReviewComments.mock.funcDeleteReviewComments != nil {
+		mmDeleteReviewComments.mock.t.Fatalf("CommentRepositoryMock.DeleteReviewComments mock is already set by Set")
+	}
+
+	expectation := &CommentRepositoryMockDeleteReviewCommentsExpectation{
+		mock:               mmDeleteReviewComments.mock,
+		params:             &CommentRepositoryMockDeleteReviewCommentsParams{ctx, reviewUserID, movieID},
+		expectationOrigins: CommentRepositoryMockDeleteReviewCommentsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteReviewComments.expectations = append(mmDeleteReviewComments.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CommentRepository.DeleteReviewComments return parameters for the expectation previously defined by the When method
+func (e *CommentRepositoryMockDeleteReviewCommentsExpectation) Then(err error) *CommentRepositoryMock {
+	e.results = &CommentRepositoryMockDeleteReviewCommentsResults{err}
+	return e.mock
+}
+
+// Times sets number of times CommentRepository.DeleteReviewComments should be invoked
+func (mmDeleteReviewComments *mCommentRepositoryMockDeleteReviewComments) Times(n uint64) *mCommentRepositoryMockDeleteReviewComments {
+	if n == 0 {
+		mmDeleteReviewComments.mock.t.Fatalf("Times of CommentRepositoryMock.DeleteReviewComments mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteReviewComments.expectedInvocations, n)
+	mmDeleteReviewComments.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteReviewComments
+}
+
+func (mmDeleteReviewComments *mCommentRepositoryMockDeleteReviewComments) invocationsDone() bool {
+	if len(mmDeleteReviewComments.expectations) == 0 && mmDeleteReviewComments.defaultExpectation == nil && mmDeleteReviewComments.mock.funcDeleteReviewComments == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteReviewComments.mock.afterDeleteReviewCommentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteReviewComments.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteReviewComments implements mm_repository.CommentRepository
+func (mmDeleteReviewComments *CommentRepositoryMock) DeleteReviewComments(ctx context.Context, reviewUserID string, movieID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteReviewComments.beforeDeleteReviewCommentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteReviewComments.afterDeleteReviewCommentsCounter, 1)
+
+	mmDeleteReviewComments.t.Helper()
+
+	if mmDeleteReviewComments.inspectFuncDeleteReviewComments != nil {
+		mmDeleteReviewComments.inspectFuncDeleteReviewComments(ctx, reviewUserID, movieID)
+	}
+
+	mm_params := CommentRepositoryMockDeleteReviewCommentsParams{ctx, reviewUserID, movieID}
+
+	// Record call args
+	mmDeleteReviewComments.DeleteReviewCommentsMock.mutex.Lock()
+	mmDeleteReviewComments.DeleteReviewCommentsMock.callArgs = append(mmDeleteReviewComments.DeleteReviewCommentsMock.callArgs, &mm_params)
+	mmDeleteReviewComments.DeleteReviewCommentsMock.mutex.Unlock()
+
+	for _, e := range mmDeleteReviewComments.DeleteReviewCommentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteReviewComments.DeleteReviewCommentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteReviewComments.DeleteReviewCommentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteReviewComments.DeleteReviewCommentsMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteReviewComments.DeleteReviewCommentsMock.defaultExpectation.paramPtrs
+
+		mm_got := CommentRepositoryMockDeleteReviewCommentsParams{ctx, reviewUserID, movieID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteReviewComments.t.Errorf("CommentRepositoryMock.DeleteReviewComments got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteReviewComments.DeleteReviewCommentsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.reviewUserID != nil && !minimock.Equal(*mm_want_ptrs.reviewUserID, mm_got.reviewUserID) {
+				mmDeleteReviewComments.t.Errorf("CommentRepositoryMock.DeleteReviewComments got unexpected parameter reviewUserID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteReviewComments.DeleteReviewCommentsMock.defaultExpectation.expectationOrigins.originReviewUserID, *mm_want_ptrs.reviewUserID, mm_got.reviewUserID, minimock.Diff(*mm_want_ptrs.reviewUserID, mm_got.reviewUserID))
+			}
+
+			if mm_want_ptrs.movieID != nil && !minimock.Equal(*mm_want_ptrs.movieID, mm_got.movieID) {
+				mmDeleteReviewComments.t.Errorf("CommentRepositoryMock.DeleteReviewComments got unexpected parameter movieID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteReviewComments.DeleteReviewCommentsMock.defaultExpectation.expectationOrigins.originMovieID, *mm_want_ptrs.movieID, mm_got.movieID, minimock.Diff(*mm_want_ptrs.movieID, mm_got.movieID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteReviewComments.t.Errorf("CommentRepositoryMock.DeleteReviewComments got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteReviewComments.DeleteReviewCommentsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteReviewComments.DeleteReviewCommentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteReviewComments.t.Fatal("No results are set for the CommentRepositoryMock.DeleteReviewComments")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteReviewComments.funcDeleteReviewComments != nil {
+		return mmDeleteReviewComments.funcDeleteReviewComments(ctx, reviewUserID, movieID)
+	}
+	mmDeleteReviewComments.t.Fatalf("Unexpected call to CommentRepositoryMock.DeleteReviewComments. %v %v %v", ctx, reviewUserID, movieID)
+	return
+}
+
+// DeleteReviewCommentsAfterCounter returns a count of finished CommentRepositoryMock.DeleteReviewComments invocations
+func (mmDeleteReviewComments *CommentRepositoryMock) DeleteReviewCommentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteReviewComments.afterDeleteReviewCommentsCounter)
+}
+
+// DeleteReviewCommentsBeforeCounter returns a count of CommentRepositoryMock.DeleteReviewComments invocations
+func (mmDeleteReviewComments *CommentRepositoryMock) DeleteReviewCommentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteReviewComments.beforeDeleteReviewCommentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to CommentRepositoryMock.DeleteReviewComments.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteReviewComments *mCommentRepositoryMockDeleteReviewComments) Calls() []*CommentRepositoryMockDeleteReviewCommentsParams {
+	mmDeleteReviewComments.mutex.RLock()
+
+	argCopy := make([]*CommentRepositoryMockDeleteReviewCommentsParams, len(mmDeleteReviewComments.callArgs))
+	copy(argCopy, mmDeleteReviewComments.callArgs)
+
+	mmDeleteReviewComments.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteReviewCommentsDone returns true if the count of the DeleteReviewComments invocations corresponds
+// the number of defined expectations
+func (m *CommentRepositoryMock) MinimockDeleteReviewCommentsDone() bool {
+	if m.DeleteReviewCommentsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteReviewCommentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteReviewCommentsMock.invocationsDone()
+}
+
+// MinimockDeleteReviewCommentsInspect logs each unmet expectation
+func (m *CommentRepositoryMock) MinimockDeleteReviewCommentsInspect() {
+	for _, e := range m.DeleteReviewCommentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CommentRepositoryMock.DeleteReviewComments at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteReviewCommentsCounter := mm_atomic.LoadUint64(&m.afterDeleteReviewCommentsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteReviewCommentsMock.defaultExpectation != nil && afterDeleteReviewCommentsCounter < 1 {
+		if m.DeleteReviewCommentsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CommentRepositoryMock.DeleteReviewComments at\n%s", m.DeleteReviewCommentsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CommentRepositoryMock.DeleteReviewComments at\n%s with params: %#v", m.DeleteReviewCommentsMock.defaultExpectation.expectationOrigins.origin, *m.DeleteReviewCommentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteReviewComments != nil && afterDeleteReviewCommentsCounter < 1 {
+		m.t.Errorf("Expected call to CommentRepositoryMock.DeleteReviewComments at\n%s", m.funcDeleteReviewCommentsOrigin)
+	}
+
+	if !m.DeleteReviewCommentsMock.invocationsDone() && afterDeleteReviewCommentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to CommentRepositoryMock.DeleteReviewComments at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteReviewCommentsMock.expectedInvocations), m.DeleteReviewCommentsMock.expectedInvocationsOrigin, afterDeleteReviewCommentsCounter)
+	}
+}
+
+type mCommentRepositoryMockDeleteUserComments struct {
+	optional           bool
+	mock               *CommentRepositoryMock
+	defaultExpectation *CommentRepositoryMockDeleteUserCommentsExpectation
+	expectations       []*CommentRepositoryMockDeleteUserCommentsExpectation
+
+	callArgs []*CommentRepositoryMockDeleteUserCommentsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CommentRepositoryMockDeleteUserCommentsExpectation specifies expectation struct of the CommentRepository.DeleteUserComments
+type CommentRepositoryMockDeleteUserCommentsExpectation struct {
+	mock               *CommentRepositoryMock
+	params             *CommentRepositoryMockDeleteUserCommentsParams
+	paramPtrs          *CommentRepositoryMockDeleteUserCommentsParamPtrs
+	expectationOrigins CommentRepositoryMockDeleteUserCommentsExpectationOrigins
+	results            *CommentRepositoryMockDeleteUserCommentsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CommentRepositoryMockDeleteUserCommentsParams contains parameters of the CommentRepository.DeleteUserComments
+type CommentRepositoryMockDeleteUserCommentsParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// CommentRepositoryMockDeleteUserCommentsParamPtrs contains pointers to parameters of the CommentRepository.DeleteUserComments
+type CommentRepositoryMockDeleteUserCommentsParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// CommentRepositoryMockDeleteUserCommentsResults contains results of the CommentRepository.DeleteUserComments
+type CommentRepositoryMockDeleteUserCommentsResults struct {
+	err error
+}
+
+// CommentRepositoryMockDeleteUserCommentsOrigins contains origins of expectations of the CommentRepository.DeleteUserComments
+type CommentRepositoryMockDeleteUserCommentsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteUserComments *mCommentRepositoryMockDeleteUserComments) Optional() *mCommentRepositoryMockDeleteUserComments {
+	mmDeleteUserComments.optional = true
+	return mmDeleteUserComments
+}
+
+// Expect sets up expected params for CommentRepository.DeleteUserComments
+func (mmDeleteUserComments *mCommentRepositoryMockDeleteUserComments) Expect(ctx context.Context, userID string) *mCommentRepositoryMockDeleteUserComments {
+	if mmDeleteUserComments.mock.funcDeleteUserComments != nil {
+		mmDeleteUserComments.mock.t.Fatalf("CommentRepositoryMock.DeleteUserComments mock is already set by Set")
+	}
+
+	if mmDeleteUserComments.defaultExpectation == nil {
+		mmDeleteUserComments.defaultExpectation = &CommentRepositoryMockDeleteUserCommentsExpectation{}
+	}
+
+	if mmDeleteUserComments.defaultExpectation.paramPtrs != nil {
+		mmDeleteUserComments.mock.t.Fatalf("CommentRepositoryMock.DeleteUserComments mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteUserComments.defaultExpectation.params = &CommentRepositoryMockDeleteUserCommentsParams{ctx, userID}
+	mmDeleteUserComments.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteUserComments.expectations {
+		if minimock.Equal(e.params, mmDeleteUserComments.defaultExpectation.params) {
+			mmDeleteUserComments.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUserComments.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteUserComments
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CommentRepository.DeleteUserComments
+func (mmDeleteUserComments *mCommentRepositoryMockDeleteUserComments) ExpectCtxParam1(ctx context.Context) *mCommentRepositoryMockDeleteUserComments {
+	if mmDeleteUserComments.mock.funcDeleteUserComments != nil {
+		mmDeleteUserComments.mock.t.Fatalf("CommentRepositoryMock.DeleteUserComments mock is already set by Set")
+	}
+
+	if mmDeleteUserComments.defaultExpectation == nil {
+		mmDeleteUserComments.defaultExpectation = &CommentRepositoryMockDeleteUserCommentsExpectation{}
+	}
+
+	if mmDeleteUserComments.defaultExpectation.params != nil {
+		mmDeleteUserComments.mock.t.Fatalf("CommentRepositoryMock.DeleteUserComments mock is already set by Expect")
+	}
+
+	if mmDeleteUserComments.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserComments.defaultExpectation.paramPtrs = &CommentRepositoryMockDeleteUserCommentsParamPtrs{}
+	}
+	mmDeleteUserComments.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteUserComments.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteUserComments
+}
+
+// ExpectUserIDParam2 sets up expected param userID for CommentRepository.DeleteUserComments
+func (mmDeleteUserComments *mCommentRepositoryMockDeleteUserComments) ExpectUserIDParam2(userID string) *mCommentRepositoryMockDeleteUserComments {
+	if mmDeleteUserComments.mock.funcDeleteUserComments != nil {
+		mmDeleteUserComments.mock.t.Fatalf("CommentRepositoryMock.DeleteUserComments mock is already set by Set")
+	}
+
+	if mmDeleteUserComments.defaultExpectation == nil {
+		mmDeleteUserComments.defaultExpectation = &CommentRepositoryMockDeleteUserCommentsExpectation{}
+	}
+
+	if mmDeleteUserComments.defaultExpectation.params != nil {
+		mmDeleteUserComments.mock.t.Fatalf("CommentRepositoryMock.DeleteUserComments mock is already set by Expect")
+	}
+
+	if mmDeleteUserComments.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserComments.defaultExpectation.paramPtrs = &CommentRepositoryMockDeleteUserCommentsParamPtrs{}
+	}
+	mmDeleteUserComments.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteUserComments.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteUserComments
+}
+
+// Inspect accepts an inspector function that has same arguments as the CommentRepository.DeleteUserComments
+func (mmDeleteUserComments *mCommentRepositoryMockDeleteUserComments) Inspect(f func(ctx context.Context, userID string)) *mCommentRepositoryMockDeleteUserComments {
+	if mmDeleteUserComments.mock.inspectFuncDeleteUserComments != nil {
+		mmDeleteUserComments.mock.t.Fatalf("Inspect function is already set for CommentRepositoryMock.DeleteUserComments")
+	}
+
+	mmDeleteUserComments.mock.inspectFuncDeleteUserComments = f
+
+	return mmDeleteUserComments
+}
+
+// Return sets up results that will be returned by CommentRepository.DeleteUserComments
+func (mmDeleteUserComments *mCommentRepositoryMockDeleteUserComments) Return(err error) *CommentRepositoryMock {
+	if mmDeleteUserComments.mock.funcDeleteUserComments != nil {
+		mmDeleteUserComments.mock.t.Fatalf("CommentRepositoryMock.DeleteUserComments mock is already set by Set")
+	}
+
+	if mmDeleteUserComments.defaultExpectation == nil {
+		mmDeleteUserComments.defaultExpectation = &CommentRepositoryMockDeleteUserCommentsExpectation{mock: mmDeleteUserComments.mock}
+	}
+	mmDeleteUserComments.defaultExpectation.results = &CommentRepositoryMockDeleteUserCommentsResults{err}
+	mmDeleteUserComments.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserComments.mock
+}
+
+// Set uses given function f to mock the CommentRepository.DeleteUserComments method
+func (mmDeleteUserComments *mCommentRepositoryMockDeleteUserComments) Set(f func(ctx context.Context, userID string) (err error)) *CommentRepositoryMock {
+	if mmDeleteUserComments.defaultExpectation != nil {
+		mmDeleteUserComments.mock.t.Fatalf("Default expectation is already set for the CommentRepository.DeleteUserComments method")
+	}
+
+	if len(mmDeleteUserComments.expectations) > 0 {
+		mmDeleteUserComments.mock.t.Fatalf("Some expectations are already set for the CommentRepository.DeleteUserComments method")
+	}
+
+	mmDeleteUserComments.mock.funcDeleteUserComments = f
+	mmDeleteUserComments.mock.funcDeleteUserCommentsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserComments.mock
+}
+
+// When sets expectation for the CommentRepository.DeleteUserComments which will trigger the result defined by the following
+// Then helper
+func (mmDeleteUserComments *mCommentRepositoryMockDeleteUserComments) When(ctx context.Context, userID string) *CommentRepositoryMockDeleteUserCommentsExpectation {
+	if mmDeleteUserComments.mock.funcDeleteUserComments != nil {
+		mmDeleteUserComments.mock.t.Fatalf("CommentRepositoryMock.DeleteUserComments mock is already set by Set")
+	}
+
+	expectation := &CommentRepositoryMockDeleteUserCommentsExpectation{
+		mock:               mmDeleteUserComments.mock,
+		params:             &CommentRepositoryMockDeleteUserCommentsParams{ctx, userID},
+		expectationOrigins: CommentRepositoryMockDeleteUserCommentsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteUserComments.expectations = append(mmDeleteUserComments.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CommentRepository.DeleteUserComments return parameters for the expectation previously defined by the When method
+func (e *CommentRepositoryMockDeleteUserCommentsExpectation) Then(err error) *CommentRepositoryMock {
+	e.results = &CommentRepositoryMockDeleteUserCommentsResults{err}
+	return e.mock
+}
+
+// Times sets number of times CommentRepository.DeleteUserComments should be invoked
+func (mmDeleteUserComments *mCommentRepositoryMockDeleteUserComments) Times(n uint64) *mCommentRepositoryMockDeleteUserComments {
+	if n == 0 {
+		mmDeleteUserComments.mock.t.Fatalf("Times of CommentRepositoryMock.DeleteUserComments mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteUserComments.expectedInvocations, n)
+	mmDeleteUserComments.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserComments
+}
+
+func (mmDeleteUserComments *mCommentRepositoryMockDeleteUserComments) invocationsDone() bool {
+	if len(mmDeleteUserComments.expectations) == 0 && mmDeleteUserComments.defaultExpectation == nil && mmDeleteUserComments.mock.funcDeleteUserComments == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUserComments.mock.afterDeleteUserCommentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUserComments.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteUserComments implements mm_repository.CommentRepository
+func (mmDeleteUserComments *CommentRepositoryMock) DeleteUserComments(ctx context.Context, userID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteUserComments.beforeDeleteUserCommentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUserComments.afterDeleteUserCommentsCounter, 1)
+
+	mmDeleteUserComments.t.Helper()
+
+	if mmDeleteUserComments.inspectFuncDeleteUserComments != nil {
+		mmDeleteUserComments.inspectFuncDeleteUserComments(ctx, userID)
+	}
+
+	mm_params := CommentRepositoryMockDeleteUserCommentsParams{ctx, userID}
+
+	// Record call args
+	mmDeleteUserComments.DeleteUserCommentsMock.mutex.Lock()
+	mmDeleteUserComments.DeleteUserCommentsMock.callArgs = append(mmDeleteUserComments.DeleteUserCommentsMock.callArgs, &mm_params)
+	mmDeleteUserComments.DeleteUserCommentsMock.mutex.Unlock()
+
+	for _, e := range mmDeleteUserComments.DeleteUserCommentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteUserComments.DeleteUserCommentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUserComments.DeleteUserCommentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUserComments.DeleteUserCommentsMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUserComments.DeleteUserCommentsMock.defaultExpectation.paramPtrs
+
+		mm_got := CommentRepositoryMockDeleteUserCommentsParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteUserComments.t.Errorf("CommentRepositoryMock.DeleteUserComments got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserComments.DeleteUserCommentsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteUserComments.t.Errorf("CommentRepositoryMock.DeleteUserComments got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserComments.DeleteUserCommentsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteUserComments.t.Errorf("CommentRepositoryMock.DeleteUserComments got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteUserComments.DeleteUserCommentsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteUserComments.DeleteUserCommentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteUserComments.t.Fatal("No results are set for the CommentRepositoryMock.DeleteUserComments")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteUserComments.funcDeleteUserComments != nil {
+		return mmDeleteUserComments.funcDeleteUserComments(ctx, userID)
+	}
+	mmDeleteUserComments.t.Fatalf("Unexpected call to CommentRepositoryMock.DeleteUserComments. %v %v", ctx, userID)
+	return
+}
+
+// DeleteUserCommentsAfterCounter returns a count of finished CommentRepositoryMock.DeleteUserComments invocations
+func (mmDeleteUserComments *CommentRepositoryMock) DeleteUserCommentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserComments.afterDeleteUserCommentsCounter)
+}
+
+// DeleteUserCommentsBeforeCounter returns a count of CommentRepositoryMock.DeleteUserComments invocations
+func (mmDeleteUserComments *CommentRepositoryMock) DeleteUserCommentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserComments.beforeDeleteUserCommentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to CommentRepositoryMock.DeleteUserComments.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteUserComments *mCommentRepositoryMockDeleteUserComments) Calls() []*CommentRepositoryMockDeleteUserCommentsParams {
+	mmDeleteUserComments.mutex.RLock()
+
+	argCopy := make([]*CommentRepositoryMockDeleteUserCommentsParams, len(mmDeleteUserComments.callArgs))
+	copy(argCopy, mmDeleteUserComments.callArgs)
+
+	mmDeleteUserComments.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteUserCommentsDone returns true if the count of the DeleteUserComments invocations corresponds
+// the number of defined expectations
+func (m *CommentRepositoryMock) MinimockDeleteUserCommentsDone() bool {
+	if m.DeleteUserCommentsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteUserCommentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteUserCommentsMock.invocationsDone()
+}
+
+// MinimockDeleteUserCommentsInspect logs each unmet expectation
+func (m *CommentRepositoryMock) MinimockDeleteUserCommentsInspect() {
+	for _, e := range m.DeleteUserCommentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CommentRepositoryMock.DeleteUserComments at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteUserCommentsCounter := mm_atomic.LoadUint64(&m.afterDeleteUserCommentsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteUserCommentsMock.defaultExpectation != nil && afterDeleteUserCommentsCounter < 1 {
+		if m.DeleteUserCommentsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CommentRepositoryMock.DeleteUserComments at\n%s", m.DeleteUserCommentsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CommentRepositoryMock.DeleteUserComments at\n%s with params: %#v", m.DeleteUserCommentsMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserCommentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteUserComments != nil && afterDeleteUserCommentsCounter < 1 {
+		m.t.Errorf("Expected call to CommentRepositoryMock.DeleteUserComments at\n%s", m.funcDeleteUserCommentsOrigin)
+	}
+
+	if !m.DeleteUserCommentsMock.invocationsDone() && afterDeleteUserCommentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to CommentRepositoryMock.DeleteUserComments at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUserCommentsMock.expectedInvocations), m.DeleteUserCommentsMock.expectedInvocationsOrigin, afterDeleteUserCommentsCounter)
+	}
+}
+
+type mCommentRepositoryMockGetComment struct {
+	optional           bool
+	mock               *CommentRepositoryMock
+	defaultExpectation *CommentRepositoryMockGetCommentExpectation
+	expectations       []*CommentRepositoryMockGetCommentExpectation
+
+	callArgs []*CommentRepositoryMockGetCommentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CommentRepositoryMockGetCommentExpectation specifies expectation struct of the CommentRepository.GetComment
+type CommentRepositoryMockGetCommentExpectation struct {
+	mock               *CommentRepositoryMock
+	params             *CommentRepositoryMockGetCommentParams
+	paramPtrs          *CommentRepositoryMockGetCommentParamPtrs
+	expectationOrigins CommentRepositoryMockGetCommentExpectationOrigins
+	results            *CommentRepositoryMockGetCommentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CommentRepositoryMockGetCommentParams contains parameters of the CommentRepository.GetComment
+type CommentRepositoryMockGetCommentParams struct {
+	ctx context.Context
+	ID  string
+}
+
+// CommentRepositoryMockGetCommentParamPtrs contains pointers to parameters of the CommentRepository.GetComment
+type CommentRepositoryMockGetCommentParamPtrs struct {
+	ctx *context.Context
+	ID  *string
+}
+
+// CommentRepositoryMockGetCommentResults contains results of the CommentRepository.GetComment
+type CommentRepositoryMockGetCommentResults struct {
+	c2  mm_repository.Comment
+	err error
+}
+
+// CommentRepositoryMockGetCommentOrigins contains origins of expectations of the CommentRepository.GetComment
+type CommentRepositoryMockGetCommentExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originID  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetComment *mCommentRepositoryMockGetComment) Optional() *mCommentRepositoryMockGetComment {
+	mmGetComment.optional = true
+	return mmGetComment
+}
+
+// Expect sets up expected params for CommentRepository.GetComment
+func (mmGetComment *mCommentRepositoryMockGetComment) Expect(ctx context.Context, ID string) *mCommentRepositoryMockGetComment {
+	if mmGetComment.mock.funcGetComment != nil {
+		mmGetComment.mock.t.Fatalf("CommentRepositoryMock.GetComment mock is already set by Set")
+	}
+
+	if mmGetComment.defaultExpectation == nil {
+		mmGetComment.defaultExpectation = &CommentRepositoryMockGetCommentExpectation{}
+	}
+
+	if mmGetComment.defaultExpectation.paramPtrs != nil {
+		mmGetComment.mock.t.Fatalf("CommentRepositoryMock.GetComment mock is already set by ExpectParams functions")
+	}
+
+	mmGetComment.defaultExpectation.params = &CommentRepositoryMockGetCommentParams{ctx, ID}
+	mmGetComment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetComment.expectations {
+		if minimock.Equal(e.params, mmGetComment.defaultExpectation.params) {
+			mmGetComment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetComment.defaultExpectation.params)
+		}
+	}
+
+	return mmGetComment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CommentRepository.GetComment
+func (mmGetComment *mCommentRepositoryMockGetComment) ExpectCtxParam1(ctx context.Context) *mCommentRepositoryMockGetComment {
+	if mmGetComment.mock.funcGetComment != nil {
+		mmGetComment.mock.t.Fatalf("CommentRepositoryMock.GetComment mock is already set by Set")
+	}
+
+	if mmGetComment.defaultExpectation == nil {
+		mmGetComment.defaultExpectation = &CommentRepositoryMockGetCommentExpectation{}
+	}
+
+	if mmGetComment.defaultExpectation.params != nil {
+		mmGetComment.mock.t.Fatalf("CommentRepositoryMock.GetComment mock is already set by Expect")
+	}
+
+	if mmGetComment.defaultExpectation.paramPtrs == nil {
+		mmGetComment.defaultExpectation.paramPtrs = &CommentRepositoryMockGetCommentParamPtrs{}
+	}
+	mmGetComment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetComment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetComment
+}
+
+// ExpectIDParam2 sets up expected param ID for CommentRepository.GetComment
+func (mmGetComment *mCommentRepositoryMockGetComment) ExpectIDParam2(ID string) *mCommentRepositoryMockGetComment {
+	if mmGetComment.mock.funcGetComment != nil {
+		mmGetComment.mock.t.Fatalf("CommentRepositoryMock.GetComment mock is already set by Set")
+	}
+
+	if mmGetComment.defaultExpectation == nil {
+		mmGetComment.defaultExpectation = &CommentRepositoryMockGetCommentExpectation{}
+	}
+
+	if mmGetComment.defaultExpectation.params != nil {
+		mmGetComment.mock.t.Fatalf("CommentRepositoryMock.GetComment mock is already set by Expect")
+	}
+
+	if mmGetComment.defaultExpectation.paramPtrs == nil {
+		mmGetComment.defaultExpectation.paramPtrs = &CommentRepositoryMockGetCommentParamPtrs{}
+	}
+	mmGetComment.defaultExpectation.paramPtrs.ID = &ID
+	mmGetComment.defaultExpectation.expectationOrigins.originID = minimock.CallerInfo(1)
+
+	return mmGetComment
+}
+
+// Inspect accepts an inspector function that has same arguments as the CommentRepository.GetComment
+func (mmGetComment *mCommentRepositoryMockGetComment) Inspect(f func(ctx context.Context, ID string)) *mCommentRepositoryMockGetComment {
+	if mmGetComment.mock.inspectFuncGetComment != nil {
+		mmGetComment.mock.t.Fatalf("Inspect function is already set for CommentRepositoryMock.GetComment")
+	}
+
+	mmGetComment.mock.inspectFuncGetComment = f
+
+	return mmGetComment
+}
+
+// Return sets up results that will be returned by CommentRepository.GetComment
+func (mmGetComment *mCommentRepositoryMockGetComment) Return(c2 mm_repository.Comment, err error) *CommentRepositoryMock {
+	if mmGetComment.mock.funcGetComment != nil {
+		mmGetComment.mock.t.Fatalf("CommentRepositoryMock.GetComment mock is already set by Set")
+	}
+
+	if mmGetComment.defaultExpectation == nil {
+		mmGetComment.defaultExpectation = &CommentRepositoryMockGetCommentExpectation{mock: mmGetComment.mock}
+	}
+	mmGetComment.defaultExpectation.results = &CommentRepositoryMockGetCommentResults{c2, err}
+	mmGetComment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetComment.mock
+}
+
+// Set uses given function f to mock the CommentRepository.GetComment method
+func (mmGetComment *mCommentRepositoryMockGetComment) Set(f func(ctx context.Context, ID string) (c2 mm_repository.Comment, err error)) *CommentRepositoryMock {
+	if mmGetComment.defaultExpectation != nil {
+		mmGetComment.mock.t.Fatalf("Default expectation is already set for the CommentRepository.GetComment method")
+	}
+
+	if len(mmGetComment.expectations) > 0 {
+		mmGetComment.mock.t.Fatalf("Some expectations are already set for the CommentRepository.GetComment method")
+	}
+
+	mmGetComment.mock.funcGetComment = f
+	mmGetComment.mock.funcGetCommentOrigin = minimock.CallerInfo(1)
+	return mmGetComment.mock
+}
+
+// When sets expectation for the CommentRepository.GetComment which will trigger the result defined by the following
+// Then helper
+func (mmGetComment *mCommentRepositoryMockGetComment) When(ctx context.Context, ID string) *CommentRepositoryMockGetCommentExpectation {
+	if mmGetComment.mock.funcGetComment != nil {
+		mmGetComment.mock.t.Fatalf("CommentRepositoryMock.GetComment mock is already set by Set")
+	}
+
+	expectation := &CommentRepositoryMockGetCommentExpectation{
+		mock:               mmGetComment.mock,
+		params:             &CommentRepositoryMockGetCommentParams{ctx, ID},
+		expectationOrigins: CommentRepositoryMockGetCommentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetComment.expectations = append(mmGetComment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CommentRepository.GetComment return parameters for the expectation previously defined by the When method
+func (e *CommentRepositoryMockGetCommentExpectation) Then(c2 mm_repository.Comment, err error) *CommentRepositoryMock {
+	e.results = &CommentRepositoryMockGetCommentResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times CommentRepository.GetComment should be invoked
+func (mmGetComment *mCommentRepositoryMockGetComment) Times(n uint64) *mCommentRepositoryMockGetComment {
+	if n == 0 {
+		mmGetComment.mock.t.Fatalf("Times of CommentRepositoryMock.GetComment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetComment.expectedInvocations, n)
+	mmGetComment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetComment
+}
+
+func (mmGetComment *mCommentRepositoryMockGetComment) invocationsDone() bool {
+	if len(mmGetComment.expectations) == 0 && mmGetComment.defaultExpectation == nil && mmGetComment.mock.funcGetComment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetComment.mock.afterGetCommentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetComment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetComment implements mm_repository.CommentRepository
+func (mmGetComment *CommentRepositoryMock) GetComment(ctx context.Context, ID string) (c2 mm_repository.Comment, err error) {
+	mm_atomic.AddUint64(&mmGetComment.beforeGetCommentCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetComment.afterGetCommentCounter, 1)
+
+	mmGetComment.t.Helper()
+
+	if mmGetComment.inspectFuncGetComment != nil {
+		mmGetComment.inspectFuncGetComment(ctx, ID)
+	}
+
+	mm_params := CommentRepositoryMockGetCommentParams{ctx, ID}
+
+	// Record call args
+	mmGetComment.GetCommentMock.mutex.Lock()
+	mmGetComment.GetCommentMock.callArgs = append(mmGetComment.GetCommentMock.callArgs, &mm_params)
+	mmGetComment.GetCommentMock.mutex.Unlock()
+
+	for _, e := range mmGetComment.GetCommentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmGetComment.GetCommentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetComment.GetCommentMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetComment.GetCommentMock.defaultExpectation.params
+		mm_want_ptrs := mmGetComment.GetCommentMock.defaultExpectation.paramPtrs
+
+		mm_got := CommentRepositoryMockGetCommentParams{ctx, ID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetComment.t.Errorf("CommentRepositoryMock.GetComment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetComment.GetCommentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ID != nil && !minimock.Equal(*mm_want_ptrs.ID, mm_got.ID) {
+				mmGetComment.t.Errorf("CommentRepositoryMock.GetComment got unexpected parameter ID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetComment.GetCommentMock.defaultExpectation.expectationOrigins.originID, *mm_want_ptrs.ID, mm_got.ID, minimock.Diff(*mm_want_ptrs.ID, mm_got.ID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetComment.t.Errorf("CommentRepositoryMock.GetComment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetComment.GetCommentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetComment.GetCommentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetComment.t.Fatal("No results are set for the CommentRepositoryMock.GetComment")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmGetComment.funcGetComment != nil {
+		return mmGetComment.funcGetComment(ctx, ID)
+	}
+	mmGetComment.t.Fatalf("Unexpected call to CommentRepositoryMock.GetComment. %v %v", ctx, ID)
+	return
+}
+
+// GetCommentAfterCounter returns a count of finished CommentRepositoryMock.GetComment invocations
+func (mmGetComment *CommentRepositoryMock) GetCommentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetComment.afterGetCommentCounter)
+}
+
+// GetCommentBeforeCounter returns a count of CommentRepositoryMock.GetComment invocations
+func (mmGetComment *CommentRepositoryMock) GetCommentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetComment.beforeGetCommentCounter)
+}
+
+// Calls returns a list of arguments used in each call to CommentRepositoryMock.GetComment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetComment *mCommentRepositoryMockGetComment) Calls() []*CommentRepositoryMockGetCommentParams {
+	mmGetComment.mutex.RLock()
+
+	argCopy := make([]*CommentRepositoryMockGetCommentParams, len(mmGetComment.callArgs))
+	copy(argCopy, mmGetComment.callArgs)
+
+	mmGetComment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCommentDone returns true if the count of the GetComment invocations corresponds
+// the number of defined expectations
+func (m *CommentRepositoryMock) MinimockGetCommentDone() bool {
+	if m.GetCommentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCommentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCommentMock.invocationsDone()
+}
+
+// MinimockGetCommentInspect logs each unmet expectation
+func (m *CommentRepositoryMock) MinimockGetCommentInspect() {
+	for _, e := range m.GetCommentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CommentRepositoryMock.GetComment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCommentCounter := mm_atomic.LoadUint64(&m.afterGetCommentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCommentMock.defaultExpectation != nil && afterGetCommentCounter < 1 {
+		if m.GetCommentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CommentRepositoryMock.GetComment at\n%s", m.GetCommentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CommentRepositoryMock.GetComment at\n%s with params: %#v", m.GetCommentMock.defaultExpectation.expectationOrigins.origin, *m.GetCommentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetComment != nil && afterGetCommentCounter < 1 {
+		m.t.Errorf("Expected call to CommentRepositoryMock.GetComment at\n%s", m.funcGetCommentOrigin)
+	}
+
+	if !m.GetCommentMock.invocationsDone() && afterGetCommentCounter > 0 {
+		m.t.Errorf("Expected %d calls to CommentRepositoryMock.GetComment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCommentMock.expectedInvocations), m.GetCommentMock.expectedInvocationsOrigin, afterGetCommentCounter)
+	}
+}
+
+type mCommentRepositoryMockListComments struct {
+	optional           bool
+	mock               *CommentRepositoryMock
+	defaultExpectation *CommentRepositoryMockListCommentsExpectation
+	expectations       []*CommentRepositoryMockListCommentsExpectation
+
+	callArgs []*CommentRepositoryMockListCommentsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CommentRepositoryMockListCommentsExpectation specifies expectation struct of the CommentRepository.ListComments
+type CommentRepositoryMockListCommentsExpectation struct {
+	mock               *CommentRepositoryMock
+	params             *CommentRepositoryMockListCommentsParams
+	paramPtrs          *CommentRepositoryMockListCommentsParamPtrs
+	expectationOrigins CommentRepositoryMockListCommentsExpectationOrigins
+	results            *CommentRepositoryMockListCommentsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CommentRepositoryMockListCommentsParams contains parameters of the CommentRepository.ListComments
+type CommentRepositoryMockListCommentsParams struct {
+	ctx          context.Context
+	reviewUserID string
+	movieID      string
+	offset       int
+	limit        int
+}
+
+// CommentRepositoryMockListCommentsParamPtrs contains pointers to parameters of the CommentRepository.ListComments
+type CommentRepositoryMockListCommentsParamPtrs struct {
+	ctx          *context.Context
+	reviewUserID *string
+	movieID      *string
+	offset       *int
+	limit        *int
+}
+
+// CommentRepositoryMockListCommentsResults contains results of the CommentRepository.ListComments
+type CommentRepositoryMockListCommentsResults struct {
+	ca1 []mm_repository.Comment
+	err error
+}
+
+// CommentRepositoryMockListCommentsOrigins contains origins of expectations of the CommentRepository.ListComments
+type CommentRepositoryMockListCommentsExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originReviewUserID string
+	originMovieID      string
+	originOffset       string
+	originLimit        string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListComments *mCommentRepositoryMockListComments) Optional() *mCommentRepositoryMockListComments {
+	mmListComments.optional = true
+	return mmListComments
+}
+
+// Expect sets up expected params for CommentRepository.ListComments
+func (mmListComments *mCommentRepositoryMockListComments) Expect(ctx context.Context, reviewUserID string, movieID string, offset int, limit int) *mCommentRepositoryMockListComments {
+	if mmListComments.mock.funcListComments != nil {
+		mmListComments.mock.t.Fatalf("CommentRepositoryMock.ListComments mock is already set by Set")
+	}
+
+	if mmListComments.defaultExpectation == nil {
+		mmListComments.defaultExpectation = &CommentRepositoryMockListCommentsExpectation{}
+	}
+
+	if mmListComments.defaultExpectation.paramPtrs != nil {
+		mmListComments.mock.t.Fatalf("CommentRepositoryMock.ListComments mock is already set by ExpectParams functions")
+	}
+
+	mmListComments.defaultExpectation.params = &CommentRepositoryMockListCommentsParams{ctx, reviewUserID, movieID, offset, limit}
+	mmListComments.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListComments.expectations {
+		if minimock.Equal(e.params, mmListComments.defaultExpectation.params) {
+			mmListComments.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListComments.defaultExpectation.params)
+		}
+	}
+
+	return mmListComments
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CommentRepository.ListComments
+func (mmListComments *mCommentRepositoryMockListComments) ExpectCtxParam1(ctx context.Context) *mCommentRepositoryMockListComments {
+	if mmListComments.mock.funcListComments != nil {
+		mmListComments.mock.t.Fatalf("CommentRepositoryMock.ListComments mock is already set by Set")
+	}
+
+	if mmListComments.defaultExpectation == nil {
+		mmListComments.defaultExpectation = &CommentRepositoryMockListCommentsExpectation{}
+	}
+
+	if mmListComments.defaultExpectation.params != nil {
+		mmListComments.mock.t.Fatalf("CommentRepositoryMock.ListComments mock is already set by Expect")
+	}
+
+	if mmListComments.defaultExpectation.paramPtrs == nil {
+		mmListComments.defaultExpectation.paramPtrs = &CommentRepositoryMockListCommentsParamPtrs{}
+	}
+	mmListComments.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListComments.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListComments
+}
+
+// ExpectReviewUserIDParam2 sets up expected param reviewUserID for CommentRepository.ListComments
+func (mmListComments *mCommentRepositoryMockListComments) ExpectReviewUserIDParam2(reviewUserID string) *mCommentRepositoryMockListComments {
+	if mmListComments.mock.funcListComments != nil {
+		mmListComments.mock.t.Fatalf("CommentRepositoryMock.ListComments mock is already set by Set")
+	}
+
+	if mmListComments.defaultExpectation == nil {
+		mmListComments.defaultExpectation = &CommentRepositoryMockListCommentsExpectation{}
+	}
+
+	if mmListComments.defaultExpectation.params != nil {
+		mmListComments.mock.t.Fatalf("CommentRepositoryMock.ListComments mock is already set by Expect")
+	}
+
+	if mmListComments.defaultExpectation.paramPtrs == nil {
+		mmListComments.defaultExpectation.paramPtrs = &CommentRepositoryMockListCommentsParamPtrs{}
+	}
+	mmListComments.defaultExpectation.paramPtrs.reviewUserID = &reviewUserID
+	mmListComments.defaultExpectation.expectationOrigins.originReviewUserID = minimock.CallerInfo(1)
+
+	return mmListComments
+}
+
+// ExpectMovieIDParam3 sets up expected param movieID for CommentRepository.ListComments
+func (mmListComments *mCommentRepositoryMockListComments) ExpectMovieIDParam3(movieID string) *mCommentRepositoryMockListComments {
+	if mmListComments.mock.funcListComments != nil {
+		mmListComments.mock.t.Fatalf("CommentRepositoryMock.ListComments mock is already set by Set")
+	}
+
+	if mmListComments.defaultExpectation == nil {
+		mmListComments.defaultExpectation = &CommentRepositoryMockListCommentsExpectation{}
+	}
+
+	if mmListComments.defaultExpectation.params != nil {
+		mmListComments.mock.t.Fatalf("CommentRepositoryMock.ListComments mock is already set by Expect")
+	}
+
+	if mmListComments.defaultExpectation.paramPtrs == nil {
+		mmListComments.defaultExpectation.paramPtrs = &CommentRepositoryMockListCommentsParamPtrs{}
+	}
+	mmListComments.defaultExpectation.paramPtrs.movieID = &movieID
+	mmListComments.defaultExpectation.expectationOrigins.originMovieID = minimock.CallerInfo(1)
+
+	return mmListComments
+}
+
+// ExpectOffsetParam4 sets up expected param offset for CommentRepository.ListComments
+func (mmListComments *mCommentRepositoryMockListComments) ExpectOffsetParam4(offset int) *mCommentRepositoryMockListComments {
+	if mmListComments.mock.funcListComments != nil {
+		mmListComments.mock.t.Fatalf("CommentRepositoryMock.ListComments mock is already set by Set")
+	}
+
+	if mmListComments.defaultExpectation == nil {
+		mmListComments.defaultExpectation = &CommentRepositoryMockListCommentsExpectation{}
+	}
+
+	if mmListComments.defaultExpectation.params != nil {
+		mmListComments.mock.t.Fatalf("CommentRepositoryMock.ListComments mock is already set by Expect")
+	}
+
+	if mmListComments.defaultExpectation.paramPtrs == nil {
+		mmListComments.defaultExpectation.paramPtrs = &CommentRepositoryMockListCommentsParamPtrs{}
+	}
+	mmListComments.defaultExpectation.paramPtrs.offset = &offset
+	mmListComments.defaultExpectation.expectationOrigins.originOffset = minimock.CallerInfo(1)
+
+	return mmListComments
+}
+
+// ExpectLimitParam5 sets up expected param limit for CommentRepository.ListComments
+func (mmListComments *mCommentRepositoryMockListComments) ExpectLimitParam5(limit int) *mCommentRepositoryMockListComments {
+	if mmListComments.mock.funcListComments != nil {
+		mmListComments.mock.t.Fatalf("CommentRepositoryMock.ListComments mock is already set by Set")
+	}
+
+	if mmListComments.defaultExpectation == nil {
+		mmListComments.defaultExpectation = &CommentRepositoryMockListCommentsExpectation{}
+	}
+
+	if mmListComments.defaultExpectation.params != nil {
+		mmListComments.mock.t.Fatalf("CommentRepositoryMock.ListComments mock is already set by Expect")
+	}
+
+	if mmListComments.defaultExpectation.paramPtrs == nil {
+		mmListComments.defaultExpectation.paramPtrs = &CommentRepositoryMockListCommentsParamPtrs{}
+	}
+	mmListComments.defaultExpectation.paramPtrs.limit = &limit
+	mmListComments.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListComments
+}
+
+// Inspect accepts an inspector function that has same arguments as the CommentRepository.ListComments
+func (mmListComments *mCommentRepositoryMockListComments) Inspect(f func(ctx context.Context, reviewUserID string, movieID string, offset int, limit int)) *mCommentRepositoryMockListComments {
+	if mmListComments.mock.inspectFuncListComments != nil {
+		mmListComments.mock.t.Fatalf("Inspect function is already set for CommentRepositoryMock.ListComments")
+	}
+
+	mmListComments.mock.inspectFuncListComments = f
+
+	return mmListComments
+}
+
+// Return sets up results that will be returned by CommentRepository.ListComments
+func (mmListComments *mCommentRepositoryMockListComments) Return(ca1 []mm_repository.Comment, err error) *CommentRepositoryMock {
+	if mmListComments.mock.funcListComments != nil {
+		mmListComments.mock.t.Fatalf("CommentRepositoryMock.ListComments mock is already set by Set")
+	}
+
+	if mmListComments.defaultExpectation == nil {
+		mmListComments.defaultExpectation = &CommentRepositoryMockListCommentsExpectation{mock: mmListComments.mock}
+	}
+	mmListComments.defaultExpectation.results = &CommentRepositoryMockListCommentsResults{ca1, err}
+	mmListComments.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListComments.mock
+}
+
+// Set uses given function f to mock the CommentRepository.ListComments method
+func (mmListComments *mCommentRepositoryMockListComments) Set(f func(ctx context.Context, reviewUserID string, movieID string, offset int, limit int) (ca1 []mm_repository.Comment, err error)) *CommentRepositoryMock {
+	if mmListComments.defaultExpectation != nil {
+		mmListComments.mock.t.Fatalf("Default expectation is already set for the CommentRepository.ListComments method")
+	}
+
+	if len(mmListComments.expectations) > 0 {
+		mmListComments.mock.t.Fatalf("Some expectations are already set for the CommentRepository.ListComments method")
+	}
+
+	mmListComments.mock.funcListComments = f
+	mmListComments.mock.funcListCommentsOrigin = minimock.CallerInfo(1)
+	return mmListComments.mock
+}
+
+// When sets expectation for the CommentRepository.ListComments which will trigger the result defined by the following
+// Then helper
+func (mmListComments *mCommentRepositoryMockListComments) When(ctx context.Context, reviewUserID string, movieID string, offset int, limit int) *CommentRepositoryMockListCommentsExpectation {
+	if mmListComments.mock.funcListComments != nil {
+		mmListComments.mock.t.Fatalf("CommentRepositoryMock.ListComments mock is already set by Set")
+	}
+
+	expectation := &CommentRepositoryMockListCommentsExpectation{
+		mock:               mmListComments.mock,
+		params:             &CommentRepositoryMockListCommentsParams{ctx, reviewUserID, movieID, offset, limit},
+		expectationOrigins: CommentRepositoryMockListCommentsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListComments.expectations = append(mmListComments.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CommentRepository.ListComments return parameters for the expectation previously defined by the When method
+func (e *CommentRepositoryMockListCommentsExpectation) Then(ca1 []mm_repository.Comment, err error) *CommentRepositoryMock {
+	e.results = &CommentRepositoryMockListCommentsResults{ca1, err}
+	return e.mock
+}
+
+// Times sets number of times CommentRepository.ListComments should be invoked
+func (mmListComments *mCommentRepositoryMockListComments) Times(n uint64) *mCommentRepositoryMockListComments {
+	if n == 0 {
+		mmListComments.mock.t.Fatalf("Times of CommentRepositoryMock.ListComments mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListComments.expectedInvocations, n)
+	mmListComments.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListComments
+}
+
+func (mmListComments *mCommentRepositoryMockListComments) invocationsDone() bool {
+	if len(mmListComments.expectations) == 0 && mmListComments.defaultExpectation == nil && mmListComments.mock.funcListComments == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListComments.mock.afterListCommentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListComments.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListComments implements mm_repository.CommentRepository
+func (mmListComments *CommentRepositoryMock) ListComments(ctx context.Context, reviewUserID string, movieID string, offset int, limit int) (ca1 []mm_repository.Comment, err error) {
+	mm_atomic.AddUint64(&mmListComments.beforeListCommentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListComments.afterListCommentsCounter, 1)
+
+	mmListComments.t.Helper()
+
+	if mmListComments.inspectFuncListComments != nil {
+		mmListComments.inspectFuncListComments(ctx, reviewUserID, movieID, offset, limit)
+	}
+
+	mm_params := CommentRepositoryMockListCommentsParams{ctx, reviewUserID, movieID, offset, limit}
+
+	// Record call args
+	mmListComments.ListCommentsMock.mutex.Lock()
+	mmListComments.ListCommentsMock.callArgs = append(mmListComments.ListCommentsMock.callArgs, &mm_params)
+	mmListComments.ListCommentsMock.mutex.Unlock()
+
+	for _, e := range mmListComments.ListCommentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ca1, e.results.err
+		}
+	}
+
+	if mmListComments.ListCommentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListComments.ListCommentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListComments.ListCommentsMock.defaultExpectation.params
+		mm_want_ptrs := mmListComments.ListCommentsMock.defaultExpectation.paramPtrs
+
+		mm_got := CommentRepositoryMockListCommentsParams{ctx, reviewUserID, movieID, offset, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListComments.t.Errorf("CommentRepositoryMock.ListComments got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListComments.ListCommentsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.reviewUserID != nil && !minimock.Equal(*mm_want_ptrs.reviewUserID, mm_got.reviewUserID) {
+				mmListComments.t.Errorf("CommentRepositoryMock.ListComments got unexpected parameter reviewUserID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListComments.ListCommentsMock.defaultExpectation.expectationOrigins.originReviewUserID, *mm_want_ptrs.reviewUserID, mm_got.reviewUserID, minimock.Diff(*mm_want_ptrs.reviewUserID, mm_got.reviewUserID))
+			}
+
+			if mm_want_ptrs.movieID != nil && !minimock.Equal(*mm_want_ptrs.movieID, mm_got.movieID) {
+				mmListComments.t.Errorf("CommentRepositoryMock.ListComments got unexpected parameter movieID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListComments.ListCommentsMock.defaultExpectation.expectationOrigins.originMovieID, *mm_want_ptrs.movieID, mm_got.movieID, minimock.Diff(*mm_want_ptrs.movieID, mm_got.movieID))
+			}
+
+			if mm_want_ptrs.offset != nil && !minimock.Equal(*mm_want_ptrs.offset, mm_got.offset) {
+				mmListComments.t.Errorf("CommentRepositoryMock.ListComments got unexpected parameter offset, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListComments.ListCommentsMock.defaultExpectation.expectationOrigins.originOffset, *mm_want_ptrs.offset, mm_got.offset, minimock.Diff(*mm_want_ptrs.offset, mm_got.offset))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListComments.t.Errorf("CommentRepositoryMock.ListComments got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListComments.ListCommentsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListComments.t.Errorf("CommentRepositoryMock.ListComments got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListComments.ListCommentsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListComments.ListCommentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListComments.t.Fatal("No results are set for the CommentRepositoryMock.ListComments")
+		}
+		return (*mm_results).ca1, (*mm_results).err
+	}
+	if mmListComments.funcListComments != nil {
+		return mmListComments.funcListComments(ctx, reviewUserID, movieID, offset, limit)
+	}
+	mmListComments.t.Fatalf("Unexpected call to CommentRepositoryMock.ListComments. %v %v %v %v %v", ctx, reviewUserID, movieID, offset, limit)
+	return
+}
+
+// ListCommentsAfterCounter returns a count of finished CommentRepositoryMock.ListComments invocations
+func (mmListComments *CommentRepositoryMock) ListCommentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListComments.afterListCommentsCounter)
+}
+
+// ListCommentsBeforeCounter returns a count of CommentRepositoryMock.ListComments invocations
+func (mmListComments *CommentRepositoryMock) ListCommentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListComments.beforeListCommentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to CommentRepositoryMock.ListComments.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListComments *mCommentRepositoryMockListComments) Calls() []*CommentRepositoryMockListCommentsParams {
+	mmListComments.mutex.RLock()
+
+	argCopy := make([]*CommentRepositoryMockListCommentsParams, len(mmListComments.callArgs))
+	copy(argCopy, mmListComments.callArgs)
+
+	mmListComments.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListCommentsDone returns true if the count of the ListComments invocations corresponds
+// the number of defined expectations
+func (m *CommentRepositoryMock) MinimockListCommentsDone() bool {
+	if m.ListCommentsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListCommentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListCommentsMock.invocationsDone()
+}
+
+// MinimockListCommentsInspect logs each unmet expectation
+func (m *CommentRepositoryMock) MinimockListCommentsInspect() {
+	for _, e := range m.ListCommentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CommentRepositoryMock.ListComments at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCommentsCounter := mm_atomic.LoadUint64(&m.afterListCommentsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListCommentsMock.defaultExpectation != nil && afterListCommentsCounter < 1 {
+		if m.ListCommentsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CommentRepositoryMock.ListComments at\n%s", m.ListCommentsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CommentRepositoryMock.ListComments at\n%s with params: %#v", m.ListCommentsMock.defaultExpectation.expectationOrigins.origin, *m.ListCommentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListComments != nil && afterListCommentsCounter < 1 {
+		m.t.Errorf("Expected call to CommentRepositoryMock.ListComments at\n%s", m.funcListCommentsOrigin)
+	}
+
+	if !m.ListCommentsMock.invocationsDone() && afterListCommentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to CommentRepositoryMock.ListComments at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListCommentsMock.expectedInvocations), m.ListCommentsMock.expectedInvocationsOrigin, afterListCommentsCounter)
+	}
+}
+
+type mCommentRepositoryMockListCommentsBy struct {
+	optional           bool
+	mock               *CommentRepositoryMock
+	defaultExpectation *CommentRepositoryMockListCommentsByExpectation
+	expectations       []*CommentRepositoryMockListCommentsByExpectation
+
+	callArgs []*CommentRepositoryMockListCommentsByParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CommentRepositoryMockListCommentsByExpectation specifies expectation struct of the CommentRepository.ListCommentsBy
+type CommentRepositoryMockListCommentsByExpectation struct {
+	mock               *CommentRepositoryMock
+	params             *CommentRepositoryMockListCommentsByParams
+	paramPtrs          *CommentRepositoryMockListCommentsByParamPtrs
+	expectationOrigins CommentRepositoryMockListCommentsByExpectationOrigins
+	results            *CommentRepositoryMockListCommentsByResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CommentRepositoryMockListCommentsByParams contains parameters of the CommentRepository.ListCommentsBy
+type CommentRepositoryMockListCommentsByParams struct {
+	ctx      context.Context
+	authorID string
+}
+
+// CommentRepositoryMockListCommentsByParamPtrs contains pointers to parameters of the CommentRepository.ListCommentsBy
+type CommentRepositoryMockListCommentsByParamPtrs struct {
+	ctx      *context.Context
+	authorID *string
+}
+
+// CommentRepositoryMockListCommentsByResults contains results of the CommentRepository.ListCommentsBy
+type CommentRepositoryMockListCommentsByResults struct {
+	ca1 []mm_repository.Comment
+	err error
+}
+
+// CommentRepositoryMockListCommentsByOrigins contains origins of expectations of the CommentRepository.ListCommentsBy
+type CommentRepositoryMockListCommentsByExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originAuthorID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListCommentsBy *mCommentRepositoryMockListCommentsBy) Optional() *mCommentRepositoryMockListCommentsBy {
+	mmListCommentsBy.optional = true
+	return mmListCommentsBy
+}
+
+// Expect sets up expected params for CommentRepository.ListCommentsBy
+func (mmListCommentsBy *mCommentRepositoryMockListCommentsBy) Expect(ctx context.Context, authorID string) *mCommentRepositoryMockListCommentsBy {
+	if mmListCommentsBy.mock.funcListCommentsBy != nil {
+		mmListCommentsBy.mock.t.Fatalf("CommentRepositoryMock.ListCommentsBy mock is already set by Set")
+	}
+
+	if mmListCommentsBy.defaultExpectation == nil {
+		mmListCommentsBy.defaultExpectation = &CommentRepositoryMockListCommentsByExpectation{}
+	}
+
+	if mmListCommentsBy.defaultExpectation.paramPtrs != nil {
+		mmListCommentsBy.mock.t.Fatalf("CommentRepositoryMock.ListCommentsBy mock is already set by ExpectParams functions")
+	}
+
+	mmListCommentsBy.defaultExpectation.params = &CommentRepositoryMockListCommentsByParams{ctx, authorID}
+	mmListCommentsBy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListCommentsBy.expectations {
+		if minimock.Equal(e.params, mmListCommentsBy.defaultExpectation.params) {
+			mmListCommentsBy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListCommentsBy.defaultExpectation.params)
+		}
+	}
+
+	return mmListCommentsBy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CommentRepository.ListCommentsBy
+func (mmListCommentsBy *mCommentRepositoryMockListCommentsBy) ExpectCtxParam1(ctx context.Context) *mCommentRepositoryMockListCommentsBy {
+	if mmListCommentsBy.mock.funcListCommentsBy != nil {
+		mmListCommentsBy.mock.t.Fatalf("CommentRepositoryMock.ListCommentsBy mock is already set by Set")
+	}
+
+	if mmListCommentsBy.defaultExpectation == nil {
+		mmListCommentsBy.defaultExpectation = &CommentRepositoryMockListCommentsByExpectation{}
+	}
+
+	if mmListCommentsBy.defaultExpectation.params != nil {
+		mmListCommentsBy.mock.t.Fatalf("CommentRepositoryMock.ListCommentsBy mock is already set by Expect")
+	}
+
+	if mmListCommentsBy.defaultExpectation.paramPtrs == nil {
+		mmListCommentsBy.defaultExpectation.paramPtrs = &CommentRepositoryMockListCommentsByParamPtrs{}
+	}
+	mmListCommentsBy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListCommentsBy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListCommentsBy
+}
+
+// ExpectAuthorIDParam2 sets up expected param authorID for CommentRepository.ListCommentsBy
+func (mmListCommentsBy *mCommentRepositoryMockListCommentsBy) ExpectAuthorIDParam2(authorID string) *mCommentRepositoryMockListCommentsBy {
+	if mmListCommentsBy.mock.funcListCommentsBy != nil {
+		mmListCommentsBy.mock.t.Fatalf("CommentRepositoryMock.ListCommentsBy mock is already set by Set")
+	}
+
+	if mmListCommentsBy.defaultExpectation == nil {
+		mmListCommentsBy.defaultExpectation = &CommentRepositoryMockListCommentsByExpectation{}
+	}
+
+	if mmListCommentsBy.defaultExpectation.params != nil {
+		mmListCommentsBy.mock.t.Fatalf("CommentRepositoryMock.ListCommentsBy mock is already set by Expect")
+	}
+
+	if mmListCommentsBy.defaultExpectation.paramPtrs == nil {
+		mmListCommentsBy.defaultExpectation.paramPtrs = &CommentRepositoryMockListCommentsByParamPtrs{}
+	}
+	mmListCommentsBy.defaultExpectation.paramPtrs.authorID = &authorID
+	mmListCommentsBy.defaultExpectation.expectationOrigins.originAuthorID = minimock.CallerInfo(1)
+
+	return mmListCommentsBy
+}
+
+// Inspect accepts an inspector function that has same arguments as the CommentRepository.ListCommentsBy
+func (mmListCommentsBy *mCommentRepositoryMockListCommentsBy) Inspect(f func(ctx context.Context, authorID string)) *mCommentRepositoryMockListCommentsBy {
+	if mmListCommentsBy.mock.inspectFuncListCommentsBy != nil {
+		mmListCommentsBy.mock.t.Fatalf("Inspect function is already set for CommentRepositoryMock.ListCommentsBy")
+	}
+
+	mmListCommentsBy.mock.inspectFuncListCommentsBy = f
+
+	return mmListCommentsBy
+}
+
+// Return sets up results that will be returned by CommentRepository.ListCommentsBy
+func (mmListCommentsBy *mCommentRepositoryMockListCommentsBy) Return(ca1 []mm_repository.Comment, err error) *CommentRepositoryMock {
+	if mmListCommentsBy.mock.funcListCommentsBy != nil {
+		mmListCommentsBy.mock.t.Fatalf("CommentRepositoryMock.ListCommentsBy mock is already set by Set")
+	}
+
+	if mmListCommentsBy.defaultExpectation == nil {
+		mmListCommentsBy.defaultExpectation = &CommentRepositoryMockListCommentsByExpectation{mock: mmListCommentsBy.mock}
+	}
+	mmListCommentsBy.defaultExpectation.results = &CommentRepositoryMockListCommentsByResults{ca1, err}
+	mmListCommentsBy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListCommentsBy.mock
+}
+
+// Set uses given function f to mock the CommentRepository.ListCommentsBy method
+func (mmListCommentsBy *mCommentRepositoryMockListCommentsBy) Set(f func(ctx context.Context, authorID string) (ca1 []mm_repository.Comment, err error)) *CommentRepositoryMock {
+	if mmListCommentsBy.defaultExpectation != nil {
+		mmListCommentsBy.mock.t.Fatalf("Default expectation is already set for the CommentRepository.ListCommentsBy method")
+	}
+
+	if len(mmListCommentsBy.expectations) > 0 {
+		mmListCommentsBy.mock.t.Fatalf("Some expectations are already set for the CommentRepository.ListCommentsBy method")
+	}
+
+	mmListCommentsBy.mock.funcListCommentsBy = f
+	mmListCommentsBy.mock.funcListCommentsByOrigin = minimock.CallerInfo(1)
+	return mmListCommentsBy.mock
+}
+
+// When sets expectation for the CommentRepository.ListCommentsBy which will trigger the result defined by the following
+// Then helper
+func (mmListCommentsBy *mCommentRepositoryMockListCommentsBy) When(ctx context.Context, authorID string) *CommentRepositoryMockListCommentsByExpectation {
+	if mmListCommentsBy.mock.funcListCommentsBy != nil {
+		mmListCommentsBy.mock.t.Fatalf("CommentRepositoryMock.ListCommentsBy mock is already set by Set")
+	}
+
+	expectation := &CommentRepositoryMockListCommentsByExpectation{
+		mock:               mmListCommentsBy.mock,
+		params:             &CommentRepositoryMockListCommentsByParams{ctx, authorID},
+		expectationOrigins: CommentRepositoryMockListCommentsByExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListCommentsBy.expectations = append(mmListCommentsBy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CommentRepository.ListCommentsBy return parameters for the expectation previously defined by the When method
+func (e *CommentRepositoryMockListCommentsByExpectation) Then(ca1 []mm_repository.Comment, err error) *CommentRepositoryMock {
+	e.results = &CommentRepositoryMockListCommentsByResults{ca1, err}
+	return e.mock
+}
+
+// Times sets number of times CommentRepository.ListCommentsBy should be invoked
+func (mmListCommentsBy *mCommentRepositoryMockListCommentsBy) Times(n uint64) *mCommentRepositoryMockListCommentsBy {
+	if n == 0 {
+		mmListCommentsBy.mock.t.Fatalf("Times of CommentRepositoryMock.ListCommentsBy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListCommentsBy.expectedInvocations, n)
+	mmListCommentsBy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListCommentsBy
+}
+
+func (mmListCommentsBy *mCommentRepositoryMockListCommentsBy) invocationsDone() bool {
+	if len(mmListCommentsBy.expectations) == 0 && mmListCommentsBy.defaultExpectation == nil && mmListCommentsBy.mock.funcListCommentsBy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListCommentsBy.mock.afterListCommentsByCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListCommentsBy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListCommentsBy implements mm_repository.CommentRepository
+func (mmListCommentsBy *CommentRepositoryMock) ListCommentsBy(ctx context.Context, authorID string) (ca1 []mm_repository.Comment, err error) {
+	mm_atomic.AddUint64(&mmListCommentsBy.beforeListCommentsByCounter, 1)
+	defer mm_atomic.AddUint64(&mmListCommentsBy.afterListCommentsByCounter, 1)
+
+	mmListCommentsBy.t.Helper()
+
+	if mmListCommentsBy.inspectFuncListCommentsBy != nil {
+		mmListCommentsBy.inspectFuncListCommentsBy(ctx, authorID)
+	}
+
+	mm_params := CommentRepositoryMockListCommentsByParams{ctx, authorID}
+
+	// Record call args
+	mmListCommentsBy.ListCommentsByMock.mutex.Lock()
+	mmListCommentsBy.ListCommentsByMock.callArgs = append(mmListCommentsBy.ListCommentsByMock.callArgs, &mm_params)
+	mmListCommentsBy.ListCommentsByMock.mutex.Unlock()
+
+	for _, e := range mmListCommentsBy.ListCommentsByMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ca1, e.results.err
+		}
+	}
+
+	if mmListCommentsBy.ListCommentsByMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListCommentsBy.ListCommentsByMock.defaultExpectation.Counter, 1)
+		mm_want := mmListCommentsBy.ListCommentsByMock.defaultExpectation.params
+		mm_want_ptrs := mmListCommentsBy.ListCommentsByMock.defaultExpectation.paramPtrs
+
+		mm_got := CommentRepositoryMockListCommentsByParams{ctx, authorID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListCommentsBy.t.Errorf("CommentRepositoryMock.ListCommentsBy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListCommentsBy.ListCommentsByMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.authorID != nil && !minimock.Equal(*mm_want_ptrs.authorID, mm_got.authorID) {
+				mmListCommentsBy.t.Errorf("CommentRepositoryMock.ListCommentsBy got unexpected parameter authorID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListCommentsBy.ListCommentsByMock.defaultExpectation.expectationOrigins.originAuthorID, *mm_want_ptrs.authorID, mm_got.authorID, minimock.Diff(*mm_want_ptrs.authorID, mm_got.authorID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListCommentsBy.t.Errorf("CommentRepositoryMock.ListCommentsBy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListCommentsBy.ListCommentsByMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListCommentsBy.ListCommentsByMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListCommentsBy.t.Fatal("No results are set for the CommentRepositoryMock.ListCommentsBy")
+		}
+		return (*mm_results).ca1, (*mm_results).err
+	}
+	if mmListCommentsBy.funcListCommentsBy != nil {
+		return mmListCommentsBy.funcListCommentsBy(ctx, authorID)
+	}
+	mmListCommentsBy.t.Fatalf("Unexpected call to CommentRepositoryMock.ListCommentsBy. %v %v", ctx, authorID)
+	return
+}
+
+// ListCommentsByAfterCounter returns a count of finished CommentRepositoryMock.ListCommentsBy invocations
+func (mmListCommentsBy *CommentRepositoryMock) ListCommentsByAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListCommentsBy.afterListCommentsByCounter)
+}
+
+// ListCommentsByBeforeCounter returns a count of CommentRepositoryMock.ListCommentsBy invocations
+func (mmListCommentsBy *CommentRepositoryMock) ListCommentsByBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListCommentsBy.beforeListCommentsByCounter)
+}
+
+// Calls returns a list of arguments used in each call to CommentRepositoryMock.ListCommentsBy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListCommentsBy *mCommentRepositoryMockListCommentsBy) Calls() []*CommentRepositoryMockListCommentsByParams {
+	mmListCommentsBy.mutex.RLock()
+
+	argCopy := make([]*CommentRepositoryMockListCommentsByParams, len(mmListCommentsBy.callArgs))
+	copy(argCopy, mmListCommentsBy.callArgs)
+
+	mmListCommentsBy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListCommentsByDone returns true if the count of the ListCommentsBy invocations corresponds
+// the number of defined expectations
+func (m *CommentRepositoryMock) MinimockListCommentsByDone() bool {
+	if m.ListCommentsByMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListCommentsByMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListCommentsByMock.invocationsDone()
+}
+
+// MinimockListCommentsByInspect logs each unmet expectation
+func (m *CommentRepositoryMock) MinimockListCommentsByInspect() {
+	for _, e := range m.ListCommentsByMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CommentRepositoryMock.ListCommentsBy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCommentsByCounter := mm_atomic.LoadUint64(&m.afterListCommentsByCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListCommentsByMock.defaultExpectation != nil && afterListCommentsByCounter < 1 {
+		if m.ListCommentsByMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CommentRepositoryMock.ListCommentsBy at\n%s", m.ListCommentsByMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CommentRepositoryMock.ListCommentsBy at\n%s with params: %#v", m.ListCommentsByMock.defaultExpectation.expectationOrigins.origin, *m.ListCommentsByMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListCommentsBy != nil && afterListCommentsByCounter < 1 {
+		m.t.Errorf("Expected call to CommentRepositoryMock.ListCommentsBy at\n%s", m.funcListCommentsByOrigin)
+	}
+
+	if !m.ListCommentsByMock.invocationsDone() && afterListCommentsByCounter > 0 {
+		m.t.Errorf("Expected %d calls to CommentRepositoryMock.ListCommentsBy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListCommentsByMock.expectedInvocations), m.ListCommentsByMock.expectedInvocationsOrigin, afterListCommentsByCounter)
+	}
+}
+
+type mCommentRepositoryMockListReplies struct {
+	optional           bool
+	mock               *CommentRepositoryMock
+	defaultExpectation *CommentRepositoryMockListRepliesExpectation
+	expectations       []*CommentRepositoryMockListRepliesExpectation
+
+	callArgs []*CommentRepositoryMockListRepliesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CommentRepositoryMockListRepliesExpectation specifies expectation struct of the CommentRepository.ListReplies
+type CommentRepositoryMockListRepliesExpectation struct {
+	mock               *CommentRepositoryMock
+	params             *CommentRepositoryMockListRepliesParams
+	paramPtrs          *CommentRepositoryMockListRepliesParamPtrs
+	expectationOrigins CommentRepositoryMockListRepliesExpectationOrigins
+	results            *CommentRepositoryMockListRepliesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CommentRepositoryMockListRepliesParams contains parameters of the CommentRepository.ListReplies
+type CommentRepositoryMockListRepliesParams struct {
+	ctx       context.Context
+	parentIDs []string
+}
+
+// CommentRepositoryMockListRepliesParamPtrs contains pointers to parameters of the CommentRepository.ListReplies
+type CommentRepositoryMockListRepliesParamPtrs struct {
+	ctx       *context.Context
+	parentIDs *[]string
+}
+
+// CommentRepositoryMockListRepliesResults contains results of the CommentRepository.ListReplies
+type CommentRepositoryMockListRepliesResults struct {
+	ca1 []mm_repository.Comment
+	err error
+}
+
+// CommentRepositoryMockListRepliesOrigins contains origins of expectations of the CommentRepository.ListReplies
+type CommentRepositoryMockListRepliesExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originParentIDs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListReplies *mCommentRepositoryMockListReplies) Optional() *mCommentRepositoryMockListReplies {
+	mmListReplies.optional = true
+	return mmListReplies
+}
+
+// Expect sets up expected params for CommentRepository.ListReplies
+func (mmListReplies *mCommentRepositoryMockListReplies) Expect(ctx context.Context, parentIDs []string) *mCommentRepositoryMockListReplies {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("CommentRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	if mmListReplies.defaultExpectation == nil {
+		mmListReplies.defaultExpectation = &CommentRepositoryMockListRepliesExpectation{}
+	}
+
+	if mmListReplies.defaultExpectation.paramPtrs != nil {
+		mmListReplies.mock.t.Fatalf("CommentRepositoryMock.ListReplies mock is already set by ExpectParams functions")
+	}
+
+	mmListReplies.defaultExpectation.params = &CommentRepositoryMockListRepliesParams{ctx, parentIDs}
+	mmListReplies.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListReplies.expectations {
+		if minimock.Equal(e.params, mmListReplies.defaultExpectation.params) {
+			mmListReplies.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListReplies.defaultExpectation.params)
+		}
+	}
+
+	return mmListReplies
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CommentRepository.ListReplies
+func (mmListReplies *mCommentRepositoryMockListReplies) ExpectCtxParam1(ctx context.Context) *mCommentRepositoryMockListReplies {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("CommentRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	if mmListReplies.defaultExpectation == nil {
+		mmListReplies.defaultExpectation = &CommentRepositoryMockListRepliesExpectation{}
+	}
+
+	if mmListReplies.defaultExpectation.params != nil {
+		mmListReplies.mock.t.Fatalf("CommentRepositoryMock.ListReplies mock is already set by Expect")
+	}
+
+	if mmListReplies.defaultExpectation.paramPtrs == nil {
+		mmListReplies.defaultExpectation.paramPtrs = &CommentRepositoryMockListRepliesParamPtrs{}
+	}
+	mmListReplies.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListReplies.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListReplies
+}
+
+// ExpectParentIDsParam2 sets up expected param parentIDs for CommentRepository.ListReplies
+func (mmListReplies *mCommentRepositoryMockListReplies) ExpectParentIDsParam2(parentIDs []string) *mCommentRepositoryMockListReplies {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("CommentRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	if mmListReplies.defaultExpectation == nil {
+		mmListReplies.defaultExpectation = &CommentRepositoryMockListRepliesExpectation{}
+	}
+
+	if mmListReplies.defaultExpectation.params != nil {
+		mmListReplies.mock.t.Fatalf("CommentRepositoryMock.ListReplies mock is already set by Expect")
+	}
+
+	if mmListReplies.defaultExpectation.paramPtrs == nil {
+		mmListReplies.defaultExpectation.paramPtrs = &CommentRepositoryMockListRepliesParamPtrs{}
+	}
+	mmListReplies.defaultExpectation.paramPtrs.parentIDs = &parentIDs
+	mmListReplies.defaultExpectation.expectationOrigins.originParentIDs = minimock.CallerInfo(1)
+
+	return mmListReplies
+}
+
+// Inspect accepts an inspector function that has same arguments as the CommentRepository.ListReplies
+func (mmListReplies *mCommentRepositoryMockListReplies) Inspect(f func(ctx context.Context, parentIDs []string)) *mCommentRepositoryMockListReplies {
+	if mmListReplies.mock.inspectFuncListReplies != nil {
+		mmListReplies.mock.t.Fatalf("Inspect function is already set for CommentRepositoryMock.ListReplies")
+	}
+
+	mmListReplies.mock.inspectFuncListReplies = f
+
+	return mmListReplies
+}
+
+// Return sets up results that will be returned by CommentRepository.ListReplies
+func (mmListReplies *mCommentRepositoryMockListReplies) Return(ca1 []mm_repository.Comment, err error) *CommentRepositoryMock {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("CommentRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	if mmListReplies.defaultExpectation == nil {
+		mmListReplies.defaultExpectation = &CommentRepositoryMockListRepliesExpectation{mock: mmListReplies.mock}
+	}
+	mmListReplies.defaultExpectation.results = &CommentRepositoryMockListRepliesResults{ca1, err}
+	mmListReplies.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListReplies.mock
+}
+
+// Set uses given function f to mock the CommentRepository.ListReplies method
+func (mmListReplies *mCommentRepositoryMockListReplies) Set(f func(ctx context.Context, parentIDs []string) (ca1 []mm_repository.Comment, err error)) *CommentRepositoryMock {
+	if mmListReplies.defaultExpectation != nil {
+		mmListReplies.mock.t.Fatalf("Default expectation is already set for the CommentRepository.ListReplies method")
+	}
+
+	if len(mmListReplies.expectations) > 0 {
+		mmListReplies.mock.t.Fatalf("Some expectations are already set for the CommentRepository.ListReplies method")
+	}
+
+	mmListReplies.mock.funcListReplies = f
+	mmListReplies.mock.funcListRepliesOrigin = minimock.CallerInfo(1)
+	return mmListReplies.mock
+}
+
+// When sets expectation for the CommentRepository.ListReplies which will trigger the result defined by the following
+// Then helper
+func (mmListReplies *mCommentRepositoryMockListReplies) When(ctx context.Context, parentIDs []string) *CommentRepositoryMockListRepliesExpectation {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("CommentRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	expectation := &CommentRepositoryMockListRepliesExpectation{
+		mock:               mmListReplies.mock,
+		params:             &CommentRepositoryMockListRepliesParams{ctx, parentIDs},
+		expectationOrigins: CommentRepositoryMockListRepliesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListReplies.expectations = append(mmListReplies.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CommentRepository.ListReplies return parameters for the expectation previously defined by the When method
+func (e *CommentRepositoryMockListRepliesExpectation) Then(ca1 []mm_repository.Comment, err error) *CommentRepositoryMock {
+	e.results = &CommentRepositoryMockListRepliesResults{ca1, err}
+	return e.mock
+}
+
+// Times sets number of times CommentRepository.ListReplies should be invoked
+func (mmListReplies *mCommentRepositoryMockListReplies) Times(n uint64) *mCommentRepositoryMockListReplies {
+	if n == 0 {
+		mmListReplies.mock.t.Fatalf("Times of CommentRepositoryMock.ListReplies mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListReplies.expectedInvocations, n)
+	mmListReplies.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListReplies
+}
+
+func (mmListReplies *mCommentRepositoryMockListReplies) invocationsDone() bool {
+	if len(mmListReplies.expectations) == 0 && mmListReplies.defaultExpectation == nil && mmListReplies.mock.funcListReplies == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListReplies.mock.afterListRepliesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListReplies.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListReplies implements mm_repository.CommentRepository
+func (mmListReplies *CommentRepositoryMock) ListReplies(ctx context.Context, parentIDs []string) (ca1 []mm_repository.Comment, err error) {
+	mm_atomic.AddUint64(&mmListReplies.beforeListRepliesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListReplies.afterListRepliesCounter, 1)
+
+	mmListReplies.t.Helper()
+
+	if mmListReplies.inspectFuncListReplies != nil {
+		mmListReplies.inspectFuncListReplies(ctx, parentIDs)
+	}
+
+	mm_params := CommentRepositoryMockListRepliesParams{ctx, parentIDs}
+
+	// Record call args
+	mmListReplies.ListRepliesMock.mutex.Lock()
+	mmListReplies.ListRepliesMock.callArgs = append(mmListReplies.ListRepliesMock.callArgs, &mm_params)
+	mmListReplies.ListRepliesMock.mutex.Unlock()
+
+	for _, e := range mmListReplies.ListRepliesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ca1, e.results.err
+		}
+	}
+
+	if mmListReplies.ListRepliesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListReplies.ListRepliesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListReplies.ListRepliesMock.defaultExpectation.params
+		mm_want_ptrs := mmListReplies.ListRepliesMock.defaultExpectation.paramPtrs
+
+		mm_got := CommentRepositoryMockListRepliesParams{ctx, parentIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListReplies.t.Errorf("CommentRepositoryMock.ListReplies got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReplies.ListRepliesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.parentIDs != nil && !minimock.Equal(*mm_want_ptrs.parentIDs, mm_got.parentIDs) {
+				mmListReplies.t.Errorf("CommentRepositoryMock.ListReplies got unexpected parameter parentIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReplies.ListRepliesMock.defaultExpectation.expectationOrigins.originParentIDs, *mm_want_ptrs.parentIDs, mm_got.parentIDs, minimock.Diff(*mm_want_ptrs.parentIDs, mm_got.parentIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListReplies.t.Errorf("CommentRepositoryMock.ListReplies got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListReplies.ListRepliesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListReplies.ListRepliesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListReplies.t.Fatal("No results are set for the CommentRepositoryMock.ListReplies")
+		}
+		return (*mm_results).ca1, (*mm_results).err
+	}
+	if mmListReplies.funcListReplies != nil {
+		return mmListReplies.funcListReplies(ctx, parentIDs)
+	}
+	mmListReplies.t.Fatalf("Unexpected call to CommentRepositoryMock.ListReplies. %v %v", ctx, parentIDs)
+	return
+}
+
+// ListRepliesAfterCounter returns a count of finished CommentRepositoryMock.ListReplies invocations
+func (mmListReplies *CommentRepositoryMock) ListRepliesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListReplies.afterListRepliesCounter)
+}
+
+// ListRepliesBeforeCounter returns a count of CommentRepositoryMock.ListReplies invocations
+func (mmListReplies *CommentRepositoryMock) ListRepliesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListReplies.beforeListRepliesCounter)
+}
+
+// Calls returns a list of arguments used in each call to CommentRepositoryMock.ListReplies.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListReplies *mCommentRepositoryMockListReplies) Calls() []*CommentRepositoryMockListRepliesParams {
+	mmListReplies.mutex.RLock()
+
+	argCopy := make([]*CommentRepositoryMockListRepliesParams, len(mmListReplies.callArgs))
+	copy(argCopy, mmListReplies.callArgs)
+
+	mmListReplies.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListRepliesDone returns true if the count of the ListReplies invocations corresponds
+// the number of defined expectations
+func (m *CommentRepositoryMock) MinimockListRepliesDone() bool {
+	if m.ListRepliesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListRepliesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListRepliesMock.invocationsDone()
+}
+
+// MinimockListRepliesInspect logs each unmet expectation
+func (m *CommentRepositoryMock) MinimockListRepliesInspect() {
+	for _, e := range m.ListRepliesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CommentRepositoryMock.ListReplies at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListRepliesCounter := mm_atomic.LoadUint64(&m.afterListRepliesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListRepliesMock.defaultExpectation != nil && afterListRepliesCounter < 1 {
+		if m.ListRepliesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CommentRepositoryMock.ListReplies at\n%s", m.ListRepliesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CommentRepositoryMock.ListReplies at\n%s with params: %#v", m.ListRepliesMock.defaultExpectation.expectationOrigins.origin, *m.ListRepliesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListReplies != nil && afterListRepliesCounter < 1 {
+		m.t.Errorf("Expected call to CommentRepositoryMock.ListReplies at\n%s", m.funcListRepliesOrigin)
+	}
+
+	if !m.ListRepliesMock.invocationsDone() && afterListRepliesCounter > 0 {
+		m.t.Errorf("Expected %d calls to CommentRepositoryMock.ListReplies at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListRepliesMock.expectedInvocations), m.ListRepliesMock.expectedInvocationsOrigin, afterListRepliesCounter)
+	}
+}
+
+type mCommentRepositoryMockUpdateComment struct {
+	optional           bool
+	mock               *CommentRepositoryMock
+	defaultExpectation *CommentRepositoryMockUpdateCommentExpectation
+	expectations       []*CommentRepositoryMockUpdateCommentExpectation
+
+	callArgs []*CommentRepositoryMockUpdateCommentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CommentRepositoryMockUpdateCommentExpectation specifies expectation struct of the CommentRepository.UpdateComment
+type CommentRepositoryMockUpdateCommentExpectation struct {
+	mock               *CommentRepositoryMock
+	params             *CommentRepositoryMockUpdateCommentParams
+	paramPtrs          *CommentRepositoryMockUpdateCommentParamPtrs
+	expectationOrigins CommentRepositoryMockUpdateCommentExpectationOrigins
+	results            *CommentRepositoryMockUpdateCommentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CommentRepositoryMockUpdateCommentParams contains parameters of the CommentRepository.UpdateComment
+type CommentRepositoryMockUpdateCommentParams struct {
+	ctx       context.Context
+	ID        string
+	text      string
+	updatedAt time.Time
+}
+
+// CommentRepositoryMockUpdateCommentParamPtrs contains pointers to parameters of the CommentRepository.UpdateComment
+type CommentRepositoryMockUpdateCommentParamPtrs struct {
+	ctx       *context.Context
+	ID        *string
+	text      *string
+	updatedAt *time.Time
+}
+
+// CommentRepositoryMockUpdateCommentResults contains results of the CommentRepository.UpdateComment
+type CommentRepositoryMockUpdateCommentResults struct {
+	c2  mm_repository.Comment
+	err error
+}
+
+// CommentRepositoryMockUpdateCommentOrigins contains origins of expectations of the CommentRepository.UpdateComment
+type CommentRepositoryMockUpdateCommentExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originID        string
+	originText      string
+	originUpdatedAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateComment *mCommentRepositoryMockUpdateComment) Optional() *mCommentRepositoryMockUpdateComment {
+	mmUpdateComment.optional = true
+	return mmUpdateComment
+}
+
+// Expect sets up expected params for CommentRepository.UpdateComment
+func (mmUpdateComment *mCommentRepositoryMockUpdateComment) Expect(ctx context.Context, ID string, text string, updatedAt time.Time) *mCommentRepositoryMockUpdateComment {
+	if mmUpdateComment.mock.funcUpdateComment != nil {
+		mmUpdateComment.mock.t.Fatalf("CommentRepositoryMock.UpdateComment mock is already set by Set")
+	}
+
+	if mmUpdateComment.defaultExpectation == nil {
+		mmUpdateComment.defaultExpectation = &CommentRepositoryMockUpdateCommentExpectation{}
+	}
+
+	if mmUpdateComment.defaultExpectation.paramPtrs != nil {
+		mmUpdateComment.mock.t.Fatalf("CommentRepositoryMock.UpdateComment mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateComment.defaultExpectation.params = &CommentRepositoryMockUpdateCommentParams{ctx, ID, text, updatedAt}
+	mmUpdateComment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateComment.expectations {
+		if minimock.Equal(e.params, mmUpdateComment.defaultExpectation.params) {
+			mmUpdateComment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateComment.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateComment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CommentRepository.UpdateComment
+func (mmUpdateComment *mCommentRepositoryMockUpdateComment) ExpectCtxParam1(ctx context.Context) *mCommentRepositoryMockUpdateComment {
+	if mmUpdateComment.mock.funcUpdateComment != nil {
+		mmUpdateComment.mock.t.Fatalf("CommentRepositoryMock.UpdateComment mock is already set by Set")
+	}
+
+	if mmUpdateComment.defaultExpectation == nil {
+		mmUpdateComment.defaultExpectation = &CommentRepositoryMockUpdateCommentExpectation{}
+	}
+
+	if mmUpdateComment.defaultExpectation.params != nil {
+		mmUpdateComment.mock.t.Fatalf("CommentRepositoryMock.UpdateComment mock is already set by Expect")
+	}
+
+	if mmUpdateComment.defaultExpectation.paramPtrs == nil {
+		mmUpdateComment.defaultExpectation.paramPtrs = &CommentRepositoryMockUpdateCommentParamPtrs{}
+	}
+	mmUpdateComment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateComment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateComment
+}
+
+// ExpectIDParam2 sets up expected param ID for CommentRepository.UpdateComment
+func (mmUpdateComment *mCommentRepositoryMockUpdateComment) ExpectIDParam2(ID string) *mCommentRepositoryMockUpdateComment {
+	if mmUpdateComment.mock.funcUpdateComment != nil {
+		mmUpdateComment.mock.t.Fatalf("CommentRepositoryMock.UpdateComment mock is already set by Set")
+	}
+
+	if mmUpdateComment.defaultExpectation == nil {
+		mmUpdateComment.defaultExpectation = &CommentRepositoryMockUpdateCommentExpectation{}
+	}
+
+	if mmUpdateComment.defaultExpectation.params != nil {
+		mmUpdateComment.mock.t.Fatalf("CommentRepositoryMock.UpdateComment mock is already set by Expect")
+	}
+
+	if mmUpdateComment.defaultExpectation.paramPtrs == nil {
+		mmUpdateComment.defaultExpectation.paramPtrs = &CommentRepositoryMockUpdateCommentParamPtrs{}
+	}
+	mmUpdateComment.defaultExpectation.paramPtrs.ID = &ID
+	mmUpdateComment.defaultExpectation.expectationOrigins.originID = minimock.CallerInfo(1)
+
+	return mmUpdateComment
+}
+
+// ExpectTextParam3 sets up expected param text for CommentRepository.UpdateComment
+func (mmUpdateComment *mCommentRepositoryMockUpdateComment) ExpectTextParam3(text string) *mCommentRepositoryMockUpdateComment {
+	if mmUpdateComment.mock.funcUpdateComment != nil {
+		mmUpdateComment.mock.t.Fatalf("CommentRepositoryMock.UpdateComment mock is already set by Set")
+	}
+
+	if mmUpdateComment.defaultExpectation == nil {
+		mmUpdateComment.defaultExpectation = &CommentRepositoryMockUpdateCommentExpectation{}
+	}
+
+	if mmUpdateComment.defaultExpectation.params != nil {
+		mmUpdateComment.mock.t.Fatalf("CommentRepositoryMock.UpdateComment mock is already set by Expect")
+	}
+
+	if mmUpdateComment.defaultExpectation.paramPtrs == nil {
+		mmUpdateComment.defaultExpectation.paramPtrs = &CommentRepositoryMockUpdateCommentParamPtrs{}
+	}
+	mmUpdateComment.defaultExpectation.paramPtrs.text = &text
+	mmUpdateComment.defaultExpectation.expectationOrigins.originText = minimock.CallerInfo(1)
+
+	return mmUpdateComment
+}
+
+// ExpectUpdatedAtParam4 sets up expected param updatedAt for CommentRepository.UpdateComment
+func (mmUpdateComment *mCommentRepositoryMockUpdateComment) ExpectUpdatedAtParam4(updatedAt time.Time) *mCommentRepositoryMockUpdateComment {
+	if mmUpdateComment.mock.funcUpdateComment != nil {
+		mmUpdateComment.mock.t.Fatalf("CommentRepositoryMock.UpdateComment mock is already set by Set")
+	}
+
+	if mmUpdateComment.defaultExpectation == nil {
+		mmUpdateComment.defaultExpectation = &CommentRepositoryMockUpdateCommentExpectation{}
+	}
+
+	if mmUpdateComment.defaultExpectation.params != nil {
+		mmUpdateComment.mock.t.Fatalf("CommentRepositoryMock.UpdateComment mock is already set by Expect")
+	}
+
+	if mmUpdateComment.defaultExpectation.paramPtrs == nil {
+		mmUpdateComment.defaultExpectation.paramPtrs = &CommentRepositoryMockUpdateCommentParamPtrs{}
+	}
+	mmUpdateComment.defaultExpectation.paramPtrs.updatedAt = &updatedAt
+	mmUpdateComment.defaultExpectation.expectationOrigins.originUpdatedAt = minimock.CallerInfo(1)
+
+	return mmUpdateComment
+}
+
+// Inspect accepts an inspector function that has same arguments as the CommentRepository.UpdateComment
+func (mmUpdateComment *mCommentRepositoryMockUpdateComment) Inspect(f func(ctx context.Context, ID string, text string, updatedAt time.Time)) *mCommentRepositoryMockUpdateComment {
+	if mmUpdateComment.mock.inspectFuncUpdateComment != nil {
+		mmUpdateComment.mock.t.Fatalf("Inspect function is already set for CommentRepositoryMock.UpdateComment")
+	}
+
+	mmUpdateComment.mock.inspectFuncUpdateComment = f
+
+	return mmUpdateComment
+}
+
+// Return sets up results that will be returned by CommentRepository.UpdateComment
+func (mmUpdateComment *mCommentRepositoryMockUpdateComment) Return(c2 mm_repository.Comment, err error) *CommentRepositoryMock {
+	if mmUpdateComment.mock.funcUpdateComment != nil {
+		mmUpdateComment.mock.t.Fatalf("CommentRepositoryMock.UpdateComment mock is already set by Set")
+	}
+
+	if mmUpdateComment.defaultExpectation == nil {
+		mmUpdateComment.defaultExpectation = &CommentRepositoryMockUpdateCommentExpectation{mock: mmUpdateComment.mock}
+	}
+	mmUpdateComment.defaultExpectation.results = &CommentRepositoryMockUpdateCommentResults{c2, err}
+	mmUpdateComment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateComment.mock
+}
+
+// Set uses given function f to mock the CommentRepository.UpdateComment method
+func (mmUpdateComment *mCommentRepositoryMockUpdateComment) Set(f func(ctx context.Context, ID string, text string, updatedAt time.Time) (c2 mm_repository.Comment, err error)) *CommentRepositoryMock {
+	if mmUpdateComment.defaultExpectation != nil {
+		mmUpdateComment.mock.t.Fatalf("Default expectation is already set for the CommentRepository.UpdateComment method")
+	}
+
+	if len(mmUpdateComment.expectations) > 0 {
+		mmUpdateComment.mock.t.Fatalf("Some expectations are already set for the CommentRepository.UpdateComment method")
+	}
+
+	mmUpdateComment.mock.funcUpdateComment = f
+	mmUpdateComment.mock.funcUpdateCommentOrigin = minimock.CallerInfo(1)
+	return mmUpdateComment.mock
+}
+
+// When sets expectation for the CommentRepository.UpdateComment which will trigger the result defined by the following
+// Then helper
+func (mmUpdateComment *mCommentRepositoryMockUpdateComment) When(ctx context.Context, ID string, text string, updatedAt time.Time) *CommentRepositoryMockUpdateCommentExpectation {
+	if mmUpdateComment.mock.funcUpdateComment != nil {
+		mmUpdateComment.mock.t.Fatalf("CommentRepositoryMock.UpdateComment mock is already set by Set")
+	}
+
+	expectation := &CommentRepositoryMockUpdateCommentExpectation{
+		mock:               mmUpdateComment.mock,
+		params:             &CommentRepositoryMockUpdateCommentParams{ctx, ID, text, updatedAt},
+		expectationOrigins: CommentRepositoryMockUpdateCommentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateComment.expectations = append(mmUpdateComment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CommentRepository.UpdateComment return parameters for the expectation previously defined by the When method
+func (e *CommentRepositoryMockUpdateCommentExpectation) Then(c2 mm_repository.Comment, err error) *CommentRepositoryMock {
+	e.results = &CommentRepositoryMockUpdateCommentResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times CommentRepository.UpdateComment should be invoked
+func (mmUpdateComment *mCommentRepositoryMockUpdateComment) Times(n uint64) *mCommentRepositoryMockUpdateComment {
+	if n == 0 {
+		mmUpdateComment.mock.t.Fatalf("Times of CommentRepositoryMock.UpdateComment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateComment.expectedInvocations, n)
+	mmUpdateComment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateComment
+}
+
+func (mmUpdateComment *mCommentRepositoryMockUpdateComment) invocationsDone() bool {
+	if len(mmUpdateComment.expectations) == 0 && mmUpdateComment.defaultExpectation == nil && mmUpdateComment.mock.funcUpdateComment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateComment.mock.afterUpdateCommentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateComment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateComment implements mm_repository.CommentRepository
+func (mmUpdateComment *CommentRepositoryMock) UpdateComment(ctx context.Context, ID string, text string, updatedAt time.Time) (c2 mm_repository.Comment, err error) {
+	mm_atomic.AddUint64(&mmUpdateComment.beforeUpdateCommentCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateComment.afterUpdateCommentCounter, 1)
+
+	mmUpdateComment.t.Helper()
+
+	if mmUpdateComment.inspectFuncUpdateComment != nil {
+		mmUpdateComment.inspectFuncUpdateComment(ctx, ID, text, updatedAt)
+	}
+
+	mm_params := CommentRepositoryMockUpdateCommentParams{ctx, ID, text, updatedAt}
+
+	// Record call args
+	mmUpdateComment.UpdateCommentMock.mutex.Lock()
+	mmUpdateComment.UpdateCommentMock.callArgs = append(mmUpdateComment.UpdateCommentMock.callArgs, &mm_params)
+	mmUpdateComment.UpdateCommentMock.mutex.Unlock()
+
+	for _, e := range mmUpdateComment.UpdateCommentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmUpdateComment.UpdateCommentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateComment.UpdateCommentMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateComment.UpdateCommentMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateComment.UpdateCommentMock.defaultExpectation.paramPtrs
+
+		mm_got := CommentRepositoryMockUpdateCommentParams{ctx, ID, text, updatedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateComment.t.Errorf("CommentRepositoryMock.UpdateComment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateComment.UpdateCommentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ID != nil && !minimock.Equal(*mm_want_ptrs.ID, mm_got.ID) {
+				mmUpdateComment.t.Errorf("CommentRepositoryMock.UpdateComment got unexpected parameter ID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateComment.UpdateCommentMock.defaultExpectation.expectationOrigins.originID, *mm_want_ptrs.ID, mm_got.ID, minimock.Diff(*mm_want_ptrs.ID, mm_got.ID))
+			}
+
+			if mm_want_ptrs.text != nil && !minimock.Equal(*mm_want_ptrs.text, mm_got.text) {
+				mmUpdateComment.t.Errorf("CommentRepositoryMock.UpdateComment got unexpected parameter text, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateComment.UpdateCommentMock.defaultExpectation.expectationOrigins.originText, *mm_want_ptrs.text, mm_got.text, minimock.Diff(*mm_want_ptrs.text, mm_got.text))
+			}
+
+			if mm_want_ptrs.updatedAt != nil && !minimock.Equal(*mm_want_ptrs.updatedAt, mm_got.updatedAt) {
+				mmUpdateComment.t.Errorf("CommentRepositoryMock.UpdateComment got unexpected parameter updatedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateComment.UpdateCommentMock.defaultExpectation.expectationOrigins.originUpdatedAt, *mm_want_ptrs.updatedAt, mm_got.updatedAt, minimock.Diff(*mm_want_ptrs.updatedAt, mm_got.updatedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateComment.t.Errorf("CommentRepositoryMock.UpdateComment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateComment.UpdateCommentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateComment.UpdateCommentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateComment.t.Fatal("No results are set for the CommentRepositoryMock.UpdateComment")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmUpdateComment.funcUpdateComment != nil {
+		return mmUpdateComment.funcUpdateComment(ctx, ID, text, updatedAt)
+	}
+	mmUpdateComment.t.Fatalf("Unexpected call to CommentRepositoryMock.UpdateComment. %v %v %v %v", ctx, ID, text, updatedAt)
+	return
+}
+
+// UpdateCommentAfterCounter returns a count of finished CommentRepositoryMock.UpdateComment invocations
+func (mmUpdateComment *CommentRepositoryMock) UpdateCommentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateComment.afterUpdateCommentCounter)
+}
+
+// UpdateCommentBeforeCounter returns a count of CommentRepositoryMock.UpdateComment invocations
+func (mmUpdateComment *CommentRepositoryMock) UpdateCommentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateComment.beforeUpdateCommentCounter)
+}
+
+// Calls returns a list of arguments used in each call to CommentRepositoryMock.UpdateComment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateComment *mCommentRepositoryMockUpdateComment) Calls() []*CommentRepositoryMockUpdateCommentParams {
+	mmUpdateComment.mutex.RLock()
+
+	argCopy := make([]*CommentRepositoryMockUpdateCommentParams, len(mmUpdateComment.callArgs))
+	copy(argCopy, mmUpdateComment.callArgs)
+
+	mmUpdateComment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateCommentDone returns true if the count of the UpdateComment invocations corresponds
+// the number of defined expectations
+func (m *CommentRepositoryMock) MinimockUpdateCommentDone() bool {
+	if m.UpdateCommentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateCommentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateCommentMock.invocationsDone()
+}
+
+// MinimockUpdateCommentInspect logs each unmet expectation
+func (m *CommentRepositoryMock) MinimockUpdateCommentInspect() {
+	for _, e := range m.UpdateCommentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CommentRepositoryMock.UpdateComment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateCommentCounter := mm_atomic.LoadUint64(&m.afterUpdateCommentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateCommentMock.defaultExpectation != nil && afterUpdateCommentCounter < 1 {
+		if m.UpdateCommentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CommentRepositoryMock.UpdateComment at\n%s", m.UpdateCommentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CommentRepositoryMock.UpdateComment at\n%s with params: %#v", m.UpdateCommentMock.defaultExpectation.expectationOrigins.origin, *m.UpdateCommentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateComment != nil && afterUpdateCommentCounter < 1 {
+		m.t.Errorf("Expected call to CommentRepositoryMock.UpdateComment at\n%s", m.funcUpdateCommentOrigin)
+	}
+
+	if !m.UpdateCommentMock.invocationsDone() && afterUpdateCommentCounter > 0 {
+		m.t.Errorf("Expected %d calls to CommentRepositoryMock.UpdateComment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateCommentMock.expectedInvocations), m.UpdateCommentMock.expectedInvocationsOrigin, afterUpdateCommentCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CommentRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddCommentInspect()
+
+			m.MinimockDeleteCommentInspect()
+
+			m.MinimockDeleteReviewCommentsInspect()
+
+			m.MinimockDeleteUserCommentsInspect()
+
+			m.MinimockGetCommentInspect()
+
+			m.MinimockListCommentsInspect()
+
+			m.MinimockListCommentsByInspect()
+
+			m.MinimockListRepliesInspect()
+
+			m.MinimockUpdateCommentInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CommentRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CommentRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddCommentDone() &&
+		m.MinimockDeleteCommentDone() &&
+		m.MinimockDeleteReviewCommentsDone() &&
+		m.MinimockDeleteUserCommentsDone() &&
+		m.MinimockGetCommentDone() &&
+		m.MinimockListCommentsDone() &&
+		m.MinimockListCommentsByDone() &&
+		m.MinimockListRepliesDone() &&
+		m.MinimockUpdateCommentDone()
+}
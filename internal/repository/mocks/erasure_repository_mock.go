@@ -0,0 +1,1902 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/maisiq/go-ugc-service/internal/repository.ErasureRepository -o erasure_repository_mock.go -n ErasureRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_repository "github.com/maisiq/go-ugc-service/internal/repository"
+)
+
+// ErasureRepositoryMock implements mm_repository.ErasureRepository
+type ErasureRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddMovies          func(ctx context.Context, userID string, movieIDs []string) (err error)
+	funcAddMoviesOrigin    string
+	inspectFuncAddMovies   func(ctx context.Context, userID string, movieIDs []string)
+	afterAddMoviesCounter  uint64
+	beforeAddMoviesCounter uint64
+	AddMoviesMock          mErasureRepositoryMockAddMovies
+
+	funcCompleteStep          func(ctx context.Context, userID string, step mm_repository.ErasureStep) (err error)
+	funcCompleteStepOrigin    string
+	inspectFuncCompleteStep   func(ctx context.Context, userID string, step mm_repository.ErasureStep)
+	afterCompleteStepCounter  uint64
+	beforeCompleteStepCounter uint64
+	CompleteStepMock          mErasureRepositoryMockCompleteStep
+
+	funcFinishErasure          func(ctx context.Context, userID string) (err error)
+	funcFinishErasureOrigin    string
+	inspectFuncFinishErasure   func(ctx context.Context, userID string)
+	afterFinishErasureCounter  uint64
+	beforeFinishErasureCounter uint64
+	FinishErasureMock          mErasureRepositoryMockFinishErasure
+
+	funcListErasures          func(ctx context.Context) (ea1 []mm_repository.Erasure, err error)
+	funcListErasuresOrigin    string
+	inspectFuncListErasures   func(ctx context.Context)
+	afterListErasuresCounter  uint64
+	beforeListErasuresCounter uint64
+	ListErasuresMock          mErasureRepositoryMockListErasures
+
+	funcStartErasure          func(ctx context.Context, userID string, startedAt time.Time) (e1 mm_repository.Erasure, err error)
+	funcStartErasureOrigin    string
+	inspectFuncStartErasure   func(ctx context.Context, userID string, startedAt time.Time)
+	afterStartErasureCounter  uint64
+	beforeStartErasureCounter uint64
+	StartErasureMock          mErasureRepositoryMockStartErasure
+}
+
+// NewErasureRepositoryMock returns a mock for mm_repository.ErasureRepository
+func NewErasureRepositoryMock(t minimock.Tester) *ErasureRepositoryMock {
+	m := &ErasureRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddMoviesMock = mErasureRepositoryMockAddMovies{mock: m}
+	m.AddMoviesMock.callArgs = []*ErasureRepositoryMockAddMoviesParams{}
+
+	m.CompleteStepMock = mErasureRepositoryMockCompleteStep{mock: m}
+	m.CompleteStepMock.callArgs = []*ErasureRepositoryMockCompleteStepParams{}
+
+	m.FinishErasureMock = mErasureRepositoryMockFinishErasure{mock: m}
+	m.FinishErasureMock.callArgs = []*ErasureRepositoryMockFinishErasureParams{}
+
+	m.ListErasuresMock = mErasureRepositoryMockListErasures{mock: m}
+	m.ListErasuresMock.callArgs = []*ErasureRepositoryMockListErasuresParams{}
+
+	m.StartErasureMock = mErasureRepositoryMockStartErasure{mock: m}
+	m.StartErasureMock.callArgs = []*ErasureRepositoryMockStartErasureParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mErasureRepositoryMockAddMovies struct {
+	optional           bool
+	mock               *ErasureRepositoryMock
+	defaultExpectation *ErasureRepositoryMockAddMoviesExpectation
+	expectations       []*ErasureRepositoryMockAddMoviesExpectation
+
+	callArgs []*ErasureRepositoryMockAddMoviesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ErasureRepositoryMockAddMoviesExpectation specifies expectation struct of the ErasureRepository.AddMovies
+type ErasureRepositoryMockAddMoviesExpectation struct {
+	mock               *ErasureRepositoryMock
+	params             *ErasureRepositoryMockAddMoviesParams
+	paramPtrs          *ErasureRepositoryMockAddMoviesParamPtrs
+	expectationOrigins ErasureRepositoryMockAddMoviesExpectationOrigins
+	results            *ErasureRepositoryMockAddMoviesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ErasureRepositoryMockAddMoviesParams contains parameters of the ErasureRepository.AddMovies
+type ErasureRepositoryMockAddMoviesParams struct {
+	ctx      context.Context
+	userID   string
+	movieIDs []string
+}
+
+// ErasureRepositoryMockAddMoviesParamPtrs contains pointers to parameters of the ErasureRepository.AddMovies
+type ErasureRepositoryMockAddMoviesParamPtrs struct {
+	ctx      *context.Context
+	userID   *string
+	movieIDs *[]string
+}
+
+// ErasureRepositoryMockAddMoviesResults contains results of the ErasureRepository.AddMovies
+type ErasureRepositoryMockAddMoviesResults struct {
+	err error
+}
+
+// ErasureRepositoryMockAddMoviesOrigins contains origins of expectations of the ErasureRepository.AddMovies
+type ErasureRepositoryMockAddMoviesExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originMovieIDs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddMovies *mErasureRepositoryMockAddMovies) Optional() *mErasureRepositoryMockAddMovies {
+	mmAddMovies.optional = true
+	return mmAddMovies
+}
+
+// Expect sets up expected params for ErasureRepository.AddMovies
+func (mmAddMovies *mErasureRepositoryMockAddMovies) Expect(ctx context.Context, userID string, movieIDs []string) *mErasureRepositoryMockAddMovies {
+	if mmAddMovies.mock.funcAddMovies != nil {
+		mmAddMovies.mock.t.Fatalf("ErasureRepositoryMock.AddMovies mock is already set by Set")
+	}
+
+	if mmAddMovies.defaultExpectation == nil {
+		mmAddMovies.defaultExpectation = &ErasureRepositoryMockAddMoviesExpectation{}
+	}
+
+	if mmAddMovies.defaultExpectation.paramPtrs != nil {
+		mmAddMovies.mock.t.Fatalf("ErasureRepositoryMock.AddMovies mock is already set by ExpectParams functions")
+	}
+
+	mmAddMovies.defaultExpectation.params = &ErasureRepositoryMockAddMoviesParams{ctx, userID, movieIDs}
+	mmAddMovies.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddMovies.expectations {
+		if minimock.Equal(e.params, mmAddMovies.defaultExpectation.params) {
+			mmAddMovies.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddMovies.defaultExpectation.params)
+		}
+	}
+
+	return mmAddMovies
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ErasureRepository.AddMovies
+func (mmAddMovies *mErasureRepositoryMockAddMovies) ExpectCtxParam1(ctx context.Context) *mErasureRepositoryMockAddMovies {
+	if mmAddMovies.mock.funcAddMovies != nil {
+		mmAddMovies.mock.t.Fatalf("ErasureRepositoryMock.AddMovies mock is already set by Set")
+	}
+
+	if mmAddMovies.defaultExpectation == nil {
+		mmAddMovies.defaultExpectation = &ErasureRepositoryMockAddMoviesExpectation{}
+	}
+
+	if mmAddMovies.defaultExpectation.params != nil {
+		mmAddMovies.mock.t.Fatalf("ErasureRepositoryMock.AddMovies mock is already set by Expect")
+	}
+
+	if mmAddMovies.defaultExpectation.paramPtrs == nil {
+		mmAddMovies.defaultExpectation.paramPtrs = &ErasureRepositoryMockAddMoviesParamPtrs{}
+	}
+	mmAddMovies.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddMovies.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddMovies
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ErasureRepository.AddMovies
+func (mmAddMovies *mErasureRepositoryMockAddMovies) ExpectUserIDParam2(userID string) *mErasureRepositoryMockAddMovies {
+	if mmAddMovies.mock.funcAddMovies != nil {
+		mmAddMovies.mock.t.Fatalf("ErasureRepositoryMock.AddMovies mock is already set by Set")
+	}
+
+	if mmAddMovies.defaultExpectation == nil {
+		mmAddMovies.defaultExpectation = &ErasureRepositoryMockAddMoviesExpectation{}
+	}
+
+	if mmAddMovies.defaultExpectation.params != nil {
+		mmAddMovies.mock.t.Fatalf("ErasureRepositoryMock.AddMovies mock is already set by Expect")
+	}
+
+	if mmAddMovies.defaultExpectation.paramPtrs == nil {
+		mmAddMovies.defaultExpectation.paramPtrs = &ErasureRepositoryMockAddMoviesParamPtrs{}
+	}
+	mmAddMovies.defaultExpectation.paramPtrs.userID = &userID
+	mmAddMovies.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmAddMovies
+}
+
+// ExpectMovieIDsParam3 sets up expected param movieIDs for ErasureRepository.AddMovies
+func (mmAddMovies *mErasureRepositoryMockAddMovies) ExpectMovieIDsParam3(movieIDs []string) *mErasureRepositoryMockAddMovies {
+	if mmAddMovies.mock.funcAddMovies != nil {
+		mmAddMovies.mock.t.Fatalf("ErasureRepositoryMock.AddMovies mock is already set by Set")
+	}
+
+	if mmAddMovies.defaultExpectation == nil {
+		mmAddMovies.defaultExpectation = &ErasureRepositoryMockAddMoviesExpectation{}
+	}
+
+	if mmAddMovies.defaultExpectation.params != nil {
+		mmAddMovies.mock.t.Fatalf("ErasureRepositoryMock.AddMovies mock is already set by Expect")
+	}
+
+	if mmAddMovies.defaultExpectation.paramPtrs == nil {
+		mmAddMovies.defaultExpectation.paramPtrs = &ErasureRepositoryMockAddMoviesParamPtrs{}
+	}
+	mmAddMovies.defaultExpectation.paramPtrs.movieIDs = &movieIDs
+	mmAddMovies.defaultExpectation.expectationOrigins.originMovieIDs = minimock.CallerInfo(1)
+
+	return mmAddMovies
+}
+
+// Inspect accepts an inspector function that has same arguments as the ErasureRepository.AddMovies
+func (mmAddMovies *mErasureRepositoryMockAddMovies) Inspect(f func(ctx context.Context, userID string, movieIDs []string)) *mErasureRepositoryMockAddMovies {
+	if mmAddMovies.mock.inspectFuncAddMovies != nil {
+		mmAddMovies.mock.t.Fatalf("Inspect function is already set for ErasureRepositoryMock.AddMovies")
+	}
+
+	mmAddMovies.mock.inspectFuncAddMovies = f
+
+	return mmAddMovies
+}
+
+// Return sets up results that will be returned by ErasureRepository.AddMovies
+func (mmAddMovies *mErasureRepositoryMockAddMovies) Return(err error) *ErasureRepositoryMock {
+	if mmAddMovies.mock.funcAddMovies != nil {
+		mmAddMovies.mock.t.Fatalf("ErasureRepositoryMock.AddMovies mock is already set by Set")
+	}
+
+	if mmAddMovies.defaultExpectation == nil {
+		mmAddMovies.defaultExpectation = &ErasureRepositoryMockAddMoviesExpectation{mock: mmAddMovies.mock}
+	}
+	mmAddMovies.defaultExpectation.results = &ErasureRepositoryMockAddMoviesResults{err}
+	mmAddMovies.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddMovies.mock
+}
+
+// Set uses given function f to mock the ErasureRepository.AddMovies method
+func (mmAddMovies *mErasureRepositoryMockAddMovies) Set(f func(ctx context.Context, userID string, movieIDs []string) (err error)) *ErasureRepositoryMock {
+	if mmAddMovies.defaultExpectation != nil {
+		mmAddMovies.mock.t.Fatalf("Default expectation is already set for the ErasureRepository.AddMovies method")
+	}
+
+	if len(mmAddMovies.expectations) > 0 {
+		mmAddMovies.mock.t.Fatalf("Some expectations are already set for the ErasureRepository.AddMovies method")
+	}
+
+	mmAddMovies.mock.funcAddMovies = f
+	mmAddMovies.mock.funcAddMoviesOrigin = minimock.CallerInfo(1)
+	return mmAddMovies.mock
+}
+
+// When sets expectation for the ErasureRepository.AddMovies which will trigger the result defined by the following
+// Then helper
+func (mmAddMovies *mErasureRepositoryMockAddMovies) When(ctx context.Context, userID string, movieIDs []string) *ErasureRepositoryMockAddMoviesExpectation {
+	if mmAddMovies.mock.funcAddMovies != nil {
+		mmAddMovies.mock.t.Fatalf("ErasureRepositoryMock.AddMovies mock is already set by Set")
+	}
+
+	expectation := &ErasureRepositoryMockAddMoviesExpectation{
+		mock:               mmAddMovies.mock,
+		params:             &ErasureRepositoryMockAddMoviesParams{ctx, userID, movieIDs},
+		expectationOrigins: ErasureRepositoryMockAddMoviesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddMovies.expectations = append(mmAddMovies.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ErasureRepository.AddMovies return parameters for the expectation previously defined by the When method
+func (e *ErasureRepositoryMockAddMoviesExpectation) Then(err error) *ErasureRepositoryMock {
+	e.results = &ErasureRepositoryMockAddMoviesResults{err}
+	return e.mock
+}
+
+// Times sets number of times ErasureRepository.AddMovies should be invoked
+func (mmAddMovies *mErasureRepositoryMockAddMovies) Times(n uint64) *mErasureRepositoryMockAddMovies {
+	if n == 0 {
+		mmAddMovies.mock.t.Fatalf("Times of ErasureRepositoryMock.AddMovies mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddMovies.expectedInvocations, n)
+	mmAddMovies.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddMovies
+}
+
+func (mmAddMovies *mErasureRepositoryMockAddMovies) invocationsDone() bool {
+	if len(mmAddMovies.expectations) == 0 && mmAddMovies.defaultExpectation == nil && mmAddMovies.mock.funcAddMovies == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddMovies.mock.afterAddMoviesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddMovies.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddMovies implements mm_repository.ErasureRepository
+func (mmAddMovies *ErasureRepositoryMock) AddMovies(ctx context.Context, userID string, movieIDs []string) (err error) {
+	mm_atomic.AddUint64(&mmAddMovies.beforeAddMoviesCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddMovies.afterAddMoviesCounter, 1)
+
+	mmAddMovies.t.Helper()
+
+	if mmAddMovies.inspectFuncAddMovies != nil {
+		mmAddMovies.inspectFuncAddMovies(ctx, userID, movieIDs)
+	}
+
+	mm_params := ErasureRepositoryMockAddMoviesParams{ctx, userID, movieIDs}
+
+	// Record call args
+	mmAddMovies.AddMoviesMock.mutex.Lock()
+	mmAddMovies.AddMoviesMock.callArgs = append(mmAddMovies.AddMoviesMock.callArgs, &mm_params)
+	mmAddMovies.AddMoviesMock.mutex.Unlock()
+
+	for _, e := range mmAddMovies.AddMoviesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddMovies.AddMoviesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddMovies.AddMoviesMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddMovies.AddMoviesMock.defaultExpectation.params
+		mm_want_ptrs := mmAddMovies.AddMoviesMock.defaultExpectation.paramPtrs
+
+		mm_got := ErasureRepositoryMockAddMoviesParams{ctx, userID, movieIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddMovies.t.Errorf("ErasureRepositoryMock.AddMovies got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddMovies.AddMoviesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmAddMovies.t.Errorf("ErasureRepositoryMock.AddMovies got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddMovies.AddMoviesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.movieIDs != nil && !minimock.Equal(*mm_want_ptrs.movieIDs, mm_got.movieIDs) {
+				mmAddMovies.t.Errorf("ErasureRepositoryMock.AddMovies got unexpected parameter movieIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddMovies.AddMoviesMock.defaultExpectation.expectationOrigins.originMovieIDs, *mm_want_ptrs.movieIDs, mm_got.movieIDs, minimock.Diff(*mm_want_ptrs.movieIDs, mm_got.movieIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddMovies.t.Errorf("ErasureRepositoryMock.AddMovies got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddMovies.AddMoviesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddMovies.AddMoviesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddMovies.t.Fatal("No results are set for the ErasureRepositoryMock.AddMovies")
+		}
+		return (*mm_results).err
+	}
+	if mmAddMovies.funcAddMovies != nil {
+		return mmAddMovies.funcAddMovies(ctx, userID, movieIDs)
+	}
+	mmAddMovies.t.Fatalf("Unexpected call to ErasureRepositoryMock.AddMovies. %v %v %v", ctx, userID, movieIDs)
+	return
+}
+
+// AddMoviesAfterCounter returns a count of finished ErasureRepositoryMock.AddMovies invocations
+func (mmAddMovies *ErasureRepositoryMock) AddMoviesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddMovies.afterAddMoviesCounter)
+}
+
+// AddMoviesBeforeCounter returns a count of ErasureRepositoryMock.AddMovies invocations
+func (mmAddMovies *ErasureRepositoryMock) AddMoviesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddMovies.beforeAddMoviesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ErasureRepositoryMock.AddMovies.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddMovies *mErasureRepositoryMockAddMovies) Calls() []*ErasureRepositoryMockAddMoviesParams {
+	mmAddMovies.mutex.RLock()
+
+	argCopy := make([]*ErasureRepositoryMockAddMoviesParams, len(mmAddMovies.callArgs))
+	copy(argCopy, mmAddMovies.callArgs)
+
+	mmAddMovies.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddMoviesDone returns true if the count of the AddMovies invocations corresponds
+// the number of defined expectations
+func (m *ErasureRepositoryMock) MinimockAddMoviesDone() bool {
+	if m.AddMoviesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddMoviesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddMoviesMock.invocationsDone()
+}
+
+// MinimockAddMoviesInspect logs each unmet expectation
+func (m *ErasureRepositoryMock) MinimockAddMoviesInspect() {
+	for _, e := range m.AddMoviesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.AddMovies at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddMoviesCounter := mm_atomic.LoadUint64(&m.afterAddMoviesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddMoviesMock.defaultExpectation != nil && afterAddMoviesCounter < 1 {
+		if m.AddMoviesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.AddMovies at\n%s", m.AddMoviesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.AddMovies at\n%s with params: %#v", m.AddMoviesMock.defaultExpectation.expectationOrigins.origin, *m.AddMoviesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddMovies != nil && afterAddMoviesCounter < 1 {
+		m.t.Errorf("Expected call to ErasureRepositoryMock.AddMovies at\n%s", m.funcAddMoviesOrigin)
+	}
+
+	if !m.AddMoviesMock.invocationsDone() && afterAddMoviesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ErasureRepositoryMock.AddMovies at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddMoviesMock.expectedInvocations), m.AddMoviesMock.expectedInvocationsOrigin, afterAddMoviesCounter)
+	}
+}
+
+type mErasureRepositoryMockCompleteStep struct {
+	optional           bool
+	mock               *ErasureRepositoryMock
+	defaultExpectation *ErasureRepositoryMockCompleteStepExpectation
+	expectations       []*ErasureRepositoryMockCompleteStepExpectation
+
+	callArgs []*ErasureRepositoryMockCompleteStepParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ErasureRepositoryMockCompleteStepExpectation specifies expectation struct of the ErasureRepository.CompleteStep
+type ErasureRepositoryMockCompleteStepExpectation struct {
+	mock               *ErasureRepositoryMock
+	params             *ErasureRepositoryMockCompleteStepParams
+	paramPtrs          *ErasureRepositoryMockCompleteStepParamPtrs
+	expectationOrigins ErasureRepositoryMockCompleteStepExpectationOrigins
+	results            *ErasureRepositoryMockCompleteStepResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ErasureRepositoryMockCompleteStepParams contains parameters of the ErasureRepository.CompleteStep
+type ErasureRepositoryMockCompleteStepParams struct {
+	ctx    context.Context
+	userID string
+	step   mm_repository.ErasureStep
+}
+
+// ErasureRepositoryMockCompleteStepParamPtrs contains pointers to parameters of the ErasureRepository.CompleteStep
+type ErasureRepositoryMockCompleteStepParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+	step   *mm_repository.ErasureStep
+}
+
+// ErasureRepositoryMockCompleteStepResults contains results of the ErasureRepository.CompleteStep
+type ErasureRepositoryMockCompleteStepResults struct {
+	err error
+}
+
+// ErasureRepositoryMockCompleteStepOrigins contains origins of expectations of the ErasureRepository.CompleteStep
+type ErasureRepositoryMockCompleteStepExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originStep   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCompleteStep *mErasureRepositoryMockCompleteStep) Optional() *mErasureRepositoryMockCompleteStep {
+	mmCompleteStep.optional = true
+	return mmCompleteStep
+}
+
+// Expect sets up expected params for ErasureRepository.CompleteStep
+func (mmCompleteStep *mErasureRepositoryMockCompleteStep) Expect(ctx context.Context, userID string, step mm_repository.ErasureStep) *mErasureRepositoryMockCompleteStep {
+	if mmCompleteStep.mock.funcCompleteStep != nil {
+		mmCompleteStep.mock.t.Fatalf("ErasureRepositoryMock.CompleteStep mock is already set by Set")
+	}
+
+	if mmCompleteStep.defaultExpectation == nil {
+		mmCompleteStep.defaultExpectation = &ErasureRepositoryMockCompleteStepExpectation{}
+	}
+
+	if mmCompleteStep.defaultExpectation.paramPtrs != nil {
+		mmCompleteStep.mock.t.Fatalf("ErasureRepositoryMock.CompleteStep mock is already set by ExpectParams functions")
+	}
+
+	mmCompleteStep.defaultExpectation.params = &ErasureRepositoryMockCompleteStepParams{ctx, userID, step}
+	mmCompleteStep.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCompleteStep.expectations {
+		if minimock.Equal(e.params, mmCompleteStep.defaultExpectation.params) {
+			mmCompleteStep.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCompleteStep.defaultExpectation.params)
+		}
+	}
+
+	return mmCompleteStep
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ErasureRepository.CompleteStep
+func (mmCompleteStep *mErasureRepositoryMockCompleteStep) ExpectCtxParam1(ctx context.Context) *mErasureRepositoryMockCompleteStep {
+	if mmCompleteStep.mock.funcCompleteStep != nil {
+		mmCompleteStep.mock.t.Fatalf("ErasureRepositoryMock.CompleteStep mock is already set by Set")
+	}
+
+	if mmCompleteStep.defaultExpectation == nil {
+		mmCompleteStep.defaultExpectation = &ErasureRepositoryMockCompleteStepExpectation{}
+	}
+
+	if mmCompleteStep.defaultExpectation.params != nil {
+		mmCompleteStep.mock.t.Fatalf("ErasureRepositoryMock.CompleteStep mock is already set by Expect")
+	}
+
+	if mmCompleteStep.defaultExpectation.paramPtrs == nil {
+		mmCompleteStep.defaultExpectation.paramPtrs = &ErasureRepositoryMockCompleteStepParamPtrs{}
+	}
+	mmCompleteStep.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCompleteStep.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCompleteStep
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ErasureRepository.CompleteStep
+func (mmCompleteStep *mErasureRepositoryMockCompleteStep) ExpectUserIDParam2(userID string) *mErasureRepositoryMockCompleteStep {
+	if mmCompleteStep.mock.funcCompleteStep != nil {
+		mmCompleteStep.mock.t.Fatalf("ErasureRepositoryMock.CompleteStep mock is already set by Set")
+	}
+
+	if mmCompleteStep.defaultExpectation == nil {
+		mmCompleteStep.defaultExpectation = &ErasureRepositoryMockCompleteStepExpectation{}
+	}
+
+	if mmCompleteStep.defaultExpectation.params != nil {
+		mmCompleteStep.mock.t.Fatalf("ErasureRepositoryMock.CompleteStep mock is already set by Expect")
+	}
+
+	if mmCompleteStep.defaultExpectation.paramPtrs == nil {
+		mmCompleteStep.defaultExpectation.paramPtrs = &ErasureRepositoryMockCompleteStepParamPtrs{}
+	}
+	mmCompleteStep.defaultExpectation.paramPtrs.userID = &userID
+	mmCompleteStep.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmCompleteStep
+}
+
+// ExpectStepParam3 sets up expected param step for ErasureRepository.CompleteStep
+func (mmCompleteStep *mErasureRepositoryMockCompleteStep) ExpectStepParam3(step mm_repository.ErasureStep) *mErasureRepositoryMockCompleteStep {
+	if mmCompleteStep.mock.funcCompleteStep != nil {
+		mmCompleteStep.mock.t.Fatalf("ErasureRepositoryMock.CompleteStep mock is already set by Set")
+	}
+
+	if mmCompleteStep.defaultExpectation == nil {
+		mmCompleteStep.defaultExpectation = &ErasureRepositoryMockCompleteStepExpectation{}
+	}
+
+	if mmCompleteStep.defaultExpectation.params != nil {
+		mmCompleteStep.mock.t.Fatalf("ErasureRepositoryMock.CompleteStep mock is already set by Expect")
+	}
+
+	if mmCompleteStep.defaultExpectation.paramPtrs == nil {
+		mmCompleteStep.defaultExpectation.paramPtrs = &ErasureRepositoryMockCompleteStepParamPtrs{}
+	}
+	mmCompleteStep.defaultExpectation.paramPtrs.step = &step
+	mmCompleteStep.defaultExpectation.expectationOrigins.originStep = minimock.CallerInfo(1)
+
+	return mmCompleteStep
+}
+
+// Inspect accepts an inspector function that has same arguments as the ErasureRepository.CompleteStep
+func (mmCompleteStep *mErasureRepositoryMockCompleteStep) Inspect(f func(ctx context.Context, userID string, step mm_repository.ErasureStep)) *mErasureRepositoryMockCompleteStep {
+	if mmCompleteStep.mock.inspectFuncCompleteStep != nil {
+		mmCompleteStep.mock.t.Fatalf("Inspect function is already set for ErasureRepositoryMock.CompleteStep")
+	}
+
+	mmCompleteStep.mock.inspectFuncCompleteStep = f
+
+	return mmCompleteStep
+}
+
+// Return sets up results that will be returned by ErasureRepository.CompleteStep
+func (mmCompleteStep *mErasureRepositoryMockCompleteStep) Return(err error) *ErasureRepositoryMock {
+	if mmCompleteStep.mock.funcCompleteStep != nil {
+		mmCompleteStep.mock.t.Fatalf("ErasureRepositoryMock.CompleteStep mock is already set by Set")
+	}
+
+	if mmCompleteStep.defaultExpectation == nil {
+		mmCompleteStep.defaultExpectation = &ErasureRepositoryMockCompleteStepExpectation{mock: mmCompleteStep.mock}
+	}
+	mmCompleteStep.defaultExpectation.results = &ErasureRepositoryMockCompleteStepResults{err}
+	mmCompleteStep.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCompleteStep.mock
+}
+
+// Set uses given function f to mock the ErasureRepository.CompleteStep method
+func (mmCompleteStep *mErasureRepositoryMockCompleteStep) Set(f func(ctx context.Context, userID string, step mm_repository.ErasureStep) (err error)) *ErasureRepositoryMock {
+	if mmCompleteStep.defaultExpectation != nil {
+		mmCompleteStep.mock.t.Fatalf("Default expectation is already set for the ErasureRepository.CompleteStep method")
+	}
+
+	if len(mmCompleteStep.expectations) > 0 {
+		mmCompleteStep.mock.t.Fatalf("Some expectations are already set for the ErasureRepository.CompleteStep method")
+	}
+
+	mmCompleteStep.mock.funcCompleteStep = f
+	mmCompleteStep.mock.funcCompleteStepOrigin = minimock.CallerInfo(1)
+	return mmCompleteStep.mock
+}
+
+// When sets expectation for the ErasureRepository.CompleteStep which will trigger the result defined by the following
+// Then helper
+func (mmCompleteStep *mErasureRepositoryMockCompleteStep) When(ctx context.Context, userID string, step mm_repository.ErasureStep) *ErasureRepositoryMockCompleteStepExpectation {
+	if mmCompleteStep.mock.funcCompleteStep != nil {
+		mmCompleteStep.mock.t.Fatalf("ErasureRepositoryMock.CompleteStep mock is already set by Set")
+	}
+
+	expectation := &ErasureRepositoryMockCompleteStepExpectation{
+		mock:               mmCompleteStep.mock,
+		params:             &ErasureRepositoryMockCompleteStepParams{ctx, userID, step},
+		expectationOrigins: ErasureRepositoryMockCompleteStepExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCompleteStep.expectations = append(mmCompleteStep.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ErasureRepository.CompleteStep return parameters for the expectation previously defined by the When method
+func (e *ErasureRepositoryMockCompleteStepExpectation) Then(err error) *ErasureRepositoryMock {
+	e.results = &ErasureRepositoryMockCompleteStepResults{err}
+	return e.mock
+}
+
+// Times sets number of times ErasureRepository.CompleteStep should be invoked
+func (mmCompleteStep *mErasureRepositoryMockCompleteStep) Times(n uint64) *mErasureRepositoryMockCompleteStep {
+	if n == 0 {
+		mmCompleteStep.mock.t.Fatalf("Times of ErasureRepositoryMock.CompleteStep mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCompleteStep.expectedInvocations, n)
+	mmCompleteStep.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCompleteStep
+}
+
+func (mmCompleteStep *mErasureRepositoryMockCompleteStep) invocationsDone() bool {
+	if len(mmCompleteStep.expectations) == 0 && mmCompleteStep.defaultExpectation == nil && mmCompleteStep.mock.funcCompleteStep == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCompleteStep.mock.afterCompleteStepCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCompleteStep.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CompleteStep implements mm_repository.ErasureRepository
+func (mmCompleteStep *ErasureRepositoryMock) CompleteStep(ctx context.Context, userID string, step mm_repository.ErasureStep) (err error) {
+	mm_atomic.AddUint64(&mmCompleteStep.beforeCompleteStepCounter, 1)
+	defer mm_atomic.AddUint64(&mmCompleteStep.afterCompleteStepCounter, 1)
+
+	mmCompleteStep.t.Helper()
+
+	if mmCompleteStep.inspectFuncCompleteStep != nil {
+		mmCompleteStep.inspectFuncCompleteStep(ctx, userID, step)
+	}
+
+	mm_params := ErasureRepositoryMockCompleteStepParams{ctx, userID, step}
+
+	// Record call args
+	mmCompleteStep.CompleteStepMock.mutex.Lock()
+	mmCompleteStep.CompleteStepMock.callArgs = append(mmCompleteStep.CompleteStepMock.callArgs, &mm_params)
+	mmCompleteStep.CompleteStepMock.mutex.Unlock()
+
+	for _, e := range mmCompleteStep.CompleteStepMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCompleteStep.CompleteStepMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCompleteStep.CompleteStepMock.defaultExpectation.Counter, 1)
+		mm_want := mmCompleteStep.CompleteStepMock.defaultExpectation.params
+		mm_want_ptrs := mmCompleteStep.CompleteStepMock.defaultExpectation.paramPtrs
+
+		mm_got := ErasureRepositoryMockCompleteStepParams{ctx, userID, step}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCompleteStep.t.Errorf("ErasureRepositoryMock.CompleteStep got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCompleteStep.CompleteStepMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmCompleteStep.t.Errorf("ErasureRepositoryMock.CompleteStep got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCompleteStep.CompleteStepMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.step != nil && !minimock.Equal(*mm_want_ptrs.step, mm_got.step) {
+				mmCompleteStep.t.Errorf("ErasureRepositoryMock.CompleteStep got unexpected parameter step, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCompleteStep.CompleteStepMock.defaultExpectation.expectationOrigins.originStep, *mm_want_ptrs.step, mm_got.step, minimock.Diff(*mm_want_ptrs.step, mm_got.step))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCompleteStep.t.Errorf("ErasureRepositoryMock.CompleteStep got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCompleteStep.CompleteStepMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCompleteStep.CompleteStepMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCompleteStep.t.Fatal("No results are set for the ErasureRepositoryMock.CompleteStep")
+		}
+		return (*mm_results).err
+	}
+	if mmCompleteStep.funcCompleteStep != nil {
+		return mmCompleteStep.funcCompleteStep(ctx, userID, step)
+	}
+	mmCompleteStep.t.Fatalf("Unexpected call to ErasureRepositoryMock.CompleteStep. %v %v %v", ctx, userID, step)
+	return
+}
+
+// CompleteStepAfterCounter returns a count of finished ErasureRepositoryMock.CompleteStep invocations
+func (mmCompleteStep *ErasureRepositoryMock) CompleteStepAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCompleteStep.afterCompleteStepCounter)
+}
+
+// CompleteStepBeforeCounter returns a count of ErasureRepositoryMock.CompleteStep invocations
+func (mmCompleteStep *ErasureRepositoryMock) CompleteStepBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCompleteStep.beforeCompleteStepCounter)
+}
+
+// Calls returns a list of arguments used in each call to ErasureRepositoryMock.CompleteStep.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCompleteStep *mErasureRepositoryMockCompleteStep) Calls() []*ErasureRepositoryMockCompleteStepParams {
+	mmCompleteStep.mutex.RLock()
+
+	argCopy := make([]*ErasureRepositoryMockCompleteStepParams, len(mmCompleteStep.callArgs))
+	copy(argCopy, mmCompleteStep.callArgs)
+
+	mmCompleteStep.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCompleteStepDone returns true if the count of the CompleteStep invocations corresponds
+// the number of defined expectations
+func (m *ErasureRepositoryMock) MinimockCompleteStepDone() bool {
+	if m.CompleteStepMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CompleteStepMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CompleteStepMock.invocationsDone()
+}
+
+// MinimockCompleteStepInspect logs each unmet expectation
+func (m *ErasureRepositoryMock) MinimockCompleteStepInspect() {
+	for _, e := range m.CompleteStepMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.CompleteStep at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCompleteStepCounter := mm_atomic.LoadUint64(&m.afterCompleteStepCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CompleteStepMock.defaultExpectation != nil && afterCompleteStepCounter < 1 {
+		if m.CompleteStepMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.CompleteStep at\n%s", m.CompleteStepMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.CompleteStep at\n%s with params: %#v", m.CompleteStepMock.defaultExpectation.expectationOrigins.origin, *m.CompleteStepMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCompleteStep != nil && afterCompleteStepCounter < 1 {
+		m.t.Errorf("Expected call to ErasureRepositoryMock.CompleteStep at\n%s", m.funcCompleteStepOrigin)
+	}
+
+	if !m.CompleteStepMock.invocationsDone() && afterCompleteStepCounter > 0 {
+		m.t.Errorf("Expected %d calls to ErasureRepositoryMock.CompleteStep at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CompleteStepMock.expectedInvocations), m.CompleteStepMock.expectedInvocationsOrigin, afterCompleteStepCounter)
+	}
+}
+
+type mErasureRepositoryMockFinishErasure struct {
+	optional           bool
+	mock               *ErasureRepositoryMock
+	defaultExpectation *ErasureRepositoryMockFinishErasureExpectation
+	expectations       []*ErasureRepositoryMockFinishErasureExpectation
+
+	callArgs []*ErasureRepositoryMockFinishErasureParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ErasureRepositoryMockFinishErasureExpectation specifies expectation struct of the ErasureRepository.FinishErasure
+type ErasureRepositoryMockFinishErasureExpectation struct {
+	mock               *ErasureRepositoryMock
+	params             *ErasureRepositoryMockFinishErasureParams
+	paramPtrs          *ErasureRepositoryMockFinishErasureParamPtrs
+	expectationOrigins ErasureRepositoryMockFinishErasureExpectationOrigins
+	results            *ErasureRepositoryMockFinishErasureResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ErasureRepositoryMockFinishErasureParams contains parameters of the ErasureRepository.FinishErasure
+type ErasureRepositoryMockFinishErasureParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// ErasureRepositoryMockFinishErasureParamPtrs contains pointers to parameters of the ErasureRepository.FinishErasure
+type ErasureRepositoryMockFinishErasureParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// ErasureRepositoryMockFinishErasureResults contains results of the ErasureRepository.FinishErasure
+type ErasureRepositoryMockFinishErasureResults struct {
+	err error
+}
+
+// ErasureRepositoryMockFinishErasureOrigins contains origins of expectations of the ErasureRepository.FinishErasure
+type ErasureRepositoryMockFinishErasureExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFinishErasure *mErasureRepositoryMockFinishErasure) Optional() *mErasureRepositoryMockFinishErasure {
+	mmFinishErasure.optional = true
+	return mmFinishErasure
+}
+
+// Expect sets up expected params for ErasureRepository.FinishErasure
+func (mmFinishErasure *mErasureRepositoryMockFinishErasure) Expect(ctx context.Context, userID string) *mErasureRepositoryMockFinishErasure {
+	if mmFinishErasure.mock.funcFinishErasure != nil {
+		mmFinishErasure.mock.t.Fatalf("ErasureRepositoryMock.FinishErasure mock is already set by Set")
+	}
+
+	if mmFinishErasure.defaultExpectation == nil {
+		mmFinishErasure.defaultExpectation = &ErasureRepositoryMockFinishErasureExpectation{}
+	}
+
+	if mmFinishErasure.defaultExpectation.paramPtrs != nil {
+		mmFinishErasure.mock.t.Fatalf("ErasureRepositoryMock.FinishErasure mock is already set by ExpectParams functions")
+	}
+
+	mmFinishErasure.defaultExpectation.params = &ErasureRepositoryMockFinishErasureParams{ctx, userID}
+	mmFinishErasure.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFinishErasure.expectations {
+		if minimock.Equal(e.params, mmFinishErasure.defaultExpectation.params) {
+			mmFinishErasure.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFinishErasure.defaultExpectation.params)
+		}
+	}
+
+	return mmFinishErasure
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ErasureRepository.FinishErasure
+func (mmFinishErasure *mErasureRepositoryMockFinishErasure) ExpectCtxParam1(ctx context.Context) *mErasureRepositoryMockFinishErasure {
+	if mmFinishErasure.mock.funcFinishErasure != nil {
+		mmFinishErasure.mock.t.Fatalf("ErasureRepositoryMock.FinishErasure mock is already set by Set")
+	}
+
+	if mmFinishErasure.defaultExpectation == nil {
+		mmFinishErasure.defaultExpectation = &ErasureRepositoryMockFinishErasureExpectation{}
+	}
+
+	if mmFinishErasure.defaultExpectation.params != nil {
+		mmFinishErasure.mock.t.Fatalf("ErasureRepositoryMock.FinishErasure mock is already set by Expect")
+	}
+
+	if mmFinishErasure.defaultExpectation.paramPtrs == nil {
+		mmFinishErasure.defaultExpectation.paramPtrs = &ErasureRepositoryMockFinishErasureParamPtrs{}
+	}
+	mmFinishErasure.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFinishErasure.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFinishErasure
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ErasureRepository.FinishErasure
+func (mmFinishErasure *mErasureRepositoryMockFinishErasure) ExpectUserIDParam2(userID string) *mErasureRepositoryMockFinishErasure {
+	if mmFinishErasure.mock.funcFinishErasure != nil {
+		mmFinishErasure.mock.t.Fatalf("ErasureRepositoryMock.FinishErasure mock is already set by Set")
+	}
+
+	if mmFinishErasure.defaultExpectation == nil {
+		mmFinishErasure.defaultExpectation = &ErasureRepositoryMockFinishErasureExpectation{}
+	}
+
+	if mmFinishErasure.defaultExpectation.params != nil {
+		mmFinishErasure.mock.t.Fatalf("ErasureRepositoryMock.FinishErasure mock is already set by Expect")
+	}
+
+	if mmFinishErasure.defaultExpectation.paramPtrs == nil {
+		mmFinishErasure.defaultExpectation.paramPtrs = &ErasureRepositoryMockFinishErasureParamPtrs{}
+	}
+	mmFinishErasure.defaultExpectation.paramPtrs.userID = &userID
+	mmFinishErasure.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmFinishErasure
+}
+
+// Inspect accepts an inspector function that has same arguments as the ErasureRepository.FinishErasure
+func (mmFinishErasure *mErasureRepositoryMockFinishErasure) Inspect(f func(ctx context.Context, userID string)) *mErasureRepositoryMockFinishErasure {
+	if mmFinishErasure.mock.inspectFuncFinishErasure != nil {
+		mmFinishErasure.mock.t.Fatalf("Inspect function is already set for ErasureRepositoryMock.FinishErasure")
+	}
+
+	mmFinishErasure.mock.inspectFuncFinishErasure = f
+
+	return mmFinishErasure
+}
+
+// Return sets up results that will be returned by ErasureRepository.FinishErasure
+func (mmFinishErasure *mErasureRepositoryMockFinishErasure) Return(err error) *ErasureRepositoryMock {
+	if mmFinishErasure.mock.funcFinishErasure != nil {
+		mmFinishErasure.mock.t.Fatalf("ErasureRepositoryMock.FinishErasure mock is already set by Set")
+	}
+
+	if mmFinishErasure.defaultExpectation == nil {
+		mmFinishErasure.defaultExpectation = &ErasureRepositoryMockFinishErasureExpectation{mock: mmFinishErasure.mock}
+	}
+	mmFinishErasure.defaultExpectation.results = &ErasureRepositoryMockFinishErasureResults{err}
+	mmFinishErasure.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFinishErasure.mock
+}
+
+// Set uses given function f to mock the ErasureRepository.FinishErasure method
+func (mmFinishErasure *mErasureRepositoryMockFinishErasure) Set(f func(ctx context.Context, userID string) (err error)) *ErasureRepositoryMock {
+	if mmFinishErasure.defaultExpectation != nil {
+		mmFinishErasure.mock.t.Fatalf("Default expectation is already set for the ErasureRepository.FinishErasure method")
+	}
+
+	if len(mmFinishErasure.expectations) > 0 {
+		mmFinishErasure.mock.t.Fatalf("Some expectations are already set for the ErasureRepository.FinishErasure method")
+	}
+
+	mmFinishErasure.mock.funcFinishErasure = f
+	mmFinishErasure.mock.funcFinishErasureOrigin = minimock.CallerInfo(1)
+	return mmFinishErasure.mock
+}
+
+// When sets expectation for the ErasureRepository.FinishErasure which will trigger the result defined by the following
+// Then helper
+func (mmFinishErasure *mErasureRepositoryMockFinishErasure) When(ctx context.Context, userID string) *ErasureRepositoryMockFinishErasureExpectation {
+	if mmFinishErasure.mock.funcFinishErasure != nil {
+		mmFinishErasure.mock.t.Fatalf("ErasureRepositoryMock.FinishErasure mock is already set by Set")
+	}
+
+	expectation := &ErasureRepositoryMockFinishErasureExpectation{
+		mock:               mmFinishErasure.mock,
+		params:             &ErasureRepositoryMockFinishErasureParams{ctx, userID},
+		expectationOrigins: ErasureRepositoryMockFinishErasureExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFinishErasure.expectations = append(mmFinishErasure.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ErasureRepository.FinishErasure return parameters for the expectation previously defined by the When method
+func (e *ErasureRepositoryMockFinishErasureExpectation) Then(err error) *ErasureRepositoryMock {
+	e.results = &ErasureRepositoryMockFinishErasureResults{err}
+	return e.mock
+}
+
+// Times sets number of times ErasureRepository.FinishErasure should be invoked
+func (mmFinishErasure *mErasureRepositoryMockFinishErasure) Times(n uint64) *mErasureRepositoryMockFinishErasure {
+	if n == 0 {
+		mmFinishErasure.mock.t.Fatalf("Times of ErasureRepositoryMock.FinishErasure mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFinishErasure.expectedInvocations, n)
+	mmFinishErasure.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFinishErasure
+}
+
+func (mmFinishErasure *mErasureRepositoryMockFinishErasure) invocationsDone() bool {
+	if len(mmFinishErasure.expectations) == 0 && mmFinishErasure.defaultExpectation == nil && mmFinishErasure.mock.funcFinishErasure == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFinishErasure.mock.afterFinishErasureCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFinishErasure.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FinishErasure implements mm_repository.ErasureRepository
+func (mmFinishErasure *ErasureRepositoryMock) FinishErasure(ctx context.Context, userID string) (err error) {
+	mm_atomic.AddUint64(&mmFinishErasure.beforeFinishErasureCounter, 1)
+	defer mm_atomic.AddUint64(&mmFinishErasure.afterFinishErasureCounter, 1)
+
+	mmFinishErasure.t.Helper()
+
+	if mmFinishErasure.inspectFuncFinishErasure != nil {
+		mmFinishErasure.inspectFuncFinishErasure(ctx, userID)
+	}
+
+	mm_params := ErasureRepositoryMockFinishErasureParams{ctx, userID}
+
+	// Record call args
+	mmFinishErasure.FinishErasureMock.mutex.Lock()
+	mmFinishErasure.FinishErasureMock.callArgs = append(mmFinishErasure.FinishErasureMock.callArgs, &mm_params)
+	mmFinishErasure.FinishErasureMock.mutex.Unlock()
+
+	for _, e := range mmFinishErasure.FinishErasureMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmFinishErasure.FinishErasureMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFinishErasure.FinishErasureMock.defaultExpectation.Counter, 1)
+		mm_want := mmFinishErasure.FinishErasureMock.defaultExpectation.params
+		mm_want_ptrs := mmFinishErasure.FinishErasureMock.defaultExpectation.paramPtrs
+
+		mm_got := ErasureRepositoryMockFinishErasureParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFinishErasure.t.Errorf("ErasureRepositoryMock.FinishErasure got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinishErasure.FinishErasureMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmFinishErasure.t.Errorf("ErasureRepositoryMock.FinishErasure got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinishErasure.FinishErasureMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFinishErasure.t.Errorf("ErasureRepositoryMock.FinishErasure got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFinishErasure.FinishErasureMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFinishErasure.FinishErasureMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFinishErasure.t.Fatal("No results are set for the ErasureRepositoryMock.FinishErasure")
+		}
+		return (*mm_results).err
+	}
+	if mmFinishErasure.funcFinishErasure != nil {
+		return mmFinishErasure.funcFinishErasure(ctx, userID)
+	}
+	mmFinishErasure.t.Fatalf("Unexpected call to ErasureRepositoryMock.FinishErasure. %v %v", ctx, userID)
+	return
+}
+
+// FinishErasureAfterCounter returns a count of finished ErasureRepositoryMock.FinishErasure invocations
+func (mmFinishErasure *ErasureRepositoryMock) FinishErasureAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFinishErasure.afterFinishErasureCounter)
+}
+
+// FinishErasureBeforeCounter returns a count of ErasureRepositoryMock.FinishErasure invocations
+func (mmFinishErasure *ErasureRepositoryMock) FinishErasureBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFinishErasure.beforeFinishErasureCounter)
+}
+
+// Calls returns a list of arguments used in each call to ErasureRepositoryMock.FinishErasure.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFinishErasure *mErasureRepositoryMockFinishErasure) Calls() []*ErasureRepositoryMockFinishErasureParams {
+	mmFinishErasure.mutex.RLock()
+
+	argCopy := make([]*ErasureRepositoryMockFinishErasureParams, len(mmFinishErasure.callArgs))
+	copy(argCopy, mmFinishErasure.callArgs)
+
+	mmFinishErasure.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFinishErasureDone returns true if the count of the FinishErasure invocations corresponds
+// the number of defined expectations
+func (m *ErasureRepositoryMock) MinimockFinishErasureDone() bool {
+	if m.FinishErasureMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FinishErasureMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FinishErasureMock.invocationsDone()
+}
+
+// MinimockFinishErasureInspect logs each unmet expectation
+func (m *ErasureRepositoryMock) MinimockFinishErasureInspect() {
+	for _, e := range m.FinishErasureMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.FinishErasure at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFinishErasureCounter := mm_atomic.LoadUint64(&m.afterFinishErasureCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FinishErasureMock.defaultExpectation != nil && afterFinishErasureCounter < 1 {
+		if m.FinishErasureMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.FinishErasure at\n%s", m.FinishErasureMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.FinishErasure at\n%s with params: %#v", m.FinishErasureMock.defaultExpectation.expectationOrigins.origin, *m.FinishErasureMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFinishErasure != nil && afterFinishErasureCounter < 1 {
+		m.t.Errorf("Expected call to ErasureRepositoryMock.FinishErasure at\n%s", m.funcFinishErasureOrigin)
+	}
+
+	if !m.FinishErasureMock.invocationsDone() && afterFinishErasureCounter > 0 {
+		m.t.Errorf("Expected %d calls to ErasureRepositoryMock.FinishErasure at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FinishErasureMock.expectedInvocations), m.FinishErasureMock.expectedInvocationsOrigin, afterFinishErasureCounter)
+	}
+}
+
+type mErasureRepositoryMockListErasures struct {
+	optional           bool
+	mock               *ErasureRepositoryMock
+	defaultExpectation *ErasureRepositoryMockListErasuresExpectation
+	expectations       []*ErasureRepositoryMockListErasuresExpectation
+
+	callArgs []*ErasureRepositoryMockListErasuresParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ErasureRepositoryMockListErasuresExpectation specifies expectation struct of the ErasureRepository.ListErasures
+type ErasureRepositoryMockListErasuresExpectation struct {
+	mock               *ErasureRepositoryMock
+	params             *ErasureRepositoryMockListErasuresParams
+	paramPtrs          *ErasureRepositoryMockListErasuresParamPtrs
+	expectationOrigins ErasureRepositoryMockListErasuresExpectationOrigins
+	results            *ErasureRepositoryMockListErasuresResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ErasureRepositoryMockListErasuresParams contains parameters of the ErasureRepository.ListErasures
+type ErasureRepositoryMockListErasuresParams struct {
+	ctx context.Context
+}
+
+// ErasureRepositoryMockListErasuresParamPtrs contains pointers to parameters of the ErasureRepository.ListErasures
+type ErasureRepositoryMockListErasuresParamPtrs struct {
+	ctx *context.Context
+}
+
+// ErasureRepositoryMockListErasuresResults contains results of the ErasureRepository.ListErasures
+type ErasureRepositoryMockListErasuresResults struct {
+	ea1 []mm_repository.Erasure
+	err error
+}
+
+// ErasureRepositoryMockListErasuresOrigins contains origins of expectations of the ErasureRepository.ListErasures
+type ErasureRepositoryMockListErasuresExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListErasures *mErasureRepositoryMockListErasures) Optional() *mErasureRepositoryMockListErasures {
+	mmListErasures.optional = true
+	return mmListErasures
+}
+
+// Expect sets up expected params for ErasureRepository.ListErasures
+func (mmListErasures *mErasureRepositoryMockListErasures) Expect(ctx context.Context) *mErasureRepositoryMockListErasures {
+	if mmListErasures.mock.funcListErasures != nil {
+		mmListErasures.mock.t.Fatalf("ErasureRepositoryMock.ListErasures mock is already set by Set")
+	}
+
+	if mmListErasures.defaultExpectation == nil {
+		mmListErasures.defaultExpectation = &ErasureRepositoryMockListErasuresExpectation{}
+	}
+
+	if mmListErasures.defaultExpectation.paramPtrs != nil {
+		mmListErasures.mock.t.Fatalf("ErasureRepositoryMock.ListErasures mock is already set by ExpectParams functions")
+	}
+
+	mmListErasures.defaultExpectation.params = &ErasureRepositoryMockListErasuresParams{ctx}
+	mmListErasures.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListErasures.expectations {
+		if minimock.Equal(e.params, mmListErasures.defaultExpectation.params) {
+			mmListErasures.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListErasures.defaultExpectation.params)
+		}
+	}
+
+	return mmListErasures
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ErasureRepository.ListErasures
+func (mmListErasures *mErasureRepositoryMockListErasures) ExpectCtxParam1(ctx context.Context) *mErasureRepositoryMockListErasures {
+	if mmListErasures.mock.funcListErasures != nil {
+		mmListErasures.mock.t.Fatalf("ErasureRepositoryMock.ListErasures mock is already set by Set")
+	}
+
+	if mmListErasures.defaultExpectation == nil {
+		mmListErasures.defaultExpectation = &ErasureRepositoryMockListErasuresExpectation{}
+	}
+
+	if mmListErasures.defaultExpectation.params != nil {
+		mmListErasures.mock.t.Fatalf("ErasureRepositoryMock.ListErasures mock is already set by Expect")
+	}
+
+	if mmListErasures.defaultExpectation.paramPtrs == nil {
+		mmListErasures.defaultExpectation.paramPtrs = &ErasureRepositoryMockListErasuresParamPtrs{}
+	}
+	mmListErasures.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListErasures.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListErasures
+}
+
+// Inspect accepts an inspector function that has same arguments as the ErasureRepository.ListErasures
+func (mmListErasures *mErasureRepositoryMockListErasures) Inspect(f func(ctx context.Context)) *mErasureRepositoryMockListErasures {
+	if mmListErasures.mock.inspectFuncListErasures != nil {
+		mmListErasures.mock.t.Fatalf("Inspect function is already set for ErasureRepositoryMock.ListErasures")
+	}
+
+	mmListErasures.mock.inspectFuncListErasures = f
+
+	return mmListErasures
+}
+
+// Return sets up results that will be returned by ErasureRepository.ListErasures
+func (mmListErasures *mErasureRepositoryMockListErasures) Return(ea1 []mm_repository.Erasure, err error) *ErasureRepositoryMock {
+	if mmListErasures.mock.funcListErasures != nil {
+		mmListErasures.mock.t.Fatalf("ErasureRepositoryMock.ListErasures mock is already set by Set")
+	}
+
+	if mmListErasures.defaultExpectation == nil {
+		mmListErasures.defaultExpectation = &ErasureRepositoryMockListErasuresExpectation{mock: mmListErasures.mock}
+	}
+	mmListErasures.defaultExpectation.results = &ErasureRepositoryMockListErasuresResults{ea1, err}
+	mmListErasures.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListErasures.mock
+}
+
+// Set uses given function f to mock the ErasureRepository.ListErasures method
+func (mmListErasures *mErasureRepositoryMockListErasures) Set(f func(ctx context.Context) (ea1 []mm_repository.Erasure, err error)) *ErasureRepositoryMock {
+	if mmListErasures.defaultExpectation != nil {
+		mmListErasures.mock.t.Fatalf("Default expectation is already set for the ErasureRepository.ListErasures method")
+	}
+
+	if len(mmListErasures.expectations) > 0 {
+		mmListErasures.mock.t.Fatalf("Some expectations are already set for the ErasureRepository.ListErasures method")
+	}
+
+	mmListErasures.mock.funcListErasures = f
+	mmListErasures.mock.funcListErasuresOrigin = minimock.CallerInfo(1)
+	return mmListErasures.mock
+}
+
+// When sets expectation for the ErasureRepository.ListErasures which will trigger the result defined by the following
+// Then helper
+func (mmListErasures *mErasureRepositoryMockListErasures) When(ctx context.Context) *ErasureRepositoryMockListErasuresExpectation {
+	if mmListErasures.mock.funcListErasures != nil {
+		mmListErasures.mock.t.Fatalf("ErasureRepositoryMock.ListErasures mock is already set by Set")
+	}
+
+	expectation := &ErasureRepositoryMockListErasuresExpectation{
+		mock:               mmListErasures.mock,
+		params:             &ErasureRepositoryMockListErasuresParams{ctx},
+		expectationOrigins: ErasureRepositoryMockListErasuresExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListErasures.expectations = append(mmListErasures.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ErasureRepository.ListErasures return parameters for the expectation previously defined by the When method
+func (e *ErasureRepositoryMockListErasuresExpectation) Then(ea1 []mm_repository.Erasure, err error) *ErasureRepositoryMock {
+	e.results = &ErasureRepositoryMockListErasuresResults{ea1, err}
+	return e.mock
+}
+
+// Times sets number of times ErasureRepository.ListErasures should be invoked
+func (mmListErasures *mErasureRepositoryMockListErasures) Times(n uint64) *mErasureRepositoryMockListErasures {
+	if n == 0 {
+		mmListErasures.mock.t.Fatalf("Times of ErasureRepositoryMock.ListErasures mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListErasures.expectedInvocations, n)
+	mmListErasures.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListErasures
+}
+
+func (mmListErasures *mErasureRepositoryMockListErasures) invocationsDone() bool {
+	if len(mmListErasures.expectations) == 0 && mmListErasures.defaultExpectation == nil && mmListErasures.mock.funcListErasures == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListErasures.mock.afterListErasuresCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListErasures.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListErasures implements mm_repository.ErasureRepository
+func (mmListErasures *ErasureRepositoryMock) ListErasures(ctx context.Context) (ea1 []mm_repository.Erasure, err error) {
+	mm_atomic.AddUint64(&mmListErasures.beforeListErasuresCounter, 1)
+	defer mm_atomic.AddUint64(&mmListErasures.afterListErasuresCounter, 1)
+
+	mmListErasures.t.Helper()
+
+	if mmListErasures.inspectFuncListErasures != nil {
+		mmListErasures.inspectFuncListErasures(ctx)
+	}
+
+	mm_params := ErasureRepositoryMockListErasuresParams{ctx}
+
+	// Record call args
+	mmListErasures.ListErasuresMock.mutex.Lock()
+	mmListErasures.ListErasuresMock.callArgs = append(mmListErasures.ListErasuresMock.callArgs, &mm_params)
+	mmListErasures.ListErasuresMock.mutex.Unlock()
+
+	for _, e := range mmListErasures.ListErasuresMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ea1, e.results.err
+		}
+	}
+
+	if mmListErasures.ListErasuresMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListErasures.ListErasuresMock.defaultExpectation.Counter, 1)
+		mm_want := mmListErasures.ListErasuresMock.defaultExpectation.params
+		mm_want_ptrs := mmListErasures.ListErasuresMock.defaultExpectation.paramPtrs
+
+		mm_got := ErasureRepositoryMockListErasuresParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListErasures.t.Errorf("ErasureRepositoryMock.ListErasures got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListErasures.ListErasuresMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListErasures.t.Errorf("ErasureRepositoryMock.ListErasures got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListErasures.ListErasuresMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListErasures.ListErasuresMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListErasures.t.Fatal("No results are set for the ErasureRepositoryMock.ListErasures")
+		}
+		return (*mm_results).ea1, (*mm_results).err
+	}
+	if mmListErasures.funcListErasures != nil {
+		return mmListErasures.funcListErasures(ctx)
+	}
+	mmListErasures.t.Fatalf("Unexpected call to ErasureRepositoryMock.ListErasures. %v", ctx)
+	return
+}
+
+// ListErasuresAfterCounter returns a count of finished ErasureRepositoryMock.ListErasures invocations
+func (mmListErasures *ErasureRepositoryMock) ListErasuresAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListErasures.afterListErasuresCounter)
+}
+
+// ListErasuresBeforeCounter returns a count of ErasureRepositoryMock.ListErasures invocations
+func (mmListErasures *ErasureRepositoryMock) ListErasuresBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListErasures.beforeListErasuresCounter)
+}
+
+// Calls returns a list of arguments used in each call to ErasureRepositoryMock.ListErasures.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListErasures *mErasureRepositoryMockListErasures) Calls() []*ErasureRepositoryMockListErasuresParams {
+	mmListErasures.mutex.RLock()
+
+	argCopy := make([]*ErasureRepositoryMockListErasuresParams, len(mmListErasures.callArgs))
+	copy(argCopy, mmListErasures.callArgs)
+
+	mmListErasures.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListErasuresDone returns true if the count of the ListErasures invocations corresponds
+// the number of defined expectations
+func (m *ErasureRepositoryMock) MinimockListErasuresDone() bool {
+	if m.ListErasuresMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListErasuresMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListErasuresMock.invocationsDone()
+}
+
+// MinimockListErasuresInspect logs each unmet expectation
+func (m *ErasureRepositoryMock) MinimockListErasuresInspect() {
+	for _, e := range m.ListErasuresMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.ListErasures at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListErasuresCounter := mm_atomic.LoadUint64(&m.afterListErasuresCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListErasuresMock.defaultExpectation != nil && afterListErasuresCounter < 1 {
+		if m.ListErasuresMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.ListErasures at\n%s", m.ListErasuresMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.ListErasures at\n%s with params: %#v", m.ListErasuresMock.defaultExpectation.expectationOrigins.origin, *m.ListErasuresMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListErasures != nil && afterListErasuresCounter < 1 {
+		m.t.Errorf("Expected call to ErasureRepositoryMock.ListErasures at\n%s", m.funcListErasuresOrigin)
+	}
+
+	if !m.ListErasuresMock.invocationsDone() && afterListErasuresCounter > 0 {
+		m.t.Errorf("Expected %d calls to ErasureRepositoryMock.ListErasures at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListErasuresMock.expectedInvocations), m.ListErasuresMock.expectedInvocationsOrigin, afterListErasuresCounter)
+	}
+}
+
+type mErasureRepositoryMockStartErasure struct {
+	optional           bool
+	mock               *ErasureRepositoryMock
+	defaultExpectation *ErasureRepositoryMockStartErasureExpectation
+	expectations       []*ErasureRepositoryMockStartErasureExpectation
+
+	callArgs []*ErasureRepositoryMockStartErasureParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ErasureRepositoryMockStartErasureExpectation specifies expectation struct of the ErasureRepository.StartErasure
+type ErasureRepositoryMockStartErasureExpectation struct {
+	mock               *ErasureRepositoryMock
+	params             *ErasureRepositoryMockStartErasureParams
+	paramPtrs          *ErasureRepositoryMockStartErasureParamPtrs
+	expectationOrigins ErasureRepositoryMockStartErasureExpectationOrigins
+	results            *ErasureRepositoryMockStartErasureResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ErasureRepositoryMockStartErasureParams contains parameters of the ErasureRepository.StartErasure
+type ErasureRepositoryMockStartErasureParams struct {
+	ctx       context.Context
+	userID    string
+	startedAt time.Time
+}
+
+// ErasureRepositoryMockStartErasureParamPtrs contains pointers to parameters of the ErasureRepository.StartErasure
+type ErasureRepositoryMockStartErasureParamPtrs struct {
+	ctx       *context.Context
+	userID    *string
+	startedAt *time.Time
+}
+
+// ErasureRepositoryMockStartErasureResults contains results of the ErasureRepository.StartErasure
+type ErasureRepositoryMockStartErasureResults struct {
+	e1  mm_repository.Erasure
+	err error
+}
+
+// ErasureRepositoryMockStartErasureOrigins contains origins of expectations of the ErasureRepository.StartErasure
+type ErasureRepositoryMockStartErasureExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUserID    string
+	originStartedAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmStartErasure *mErasureRepositoryMockStartErasure) Optional() *mErasureRepositoryMockStartErasure {
+	mmStartErasure.optional = true
+	return mmStartErasure
+}
+
+// Expect sets up expected params for ErasureRepository.StartErasure
+func (mmStartErasure *mErasureRepositoryMockStartErasure) Expect(ctx context.Context, userID string, startedAt time.Time) *mErasureRepositoryMockStartErasure {
+	if mmStartErasure.mock.funcStartErasure != nil {
+		mmStartErasure.mock.t.Fatalf("ErasureRepositoryMock.StartErasure mock is already set by Set")
+	}
+
+	if mmStartErasure.defaultExpectation == nil {
+		mmStartErasure.defaultExpectation = &ErasureRepositoryMockStartErasureExpectation{}
+	}
+
+	if mmStartErasure.defaultExpectation.paramPtrs != nil {
+		mmStartErasure.mock.t.Fatalf("ErasureRepositoryMock.StartErasure mock is already set by ExpectParams functions")
+	}
+
+	mmStartErasure.defaultExpectation.params = &ErasureRepositoryMockStartErasureParams{ctx, userID, startedAt}
+	mmStartErasure.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmStartErasure.expectations {
+		if minimock.Equal(e.params, mmStartErasure.defaultExpectation.params) {
+			mmStartErasure.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmStartErasure.defaultExpectation.params)
+		}
+	}
+
+	return mmStartErasure
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ErasureRepository.StartErasure
+func (mmStartErasure *mErasureRepositoryMockStartErasure) ExpectCtxParam1(ctx context.Context) *mErasureRepositoryMockStartErasure {
+	if mmStartErasure.mock.funcStartErasure != nil {
+		mmStartErasure.mock.t.Fatalf("ErasureRepositoryMock.StartErasure mock is already set by Set")
+	}
+
+	if mmStartErasure.defaultExpectation == nil {
+		mmStartErasure.defaultExpectation = &ErasureRepositoryMockStartErasureExpectation{}
+	}
+
+	if mmStartErasure.defaultExpectation.params != nil {
+		mmStartErasure.mock.t.Fatalf("ErasureRepositoryMock.StartErasure mock is already set by Expect")
+	}
+
+	if mmStartErasure.defaultExpectation.paramPtrs == nil {
+		mmStartErasure.defaultExpectation.paramPtrs = &ErasureRepositoryMockStartErasureParamPtrs{}
+	}
+	mmStartErasure.defaultExpectation.paramPtrs.ctx = &ctx
+	mmStartErasure.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmStartErasure
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ErasureRepository.StartErasure
+func (mmStartErasure *mErasureRepositoryMockStartErasure) ExpectUserIDParam2(userID string) *mErasureRepositoryMockStartErasure {
+	if mmStartErasure.mock.funcStartErasure != nil {
+		mmStartErasure.mock.t.Fatalf("ErasureRepositoryMock.StartErasure mock is already set by Set")
+	}
+
+	if mmStartErasure.defaultExpectation == nil {
+		mmStartErasure.defaultExpectation = &ErasureRepositoryMockStartErasureExpectation{}
+	}
+
+	if mmStartErasure.defaultExpectation.params != nil {
+		mmStartErasure.mock.t.Fatalf("ErasureRepositoryMock.StartErasure mock is already set by Expect")
+	}
+
+	if mmStartErasure.defaultExpectation.paramPtrs == nil {
+		mmStartErasure.defaultExpectation.paramPtrs = &ErasureRepositoryMockStartErasureParamPtrs{}
+	}
+	mmStartErasure.defaultExpectation.paramPtrs.userID = &userID
+	mmStartErasure.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmStartErasure
+}
+
+// ExpectStartedAtParam3 sets up expected param startedAt for ErasureRepository.StartErasure
+func (mmStartErasure *mErasureRepositoryMockStartErasure) ExpectStartedAtParam3(startedAt time.Time) *mErasureRepositoryMockStartErasure {
+	if mmStartErasure.mock.funcStartErasure != nil {
+		mmStartErasure.mock.t.Fatalf("ErasureRepositoryMock.StartErasure mock is already set by Set")
+	}
+
+	if mmStartErasure.defaultExpectation == nil {
+		mmStartErasure.defaultExpectation = &ErasureRepositoryMockStartErasureExpectation{}
+	}
+
+	if mmStartErasure.defaultExpectation.params != nil {
+		mmStartErasure.mock.t.Fatalf("ErasureRepositoryMock.StartErasure mock is already set by Expect")
+	}
+
+	if mmStartErasure.defaultExpectation.paramPtrs == nil {
+		mmStartErasure.defaultExpectation.paramPtrs = &ErasureRepositoryMockStartErasureParamPtrs{}
+	}
+	mmStartErasure.defaultExpectation.paramPtrs.startedAt = &startedAt
+	mmStartErasure.defaultExpectation.expectationOrigins.originStartedAt = minimock.CallerInfo(1)
+
+	return mmStartErasure
+}
+
+// Inspect accepts an inspector function that has same arguments as the ErasureRepository.StartErasure
+func (mmStartErasure *mErasureRepositoryMockStartErasure) Inspect(f func(ctx context.Context, userID string, startedAt time.Time)) *mErasureRepositoryMockStartErasure {
+	if mmStartErasure.mock.inspectFuncStartErasure != nil {
+		mmStartErasure.mock.t.Fatalf("Inspect function is already set for ErasureRepositoryMock.StartErasure")
+	}
+
+	mmStartErasure.mock.inspectFuncStartErasure = f
+
+	return mmStartErasure
+}
+
+// Return sets up results that will be returned by ErasureRepository.StartErasure
+func (mmStartErasure *mErasureRepositoryMockStartErasure) Return(e1 mm_repository.Erasure, err error) *ErasureRepositoryMock {
+	if mmStartErasure.mock.funcStartErasure != nil {
+		mmStartErasure.mock.t.Fatalf("ErasureRepositoryMock.StartErasure mock is already set by Set")
+	}
+
+	if mmStartErasure.defaultExpectation == nil {
+		mmStartErasure.defaultExpectation = &ErasureRepositoryMockStartErasureExpectation{mock: mmStartErasure.mock}
+	}
+	mmStartErasure.defaultExpectation.results = &ErasureRepositoryMockStartErasureResults{e1, err}
+	mmStartErasure.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmStartErasure.mock
+}
+
+// Set uses given function f to mock the ErasureRepository.StartErasure method
+func (mmStartErasure *mErasureRepositoryMockStartErasure) Set(f func(ctx context.Context, userID string, startedAt time.Time) (e1 mm_repository.Erasure, err error)) *ErasureRepositoryMock {
+	if mmStartErasure.defaultExpectation != nil {
+		mmStartErasure.mock.t.Fatalf("Default expectation is already set for the ErasureRepository.StartErasure method")
+	}
+
+	if len(mmStartErasure.expectations) > 0 {
+		mmStartErasure.mock.t.Fatalf("Some expectations are already set for the ErasureRepository.StartErasure method")
+	}
+
+	mmStartErasure.mock.funcStartErasure = f
+	mmStartErasure.mock.funcStartErasureOrigin = minimock.CallerInfo(1)
+	return mmStartErasure.mock
+}
+
+// When sets expectation for the ErasureRepository.StartErasure which will trigger the result defined by the following
+// Then helper
+func (mmStartErasure *mErasureRepositoryMockStartErasure) When(ctx context.Context, userID string, startedAt time.Time) *ErasureRepositoryMockStartErasureExpectation {
+	if mmStartErasure.mock.funcStartErasure != nil {
+		mmStartErasure.mock.t.Fatalf("ErasureRepositoryMock.StartErasure mock is already set by Set")
+	}
+
+	expectation := &ErasureRepositoryMockStartErasureExpectation{
+		mock:               mmStartErasure.mock,
+		params:             &ErasureRepositoryMockStartErasureParams{ctx, userID, startedAt},
+		expectationOrigins: ErasureRepositoryMockStartErasureExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmStartErasure.expectations = append(mmStartErasure.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ErasureRepository.StartErasure return parameters for the expectation previously defined by the When method
+func (e *ErasureRepositoryMockStartErasureExpectation) Then(e1 mm_repository.Erasure, err error) *ErasureRepositoryMock {
+	e.results = &ErasureRepositoryMockStartErasureResults{e1, err}
+	return e.mock
+}
+
+// Times sets number of times ErasureRepository.StartErasure should be invoked
+func (mmStartErasure *mErasureRepositoryMockStartErasure) Times(n uint64) *mErasureRepositoryMockStartErasure {
+	if n == 0 {
+		mmStartErasure.mock.t.Fatalf("Times of ErasureRepositoryMock.StartErasure mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmStartErasure.expectedInvocations, n)
+	mmStartErasure.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmStartErasure
+}
+
+func (mmStartErasure *mErasureRepositoryMockStartErasure) invocationsDone() bool {
+	if len(mmStartErasure.expectations) == 0 && mmStartErasure.defaultExpectation == nil && mmStartErasure.mock.funcStartErasure == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmStartErasure.mock.afterStartErasureCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmStartErasure.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// StartErasure implements mm_repository.ErasureRepository
+func (mmStartErasure *ErasureRepositoryMock) StartErasure(ctx context.Context, userID string, startedAt time.Time) (e1 mm_repository.Erasure, err error) {
+	mm_atomic.AddUint64(&mmStartErasure.beforeStartErasureCounter, 1)
+	defer mm_atomic.AddUint64(&mmStartErasure.afterStartErasureCounter, 1)
+
+	mmStartErasure.t.Helper()
+
+	if mmStartErasure.inspectFuncStartErasure != nil {
+		mmStartErasure.inspectFuncStartErasure(ctx, userID, startedAt)
+	}
+
+	mm_params := ErasureRepositoryMockStartErasureParams{ctx, userID, startedAt}
+
+	// Record call args
+	mmStartErasure.StartErasureMock.mutex.Lock()
+	mmStartErasure.StartErasureMock.callArgs = append(mmStartErasure.StartErasureMock.callArgs, &mm_params)
+	mmStartErasure.StartErasureMock.mutex.Unlock()
+
+	for _, e := range mmStartErasure.StartErasureMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.e1, e.results.err
+		}
+	}
+
+	if mmStartErasure.StartErasureMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStartErasure.StartErasureMock.defaultExpectation.Counter, 1)
+		mm_want := mmStartErasure.StartErasureMock.defaultExpectation.params
+		mm_want_ptrs := mmStartErasure.StartErasureMock.defaultExpectation.paramPtrs
+
+		mm_got := ErasureRepositoryMockStartErasureParams{ctx, userID, startedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmStartErasure.t.Errorf("ErasureRepositoryMock.StartErasure got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStartErasure.StartErasureMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmStartErasure.t.Errorf("ErasureRepositoryMock.StartErasure got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStartErasure.StartErasureMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.startedAt != nil && !minimock.Equal(*mm_want_ptrs.startedAt, mm_got.startedAt) {
+				mmStartErasure.t.Errorf("ErasureRepositoryMock.StartErasure got unexpected parameter startedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStartErasure.StartErasureMock.defaultExpectation.expectationOrigins.originStartedAt, *mm_want_ptrs.startedAt, mm_got.startedAt, minimock.Diff(*mm_want_ptrs.startedAt, mm_got.startedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmStartErasure.t.Errorf("ErasureRepositoryMock.StartErasure got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmStartErasure.StartErasureMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmStartErasure.StartErasureMock.defaultExpectation.results
+		if mm_results == nil {
+			mmStartErasure.t.Fatal("No results are set for the ErasureRepositoryMock.StartErasure")
+		}
+		return (*mm_results).e1, (*mm_results).err
+	}
+	if mmStartErasure.funcStartErasure != nil {
+		return mmStartErasure.funcStartErasure(ctx, userID, startedAt)
+	}
+	mmStartErasure.t.Fatalf("Unexpected call to ErasureRepositoryMock.StartErasure. %v %v %v", ctx, userID, startedAt)
+	return
+}
+
+// StartErasureAfterCounter returns a count of finished ErasureRepositoryMock.StartErasure invocations
+func (mmStartErasure *ErasureRepositoryMock) StartErasureAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStartErasure.afterStartErasureCounter)
+}
+
+// StartErasureBeforeCounter returns a count of ErasureRepositoryMock.StartErasure invocations
+func (mmStartErasure *ErasureRepositoryMock) StartErasureBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStartErasure.beforeStartErasureCounter)
+}
+
+// Calls returns a list of arguments used in each call to ErasureRepositoryMock.StartErasure.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmStartErasure *mErasureRepositoryMockStartErasure) Calls() []*ErasureRepositoryMockStartErasureParams {
+	mmStartErasure.mutex.RLock()
+
+	argCopy := make([]*ErasureRepositoryMockStartErasureParams, len(mmStartErasure.callArgs))
+	copy(argCopy, mmStartErasure.callArgs)
+
+	mmStartErasure.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockStartErasureDone returns true if the count of the StartErasure invocations corresponds
+// the number of defined expectations
+func (m *ErasureRepositoryMock) MinimockStartErasureDone() bool {
+	if m.StartErasureMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.StartErasureMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.StartErasureMock.invocationsDone()
+}
+
+// MinimockStartErasureInspect logs each unmet expectation
+func (m *ErasureRepositoryMock) MinimockStartErasureInspect() {
+	for _, e := range m.StartErasureMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.StartErasure at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterStartErasureCounter := mm_atomic.LoadUint64(&m.afterStartErasureCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StartErasureMock.defaultExpectation != nil && afterStartErasureCounter < 1 {
+		if m.StartErasureMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.StartErasure at\n%s", m.StartErasureMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ErasureRepositoryMock.StartErasure at\n%s with params: %#v", m.StartErasureMock.defaultExpectation.expectationOrigins.origin, *m.StartErasureMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStartErasure != nil && afterStartErasureCounter < 1 {
+		m.t.Errorf("Expected call to ErasureRepositoryMock.StartErasure at\n%s", m.funcStartErasureOrigin)
+	}
+
+	if !m.StartErasureMock.invocationsDone() && afterStartErasureCounter > 0 {
+		m.t.Errorf("Expected %d calls to ErasureRepositoryMock.StartErasure at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.StartErasureMock.expectedInvocations), m.StartErasureMock.expectedInvocationsOrigin, afterStartErasureCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ErasureRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddMoviesInspect()
+
+			m.MinimockCompleteStepInspect()
+
+			m.MinimockFinishErasureInspect()
+
+			m.MinimockListErasuresInspect()
+
+			m.MinimockStartErasureInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ErasureRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ErasureRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddMoviesDone() &&
+		m.MinimockCompleteStepDone() &&
+		m.MinimockFinishErasureDone() &&
+		m.MinimockListErasuresDone() &&
+		m.MinimockStartErasureDone()
+}
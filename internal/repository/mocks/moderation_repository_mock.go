@@ -0,0 +1,868 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/maisiq/go-ugc-service/internal/repository.ModerationRepository -o moderation_repository_mock.go -n ModerationRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_repository "github.com/maisiq/go-ugc-service/internal/repository"
+)
+
+// ModerationRepositoryMock implements mm_repository.ModerationRepository
+type ModerationRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcListDeleted          func(ctx context.Context, before time.Time, limit int) (ra1 []mm_repository.Review, err error)
+	funcListDeletedOrigin    string
+	inspectFuncListDeleted   func(ctx context.Context, before time.Time, limit int)
+	afterListDeletedCounter  uint64
+	beforeListDeletedCounter uint64
+	ListDeletedMock          mModerationRepositoryMockListDeleted
+
+	funcListReviews          func(ctx context.Context, statuses []mm_repository.ReviewStatus, offset int, limit int) (ra1 []mm_repository.Review, err error)
+	funcListReviewsOrigin    string
+	inspectFuncListReviews   func(ctx context.Context, statuses []mm_repository.ReviewStatus, offset int, limit int)
+	afterListReviewsCounter  uint64
+	beforeListReviewsCounter uint64
+	ListReviewsMock          mModerationRepositoryMockListReviews
+}
+
+// NewModerationRepositoryMock returns a mock for mm_repository.ModerationRepository
+func NewModerationRepositoryMock(t minimock.Tester) *ModerationRepositoryMock {
+	m := &ModerationRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ListDeletedMock = mModerationRepositoryMockListDeleted{mock: m}
+	m.ListDeletedMock.callArgs = []*ModerationRepositoryMockListDeletedParams{}
+
+	m.ListReviewsMock = mModerationRepositoryMockListReviews{mock: m}
+	m.ListReviewsMock.callArgs = []*ModerationRepositoryMockListReviewsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mModerationRepositoryMockListDeleted struct {
+	optional           bool
+	mock               *ModerationRepositoryMock
+	defaultExpectation *ModerationRepositoryMockListDeletedExpectation
+	expectations       []*ModerationRepositoryMockListDeletedExpectation
+
+	callArgs []*ModerationRepositoryMockListDeletedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ModerationRepositoryMockListDeletedExpectation specifies expectation struct of the ModerationRepository.ListDeleted
+type ModerationRepositoryMockListDeletedExpectation struct {
+	mock               *ModerationRepositoryMock
+	params             *ModerationRepositoryMockListDeletedParams
+	paramPtrs          *ModerationRepositoryMockListDeletedParamPtrs
+	expectationOrigins ModerationRepositoryMockListDeletedExpectationOrigins
+	results            *ModerationRepositoryMockListDeletedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ModerationRepositoryMockListDeletedParams contains parameters of the ModerationRepository.ListDeleted
+type ModerationRepositoryMockListDeletedParams struct {
+	ctx    context.Context
+	before time.Time
+	limit  int
+}
+
+// ModerationRepositoryMockListDeletedParamPtrs contains pointers to parameters of the ModerationRepository.ListDeleted
+type ModerationRepositoryMockListDeletedParamPtrs struct {
+	ctx    *context.Context
+	before *time.Time
+	limit  *int
+}
+
+// ModerationRepositoryMockListDeletedResults contains results of the ModerationRepository.ListDeleted
+type ModerationRepositoryMockListDeletedResults struct {
+	ra1 []mm_repository.Review
+	err error
+}
+
+// ModerationRepositoryMockListDeletedOrigins contains origins of expectations of the ModerationRepository.ListDeleted
+type ModerationRepositoryMockListDeletedExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originBefore string
+	originLimit  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListDeleted *mModerationRepositoryMockListDeleted) Optional() *mModerationRepositoryMockListDeleted {
+	mmListDeleted.optional = true
+	return mmListDeleted
+}
+
+// Expect sets up expected params for ModerationRepository.ListDeleted
+func (mmListDeleted *mModerationRepositoryMockListDeleted) Expect(ctx context.Context, before time.Time, limit int) *mModerationRepositoryMockListDeleted {
+	if mmListDeleted.mock.funcListDeleted != nil {
+		mmListDeleted.mock.t.Fatalf("ModerationRepositoryMock.ListDeleted mock is already set by Set")
+	}
+
+	if mmListDeleted.defaultExpectation == nil {
+		mmListDeleted.defaultExpectation = &ModerationRepositoryMockListDeletedExpectation{}
+	}
+
+	if mmListDeleted.defaultExpectation.paramPtrs != nil {
+		mmListDeleted.mock.t.Fatalf("ModerationRepositoryMock.ListDeleted mock is already set by ExpectParams functions")
+	}
+
+	mmListDeleted.defaultExpectation.params = &ModerationRepositoryMockListDeletedParams{ctx, before, limit}
+	mmListDeleted.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListDeleted.expectations {
+		if minimock.Equal(e.params, mmListDeleted.defaultExpectation.params) {
+			mmListDeleted.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListDeleted.defaultExpectation.params)
+		}
+	}
+
+	return mmListDeleted
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ModerationRepository.ListDeleted
+func (mmListDeleted *mModerationRepositoryMockListDeleted) ExpectCtxParam1(ctx context.Context) *mModerationRepositoryMockListDeleted {
+	if mmListDeleted.mock.funcListDeleted != nil {
+		mmListDeleted.mock.t.Fatalf("ModerationRepositoryMock.ListDeleted mock is already set by Set")
+	}
+
+	if mmListDeleted.defaultExpectation == nil {
+		mmListDeleted.defaultExpectation = &ModerationRepositoryMockListDeletedExpectation{}
+	}
+
+	if mmListDeleted.defaultExpectation.params != nil {
+		mmListDeleted.mock.t.Fatalf("ModerationRepositoryMock.ListDeleted mock is already set by Expect")
+	}
+
+	if mmListDeleted.defaultExpectation.paramPtrs == nil {
+		mmListDeleted.defaultExpectation.paramPtrs = &ModerationRepositoryMockListDeletedParamPtrs{}
+	}
+	mmListDeleted.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListDeleted.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListDeleted
+}
+
+// ExpectBeforeParam2 sets up expected param before for ModerationRepository.ListDeleted
+func (mmListDeleted *mModerationRepositoryMockListDeleted) ExpectBeforeParam2(before time.Time) *mModerationRepositoryMockListDeleted {
+	if mmListDeleted.mock.funcListDeleted != nil {
+		mmListDeleted.mock.t.Fatalf("ModerationRepositoryMock.ListDeleted mock is already set by Set")
+	}
+
+	if mmListDeleted.defaultExpectation == nil {
+		mmListDeleted.defaultExpectation = &ModerationRepositoryMockListDeletedExpectation{}
+	}
+
+	if mmListDeleted.defaultExpectation.params != nil {
+		mmListDeleted.mock.t.Fatalf("ModerationRepositoryMock.ListDeleted mock is already set by Expect")
+	}
+
+	if mmListDeleted.defaultExpectation.paramPtrs == nil {
+		mmListDeleted.defaultExpectation.paramPtrs = &ModerationRepositoryMockListDeletedParamPtrs{}
+	}
+	mmListDeleted.defaultExpectation.paramPtrs.before = &before
+	mmListDeleted.defaultExpectation.expectationOrigins.originBefore = minimock.CallerInfo(1)
+
+	return mmListDeleted
+}
+
+// ExpectLimitParam3 sets up expected param limit for ModerationRepository.ListDeleted
+func (mmListDeleted *mModerationRepositoryMockListDeleted) ExpectLimitParam3(limit int) *mModerationRepositoryMockListDeleted {
+	if mmListDeleted.mock.funcListDeleted != nil {
+		mmListDeleted.mock.t.Fatalf("ModerationRepositoryMock.ListDeleted mock is already set by Set")
+	}
+
+	if mmListDeleted.defaultExpectation == nil {
+		mmListDeleted.defaultExpectation = &ModerationRepositoryMockListDeletedExpectation{}
+	}
+
+	if mmListDeleted.defaultExpectation.params != nil {
+		mmListDeleted.mock.t.Fatalf("ModerationRepositoryMock.ListDeleted mock is already set by Expect")
+	}
+
+	if mmListDeleted.defaultExpectation.paramPtrs == nil {
+		mmListDeleted.defaultExpectation.paramPtrs = &ModerationRepositoryMockListDeletedParamPtrs{}
+	}
+	mmListDeleted.defaultExpectation.paramPtrs.limit = &limit
+	mmListDeleted.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListDeleted
+}
+
+// Inspect accepts an inspector function that has same arguments as the ModerationRepository.ListDeleted
+func (mmListDeleted *mModerationRepositoryMockListDeleted) Inspect(f func(ctx context.Context, before time.Time, limit int)) *mModerationRepositoryMockListDeleted {
+	if mmListDeleted.mock.inspectFuncListDeleted != nil {
+		mmListDeleted.mock.t.Fatalf("Inspect function is already set for ModerationRepositoryMock.ListDeleted")
+	}
+
+	mmListDeleted.mock.inspectFuncListDeleted = f
+
+	return mmListDeleted
+}
+
+// Return sets up results that will be returned by ModerationRepository.ListDeleted
+func (mmListDeleted *mModerationRepositoryMockListDeleted) Return(ra1 []mm_repository.Review, err error) *ModerationRepositoryMock {
+	if mmListDeleted.mock.funcListDeleted != nil {
+		mmListDeleted.mock.t.Fatalf("ModerationRepositoryMock.ListDeleted mock is already set by Set")
+	}
+
+	if mmListDeleted.defaultExpectation == nil {
+		mmListDeleted.defaultExpectation = &ModerationRepositoryMockListDeletedExpectation{mock: mmListDeleted.mock}
+	}
+	mmListDeleted.defaultExpectation.results = &ModerationRepositoryMockListDeletedResults{ra1, err}
+	mmListDeleted.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListDeleted.mock
+}
+
+// Set uses given function f to mock the ModerationRepository.ListDeleted method
+func (mmListDeleted *mModerationRepositoryMockListDeleted) Set(f func(ctx context.Context, before time.Time, limit int) (ra1 []mm_repository.Review, err error)) *ModerationRepositoryMock {
+	if mmListDeleted.defaultExpectation != nil {
+		mmListDeleted.mock.t.Fatalf("Default expectation is already set for the ModerationRepository.ListDeleted method")
+	}
+
+	if len(mmListDeleted.expectations) > 0 {
+		mmListDeleted.mock.t.Fatalf("Some expectations are already set for the ModerationRepository.ListDeleted method")
+	}
+
+	mmListDeleted.mock.funcListDeleted = f
+	mmListDeleted.mock.funcListDeletedOrigin = minimock.CallerInfo(1)
+	return mmListDeleted.mock
+}
+
+// When sets expectation for the ModerationRepository.ListDeleted which will trigger the result defined by the following
+// Then helper
+func (mmListDeleted *mModerationRepositoryMockListDeleted) When(ctx context.Context, before time.Time, limit int) *ModerationRepositoryMockListDeletedExpectation {
+	if mmListDeleted.mock.funcListDeleted != nil {
+		mmListDeleted.mock.t.Fatalf("ModerationRepositoryMock.ListDeleted mock is already set by Set")
+	}
+
+	expectation := &ModerationRepositoryMockListDeletedExpectation{
+		mock:               mmListDeleted.mock,
+		params:             &ModerationRepositoryMockListDeletedParams{ctx, before, limit},
+		expectationOrigins: ModerationRepositoryMockListDeletedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListDeleted.expectations = append(mmListDeleted.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ModerationRepository.ListDeleted return parameters for the expectation previously defined by the When method
+func (e *ModerationRepositoryMockListDeletedExpectation) Then(ra1 []mm_repository.Review, err error) *ModerationRepositoryMock {
+	e.results = &ModerationRepositoryMockListDeletedResults{ra1, err}
+	return e.mock
+}
+
+// Times sets number of times ModerationRepository.ListDeleted should be invoked
+func (mmListDeleted *mModerationRepositoryMockListDeleted) Times(n uint64) *mModerationRepositoryMockListDeleted {
+	if n == 0 {
+		mmListDeleted.mock.t.Fatalf("Times of ModerationRepositoryMock.ListDeleted mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListDeleted.expectedInvocations, n)
+	mmListDeleted.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListDeleted
+}
+
+func (mmListDeleted *mModerationRepositoryMockListDeleted) invocationsDone() bool {
+	if len(mmListDeleted.expectations) == 0 && mmListDeleted.defaultExpectation == nil && mmListDeleted.mock.funcListDeleted == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListDeleted.mock.afterListDeletedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListDeleted.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListDeleted implements mm_repository.ModerationRepository
+func (mmListDeleted *ModerationRepositoryMock) ListDeleted(ctx context.Context, before time.Time, limit int) (ra1 []mm_repository.Review, err error) {
+	mm_atomic.AddUint64(&mmListDeleted.beforeListDeletedCounter, 1)
+	defer mm_atomic.AddUint64(&mmListDeleted.afterListDeletedCounter, 1)
+
+	mmListDeleted.t.Helper()
+
+	if mmListDeleted.inspectFuncListDeleted != nil {
+		mmListDeleted.inspectFuncListDeleted(ctx, before, limit)
+	}
+
+	mm_params := ModerationRepositoryMockListDeletedParams{ctx, before, limit}
+
+	// Record call args
+	mmListDeleted.ListDeletedMock.mutex.Lock()
+	mmListDeleted.ListDeletedMock.callArgs = append(mmListDeleted.ListDeletedMock.callArgs, &mm_params)
+	mmListDeleted.ListDeletedMock.mutex.Unlock()
+
+	for _, e := range mmListDeleted.ListDeletedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmListDeleted.ListDeletedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListDeleted.ListDeletedMock.defaultExpectation.Counter, 1)
+		mm_want := mmListDeleted.ListDeletedMock.defaultExpectation.params
+		mm_want_ptrs := mmListDeleted.ListDeletedMock.defaultExpectation.paramPtrs
+
+		mm_got := ModerationRepositoryMockListDeletedParams{ctx, before, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListDeleted.t.Errorf("ModerationRepositoryMock.ListDeleted got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListDeleted.ListDeletedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.before != nil && !minimock.Equal(*mm_want_ptrs.before, mm_got.before) {
+				mmListDeleted.t.Errorf("ModerationRepositoryMock.ListDeleted got unexpected parameter before, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListDeleted.ListDeletedMock.defaultExpectation.expectationOrigins.originBefore, *mm_want_ptrs.before, mm_got.before, minimock.Diff(*mm_want_ptrs.before, mm_got.before))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListDeleted.t.Errorf("ModerationRepositoryMock.ListDeleted got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListDeleted.ListDeletedMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListDeleted.t.Errorf("ModerationRepositoryMock.ListDeleted got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListDeleted.ListDeletedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListDeleted.ListDeletedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListDeleted.t.Fatal("No results are set for the ModerationRepositoryMock.ListDeleted")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmListDeleted.funcListDeleted != nil {
+		return mmListDeleted.funcListDeleted(ctx, before, limit)
+	}
+	mmListDeleted.t.Fatalf("Unexpected call to ModerationRepositoryMock.ListDeleted. %v %v %v", ctx, before, limit)
+	return
+}
+
+// ListDeletedAfterCounter returns a count of finished ModerationRepositoryMock.ListDeleted invocations
+func (mmListDeleted *ModerationRepositoryMock) ListDeletedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListDeleted.afterListDeletedCounter)
+}
+
+// ListDeletedBeforeCounter returns a count of ModerationRepositoryMock.ListDeleted invocations
+func (mmListDeleted *ModerationRepositoryMock) ListDeletedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListDeleted.beforeListDeletedCounter)
+}
+
+// Calls returns a list of arguments used in each call to ModerationRepositoryMock.ListDeleted.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListDeleted *mModerationRepositoryMockListDeleted) Calls() []*ModerationRepositoryMockListDeletedParams {
+	mmListDeleted.mutex.RLock()
+
+	argCopy := make([]*ModerationRepositoryMockListDeletedParams, len(mmListDeleted.callArgs))
+	copy(argCopy, mmListDeleted.callArgs)
+
+	mmListDeleted.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDeletedDone returns true if the count of the ListDeleted invocations corresponds
+// the number of defined expectations
+func (m *ModerationRepositoryMock) MinimockListDeletedDone() bool {
+	if m.ListDeletedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListDeletedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListDeletedMock.invocationsDone()
+}
+
+// MinimockListDeletedInspect logs each unmet expectation
+func (m *ModerationRepositoryMock) MinimockListDeletedInspect() {
+	for _, e := range m.ListDeletedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ModerationRepositoryMock.ListDeleted at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListDeletedCounter := mm_atomic.LoadUint64(&m.afterListDeletedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListDeletedMock.defaultExpectation != nil && afterListDeletedCounter < 1 {
+		if m.ListDeletedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ModerationRepositoryMock.ListDeleted at\n%s", m.ListDeletedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ModerationRepositoryMock.ListDeleted at\n%s with params: %#v", m.ListDeletedMock.defaultExpectation.expectationOrigins.origin, *m.ListDeletedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListDeleted != nil && afterListDeletedCounter < 1 {
+		m.t.Errorf("Expected call to ModerationRepositoryMock.ListDeleted at\n%s", m.funcListDeletedOrigin)
+	}
+
+	if !m.ListDeletedMock.invocationsDone() && afterListDeletedCounter > 0 {
+		m.t.Errorf("Expected %d calls to ModerationRepositoryMock.ListDeleted at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListDeletedMock.expectedInvocations), m.ListDeletedMock.expectedInvocationsOrigin, afterListDeletedCounter)
+	}
+}
+
+type mModerationRepositoryMockListReviews struct {
+	optional           bool
+	mock               *ModerationRepositoryMock
+	defaultExpectation *ModerationRepositoryMockListReviewsExpectation
+	expectations       []*ModerationRepositoryMockListReviewsExpectation
+
+	callArgs []*ModerationRepositoryMockListReviewsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ModerationRepositoryMockListReviewsExpectation specifies expectation struct of the ModerationRepository.ListReviews
+type ModerationRepositoryMockListReviewsExpectation struct {
+	mock               *ModerationRepositoryMock
+	params             *ModerationRepositoryMockListReviewsParams
+	paramPtrs          *ModerationRepositoryMockListReviewsParamPtrs
+	expectationOrigins ModerationRepositoryMockListReviewsExpectationOrigins
+	results            *ModerationRepositoryMockListReviewsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ModerationRepositoryMockListReviewsParams contains parameters of the ModerationRepository.ListReviews
+type ModerationRepositoryMockListReviewsParams struct {
+	ctx      context.Context
+	statuses []mm_repository.ReviewStatus
+	offset   int
+	limit    int
+}
+
+// ModerationRepositoryMockListReviewsParamPtrs contains pointers to parameters of the ModerationRepository.ListReviews
+type ModerationRepositoryMockListReviewsParamPtrs struct {
+	ctx      *context.Context
+	statuses *[]mm_repository.ReviewStatus
+	offset   *int
+	limit    *int
+}
+
+// ModerationRepositoryMockListReviewsResults contains results of the ModerationRepository.ListReviews
+type ModerationRepositoryMockListReviewsResults struct {
+	ra1 []mm_repository.Review
+	err error
+}
+
+// ModerationRepositoryMockListReviewsOrigins contains origins of expectations of the ModerationRepository.ListReviews
+type ModerationRepositoryMockListReviewsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originStatuses string
+	originOffset   string
+	originLimit    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListReviews *mModerationRepositoryMockListReviews) Optional() *mModerationRepositoryMockListReviews {
+	mmListReviews.optional = true
+	return mmListReviews
+}
+
+// Expect sets up expected params for ModerationRepository.ListReviews
+func (mmListReviews *mModerationRepositoryMockListReviews) Expect(ctx context.Context, statuses []mm_repository.ReviewStatus, offset int, limit int) *mModerationRepositoryMockListReviews {
+	if mmListReviews.mock.funcListReviews != nil {
+		mmListReviews.mock.t.Fatalf("ModerationRepositoryMock.ListReviews mock is already set by Set")
+	}
+
+	if mmListReviews.defaultExpectation == nil {
+		mmListReviews.defaultExpectation = &ModerationRepositoryMockListReviewsExpectation{}
+	}
+
+	if mmListReviews.defaultExpectation.paramPtrs != nil {
+		mmListReviews.mock.t.Fatalf("ModerationRepositoryMock.ListReviews mock is already set by ExpectParams functions")
+	}
+
+	mmListReviews.defaultExpectation.params = &ModerationRepositoryMockListReviewsParams{ctx, statuses, offset, limit}
+	mmListReviews.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListReviews.expectations {
+		if minimock.Equal(e.params, mmListReviews.defaultExpectation.params) {
+			mmListReviews.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListReviews.defaultExpectation.params)
+		}
+	}
+
+	return mmListReviews
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ModerationRepository.ListReviews
+func (mmListReviews *mModerationRepositoryMockListReviews) ExpectCtxParam1(ctx context.Context) *mModerationRepositoryMockListReviews {
+	if mmListReviews.mock.funcListReviews != nil {
+		mmListReviews.mock.t.Fatalf("ModerationRepositoryMock.ListReviews mock is already set by Set")
+	}
+
+	if mmListReviews.defaultExpectation == nil {
+		mmListReviews.defaultExpectation = &ModerationRepositoryMockListReviewsExpectation{}
+	}
+
+	if mmListReviews.defaultExpectation.params != nil {
+		mmListReviews.mock.t.Fatalf("ModerationRepositoryMock.ListReviews mock is already set by Expect")
+	}
+
+	if mmListReviews.defaultExpectation.paramPtrs == nil {
+		mmListReviews.defaultExpectation.paramPtrs = &ModerationRepositoryMockListReviewsParamPtrs{}
+	}
+	mmListReviews.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListReviews.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListReviews
+}
+
+// ExpectStatusesParam2 sets up expected param statuses for ModerationRepository.ListReviews
+func (mmListReviews *mModerationRepositoryMockListReviews) ExpectStatusesParam2(statuses []mm_repository.ReviewStatus) *mModerationRepositoryMockListReviews {
+	if mmListReviews.mock.funcListReviews != nil {
+		mmListReviews.mock.t.Fatalf("ModerationRepositoryMock.ListReviews mock is already set by Set")
+	}
+
+	if mmListReviews.defaultExpectation == nil {
+		mmListReviews.defaultExpectation = &ModerationRepositoryMockListReviewsExpectation{}
+	}
+
+	if mmListReviews.defaultExpectation.params != nil {
+		mmListReviews.mock.t.Fatalf("ModerationRepositoryMock.ListReviews mock is already set by Expect")
+	}
+
+	if mmListReviews.defaultExpectation.paramPtrs == nil {
+		mmListReviews.defaultExpectation.paramPtrs = &ModerationRepositoryMockListReviewsParamPtrs{}
+	}
+	mmListReviews.defaultExpectation.paramPtrs.statuses = &statuses
+	mmListReviews.defaultExpectation.expectationOrigins.originStatuses = minimock.CallerInfo(1)
+
+	return mmListReviews
+}
+
+// ExpectOffsetParam3 sets up expected param offset for ModerationRepository.ListReviews
+func (mmListReviews *mModerationRepositoryMockListReviews) ExpectOffsetParam3(offset int) *mModerationRepositoryMockListReviews {
+	if mmListReviews.mock.funcListReviews != nil {
+		mmListReviews.mock.t.Fatalf("ModerationRepositoryMock.ListReviews mock is already set by Set")
+	}
+
+	if mmListReviews.defaultExpectation == nil {
+		mmListReviews.defaultExpectation = &ModerationRepositoryMockListReviewsExpectation{}
+	}
+
+	if mmListReviews.defaultExpectation.params != nil {
+		mmListReviews.mock.t.Fatalf("ModerationRepositoryMock.ListReviews mock is already set by Expect")
+	}
+
+	if mmListReviews.defaultExpectation.paramPtrs == nil {
+		mmListReviews.defaultExpectation.paramPtrs = &ModerationRepositoryMockListReviewsParamPtrs{}
+	}
+	mmListReviews.defaultExpectation.paramPtrs.offset = &offset
+	mmListReviews.defaultExpectation.expectationOrigins.originOffset = minimock.CallerInfo(1)
+
+	return mmListReviews
+}
+
+// ExpectLimitParam4 sets up expected param limit for ModerationRepository.ListReviews
+func (mmListReviews *mModerationRepositoryMockListReviews) ExpectLimitParam4(limit int) *mModerationRepositoryMockListReviews {
+	if mmListReviews.mock.funcListReviews != nil {
+		mmListReviews.mock.t.Fatalf("ModerationRepositoryMock.ListReviews mock is already set by Set")
+	}
+
+	if mmListReviews.defaultExpectation == nil {
+		mmListReviews.defaultExpectation = &ModerationRepositoryMockListReviewsExpectation{}
+	}
+
+	if mmListReviews.defaultExpectation.params != nil {
+		mmListReviews.mock.t.Fatalf("ModerationRepositoryMock.ListReviews mock is already set by Expect")
+	}
+
+	if mmListReviews.defaultExpectation.paramPtrs == nil {
+		mmListReviews.defaultExpectation.paramPtrs = &ModerationRepositoryMockListReviewsParamPtrs{}
+	}
+	mmListReviews.defaultExpectation.paramPtrs.limit = &limit
+	mmListReviews.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListReviews
+}
+
+// Inspect accepts an inspector function that has same arguments as the ModerationRepository.ListReviews
+func (mmListReviews *mModerationRepositoryMockListReviews) Inspect(f func(ctx context.Context, statuses []mm_repository.ReviewStatus, offset int, limit int)) *mModerationRepositoryMockListReviews {
+	if mmListReviews.mock.inspectFuncListReviews != nil {
+		mmListReviews.mock.t.Fatalf("Inspect function is already set for ModerationRepositoryMock.ListReviews")
+	}
+
+	mmListReviews.mock.inspectFuncListReviews = f
+
+	return mmListReviews
+}
+
+// Return sets up results that will be returned by ModerationRepository.ListReviews
+func (mmListReviews *mModerationRepositoryMockListReviews) Return(ra1 []mm_repository.Review, err error) *ModerationRepositoryMock {
+	if mmListReviews.mock.funcListReviews != nil {
+		mmListReviews.mock.t.Fatalf("ModerationRepositoryMock.ListReviews mock is already set by Set")
+	}
+
+	if mmListReviews.defaultExpectation == nil {
+		mmListReviews.defaultExpectation = &ModerationRepositoryMockListReviewsExpectation{mock: mmListReviews.mock}
+	}
+	mmListReviews.defaultExpectation.results = &ModerationRepositoryMockListReviewsResults{ra1, err}
+	mmListReviews.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListReviews.mock
+}
+
+// Set uses given function f to mock the ModerationRepository.ListReviews method
+func (mmListReviews *mModerationRepositoryMockListReviews) Set(f func(ctx context.Context, statuses []mm_repository.ReviewStatus, offset int, limit int) (ra1 []mm_repository.Review, err error)) *ModerationRepositoryMock {
+	if mmListReviews.defaultExpectation != nil {
+		mmListReviews.mock.t.Fatalf("Default expectation is already set for the ModerationRepository.ListReviews method")
+	}
+
+	if len(mmListReviews.expectations) > 0 {
+		mmListReviews.mock.t.Fatalf("Some expectations are already set for the ModerationRepository.ListReviews method")
+	}
+
+	mmListReviews.mock.funcListReviews = f
+	mmListReviews.mock.funcListReviewsOrigin = minimock.CallerInfo(1)
+	return mmListReviews.mock
+}
+
+// When sets expectation for the ModerationRepository.ListReviews which will trigger the result defined by the following
+// Then helper
+func (mmListReviews *mModerationRepositoryMockListReviews) When(ctx context.Context, statuses []mm_repository.ReviewStatus, offset int, limit int) *ModerationRepositoryMockListReviewsExpectation {
+	if mmListReviews.mock.funcListReviews != nil {
+		mmListReviews.mock.t.Fatalf("ModerationRepositoryMock.ListReviews mock is already set by Set")
+	}
+
+	expectation := &ModerationRepositoryMockListReviewsExpectation{
+		mock:               mmListReviews.mock,
+		params:             &ModerationRepositoryMockListReviewsParams{ctx, statuses, offset, limit},
+		expectationOrigins: ModerationRepositoryMockListReviewsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListReviews.expectations = append(mmListReviews.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ModerationRepository.ListReviews return parameters for the expectation previously defined by the When method
+func (e *ModerationRepositoryMockListReviewsExpectation) Then(ra1 []mm_repository.Review, err error) *ModerationRepositoryMock {
+	e.results = &ModerationRepositoryMockListReviewsResults{ra1, err}
+	return e.mock
+}
+
+// Times sets number of times ModerationRepository.ListReviews should be invoked
+func (mmListReviews *mModerationRepositoryMockListReviews) Times(n uint64) *mModerationRepositoryMockListReviews {
+	if n == 0 {
+		mmListReviews.mock.t.Fatalf("Times of ModerationRepositoryMock.ListReviews mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListReviews.expectedInvocations, n)
+	mmListReviews.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListReviews
+}
+
+func (mmListReviews *mModerationRepositoryMockListReviews) invocationsDone() bool {
+	if len(mmListReviews.expectations) == 0 && mmListReviews.defaultExpectation == nil && mmListReviews.mock.funcListReviews == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListReviews.mock.afterListReviewsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListReviews.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListReviews implements mm_repository.ModerationRepository
+func (mmListReviews *ModerationRepositoryMock) ListReviews(ctx context.Context, statuses []mm_repository.ReviewStatus, offset int, limit int) (ra1 []mm_repository.Review, err error) {
+	mm_atomic.AddUint64(&mmListReviews.beforeListReviewsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListReviews.afterListReviewsCounter, 1)
+
+	mmListReviews.t.Helper()
+
+	if mmListReviews.inspectFuncListReviews != nil {
+		mmListReviews.inspectFuncListReviews(ctx, statuses, offset, limit)
+	}
+
+	mm_params := ModerationRepositoryMockListReviewsParams{ctx, statuses, offset, limit}
+
+	// Record call args
+	mmListReviews.ListReviewsMock.mutex.Lock()
+	mmListReviews.ListReviewsMock.callArgs = append(mmListReviews.ListReviewsMock.callArgs, &mm_params)
+	mmListReviews.ListReviewsMock.mutex.Unlock()
+
+	for _, e := range mmListReviews.ListReviewsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmListReviews.ListReviewsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListReviews.ListReviewsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListReviews.ListReviewsMock.defaultExpectation.params
+		mm_want_ptrs := mmListReviews.ListReviewsMock.defaultExpectation.paramPtrs
+
+		mm_got := ModerationRepositoryMockListReviewsParams{ctx, statuses, offset, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListReviews.t.Errorf("ModerationRepositoryMock.ListReviews got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReviews.ListReviewsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.statuses != nil && !minimock.Equal(*mm_want_ptrs.statuses, mm_got.statuses) {
+				mmListReviews.t.Errorf("ModerationRepositoryMock.ListReviews got unexpected parameter statuses, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReviews.ListReviewsMock.defaultExpectation.expectationOrigins.originStatuses, *mm_want_ptrs.statuses, mm_got.statuses, minimock.Diff(*mm_want_ptrs.statuses, mm_got.statuses))
+			}
+
+			if mm_want_ptrs.offset != nil && !minimock.Equal(*mm_want_ptrs.offset, mm_got.offset) {
+				mmListReviews.t.Errorf("ModerationRepositoryMock.ListReviews got unexpected parameter offset, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReviews.ListReviewsMock.defaultExpectation.expectationOrigins.originOffset, *mm_want_ptrs.offset, mm_got.offset, minimock.Diff(*mm_want_ptrs.offset, mm_got.offset))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListReviews.t.Errorf("ModerationRepositoryMock.ListReviews got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReviews.ListReviewsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListReviews.t.Errorf("ModerationRepositoryMock.ListReviews got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListReviews.ListReviewsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListReviews.ListReviewsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListReviews.t.Fatal("No results are set for the ModerationRepositoryMock.ListReviews")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmListReviews.funcListReviews != nil {
+		return mmListReviews.funcListReviews(ctx, statuses, offset, limit)
+	}
+	mmListReviews.t.Fatalf("Unexpected call to ModerationRepositoryMock.ListReviews. %v %v %v %v", ctx, statuses, offset, limit)
+	return
+}
+
+// ListReviewsAfterCounter returns a count of finished ModerationRepositoryMock.ListReviews invocations
+func (mmListReviews *ModerationRepositoryMock) ListReviewsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListReviews.afterListReviewsCounter)
+}
+
+// ListReviewsBeforeCounter returns a count of ModerationRepositoryMock.ListReviews invocations
+func (mmListReviews *ModerationRepositoryMock) ListReviewsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListReviews.beforeListReviewsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ModerationRepositoryMock.ListReviews.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListReviews *mModerationRepositoryMockListReviews) Calls() []*ModerationRepositoryMockListReviewsParams {
+	mmListReviews.mutex.RLock()
+
+	argCopy := make([]*ModerationRepositoryMockListReviewsParams, len(mmListReviews.callArgs))
+	copy(argCopy, mmListReviews.callArgs)
+
+	mmListReviews.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListReviewsDone returns true if the count of the ListReviews invocations corresponds
+// the number of defined expectations
+func (m *ModerationRepositoryMock) MinimockListReviewsDone() bool {
+	if m.ListReviewsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListReviewsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListReviewsMock.invocationsDone()
+}
+
+// MinimockListReviewsInspect logs each unmet expectation
+func (m *ModerationRepositoryMock) MinimockListReviewsInspect() {
+	for _, e := range m.ListReviewsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ModerationRepositoryMock.ListReviews at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListReviewsCounter := mm_atomic.LoadUint64(&m.afterListReviewsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListReviewsMock.defaultExpectation != nil && afterListReviewsCounter < 1 {
+		if m.ListReviewsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ModerationRepositoryMock.ListReviews at\n%s", m.ListReviewsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ModerationRepositoryMock.ListReviews at\n%s with params: %#v", m.ListReviewsMock.defaultExpectation.expectationOrigins.origin, *m.ListReviewsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListReviews != nil && afterListReviewsCounter < 1 {
+		m.t.Errorf("Expected call to ModerationRepositoryMock.ListReviews at\n%s", m.funcListReviewsOrigin)
+	}
+
+	if !m.ListReviewsMock.invocationsDone() && afterListReviewsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ModerationRepositoryMock.ListReviews at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListReviewsMock.expectedInvocations), m.ListReviewsMock.expectedInvocationsOrigin, afterListReviewsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ModerationRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockListDeletedInspect()
+
+			m.MinimockListReviewsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ModerationRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ModerationRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockListDeletedDone() &&
+		m.MinimockListReviewsDone()
+}
@@ -0,0 +1,1222 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/maisiq/go-ugc-service/internal/repository.OutboxRepository -o outbox_repository_mock.go -n OutboxRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_repository "github.com/maisiq/go-ugc-service/internal/repository"
+)
+
+// OutboxRepositoryMock implements mm_repository.OutboxRepository
+type OutboxRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddEvents          func(ctx context.Context, events []mm_repository.OutboxEvent) (err error)
+	funcAddEventsOrigin    string
+	inspectFuncAddEvents   func(ctx context.Context, events []mm_repository.OutboxEvent)
+	afterAddEventsCounter  uint64
+	beforeAddEventsCounter uint64
+	AddEventsMock          mOutboxRepositoryMockAddEvents
+
+	funcClaimEvents          func(ctx context.Context, limit int, now time.Time, lockedUntil time.Time) (oa1 []mm_repository.OutboxEvent, err error)
+	funcClaimEventsOrigin    string
+	inspectFuncClaimEvents   func(ctx context.Context, limit int, now time.Time, lockedUntil time.Time)
+	afterClaimEventsCounter  uint64
+	beforeClaimEventsCounter uint64
+	ClaimEventsMock          mOutboxRepositoryMockClaimEvents
+
+	funcMarkSent          func(ctx context.Context, IDs []string, sentAt time.Time) (err error)
+	funcMarkSentOrigin    string
+	inspectFuncMarkSent   func(ctx context.Context, IDs []string, sentAt time.Time)
+	afterMarkSentCounter  uint64
+	beforeMarkSentCounter uint64
+	MarkSentMock          mOutboxRepositoryMockMarkSent
+}
+
+// NewOutboxRepositoryMock returns a mock for mm_repository.OutboxRepository
+func NewOutboxRepositoryMock(t minimock.Tester) *OutboxRepositoryMock {
+	m := &OutboxRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddEventsMock = mOutboxRepositoryMockAddEvents{mock: m}
+	m.AddEventsMock.callArgs = []*OutboxRepositoryMockAddEventsParams{}
+
+	m.ClaimEventsMock = mOutboxRepositoryMockClaimEvents{mock: m}
+	m.ClaimEventsMock.callArgs = []*OutboxRepositoryMockClaimEventsParams{}
+
+	m.MarkSentMock = mOutboxRepositoryMockMarkSent{mock: m}
+	m.MarkSentMock.callArgs = []*OutboxRepositoryMockMarkSentParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOutboxRepositoryMockAddEvents struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockAddEventsExpectation
+	expectations       []*OutboxRepositoryMockAddEventsExpectation
+
+	callArgs []*OutboxRepositoryMockAddEventsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxRepositoryMockAddEventsExpectation specifies expectation struct of the OutboxRepository.AddEvents
+type OutboxRepositoryMockAddEventsExpectation struct {
+	mock               *OutboxRepositoryMock
+	params             *OutboxRepositoryMockAddEventsParams
+	paramPtrs          *OutboxRepositoryMockAddEventsParamPtrs
+	expectationOrigins OutboxRepositoryMockAddEventsExpectationOrigins
+	results            *OutboxRepositoryMockAddEventsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxRepositoryMockAddEventsParams contains parameters of the OutboxRepository.AddEvents
+type OutboxRepositoryMockAddEventsParams struct {
+	ctx    context.Context
+	events []mm_repository.OutboxEvent
+}
+
+// OutboxRepositoryMockAddEventsParamPtrs contains pointers to parameters of the OutboxRepository.AddEvents
+type OutboxRepositoryMockAddEventsParamPtrs struct {
+	ctx    *context.Context
+	events *[]mm_repository.OutboxEvent
+}
+
+// OutboxRepositoryMockAddEventsResults contains results of the OutboxRepository.AddEvents
+type OutboxRepositoryMockAddEventsResults struct {
+	err error
+}
+
+// OutboxRepositoryMockAddEventsOrigins contains origins of expectations of the OutboxRepository.AddEvents
+type OutboxRepositoryMockAddEventsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originEvents string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddEvents *mOutboxRepositoryMockAddEvents) Optional() *mOutboxRepositoryMockAddEvents {
+	mmAddEvents.optional = true
+	return mmAddEvents
+}
+
+// Expect sets up expected params for OutboxRepository.AddEvents
+func (mmAddEvents *mOutboxRepositoryMockAddEvents) Expect(ctx context.Context, events []mm_repository.OutboxEvent) *mOutboxRepositoryMockAddEvents {
+	if mmAddEvents.mock.funcAddEvents != nil {
+		mmAddEvents.mock.t.Fatalf("OutboxRepositoryMock.AddEvents mock is already set by Set")
+	}
+
+	if mmAddEvents.defaultExpectation == nil {
+		mmAddEvents.defaultExpectation = &OutboxRepositoryMockAddEventsExpectation{}
+	}
+
+	if mmAddEvents.defaultExpectation.paramPtrs != nil {
+		mmAddEvents.mock.t.Fatalf("OutboxRepositoryMock.AddEvents mock is already set by ExpectParams functions")
+	}
+
+	mmAddEvents.defaultExpectation.params = &OutboxRepositoryMockAddEventsParams{ctx, events}
+	mmAddEvents.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddEvents.expectations {
+		if minimock.Equal(e.params, mmAddEvents.defaultExpectation.params) {
+			mmAddEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmAddEvents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.AddEvents
+func (mmAddEvents *mOutboxRepositoryMockAddEvents) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockAddEvents {
+	if mmAddEvents.mock.funcAddEvents != nil {
+		mmAddEvents.mock.t.Fatalf("OutboxRepositoryMock.AddEvents mock is already set by Set")
+	}
+
+	if mmAddEvents.defaultExpectation == nil {
+		mmAddEvents.defaultExpectation = &OutboxRepositoryMockAddEventsExpectation{}
+	}
+
+	if mmAddEvents.defaultExpectation.params != nil {
+		mmAddEvents.mock.t.Fatalf("OutboxRepositoryMock.AddEvents mock is already set by Expect")
+	}
+
+	if mmAddEvents.defaultExpectation.paramPtrs == nil {
+		mmAddEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockAddEventsParamPtrs{}
+	}
+	mmAddEvents.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddEvents.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddEvents
+}
+
+// ExpectEventsParam2 sets up expected param events for OutboxRepository.AddEvents
+func (mmAddEvents *mOutboxRepositoryMockAddEvents) ExpectEventsParam2(events []mm_repository.OutboxEvent) *mOutboxRepositoryMockAddEvents {
+	if mmAddEvents.mock.funcAddEvents != nil {
+		mmAddEvents.mock.t.Fatalf("OutboxRepositoryMock.AddEvents mock is already set by Set")
+	}
+
+	if mmAddEvents.defaultExpectation == nil {
+		mmAddEvents.defaultExpectation = &OutboxRepositoryMockAddEventsExpectation{}
+	}
+
+	if mmAddEvents.defaultExpectation.params != nil {
+		mmAddEvents.mock.t.Fatalf("OutboxRepositoryMock.AddEvents mock is already set by Expect")
+	}
+
+	if mmAddEvents.defaultExpectation.paramPtrs == nil {
+		mmAddEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockAddEventsParamPtrs{}
+	}
+	mmAddEvents.defaultExpectation.paramPtrs.events = &events
+	mmAddEvents.defaultExpectation.expectationOrigins.originEvents = minimock.CallerInfo(1)
+
+	return mmAddEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.AddEvents
+func (mmAddEvents *mOutboxRepositoryMockAddEvents) Inspect(f func(ctx context.Context, events []mm_repository.OutboxEvent)) *mOutboxRepositoryMockAddEvents {
+	if mmAddEvents.mock.inspectFuncAddEvents != nil {
+		mmAddEvents.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.AddEvents")
+	}
+
+	mmAddEvents.mock.inspectFuncAddEvents = f
+
+	return mmAddEvents
+}
+
+// Return sets up results that will be returned by OutboxRepository.AddEvents
+func (mmAddEvents *mOutboxRepositoryMockAddEvents) Return(err error) *OutboxRepositoryMock {
+	if mmAddEvents.mock.funcAddEvents != nil {
+		mmAddEvents.mock.t.Fatalf("OutboxRepositoryMock.AddEvents mock is already set by Set")
+	}
+
+	if mmAddEvents.defaultExpectation == nil {
+		mmAddEvents.defaultExpectation = &OutboxRepositoryMockAddEventsExpectation{mock: mmAddEvents.mock}
+	}
+	mmAddEvents.defaultExpectation.results = &OutboxRepositoryMockAddEventsResults{err}
+	mmAddEvents.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddEvents.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.AddEvents method
+func (mmAddEvents *mOutboxRepositoryMockAddEvents) Set(f func(ctx context.Context, events []mm_repository.OutboxEvent) (err error)) *OutboxRepositoryMock {
+	if mmAddEvents.defaultExpectation != nil {
+		mmAddEvents.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.AddEvents method")
+	}
+
+	if len(mmAddEvents.expectations) > 0 {
+		mmAddEvents.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.AddEvents method")
+	}
+
+	mmAddEvents.mock.funcAddEvents = f
+	mmAddEvents.mock.funcAddEventsOrigin = minimock.CallerInfo(1)
+	return mmAddEvents.mock
+}
+
+// When sets expectation for the OutboxRepository.AddEvents which will trigger the result defined by the following
+// Then helper
+func (mmAddEvents *mOutboxRepositoryMockAddEvents) When(ctx context.Context, events []mm_repository.OutboxEvent) *OutboxRepositoryMockAddEventsExpectation {
+	if mmAddEvents.mock.funcAddEvents != nil {
+		mmAddEvents.mock.t.Fatalf("OutboxRepositoryMock.AddEvents mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockAddEventsExpectation{
+		mock:               mmAddEvents.mock,
+		params:             &OutboxRepositoryMockAddEventsParams{ctx, events},
+		expectationOrigins: OutboxRepositoryMockAddEventsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddEvents.expectations = append(mmAddEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.AddEvents return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockAddEventsExpectation) Then(err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockAddEventsResults{err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.AddEvents should be invoked
+func (mmAddEvents *mOutboxRepositoryMockAddEvents) Times(n uint64) *mOutboxRepositoryMockAddEvents {
+	if n == 0 {
+		mmAddEvents.mock.t.Fatalf("Times of OutboxRepositoryMock.AddEvents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddEvents.expectedInvocations, n)
+	mmAddEvents.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddEvents
+}
+
+func (mmAddEvents *mOutboxRepositoryMockAddEvents) invocationsDone() bool {
+	if len(mmAddEvents.expectations) == 0 && mmAddEvents.defaultExpectation == nil && mmAddEvents.mock.funcAddEvents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddEvents.mock.afterAddEventsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddEvents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddEvents implements mm_repository.OutboxRepository
+func (mmAddEvents *OutboxRepositoryMock) AddEvents(ctx context.Context, events []mm_repository.OutboxEvent) (err error) {
+	mm_atomic.AddUint64(&mmAddEvents.beforeAddEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddEvents.afterAddEventsCounter, 1)
+
+	mmAddEvents.t.Helper()
+
+	if mmAddEvents.inspectFuncAddEvents != nil {
+		mmAddEvents.inspectFuncAddEvents(ctx, events)
+	}
+
+	mm_params := OutboxRepositoryMockAddEventsParams{ctx, events}
+
+	// Record call args
+	mmAddEvents.AddEventsMock.mutex.Lock()
+	mmAddEvents.AddEventsMock.callArgs = append(mmAddEvents.AddEventsMock.callArgs, &mm_params)
+	mmAddEvents.AddEventsMock.mutex.Unlock()
+
+	for _, e := range mmAddEvents.AddEventsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddEvents.AddEventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddEvents.AddEventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddEvents.AddEventsMock.defaultExpectation.params
+		mm_want_ptrs := mmAddEvents.AddEventsMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockAddEventsParams{ctx, events}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddEvents.t.Errorf("OutboxRepositoryMock.AddEvents got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddEvents.AddEventsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.events != nil && !minimock.Equal(*mm_want_ptrs.events, mm_got.events) {
+				mmAddEvents.t.Errorf("OutboxRepositoryMock.AddEvents got unexpected parameter events, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddEvents.AddEventsMock.defaultExpectation.expectationOrigins.originEvents, *mm_want_ptrs.events, mm_got.events, minimock.Diff(*mm_want_ptrs.events, mm_got.events))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddEvents.t.Errorf("OutboxRepositoryMock.AddEvents got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddEvents.AddEventsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddEvents.AddEventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddEvents.t.Fatal("No results are set for the OutboxRepositoryMock.AddEvents")
+		}
+		return (*mm_results).err
+	}
+	if mmAddEvents.funcAddEvents != nil {
+		return mmAddEvents.funcAddEvents(ctx, events)
+	}
+	mmAddEvents.t.Fatalf("Unexpected call to OutboxRepositoryMock.AddEvents. %v %v", ctx, events)
+	return
+}
+
+// AddEventsAfterCounter returns a count of finished OutboxRepositoryMock.AddEvents invocations
+func (mmAddEvents *OutboxRepositoryMock) AddEventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddEvents.afterAddEventsCounter)
+}
+
+// AddEventsBeforeCounter returns a count of OutboxRepositoryMock.AddEvents invocations
+func (mmAddEvents *OutboxRepositoryMock) AddEventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddEvents.beforeAddEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.AddEvents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddEvents *mOutboxRepositoryMockAddEvents) Calls() []*OutboxRepositoryMockAddEventsParams {
+	mmAddEvents.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockAddEventsParams, len(mmAddEvents.callArgs))
+	copy(argCopy, mmAddEvents.callArgs)
+
+	mmAddEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddEventsDone returns true if the count of the AddEvents invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockAddEventsDone() bool {
+	if m.AddEventsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddEventsMock.invocationsDone()
+}
+
+// MinimockAddEventsInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockAddEventsInspect() {
+	for _, e := range m.AddEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.AddEvents at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddEventsCounter := mm_atomic.LoadUint64(&m.afterAddEventsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddEventsMock.defaultExpectation != nil && afterAddEventsCounter < 1 {
+		if m.AddEventsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.AddEvents at\n%s", m.AddEventsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.AddEvents at\n%s with params: %#v", m.AddEventsMock.defaultExpectation.expectationOrigins.origin, *m.AddEventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddEvents != nil && afterAddEventsCounter < 1 {
+		m.t.Errorf("Expected call to OutboxRepositoryMock.AddEvents at\n%s", m.funcAddEventsOrigin)
+	}
+
+	if !m.AddEventsMock.invocationsDone() && afterAddEventsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.AddEvents at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddEventsMock.expectedInvocations), m.AddEventsMock.expectedInvocationsOrigin, afterAddEventsCounter)
+	}
+}
+
+type mOutboxRepositoryMockClaimEvents struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockClaimEventsExpectation
+	expectations       []*OutboxRepositoryMockClaimEventsExpectation
+
+	callArgs []*OutboxRepositoryMockClaimEventsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxRepositoryMockClaimEventsExpectation specifies expectation struct of the OutboxRepository.ClaimEvents
+type OutboxRepositoryMockClaimEventsExpectation struct {
+	mock               *OutboxRepositoryMock
+	params             *OutboxRepositoryMockClaimEventsParams
+	paramPtrs          *OutboxRepositoryMockClaimEventsParamPtrs
+	expectationOrigins OutboxRepositoryMockClaimEventsExpectationOrigins
+	results            *OutboxRepositoryMockClaimEventsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxRepositoryMockClaimEventsParams contains parameters of the OutboxRepository.ClaimEvents
+type OutboxRepositoryMockClaimEventsParams struct {
+	ctx         context.Context
+	limit       int
+	now         time.Time
+	lockedUntil time.Time
+}
+
+// OutboxRepositoryMockClaimEventsParamPtrs contains pointers to parameters of the OutboxRepository.ClaimEvents
+type OutboxRepositoryMockClaimEventsParamPtrs struct {
+	ctx         *context.Context
+	limit       *int
+	now         *time.Time
+	lockedUntil *time.Time
+}
+
+// OutboxRepositoryMockClaimEventsResults contains results of the OutboxRepository.ClaimEvents
+type OutboxRepositoryMockClaimEventsResults struct {
+	oa1 []mm_repository.OutboxEvent
+	err error
+}
+
+// OutboxRepositoryMockClaimEventsOrigins contains origins of expectations of the OutboxRepository.ClaimEvents
+type OutboxRepositoryMockClaimEventsExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originLimit       string
+	originNow         string
+	originLockedUntil string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClaimEvents *mOutboxRepositoryMockClaimEvents) Optional() *mOutboxRepositoryMockClaimEvents {
+	mmClaimEvents.optional = true
+	return mmClaimEvents
+}
+
+// Expect sets up expected params for OutboxRepository.ClaimEvents
+func (mmClaimEvents *mOutboxRepositoryMockClaimEvents) Expect(ctx context.Context, limit int, now time.Time, lockedUntil time.Time) *mOutboxRepositoryMockClaimEvents {
+	if mmClaimEvents.mock.funcClaimEvents != nil {
+		mmClaimEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimEvents mock is already set by Set")
+	}
+
+	if mmClaimEvents.defaultExpectation == nil {
+		mmClaimEvents.defaultExpectation = &OutboxRepositoryMockClaimEventsExpectation{}
+	}
+
+	if mmClaimEvents.defaultExpectation.paramPtrs != nil {
+		mmClaimEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimEvents mock is already set by ExpectParams functions")
+	}
+
+	mmClaimEvents.defaultExpectation.params = &OutboxRepositoryMockClaimEventsParams{ctx, limit, now, lockedUntil}
+	mmClaimEvents.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmClaimEvents.expectations {
+		if minimock.Equal(e.params, mmClaimEvents.defaultExpectation.params) {
+			mmClaimEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClaimEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmClaimEvents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.ClaimEvents
+func (mmClaimEvents *mOutboxRepositoryMockClaimEvents) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockClaimEvents {
+	if mmClaimEvents.mock.funcClaimEvents != nil {
+		mmClaimEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimEvents mock is already set by Set")
+	}
+
+	if mmClaimEvents.defaultExpectation == nil {
+		mmClaimEvents.defaultExpectation = &OutboxRepositoryMockClaimEventsExpectation{}
+	}
+
+	if mmClaimEvents.defaultExpectation.params != nil {
+		mmClaimEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimEvents mock is already set by Expect")
+	}
+
+	if mmClaimEvents.defaultExpectation.paramPtrs == nil {
+		mmClaimEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockClaimEventsParamPtrs{}
+	}
+	mmClaimEvents.defaultExpectation.paramPtrs.ctx = &ctx
+	mmClaimEvents.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmClaimEvents
+}
+
+// ExpectLimitParam2 sets up expected param limit for OutboxRepository.ClaimEvents
+func (mmClaimEvents *mOutboxRepositoryMockClaimEvents) ExpectLimitParam2(limit int) *mOutboxRepositoryMockClaimEvents {
+	if mmClaimEvents.mock.funcClaimEvents != nil {
+		mmClaimEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimEvents mock is already set by Set")
+	}
+
+	if mmClaimEvents.defaultExpectation == nil {
+		mmClaimEvents.defaultExpectation = &OutboxRepositoryMockClaimEventsExpectation{}
+	}
+
+	if mmClaimEvents.defaultExpectation.params != nil {
+		mmClaimEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimEvents mock is already set by Expect")
+	}
+
+	if mmClaimEvents.defaultExpectation.paramPtrs == nil {
+		mmClaimEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockClaimEventsParamPtrs{}
+	}
+	mmClaimEvents.defaultExpectation.paramPtrs.limit = &limit
+	mmClaimEvents.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmClaimEvents
+}
+
+// ExpectNowParam3 sets up expected param now for OutboxRepository.ClaimEvents
+func (mmClaimEvents *mOutboxRepositoryMockClaimEvents) ExpectNowParam3(now time.Time) *mOutboxRepositoryMockClaimEvents {
+	if mmClaimEvents.mock.funcClaimEvents != nil {
+		mmClaimEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimEvents mock is already set by Set")
+	}
+
+	if mmClaimEvents.defaultExpectation == nil {
+		mmClaimEvents.defaultExpectation = &OutboxRepositoryMockClaimEventsExpectation{}
+	}
+
+	if mmClaimEvents.defaultExpectation.params != nil {
+		mmClaimEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimEvents mock is already set by Expect")
+	}
+
+	if mmClaimEvents.defaultExpectation.paramPtrs == nil {
+		mmClaimEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockClaimEventsParamPtrs{}
+	}
+	mmClaimEvents.defaultExpectation.paramPtrs.now = &now
+	mmClaimEvents.defaultExpectation.expectationOrigins.originNow = minimock.CallerInfo(1)
+
+	return mmClaimEvents
+}
+
+// ExpectLockedUntilParam4 sets up expected param lockedUntil for OutboxRepository.ClaimEvents
+func (mmClaimEvents *mOutboxRepositoryMockClaimEvents) ExpectLockedUntilParam4(lockedUntil time.Time) *mOutboxRepositoryMockClaimEvents {
+	if mmClaimEvents.mock.funcClaimEvents != nil {
+		mmClaimEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimEvents mock is already set by Set")
+	}
+
+	if mmClaimEvents.defaultExpectation == nil {
+		mmClaimEvents.defaultExpectation = &OutboxRepositoryMockClaimEventsExpectation{}
+	}
+
+	if mmClaimEvents.defaultExpectation.params != nil {
+		mmClaimEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimEvents mock is already set by Expect")
+	}
+
+	if mmClaimEvents.defaultExpectation.paramPtrs == nil {
+		mmClaimEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockClaimEventsParamPtrs{}
+	}
+	mmClaimEvents.defaultExpectation.paramPtrs.lockedUntil = &lockedUntil
+	mmClaimEvents.defaultExpectation.expectationOrigins.originLockedUntil = minimock.CallerInfo(1)
+
+	return mmClaimEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.ClaimEvents
+func (mmClaimEvents *mOutboxRepositoryMockClaimEvents) Inspect(f func(ctx context.Context, limit int, now time.Time, lockedUntil time.Time)) *mOutboxRepositoryMockClaimEvents {
+	if mmClaimEvents.mock.inspectFuncClaimEvents != nil {
+		mmClaimEvents.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.ClaimEvents")
+	}
+
+	mmClaimEvents.mock.inspectFuncClaimEvents = f
+
+	return mmClaimEvents
+}
+
+// Return sets up results that will be returned by OutboxRepository.ClaimEvents
+func (mmClaimEvents *mOutboxRepositoryMockClaimEvents) Return(oa1 []mm_repository.OutboxEvent, err error) *OutboxRepositoryMock {
+	if mmClaimEvents.mock.funcClaimEvents != nil {
+		mmClaimEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimEvents mock is already set by Set")
+	}
+
+	if mmClaimEvents.defaultExpectation == nil {
+		mmClaimEvents.defaultExpectation = &OutboxRepositoryMockClaimEventsExpectation{mock: mmClaimEvents.mock}
+	}
+	mmClaimEvents.defaultExpectation.results = &OutboxRepositoryMockClaimEventsResults{oa1, err}
+	mmClaimEvents.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmClaimEvents.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.ClaimEvents method
+func (mmClaimEvents *mOutboxRepositoryMockClaimEvents) Set(f func(ctx context.Context, limit int, now time.Time, lockedUntil time.Time) (oa1 []mm_repository.OutboxEvent, err error)) *OutboxRepositoryMock {
+	if mmClaimEvents.defaultExpectation != nil {
+		mmClaimEvents.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.ClaimEvents method")
+	}
+
+	if len(mmClaimEvents.expectations) > 0 {
+		mmClaimEvents.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.ClaimEvents method")
+	}
+
+	mmClaimEvents.mock.funcClaimEvents = f
+	mmClaimEvents.mock.funcClaimEventsOrigin = minimock.CallerInfo(1)
+	return mmClaimEvents.mock
+}
+
+// When sets expectation for the OutboxRepository.ClaimEvents which will trigger the result defined by the following
+// Then helper
+func (mmClaimEvents *mOutboxRepositoryMockClaimEvents) When(ctx context.Context, limit int, now time.Time, lockedUntil time.Time) *OutboxRepositoryMockClaimEventsExpectation {
+	if mmClaimEvents.mock.funcClaimEvents != nil {
+		mmClaimEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimEvents mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockClaimEventsExpectation{
+		mock:               mmClaimEvents.mock,
+		params:             &OutboxRepositoryMockClaimEventsParams{ctx, limit, now, lockedUntil},
+		expectationOrigins: OutboxRepositoryMockClaimEventsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmClaimEvents.expectations = append(mmClaimEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.ClaimEvents return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockClaimEventsExpectation) Then(oa1 []mm_repository.OutboxEvent, err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockClaimEventsResults{oa1, err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.ClaimEvents should be invoked
+func (mmClaimEvents *mOutboxRepositoryMockClaimEvents) Times(n uint64) *mOutboxRepositoryMockClaimEvents {
+	if n == 0 {
+		mmClaimEvents.mock.t.Fatalf("Times of OutboxRepositoryMock.ClaimEvents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClaimEvents.expectedInvocations, n)
+	mmClaimEvents.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmClaimEvents
+}
+
+func (mmClaimEvents *mOutboxRepositoryMockClaimEvents) invocationsDone() bool {
+	if len(mmClaimEvents.expectations) == 0 && mmClaimEvents.defaultExpectation == nil && mmClaimEvents.mock.funcClaimEvents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClaimEvents.mock.afterClaimEventsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClaimEvents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ClaimEvents implements mm_repository.OutboxRepository
+func (mmClaimEvents *OutboxRepositoryMock) ClaimEvents(ctx context.Context, limit int, now time.Time, lockedUntil time.Time) (oa1 []mm_repository.OutboxEvent, err error) {
+	mm_atomic.AddUint64(&mmClaimEvents.beforeClaimEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmClaimEvents.afterClaimEventsCounter, 1)
+
+	mmClaimEvents.t.Helper()
+
+	if mmClaimEvents.inspectFuncClaimEvents != nil {
+		mmClaimEvents.inspectFuncClaimEvents(ctx, limit, now, lockedUntil)
+	}
+
+	mm_params := OutboxRepositoryMockClaimEventsParams{ctx, limit, now, lockedUntil}
+
+	// Record call args
+	mmClaimEvents.ClaimEventsMock.mutex.Lock()
+	mmClaimEvents.ClaimEventsMock.callArgs = append(mmClaimEvents.ClaimEventsMock.callArgs, &mm_params)
+	mmClaimEvents.ClaimEventsMock.mutex.Unlock()
+
+	for _, e := range mmClaimEvents.ClaimEventsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.oa1, e.results.err
+		}
+	}
+
+	if mmClaimEvents.ClaimEventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClaimEvents.ClaimEventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmClaimEvents.ClaimEventsMock.defaultExpectation.params
+		mm_want_ptrs := mmClaimEvents.ClaimEventsMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockClaimEventsParams{ctx, limit, now, lockedUntil}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClaimEvents.t.Errorf("OutboxRepositoryMock.ClaimEvents got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimEvents.ClaimEventsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmClaimEvents.t.Errorf("OutboxRepositoryMock.ClaimEvents got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimEvents.ClaimEventsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmClaimEvents.t.Errorf("OutboxRepositoryMock.ClaimEvents got unexpected parameter now, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimEvents.ClaimEventsMock.defaultExpectation.expectationOrigins.originNow, *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+			if mm_want_ptrs.lockedUntil != nil && !minimock.Equal(*mm_want_ptrs.lockedUntil, mm_got.lockedUntil) {
+				mmClaimEvents.t.Errorf("OutboxRepositoryMock.ClaimEvents got unexpected parameter lockedUntil, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimEvents.ClaimEventsMock.defaultExpectation.expectationOrigins.originLockedUntil, *mm_want_ptrs.lockedUntil, mm_got.lockedUntil, minimock.Diff(*mm_want_ptrs.lockedUntil, mm_got.lockedUntil))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClaimEvents.t.Errorf("OutboxRepositoryMock.ClaimEvents got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmClaimEvents.ClaimEventsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClaimEvents.ClaimEventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClaimEvents.t.Fatal("No results are set for the OutboxRepositoryMock.ClaimEvents")
+		}
+		return (*mm_results).oa1, (*mm_results).err
+	}
+	if mmClaimEvents.funcClaimEvents != nil {
+		return mmClaimEvents.funcClaimEvents(ctx, limit, now, lockedUntil)
+	}
+	mmClaimEvents.t.Fatalf("Unexpected call to OutboxRepositoryMock.ClaimEvents. %v %v %v %v", ctx, limit, now, lockedUntil)
+	return
+}
+
+// ClaimEventsAfterCounter returns a count of finished OutboxRepositoryMock.ClaimEvents invocations
+func (mmClaimEvents *OutboxRepositoryMock) ClaimEventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimEvents.afterClaimEventsCounter)
+}
+
+// ClaimEventsBeforeCounter returns a count of OutboxRepositoryMock.ClaimEvents invocations
+func (mmClaimEvents *OutboxRepositoryMock) ClaimEventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimEvents.beforeClaimEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.ClaimEvents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClaimEvents *mOutboxRepositoryMockClaimEvents) Calls() []*OutboxRepositoryMockClaimEventsParams {
+	mmClaimEvents.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockClaimEventsParams, len(mmClaimEvents.callArgs))
+	copy(argCopy, mmClaimEvents.callArgs)
+
+	mmClaimEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClaimEventsDone returns true if the count of the ClaimEvents invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockClaimEventsDone() bool {
+	if m.ClaimEventsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClaimEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClaimEventsMock.invocationsDone()
+}
+
+// MinimockClaimEventsInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockClaimEventsInspect() {
+	for _, e := range m.ClaimEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.ClaimEvents at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterClaimEventsCounter := mm_atomic.LoadUint64(&m.afterClaimEventsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClaimEventsMock.defaultExpectation != nil && afterClaimEventsCounter < 1 {
+		if m.ClaimEventsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.ClaimEvents at\n%s", m.ClaimEventsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.ClaimEvents at\n%s with params: %#v", m.ClaimEventsMock.defaultExpectation.expectationOrigins.origin, *m.ClaimEventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClaimEvents != nil && afterClaimEventsCounter < 1 {
+		m.t.Errorf("Expected call to OutboxRepositoryMock.ClaimEvents at\n%s", m.funcClaimEventsOrigin)
+	}
+
+	if !m.ClaimEventsMock.invocationsDone() && afterClaimEventsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.ClaimEvents at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ClaimEventsMock.expectedInvocations), m.ClaimEventsMock.expectedInvocationsOrigin, afterClaimEventsCounter)
+	}
+}
+
+type mOutboxRepositoryMockMarkSent struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockMarkSentExpectation
+	expectations       []*OutboxRepositoryMockMarkSentExpectation
+
+	callArgs []*OutboxRepositoryMockMarkSentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxRepositoryMockMarkSentExpectation specifies expectation struct of the OutboxRepository.MarkSent
+type OutboxRepositoryMockMarkSentExpectation struct {
+	mock               *OutboxRepositoryMock
+	params             *OutboxRepositoryMockMarkSentParams
+	paramPtrs          *OutboxRepositoryMockMarkSentParamPtrs
+	expectationOrigins OutboxRepositoryMockMarkSentExpectationOrigins
+	results            *OutboxRepositoryMockMarkSentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxRepositoryMockMarkSentParams contains parameters of the OutboxRepository.MarkSent
+type OutboxRepositoryMockMarkSentParams struct {
+	ctx    context.Context
+	IDs    []string
+	sentAt time.Time
+}
+
+// OutboxRepositoryMockMarkSentParamPtrs contains pointers to parameters of the OutboxRepository.MarkSent
+type OutboxRepositoryMockMarkSentParamPtrs struct {
+	ctx    *context.Context
+	IDs    *[]string
+	sentAt *time.Time
+}
+
+// OutboxRepositoryMockMarkSentResults contains results of the OutboxRepository.MarkSent
+type OutboxRepositoryMockMarkSentResults struct {
+	err error
+}
+
+// OutboxRepositoryMockMarkSentOrigins contains origins of expectations of the OutboxRepository.MarkSent
+type OutboxRepositoryMockMarkSentExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originIDs    string
+	originSentAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkSent *mOutboxRepositoryMockMarkSent) Optional() *mOutboxRepositoryMockMarkSent {
+	mmMarkSent.optional = true
+	return mmMarkSent
+}
+
+// Expect sets up expected params for OutboxRepository.MarkSent
+func (mmMarkSent *mOutboxRepositoryMockMarkSent) Expect(ctx context.Context, IDs []string, sentAt time.Time) *mOutboxRepositoryMockMarkSent {
+	if mmMarkSent.mock.funcMarkSent != nil {
+		mmMarkSent.mock.t.Fatalf("OutboxRepositoryMock.MarkSent mock is already set by Set")
+	}
+
+	if mmMarkSent.defaultExpectation == nil {
+		mmMarkSent.defaultExpectation = &OutboxRepositoryMockMarkSentExpectation{}
+	}
+
+	if mmMarkSent.defaultExpectation.paramPtrs != nil {
+		mmMarkSent.mock.t.Fatalf("OutboxRepositoryMock.MarkSent mock is already set by ExpectParams functions")
+	}
+
+	mmMarkSent.defaultExpectation.params = &OutboxRepositoryMockMarkSentParams{ctx, IDs, sentAt}
+	mmMarkSent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkSent.expectations {
+		if minimock.Equal(e.params, mmMarkSent.defaultExpectation.params) {
+			mmMarkSent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkSent.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkSent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.MarkSent
+func (mmMarkSent *mOutboxRepositoryMockMarkSent) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockMarkSent {
+	if mmMarkSent.mock.funcMarkSent != nil {
+		mmMarkSent.mock.t.Fatalf("OutboxRepositoryMock.MarkSent mock is already set by Set")
+	}
+
+	if mmMarkSent.defaultExpectation == nil {
+		mmMarkSent.defaultExpectation = &OutboxRepositoryMockMarkSentExpectation{}
+	}
+
+	if mmMarkSent.defaultExpectation.params != nil {
+		mmMarkSent.mock.t.Fatalf("OutboxRepositoryMock.MarkSent mock is already set by Expect")
+	}
+
+	if mmMarkSent.defaultExpectation.paramPtrs == nil {
+		mmMarkSent.defaultExpectation.paramPtrs = &OutboxRepositoryMockMarkSentParamPtrs{}
+	}
+	mmMarkSent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkSent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkSent
+}
+
+// ExpectIDsParam2 sets up expected param IDs for OutboxRepository.MarkSent
+func (mmMarkSent *mOutboxRepositoryMockMarkSent) ExpectIDsParam2(IDs []string) *mOutboxRepositoryMockMarkSent {
+	if mmMarkSent.mock.funcMarkSent != nil {
+		mmMarkSent.mock.t.Fatalf("OutboxRepositoryMock.MarkSent mock is already set by Set")
+	}
+
+	if mmMarkSent.defaultExpectation == nil {
+		mmMarkSent.defaultExpectation = &OutboxRepositoryMockMarkSentExpectation{}
+	}
+
+	if mmMarkSent.defaultExpectation.params != nil {
+		mmMarkSent.mock.t.Fatalf("OutboxRepositoryMock.MarkSent mock is already set by Expect")
+	}
+
+	if mmMarkSent.defaultExpectation.paramPtrs == nil {
+		mmMarkSent.defaultExpectation.paramPtrs = &OutboxRepositoryMockMarkSentParamPtrs{}
+	}
+	mmMarkSent.defaultExpectation.paramPtrs.IDs = &IDs
+	mmMarkSent.defaultExpectation.expectationOrigins.originIDs = minimock.CallerInfo(1)
+
+	return mmMarkSent
+}
+
+// ExpectSentAtParam3 sets up expected param sentAt for OutboxRepository.MarkSent
+func (mmMarkSent *mOutboxRepositoryMockMarkSent) ExpectSentAtParam3(sentAt time.Time) *mOutboxRepositoryMockMarkSent {
+	if mmMarkSent.mock.funcMarkSent != nil {
+		mmMarkSent.mock.t.Fatalf("OutboxRepositoryMock.MarkSent mock is already set by Set")
+	}
+
+	if mmMarkSent.defaultExpectation == nil {
+		mmMarkSent.defaultExpectation = &OutboxRepositoryMockMarkSentExpectation{}
+	}
+
+	if mmMarkSent.defaultExpectation.params != nil {
+		mmMarkSent.mock.t.Fatalf("OutboxRepositoryMock.MarkSent mock is already set by Expect")
+	}
+
+	if mmMarkSent.defaultExpectation.paramPtrs == nil {
+		mmMarkSent.defaultExpectation.paramPtrs = &OutboxRepositoryMockMarkSentParamPtrs{}
+	}
+	mmMarkSent.defaultExpectation.paramPtrs.sentAt = &sentAt
+	mmMarkSent.defaultExpectation.expectationOrigins.originSentAt = minimock.CallerInfo(1)
+
+	return mmMarkSent
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.MarkSent
+func (mmMarkSent *mOutboxRepositoryMockMarkSent) Inspect(f func(ctx context.Context, IDs []string, sentAt time.Time)) *mOutboxRepositoryMockMarkSent {
+	if mmMarkSent.mock.inspectFuncMarkSent != nil {
+		mmMarkSent.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.MarkSent")
+	}
+
+	mmMarkSent.mock.inspectFuncMarkSent = f
+
+	return mmMarkSent
+}
+
+// Return sets up results that will be returned by OutboxRepository.MarkSent
+func (mmMarkSent *mOutboxRepositoryMockMarkSent) Return(err error) *OutboxRepositoryMock {
+	if mmMarkSent.mock.funcMarkSent != nil {
+		mmMarkSent.mock.t.Fatalf("OutboxRepositoryMock.MarkSent mock is already set by Set")
+	}
+
+	if mmMarkSent.defaultExpectation == nil {
+		mmMarkSent.defaultExpectation = &OutboxRepositoryMockMarkSentExpectation{mock: mmMarkSent.mock}
+	}
+	mmMarkSent.defaultExpectation.results = &OutboxRepositoryMockMarkSentResults{err}
+	mmMarkSent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkSent.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.MarkSent method
+func (mmMarkSent *mOutboxRepositoryMockMarkSent) Set(f func(ctx context.Context, IDs []string, sentAt time.Time) (err error)) *OutboxRepositoryMock {
+	if mmMarkSent.defaultExpectation != nil {
+		mmMarkSent.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.MarkSent method")
+	}
+
+	if len(mmMarkSent.expectations) > 0 {
+		mmMarkSent.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.MarkSent method")
+	}
+
+	mmMarkSent.mock.funcMarkSent = f
+	mmMarkSent.mock.funcMarkSentOrigin = minimock.CallerInfo(1)
+	return mmMarkSent.mock
+}
+
+// When sets expectation for the OutboxRepository.MarkSent which will trigger the result defined by the following
+// Then helper
+func (mmMarkSent *mOutboxRepositoryMockMarkSent) When(ctx context.Context, IDs []string, sentAt time.Time) *OutboxRepositoryMockMarkSentExpectation {
+	if mmMarkSent.mock.funcMarkSent != nil {
+		mmMarkSent.mock.t.Fatalf("OutboxRepositoryMock.MarkSent mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockMarkSentExpectation{
+		mock:               mmMarkSent.mock,
+		params:             &OutboxRepositoryMockMarkSentParams{ctx, IDs, sentAt},
+		expectationOrigins: OutboxRepositoryMockMarkSentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkSent.expectations = append(mmMarkSent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.MarkSent return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockMarkSentExpectation) Then(err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockMarkSentResults{err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.MarkSent should be invoked
+func (mmMarkSent *mOutboxRepositoryMockMarkSent) Times(n uint64) *mOutboxRepositoryMockMarkSent {
+	if n == 0 {
+		mmMarkSent.mock.t.Fatalf("Times of OutboxRepositoryMock.MarkSent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkSent.expectedInvocations, n)
+	mmMarkSent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkSent
+}
+
+func (mmMarkSent *mOutboxRepositoryMockMarkSent) invocationsDone() bool {
+	if len(mmMarkSent.expectations) == 0 && mmMarkSent.defaultExpectation == nil && mmMarkSent.mock.funcMarkSent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkSent.mock.afterMarkSentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkSent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkSent implements mm_repository.OutboxRepository
+func (mmMarkSent *OutboxRepositoryMock) MarkSent(ctx context.Context, IDs []string, sentAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmMarkSent.beforeMarkSentCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkSent.afterMarkSentCounter, 1)
+
+	mmMarkSent.t.Helper()
+
+	if mmMarkSent.inspectFuncMarkSent != nil {
+		mmMarkSent.inspectFuncMarkSent(ctx, IDs, sentAt)
+	}
+
+	mm_params := OutboxRepositoryMockMarkSentParams{ctx, IDs, sentAt}
+
+	// Record call args
+	mmMarkSent.MarkSentMock.mutex.Lock()
+	mmMarkSent.MarkSentMock.callArgs = append(mmMarkSent.MarkSentMock.callArgs, &mm_params)
+	mmMarkSent.MarkSentMock.mutex.Unlock()
+
+	for _, e := range mmMarkSent.MarkSentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkSent.MarkSentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkSent.MarkSentMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkSent.MarkSentMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkSent.MarkSentMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockMarkSentParams{ctx, IDs, sentAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkSent.t.Errorf("OutboxRepositoryMock.MarkSent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkSent.MarkSentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.IDs != nil && !minimock.Equal(*mm_want_ptrs.IDs, mm_got.IDs) {
+				mmMarkSent.t.Errorf("OutboxRepositoryMock.MarkSent got unexpected parameter IDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkSent.MarkSentMock.defaultExpectation.expectationOrigins.originIDs, *mm_want_ptrs.IDs, mm_got.IDs, minimock.Diff(*mm_want_ptrs.IDs, mm_got.IDs))
+			}
+
+			if mm_want_ptrs.sentAt != nil && !minimock.Equal(*mm_want_ptrs.sentAt, mm_got.sentAt) {
+				mmMarkSent.t.Errorf("OutboxRepositoryMock.MarkSent got unexpected parameter sentAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkSent.MarkSentMock.defaultExpectation.expectationOrigins.originSentAt, *mm_want_ptrs.sentAt, mm_got.sentAt, minimock.Diff(*mm_want_ptrs.sentAt, mm_got.sentAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkSent.t.Errorf("OutboxRepositoryMock.MarkSent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkSent.MarkSentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkSent.MarkSentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkSent.t.Fatal("No results are set for the OutboxRepositoryMock.MarkSent")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkSent.funcMarkSent != nil {
+		return mmMarkSent.funcMarkSent(ctx, IDs, sentAt)
+	}
+	mmMarkSent.t.Fatalf("Unexpected call to OutboxRepositoryMock.MarkSent. %v %v %v", ctx, IDs, sentAt)
+	return
+}
+
+// MarkSentAfterCounter returns a count of finished OutboxRepositoryMock.MarkSent invocations
+func (mmMarkSent *OutboxRepositoryMock) MarkSentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkSent.afterMarkSentCounter)
+}
+
+// MarkSentBeforeCounter returns a count of OutboxRepositoryMock.MarkSent invocations
+func (mmMarkSent *OutboxRepositoryMock) MarkSentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkSent.beforeMarkSentCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.MarkSent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkSent *mOutboxRepositoryMockMarkSent) Calls() []*OutboxRepositoryMockMarkSentParams {
+	mmMarkSent.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockMarkSentParams, len(mmMarkSent.callArgs))
+	copy(argCopy, mmMarkSent.callArgs)
+
+	mmMarkSent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkSentDone returns true if the count of the MarkSent invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockMarkSentDone() bool {
+	if m.MarkSentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkSentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkSentMock.invocationsDone()
+}
+
+// MinimockMarkSentInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockMarkSentInspect() {
+	for _, e := range m.MarkSentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.MarkSent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkSentCounter := mm_atomic.LoadUint64(&m.afterMarkSentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkSentMock.defaultExpectation != nil && afterMarkSentCounter < 1 {
+		if m.MarkSentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.MarkSent at\n%s", m.MarkSentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.MarkSent at\n%s with params: %#v", m.MarkSentMock.defaultExpectation.expectationOrigins.origin, *m.MarkSentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkSent != nil && afterMarkSentCounter < 1 {
+		m.t.Errorf("Expected call to OutboxRepositoryMock.MarkSent at\n%s", m.funcMarkSentOrigin)
+	}
+
+	if !m.MarkSentMock.invocationsDone() && afterMarkSentCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.MarkSent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkSentMock.expectedInvocations), m.MarkSentMock.expectedInvocationsOrigin, afterMarkSentCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OutboxRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddEventsInspect()
+
+			m.MinimockClaimEventsInspect()
+
+			m.MinimockMarkSentInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OutboxRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OutboxRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddEventsDone() &&
+		m.MinimockClaimEventsDone() &&
+		m.MinimockMarkSentDone()
+}
@@ -0,0 +1,1515 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/maisiq/go-ugc-service/internal/repository.ProgressRepository -o progress_repository_mock.go -n ProgressRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_repository "github.com/maisiq/go-ugc-service/internal/repository"
+)
+
+// ProgressRepositoryMock implements mm_repository.ProgressRepository
+type ProgressRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDeleteUserProgress          func(ctx context.Context, userID string) (err error)
+	funcDeleteUserProgressOrigin    string
+	inspectFuncDeleteUserProgress   func(ctx context.Context, userID string)
+	afterDeleteUserProgressCounter  uint64
+	beforeDeleteUserProgressCounter uint64
+	DeleteUserProgressMock          mProgressRepositoryMockDeleteUserProgress
+
+	funcGetProgress          func(ctx context.Context, userID string, movieID string) (p1 mm_repository.Progress, err error)
+	funcGetProgressOrigin    string
+	inspectFuncGetProgress   func(ctx context.Context, userID string, movieID string)
+	afterGetProgressCounter  uint64
+	beforeGetProgressCounter uint64
+	GetProgressMock          mProgressRepositoryMockGetProgress
+
+	funcListProgress          func(ctx context.Context, userID string) (pa1 []mm_repository.Progress, err error)
+	funcListProgressOrigin    string
+	inspectFuncListProgress   func(ctx context.Context, userID string)
+	afterListProgressCounter  uint64
+	beforeListProgressCounter uint64
+	ListProgressMock          mProgressRepositoryMockListProgress
+
+	funcSaveProgress          func(ctx context.Context, progress []mm_repository.Progress) (err error)
+	funcSaveProgressOrigin    string
+	inspectFuncSaveProgress   func(ctx context.Context, progress []mm_repository.Progress)
+	afterSaveProgressCounter  uint64
+	beforeSaveProgressCounter uint64
+	SaveProgressMock          mProgressRepositoryMockSaveProgress
+}
+
+// NewProgressRepositoryMock returns a mock for mm_repository.ProgressRepository
+func NewProgressRepositoryMock(t minimock.Tester) *ProgressRepositoryMock {
+	m := &ProgressRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteUserProgressMock = mProgressRepositoryMockDeleteUserProgress{mock: m}
+	m.DeleteUserProgressMock.callArgs = []*ProgressRepositoryMockDeleteUserProgressParams{}
+
+	m.GetProgressMock = mProgressRepositoryMockGetProgress{mock: m}
+	m.GetProgressMock.callArgs = []*ProgressRepositoryMockGetProgressParams{}
+
+	m.ListProgressMock = mProgressRepositoryMockListProgress{mock: m}
+	m.ListProgressMock.callArgs = []*ProgressRepositoryMockListProgressParams{}
+
+	m.SaveProgressMock = mProgressRepositoryMockSaveProgress{mock: m}
+	m.SaveProgressMock.callArgs = []*ProgressRepositoryMockSaveProgressParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mProgressRepositoryMockDeleteUserProgress struct {
+	optional           bool
+	mock               *ProgressRepositoryMock
+	defaultExpectation *ProgressRepositoryMockDeleteUserProgressExpectation
+	expectations       []*ProgressRepositoryMockDeleteUserProgressExpectation
+
+	callArgs []*ProgressRepositoryMockDeleteUserProgressParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ProgressRepositoryMockDeleteUserProgressExpectation specifies expectation struct of the ProgressRepository.DeleteUserProgress
+type ProgressRepositoryMockDeleteUserProgressExpectation struct {
+	mock               *ProgressRepositoryMock
+	params             *ProgressRepositoryMockDeleteUserProgressParams
+	paramPtrs          *ProgressRepositoryMockDeleteUserProgressParamPtrs
+	expectationOrigins ProgressRepositoryMockDeleteUserProgressExpectationOrigins
+	results            *ProgressRepositoryMockDeleteUserProgressResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ProgressRepositoryMockDeleteUserProgressParams contains parameters of the ProgressRepository.DeleteUserProgress
+type ProgressRepositoryMockDeleteUserProgressParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// ProgressRepositoryMockDeleteUserProgressParamPtrs contains pointers to parameters of the ProgressRepository.DeleteUserProgress
+type ProgressRepositoryMockDeleteUserProgressParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// ProgressRepositoryMockDeleteUserProgressResults contains results of the ProgressRepository.DeleteUserProgress
+type ProgressRepositoryMockDeleteUserProgressResults struct {
+	err error
+}
+
+// ProgressRepositoryMockDeleteUserProgressOrigins contains origins of expectations of the ProgressRepository.DeleteUserProgress
+type ProgressRepositoryMockDeleteUserProgressExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteUserProgress *mProgressRepositoryMockDeleteUserProgress) Optional() *mProgressRepositoryMockDeleteUserProgress {
+	mmDeleteUserProgress.optional = true
+	return mmDeleteUserProgress
+}
+
+// Expect sets up expected params for ProgressRepository.DeleteUserProgress
+func (mmDeleteUserProgress *mProgressRepositoryMockDeleteUserProgress) Expect(ctx context.Context, userID string) *mProgressRepositoryMockDeleteUserProgress {
+	if mmDeleteUserProgress.mock.funcDeleteUserProgress != nil {
+		mmDeleteUserProgress.mock.t.Fatalf("ProgressRepositoryMock.DeleteUserProgress mock is already set by Set")
+	}
+
+	if mmDeleteUserProgress.defaultExpectation == nil {
+		mmDeleteUserProgress.defaultExpectation = &ProgressRepositoryMockDeleteUserProgressExpectation{}
+	}
+
+	if mmDeleteUserProgress.defaultExpectation.paramPtrs != nil {
+		mmDeleteUserProgress.mock.t.Fatalf("ProgressRepositoryMock.DeleteUserProgress mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteUserProgress.defaultExpectation.params = &ProgressRepositoryMockDeleteUserProgressParams{ctx, userID}
+	mmDeleteUserProgress.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteUserProgress.expectations {
+		if minimock.Equal(e.params, mmDeleteUserProgress.defaultExpectation.params) {
+			mmDeleteUserProgress.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUserProgress.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteUserProgress
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ProgressRepository.DeleteUserProgress
+func (mmDeleteUserProgress *mProgressRepositoryMockDeleteUserProgress) ExpectCtxParam1(ctx context.Context) *mProgressRepositoryMockDeleteUserProgress {
+	if mmDeleteUserProgress.mock.funcDeleteUserProgress != nil {
+		mmDeleteUserProgress.mock.t.Fatalf("ProgressRepositoryMock.DeleteUserProgress mock is already set by Set")
+	}
+
+	if mmDeleteUserProgress.defaultExpectation == nil {
+		mmDeleteUserProgress.defaultExpectation = &ProgressRepositoryMockDeleteUserProgressExpectation{}
+	}
+
+	if mmDeleteUserProgress.defaultExpectation.params != nil {
+		mmDeleteUserProgress.mock.t.Fatalf("ProgressRepositoryMock.DeleteUserProgress mock is already set by Expect")
+	}
+
+	if mmDeleteUserProgress.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserProgress.defaultExpectation.paramPtrs = &ProgressRepositoryMockDeleteUserProgressParamPtrs{}
+	}
+	mmDeleteUserProgress.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteUserProgress.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteUserProgress
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ProgressRepository.DeleteUserProgress
+func (mmDeleteUserProgress *mProgressRepositoryMockDeleteUserProgress) ExpectUserIDParam2(userID string) *mProgressRepositoryMockDeleteUserProgress {
+	if mmDeleteUserProgress.mock.funcDeleteUserProgress != nil {
+		mmDeleteUserProgress.mock.t.Fatalf("ProgressRepositoryMock.DeleteUserProgress mock is already set by Set")
+	}
+
+	if mmDeleteUserProgress.defaultExpectation == nil {
+		mmDeleteUserProgress.defaultExpectation = &ProgressRepositoryMockDeleteUserProgressExpectation{}
+	}
+
+	if mmDeleteUserProgress.defaultExpectation.params != nil {
+		mmDeleteUserProgress.mock.t.Fatalf("ProgressRepositoryMock.DeleteUserProgress mock is already set by Expect")
+	}
+
+	if mmDeleteUserProgress.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserProgress.defaultExpectation.paramPtrs = &ProgressRepositoryMockDeleteUserProgressParamPtrs{}
+	}
+	mmDeleteUserProgress.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteUserProgress.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteUserProgress
+}
+
+// Inspect accepts an inspector function that has same arguments as the ProgressRepository.DeleteUserProgress
+func (mmDeleteUserProgress *mProgressRepositoryMockDeleteUserProgress) Inspect(f func(ctx context.Context, userID string)) *mProgressRepositoryMockDeleteUserProgress {
+	if mmDeleteUserProgress.mock.inspectFuncDeleteUserProgress != nil {
+		mmDeleteUserProgress.mock.t.Fatalf("Inspect function is already set for ProgressRepositoryMock.DeleteUserProgress")
+	}
+
+	mmDeleteUserProgress.mock.inspectFuncDeleteUserProgress = f
+
+	return mmDeleteUserProgress
+}
+
+// Return sets up results that will be returned by ProgressRepository.DeleteUserProgress
+func (mmDeleteUserProgress *mProgressRepositoryMockDeleteUserProgress) Return(err error) *ProgressRepositoryMock {
+	if mmDeleteUserProgress.mock.funcDeleteUserProgress != nil {
+		mmDeleteUserProgress.mock.t.Fatalf("ProgressRepositoryMock.DeleteUserProgress mock is already set by Set")
+	}
+
+	if mmDeleteUserProgress.defaultExpectation == nil {
+		mmDeleteUserProgress.defaultExpectation = &ProgressRepositoryMockDeleteUserProgressExpectation{mock: mmDeleteUserProgress.mock}
+	}
+	mmDeleteUserProgress.defaultExpectation.results = &ProgressRepositoryMockDeleteUserProgressResults{err}
+	mmDeleteUserProgress.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserProgress.mock
+}
+
+// Set uses given function f to mock the ProgressRepository.DeleteUserProgress method
+func (mmDeleteUserProgress *mProgressRepositoryMockDeleteUserProgress) Set(f func(ctx context.Context, userID string) (err error)) *ProgressRepositoryMock {
+	if mmDeleteUserProgress.defaultExpectation != nil {
+		mmDeleteUserProgress.mock.t.Fatalf("Default expectation is already set for the ProgressRepository.DeleteUserProgress method")
+	}
+
+	if len(mmDeleteUserProgress.expectations) > 0 {
+		mmDeleteUserProgress.mock.t.Fatalf("Some expectations are already set for the ProgressRepository.DeleteUserProgress method")
+	}
+
+	mmDeleteUserProgress.mock.funcDeleteUserProgress = f
+	mmDeleteUserProgress.mock.funcDeleteUserProgressOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserProgress.mock
+}
+
+// When sets expectation for the ProgressRepository.DeleteUserProgress which will trigger the result defined by the following
+// Then helper
+func (mmDeleteUserProgress *mProgressRepositoryMockDeleteUserProgress) When(ctx context.Context, userID string) *ProgressRepositoryMockDeleteUserProgressExpectation {
+	if mmDeleteUserProgress.mock.funcDeleteUserProgress != nil {
+		mmDeleteUserProgress.mock.t.Fatalf("ProgressRepositoryMock.DeleteUserProgress mock is already set by Set")
+	}
+
+	expectation := &ProgressRepositoryMockDeleteUserProgressExpectation{
+		mock:               mmDeleteUserProgress.mock,
+		params:             &ProgressRepositoryMockDeleteUserProgressParams{ctx, userID},
+		expectationOrigins: ProgressRepositoryMockDeleteUserProgressExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteUserProgress.expectations = append(mmDeleteUserProgress.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ProgressRepository.DeleteUserProgress return parameters for the expectation previously defined by the When method
+func (e *ProgressRepositoryMockDeleteUserProgressExpectation) Then(err error) *ProgressRepositoryMock {
+	e.results = &ProgressRepositoryMockDeleteUserProgressResults{err}
+	return e.mock
+}
+
+// Times sets number of times ProgressRepository.DeleteUserProgress should be invoked
+func (mmDeleteUserProgress *mProgressRepositoryMockDeleteUserProgress) Times(n uint64) *mProgressRepositoryMockDeleteUserProgress {
+	if n == 0 {
+		mmDeleteUserProgress.mock.t.Fatalf("Times of ProgressRepositoryMock.DeleteUserProgress mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteUserProgress.expectedInvocations, n)
+	mmDeleteUserProgress.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserProgress
+}
+
+func (mmDeleteUserProgress *mProgressRepositoryMockDeleteUserProgress) invocationsDone() bool {
+	if len(mmDeleteUserProgress.expectations) == 0 && mmDeleteUserProgress.defaultExpectation == nil && mmDeleteUserProgress.mock.funcDeleteUserProgress == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUserProgress.mock.afterDeleteUserProgressCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUserProgress.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteUserProgress implements mm_repository.ProgressRepository
+func (mmDeleteUserProgress *ProgressRepositoryMock) DeleteUserProgress(ctx context.Context, userID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteUserProgress.beforeDeleteUserProgressCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUserProgress.afterDeleteUserProgressCounter, 1)
+
+	mmDeleteUserProgress.t.Helper()
+
+	if mmDeleteUserProgress.inspectFuncDeleteUserProgress != nil {
+		mmDeleteUserProgress.inspectFuncDeleteUserProgress(ctx, userID)
+	}
+
+	mm_params := ProgressRepositoryMockDeleteUserProgressParams{ctx, userID}
+
+	// Record call args
+	mmDeleteUserProgress.DeleteUserProgressMock.mutex.Lock()
+	mmDeleteUserProgress.DeleteUserProgressMock.callArgs = append(mmDeleteUserProgress.DeleteUserProgressMock.callArgs, &mm_params)
+	mmDeleteUserProgress.DeleteUserProgressMock.mutex.Unlock()
+
+	for _, e := range mmDeleteUserProgress.DeleteUserProgressMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteUserProgress.DeleteUserProgressMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUserProgress.DeleteUserProgressMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUserProgress.DeleteUserProgressMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUserProgress.DeleteUserProgressMock.defaultExpectation.paramPtrs
+
+		mm_got := ProgressRepositoryMockDeleteUserProgressParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteUserProgress.t.Errorf("ProgressRepositoryMock.DeleteUserProgress got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserProgress.DeleteUserProgressMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteUserProgress.t.Errorf("ProgressRepositoryMock.DeleteUserProgress got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserProgress.DeleteUserProgressMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteUserProgress.t.Errorf("ProgressRepositoryMock.DeleteUserProgress got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteUserProgress.DeleteUserProgressMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteUserProgress.DeleteUserProgressMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteUserProgress.t.Fatal("No results are set for the ProgressRepositoryMock.DeleteUserProgress")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteUserProgress.funcDeleteUserProgress != nil {
+		return mmDeleteUserProgress.funcDeleteUserProgress(ctx, userID)
+	}
+	mmDeleteUserProgress.t.Fatalf("Unexpected call to ProgressRepositoryMock.DeleteUserProgress. %v %v", ctx, userID)
+	return
+}
+
+// DeleteUserProgressAfterCounter returns a count of finished ProgressRepositoryMock.DeleteUserProgress invocations
+func (mmDeleteUserProgress *ProgressRepositoryMock) DeleteUserProgressAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserProgress.afterDeleteUserProgressCounter)
+}
+
+// DeleteUserProgressBeforeCounter returns a count of ProgressRepositoryMock.DeleteUserProgress invocations
+func (mmDeleteUserProgress *ProgressRepositoryMock) DeleteUserProgressBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserProgress.beforeDeleteUserProgressCounter)
+}
+
+// Calls returns a list of arguments used in each call to ProgressRepositoryMock.DeleteUserProgress.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteUserProgress *mProgressRepositoryMockDeleteUserProgress) Calls() []*ProgressRepositoryMockDeleteUserProgressParams {
+	mmDeleteUserProgress.mutex.RLock()
+
+	argCopy := make([]*ProgressRepositoryMockDeleteUserProgressParams, len(mmDeleteUserProgress.callArgs))
+	copy(argCopy, mmDeleteUserProgress.callArgs)
+
+	mmDeleteUserProgress.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteUserProgressDone returns true if the count of the DeleteUserProgress invocations corresponds
+// the number of defined expectations
+func (m *ProgressRepositoryMock) MinimockDeleteUserProgressDone() bool {
+	if m.DeleteUserProgressMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteUserProgressMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteUserProgressMock.invocationsDone()
+}
+
+// MinimockDeleteUserProgressInspect logs each unmet expectation
+func (m *ProgressRepositoryMock) MinimockDeleteUserProgressInspect() {
+	for _, e := range m.DeleteUserProgressMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ProgressRepositoryMock.DeleteUserProgress at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteUserProgressCounter := mm_atomic.LoadUint64(&m.afterDeleteUserProgressCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteUserProgressMock.defaultExpectation != nil && afterDeleteUserProgressCounter < 1 {
+		if m.DeleteUserProgressMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ProgressRepositoryMock.DeleteUserProgress at\n%s", m.DeleteUserProgressMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ProgressRepositoryMock.DeleteUserProgress at\n%s with params: %#v", m.DeleteUserProgressMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserProgressMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteUserProgress != nil && afterDeleteUserProgressCounter < 1 {
+		m.t.Errorf("Expected call to ProgressRepositoryMock.DeleteUserProgress at\n%s", m.funcDeleteUserProgressOrigin)
+	}
+
+	if !m.DeleteUserProgressMock.invocationsDone() && afterDeleteUserProgressCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProgressRepositoryMock.DeleteUserProgress at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUserProgressMock.expectedInvocations), m.DeleteUserProgressMock.expectedInvocationsOrigin, afterDeleteUserProgressCounter)
+	}
+}
+
+type mProgressRepositoryMockGetProgress struct {
+	optional           bool
+	mock               *ProgressRepositoryMock
+	defaultExpectation *ProgressRepositoryMockGetProgressExpectation
+	expectations       []*ProgressRepositoryMockGetProgressExpectation
+
+	callArgs []*ProgressRepositoryMockGetProgressParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ProgressRepositoryMockGetProgressExpectation specifies expectation struct of the ProgressRepository.GetProgress
+type ProgressRepositoryMockGetProgressExpectation struct {
+	mock               *ProgressRepositoryMock
+	params             *ProgressRepositoryMockGetProgressParams
+	paramPtrs          *ProgressRepositoryMockGetProgressParamPtrs
+	expectationOrigins ProgressRepositoryMockGetProgressExpectationOrigins
+	results            *ProgressRepositoryMockGetProgressResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ProgressRepositoryMockGetProgressParams contains parameters of the ProgressRepository.GetProgress
+type ProgressRepositoryMockGetProgressParams struct {
+	ctx     context.Context
+	userID  string
+	movieID string
+}
+
+// ProgressRepositoryMockGetProgressParamPtrs contains pointers to parameters of the ProgressRepository.GetProgress
+type ProgressRepositoryMockGetProgressParamPtrs struct {
+	ctx     *context.Context
+	userID  *string
+	movieID *string
+}
+
+// ProgressRepositoryMockGetProgressResults contains results of the ProgressRepository.GetProgress
+type ProgressRepositoryMockGetProgressResults struct {
+	p1  mm_repository.Progress
+	err error
+}
+
+// ProgressRepositoryMockGetProgressOrigins contains origins of expectations of the ProgressRepository.GetProgress
+type ProgressRepositoryMockGetProgressExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originMovieID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetProgress *mProgressRepositoryMockGetProgress) Optional() *mProgressRepositoryMockGetProgress {
+	mmGetProgress.optional = true
+	return mmGetProgress
+}
+
+// Expect sets up expected params for ProgressRepository.GetProgress
+func (mmGetProgress *mProgressRepositoryMockGetProgress) Expect(ctx context.Context, userID string, movieID string) *mProgressRepositoryMockGetProgress {
+	if mmGetProgress.mock.funcGetProgress != nil {
+		mmGetProgress.mock.t.Fatalf("ProgressRepositoryMock.GetProgress mock is already set by Set")
+	}
+
+	if mmGetProgress.defaultExpectation == nil {
+		mmGetProgress.defaultExpectation = &ProgressRepositoryMockGetProgressExpectation{}
+	}
+
+	if mmGetProgress.defaultExpectation.paramPtrs != nil {
+		mmGetProgress.mock.t.Fatalf("ProgressRepositoryMock.GetProgress mock is already set by ExpectParams functions")
+	}
+
+	mmGetProgress.defaultExpectation.params = &ProgressRepositoryMockGetProgressParams{ctx, userID, movieID}
+	mmGetProgress.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetProgress.expectations {
+		if minimock.Equal(e.params, mmGetProgress.defaultExpectation.params) {
+			mmGetProgress.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetProgress.defaultExpectation.params)
+		}
+	}
+
+	return mmGetProgress
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ProgressRepository.GetProgress
+func (mmGetProgress *mProgressRepositoryMockGetProgress) ExpectCtxParam1(ctx context.Context) *mProgressRepositoryMockGetProgress {
+	if mmGetProgress.mock.funcGetProgress != nil {
+		mmGetProgress.mock.t.Fatalf("ProgressRepositoryMock.GetProgress mock is already set by Set")
+	}
+
+	if mmGetProgress.defaultExpectation == nil {
+		mmGetProgress.defaultExpectation = &ProgressRepositoryMockGetProgressExpectation{}
+	}
+
+	if mmGetProgress.defaultExpectation.params != nil {
+		mmGetProgress.mock.t.Fatalf("ProgressRepositoryMock.GetProgress mock is already set by Expect")
+	}
+
+	if mmGetProgress.defaultExpectation.paramPtrs == nil {
+		mmGetProgress.defaultExpectation.paramPtrs = &ProgressRepositoryMockGetProgressParamPtrs{}
+	}
+	mmGetProgress.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetProgress.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetProgress
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ProgressRepository.GetProgress
+func (mmGetProgress *mProgressRepositoryMockGetProgress) ExpectUserIDParam2(userID string) *mProgressRepositoryMockGetProgress {
+	if mmGetProgress.mock.funcGetProgress != nil {
+		mmGetProgress.mock.t.Fatalf("ProgressRepositoryMock.GetProgress mock is already set by Set")
+	}
+
+	if mmGetProgress.defaultExpectation == nil {
+		mmGetProgress.defaultExpectation = &ProgressRepositoryMockGetProgressExpectation{}
+	}
+
+	if mmGetProgress.defaultExpectation.params != nil {
+		mmGetProgress.mock.t.Fatalf("ProgressRepositoryMock.GetProgress mock is already set by Expect")
+	}
+
+	if mmGetProgress.defaultExpectation.paramPtrs == nil {
+		mmGetProgress.defaultExpectation.paramPtrs = &ProgressRepositoryMockGetProgressParamPtrs{}
+	}
+	mmGetProgress.defaultExpectation.paramPtrs.userID = &userID
+	mmGetProgress.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetProgress
+}
+
+// ExpectMovieIDParam3 sets up expected param movieID for ProgressRepository.GetProgress
+func (mmGetProgress *mProgressRepositoryMockGetProgress) ExpectMovieIDParam3(movieID string) *mProgressRepositoryMockGetProgress {
+	if mmGetProgress.mock.funcGetProgress != nil {
+		mmGetProgress.mock.t.Fatalf("ProgressRepositoryMock.GetProgress mock is already set by Set")
+	}
+
+	if mmGetProgress.defaultExpectation == nil {
+		mmGetProgress.defaultExpectation = &ProgressRepositoryMockGetProgressExpectation{}
+	}
+
+	if mmGetProgress.defaultExpectation.params != nil {
+		mmGetProgress.mock.t.Fatalf("ProgressRepositoryMock.GetProgress mock is already set by Expect")
+	}
+
+	if mmGetProgress.defaultExpectation.paramPtrs == nil {
+		mmGetProgress.defaultExpectation.paramPtrs = &ProgressRepositoryMockGetProgressParamPtrs{}
+	}
+	mmGetProgress.defaultExpectation.paramPtrs.movieID = &movieID
+	mmGetProgress.defaultExpectation.expectationOrigins.originMovieID = minimock.CallerInfo(1)
+
+	return mmGetProgress
+}
+
+// Inspect accepts an inspector function that has same arguments as the ProgressRepository.GetProgress
+func (mmGetProgress *mProgressRepositoryMockGetProgress) Inspect(f func(ctx context.Context, userID string, movieID string)) *mProgressRepositoryMockGetProgress {
+	if mmGetProgress.mock.inspectFuncGetProgress != nil {
+		mmGetProgress.mock.t.Fatalf("Inspect function is already set for ProgressRepositoryMock.GetProgress")
+	}
+
+	mmGetProgress.mock.inspectFuncGetProgress = f
+
+	return mmGetProgress
+}
+
+// Return sets up results that will be returned by ProgressRepository.GetProgress
+func (mmGetProgress *mProgressRepositoryMockGetProgress) Return(p1 mm_repository.Progress, err error) *ProgressRepositoryMock {
+	if mmGetProgress.mock.funcGetProgress != nil {
+		mmGetProgress.mock.t.Fatalf("ProgressRepositoryMock.GetProgress mock is already set by Set")
+	}
+
+	if mmGetProgress.defaultExpectation == nil {
+		mmGetProgress.defaultExpectation = &ProgressRepositoryMockGetProgressExpectation{mock: mmGetProgress.mock}
+	}
+	mmGetProgress.defaultExpectation.results = &ProgressRepositoryMockGetProgressResults{p1, err}
+	mmGetProgress.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetProgress.mock
+}
+
+// Set uses given function f to mock the ProgressRepository.GetProgress method
+func (mmGetProgress *mProgressRepositoryMockGetProgress) Set(f func(ctx context.Context, userID string, movieID string) (p1 mm_repository.Progress, err error)) *ProgressRepositoryMock {
+	if mmGetProgress.defaultExpectation != nil {
+		mmGetProgress.mock.t.Fatalf("Default expectation is already set for the ProgressRepository.GetProgress method")
+	}
+
+	if len(mmGetProgress.expectations) > 0 {
+		mmGetProgress.mock.t.Fatalf("Some expectations are already set for the ProgressRepository.GetProgress method")
+	}
+
+	mmGetProgress.mock.funcGetProgress = f
+	mmGetProgress.mock.funcGetProgressOrigin = minimock.CallerInfo(1)
+	return mmGetProgress.mock
+}
+
+// When sets expectation for the ProgressRepository.GetProgress which will trigger the result defined by the following
+// Then helper
+func (mmGetProgress *mProgressRepositoryMockGetProgress) When(ctx context.Context, userID string, movieID string) *ProgressRepositoryMockGetProgressExpectation {
+	if mmGetProgress.mock.funcGetProgress != nil {
+		mmGetProgress.mock.t.Fatalf("ProgressRepositoryMock.GetProgress mock is already set by Set")
+	}
+
+	expectation := &ProgressRepositoryMockGetProgressExpectation{
+		mock:               mmGetProgress.mock,
+		params:             &ProgressRepositoryMockGetProgressParams{ctx, userID, movieID},
+		expectationOrigins: ProgressRepositoryMockGetProgressExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetProgress.expectations = append(mmGetProgress.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ProgressRepository.GetProgress return parameters for the expectation previously defined by the When method
+func (e *ProgressRepositoryMockGetProgressExpectation) Then(p1 mm_repository.Progress, err error) *ProgressRepositoryMock {
+	e.results = &ProgressRepositoryMockGetProgressResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times ProgressRepository.GetProgress should be invoked
+func (mmGetProgress *mProgressRepositoryMockGetProgress) Times(n uint64) *mProgressRepositoryMockGetProgress {
+	if n == 0 {
+		mmGetProgress.mock.t.Fatalf("Times of ProgressRepositoryMock.GetProgress mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetProgress.expectedInvocations, n)
+	mmGetProgress.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetProgress
+}
+
+func (mmGetProgress *mProgressRepositoryMockGetProgress) invocationsDone() bool {
+	if len(mmGetProgress.expectations) == 0 && mmGetProgress.defaultExpectation == nil && mmGetProgress.mock.funcGetProgress == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetProgress.mock.afterGetProgressCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetProgress.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetProgress implements mm_repository.ProgressRepository
+func (mmGetProgress *ProgressRepositoryMock) GetProgress(ctx context.Context, userID string, movieID string) (p1 mm_repository.Progress, err error) {
+	mm_atomic.AddUint64(&mmGetProgress.beforeGetProgressCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetProgress.afterGetProgressCounter, 1)
+
+	mmGetProgress.t.Helper()
+
+	if mmGetProgress.inspectFuncGetProgress != nil {
+		mmGetProgress.inspectFuncGetProgress(ctx, userID, movieID)
+	}
+
+	mm_params := ProgressRepositoryMockGetProgressParams{ctx, userID, movieID}
+
+	// Record call args
+	mmGetProgress.GetProgressMock.mutex.Lock()
+	mmGetProgress.GetProgressMock.callArgs = append(mmGetProgress.GetProgressMock.callArgs, &mm_params)
+	mmGetProgress.GetProgressMock.mutex.Unlock()
+
+	for _, e := range mmGetProgress.GetProgressMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmGetProgress.GetProgressMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetProgress.GetProgressMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetProgress.GetProgressMock.defaultExpectation.params
+		mm_want_ptrs := mmGetProgress.GetProgressMock.defaultExpectation.paramPtrs
+
+		mm_got := ProgressRepositoryMockGetProgressParams{ctx, userID, movieID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetProgress.t.Errorf("ProgressRepositoryMock.GetProgress got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetProgress.GetProgressMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetProgress.t.Errorf("ProgressRepositoryMock.GetProgress got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetProgress.GetProgressMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.movieID != nil && !minimock.Equal(*mm_want_ptrs.movieID, mm_got.movieID) {
+				mmGetProgress.t.Errorf("ProgressRepositoryMock.GetProgress got unexpected parameter movieID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetProgress.GetProgressMock.defaultExpectation.expectationOrigins.originMovieID, *mm_want_ptrs.movieID, mm_got.movieID, minimock.Diff(*mm_want_ptrs.movieID, mm_got.movieID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetProgress.t.Errorf("ProgressRepositoryMock.GetProgress got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetProgress.GetProgressMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetProgress.GetProgressMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetProgress.t.Fatal("No results are set for the ProgressRepositoryMock.GetProgress")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGetProgress.funcGetProgress != nil {
+		return mmGetProgress.funcGetProgress(ctx, userID, movieID)
+	}
+	mmGetProgress.t.Fatalf("Unexpected call to ProgressRepositoryMock.GetProgress. %v %v %v", ctx, userID, movieID)
+	return
+}
+
+// GetProgressAfterCounter returns a count of finished ProgressRepositoryMock.GetProgress invocations
+func (mmGetProgress *ProgressRepositoryMock) GetProgressAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetProgress.afterGetProgressCounter)
+}
+
+// GetProgressBeforeCounter returns a count of ProgressRepositoryMock.GetProgress invocations
+func (mmGetProgress *ProgressRepositoryMock) GetProgressBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetProgress.beforeGetProgressCounter)
+}
+
+// Calls returns a list of arguments used in each call to ProgressRepositoryMock.GetProgress.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetProgress *mProgressRepositoryMockGetProgress) Calls() []*ProgressRepositoryMockGetProgressParams {
+	mmGetProgress.mutex.RLock()
+
+	argCopy := make([]*ProgressRepositoryMockGetProgressParams, len(mmGetProgress.callArgs))
+	copy(argCopy, mmGetProgress.callArgs)
+
+	mmGetProgress.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetProgressDone returns true if the count of the GetProgress invocations corresponds
+// the number of defined expectations
+func (m *ProgressRepositoryMock) MinimockGetProgressDone() bool {
+	if m.GetProgressMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetProgressMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetProgressMock.invocationsDone()
+}
+
+// MinimockGetProgressInspect logs each unmet expectation
+func (m *ProgressRepositoryMock) MinimockGetProgressInspect() {
+	for _, e := range m.GetProgressMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ProgressRepositoryMock.GetProgress at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetProgressCounter := mm_atomic.LoadUint64(&m.afterGetProgressCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetProgressMock.defaultExpectation != nil && afterGetProgressCounter < 1 {
+		if m.GetProgressMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ProgressRepositoryMock.GetProgress at\n%s", m.GetProgressMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ProgressRepositoryMock.GetProgress at\n%s with params: %#v", m.GetProgressMock.defaultExpectation.expectationOrigins.origin, *m.GetProgressMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetProgress != nil && afterGetProgressCounter < 1 {
+		m.t.Errorf("Expected call to ProgressRepositoryMock.GetProgress at\n%s", m.funcGetProgressOrigin)
+	}
+
+	if !m.GetProgressMock.invocationsDone() && afterGetProgressCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProgressRepositoryMock.GetProgress at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetProgressMock.expectedInvocations), m.GetProgressMock.expectedInvocationsOrigin, afterGetProgressCounter)
+	}
+}
+
+type mProgressRepositoryMockListProgress struct {
+	optional           bool
+	mock               *ProgressRepositoryMock
+	defaultExpectation *ProgressRepositoryMockListProgressExpectation
+	expectations       []*ProgressRepositoryMockListProgressExpectation
+
+	callArgs []*ProgressRepositoryMockListProgressParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ProgressRepositoryMockListProgressExpectation specifies expectation struct of the ProgressRepository.ListProgress
+type ProgressRepositoryMockListProgressExpectation struct {
+	mock               *ProgressRepositoryMock
+	params             *ProgressRepositoryMockListProgressParams
+	paramPtrs          *ProgressRepositoryMockListProgressParamPtrs
+	expectationOrigins ProgressRepositoryMockListProgressExpectationOrigins
+	results            *ProgressRepositoryMockListProgressResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ProgressRepositoryMockListProgressParams contains parameters of the ProgressRepository.ListProgress
+type ProgressRepositoryMockListProgressParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// ProgressRepositoryMockListProgressParamPtrs contains pointers to parameters of the ProgressRepository.ListProgress
+type ProgressRepositoryMockListProgressParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// ProgressRepositoryMockListProgressResults contains results of the ProgressRepository.ListProgress
+type ProgressRepositoryMockListProgressResults struct {
+	pa1 []mm_repository.Progress
+	err error
+}
+
+// ProgressRepositoryMockListProgressOrigins contains origins of expectations of the ProgressRepository.ListProgress
+type ProgressRepositoryMockListProgressExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListProgress *mProgressRepositoryMockListProgress) Optional() *mProgressRepositoryMockListProgress {
+	mmListProgress.optional = true
+	return mmListProgress
+}
+
+// Expect sets up expected params for ProgressRepository.ListProgress
+func (mmListProgress *mProgressRepositoryMockListProgress) Expect(ctx context.Context, userID string) *mProgressRepositoryMockListProgress {
+	if mmListProgress.mock.funcListProgress != nil {
+		mmListProgress.mock.t.Fatalf("ProgressRepositoryMock.ListProgress mock is already set by Set")
+	}
+
+	if mmListProgress.defaultExpectation == nil {
+		mmListProgress.defaultExpectation = &ProgressRepositoryMockListProgressExpectation{}
+	}
+
+	if mmListProgress.defaultExpectation.paramPtrs != nil {
+		mmListProgress.mock.t.Fatalf("ProgressRepositoryMock.ListProgress mock is already set by ExpectParams functions")
+	}
+
+	mmListProgress.defaultExpectation.params = &ProgressRepositoryMockListProgressParams{ctx, userID}
+	mmListProgress.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListProgress.expectations {
+		if minimock.Equal(e.params, mmListProgress.defaultExpectation.params) {
+			mmListProgress.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListProgress.defaultExpectation.params)
+		}
+	}
+
+	return mmListProgress
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ProgressRepository.ListProgress
+func (mmListProgress *mProgressRepositoryMockListProgress) ExpectCtxParam1(ctx context.Context) *mProgressRepositoryMockListProgress {
+	if mmListProgress.mock.funcListProgress != nil {
+		mmListProgress.mock.t.Fatalf("ProgressRepositoryMock.ListProgress mock is already set by Set")
+	}
+
+	if mmListProgress.defaultExpectation == nil {
+		mmListProgress.defaultExpectation = &ProgressRepositoryMockListProgressExpectation{}
+	}
+
+	if mmListProgress.defaultExpectation.params != nil {
+		mmListProgress.mock.t.Fatalf("ProgressRepositoryMock.ListProgress mock is already set by Expect")
+	}
+
+	if mmListProgress.defaultExpectation.paramPtrs == nil {
+		mmListProgress.defaultExpectation.paramPtrs = &ProgressRepositoryMockListProgressParamPtrs{}
+	}
+	mmListProgress.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListProgress.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListProgress
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ProgressRepository.ListProgress
+func (mmListProgress *mProgressRepositoryMockListProgress) ExpectUserIDParam2(userID string) *mProgressRepositoryMockListProgress {
+	if mmListProgress.mock.funcListProgress != nil {
+		mmListProgress.mock.t.Fatalf("ProgressRepositoryMock.ListProgress mock is already set by Set")
+	}
+
+	if mmListProgress.defaultExpectation == nil {
+		mmListProgress.defaultExpectation = &ProgressRepositoryMockListProgressExpectation{}
+	}
+
+	if mmListProgress.defaultExpectation.params != nil {
+		mmListProgress.mock.t.Fatalf("ProgressRepositoryMock.ListProgress mock is already set by Expect")
+	}
+
+	if mmListProgress.defaultExpectation.paramPtrs == nil {
+		mmListProgress.defaultExpectation.paramPtrs = &ProgressRepositoryMockListProgressParamPtrs{}
+	}
+	mmListProgress.defaultExpectation.paramPtrs.userID = &userID
+	mmListProgress.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListProgress
+}
+
+// Inspect accepts an inspector function that has same arguments as the ProgressRepository.ListProgress
+func (mmListProgress *mProgressRepositoryMockListProgress) Inspect(f func(ctx context.Context, userID string)) *mProgressRepositoryMockListProgress {
+	if mmListProgress.mock.inspectFuncListProgress != nil {
+		mmListProgress.mock.t.Fatalf("Inspect function is already set for ProgressRepositoryMock.ListProgress")
+	}
+
+	mmListProgress.mock.inspectFuncListProgress = f
+
+	return mmListProgress
+}
+
+// Return sets up results that will be returned by ProgressRepository.ListProgress
+func (mmListProgress *mProgressRepositoryMockListProgress) Return(pa1 []mm_repository.Progress, err error) *ProgressRepositoryMock {
+	if mmListProgress.mock.funcListProgress != nil {
+		mmListProgress.mock.t.Fatalf("ProgressRepositoryMock.ListProgress mock is already set by Set")
+	}
+
+	if mmListProgress.defaultExpectation == nil {
+		mmListProgress.defaultExpectation = &ProgressRepositoryMockListProgressExpectation{mock: mmListProgress.mock}
+	}
+	mmListProgress.defaultExpectation.results = &ProgressRepositoryMockListProgressResults{pa1, err}
+	mmListProgress.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListProgress.mock
+}
+
+// Set uses given function f to mock the ProgressRepository.ListProgress method
+func (mmListProgress *mProgressRepositoryMockListProgress) Set(f func(ctx context.Context, userID string) (pa1 []mm_repository.Progress, err error)) *ProgressRepositoryMock {
+	if mmListProgress.defaultExpectation != nil {
+		mmListProgress.mock.t.Fatalf("Default expectation is already set for the ProgressRepository.ListProgress method")
+	}
+
+	if len(mmListProgress.expectations) > 0 {
+		mmListProgress.mock.t.Fatalf("Some expectations are already set for the ProgressRepository.ListProgress method")
+	}
+
+	mmListProgress.mock.funcListProgress = f
+	mmListProgress.mock.funcListProgressOrigin = minimock.CallerInfo(1)
+	return mmListProgress.mock
+}
+
+// When sets expectation for the ProgressRepository.ListProgress which will trigger the result defined by the following
+// Then helper
+func (mmListProgress *mProgressRepositoryMockListProgress) When(ctx context.Context, userID string) *ProgressRepositoryMockListProgressExpectation {
+	if mmListProgress.mock.funcListProgress != nil {
+		mmListProgress.mock.t.Fatalf("ProgressRepositoryMock.ListProgress mock is already set by Set")
+	}
+
+	expectation := &ProgressRepositoryMockListProgressExpectation{
+		mock:               mmListProgress.mock,
+		params:             &ProgressRepositoryMockListProgressParams{ctx, userID},
+		expectationOrigins: ProgressRepositoryMockListProgressExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListProgress.expectations = append(mmListProgress.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ProgressRepository.ListProgress return parameters for the expectation previously defined by the When method
+func (e *ProgressRepositoryMockListProgressExpectation) Then(pa1 []mm_repository.Progress, err error) *ProgressRepositoryMock {
+	e.results = &ProgressRepositoryMockListProgressResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times ProgressRepository.ListProgress should be invoked
+func (mmListProgress *mProgressRepositoryMockListProgress) Times(n uint64) *mProgressRepositoryMockListProgress {
+	if n == 0 {
+		mmListProgress.mock.t.Fatalf("Times of ProgressRepositoryMock.ListProgress mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListProgress.expectedInvocations, n)
+	mmListProgress.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListProgress
+}
+
+func (mmListProgress *mProgressRepositoryMockListProgress) invocationsDone() bool {
+	if len(mmListProgress.expectations) == 0 && mmListProgress.defaultExpectation == nil && mmListProgress.mock.funcListProgress == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListProgress.mock.afterListProgressCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListProgress.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListProgress implements mm_repository.ProgressRepository
+func (mmListProgress *ProgressRepositoryMock) ListProgress(ctx context.Context, userID string) (pa1 []mm_repository.Progress, err error) {
+	mm_atomic.AddUint64(&mmListProgress.beforeListProgressCounter, 1)
+	defer mm_atomic.AddUint64(&mmListProgress.afterListProgressCounter, 1)
+
+	mmListProgress.t.Helper()
+
+	if mmListProgress.inspectFuncListProgress != nil {
+		mmListProgress.inspectFuncListProgress(ctx, userID)
+	}
+
+	mm_params := ProgressRepositoryMockListProgressParams{ctx, userID}
+
+	// Record call args
+	mmListProgress.ListProgressMock.mutex.Lock()
+	mmListProgress.ListProgressMock.callArgs = append(mmListProgress.ListProgressMock.callArgs, &mm_params)
+	mmListProgress.ListProgressMock.mutex.Unlock()
+
+	for _, e := range mmListProgress.ListProgressMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmListProgress.ListProgressMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListProgress.ListProgressMock.defaultExpectation.Counter, 1)
+		mm_want := mmListProgress.ListProgressMock.defaultExpectation.params
+		mm_want_ptrs := mmListProgress.ListProgressMock.defaultExpectation.paramPtrs
+
+		mm_got := ProgressRepositoryMockListProgressParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListProgress.t.Errorf("ProgressRepositoryMock.ListProgress got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListProgress.ListProgressMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListProgress.t.Errorf("ProgressRepositoryMock.ListProgress got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListProgress.ListProgressMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListProgress.t.Errorf("ProgressRepositoryMock.ListProgress got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListProgress.ListProgressMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListProgress.ListProgressMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListProgress.t.Fatal("No results are set for the ProgressRepositoryMock.ListProgress")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmListProgress.funcListProgress != nil {
+		return mmListProgress.funcListProgress(ctx, userID)
+	}
+	mmListProgress.t.Fatalf("Unexpected call to ProgressRepositoryMock.ListProgress. %v %v", ctx, userID)
+	return
+}
+
+// ListProgressAfterCounter returns a count of finished ProgressRepositoryMock.ListProgress invocations
+func (mmListProgress *ProgressRepositoryMock) ListProgressAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListProgress.afterListProgressCounter)
+}
+
+// ListProgressBeforeCounter returns a count of ProgressRepositoryMock.ListProgress invocations
+func (mmListProgress *ProgressRepositoryMock) ListProgressBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListProgress.beforeListProgressCounter)
+}
+
+// Calls returns a list of arguments used in each call to ProgressRepositoryMock.ListProgress.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListProgress *mProgressRepositoryMockListProgress) Calls() []*ProgressRepositoryMockListProgressParams {
+	mmListProgress.mutex.RLock()
+
+	argCopy := make([]*ProgressRepositoryMockListProgressParams, len(mmListProgress.callArgs))
+	copy(argCopy, mmListProgress.callArgs)
+
+	mmListProgress.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListProgressDone returns true if the count of the ListProgress invocations corresponds
+// the number of defined expectations
+func (m *ProgressRepositoryMock) MinimockListProgressDone() bool {
+	if m.ListProgressMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListProgressMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListProgressMock.invocationsDone()
+}
+
+// MinimockListProgressInspect logs each unmet expectation
+func (m *ProgressRepositoryMock) MinimockListProgressInspect() {
+	for _, e := range m.ListProgressMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ProgressRepositoryMock.ListProgress at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListProgressCounter := mm_atomic.LoadUint64(&m.afterListProgressCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListProgressMock.defaultExpectation != nil && afterListProgressCounter < 1 {
+		if m.ListProgressMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ProgressRepositoryMock.ListProgress at\n%s", m.ListProgressMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ProgressRepositoryMock.ListProgress at\n%s with params: %#v", m.ListProgressMock.defaultExpectation.expectationOrigins.origin, *m.ListProgressMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListProgress != nil && afterListProgressCounter < 1 {
+		m.t.Errorf("Expected call to ProgressRepositoryMock.ListProgress at\n%s", m.funcListProgressOrigin)
+	}
+
+	if !m.ListProgressMock.invocationsDone() && afterListProgressCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProgressRepositoryMock.ListProgress at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListProgressMock.expectedInvocations), m.ListProgressMock.expectedInvocationsOrigin, afterListProgressCounter)
+	}
+}
+
+type mProgressRepositoryMockSaveProgress struct {
+	optional           bool
+	mock               *ProgressRepositoryMock
+	defaultExpectation *ProgressRepositoryMockSaveProgressExpectation
+	expectations       []*ProgressRepositoryMockSaveProgressExpectation
+
+	callArgs []*ProgressRepositoryMockSaveProgressParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ProgressRepositoryMockSaveProgressExpectation specifies expectation struct of the ProgressRepository.SaveProgress
+type ProgressRepositoryMockSaveProgressExpectation struct {
+	mock               *ProgressRepositoryMock
+	params             *ProgressRepositoryMockSaveProgressParams
+	paramPtrs          *ProgressRepositoryMockSaveProgressParamPtrs
+	expectationOrigins ProgressRepositoryMockSaveProgressExpectationOrigins
+	results            *ProgressRepositoryMockSaveProgressResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ProgressRepositoryMockSaveProgressParams contains parameters of the ProgressRepository.SaveProgress
+type ProgressRepositoryMockSaveProgressParams struct {
+	ctx      context.Context
+	progress []mm_repository.Progress
+}
+
+// ProgressRepositoryMockSaveProgressParamPtrs contains pointers to parameters of the ProgressRepository.SaveProgress
+type ProgressRepositoryMockSaveProgressParamPtrs struct {
+	ctx      *context.Context
+	progress *[]mm_repository.Progress
+}
+
+// ProgressRepositoryMockSaveProgressResults contains results of the ProgressRepository.SaveProgress
+type ProgressRepositoryMockSaveProgressResults struct {
+	err error
+}
+
+// ProgressRepositoryMockSaveProgressOrigins contains origins of expectations of the ProgressRepository.SaveProgress
+type ProgressRepositoryMockSaveProgressExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originProgress string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveProgress *mProgressRepositoryMockSaveProgress) Optional() *mProgressRepositoryMockSaveProgress {
+	mmSaveProgress.optional = true
+	return mmSaveProgress
+}
+
+// Expect sets up expected params for ProgressRepository.SaveProgress
+func (mmSaveProgress *mProgressRepositoryMockSaveProgress) Expect(ctx context.Context, progress []mm_repository.Progress) *mProgressRepositoryMockSaveProgress {
+	if mmSaveProgress.mock.funcSaveProgress != nil {
+		mmSaveProgress.mock.t.Fatalf("ProgressRepositoryMock.SaveProgress mock is already set by Set")
+	}
+
+	if mmSaveProgress.defaultExpectation == nil {
+		mmSaveProgress.defaultExpectation = &ProgressRepositoryMockSaveProgressExpectation{}
+	}
+
+	if mmSaveProgress.defaultExpectation.paramPtrs != nil {
+		mmSaveProgress.mock.t.Fatalf("ProgressRepositoryMock.SaveProgress mock is already set by ExpectParams functions")
+	}
+
+	mmSaveProgress.defaultExpectation.params = &ProgressRepositoryMockSaveProgressParams{ctx, progress}
+	mmSaveProgress.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveProgress.expectations {
+		if minimock.Equal(e.params, mmSaveProgress.defaultExpectation.params) {
+			mmSaveProgress.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveProgress.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveProgress
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ProgressRepository.SaveProgress
+func (mmSaveProgress *mProgressRepositoryMockSaveProgress) ExpectCtxParam1(ctx context.Context) *mProgressRepositoryMockSaveProgress {
+	if mmSaveProgress.mock.funcSaveProgress != nil {
+		mmSaveProgress.mock.t.Fatalf("ProgressRepositoryMock.SaveProgress mock is already set by Set")
+	}
+
+	if mmSaveProgress.defaultExpectation == nil {
+		mmSaveProgress.defaultExpectation = &ProgressRepositoryMockSaveProgressExpectation{}
+	}
+
+	if mmSaveProgress.defaultExpectation.params != nil {
+		mmSaveProgress.mock.t.Fatalf("ProgressRepositoryMock.SaveProgress mock is already set by Expect")
+	}
+
+	if mmSaveProgress.defaultExpectation.paramPtrs == nil {
+		mmSaveProgress.defaultExpectation.paramPtrs = &ProgressRepositoryMockSaveProgressParamPtrs{}
+	}
+	mmSaveProgress.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveProgress.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveProgress
+}
+
+// ExpectProgressParam2 sets up expected param progress for ProgressRepository.SaveProgress
+func (mmSaveProgress *mProgressRepositoryMockSaveProgress) ExpectProgressParam2(progress []mm_repository.Progress) *mProgressRepositoryMockSaveProgress {
+	if mmSaveProgress.mock.funcSaveProgress != nil {
+		mmSaveProgress.mock.t.Fatalf("ProgressRepositoryMock.SaveProgress mock is already set by Set")
+	}
+
+	if mmSaveProgress.defaultExpectation == nil {
+		mmSaveProgress.defaultExpectation = &ProgressRepositoryMockSaveProgressExpectation{}
+	}
+
+	if mmSaveProgress.defaultExpectation.params != nil {
+		mmSaveProgress.mock.t.Fatalf("ProgressRepositoryMock.SaveProgress mock is already set by Expect")
+	}
+
+	if mmSaveProgress.defaultExpectation.paramPtrs == nil {
+		mmSaveProgress.defaultExpectation.paramPtrs = &ProgressRepositoryMockSaveProgressParamPtrs{}
+	}
+	mmSaveProgress.defaultExpectation.paramPtrs.progress = &progress
+	mmSaveProgress.defaultExpectation.expectationOrigins.originProgress = minimock.CallerInfo(1)
+
+	return mmSaveProgress
+}
+
+// Inspect accepts an inspector function that has same arguments as the ProgressRepository.SaveProgress
+func (mmSaveProgress *mProgressRepositoryMockSaveProgress) Inspect(f func(ctx context.Context, progress []mm_repository.Progress)) *mProgressRepositoryMockSaveProgress {
+	if mmSaveProgress.mock.inspectFuncSaveProgress != nil {
+		mmSaveProgress.mock.t.Fatalf("Inspect function is already set for ProgressRepositoryMock.SaveProgress")
+	}
+
+	mmSaveProgress.mock.inspectFuncSaveProgress = f
+
+	return mmSaveProgress
+}
+
+// Return sets up results that will be returned by ProgressRepository.SaveProgress
+func (mmSaveProgress *mProgressRepositoryMockSaveProgress) Return(err error) *ProgressRepositoryMock {
+	if mmSaveProgress.mock.funcSaveProgress != nil {
+		mmSaveProgress.mock.t.Fatalf("ProgressRepositoryMock.SaveProgress mock is already set by Set")
+	}
+
+	if mmSaveProgress.defaultExpectation == nil {
+		mmSaveProgress.defaultExpectation = &ProgressRepositoryMockSaveProgressExpectation{mock: mmSaveProgress.mock}
+	}
+	mmSaveProgress.defaultExpectation.results = &ProgressRepositoryMockSaveProgressResults{err}
+	mmSaveProgress.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveProgress.mock
+}
+
+// Set uses given function f to mock the ProgressRepository.SaveProgress method
+func (mmSaveProgress *mProgressRepositoryMockSaveProgress) Set(f func(ctx context.Context, progress []mm_repository.Progress) (err error)) *ProgressRepositoryMock {
+	if mmSaveProgress.defaultExpectation != nil {
+		mmSaveProgress.mock.t.Fatalf("Default expectation is already set for the ProgressRepository.SaveProgress method")
+	}
+
+	if len(mmSaveProgress.expectations) > 0 {
+		mmSaveProgress.mock.t.Fatalf("Some expectations are already set for the ProgressRepository.SaveProgress method")
+	}
+
+	mmSaveProgress.mock.funcSaveProgress = f
+	mmSaveProgress.mock.funcSaveProgressOrigin = minimock.CallerInfo(1)
+	return mmSaveProgress.mock
+}
+
+// When sets expectation for the ProgressRepository.SaveProgress which will trigger the result defined by the following
+// Then helper
+func (mmSaveProgress *mProgressRepositoryMockSaveProgress) When(ctx context.Context, progress []mm_repository.Progress) *ProgressRepositoryMockSaveProgressExpectation {
+	if mmSaveProgress.mock.funcSaveProgress != nil {
+		mmSaveProgress.mock.t.Fatalf("ProgressRepositoryMock.SaveProgress mock is already set by Set")
+	}
+
+	expectation := &ProgressRepositoryMockSaveProgressExpectation{
+		mock:               mmSaveProgress.mock,
+		params:             &ProgressRepositoryMockSaveProgressParams{ctx, progress},
+		expectationOrigins: ProgressRepositoryMockSaveProgressExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveProgress.expectations = append(mmSaveProgress.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ProgressRepository.SaveProgress return parameters for the expectation previously defined by the When method
+func (e *ProgressRepositoryMockSaveProgressExpectation) Then(err error) *ProgressRepositoryMock {
+	e.results = &ProgressRepositoryMockSaveProgressResults{err}
+	return e.mock
+}
+
+// Times sets number of times ProgressRepository.SaveProgress should be invoked
+func (mmSaveProgress *mProgressRepositoryMockSaveProgress) Times(n uint64) *mProgressRepositoryMockSaveProgress {
+	if n == 0 {
+		mmSaveProgress.mock.t.Fatalf("Times of ProgressRepositoryMock.SaveProgress mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveProgress.expectedInvocations, n)
+	mmSaveProgress.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveProgress
+}
+
+func (mmSaveProgress *mProgressRepositoryMockSaveProgress) invocationsDone() bool {
+	if len(mmSaveProgress.expectations) == 0 && mmSaveProgress.defaultExpectation == nil && mmSaveProgress.mock.funcSaveProgress == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveProgress.mock.afterSaveProgressCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveProgress.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveProgress implements mm_repository.ProgressRepository
+func (mmSaveProgress *ProgressRepositoryMock) SaveProgress(ctx context.Context, progress []mm_repository.Progress) (err error) {
+	mm_atomic.AddUint64(&mmSaveProgress.beforeSaveProgressCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveProgress.afterSaveProgressCounter, 1)
+
+	mmSaveProgress.t.Helper()
+
+	if mmSaveProgress.inspectFuncSaveProgress != nil {
+		mmSaveProgress.inspectFuncSaveProgress(ctx, progress)
+	}
+
+	mm_params := ProgressRepositoryMockSaveProgressParams{ctx, progress}
+
+	// Record call args
+	mmSaveProgress.SaveProgressMock.mutex.Lock()
+	mmSaveProgress.SaveProgressMock.callArgs = append(mmSaveProgress.SaveProgressMock.callArgs, &mm_params)
+	mmSaveProgress.SaveProgressMock.mutex.Unlock()
+
+	for _, e := range mmSaveProgress.SaveProgressMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveProgress.SaveProgressMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveProgress.SaveProgressMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveProgress.SaveProgressMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveProgress.SaveProgressMock.defaultExpectation.paramPtrs
+
+		mm_got := ProgressRepositoryMockSaveProgressParams{ctx, progress}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveProgress.t.Errorf("ProgressRepositoryMock.SaveProgress got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveProgress.SaveProgressMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.progress != nil && !minimock.Equal(*mm_want_ptrs.progress, mm_got.progress) {
+				mmSaveProgress.t.Errorf("ProgressRepositoryMock.SaveProgress got unexpected parameter progress, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveProgress.SaveProgressMock.defaultExpectation.expectationOrigins.originProgress, *mm_want_ptrs.progress, mm_got.progress, minimock.Diff(*mm_want_ptrs.progress, mm_got.progress))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveProgress.t.Errorf("ProgressRepositoryMock.SaveProgress got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveProgress.SaveProgressMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveProgress.SaveProgressMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveProgress.t.Fatal("No results are set for the ProgressRepositoryMock.SaveProgress")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveProgress.funcSaveProgress != nil {
+		return mmSaveProgress.funcSaveProgress(ctx, progress)
+	}
+	mmSaveProgress.t.Fatalf("Unexpected call to ProgressRepositoryMock.SaveProgress. %v %v", ctx, progress)
+	return
+}
+
+// SaveProgressAfterCounter returns a count of finished ProgressRepositoryMock.SaveProgress invocations
+func (mmSaveProgress *ProgressRepositoryMock) SaveProgressAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveProgress.afterSaveProgressCounter)
+}
+
+// SaveProgressBeforeCounter returns a count of ProgressRepositoryMock.SaveProgress invocations
+func (mmSaveProgress *ProgressRepositoryMock) SaveProgressBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveProgress.beforeSaveProgressCounter)
+}
+
+// Calls returns a list of arguments used in each call to ProgressRepositoryMock.SaveProgress.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveProgress *mProgressRepositoryMockSaveProgress) Calls() []*ProgressRepositoryMockSaveProgressParams {
+	mmSaveProgress.mutex.RLock()
+
+	argCopy := make([]*ProgressRepositoryMockSaveProgressParams, len(mmSaveProgress.callArgs))
+	copy(argCopy, mmSaveProgress.callArgs)
+
+	mmSaveProgress.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveProgressDone returns true if the count of the SaveProgress invocations corresponds
+// the number of defined expectations
+func (m *ProgressRepositoryMock) MinimockSaveProgressDone() bool {
+	if m.SaveProgressMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveProgressMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveProgressMock.invocationsDone()
+}
+
+// MinimockSaveProgressInspect logs each unmet expectation
+func (m *ProgressRepositoryMock) MinimockSaveProgressInspect() {
+	for _, e := range m.SaveProgressMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ProgressRepositoryMock.SaveProgress at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveProgressCounter := mm_atomic.LoadUint64(&m.afterSaveProgressCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveProgressMock.defaultExpectation != nil && afterSaveProgressCounter < 1 {
+		if m.SaveProgressMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ProgressRepositoryMock.SaveProgress at\n%s", m.SaveProgressMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ProgressRepositoryMock.SaveProgress at\n%s with params: %#v", m.SaveProgressMock.defaultExpectation.expectationOrigins.origin, *m.SaveProgressMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveProgress != nil && afterSaveProgressCounter < 1 {
+		m.t.Errorf("Expected call to ProgressRepositoryMock.SaveProgress at\n%s", m.funcSaveProgressOrigin)
+	}
+
+	if !m.SaveProgressMock.invocationsDone() && afterSaveProgressCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProgressRepositoryMock.SaveProgress at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveProgressMock.expectedInvocations), m.SaveProgressMock.expectedInvocationsOrigin, afterSaveProgressCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ProgressRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDeleteUserProgressInspect()
+
+			m.MinimockGetProgressInspect()
+
+			m.MinimockListProgressInspect()
+
+			m.MinimockSaveProgressInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ProgressRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ProgressRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeleteUserProgressDone() &&
+		m.MinimockGetProgressDone() &&
+		m.MinimockListProgressDone() &&
+		m.MinimockSaveProgressDone()
+}
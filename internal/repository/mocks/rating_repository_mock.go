@@ -0,0 +1,1191 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/maisiq/go-ugc-service/internal/repository.RatingRepository -o rating_repository_mock.go -n RatingRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_repository "github.com/maisiq/go-ugc-service/internal/repository"
+)
+
+// RatingRepositoryMock implements mm_repository.RatingRepository
+type RatingRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetRating          func(ctx context.Context, movieID string) (m1 mm_repository.MovieRating, err error)
+	funcGetRatingOrigin    string
+	inspectFuncGetRating   func(ctx context.Context, movieID string)
+	afterGetRatingCounter  uint64
+	beforeGetRatingCounter uint64
+	GetRatingMock          mRatingRepositoryMockGetRating
+
+	funcGetSummaries          func(ctx context.Context, movieIDs []string) (ra1 []mm_repository.ReviewSummary, err error)
+	funcGetSummariesOrigin    string
+	inspectFuncGetSummaries   func(ctx context.Context, movieIDs []string)
+	afterGetSummariesCounter  uint64
+	beforeGetSummariesCounter uint64
+	GetSummariesMock          mRatingRepositoryMockGetSummaries
+
+	funcUpdateRating          func(ctx context.Context, movieID string, oldRating int32, newRating int32) (err error)
+	funcUpdateRatingOrigin    string
+	inspectFuncUpdateRating   func(ctx context.Context, movieID string, oldRating int32, newRating int32)
+	afterUpdateRatingCounter  uint64
+	beforeUpdateRatingCounter uint64
+	UpdateRatingMock          mRatingRepositoryMockUpdateRating
+}
+
+// NewRatingRepositoryMock returns a mock for mm_repository.RatingRepository
+func NewRatingRepositoryMock(t minimock.Tester) *RatingRepositoryMock {
+	m := &RatingRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetRatingMock = mRatingRepositoryMockGetRating{mock: m}
+	m.GetRatingMock.callArgs = []*RatingRepositoryMockGetRatingParams{}
+
+	m.GetSummariesMock = mRatingRepositoryMockGetSummaries{mock: m}
+	m.GetSummariesMock.callArgs = []*RatingRepositoryMockGetSummariesParams{}
+
+	m.UpdateRatingMock = mRatingRepositoryMockUpdateRating{mock: m}
+	m.UpdateRatingMock.callArgs = []*RatingRepositoryMockUpdateRatingParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRatingRepositoryMockGetRating struct {
+	optional           bool
+	mock               *RatingRepositoryMock
+	defaultExpectation *RatingRepositoryMockGetRatingExpectation
+	expectations       []*RatingRepositoryMockGetRatingExpectation
+
+	callArgs []*RatingRepositoryMockGetRatingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RatingRepositoryMockGetRatingExpectation specifies expectation struct of the RatingRepository.GetRating
+type RatingRepositoryMockGetRatingExpectation struct {
+	mock               *RatingRepositoryMock
+	params             *RatingRepositoryMockGetRatingParams
+	paramPtrs          *RatingRepositoryMockGetRatingParamPtrs
+	expectationOrigins RatingRepositoryMockGetRatingExpectationOrigins
+	results            *RatingRepositoryMockGetRatingResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RatingRepositoryMockGetRatingParams contains parameters of the RatingRepository.GetRating
+type RatingRepositoryMockGetRatingParams struct {
+	ctx     context.Context
+	movieID string
+}
+
+// RatingRepositoryMockGetRatingParamPtrs contains pointers to parameters of the RatingRepository.GetRating
+type RatingRepositoryMockGetRatingParamPtrs struct {
+	ctx     *context.Context
+	movieID *string
+}
+
+// RatingRepositoryMockGetRatingResults contains results of the RatingRepository.GetRating
+type RatingRepositoryMockGetRatingResults struct {
+	m1  mm_repository.MovieRating
+	err error
+}
+
+// RatingRepositoryMockGetRatingOrigins contains origins of expectations of the RatingRepository.GetRating
+type RatingRepositoryMockGetRatingExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originMovieID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetRating *mRatingRepositoryMockGetRating) Optional() *mRatingRepositoryMockGetRating {
+	mmGetRating.optional = true
+	return mmGetRating
+}
+
+// Expect sets up expected params for RatingRepository.GetRating
+func (mmGetRating *mRatingRepositoryMockGetRating) Expect(ctx context.Context, movieID string) *mRatingRepositoryMockGetRating {
+	if mmGetRating.mock.funcGetRating != nil {
+		mmGetRating.mock.t.Fatalf("RatingRepositoryMock.GetRating mock is already set by Set")
+	}
+
+	if mmGetRating.defaultExpectation == nil {
+		mmGetRating.defaultExpectation = &RatingRepositoryMockGetRatingExpectation{}
+	}
+
+	if mmGetRating.defaultExpectation.paramPtrs != nil {
+		mmGetRating.mock.t.Fatalf("RatingRepositoryMock.GetRating mock is already set by ExpectParams functions")
+	}
+
+	mmGetRating.defaultExpectation.params = &RatingRepositoryMockGetRatingParams{ctx, movieID}
+	mmGetRating.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetRating.expectations {
+		if minimock.Equal(e.params, mmGetRating.defaultExpectation.params) {
+			mmGetRating.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRating.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRating
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RatingRepository.GetRating
+func (mmGetRating *mRatingRepositoryMockGetRating) ExpectCtxParam1(ctx context.Context) *mRatingRepositoryMockGetRating {
+	if mmGetRating.mock.funcGetRating != nil {
+		mmGetRating.mock.t.Fatalf("RatingRepositoryMock.GetRating mock is already set by Set")
+	}
+
+	if mmGetRating.defaultExpectation == nil {
+		mmGetRating.defaultExpectation = &RatingRepositoryMockGetRatingExpectation{}
+	}
+
+	if mmGetRating.defaultExpectation.params != nil {
+		mmGetRating.mock.t.Fatalf("RatingRepositoryMock.GetRating mock is already set by Expect")
+	}
+
+	if mmGetRating.defaultExpectation.paramPtrs == nil {
+		mmGetRating.defaultExpectation.paramPtrs = &RatingRepositoryMockGetRatingParamPtrs{}
+	}
+	mmGetRating.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetRating.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetRating
+}
+
+// ExpectMovieIDParam2 sets up expected param movieID for RatingRepository.GetRating
+func (mmGetRating *mRatingRepositoryMockGetRating) ExpectMovieIDParam2(movieID string) *mRatingRepositoryMockGetRating {
+	if mmGetRating.mock.funcGetRating != nil {
+		mmGetRating.mock.t.Fatalf("RatingRepositoryMock.GetRating mock is already set by Set")
+	}
+
+	if mmGetRating.defaultExpectation == nil {
+		mmGetRating.defaultExpectation = &RatingRepositoryMockGetRatingExpectation{}
+	}
+
+	if mmGetRating.defaultExpectation.params != nil {
+		mmGetRating.mock.t.Fatalf("RatingRepositoryMock.GetRating mock is already set by Expect")
+	}
+
+	if mmGetRating.defaultExpectation.paramPtrs == nil {
+		mmGetRating.defaultExpectation.paramPtrs = &RatingRepositoryMockGetRatingParamPtrs{}
+	}
+	mmGetRating.defaultExpectation.paramPtrs.movieID = &movieID
+	mmGetRating.defaultExpectation.expectationOrigins.originMovieID = minimock.CallerInfo(1)
+
+	return mmGetRating
+}
+
+// Inspect accepts an inspector function that has same arguments as the RatingRepository.GetRating
+func (mmGetRating *mRatingRepositoryMockGetRating) Inspect(f func(ctx context.Context, movieID string)) *mRatingRepositoryMockGetRating {
+	if mmGetRating.mock.inspectFuncGetRating != nil {
+		mmGetRating.mock.t.Fatalf("Inspect function is already set for RatingRepositoryMock.GetRating")
+	}
+
+	mmGetRating.mock.inspectFuncGetRating = f
+
+	return mmGetRating
+}
+
+// Return sets up results that will be returned by RatingRepository.GetRating
+func (mmGetRating *mRatingRepositoryMockGetRating) Return(m1 mm_repository.MovieRating, err error) *RatingRepositoryMock {
+	if mmGetRating.mock.funcGetRating != nil {
+		mmGetRating.mock.t.Fatalf("RatingRepositoryMock.GetRating mock is already set by Set")
+	}
+
+	if mmGetRating.defaultExpectation == nil {
+		mmGetRating.defaultExpectation = &RatingRepositoryMockGetRatingExpectation{mock: mmGetRating.mock}
+	}
+	mmGetRating.defaultExpectation.results = &RatingRepositoryMockGetRatingResults{m1, err}
+	mmGetRating.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetRating.mock
+}
+
+// Set uses given function f to mock the RatingRepository.GetRating method
+func (mmGetRating *mRatingRepositoryMockGetRating) Set(f func(ctx context.Context, movieID string) (m1 mm_repository.MovieRating, err error)) *RatingRepositoryMock {
+	if mmGetRating.defaultExpectation != nil {
+		mmGetRating.mock.t.Fatalf("Default expectation is already set for the RatingRepository.GetRating method")
+	}
+
+	if len(mmGetRating.expectations) > 0 {
+		mmGetRating.mock.t.Fatalf("Some expectations are already set for the RatingRepository.GetRating method")
+	}
+
+	mmGetRating.mock.funcGetRating = f
+	mmGetRating.mock.funcGetRatingOrigin = minimock.CallerInfo(1)
+	return mmGetRating.mock
+}
+
+// When sets expectation for the RatingRepository.GetRating which will trigger the result defined by the following
+// Then helper
+func (mmGetRating *mRatingRepositoryMockGetRating) When(ctx context.Context, movieID string) *RatingRepositoryMockGetRatingExpectation {
+	if mmGetRating.mock.funcGetRating != nil {
+		mmGetRating.mock.t.Fatalf("RatingRepositoryMock.GetRating mock is already set by Set")
+	}
+
+	expectation := &RatingRepositoryMockGetRatingExpectation{
+		mock:               mmGetRating.mock,
+		params:             &RatingRepositoryMockGetRatingParams{ctx, movieID},
+		expectationOrigins: RatingRepositoryMockGetRatingExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetRating.expectations = append(mmGetRating.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RatingRepository.GetRating return parameters for the expectation previously defined by the When method
+func (e *RatingRepositoryMockGetRatingExpectation) Then(m1 mm_repository.MovieRating, err error) *RatingRepositoryMock {
+	e.results = &RatingRepositoryMockGetRatingResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times RatingRepository.GetRating should be invoked
+func (mmGetRating *mRatingRepositoryMockGetRating) Times(n uint64) *mRatingRepositoryMockGetRating {
+	if n == 0 {
+		mmGetRating.mock.t.Fatalf("Times of RatingRepositoryMock.GetRating mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetRating.expectedInvocations, n)
+	mmGetRating.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetRating
+}
+
+func (mmGetRating *mRatingRepositoryMockGetRating) invocationsDone() bool {
+	if len(mmGetRating.expectations) == 0 && mmGetRating.defaultExpectation == nil && mmGetRating.mock.funcGetRating == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetRating.mock.afterGetRatingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetRating.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetRating implements mm_repository.RatingRepository
+func (mmGetRating *RatingRepositoryMock) GetRating(ctx context.Context, movieID string) (m1 mm_repository.MovieRating, err error) {
+	mm_atomic.AddUint64(&mmGetRating.beforeGetRatingCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRating.afterGetRatingCounter, 1)
+
+	mmGetRating.t.Helper()
+
+	if mmGetRating.inspectFuncGetRating != nil {
+		mmGetRating.inspectFuncGetRating(ctx, movieID)
+	}
+
+	mm_params := RatingRepositoryMockGetRatingParams{ctx, movieID}
+
+	// Record call args
+	mmGetRating.GetRatingMock.mutex.Lock()
+	mmGetRating.GetRatingMock.callArgs = append(mmGetRating.GetRatingMock.callArgs, &mm_params)
+	mmGetRating.GetRatingMock.mutex.Unlock()
+
+	for _, e := range mmGetRating.GetRatingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmGetRating.GetRatingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRating.GetRatingMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRating.GetRatingMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRating.GetRatingMock.defaultExpectation.paramPtrs
+
+		mm_got := RatingRepositoryMockGetRatingParams{ctx, movieID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetRating.t.Errorf("RatingRepositoryMock.GetRating got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRating.GetRatingMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.movieID != nil && !minimock.Equal(*mm_want_ptrs.movieID, mm_got.movieID) {
+				mmGetRating.t.Errorf("RatingRepositoryMock.GetRating got unexpected parameter movieID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRating.GetRatingMock.defaultExpectation.expectationOrigins.originMovieID, *mm_want_ptrs.movieID, mm_got.movieID, minimock.Diff(*mm_want_ptrs.movieID, mm_got.movieID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRating.t.Errorf("RatingRepositoryMock.GetRating got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetRating.GetRatingMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRating.GetRatingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRating.t.Fatal("No results are set for the RatingRepositoryMock.GetRating")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmGetRating.funcGetRating != nil {
+		return mmGetRating.funcGetRating(ctx, movieID)
+	}
+	mmGetRating.t.Fatalf("Unexpected call to RatingRepositoryMock.GetRating. %v %v", ctx, movieID)
+	return
+}
+
+// GetRatingAfterCounter returns a count of finished RatingRepositoryMock.GetRating invocations
+func (mmGetRating *RatingRepositoryMock) GetRatingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRating.afterGetRatingCounter)
+}
+
+// GetRatingBeforeCounter returns a count of RatingRepositoryMock.GetRating invocations
+func (mmGetRating *RatingRepositoryMock) GetRatingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRating.beforeGetRatingCounter)
+}
+
+// Calls returns a list of arguments used in each call to RatingRepositoryMock.GetRating.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRating *mRatingRepositoryMockGetRating) Calls() []*RatingRepositoryMockGetRatingParams {
+	mmGetRating.mutex.RLock()
+
+	argCopy := make([]*RatingRepositoryMockGetRatingParams, len(mmGetRating.callArgs))
+	copy(argCopy, mmGetRating.callArgs)
+
+	mmGetRating.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRatingDone returns true if the count of the GetRating invocations corresponds
+// the number of defined expectations
+func (m *RatingRepositoryMock) MinimockGetRatingDone() bool {
+	if m.GetRatingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetRatingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetRatingMock.invocationsDone()
+}
+
+// MinimockGetRatingInspect logs each unmet expectation
+func (m *RatingRepositoryMock) MinimockGetRatingInspect() {
+	for _, e := range m.GetRatingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RatingRepositoryMock.GetRating at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetRatingCounter := mm_atomic.LoadUint64(&m.afterGetRatingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRatingMock.defaultExpectation != nil && afterGetRatingCounter < 1 {
+		if m.GetRatingMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RatingRepositoryMock.GetRating at\n%s", m.GetRatingMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RatingRepositoryMock.GetRating at\n%s with params: %#v", m.GetRatingMock.defaultExpectation.expectationOrigins.origin, *m.GetRatingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRating != nil && afterGetRatingCounter < 1 {
+		m.t.Errorf("Expected call to RatingRepositoryMock.GetRating at\n%s", m.funcGetRatingOrigin)
+	}
+
+	if !m.GetRatingMock.invocationsDone() && afterGetRatingCounter > 0 {
+		m.t.Errorf("Expected %d calls to RatingRepositoryMock.GetRating at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetRatingMock.expectedInvocations), m.GetRatingMock.expectedInvocationsOrigin, afterGetRatingCounter)
+	}
+}
+
+type mRatingRepositoryMockGetSummaries struct {
+	optional           bool
+	mock               *RatingRepositoryMock
+	defaultExpectation *RatingRepositoryMockGetSummariesExpectation
+	expectations       []*RatingRepositoryMockGetSummariesExpectation
+
+	callArgs []*RatingRepositoryMockGetSummariesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RatingRepositoryMockGetSummariesExpectation specifies expectation struct of the RatingRepository.GetSummaries
+type RatingRepositoryMockGetSummariesExpectation struct {
+	mock               *RatingRepositoryMock
+	params             *RatingRepositoryMockGetSummariesParams
+	paramPtrs          *RatingRepositoryMockGetSummariesParamPtrs
+	expectationOrigins RatingRepositoryMockGetSummariesExpectationOrigins
+	results            *RatingRepositoryMockGetSummariesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RatingRepositoryMockGetSummariesParams contains parameters of the RatingRepository.GetSummaries
+type RatingRepositoryMockGetSummariesParams struct {
+	ctx      context.Context
+	movieIDs []string
+}
+
+// RatingRepositoryMockGetSummariesParamPtrs contains pointers to parameters of the RatingRepository.GetSummaries
+type RatingRepositoryMockGetSummariesParamPtrs struct {
+	ctx      *context.Context
+	movieIDs *[]string
+}
+
+// RatingRepositoryMockGetSummariesResults contains results of the RatingRepository.GetSummaries
+type RatingRepositoryMockGetSummariesResults struct {
+	ra1 []mm_repository.ReviewSummary
+	err error
+}
+
+// RatingRepositoryMockGetSummariesOrigins contains origins of expectations of the RatingRepository.GetSummaries
+type RatingRepositoryMockGetSummariesExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originMovieIDs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetSummaries *mRatingRepositoryMockGetSummaries) Optional() *mRatingRepositoryMockGetSummaries {
+	mmGetSummaries.optional = true
+	return mmGetSummaries
+}
+
+// Expect sets up expected params for RatingRepository.GetSummaries
+func (mmGetSummaries *mRatingRepositoryMockGetSummaries) Expect(ctx context.Context, movieIDs []string) *mRatingRepositoryMockGetSummaries {
+	if mmGetSummaries.mock.funcGetSummaries != nil {
+		mmGetSummaries.mock.t.Fatalf("RatingRepositoryMock.GetSummaries mock is already set by Set")
+	}
+
+	if mmGetSummaries.defaultExpectation == nil {
+		mmGetSummaries.defaultExpectation = &RatingRepositoryMockGetSummariesExpectation{}
+	}
+
+	if mmGetSummaries.defaultExpectation.paramPtrs != nil {
+		mmGetSummaries.mock.t.Fatalf("RatingRepositoryMock.GetSummaries mock is already set by ExpectParams functions")
+	}
+
+	mmGetSummaries.defaultExpectation.params = &RatingRepositoryMockGetSummariesParams{ctx, movieIDs}
+	mmGetSummaries.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetSummaries.expectations {
+		if minimock.Equal(e.params, mmGetSummaries.defaultExpectation.params) {
+			mmGetSummaries.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSummaries.defaultExpectation.params)
+		}
+	}
+
+	return mmGetSummaries
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RatingRepository.GetSummaries
+func (mmGetSummaries *mRatingRepositoryMockGetSummaries) ExpectCtxParam1(ctx context.Context) *mRatingRepositoryMockGetSummaries {
+	if mmGetSummaries.mock.funcGetSummaries != nil {
+		mmGetSummaries.mock.t.Fatalf("RatingRepositoryMock.GetSummaries mock is already set by Set")
+	}
+
+	if mmGetSummaries.defaultExpectation == nil {
+		mmGetSummaries.defaultExpectation = &RatingRepositoryMockGetSummariesExpectation{}
+	}
+
+	if mmGetSummaries.defaultExpectation.params != nil {
+		mmGetSummaries.mock.t.Fatalf("RatingRepositoryMock.GetSummaries mock is already set by Expect")
+	}
+
+	if mmGetSummaries.defaultExpectation.paramPtrs == nil {
+		mmGetSummaries.defaultExpectation.paramPtrs = &RatingRepositoryMockGetSummariesParamPtrs{}
+	}
+	mmGetSummaries.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetSummaries.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetSummaries
+}
+
+// ExpectMovieIDsParam2 sets up expected param movieIDs for RatingRepository.GetSummaries
+func (mmGetSummaries *mRatingRepositoryMockGetSummaries) ExpectMovieIDsParam2(movieIDs []string) *mRatingRepositoryMockGetSummaries {
+	if mmGetSummaries.mock.funcGetSummaries != nil {
+		mmGetSummaries.mock.t.Fatalf("RatingRepositoryMock.GetSummaries mock is already set by Set")
+	}
+
+	if mmGetSummaries.defaultExpectation == nil {
+		mmGetSummaries.defaultExpectation = &RatingRepositoryMockGetSummariesExpectation{}
+	}
+
+	if mmGetSummaries.defaultExpectation.params != nil {
+		mmGetSummaries.mock.t.Fatalf("RatingRepositoryMock.GetSummaries mock is already set by Expect")
+	}
+
+	if mmGetSummaries.defaultExpectation.paramPtrs == nil {
+		mmGetSummaries.defaultExpectation.paramPtrs = &RatingRepositoryMockGetSummariesParamPtrs{}
+	}
+	mmGetSummaries.defaultExpectation.paramPtrs.movieIDs = &movieIDs
+	mmGetSummaries.defaultExpectation.expectationOrigins.originMovieIDs = minimock.CallerInfo(1)
+
+	return mmGetSummaries
+}
+
+// Inspect accepts an inspector function that has same arguments as the RatingRepository.GetSummaries
+func (mmGetSummaries *mRatingRepositoryMockGetSummaries) Inspect(f func(ctx context.Context, movieIDs []string)) *mRatingRepositoryMockGetSummaries {
+	if mmGetSummaries.mock.inspectFuncGetSummaries != nil {
+		mmGetSummaries.mock.t.Fatalf("Inspect function is already set for RatingRepositoryMock.GetSummaries")
+	}
+
+	mmGetSummaries.mock.inspectFuncGetSummaries = f
+
+	return mmGetSummaries
+}
+
+// Return sets up results that will be returned by RatingRepository.GetSummaries
+func (mmGetSummaries *mRatingRepositoryMockGetSummaries) Return(ra1 []mm_repository.ReviewSummary, err error) *RatingRepositoryMock {
+	if mmGetSummaries.mock.funcGetSummaries != nil {
+		mmGetSummaries.mock.t.Fatalf("RatingRepositoryMock.GetSummaries mock is already set by Set")
+	}
+
+	if mmGetSummaries.defaultExpectation == nil {
+		mmGetSummaries.defaultExpectation = &RatingRepositoryMockGetSummariesExpectation{mock: mmGetSummaries.mock}
+	}
+	mmGetSummaries.defaultExpectation.results = &RatingRepositoryMockGetSummariesResults{ra1, err}
+	mmGetSummaries.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetSummaries.mock
+}
+
+// Set uses given function f to mock the RatingRepository.GetSummaries method
+func (mmGetSummaries *mRatingRepositoryMockGetSummaries) Set(f func(ctx context.Context, movieIDs []string) (ra1 []mm_repository.ReviewSummary, err error)) *RatingRepositoryMock {
+	if mmGetSummaries.defaultExpectation != nil {
+		mmGetSummaries.mock.t.Fatalf("Default expectation is already set for the RatingRepository.GetSummaries method")
+	}
+
+	if len(mmGetSummaries.expectations) > 0 {
+		mmGetSummaries.mock.t.Fatalf("Some expectations are already set for the RatingRepository.GetSummaries method")
+	}
+
+	mmGetSummaries.mock.funcGetSummaries = f
+	mmGetSummaries.mock.funcGetSummariesOrigin = minimock.CallerInfo(1)
+	return mmGetSummaries.mock
+}
+
+// When sets expectation for the RatingRepository.GetSummaries which will trigger the result defined by the following
+// Then helper
+func (mmGetSummaries *mRatingRepositoryMockGetSummaries) When(ctx context.Context, movieIDs []string) *RatingRepositoryMockGetSummariesExpectation {
+	if mmGetSummaries.mock.funcGetSummaries != nil {
+		mmGetSummaries.mock.t.Fatalf("RatingRepositoryMock.GetSummaries mock is already set by Set")
+	}
+
+	expectation := &RatingRepositoryMockGetSummariesExpectation{
+		mock:               mmGetSummaries.mock,
+		params:             &RatingRepositoryMockGetSummariesParams{ctx, movieIDs},
+		expectationOrigins: RatingRepositoryMockGetSummariesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetSummaries.expectations = append(mmGetSummaries.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RatingRepository.GetSummaries return parameters for the expectation previously defined by the When method
+func (e *RatingRepositoryMockGetSummariesExpectation) Then(ra1 []mm_repository.ReviewSummary, err error) *RatingRepositoryMock {
+	e.results = &RatingRepositoryMockGetSummariesResults{ra1, err}
+	return e.mock
+}
+
+// Times sets number of times RatingRepository.GetSummaries should be invoked
+func (mmGetSummaries *mRatingRepositoryMockGetSummaries) Times(n uint64) *mRatingRepositoryMockGetSummaries {
+	if n == 0 {
+		mmGetSummaries.mock.t.Fatalf("Times of RatingRepositoryMock.GetSummaries mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetSummaries.expectedInvocations, n)
+	mmGetSummaries.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetSummaries
+}
+
+func (mmGetSummaries *mRatingRepositoryMockGetSummaries) invocationsDone() bool {
+	if len(mmGetSummaries.expectations) == 0 && mmGetSummaries.defaultExpectation == nil && mmGetSummaries.mock.funcGetSummaries == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetSummaries.mock.afterGetSummariesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetSummaries.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetSummaries implements mm_repository.RatingRepository
+func (mmGetSummaries *RatingRepositoryMock) GetSummaries(ctx context.Context, movieIDs []string) (ra1 []mm_repository.ReviewSummary, err error) {
+	mm_atomic.AddUint64(&mmGetSummaries.beforeGetSummariesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSummaries.afterGetSummariesCounter, 1)
+
+	mmGetSummaries.t.Helper()
+
+	if mmGetSummaries.inspectFuncGetSummaries != nil {
+		mmGetSummaries.inspectFuncGetSummaries(ctx, movieIDs)
+	}
+
+	mm_params := RatingRepositoryMockGetSummariesParams{ctx, movieIDs}
+
+	// Record call args
+	mmGetSummaries.GetSummariesMock.mutex.Lock()
+	mmGetSummaries.GetSummariesMock.callArgs = append(mmGetSummaries.GetSummariesMock.callArgs, &mm_params)
+	mmGetSummaries.GetSummariesMock.mutex.Unlock()
+
+	for _, e := range mmGetSummaries.GetSummariesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmGetSummaries.GetSummariesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSummaries.GetSummariesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetSummaries.GetSummariesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetSummaries.GetSummariesMock.defaultExpectation.paramPtrs
+
+		mm_got := RatingRepositoryMockGetSummariesParams{ctx, movieIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetSummaries.t.Errorf("RatingRepositoryMock.GetSummaries got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSummaries.GetSummariesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.movieIDs != nil && !minimock.Equal(*mm_want_ptrs.movieIDs, mm_got.movieIDs) {
+				mmGetSummaries.t.Errorf("RatingRepositoryMock.GetSummaries got unexpected parameter movieIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSummaries.GetSummariesMock.defaultExpectation.expectationOrigins.originMovieIDs, *mm_want_ptrs.movieIDs, mm_got.movieIDs, minimock.Diff(*mm_want_ptrs.movieIDs, mm_got.movieIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetSummaries.t.Errorf("RatingRepositoryMock.GetSummaries got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetSummaries.GetSummariesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetSummaries.GetSummariesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSummaries.t.Fatal("No results are set for the RatingRepositoryMock.GetSummaries")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmGetSummaries.funcGetSummaries != nil {
+		return mmGetSummaries.funcGetSummaries(ctx, movieIDs)
+	}
+	mmGetSummaries.t.Fatalf("Unexpected call to RatingRepositoryMock.GetSummaries. %v %v", ctx, movieIDs)
+	return
+}
+
+// GetSummariesAfterCounter returns a count of finished RatingRepositoryMock.GetSummaries invocations
+func (mmGetSummaries *RatingRepositoryMock) GetSummariesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSummaries.afterGetSummariesCounter)
+}
+
+// GetSummariesBeforeCounter returns a count of RatingRepositoryMock.GetSummaries invocations
+func (mmGetSummaries *RatingRepositoryMock) GetSummariesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSummaries.beforeGetSummariesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RatingRepositoryMock.GetSummaries.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetSummaries *mRatingRepositoryMockGetSummaries) Calls() []*RatingRepositoryMockGetSummariesParams {
+	mmGetSummaries.mutex.RLock()
+
+	argCopy := make([]*RatingRepositoryMockGetSummariesParams, len(mmGetSummaries.callArgs))
+	copy(argCopy, mmGetSummaries.callArgs)
+
+	mmGetSummaries.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetSummariesDone returns true if the count of the GetSummaries invocations corresponds
+// the number of defined expectations
+func (m *RatingRepositoryMock) MinimockGetSummariesDone() bool {
+	if m.GetSummariesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetSummariesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetSummariesMock.invocationsDone()
+}
+
+// MinimockGetSummariesInspect logs each unmet expectation
+func (m *RatingRepositoryMock) MinimockGetSummariesInspect() {
+	for _, e := range m.GetSummariesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RatingRepositoryMock.GetSummaries at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetSummariesCounter := mm_atomic.LoadUint64(&m.afterGetSummariesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSummariesMock.defaultExpectation != nil && afterGetSummariesCounter < 1 {
+		if m.GetSummariesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RatingRepositoryMock.GetSummaries at\n%s", m.GetSummariesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RatingRepositoryMock.GetSummaries at\n%s with params: %#v", m.GetSummariesMock.defaultExpectation.expectationOrigins.origin, *m.GetSummariesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSummaries != nil && afterGetSummariesCounter < 1 {
+		m.t.Errorf("Expected call to RatingRepositoryMock.GetSummaries at\n%s", m.funcGetSummariesOrigin)
+	}
+
+	if !m.GetSummariesMock.invocationsDone() && afterGetSummariesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RatingRepositoryMock.GetSummaries at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetSummariesMock.expectedInvocations), m.GetSummariesMock.expectedInvocationsOrigin, afterGetSummariesCounter)
+	}
+}
+
+type mRatingRepositoryMockUpdateRating struct {
+	optional           bool
+	mock               *RatingRepositoryMock
+	defaultExpectation *RatingRepositoryMockUpdateRatingExpectation
+	expectations       []*RatingRepositoryMockUpdateRatingExpectation
+
+	callArgs []*RatingRepositoryMockUpdateRatingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RatingRepositoryMockUpdateRatingExpectation specifies expectation struct of the RatingRepository.UpdateRating
+type RatingRepositoryMockUpdateRatingExpectation struct {
+	mock               *RatingRepositoryMock
+	params             *RatingRepositoryMockUpdateRatingParams
+	paramPtrs          *RatingRepositoryMockUpdateRatingParamPtrs
+	expectationOrigins RatingRepositoryMockUpdateRatingExpectationOrigins
+	results            *RatingRepositoryMockUpdateRatingResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RatingRepositoryMockUpdateRatingParams contains parameters of the RatingRepository.UpdateRating
+type RatingRepositoryMockUpdateRatingParams struct {
+	ctx       context.Context
+	movieID   string
+	oldRating int32
+	newRating int32
+}
+
+// RatingRepositoryMockUpdateRatingParamPtrs contains pointers to parameters of the RatingRepository.UpdateRating
+type RatingRepositoryMockUpdateRatingParamPtrs struct {
+	ctx       *context.Context
+	movieID   *string
+	oldRating *int32
+	newRating *int32
+}
+
+// RatingRepositoryMockUpdateRatingResults contains results of the RatingRepository.UpdateRating
+type RatingRepositoryMockUpdateRatingResults struct {
+	err error
+}
+
+// RatingRepositoryMockUpdateRatingOrigins contains origins of expectations of the RatingRepository.UpdateRating
+type RatingRepositoryMockUpdateRatingExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originMovieID   string
+	originOldRating string
+	originNewRating string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateRating *mRatingRepositoryMockUpdateRating) Optional() *mRatingRepositoryMockUpdateRating {
+	mmUpdateRating.optional = true
+	return mmUpdateRating
+}
+
+// Expect sets up expected params for RatingRepository.UpdateRating
+func (mmUpdateRating *mRatingRepositoryMockUpdateRating) Expect(ctx context.Context, movieID string, oldRating int32, newRating int32) *mRatingRepositoryMockUpdateRating {
+	if mmUpdateRating.mock.funcUpdateRating != nil {
+		mmUpdateRating.mock.t.Fatalf("RatingRepositoryMock.UpdateRating mock is already set by Set")
+	}
+
+	if mmUpdateRating.defaultExpectation == nil {
+		mmUpdateRating.defaultExpectation = &RatingRepositoryMockUpdateRatingExpectation{}
+	}
+
+	if mmUpdateRating.defaultExpectation.paramPtrs != nil {
+		mmUpdateRating.mock.t.Fatalf("RatingRepositoryMock.UpdateRating mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateRating.defaultExpectation.params = &RatingRepositoryMockUpdateRatingParams{ctx, movieID, oldRating, newRating}
+	mmUpdateRating.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateRating.expectations {
+		if minimock.Equal(e.params, mmUpdateRating.defaultExpectation.params) {
+			mmUpdateRating.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateRating.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateRating
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RatingRepository.UpdateRating
+func (mmUpdateRating *mRatingRepositoryMockUpdateRating) ExpectCtxParam1(ctx context.Context) *mRatingRepositoryMockUpdateRating {
+	if mmUpdateRating.mock.funcUpdateRating != nil {
+		mmUpdateRating.mock.t.Fatalf("RatingRepositoryMock.UpdateRating mock is already set by Set")
+	}
+
+	if mmUpdateRating.defaultExpectation == nil {
+		mmUpdateRating.defaultExpectation = &RatingRepositoryMockUpdateRatingExpectation{}
+	}
+
+	if mmUpdateRating.defaultExpectation.params != nil {
+		mmUpdateRating.mock.t.Fatalf("RatingRepositoryMock.UpdateRating mock is already set by Expect")
+	}
+
+	if mmUpdateRating.defaultExpectation.paramPtrs == nil {
+		mmUpdateRating.defaultExpectation.paramPtrs = &RatingRepositoryMockUpdateRatingParamPtrs{}
+	}
+	mmUpdateRating.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateRating.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateRating
+}
+
+// ExpectMovieIDParam2 sets up expected param movieID for RatingRepository.UpdateRating
+func (mmUpdateRating *mRatingRepositoryMockUpdateRating) ExpectMovieIDParam2(movieID string) *mRatingRepositoryMockUpdateRating {
+	if mmUpdateRating.mock.funcUpdateRating != nil {
+		mmUpdateRating.mock.t.Fatalf("RatingRepositoryMock.UpdateRating mock is already set by Set")
+	}
+
+	if mmUpdateRating.defaultExpectation == nil {
+		mmUpdateRating.defaultExpectation = &RatingRepositoryMockUpdateRatingExpectation{}
+	}
+
+	if mmUpdateRating.defaultExpectation.params != nil {
+		mmUpdateRating.mock.t.Fatalf("RatingRepositoryMock.UpdateRating mock is already set by Expect")
+	}
+
+	if mmUpdateRating.defaultExpectation.paramPtrs == nil {
+		mmUpdateRating.defaultExpectation.paramPtrs = &RatingRepositoryMockUpdateRatingParamPtrs{}
+	}
+	mmUpdateRating.defaultExpectation.paramPtrs.movieID = &movieID
+	mmUpdateRating.defaultExpectation.expectationOrigins.originMovieID = minimock.CallerInfo(1)
+
+	return mmUpdateRating
+}
+
+// ExpectOldRatingParam3 sets up expected param oldRating for RatingRepository.UpdateRating
+func (mmUpdateRating *mRatingRepositoryMockUpdateRating) ExpectOldRatingParam3(oldRating int32) *mRatingRepositoryMockUpdateRating {
+	if mmUpdateRating.mock.funcUpdateRating != nil {
+		mmUpdateRating.mock.t.Fatalf("RatingRepositoryMock.UpdateRating mock is already set by Set")
+	}
+
+	if mmUpdateRating.defaultExpectation == nil {
+		mmUpdateRating.defaultExpectation = &RatingRepositoryMockUpdateRatingExpectation{}
+	}
+
+	if mmUpdateRating.defaultExpectation.params != nil {
+		mmUpdateRating.mock.t.Fatalf("RatingRepositoryMock.UpdateRating mock is already set by Expect")
+	}
+
+	if mmUpdateRating.defaultExpectation.paramPtrs == nil {
+		mmUpdateRating.defaultExpectation.paramPtrs = &RatingRepositoryMockUpdateRatingParamPtrs{}
+	}
+	mmUpdateRating.defaultExpectation.paramPtrs.oldRating = &oldRating
+	mmUpdateRating.defaultExpectation.expectationOrigins.originOldRating = minimock.CallerInfo(1)
+
+	return mmUpdateRating
+}
+
+// ExpectNewRatingParam4 sets up expected param newRating for RatingRepository.UpdateRating
+func (mmUpdateRating *mRatingRepositoryMockUpdateRating) ExpectNewRatingParam4(newRating int32) *mRatingRepositoryMockUpdateRating {
+	if mmUpdateRating.mock.funcUpdateRating != nil {
+		mmUpdateRating.mock.t.Fatalf("RatingRepositoryMock.UpdateRating mock is already set by Set")
+	}
+
+	if mmUpdateRating.defaultExpectation == nil {
+		mmUpdateRating.defaultExpectation = &RatingRepositoryMockUpdateRatingExpectation{}
+	}
+
+	if mmUpdateRating.defaultExpectation.params != nil {
+		mmUpdateRating.mock.t.Fatalf("RatingRepositoryMock.UpdateRating mock is already set by Expect")
+	}
+
+	if mmUpdateRating.defaultExpectation.paramPtrs == nil {
+		mmUpdateRating.defaultExpectation.paramPtrs = &RatingRepositoryMockUpdateRatingParamPtrs{}
+	}
+	mmUpdateRating.defaultExpectation.paramPtrs.newRating = &newRating
+	mmUpdateRating.defaultExpectation.expectationOrigins.originNewRating = minimock.CallerInfo(1)
+
+	return mmUpdateRating
+}
+
+// Inspect accepts an inspector function that has same arguments as the RatingRepository.UpdateRating
+func (mmUpdateRating *mRatingRepositoryMockUpdateRating) Inspect(f func(ctx context.Context, movieID string, oldRating int32, newRating int32)) *mRatingRepositoryMockUpdateRating {
+	if mmUpdateRating.mock.inspectFuncUpdateRating != nil {
+		mmUpdateRating.mock.t.Fatalf("Inspect function is already set for RatingRepositoryMock.UpdateRating")
+	}
+
+	mmUpdateRating.mock.inspectFuncUpdateRating = f
+
+	return mmUpdateRating
+}
+
+// Return sets up results that will be returned by RatingRepository.UpdateRating
+func (mmUpdateRating *mRatingRepositoryMockUpdateRating) Return(err error) *RatingRepositoryMock {
+	if mmUpdateRating.mock.funcUpdateRating != nil {
+		mmUpdateRating.mock.t.Fatalf("RatingRepositoryMock.UpdateRating mock is already set by Set")
+	}
+
+	if mmUpdateRating.defaultExpectation == nil {
+		mmUpdateRating.defaultExpectation = &RatingRepositoryMockUpdateRatingExpectation{mock: mmUpdateRating.mock}
+	}
+	mmUpdateRating.defaultExpectation.results = &RatingRepositoryMockUpdateRatingResults{err}
+	mmUpdateRating.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateRating.mock
+}
+
+// Set uses given function f to mock the RatingRepository.UpdateRating method
+func (mmUpdateRating *mRatingRepositoryMockUpdateRating) Set(f func(ctx context.Context, movieID string, oldRating int32, newRating int32) (err error)) *RatingRepositoryMock {
+	if mmUpdateRating.defaultExpectation != nil {
+		mmUpdateRating.mock.t.Fatalf("Default expectation is already set for the RatingRepository.UpdateRating method")
+	}
+
+	if len(mmUpdateRating.expectations) > 0 {
+		mmUpdateRating.mock.t.Fatalf("Some expectations are already set for the RatingRepository.UpdateRating method")
+	}
+
+	mmUpdateRating.mock.funcUpdateRating = f
+	mmUpdateRating.mock.funcUpdateRatingOrigin = minimock.CallerInfo(1)
+	return mmUpdateRating.mock
+}
+
+// When sets expectation for the RatingRepository.UpdateRating which will trigger the result defined by the following
+// Then helper
+func (mmUpdateRating *mRatingRepositoryMockUpdateRating) When(ctx context.Context, movieID string, oldRating int32, newRating int32) *RatingRepositoryMockUpdateRatingExpectation {
+	if mmUpdateRating.mock.funcUpdateRating != nil {
+		mmUpdateRating.mock.t.Fatalf("RatingRepositoryMock.UpdateRating mock is already set by Set")
+	}
+
+	expectation := &RatingRepositoryMockUpdateRatingExpectation{
+		mock:               mmUpdateRating.mock,
+		params:             &RatingRepositoryMockUpdateRatingParams{ctx, movieID, oldRating, newRating},
+		expectationOrigins: RatingRepositoryMockUpdateRatingExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateRating.expectations = append(mmUpdateRating.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RatingRepository.UpdateRating return parameters for the expectation previously defined by the When method
+func (e *RatingRepositoryMockUpdateRatingExpectation) Then(err error) *RatingRepositoryMock {
+	e.results = &RatingRepositoryMockUpdateRatingResults{err}
+	return e.mock
+}
+
+// Times sets number of times RatingRepository.UpdateRating should be invoked
+func (mmUpdateRating *mRatingRepositoryMockUpdateRating) Times(n uint64) *mRatingRepositoryMockUpdateRating {
+	if n == 0 {
+		mmUpdateRating.mock.t.Fatalf("Times of RatingRepositoryMock.UpdateRating mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateRating.expectedInvocations, n)
+	mmUpdateRating.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateRating
+}
+
+func (mmUpdateRating *mRatingRepositoryMockUpdateRating) invocationsDone() bool {
+	if len(mmUpdateRating.expectations) == 0 && mmUpdateRating.defaultExpectation == nil && mmUpdateRating.mock.funcUpdateRating == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateRating.mock.afterUpdateRatingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateRating.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateRating implements mm_repository.RatingRepository
+func (mmUpdateRating *RatingRepositoryMock) UpdateRating(ctx context.Context, movieID string, oldRating int32, newRating int32) (err error) {
+	mm_atomic.AddUint64(&mmUpdateRating.beforeUpdateRatingCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateRating.afterUpdateRatingCounter, 1)
+
+	mmUpdateRating.t.Helper()
+
+	if mmUpdateRating.inspectFuncUpdateRating != nil {
+		mmUpdateRating.inspectFuncUpdateRating(ctx, movieID, oldRating, newRating)
+	}
+
+	mm_params := RatingRepositoryMockUpdateRatingParams{ctx, movieID, oldRating, newRating}
+
+	// Record call args
+	mmUpdateRating.UpdateRatingMock.mutex.Lock()
+	mmUpdateRating.UpdateRatingMock.callArgs = append(mmUpdateRating.UpdateRatingMock.callArgs, &mm_params)
+	mmUpdateRating.UpdateRatingMock.mutex.Unlock()
+
+	for _, e := range mmUpdateRating.UpdateRatingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateRating.UpdateRatingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateRating.UpdateRatingMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateRating.UpdateRatingMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateRating.UpdateRatingMock.defaultExpectation.paramPtrs
+
+		mm_got := RatingRepositoryMockUpdateRatingParams{ctx, movieID, oldRating, newRating}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateRating.t.Errorf("RatingRepositoryMock.UpdateRating got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateRating.UpdateRatingMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.movieID != nil && !minimock.Equal(*mm_want_ptrs.movieID, mm_got.movieID) {
+				mmUpdateRating.t.Errorf("RatingRepositoryMock.UpdateRating got unexpected parameter movieID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateRating.UpdateRatingMock.defaultExpectation.expectationOrigins.originMovieID, *mm_want_ptrs.movieID, mm_got.movieID, minimock.Diff(*mm_want_ptrs.movieID, mm_got.movieID))
+			}
+
+			if mm_want_ptrs.oldRating != nil && !minimock.Equal(*mm_want_ptrs.oldRating, mm_got.oldRating) {
+				mmUpdateRating.t.Errorf("RatingRepositoryMock.UpdateRating got unexpected parameter oldRating, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateRating.UpdateRatingMock.defaultExpectation.expectationOrigins.originOldRating, *mm_want_ptrs.oldRating, mm_got.oldRating, minimock.Diff(*mm_want_ptrs.oldRating, mm_got.oldRating))
+			}
+
+			if mm_want_ptrs.newRating != nil && !minimock.Equal(*mm_want_ptrs.newRating, mm_got.newRating) {
+				mmUpdateRating.t.Errorf("RatingRepositoryMock.UpdateRating got unexpected parameter newRating, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateRating.UpdateRatingMock.defaultExpectation.expectationOrigins.originNewRating, *mm_want_ptrs.newRating, mm_got.newRating, minimock.Diff(*mm_want_ptrs.newRating, mm_got.newRating))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateRating.t.Errorf("RatingRepositoryMock.UpdateRating got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateRating.UpdateRatingMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateRating.UpdateRatingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateRating.t.Fatal("No results are set for the RatingRepositoryMock.UpdateRating")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateRating.funcUpdateRating != nil {
+		return mmUpdateRating.funcUpdateRating(ctx, movieID, oldRating, newRating)
+	}
+	mmUpdateRating.t.Fatalf("Unexpected call to RatingRepositoryMock.UpdateRating. %v %v %v %v", ctx, movieID, oldRating, newRating)
+	return
+}
+
+// UpdateRatingAfterCounter returns a count of finished RatingRepositoryMock.UpdateRating invocations
+func (mmUpdateRating *RatingRepositoryMock) UpdateRatingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateRating.afterUpdateRatingCounter)
+}
+
+// UpdateRatingBeforeCounter returns a count of RatingRepositoryMock.UpdateRating invocations
+func (mmUpdateRating *RatingRepositoryMock) UpdateRatingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateRating.beforeUpdateRatingCounter)
+}
+
+// Calls returns a list of arguments used in each call to RatingRepositoryMock.UpdateRating.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateRating *mRatingRepositoryMockUpdateRating) Calls() []*RatingRepositoryMockUpdateRatingParams {
+	mmUpdateRating.mutex.RLock()
+
+	argCopy := make([]*RatingRepositoryMockUpdateRatingParams, len(mmUpdateRating.callArgs))
+	copy(argCopy, mmUpdateRating.callArgs)
+
+	mmUpdateRating.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateRatingDone returns true if the count of the UpdateRating invocations corresponds
+// the number of defined expectations
+func (m *RatingRepositoryMock) MinimockUpdateRatingDone() bool {
+	if m.UpdateRatingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateRatingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateRatingMock.invocationsDone()
+}
+
+// MinimockUpdateRatingInspect logs each unmet expectation
+func (m *RatingRepositoryMock) MinimockUpdateRatingInspect() {
+	for _, e := range m.UpdateRatingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RatingRepositoryMock.UpdateRating at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateRatingCounter := mm_atomic.LoadUint64(&m.afterUpdateRatingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateRatingMock.defaultExpectation != nil && afterUpdateRatingCounter < 1 {
+		if m.UpdateRatingMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RatingRepositoryMock.UpdateRating at\n%s", m.UpdateRatingMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RatingRepositoryMock.UpdateRating at\n%s with params: %#v", m.UpdateRatingMock.defaultExpectation.expectationOrigins.origin, *m.UpdateRatingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateRating != nil && afterUpdateRatingCounter < 1 {
+		m.t.Errorf("Expected call to RatingRepositoryMock.UpdateRating at\n%s", m.funcUpdateRatingOrigin)
+	}
+
+	if !m.UpdateRatingMock.invocationsDone() && afterUpdateRatingCounter > 0 {
+		m.t.Errorf("Expected %d calls to RatingRepositoryMock.UpdateRating at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateRatingMock.expectedInvocations), m.UpdateRatingMock.expectedInvocationsOrigin, afterUpdateRatingCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RatingRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetRatingInspect()
+
+			m.MinimockGetSummariesInspect()
+
+			m.MinimockUpdateRatingInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RatingRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RatingRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetRatingDone() &&
+		m.MinimockGetSummariesDone() &&
+		m.MinimockUpdateRatingDone()
+}
@@ -0,0 +1,1548 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/maisiq/go-ugc-service/internal/repository.ReportRepository -o report_repository_mock.go -n ReportRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_repository "github.com/maisiq/go-ugc-service/internal/repository"
+)
+
+// ReportRepositoryMock implements mm_repository.ReportRepository
+type ReportRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddReport          func(ctx context.Context, report mm_repository.Report) (b1 bool, err error)
+	funcAddReportOrigin    string
+	inspectFuncAddReport   func(ctx context.Context, report mm_repository.Report)
+	afterAddReportCounter  uint64
+	beforeAddReportCounter uint64
+	AddReportMock          mReportRepositoryMockAddReport
+
+	funcCountReports          func(ctx context.Context, userID string, movieID string, after time.Time) (i1 int64, err error)
+	funcCountReportsOrigin    string
+	inspectFuncCountReports   func(ctx context.Context, userID string, movieID string, after time.Time)
+	afterCountReportsCounter  uint64
+	beforeCountReportsCounter uint64
+	CountReportsMock          mReportRepositoryMockCountReports
+
+	funcDeleteUserReports          func(ctx context.Context, userID string) (err error)
+	funcDeleteUserReportsOrigin    string
+	inspectFuncDeleteUserReports   func(ctx context.Context, userID string)
+	afterDeleteUserReportsCounter  uint64
+	beforeDeleteUserReportsCounter uint64
+	DeleteUserReportsMock          mReportRepositoryMockDeleteUserReports
+
+	funcListReportsBy          func(ctx context.Context, reporterID string) (ra1 []mm_repository.Report, err error)
+	funcListReportsByOrigin    string
+	inspectFuncListReportsBy   func(ctx context.Context, reporterID string)
+	afterListReportsByCounter  uint64
+	beforeListReportsByCounter uint64
+	ListReportsByMock          mReportRepositoryMockListReportsBy
+}
+
+// NewReportRepositoryMock returns a mock for mm_repository.ReportRepository
+func NewReportRepositoryMock(t minimock.Tester) *ReportRepositoryMock {
+	m := &ReportRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddReportMock = mReportRepositoryMockAddReport{mock: m}
+	m.AddReportMock.callArgs = []*ReportRepositoryMockAddReportParams{}
+
+	m.CountReportsMock = mReportRepositoryMockCountReports{mock: m}
+	m.CountReportsMock.callArgs = []*ReportRepositoryMockCountReportsParams{}
+
+	m.DeleteUserReportsMock = mReportRepositoryMockDeleteUserReports{mock: m}
+	m.DeleteUserReportsMock.callArgs = []*ReportRepositoryMockDeleteUserReportsParams{}
+
+	m.ListReportsByMock = mReportRepositoryMockListReportsBy{mock: m}
+	m.ListReportsByMock.callArgs = []*ReportRepositoryMockListReportsByParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mReportRepositoryMockAddReport struct {
+	optional           bool
+	mock               *ReportRepositoryMock
+	defaultExpectation *ReportRepositoryMockAddReportExpectation
+	expectations       []*ReportRepositoryMockAddReportExpectation
+
+	callArgs []*ReportRepositoryMockAddReportParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReportRepositoryMockAddReportExpectation specifies expectation struct of the ReportRepository.AddReport
+type ReportRepositoryMockAddReportExpectation struct {
+	mock               *ReportRepositoryMock
+	params             *ReportRepositoryMockAddReportParams
+	paramPtrs          *ReportRepositoryMockAddReportParamPtrs
+	expectationOrigins ReportRepositoryMockAddReportExpectationOrigins
+	results            *ReportRepositoryMockAddReportResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReportRepositoryMockAddReportParams contains parameters of the ReportRepository.AddReport
+type ReportRepositoryMockAddReportParams struct {
+	ctx    context.Context
+	report mm_repository.Report
+}
+
+// ReportRepositoryMockAddReportParamPtrs contains pointers to parameters of the ReportRepository.AddReport
+type ReportRepositoryMockAddReportParamPtrs struct {
+	ctx    *context.Context
+	report *mm_repository.Report
+}
+
+// ReportRepositoryMockAddReportResults contains results of the ReportRepository.AddReport
+type ReportRepositoryMockAddReportResults struct {
+	b1  bool
+	err error
+}
+
+// ReportRepositoryMockAddReportOrigins contains origins of expectations of the ReportRepository.AddReport
+type ReportRepositoryMockAddReportExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originReport string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddReport *mReportRepositoryMockAddReport) Optional() *mReportRepositoryMockAddReport {
+	mmAddReport.optional = true
+	return mmAddReport
+}
+
+// Expect sets up expected params for ReportRepository.AddReport
+func (mmAddReport *mReportRepositoryMockAddReport) Expect(ctx context.Context, report mm_repository.Report) *mReportRepositoryMockAddReport {
+	if mmAddReport.mock.funcAddReport != nil {
+		mmAddReport.mock.t.Fatalf("ReportRepositoryMock.AddReport mock is already set by Set")
+	}
+
+	if mmAddReport.defaultExpectation == nil {
+		mmAddReport.defaultExpectation = &ReportRepositoryMockAddReportExpectation{}
+	}
+
+	if mmAddReport.defaultExpectation.paramPtrs != nil {
+		mmAddReport.mock.t.Fatalf("ReportRepositoryMock.AddReport mock is already set by ExpectParams functions")
+	}
+
+	mmAddReport.defaultExpectation.params = &ReportRepositoryMockAddReportParams{ctx, report}
+	mmAddReport.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddReport.expectations {
+		if minimock.Equal(e.params, mmAddReport.defaultExpectation.params) {
+			mmAddReport.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddReport.defaultExpectation.params)
+		}
+	}
+
+	return mmAddReport
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReportRepository.AddReport
+func (mmAddReport *mReportRepositoryMockAddReport) ExpectCtxParam1(ctx context.Context) *mReportRepositoryMockAddReport {
+	if mmAddReport.mock.funcAddReport != nil {
+		mmAddReport.mock.t.Fatalf("ReportRepositoryMock.AddReport mock is already set by Set")
+	}
+
+	if mmAddReport.defaultExpectation == nil {
+		mmAddReport.defaultExpectation = &ReportRepositoryMockAddReportExpectation{}
+	}
+
+	if mmAddReport.defaultExpectation.params != nil {
+		mmAddReport.mock.t.Fatalf("ReportRepositoryMock.AddReport mock is already set by Expect")
+	}
+
+	if mmAddReport.defaultExpectation.paramPtrs == nil {
+		mmAddReport.defaultExpectation.paramPtrs = &ReportRepositoryMockAddReportParamPtrs{}
+	}
+	mmAddReport.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddReport.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddReport
+}
+
+// ExpectReportParam2 sets up expected param report for ReportRepository.AddReport
+func (mmAddReport *mReportRepositoryMockAddReport) ExpectReportParam2(report mm_repository.Report) *mReportRepositoryMockAddReport {
+	if mmAddReport.mock.funcAddReport != nil {
+		mmAddReport.mock.t.Fatalf("ReportRepositoryMock.AddReport mock is already set by Set")
+	}
+
+	if mmAddReport.defaultExpectation == nil {
+		mmAddReport.defaultExpectation = &ReportRepositoryMockAddReportExpectation{}
+	}
+
+	if mmAddReport.defaultExpectation.params != nil {
+		mmAddReport.mock.t.Fatalf("ReportRepositoryMock.AddReport mock is already set by Expect")
+	}
+
+	if mmAddReport.defaultExpectation.paramPtrs == nil {
+		mmAddReport.defaultExpectation.paramPtrs = &ReportRepositoryMockAddReportParamPtrs{}
+	}
+	mmAddReport.defaultExpectation.paramPtrs.report = &report
+	mmAddReport.defaultExpectation.expectationOrigins.originReport = minimock.CallerInfo(1)
+
+	return mmAddReport
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReportRepository.AddReport
+func (mmAddReport *mReportRepositoryMockAddReport) Inspect(f func(ctx context.Context, report mm_repository.Report)) *mReportRepositoryMockAddReport {
+	if mmAddReport.mock.inspectFuncAddReport != nil {
+		mmAddReport.mock.t.Fatalf("Inspect function is already set for ReportRepositoryMock.AddReport")
+	}
+
+	mmAddReport.mock.inspectFuncAddReport = f
+
+	return mmAddReport
+}
+
+// Return sets up results that will be returned by ReportRepository.AddReport
+func (mmAddReport *mReportRepositoryMockAddReport) Return(b1 bool, err error) *ReportRepositoryMock {
+	if mmAddReport.mock.funcAddReport != nil {
+		mmAddReport.mock.t.Fatalf("ReportRepositoryMock.AddReport mock is already set by Set")
+	}
+
+	if mmAddReport.defaultExpectation == nil {
+		mmAddReport.defaultExpectation = &ReportRepositoryMockAddReportExpectation{mock: mmAddReport.mock}
+	}
+	mmAddReport.defaultExpectation.results = &ReportRepositoryMockAddReportResults{b1, err}
+	mmAddReport.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddReport.mock
+}
+
+// Set uses given function f to mock the ReportRepository.AddReport method
+func (mmAddReport *mReportRepositoryMockAddReport) Set(f func(ctx context.Context, report mm_repository.Report) (b1 bool, err error)) *ReportRepositoryMock {
+	if mmAddReport.defaultExpectation != nil {
+		mmAddReport.mock.t.Fatalf("Default expectation is already set for the ReportRepository.AddReport method")
+	}
+
+	if len(mmAddReport.expectations) > 0 {
+		mmAddReport.mock.t.Fatalf("Some expectations are already set for the ReportRepository.AddReport method")
+	}
+
+	mmAddReport.mock.funcAddReport = f
+	mmAddReport.mock.funcAddReportOrigin = minimock.CallerInfo(1)
+	return mmAddReport.mock
+}
+
+// When sets expectation for the ReportRepository.AddReport which will trigger the result defined by the following
+// Then helper
+func (mmAddReport *mReportRepositoryMockAddReport) When(ctx context.Context, report mm_repository.Report) *ReportRepositoryMockAddReportExpectation {
+	if mmAddReport.mock.funcAddReport != nil {
+		mmAddReport.mock.t.Fatalf("ReportRepositoryMock.AddReport mock is already set by Set")
+	}
+
+	expectation := &ReportRepositoryMockAddReportExpectation{
+		mock:               mmAddReport.mock,
+		params:             &ReportRepositoryMockAddReportParams{ctx, report},
+		expectationOrigins: ReportRepositoryMockAddReportExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddReport.expectations = append(mmAddReport.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReportRepository.AddReport return parameters for the expectation previously defined by the When method
+func (e *ReportRepositoryMockAddReportExpectation) Then(b1 bool, err error) *ReportRepositoryMock {
+	e.results = &ReportRepositoryMockAddReportResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ReportRepository.AddReport should be invoked
+func (mmAddReport *mReportRepositoryMockAddReport) Times(n uint64) *mReportRepositoryMockAddReport {
+	if n == 0 {
+		mmAddReport.mock.t.Fatalf("Times of ReportRepositoryMock.AddReport mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddReport.expectedInvocations, n)
+	mmAddReport.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddReport
+}
+
+func (mmAddReport *mReportRepositoryMockAddReport) invocationsDone() bool {
+	if len(mmAddReport.expectations) == 0 && mmAddReport.defaultExpectation == nil && mmAddReport.mock.funcAddReport == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddReport.mock.afterAddReportCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddReport.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddReport implements mm_repository.ReportRepository
+func (mmAddReport *ReportRepositoryMock) AddReport(ctx context.Context, report mm_repository.Report) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmAddReport.beforeAddReportCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddReport.afterAddReportCounter, 1)
+
+	mmAddReport.t.Helper()
+
+	if mmAddReport.inspectFuncAddReport != nil {
+		mmAddReport.inspectFuncAddReport(ctx, report)
+	}
+
+	mm_params := ReportRepositoryMockAddReportParams{ctx, report}
+
+	// Record call args
+	mmAddReport.AddReportMock.mutex.Lock()
+	mmAddReport.AddReportMock.callArgs = append(mmAddReport.AddReportMock.callArgs, &mm_params)
+	mmAddReport.AddReportMock.mutex.Unlock()
+
+	for _, e := range mmAddReport.AddReportMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmAddReport.AddReportMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddReport.AddReportMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddReport.AddReportMock.defaultExpectation.params
+		mm_want_ptrs := mmAddReport.AddReportMock.defaultExpectation.paramPtrs
+
+		mm_got := ReportRepositoryMockAddReportParams{ctx, report}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddReport.t.Errorf("ReportRepositoryMock.AddReport got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReport.AddReportMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.report != nil && !minimock.Equal(*mm_want_ptrs.report, mm_got.report) {
+				mmAddReport.t.Errorf("ReportRepositoryMock.AddReport got unexpected parameter report, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReport.AddReportMock.defaultExpectation.expectationOrigins.originReport, *mm_want_ptrs.report, mm_got.report, minimock.Diff(*mm_want_ptrs.report, mm_got.report))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddReport.t.Errorf("ReportRepositoryMock.AddReport got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddReport.AddReportMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddReport.AddReportMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddReport.t.Fatal("No results are set for the ReportRepositoryMock.AddReport")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmAddReport.funcAddReport != nil {
+		return mmAddReport.funcAddReport(ctx, report)
+	}
+	mmAddReport.t.Fatalf("Unexpected call to ReportRepositoryMock.AddReport. %v %v", ctx, report)
+	return
+}
+
+// AddReportAfterCounter returns a count of finished ReportRepositoryMock.AddReport invocations
+func (mmAddReport *ReportRepositoryMock) AddReportAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReport.afterAddReportCounter)
+}
+
+// AddReportBeforeCounter returns a count of ReportRepositoryMock.AddReport invocations
+func (mmAddReport *ReportRepositoryMock) AddReportBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReport.beforeAddReportCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReportRepositoryMock.AddReport.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddReport *mReportRepositoryMockAddReport) Calls() []*ReportRepositoryMockAddReportParams {
+	mmAddReport.mutex.RLock()
+
+	argCopy := make([]*ReportRepositoryMockAddReportParams, len(mmAddReport.callArgs))
+	copy(argCopy, mmAddReport.callArgs)
+
+	mmAddReport.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddReportDone returns true if the count of the AddReport invocations corresponds
+// the number of defined expectations
+func (m *ReportRepositoryMock) MinimockAddReportDone() bool {
+	if m.AddReportMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddReportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddReportMock.invocationsDone()
+}
+
+// MinimockAddReportInspect logs each unmet expectation
+func (m *ReportRepositoryMock) MinimockAddReportInspect() {
+	for _, e := range m.AddReportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReportRepositoryMock.AddReport at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddReportCounter := mm_atomic.LoadUint64(&m.afterAddReportCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddReportMock.defaultExpectation != nil && afterAddReportCounter < 1 {
+		if m.AddReportMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReportRepositoryMock.AddReport at\n%s", m.AddReportMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReportRepositoryMock.AddReport at\n%s with params: %#v", m.AddReportMock.defaultExpectation.expectationOrigins.origin, *m.AddReportMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddReport != nil && afterAddReportCounter < 1 {
+		m.t.Errorf("Expected call to ReportRepositoryMock.AddReport at\n%s", m.funcAddReportOrigin)
+	}
+
+	if !m.AddReportMock.invocationsDone() && afterAddReportCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReportRepositoryMock.AddReport at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddReportMock.expectedInvocations), m.AddReportMock.expectedInvocationsOrigin, afterAddReportCounter)
+	}
+}
+
+type mReportRepositoryMockCountReports struct {
+	optional           bool
+	mock               *ReportRepositoryMock
+	defaultExpectation *ReportRepositoryMockCountReportsExpectation
+	expectations       []*ReportRepositoryMockCountReportsExpectation
+
+	callArgs []*ReportRepositoryMockCountReportsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReportRepositoryMockCountReportsExpectation specifies expectation struct of the ReportRepository.CountReports
+type ReportRepositoryMockCountReportsExpectation struct {
+	mock               *ReportRepositoryMock
+	params             *ReportRepositoryMockCountReportsParams
+	paramPtrs          *ReportRepositoryMockCountReportsParamPtrs
+	expectationOrigins ReportRepositoryMockCountReportsExpectationOrigins
+	results            *ReportRepositoryMockCountReportsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReportRepositoryMockCountReportsParams contains parameters of the ReportRepository.CountReports
+type ReportRepositoryMockCountReportsParams struct {
+	ctx     context.Context
+	userID  string
+	movieID string
+	after   time.Time
+}
+
+// ReportRepositoryMockCountReportsParamPtrs contains pointers to parameters of the ReportRepository.CountReports
+type ReportRepositoryMockCountReportsParamPtrs struct {
+	ctx     *context.Context
+	userID  *string
+	movieID *string
+	after   *time.Time
+}
+
+// ReportRepositoryMockCountReportsResults contains results of the ReportRepository.CountReports
+type ReportRepositoryMockCountReportsResults struct {
+	i1  int64
+	err error
+}
+
+// ReportRepositoryMockCountReportsOrigins contains origins of expectations of the ReportRepository.CountReports
+type ReportRepositoryMockCountReportsExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originMovieID string
+	originAfter   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCountReports *mReportRepositoryMockCountReports) Optional() *mReportRepositoryMockCountReports {
+	mmCountReports.optional = true
+	return mmCountReports
+}
+
+// Expect sets up expected params for ReportRepository.CountReports
+func (mmCountReports *mReportRepositoryMockCountReports) Expect(ctx context.Context, userID string, movieID string, after time.Time) *mReportRepositoryMockCountReports {
+	if mmCountReports.mock.funcCountReports != nil {
+		mmCountReports.mock.t.Fatalf("ReportRepositoryMock.CountReports mock is already set by Set")
+	}
+
+	if mmCountReports.defaultExpectation == nil {
+		mmCountReports.defaultExpectation = &ReportRepositoryMockCountReportsExpectation{}
+	}
+
+	if mmCountReports.defaultExpectation.paramPtrs != nil {
+		mmCountReports.mock.t.Fatalf("ReportRepositoryMock.CountReports mock is already set by ExpectParams functions")
+	}
+
+	mmCountReports.defaultExpectation.params = &ReportRepositoryMockCountReportsParams{ctx, userID, movieID, after}
+	mmCountReports.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCountReports.expectations {
+		if minimock.Equal(e.params, mmCountReports.defaultExpectation.params) {
+			mmCountReports.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountReports.defaultExpectation.params)
+		}
+	}
+
+	return mmCountReports
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReportRepository.CountReports
+func (mmCountReports *mReportRepositoryMockCountReports) ExpectCtxParam1(ctx context.Context) *mReportRepositoryMockCountReports {
+	if mmCountReports.mock.funcCountReports != nil {
+		mmCountReports.mock.t.Fatalf("ReportRepositoryMock.CountReports mock is already set by Set")
+	}
+
+	if mmCountReports.defaultExpectation == nil {
+		mmCountReports.defaultExpectation = &ReportRepositoryMockCountReportsExpectation{}
+	}
+
+	if mmCountReports.defaultExpectation.params != nil {
+		mmCountReports.mock.t.Fatalf("ReportRepositoryMock.CountReports mock is already set by Expect")
+	}
+
+	if mmCountReports.defaultExpectation.paramPtrs == nil {
+		mmCountReports.defaultExpectation.paramPtrs = &ReportRepositoryMockCountReportsParamPtrs{}
+	}
+	mmCountReports.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCountReports.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCountReports
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ReportRepository.CountReports
+func (mmCountReports *mReportRepositoryMockCountReports) ExpectUserIDParam2(userID string) *mReportRepositoryMockCountReports {
+	if mmCountReports.mock.funcCountReports != nil {
+		mmCountReports.mock.t.Fatalf("ReportRepositoryMock.CountReports mock is already set by Set")
+	}
+
+	if mmCountReports.defaultExpectation == nil {
+		mmCountReports.defaultExpectation = &ReportRepositoryMockCountReportsExpectation{}
+	}
+
+	if mmCountReports.defaultExpectation.params != nil {
+		mmCountReports.mock.t.Fatalf("ReportRepositoryMock.CountReports mock is already set by Expect")
+	}
+
+	if mmCountReports.defaultExpectation.paramPtrs == nil {
+		mmCountReports.defaultExpectation.paramPtrs = &ReportRepositoryMockCountReportsParamPtrs{}
+	}
+	mmCountReports.defaultExpectation.paramPtrs.userID = &userID
+	mmCountReports.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmCountReports
+}
+
+// ExpectMovieIDParam3 sets up expected param movieID for ReportRepository.CountReports
+func (mmCountReports *mReportRepositoryMockCountReports) ExpectMovieIDParam3(movieID string) *mReportRepositoryMockCountReports {
+	if mmCountReports.mock.funcCountReports != nil {
+		mmCountReports.mock.t.Fatalf("ReportRepositoryMock.CountReports mock is already set by Set")
+	}
+
+	if mmCountReports.defaultExpectation == nil {
+		mmCountReports.defaultExpectation = &ReportRepositoryMockCountReportsExpectation{}
+	}
+
+	if mmCountReports.defaultExpectation.params != nil {
+		mmCountReports.mock.t.Fatalf("ReportRepositoryMock.CountReports mock is already set by Expect")
+	}
+
+	if mmCountReports.defaultExpectation.paramPtrs == nil {
+		mmCountReports.defaultExpectation.paramPtrs = &ReportRepositoryMockCountReportsParamPtrs{}
+	}
+	mmCountReports.defaultExpectation.paramPtrs.movieID = &movieID
+	mmCountReports.defaultExpectation.expectationOrigins.originMovieID = minimock.CallerInfo(1)
+
+	return mmCountReports
+}
+
+// ExpectAfterParam4 sets up expected param after for ReportRepository.CountReports
+func (mmCountReports *mReportRepositoryMockCountReports) ExpectAfterParam4(after time.Time) *mReportRepositoryMockCountReports {
+	if mmCountReports.mock.funcCountReports != nil {
+		mmCountReports.mock.t.Fatalf("ReportRepositoryMock.CountReports mock is already set by Set")
+	}
+
+	if mmCountReports.defaultExpectation == nil {
+		mmCountReports.defaultExpectation = &ReportRepositoryMockCountReportsExpectation{}
+	}
+
+	if mmCountReports.defaultExpectation.params != nil {
+		mmCountReports.mock.t.Fatalf("ReportRepositoryMock.CountReports mock is already set by Expect")
+	}
+
+	if mmCountReports.defaultExpectation.paramPtrs == nil {
+		mmCountReports.defaultExpectation.paramPtrs = &ReportRepositoryMockCountReportsParamPtrs{}
+	}
+	mmCountReports.defaultExpectation.paramPtrs.after = &after
+	mmCountReports.defaultExpectation.expectationOrigins.originAfter = minimock.CallerInfo(1)
+
+	return mmCountReports
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReportRepository.CountReports
+func (mmCountReports *mReportRepositoryMockCountReports) Inspect(f func(ctx context.Context, userID string, movieID string, after time.Time)) *mReportRepositoryMockCountReports {
+	if mmCountReports.mock.inspectFuncCountReports != nil {
+		mmCountReports.mock.t.Fatalf("Inspect function is already set for ReportRepositoryMock.CountReports")
+	}
+
+	mmCountReports.mock.inspectFuncCountReports = f
+
+	return mmCountReports
+}
+
+// Return sets up results that will be returned by ReportRepository.CountReports
+func (mmCountReports *mReportRepositoryMockCountReports) Return(i1 int64, err error) *ReportRepositoryMock {
+	if mmCountReports.mock.funcCountReports != nil {
+		mmCountReports.mock.t.Fatalf("ReportRepositoryMock.CountReports mock is already set by Set")
+	}
+
+	if mmCountReports.defaultExpectation == nil {
+		mmCountReports.defaultExpectation = &ReportRepositoryMockCountReportsExpectation{mock: mmCountReports.mock}
+	}
+	mmCountReports.defaultExpectation.results = &ReportRepositoryMockCountReportsResults{i1, err}
+	mmCountReports.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCountReports.mock
+}
+
+// Set uses given function f to mock the ReportRepository.CountReports method
+func (mmCountReports *mReportRepositoryMockCountReports) Set(f func(ctx context.Context, userID string, movieID string, after time.Time) (i1 int64, err error)) *ReportRepositoryMock {
+	if mmCountReports.defaultExpectation != nil {
+		mmCountReports.mock.t.Fatalf("Default expectation is already set for the ReportRepository.CountReports method")
+	}
+
+	if len(mmCountReports.expectations) > 0 {
+		mmCountReports.mock.t.Fatalf("Some expectations are already set for the ReportRepository.CountReports method")
+	}
+
+	mmCountReports.mock.funcCountReports = f
+	mmCountReports.mock.funcCountReportsOrigin = minimock.CallerInfo(1)
+	return mmCountReports.mock
+}
+
+// When sets expectation for the ReportRepository.CountReports which will trigger the result defined by the following
+// Then helper
+func (mmCountReports *mReportRepositoryMockCountReports) When(ctx context.Context, userID string, movieID string, after time.Time) *ReportRepositoryMockCountReportsExpectation {
+	if mmCountReports.mock.funcCountReports != nil {
+		mmCountReports.mock.t.Fatalf("ReportRepositoryMock.CountReports mock is already set by Set")
+	}
+
+	expectation := &ReportRepositoryMockCountReportsExpectation{
+		mock:               mmCountReports.mock,
+		params:             &ReportRepositoryMockCountReportsParams{ctx, userID, movieID, after},
+		expectationOrigins: ReportRepositoryMockCountReportsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCountReports.expectations = append(mmCountReports.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReportRepository.CountReports return parameters for the expectation previously defined by the When method
+func (e *ReportRepositoryMockCountReportsExpectation) Then(i1 int64, err error) *ReportRepositoryMock {
+	e.results = &ReportRepositoryMockCountReportsResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ReportRepository.CountReports should be invoked
+func (mmCountReports *mReportRepositoryMockCountReports) Times(n uint64) *mReportRepositoryMockCountReports {
+	if n == 0 {
+		mmCountReports.mock.t.Fatalf("Times of ReportRepositoryMock.CountReports mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountReports.expectedInvocations, n)
+	mmCountReports.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCountReports
+}
+
+func (mmCountReports *mReportRepositoryMockCountReports) invocationsDone() bool {
+	if len(mmCountReports.expectations) == 0 && mmCountReports.defaultExpectation == nil && mmCountReports.mock.funcCountReports == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountReports.mock.afterCountReportsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountReports.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountReports implements mm_repository.ReportRepository
+func (mmCountReports *ReportRepositoryMock) CountReports(ctx context.Context, userID string, movieID string, after time.Time) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCountReports.beforeCountReportsCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountReports.afterCountReportsCounter, 1)
+
+	mmCountReports.t.Helper()
+
+	if mmCountReports.inspectFuncCountReports != nil {
+		mmCountReports.inspectFuncCountReports(ctx, userID, movieID, after)
+	}
+
+	mm_params := ReportRepositoryMockCountReportsParams{ctx, userID, movieID, after}
+
+	// Record call args
+	mmCountReports.CountReportsMock.mutex.Lock()
+	mmCountReports.CountReportsMock.callArgs = append(mmCountReports.CountReportsMock.callArgs, &mm_params)
+	mmCountReports.CountReportsMock.mutex.Unlock()
+
+	for _, e := range mmCountReports.CountReportsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCountReports.CountReportsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountReports.CountReportsMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountReports.CountReportsMock.defaultExpectation.params
+		mm_want_ptrs := mmCountReports.CountReportsMock.defaultExpectation.paramPtrs
+
+		mm_got := ReportRepositoryMockCountReportsParams{ctx, userID, movieID, after}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountReports.t.Errorf("ReportRepositoryMock.CountReports got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountReports.CountReportsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmCountReports.t.Errorf("ReportRepositoryMock.CountReports got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountReports.CountReportsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.movieID != nil && !minimock.Equal(*mm_want_ptrs.movieID, mm_got.movieID) {
+				mmCountReports.t.Errorf("ReportRepositoryMock.CountReports got unexpected parameter movieID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountReports.CountReportsMock.defaultExpectation.expectationOrigins.originMovieID, *mm_want_ptrs.movieID, mm_got.movieID, minimock.Diff(*mm_want_ptrs.movieID, mm_got.movieID))
+			}
+
+			if mm_want_ptrs.after != nil && !minimock.Equal(*mm_want_ptrs.after, mm_got.after) {
+				mmCountReports.t.Errorf("ReportRepositoryMock.CountReports got unexpected parameter after, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountReports.CountReportsMock.defaultExpectation.expectationOrigins.originAfter, *mm_want_ptrs.after, mm_got.after, minimock.Diff(*mm_want_ptrs.after, mm_got.after))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountReports.t.Errorf("ReportRepositoryMock.CountReports got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCountReports.CountReportsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountReports.CountReportsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountReports.t.Fatal("No results are set for the ReportRepositoryMock.CountReports")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCountReports.funcCountReports != nil {
+		return mmCountReports.funcCountReports(ctx, userID, movieID, after)
+	}
+	mmCountReports.t.Fatalf("Unexpected call to ReportRepositoryMock.CountReports. %v %v %v %v", ctx, userID, movieID, after)
+	return
+}
+
+// CountReportsAfterCounter returns a count of finished ReportRepositoryMock.CountReports invocations
+func (mmCountReports *ReportRepositoryMock) CountReportsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountReports.afterCountReportsCounter)
+}
+
+// CountReportsBeforeCounter returns a count of ReportRepositoryMock.CountReports invocations
+func (mmCountReports *ReportRepositoryMock) CountReportsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountReports.beforeCountReportsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReportRepositoryMock.CountReports.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountReports *mReportRepositoryMockCountReports) Calls() []*ReportRepositoryMockCountReportsParams {
+	mmCountReports.mutex.RLock()
+
+	argCopy := make([]*ReportRepositoryMockCountReportsParams, len(mmCountReports.callArgs))
+	copy(argCopy, mmCountReports.callArgs)
+
+	mmCountReports.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountReportsDone returns true if the count of the CountReports invocations corresponds
+// the number of defined expectations
+func (m *ReportRepositoryMock) MinimockCountReportsDone() bool {
+	if m.CountReportsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountReportsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountReportsMock.invocationsDone()
+}
+
+// MinimockCountReportsInspect logs each unmet expectation
+func (m *ReportRepositoryMock) MinimockCountReportsInspect() {
+	for _, e := range m.CountReportsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReportRepositoryMock.CountReports at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountReportsCounter := mm_atomic.LoadUint64(&m.afterCountReportsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountReportsMock.defaultExpectation != nil && afterCountReportsCounter < 1 {
+		if m.CountReportsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReportRepositoryMock.CountReports at\n%s", m.CountReportsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReportRepositoryMock.CountReports at\n%s with params: %#v", m.CountReportsMock.defaultExpectation.expectationOrigins.origin, *m.CountReportsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountReports != nil && afterCountReportsCounter < 1 {
+		m.t.Errorf("Expected call to ReportRepositoryMock.CountReports at\n%s", m.funcCountReportsOrigin)
+	}
+
+	if !m.CountReportsMock.invocationsDone() && afterCountReportsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReportRepositoryMock.CountReports at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountReportsMock.expectedInvocations), m.CountReportsMock.expectedInvocationsOrigin, afterCountReportsCounter)
+	}
+}
+
+type mReportRepositoryMockDeleteUserReports struct {
+	optional           bool
+	mock               *ReportRepositoryMock
+	defaultExpectation *ReportRepositoryMockDeleteUserReportsExpectation
+	expectations       []*ReportRepositoryMockDeleteUserReportsExpectation
+
+	callArgs []*ReportRepositoryMockDeleteUserReportsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReportRepositoryMockDeleteUserReportsExpectation specifies expectation struct of the ReportRepository.DeleteUserReports
+type ReportRepositoryMockDeleteUserReportsExpectation struct {
+	mock               *ReportRepositoryMock
+	params             *ReportRepositoryMockDeleteUserReportsParams
+	paramPtrs          *ReportRepositoryMockDeleteUserReportsParamPtrs
+	expectationOrigins ReportRepositoryMockDeleteUserReportsExpectationOrigins
+	results            *ReportRepositoryMockDeleteUserReportsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReportRepositoryMockDeleteUserReportsParams contains parameters of the ReportRepository.DeleteUserReports
+type ReportRepositoryMockDeleteUserReportsParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// ReportRepositoryMockDeleteUserReportsParamPtrs contains pointers to parameters of the ReportRepository.DeleteUserReports
+type ReportRepositoryMockDeleteUserReportsParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// ReportRepositoryMockDeleteUserReportsResults contains results of the ReportRepository.DeleteUserReports
+type ReportRepositoryMockDeleteUserReportsResults struct {
+	err error
+}
+
+// ReportRepositoryMockDeleteUserReportsOrigins contains origins of expectations of the ReportRepository.DeleteUserReports
+type ReportRepositoryMockDeleteUserReportsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteUserReports *mReportRepositoryMockDeleteUserReports) Optional() *mReportRepositoryMockDeleteUserReports {
+	mmDeleteUserReports.optional = true
+	return mmDeleteUserReports
+}
+
+// Expect sets up expected params for ReportRepository.DeleteUserReports
+func (mmDeleteUserReports *mReportRepositoryMockDeleteUserReports) Expect(ctx context.Context, userID string) *mReportRepositoryMockDeleteUserReports {
+	if mmDeleteUserReports.mock.funcDeleteUserReports != nil {
+		mmDeleteUserReports.mock.t.Fatalf("ReportRepositoryMock.DeleteUserReports mock is already set by Set")
+	}
+
+	if mmDeleteUserReports.defaultExpectation == nil {
+		mmDeleteUserReports.defaultExpectation = &ReportRepositoryMockDeleteUserReportsExpectation{}
+	}
+
+	if mmDeleteUserReports.defaultExpectation.paramPtrs != nil {
+		mmDeleteUserReports.mock.t.Fatalf("ReportRepositoryMock.DeleteUserReports mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteUserReports.defaultExpectation.params = &ReportRepositoryMockDeleteUserReportsParams{ctx, userID}
+	mmDeleteUserReports.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteUserReports.expectations {
+		if minimock.Equal(e.params, mmDeleteUserReports.defaultExpectation.params) {
+			mmDeleteUserReports.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUserReports.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteUserReports
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReportRepository.DeleteUserReports
+func (mmDeleteUserReports *mReportRepositoryMockDeleteUserReports) ExpectCtxParam1(ctx context.Context) *mReportRepositoryMockDeleteUserReports {
+	if mmDeleteUserReports.mock.funcDeleteUserReports != nil {
+		mmDeleteUserReports.mock.t.Fatalf("ReportRepositoryMock.DeleteUserReports mock is already set by Set")
+	}
+
+	if mmDeleteUserReports.defaultExpectation == nil {
+		mmDeleteUserReports.defaultExpectation = &ReportRepositoryMockDeleteUserReportsExpectation{}
+	}
+
+	if mmDeleteUserReports.defaultExpectation.params != nil {
+		mmDeleteUserReports.mock.t.Fatalf("ReportRepositoryMock.DeleteUserReports mock is already set by Expect")
+	}
+
+	if mmDeleteUserReports.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserReports.defaultExpectation.paramPtrs = &ReportRepositoryMockDeleteUserReportsParamPtrs{}
+	}
+	mmDeleteUserReports.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteUserReports.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteUserReports
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ReportRepository.DeleteUserReports
+func (mmDeleteUserReports *mReportRepositoryMockDeleteUserReports) ExpectUserIDParam2(userID string) *mReportRepositoryMockDeleteUserReports {
+	if mmDeleteUserReports.mock.funcDeleteUserReports != nil {
+		mmDeleteUserReports.mock.t.Fatalf("ReportRepositoryMock.DeleteUserReports mock is already set by Set")
+	}
+
+	if mmDeleteUserReports.defaultExpectation == nil {
+		mmDeleteUserReports.defaultExpectation = &ReportRepositoryMockDeleteUserReportsExpectation{}
+	}
+
+	if mmDeleteUserReports.defaultExpectation.params != nil {
+		mmDeleteUserReports.mock.t.Fatalf("ReportRepositoryMock.DeleteUserReports mock is already set by Expect")
+	}
+
+	if mmDeleteUserReports.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserReports.defaultExpectation.paramPtrs = &ReportRepositoryMockDeleteUserReportsParamPtrs{}
+	}
+	mmDeleteUserReports.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteUserReports.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteUserReports
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReportRepository.DeleteUserReports
+func (mmDeleteUserReports *mReportRepositoryMockDeleteUserReports) Inspect(f func(ctx context.Context, userID string)) *mReportRepositoryMockDeleteUserReports {
+	if mmDeleteUserReports.mock.inspectFuncDeleteUserReports != nil {
+		mmDeleteUserReports.mock.t.Fatalf("Inspect function is already set for ReportRepositoryMock.DeleteUserReports")
+	}
+
+	mmDeleteUserReports.mock.inspectFuncDeleteUserReports = f
+
+	return mmDeleteUserReports
+}
+
+// Return sets up results that will be returned by ReportRepository.DeleteUserReports
+func (mmDeleteUserReports *mReportRepositoryMockDeleteUserReports) Return(err error) *ReportRepositoryMock {
+	if mmDeleteUserReports.mock.funcDeleteUserReports != nil {
+		mmDeleteUserReports.mock.t.Fatalf("ReportRepositoryMock.DeleteUserReports mock is already set by Set")
+	}
+
+	if mmDeleteUserReports.defaultExpectation == nil {
+		mmDeleteUserReports.defaultExpectation = &ReportRepositoryMockDeleteUserReportsExpectation{mock: mmDeleteUserReports.mock}
+	}
+	mmDeleteUserReports.defaultExpectation.results = &ReportRepositoryMockDeleteUserReportsResults{err}
+	mmDeleteUserReports.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserReports.mock
+}
+
+// Set uses given function f to mock the ReportRepository.DeleteUserReports method
+func (mmDeleteUserReports *mReportRepositoryMockDeleteUserReports) Set(f func(ctx context.Context, userID string) (err error)) *ReportRepositoryMock {
+	if mmDeleteUserReports.defaultExpectation != nil {
+		mmDeleteUserReports.mock.t.Fatalf("Default expectation is already set for the ReportRepository.DeleteUserReports method")
+	}
+
+	if len(mmDeleteUserReports.expectations) > 0 {
+		mmDeleteUserReports.mock.t.Fatalf("Some expectations are already set for the ReportRepository.DeleteUserReports method")
+	}
+
+	mmDeleteUserReports.mock.funcDeleteUserReports = f
+	mmDeleteUserReports.mock.funcDeleteUserReportsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserReports.mock
+}
+
+// When sets expectation for the ReportRepository.DeleteUserReports which will trigger the result defined by the following
+// Then helper
+func (mmDeleteUserReports *mReportRepositoryMockDeleteUserReports) When(ctx context.Context, userID string) *ReportRepositoryMockDeleteUserReportsExpectation {
+	if mmDeleteUserReports.mock.funcDeleteUserReports != nil {
+		mmDeleteUserReports.mock.t.Fatalf("ReportRepositoryMock.DeleteUserReports mock is already set by Set")
+	}
+
+	expectation := &ReportRepositoryMockDeleteUserReportsExpectation{
+		mock:               mmDeleteUserReports.mock,
+		params:             &ReportRepositoryMockDeleteUserReportsParams{ctx, userID},
+		expectationOrigins: ReportRepositoryMockDeleteUserReportsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteUserReports.expectations = append(mmDeleteUserReports.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReportRepository.DeleteUserReports return parameters for the expectation previously defined by the When method
+func (e *ReportRepositoryMockDeleteUserReportsExpectation) Then(err error) *ReportRepositoryMock {
+	e.results = &ReportRepositoryMockDeleteUserReportsResults{err}
+	return e.mock
+}
+
+// Times sets number of times ReportRepository.DeleteUserReports should be invoked
+func (mmDeleteUserReports *mReportRepositoryMockDeleteUserReports) Times(n uint64) *mReportRepositoryMockDeleteUserReports {
+	if n == 0 {
+		mmDeleteUserReports.mock.t.Fatalf("Times of ReportRepositoryMock.DeleteUserReports mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteUserReports.expectedInvocations, n)
+	mmDeleteUserReports.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserReports
+}
+
+func (mmDeleteUserReports *mReportRepositoryMockDeleteUserReports) invocationsDone() bool {
+	if len(mmDeleteUserReports.expectations) == 0 && mmDeleteUserReports.defaultExpectation == nil && mmDeleteUserReports.mock.funcDeleteUserReports == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUserReports.mock.afterDeleteUserReportsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUserReports.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteUserReports implements mm_repository.ReportRepository
+func (mmDeleteUserReports *ReportRepositoryMock) DeleteUserReports(ctx context.Context, userID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteUserReports.beforeDeleteUserReportsCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUserReports.afterDeleteUserReportsCounter, 1)
+
+	mmDeleteUserReports.t.Helper()
+
+	if mmDeleteUserReports.inspectFuncDeleteUserReports != nil {
+		mmDeleteUserReports.inspectFuncDeleteUserReports(ctx, userID)
+	}
+
+	mm_params := ReportRepositoryMockDeleteUserReportsParams{ctx, userID}
+
+	// Record call args
+	mmDeleteUserReports.DeleteUserReportsMock.mutex.Lock()
+	mmDeleteUserReports.DeleteUserReportsMock.callArgs = append(mmDeleteUserReports.DeleteUserReportsMock.callArgs, &mm_params)
+	mmDeleteUserReports.DeleteUserReportsMock.mutex.Unlock()
+
+	for _, e := range mmDeleteUserReports.DeleteUserReportsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteUserReports.DeleteUserReportsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUserReports.DeleteUserReportsMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUserReports.DeleteUserReportsMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUserReports.DeleteUserReportsMock.defaultExpectation.paramPtrs
+
+		mm_got := ReportRepositoryMockDeleteUserReportsParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteUserReports.t.Errorf("ReportRepositoryMock.DeleteUserReports got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserReports.DeleteUserReportsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteUserReports.t.Errorf("ReportRepositoryMock.DeleteUserReports got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserReports.DeleteUserReportsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteUserReports.t.Errorf("ReportRepositoryMock.DeleteUserReports got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteUserReports.DeleteUserReportsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteUserReports.DeleteUserReportsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteUserReports.t.Fatal("No results are set for the ReportRepositoryMock.DeleteUserReports")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteUserReports.funcDeleteUserReports != nil {
+		return mmDeleteUserReports.funcDeleteUserReports(ctx, userID)
+	}
+	mmDeleteUserReports.t.Fatalf("Unexpected call to ReportRepositoryMock.DeleteUserReports. %v %v", ctx, userID)
+	return
+}
+
+// DeleteUserReportsAfterCounter returns a count of finished ReportRepositoryMock.DeleteUserReports invocations
+func (mmDeleteUserReports *ReportRepositoryMock) DeleteUserReportsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserReports.afterDeleteUserReportsCounter)
+}
+
+// DeleteUserReportsBeforeCounter returns a count of ReportRepositoryMock.DeleteUserReports invocations
+func (mmDeleteUserReports *ReportRepositoryMock) DeleteUserReportsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserReports.beforeDeleteUserReportsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReportRepositoryMock.DeleteUserReports.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteUserReports *mReportRepositoryMockDeleteUserReports) Calls() []*ReportRepositoryMockDeleteUserReportsParams {
+	mmDeleteUserReports.mutex.RLock()
+
+	argCopy := make([]*ReportRepositoryMockDeleteUserReportsParams, len(mmDeleteUserReports.callArgs))
+	copy(argCopy, mmDeleteUserReports.callArgs)
+
+	mmDeleteUserReports.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteUserReportsDone returns true if the count of the DeleteUserReports invocations corresponds
+// the number of defined expectations
+func (m *ReportRepositoryMock) MinimockDeleteUserReportsDone() bool {
+	if m.DeleteUserReportsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteUserReportsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteUserReportsMock.invocationsDone()
+}
+
+// MinimockDeleteUserReportsInspect logs each unmet expectation
+func (m *ReportRepositoryMock) MinimockDeleteUserReportsInspect() {
+	for _, e := range m.DeleteUserReportsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReportRepositoryMock.DeleteUserReports at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteUserReportsCounter := mm_atomic.LoadUint64(&m.afterDeleteUserReportsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteUserReportsMock.defaultExpectation != nil && afterDeleteUserReportsCounter < 1 {
+		if m.DeleteUserReportsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReportRepositoryMock.DeleteUserReports at\n%s", m.DeleteUserReportsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReportRepositoryMock.DeleteUserReports at\n%s with params: %#v", m.DeleteUserReportsMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserReportsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteUserReports != nil && afterDeleteUserReportsCounter < 1 {
+		m.t.Errorf("Expected call to ReportRepositoryMock.DeleteUserReports at\n%s", m.funcDeleteUserReportsOrigin)
+	}
+
+	if !m.DeleteUserReportsMock.invocationsDone() && afterDeleteUserReportsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReportRepositoryMock.DeleteUserReports at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUserReportsMock.expectedInvocations), m.DeleteUserReportsMock.expectedInvocationsOrigin, afterDeleteUserReportsCounter)
+	}
+}
+
+type mReportRepositoryMockListReportsBy struct {
+	optional           bool
+	mock               *ReportRepositoryMock
+	defaultExpectation *ReportRepositoryMockListReportsByExpectation
+	expectations       []*ReportRepositoryMockListReportsByExpectation
+
+	callArgs []*ReportRepositoryMockListReportsByParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReportRepositoryMockListReportsByExpectation specifies expectation struct of the ReportRepository.ListReportsBy
+type ReportRepositoryMockListReportsByExpectation struct {
+	mock               *ReportRepositoryMock
+	params             *ReportRepositoryMockListReportsByParams
+	paramPtrs          *ReportRepositoryMockListReportsByParamPtrs
+	expectationOrigins ReportRepositoryMockListReportsByExpectationOrigins
+	results            *ReportRepositoryMockListReportsByResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReportRepositoryMockListReportsByParams contains parameters of the ReportRepository.ListReportsBy
+type ReportRepositoryMockListReportsByParams struct {
+	ctx        context.Context
+	reporterID string
+}
+
+// ReportRepositoryMockListReportsByParamPtrs contains pointers to parameters of the ReportRepository.ListReportsBy
+type ReportRepositoryMockListReportsByParamPtrs struct {
+	ctx        *context.Context
+	reporterID *string
+}
+
+// ReportRepositoryMockListReportsByResults contains results of the ReportRepository.ListReportsBy
+type ReportRepositoryMockListReportsByResults struct {
+	ra1 []mm_repository.Report
+	err error
+}
+
+// ReportRepositoryMockListReportsByOrigins contains origins of expectations of the ReportRepository.ListReportsBy
+type ReportRepositoryMockListReportsByExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originReporterID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListReportsBy *mReportRepositoryMockListReportsBy) Optional() *mReportRepositoryMockListReportsBy {
+	mmListReportsBy.optional = true
+	return mmListReportsBy
+}
+
+// Expect sets up expected params for ReportRepository.ListReportsBy
+func (mmListReportsBy *mReportRepositoryMockListReportsBy) Expect(ctx context.Context, reporterID string) *mReportRepositoryMockListReportsBy {
+	if mmListReportsBy.mock.funcListReportsBy != nil {
+		mmListReportsBy.mock.t.Fatalf("ReportRepositoryMock.ListReportsBy mock is already set by Set")
+	}
+
+	if mmListReportsBy.defaultExpectation == nil {
+		mmListReportsBy.defaultExpectation = &ReportRepositoryMockListReportsByExpectation{}
+	}
+
+	if mmListReportsBy.defaultExpectation.paramPtrs != nil {
+		mmListReportsBy.mock.t.Fatalf("ReportRepositoryMock.ListReportsBy mock is already set by ExpectParams functions")
+	}
+
+	mmListReportsBy.defaultExpectation.params = &ReportRepositoryMockListReportsByParams{ctx, reporterID}
+	mmListReportsBy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListReportsBy.expectations {
+		if minimock.Equal(e.params, mmListReportsBy.defaultExpectation.params) {
+			mmListReportsBy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListReportsBy.defaultExpectation.params)
+		}
+	}
+
+	return mmListReportsBy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReportRepository.ListReportsBy
+func (mmListReportsBy *mReportRepositoryMockListReportsBy) ExpectCtxParam1(ctx context.Context) *mReportRepositoryMockListReportsBy {
+	if mmListReportsBy.mock.funcListReportsBy != nil {
+		mmListReportsBy.mock.t.Fatalf("ReportRepositoryMock.ListReportsBy mock is already set by Set")
+	}
+
+	if mmListReportsBy.defaultExpectation == nil {
+		mmListReportsBy.defaultExpectation = &ReportRepositoryMockListReportsByExpectation{}
+	}
+
+	if mmListReportsBy.defaultExpectation.params != nil {
+		mmListReportsBy.mock.t.Fatalf("ReportRepositoryMock.ListReportsBy mock is already set by Expect")
+	}
+
+	if mmListReportsBy.defaultExpectation.paramPtrs == nil {
+		mmListReportsBy.defaultExpectation.paramPtrs = &ReportRepositoryMockListReportsByParamPtrs{}
+	}
+	mmListReportsBy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListReportsBy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListReportsBy
+}
+
+// ExpectReporterIDParam2 sets up expected param reporterID for ReportRepository.ListReportsBy
+func (mmListReportsBy *mReportRepositoryMockListReportsBy) ExpectReporterIDParam2(reporterID string) *mReportRepositoryMockListReportsBy {
+	if mmListReportsBy.mock.funcListReportsBy != nil {
+		mmListReportsBy.mock.t.Fatalf("ReportRepositoryMock.ListReportsBy mock is already set by Set")
+	}
+
+	if mmListReportsBy.defaultExpectation == nil {
+		mmListReportsBy.defaultExpectation = &ReportRepositoryMockListReportsByExpectation{}
+	}
+
+	if mmListReportsBy.defaultExpectation.params != nil {
+		mmListReportsBy.mock.t.Fatalf("ReportRepositoryMock.ListReportsBy mock is already set by Expect")
+	}
+
+	if mmListReportsBy.defaultExpectation.paramPtrs == nil {
+		mmListReportsBy.defaultExpectation.paramPtrs = &ReportRepositoryMockListReportsByParamPtrs{}
+	}
+	mmListReportsBy.defaultExpectation.paramPtrs.reporterID = &reporterID
+	mmListReportsBy.defaultExpectation.expectationOrigins.originReporterID = minimock.CallerInfo(1)
+
+	return mmListReportsBy
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReportRepository.ListReportsBy
+func (mmListReportsBy *mReportRepositoryMockListReportsBy) Inspect(f func(ctx context.Context, reporterID string)) *mReportRepositoryMockListReportsBy {
+	if mmListReportsBy.mock.inspectFuncListReportsBy != nil {
+		mmListReportsBy.mock.t.Fatalf("Inspect function is already set for ReportRepositoryMock.ListReportsBy")
+	}
+
+	mmListReportsBy.mock.inspectFuncListReportsBy = f
+
+	return mmListReportsBy
+}
+
+// Return sets up results that will be returned by ReportRepository.ListReportsBy
+func (mmListReportsBy *mReportRepositoryMockListReportsBy) Return(ra1 []mm_repository.Report, err error) *ReportRepositoryMock {
+	if mmListReportsBy.mock.funcListReportsBy != nil {
+		mmListReportsBy.mock.t.Fatalf("ReportRepositoryMock.ListReportsBy mock is already set by Set")
+	}
+
+	if mmListReportsBy.defaultExpectation == nil {
+		mmListReportsBy.defaultExpectation = &ReportRepositoryMockListReportsByExpectation{mock: mmListReportsBy.mock}
+	}
+	mmListReportsBy.defaultExpectation.results = &ReportRepositoryMockListReportsByResults{ra1, err}
+	mmListReportsBy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListReportsBy.mock
+}
+
+// Set uses given function f to mock the ReportRepository.ListReportsBy method
+func (mmListReportsBy *mReportRepositoryMockListReportsBy) Set(f func(ctx context.Context, reporterID string) (ra1 []mm_repository.Report, err error)) *ReportRepositoryMock {
+	if mmListReportsBy.defaultExpectation != nil {
+		mmListReportsBy.mock.t.Fatalf("Default expectation is already set for the ReportRepository.ListReportsBy method")
+	}
+
+	if len(mmListReportsBy.expectations) > 0 {
+		mmListReportsBy.mock.t.Fatalf("Some expectations are already set for the ReportRepository.ListReportsBy method")
+	}
+
+	mmListReportsBy.mock.funcListReportsBy = f
+	mmListReportsBy.mock.funcListReportsByOrigin = minimock.CallerInfo(1)
+	return mmListReportsBy.mock
+}
+
+// When sets expectation for the ReportRepository.ListReportsBy which will trigger the result defined by the following
+// Then helper
+func (mmListReportsBy *mReportRepositoryMockListReportsBy) When(ctx context.Context, reporterID string) *ReportRepositoryMockListReportsByExpectation {
+	if mmListReportsBy.mock.funcListReportsBy != nil {
+		mmListReportsBy.mock.t.Fatalf("ReportRepositoryMock.ListReportsBy mock is already set by Set")
+	}
+
+	expectation := &ReportRepositoryMockListReportsByExpectation{
+		mock:               mmListReportsBy.mock,
+		params:             &ReportRepositoryMockListReportsByParams{ctx, reporterID},
+		expectationOrigins: ReportRepositoryMockListReportsByExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListReportsBy.expectations = append(mmListReportsBy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReportRepository.ListReportsBy return parameters for the expectation previously defined by the When method
+func (e *ReportRepositoryMockListReportsByExpectation) Then(ra1 []mm_repository.Report, err error) *ReportRepositoryMock {
+	e.results = &ReportRepositoryMockListReportsByResults{ra1, err}
+	return e.mock
+}
+
+// Times sets number of times ReportRepository.ListReportsBy should be invoked
+func (mmListReportsBy *mReportRepositoryMockListReportsBy) Times(n uint64) *mReportRepositoryMockListReportsBy {
+	if n == 0 {
+		mmListReportsBy.mock.t.Fatalf("Times of ReportRepositoryMock.ListReportsBy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListReportsBy.expectedInvocations, n)
+	mmListReportsBy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListReportsBy
+}
+
+func (mmListReportsBy *mReportRepositoryMockListReportsBy) invocationsDone() bool {
+	if len(mmListReportsBy.expectations) == 0 && mmListReportsBy.defaultExpectation == nil && mmListReportsBy.mock.funcListReportsBy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListReportsBy.mock.afterListReportsByCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListReportsBy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListReportsBy implements mm_repository.ReportRepository
+func (mmListReportsBy *ReportRepositoryMock) ListReportsBy(ctx context.Context, reporterID string) (ra1 []mm_repository.Report, err error) {
+	mm_atomic.AddUint64(&mmListReportsBy.beforeListReportsByCounter, 1)
+	defer mm_atomic.AddUint64(&mmListReportsBy.afterListReportsByCounter, 1)
+
+	mmListReportsBy.t.Helper()
+
+	if mmListReportsBy.inspectFuncListReportsBy != nil {
+		mmListReportsBy.inspectFuncListReportsBy(ctx, reporterID)
+	}
+
+	mm_params := ReportRepositoryMockListReportsByParams{ctx, reporterID}
+
+	// Record call args
+	mmListReportsBy.ListReportsByMock.mutex.Lock()
+	mmListReportsBy.ListReportsByMock.callArgs = append(mmListReportsBy.ListReportsByMock.callArgs, &mm_params)
+	mmListReportsBy.ListReportsByMock.mutex.Unlock()
+
+	for _, e := range mmListReportsBy.ListReportsByMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmListReportsBy.ListReportsByMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListReportsBy.ListReportsByMock.defaultExpectation.Counter, 1)
+		mm_want := mmListReportsBy.ListReportsByMock.defaultExpectation.params
+		mm_want_ptrs := mmListReportsBy.ListReportsByMock.defaultExpectation.paramPtrs
+
+		mm_got := ReportRepositoryMockListReportsByParams{ctx, reporterID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListReportsBy.t.Errorf("ReportRepositoryMock.ListReportsBy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReportsBy.ListReportsByMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.reporterID != nil && !minimock.Equal(*mm_want_ptrs.reporterID, mm_got.reporterID) {
+				mmListReportsBy.t.Errorf("ReportRepositoryMock.ListReportsBy got unexpected parameter reporterID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReportsBy.ListReportsByMock.defaultExpectation.expectationOrigins.originReporterID, *mm_want_ptrs.reporterID, mm_got.reporterID, minimock.Diff(*mm_want_ptrs.reporterID, mm_got.reporterID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListReportsBy.t.Errorf("ReportRepositoryMock.ListReportsBy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListReportsBy.ListReportsByMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListReportsBy.ListReportsByMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListReportsBy.t.Fatal("No results are set for the ReportRepositoryMock.ListReportsBy")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmListReportsBy.funcListReportsBy != nil {
+		return mmListReportsBy.funcListReportsBy(ctx, reporterID)
+	}
+	mmListReportsBy.t.Fatalf("Unexpected call to ReportRepositoryMock.ListReportsBy. %v %v", ctx, reporterID)
+	return
+}
+
+// ListReportsByAfterCounter returns a count of finished ReportRepositoryMock.ListReportsBy invocations
+func (mmListReportsBy *ReportRepositoryMock) ListReportsByAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListReportsBy.afterListReportsByCounter)
+}
+
+// ListReportsByBeforeCounter returns a count of ReportRepositoryMock.ListReportsBy invocations
+func (mmListReportsBy *ReportRepositoryMock) ListReportsByBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListReportsBy.beforeListReportsByCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReportRepositoryMock.ListReportsBy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListReportsBy *mReportRepositoryMockListReportsBy) Calls() []*ReportRepositoryMockListReportsByParams {
+	mmListReportsBy.mutex.RLock()
+
+	argCopy := make([]*ReportRepositoryMockListReportsByParams, len(mmListReportsBy.callArgs))
+	copy(argCopy, mmListReportsBy.callArgs)
+
+	mmListReportsBy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListReportsByDone returns true if the count of the ListReportsBy invocations corresponds
+// the number of defined expectations
+func (m *ReportRepositoryMock) MinimockListReportsByDone() bool {
+	if m.ListReportsByMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListReportsByMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListReportsByMock.invocationsDone()
+}
+
+// MinimockListReportsByInspect logs each unmet expectation
+func (m *ReportRepositoryMock) MinimockListReportsByInspect() {
+	for _, e := range m.ListReportsByMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReportRepositoryMock.ListReportsBy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListReportsByCounter := mm_atomic.LoadUint64(&m.afterListReportsByCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListReportsByMock.defaultExpectation != nil && afterListReportsByCounter < 1 {
+		if m.ListReportsByMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReportRepositoryMock.ListReportsBy at\n%s", m.ListReportsByMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReportRepositoryMock.ListReportsBy at\n%s with params: %#v", m.ListReportsByMock.defaultExpectation.expectationOrigins.origin, *m.ListReportsByMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListReportsBy != nil && afterListReportsByCounter < 1 {
+		m.t.Errorf("Expected call to ReportRepositoryMock.ListReportsBy at\n%s", m.funcListReportsByOrigin)
+	}
+
+	if !m.ListReportsByMock.invocationsDone() && afterListReportsByCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReportRepositoryMock.ListReportsBy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListReportsByMock.expectedInvocations), m.ListReportsByMock.expectedInvocationsOrigin, afterListReportsByCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ReportRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddReportInspect()
+
+			m.MinimockCountReportsInspect()
+
+			m.MinimockDeleteUserReportsInspect()
+
+			m.MinimockListReportsByInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ReportRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ReportRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddReportDone() &&
+		m.MinimockCountReportsDone() &&
+		m.MinimockDeleteUserReportsDone() &&
+		m.MinimockListReportsByDone()
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
@@ -26,16 +27,79 @@ type ReviewRepositoryMock struct {
 	beforeCreateReviewCounter uint64
 	CreateReviewMock          mReviewRepositoryMockCreateReview
 
-	funcGetReviews          func(ctx context.Context, ID string) (ra1 []mm_repository.Review, err error)
+	funcDeleteReview          func(ctx context.Context, userID string, movieID string) (err error)
+	funcDeleteReviewOrigin    string
+	inspectFuncDeleteReview   func(ctx context.Context, userID string, movieID string)
+	afterDeleteReviewCounter  uint64
+	beforeDeleteReviewCounter uint64
+	DeleteReviewMock          mReviewRepositoryMockDeleteReview
+
+	funcGetDeletedReview          func(ctx context.Context, userID string, movieID string) (r1 mm_repository.Review, err error)
+	funcGetDeletedReviewOrigin    string
+	inspectFuncGetDeletedReview   func(ctx context.Context, userID string, movieID string)
+	afterGetDeletedReviewCounter  uint64
+	beforeGetDeletedReviewCounter uint64
+	GetDeletedReviewMock          mReviewRepositoryMockGetDeletedReview
+
+	funcGetReview          func(ctx context.Context, userID string, movieID string) (r1 mm_repository.Review, err error)
+	funcGetReviewOrigin    string
+	inspectFuncGetReview   func(ctx context.Context, userID string, movieID string)
+	afterGetReviewCounter  uint64
+	beforeGetReviewCounter uint64
+	GetReviewMock          mReviewRepositoryMockGetReview
+
+	funcGetReviews          func(ctx context.Context, ID string, statuses []mm_repository.ReviewStatus, sort mm_repository.ReviewSort, after *mm_repository.ReviewCursor, limit int) (ra1 []mm_repository.Review, err error)
 	funcGetReviewsOrigin    string
-	inspectFuncGetReviews   func(ctx context.Context, ID string)
+	inspectFuncGetReviews   func(ctx context.Context, ID string, statuses []mm_repository.ReviewStatus, sort mm_repository.ReviewSort, after *mm_repository.ReviewCursor, limit int)
 	afterGetReviewsCounter  uint64
 	beforeGetReviewsCounter uint64
 	GetReviewsMock          mReviewRepositoryMockGetReviews
 
-	funcUpdateReview          func(ctx context.Context, review mm_repository.Review) (err error)
+	funcIncrementVotes          func(ctx context.Context, userID string, movieID string, likes int64, dislikes int64) (err error)
+	funcIncrementVotesOrigin    string
+	inspectFuncIncrementVotes   func(ctx context.Context, userID string, movieID string, likes int64, dislikes int64)
+	afterIncrementVotesCounter  uint64
+	beforeIncrementVotesCounter uint64
+	IncrementVotesMock          mReviewRepositoryMockIncrementVotes
+
+	funcListAllReviews          func(ctx context.Context, ID string) (ra1 []mm_repository.Review, err error)
+	funcListAllReviewsOrigin    string
+	inspectFuncListAllReviews   func(ctx context.Context, ID string)
+	afterListAllReviewsCounter  uint64
+	beforeListAllReviewsCounter uint64
+	ListAllReviewsMock          mReviewRepositoryMockListAllReviews
+
+	funcPurgeReview          func(ctx context.Context, userID string, movieID string, before time.Time) (err error)
+	funcPurgeReviewOrigin    string
+	inspectFuncPurgeReview   func(ctx context.Context, userID string, movieID string, before time.Time)
+	afterPurgeReviewCounter  uint64
+	beforePurgeReviewCounter uint64
+	PurgeReviewMock          mReviewRepositoryMockPurgeReview
+
+	funcRestoreReview          func(ctx context.Context, userID string, movieID string) (err error)
+	funcRestoreReviewOrigin    string
+	inspectFuncRestoreReview   func(ctx context.Context, userID string, movieID string)
+	afterRestoreReviewCounter  uint64
+	beforeRestoreReviewCounter uint64
+	RestoreReviewMock          mReviewRepositoryMockRestoreReview
+
+	funcSetStatus          func(ctx context.Context, userID string, movieID string, status mm_repository.ReviewStatus, reason string, moderatedAt time.Time) (err error)
+	funcSetStatusOrigin    string
+	inspectFuncSetStatus   func(ctx context.Context, userID string, movieID string, status mm_repository.ReviewStatus, reason string, moderatedAt time.Time)
+	afterSetStatusCounter  uint64
+	beforeSetStatusCounter uint64
+	SetStatusMock          mReviewRepositoryMockSetStatus
+
+	funcSoftDeleteReview          func(ctx context.Context, userID string, movieID string, deletedAt time.Time) (err error)
+	funcSoftDeleteReviewOrigin    string
+	inspectFuncSoftDeleteReview   func(ctx context.Context, userID string, movieID string, deletedAt time.Time)
+	afterSoftDeleteReviewCounter  uint64
+	beforeSoftDeleteReviewCounter uint64
+	SoftDeleteReviewMock          mReviewRepositoryMockSoftDeleteReview
+
+	funcUpdateReview          func(ctx context.Context, review mm_repository.Review, fields ...mm_repository.ReviewField) (err error)
 	funcUpdateReviewOrigin    string
-	inspectFuncUpdateReview   func(ctx context.Context, review mm_repository.Review)
+	inspectFuncUpdateReview   func(ctx context.Context, review mm_repository.Review, fields ...mm_repository.ReviewField)
 	afterUpdateReviewCounter  uint64
 	beforeUpdateReviewCounter uint64
 	UpdateReviewMock          mReviewRepositoryMockUpdateReview
@@ -52,9 +116,36 @@ func NewReviewRepositoryMock(t minimock.Tester) *ReviewRepositoryMock {
 	m.CreateReviewMock = mReviewRepositoryMockCreateReview{mock: m}
 	m.CreateReviewMock.callArgs = []*ReviewRepositoryMockCreateReviewParams{}
 
+	m.DeleteReviewMock = mReviewRepositoryMockDeleteReview{mock: m}
+	m.DeleteReviewMock.callArgs = []*ReviewRepositoryMockDeleteReviewParams{}
+
+	m.GetDeletedReviewMock = mReviewRepositoryMockGetDeletedReview{mock: m}
+	m.GetDeletedReviewMock.callArgs = []*ReviewRepositoryMockGetDeletedReviewParams{}
+
+	m.GetReviewMock = mReviewRepositoryMockGetReview{mock: m}
+	m.GetReviewMock.callArgs = []*ReviewRepositoryMockGetReviewParams{}
+
 	m.GetReviewsMock = mReviewRepositoryMockGetReviews{mock: m}
 	m.GetReviewsMock.callArgs = []*ReviewRepositoryMockGetReviewsParams{}
 
+	m.IncrementVotesMock = mReviewRepositoryMockIncrementVotes{mock: m}
+	m.IncrementVotesMock.callArgs = []*ReviewRepositoryMockIncrementVotesParams{}
+
+	m.ListAllReviewsMock = mReviewRepositoryMockListAllReviews{mock: m}
+	m.ListAllReviewsMock.callArgs = []*ReviewRepositoryMockListAllReviewsParams{}
+
+	m.PurgeReviewMock = mReviewRepositoryMockPurgeReview{mock: m}
+	m.PurgeReviewMock.callArgs = []*ReviewRepositoryMockPurgeReviewParams{}
+
+	m.RestoreReviewMock = mReviewRepositoryMockRestoreReview{mock: m}
+	m.RestoreReviewMock.callArgs = []*ReviewRepositoryMockRestoreReviewParams{}
+
+	m.SetStatusMock = mReviewRepositoryMockSetStatus{mock: m}
+	m.SetStatusMock.callArgs = []*ReviewRepositoryMockSetStatusParams{}
+
+	m.SoftDeleteReviewMock = mReviewRepositoryMockSoftDeleteReview{mock: m}
+	m.SoftDeleteReviewMock.callArgs = []*ReviewRepositoryMockSoftDeleteReviewParams{}
+
 	m.UpdateReviewMock = mReviewRepositoryMockUpdateReview{mock: m}
 	m.UpdateReviewMock.callArgs = []*ReviewRepositoryMockUpdateReviewParams{}
 
@@ -405,53 +496,55 @@ func (m *ReviewRepositoryMock) MinimockCreateReviewInspect() {
 	}
 }
 
-type mReviewRepositoryMockGetReviews struct {
+type mReviewRepositoryMockDeleteReview struct {
 	optional           bool
 	mock               *ReviewRepositoryMock
-	defaultExpectation *ReviewRepositoryMockGetReviewsExpectation
-	expectations       []*ReviewRepositoryMockGetReviewsExpectation
+	defaultExpectation *ReviewRepositoryMockDeleteReviewExpectation
+	expectations       []*ReviewRepositoryMockDeleteReviewExpectation
 
-	callArgs []*ReviewRepositoryMockGetReviewsParams
+	callArgs []*ReviewRepositoryMockDeleteReviewParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ReviewRepositoryMockGetReviewsExpectation specifies expectation struct of the ReviewRepository.GetReviews
-type ReviewRepositoryMockGetReviewsExpectation struct {
+// ReviewRepositoryMockDeleteReviewExpectation specifies expectation struct of the ReviewRepository.DeleteReview
+type ReviewRepositoryMockDeleteReviewExpectation struct {
 	mock               *ReviewRepositoryMock
-	params             *ReviewRepositoryMockGetReviewsParams
-	paramPtrs          *ReviewRepositoryMockGetReviewsParamPtrs
-	expectationOrigins ReviewRepositoryMockGetReviewsExpectationOrigins
-	results            *ReviewRepositoryMockGetReviewsResults
+	params             *ReviewRepositoryMockDeleteReviewParams
+	paramPtrs          *ReviewRepositoryMockDeleteReviewParamPtrs
+	expectationOrigins ReviewRepositoryMockDeleteReviewExpectationOrigins
+	results            *ReviewRepositoryMockDeleteReviewResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ReviewRepositoryMockGetReviewsParams contains parameters of the ReviewRepository.GetReviews
-type ReviewRepositoryMockGetReviewsParams struct {
-	ctx context.Context
-	ID  string
+// ReviewRepositoryMockDeleteReviewParams contains parameters of the ReviewRepository.DeleteReview
+type ReviewRepositoryMockDeleteReviewParams struct {
+	ctx     context.Context
+	userID  string
+	movieID string
 }
 
-// ReviewRepositoryMockGetReviewsParamPtrs contains pointers to parameters of the ReviewRepository.GetReviews
-type ReviewRepositoryMockGetReviewsParamPtrs struct {
-	ctx *context.Context
-	ID  *string
+// ReviewRepositoryMockDeleteReviewParamPtrs contains pointers to parameters of the ReviewRepository.DeleteReview
+type ReviewRepositoryMockDeleteReviewParamPtrs struct {
+	ctx     *context.Context
+	userID  *string
+	movieID *string
 }
 
-// ReviewRepositoryMockGetReviewsResults contains results of the ReviewRepository.GetReviews
-type ReviewRepositoryMockGetReviewsResults struct {
-	ra1 []mm_repository.Review
+// ReviewRepositoryMockDeleteReviewResults contains results of the ReviewRepository.DeleteReview
+type ReviewRepositoryMockDeleteReviewResults struct {
 	err error
 }
 
-// ReviewRepositoryMockGetReviewsOrigins contains origins of expectations of the ReviewRepository.GetReviews
-type ReviewRepositoryMockGetReviewsExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originID  string
+// ReviewRepositoryMockDeleteReviewOrigins contains origins of expectations of the ReviewRepository.DeleteReview
+type ReviewRepositoryMockDeleteReviewExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originMovieID string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -459,341 +552,373 @@ type ReviewRepositoryMockGetReviewsExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetReviews *mReviewRepositoryMockGetReviews) Optional() *mReviewRepositoryMockGetReviews {
-	mmGetReviews.optional = true
-	return mmGetReviews
+func (mmDeleteReview *mReviewRepositoryMockDeleteReview) Optional() *mReviewRepositoryMockDeleteReview {
+	mmDeleteReview.optional = true
+	return mmDeleteReview
 }
 
-// Expect sets up expected params for ReviewRepository.GetReviews
-func (mmGetReviews *mReviewRepositoryMockGetReviews) Expect(ctx context.Context, ID string) *mReviewRepositoryMockGetReviews {
-	if mmGetReviews.mock.funcGetReviews != nil {
-		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by Set")
+// Expect sets up expected params for ReviewRepository.DeleteReview
+func (mmDeleteReview *mReviewRepositoryMockDeleteReview) Expect(ctx context.Context, userID string, movieID string) *mReviewRepositoryMockDeleteReview {
+	if mmDeleteReview.mock.funcDeleteReview != nil {
+		mmDeleteReview.mock.t.Fatalf("ReviewRepositoryMock.DeleteReview mock is already set by Set")
 	}
 
-	if mmGetReviews.defaultExpectation == nil {
-		mmGetReviews.defaultExpectation = &ReviewRepositoryMockGetReviewsExpectation{}
+	if mmDeleteReview.defaultExpectation == nil {
+		mmDeleteReview.defaultExpectation = &ReviewRepositoryMockDeleteReviewExpectation{}
 	}
 
-	if mmGetReviews.defaultExpectation.paramPtrs != nil {
-		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by ExpectParams functions")
+	if mmDeleteReview.defaultExpectation.paramPtrs != nil {
+		mmDeleteReview.mock.t.Fatalf("ReviewRepositoryMock.DeleteReview mock is already set by ExpectParams functions")
 	}
 
-	mmGetReviews.defaultExpectation.params = &ReviewRepositoryMockGetReviewsParams{ctx, ID}
-	mmGetReviews.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetReviews.expectations {
-		if minimock.Equal(e.params, mmGetReviews.defaultExpectation.params) {
-			mmGetReviews.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetReviews.defaultExpectation.params)
+	mmDeleteReview.defaultExpectation.params = &ReviewRepositoryMockDeleteReviewParams{ctx, userID, movieID}
+	mmDeleteReview.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteReview.expectations {
+		if minimock.Equal(e.params, mmDeleteReview.defaultExpectation.params) {
+			mmDeleteReview.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteReview.defaultExpectation.params)
 		}
 	}
 
-	return mmGetReviews
+	return mmDeleteReview
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ReviewRepository.GetReviews
-func (mmGetReviews *mReviewRepositoryMockGetReviews) ExpectCtxParam1(ctx context.Context) *mReviewRepositoryMockGetReviews {
-	if mmGetReviews.mock.funcGetReviews != nil {
-		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ReviewRepository.DeleteReview
+func (mmDeleteReview *mReviewRepositoryMockDeleteReview) ExpectCtxParam1(ctx context.Context) *mReviewRepositoryMockDeleteReview {
+	if mmDeleteReview.mock.funcDeleteReview != nil {
+		mmDeleteReview.mock.t.Fatalf("ReviewRepositoryMock.DeleteReview mock is already set by Set")
 	}
 
-	if mmGetReviews.defaultExpectation == nil {
-		mmGetReviews.defaultExpectation = &ReviewRepositoryMockGetReviewsExpectation{}
+	if mmDeleteReview.defaultExpectation == nil {
+		mmDeleteReview.defaultExpectation = &ReviewRepositoryMockDeleteReviewExpectation{}
 	}
 
-	if mmGetReviews.defaultExpectation.params != nil {
-		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by Expect")
+	if mmDeleteReview.defaultExpectation.params != nil {
+		mmDeleteReview.mock.t.Fatalf("ReviewRepositoryMock.DeleteReview mock is already set by Expect")
 	}
 
-	if mmGetReviews.defaultExpectation.paramPtrs == nil {
-		mmGetReviews.defaultExpectation.paramPtrs = &ReviewRepositoryMockGetReviewsParamPtrs{}
+	if mmDeleteReview.defaultExpectation.paramPtrs == nil {
+		mmDeleteReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockDeleteReviewParamPtrs{}
 	}
-	mmGetReviews.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetReviews.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmDeleteReview.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteReview.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetReviews
+	return mmDeleteReview
 }
 
-// ExpectIDParam2 sets up expected param ID for ReviewRepository.GetReviews
-func (mmGetReviews *mReviewRepositoryMockGetReviews) ExpectIDParam2(ID string) *mReviewRepositoryMockGetReviews {
-	if mmGetReviews.mock.funcGetReviews != nil {
-		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for ReviewRepository.DeleteReview
+func (mmDeleteReview *mReviewRepositoryMockDeleteReview) ExpectUserIDParam2(userID string) *mReviewRepositoryMockDeleteReview {
+	if mmDeleteReview.mock.funcDeleteReview != nil {
+		mmDeleteReview.mock.t.Fatalf("ReviewRepositoryMock.DeleteReview mock is already set by Set")
 	}
 
-	if mmGetReviews.defaultExpectation == nil {
-		mmGetReviews.defaultExpectation = &ReviewRepositoryMockGetReviewsExpectation{}
+	if mmDeleteReview.defaultExpectation == nil {
+		mmDeleteReview.defaultExpectation = &ReviewRepositoryMockDeleteReviewExpectation{}
 	}
 
-	if mmGetReviews.defaultExpectation.params != nil {
-		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by Expect")
+	if mmDeleteReview.defaultExpectation.params != nil {
+		mmDeleteReview.mock.t.Fatalf("ReviewRepositoryMock.DeleteReview mock is already set by Expect")
 	}
 
-	if mmGetReviews.defaultExpectation.paramPtrs == nil {
-		mmGetReviews.defaultExpectation.paramPtrs = &ReviewRepositoryMockGetReviewsParamPtrs{}
+	if mmDeleteReview.defaultExpectation.paramPtrs == nil {
+		mmDeleteReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockDeleteReviewParamPtrs{}
 	}
-	mmGetReviews.defaultExpectation.paramPtrs.ID = &ID
-	mmGetReviews.defaultExpectation.expectationOrigins.originID = minimock.CallerInfo(1)
+	mmDeleteReview.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteReview.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmGetReviews
+	return mmDeleteReview
 }
 
-// Inspect accepts an inspector function that has same arguments as the ReviewRepository.GetReviews
-func (mmGetReviews *mReviewRepositoryMockGetReviews) Inspect(f func(ctx context.Context, ID string)) *mReviewRepositoryMockGetReviews {
-	if mmGetReviews.mock.inspectFuncGetReviews != nil {
-		mmGetReviews.mock.t.Fatalf("Inspect function is already set for ReviewRepositoryMock.GetReviews")
+// ExpectMovieIDParam3 sets up expected param movieID for ReviewRepository.DeleteReview
+func (mmDeleteReview *mReviewRepositoryMockDeleteReview) ExpectMovieIDParam3(movieID string) *mReviewRepositoryMockDeleteReview {
+	if mmDeleteReview.mock.funcDeleteReview != nil {
+		mmDeleteReview.mock.t.Fatalf("ReviewRepositoryMock.DeleteReview mock is already set by Set")
 	}
 
-	mmGetReviews.mock.inspectFuncGetReviews = f
+	if mmDeleteReview.defaultExpectation == nil {
+		mmDeleteReview.defaultExpectation = &ReviewRepositoryMockDeleteReviewExpectation{}
+	}
 
-	return mmGetReviews
+	if mmDeleteReview.defaultExpectation.params != nil {
+		mmDeleteReview.mock.t.Fatalf("ReviewRepositoryMock.DeleteReview mock is already set by Expect")
+	}
+
+	if mmDeleteReview.defaultExpectation.paramPtrs == nil {
+		mmDeleteReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockDeleteReviewParamPtrs{}
+	}
+	mmDeleteReview.defaultExpectation.paramPtrs.movieID = &movieID
+	mmDeleteReview.defaultExpectation.expectationOrigins.originMovieID = minimock.CallerInfo(1)
+
+	return mmDeleteReview
 }
 
-// Return sets up results that will be returned by ReviewRepository.GetReviews
-func (mmGetReviews *mReviewRepositoryMockGetReviews) Return(ra1 []mm_repository.Review, err error) *ReviewRepositoryMock {
-	if mmGetReviews.mock.funcGetReviews != nil {
-		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the ReviewRepository.DeleteReview
+func (mmDeleteReview *mReviewRepositoryMockDeleteReview) Inspect(f func(ctx context.Context, userID string, movieID string)) *mReviewRepositoryMockDeleteReview {
+	if mmDeleteReview.mock.inspectFuncDeleteReview != nil {
+		mmDeleteReview.mock.t.Fatalf("Inspect function is already set for ReviewRepositoryMock.DeleteReview")
 	}
 
-	if mmGetReviews.defaultExpectation == nil {
-		mmGetReviews.defaultExpectation = &ReviewRepositoryMockGetReviewsExpectation{mock: mmGetReviews.mock}
+	mmDeleteReview.mock.inspectFuncDeleteReview = f
+
+	return mmDeleteReview
+}
+
+// Return sets up results that will be returned by ReviewRepository.DeleteReview
+func (mmDeleteReview *mReviewRepositoryMockDeleteReview) Return(err error) *ReviewRepositoryMock {
+	if mmDeleteReview.mock.funcDeleteReview != nil {
+		mmDeleteReview.mock.t.Fatalf("ReviewRepositoryMock.DeleteReview mock is already set by Set")
 	}
-	mmGetReviews.defaultExpectation.results = &ReviewRepositoryMockGetReviewsResults{ra1, err}
-	mmGetReviews.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetReviews.mock
+
+	if mmDeleteReview.defaultExpectation == nil {
+		mmDeleteReview.defaultExpectation = &ReviewRepositoryMockDeleteReviewExpectation{mock: mmDeleteReview.mock}
+	}
+	mmDeleteReview.defaultExpectation.results = &ReviewRepositoryMockDeleteReviewResults{err}
+	mmDeleteReview.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteReview.mock
 }
 
-// Set uses given function f to mock the ReviewRepository.GetReviews method
-func (mmGetReviews *mReviewRepositoryMockGetReviews) Set(f func(ctx context.Context, ID string) (ra1 []mm_repository.Review, err error)) *ReviewRepositoryMock {
-	if mmGetReviews.defaultExpectation != nil {
-		mmGetReviews.mock.t.Fatalf("Default expectation is already set for the ReviewRepository.GetReviews method")
+// Set uses given function f to mock the ReviewRepository.DeleteReview method
+func (mmDeleteReview *mReviewRepositoryMockDeleteReview) Set(f func(ctx context.Context, userID string, movieID string) (err error)) *ReviewRepositoryMock {
+	if mmDeleteReview.defaultExpectation != nil {
+		mmDeleteReview.mock.t.Fatalf("Default expectation is already set for the ReviewRepository.DeleteReview method")
 	}
 
-	if len(mmGetReviews.expectations) > 0 {
-		mmGetReviews.mock.t.Fatalf("Some expectations are already set for the ReviewRepository.GetReviews method")
+	if len(mmDeleteReview.expectations) > 0 {
+		mmDeleteReview.mock.t.Fatalf("Some expectations are already set for the ReviewRepository.DeleteReview method")
 	}
 
-	mmGetReviews.mock.funcGetReviews = f
-	mmGetReviews.mock.funcGetReviewsOrigin = minimock.CallerInfo(1)
-	return mmGetReviews.mock
+	mmDeleteReview.mock.funcDeleteReview = f
+	mmDeleteReview.mock.funcDeleteReviewOrigin = minimock.CallerInfo(1)
+	return mmDeleteReview.mock
 }
 
-// When sets expectation for the ReviewRepository.GetReviews which will trigger the result defined by the following
+// When sets expectation for the ReviewRepository.DeleteReview which will trigger the result defined by the following
 // Then helper
-func (mmGetReviews *mReviewRepositoryMockGetReviews) When(ctx context.Context, ID string) *ReviewRepositoryMockGetReviewsExpectation {
-	if mmGetReviews.mock.funcGetReviews != nil {
-		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by Set")
+func (mmDeleteReview *mReviewRepositoryMockDeleteReview) When(ctx context.Context, userID string, movieID string) *ReviewRepositoryMockDeleteReviewExpectation {
+	if mmDeleteReview.mock.funcDeleteReview != nil {
+		mmDeleteReview.mock.t.Fatalf("ReviewRepositoryMock.DeleteReview mock is already set by Set")
 	}
 
-	expectation := &ReviewRepositoryMockGetReviewsExpectation{
-		mock:               mmGetReviews.mock,
-		params:             &ReviewRepositoryMockGetReviewsParams{ctx, ID},
-		expectationOrigins: ReviewRepositoryMockGetReviewsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ReviewRepositoryMockDeleteReviewExpectation{
+		mock:               mmDeleteReview.mock,
+		params:             &ReviewRepositoryMockDeleteReviewParams{ctx, userID, movieID},
+		expectationOrigins: ReviewRepositoryMockDeleteReviewExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetReviews.expectations = append(mmGetReviews.expectations, expectation)
+	mmDeleteReview.expectations = append(mmDeleteReview.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ReviewRepository.GetReviews return parameters for the expectation previously defined by the When method
-func (e *ReviewRepositoryMockGetReviewsExpectation) Then(ra1 []mm_repository.Review, err error) *ReviewRepositoryMock {
-	e.results = &ReviewRepositoryMockGetReviewsResults{ra1, err}
+// Then sets up ReviewRepository.DeleteReview return parameters for the expectation previously defined by the When method
+func (e *ReviewRepositoryMockDeleteReviewExpectation) Then(err error) *ReviewRepositoryMock {
+	e.results = &ReviewRepositoryMockDeleteReviewResults{err}
 	return e.mock
 }
 
-// Times sets number of times ReviewRepository.GetReviews should be invoked
-func (mmGetReviews *mReviewRepositoryMockGetReviews) Times(n uint64) *mReviewRepositoryMockGetReviews {
+// Times sets number of times ReviewRepository.DeleteReview should be invoked
+func (mmDeleteReview *mReviewRepositoryMockDeleteReview) Times(n uint64) *mReviewRepositoryMockDeleteReview {
 	if n == 0 {
-		mmGetReviews.mock.t.Fatalf("Times of ReviewRepositoryMock.GetReviews mock can not be zero")
+		mmDeleteReview.mock.t.Fatalf("Times of ReviewRepositoryMock.DeleteReview mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetReviews.expectedInvocations, n)
-	mmGetReviews.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetReviews
+	mm_atomic.StoreUint64(&mmDeleteReview.expectedInvocations, n)
+	mmDeleteReview.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteReview
 }
 
-func (mmGetReviews *mReviewRepositoryMockGetReviews) invocationsDone() bool {
-	if len(mmGetReviews.expectations) == 0 && mmGetReviews.defaultExpectation == nil && mmGetReviews.mock.funcGetReviews == nil {
+func (mmDeleteReview *mReviewRepositoryMockDeleteReview) invocationsDone() bool {
+	if len(mmDeleteReview.expectations) == 0 && mmDeleteReview.defaultExpectation == nil && mmDeleteReview.mock.funcDeleteReview == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetReviews.mock.afterGetReviewsCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetReviews.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteReview.mock.afterDeleteReviewCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteReview.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetReviews implements mm_repository.ReviewRepository
-func (mmGetReviews *ReviewRepositoryMock) GetReviews(ctx context.Context, ID string) (ra1 []mm_repository.Review, err error) {
-	mm_atomic.AddUint64(&mmGetReviews.beforeGetReviewsCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetReviews.afterGetReviewsCounter, 1)
+// DeleteReview implements mm_repository.ReviewRepository
+func (mmDeleteReview *ReviewRepositoryMock) DeleteReview(ctx context.Context, userID string, movieID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteReview.beforeDeleteReviewCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteReview.afterDeleteReviewCounter, 1)
 
-	mmGetReviews.t.Helper()
+	mmDeleteReview.t.Helper()
 
-	if mmGetReviews.inspectFuncGetReviews != nil {
-		mmGetReviews.inspectFuncGetReviews(ctx, ID)
+	if mmDeleteReview.inspectFuncDeleteReview != nil {
+		mmDeleteReview.inspectFuncDeleteReview(ctx, userID, movieID)
 	}
 
-	mm_params := ReviewRepositoryMockGetReviewsParams{ctx, ID}
+	mm_params := ReviewRepositoryMockDeleteReviewParams{ctx, userID, movieID}
 
 	// Record call args
-	mmGetReviews.GetReviewsMock.mutex.Lock()
-	mmGetReviews.GetReviewsMock.callArgs = append(mmGetReviews.GetReviewsMock.callArgs, &mm_params)
-	mmGetReviews.GetReviewsMock.mutex.Unlock()
+	mmDeleteReview.DeleteReviewMock.mutex.Lock()
+	mmDeleteReview.DeleteReviewMock.callArgs = append(mmDeleteReview.DeleteReviewMock.callArgs, &mm_params)
+	mmDeleteReview.DeleteReviewMock.mutex.Unlock()
 
-	for _, e := range mmGetReviews.GetReviewsMock.expectations {
+	for _, e := range mmDeleteReview.DeleteReviewMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ra1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetReviews.GetReviewsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetReviews.GetReviewsMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetReviews.GetReviewsMock.defaultExpectation.params
-		mm_want_ptrs := mmGetReviews.GetReviewsMock.defaultExpectation.paramPtrs
+	if mmDeleteReview.DeleteReviewMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteReview.DeleteReviewMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteReview.DeleteReviewMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteReview.DeleteReviewMock.defaultExpectation.paramPtrs
 
-		mm_got := ReviewRepositoryMockGetReviewsParams{ctx, ID}
+		mm_got := ReviewRepositoryMockDeleteReviewParams{ctx, userID, movieID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetReviews.t.Errorf("ReviewRepositoryMock.GetReviews got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetReviews.GetReviewsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmDeleteReview.t.Errorf("ReviewRepositoryMock.DeleteReview got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteReview.DeleteReviewMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.ID != nil && !minimock.Equal(*mm_want_ptrs.ID, mm_got.ID) {
-				mmGetReviews.t.Errorf("ReviewRepositoryMock.GetReviews got unexpected parameter ID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetReviews.GetReviewsMock.defaultExpectation.expectationOrigins.originID, *mm_want_ptrs.ID, mm_got.ID, minimock.Diff(*mm_want_ptrs.ID, mm_got.ID))
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteReview.t.Errorf("ReviewRepositoryMock.DeleteReview got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteReview.DeleteReviewMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.movieID != nil && !minimock.Equal(*mm_want_ptrs.movieID, mm_got.movieID) {
+				mmDeleteReview.t.Errorf("ReviewRepositoryMock.DeleteReview got unexpected parameter movieID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteReview.DeleteReviewMock.defaultExpectation.expectationOrigins.originMovieID, *mm_want_ptrs.movieID, mm_got.movieID, minimock.Diff(*mm_want_ptrs.movieID, mm_got.movieID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetReviews.t.Errorf("ReviewRepositoryMock.GetReviews got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetReviews.GetReviewsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmDeleteReview.t.Errorf("ReviewRepositoryMock.DeleteReview got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteReview.DeleteReviewMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetReviews.GetReviewsMock.defaultExpectation.results
+		mm_results := mmDeleteReview.DeleteReviewMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetReviews.t.Fatal("No results are set for the ReviewRepositoryMock.GetReviews")
+			mmDeleteReview.t.Fatal("No results are set for the ReviewRepositoryMock.DeleteReview")
 		}
-		return (*mm_results).ra1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetReviews.funcGetReviews != nil {
-		return mmGetReviews.funcGetReviews(ctx, ID)
+	if mmDeleteReview.funcDeleteReview != nil {
+		return mmDeleteReview.funcDeleteReview(ctx, userID, movieID)
 	}
-	mmGetReviews.t.Fatalf("Unexpected call to ReviewRepositoryMock.GetReviews. %v %v", ctx, ID)
+	mmDeleteReview.t.Fatalf("Unexpected call to ReviewRepositoryMock.DeleteReview. %v %v %v", ctx, userID, movieID)
 	return
 }
 
-// GetReviewsAfterCounter returns a count of finished ReviewRepositoryMock.GetReviews invocations
-func (mmGetReviews *ReviewRepositoryMock) GetReviewsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetReviews.afterGetReviewsCounter)
+// DeleteReviewAfterCounter returns a count of finished ReviewRepositoryMock.DeleteReview invocations
+func (mmDeleteReview *ReviewRepositoryMock) DeleteReviewAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteReview.afterDeleteReviewCounter)
 }
 
-// GetReviewsBeforeCounter returns a count of ReviewRepositoryMock.GetReviews invocations
-func (mmGetReviews *ReviewRepositoryMock) GetReviewsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetReviews.beforeGetReviewsCounter)
+// DeleteReviewBeforeCounter returns a count of ReviewRepositoryMock.DeleteReview invocations
+func (mmDeleteReview *ReviewRepositoryMock) DeleteReviewBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteReview.beforeDeleteReviewCounter)
 }
 
-// Calls returns a list of arguments used in each call to ReviewRepositoryMock.GetReviews.
+// Calls returns a list of arguments used in each call to ReviewRepositoryMock.DeleteReview.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetReviews *mReviewRepositoryMockGetReviews) Calls() []*ReviewRepositoryMockGetReviewsParams {
-	mmGetReviews.mutex.RLock()
+func (mmDeleteReview *mReviewRepositoryMockDeleteReview) Calls() []*ReviewRepositoryMockDeleteReviewParams {
+	mmDeleteReview.mutex.RLock()
 
-	argCopy := make([]*ReviewRepositoryMockGetReviewsParams, len(mmGetReviews.callArgs))
-	copy(argCopy, mmGetReviews.callArgs)
+	argCopy := make([]*ReviewRepositoryMockDeleteReviewParams, len(mmDeleteReview.callArgs))
+	copy(argCopy, mmDeleteReview.callArgs)
 
-	mmGetReviews.mutex.RUnlock()
+	mmDeleteReview.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetReviewsDone returns true if the count of the GetReviews invocations corresponds
+// MinimockDeleteReviewDone returns true if the count of the DeleteReview invocations corresponds
 // the number of defined expectations
-func (m *ReviewRepositoryMock) MinimockGetReviewsDone() bool {
-	if m.GetReviewsMock.optional {
+func (m *ReviewRepositoryMock) MinimockDeleteReviewDone() bool {
+	if m.DeleteReviewMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetReviewsMock.expectations {
+	for _, e := range m.DeleteReviewMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetReviewsMock.invocationsDone()
+	return m.DeleteReviewMock.invocationsDone()
 }
 
-// MinimockGetReviewsInspect logs each unmet expectation
-func (m *ReviewRepositoryMock) MinimockGetReviewsInspect() {
-	for _, e := range m.GetReviewsMock.expectations {
+// MinimockDeleteReviewInspect logs each unmet expectation
+func (m *ReviewRepositoryMock) MinimockDeleteReviewInspect() {
+	for _, e := range m.DeleteReviewMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ReviewRepositoryMock.GetReviews at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ReviewRepositoryMock.DeleteReview at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetReviewsCounter := mm_atomic.LoadUint64(&m.afterGetReviewsCounter)
+	afterDeleteReviewCounter := mm_atomic.LoadUint64(&m.afterDeleteReviewCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetReviewsMock.defaultExpectation != nil && afterGetReviewsCounter < 1 {
-		if m.GetReviewsMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ReviewRepositoryMock.GetReviews at\n%s", m.GetReviewsMock.defaultExpectation.returnOrigin)
+	if m.DeleteReviewMock.defaultExpectation != nil && afterDeleteReviewCounter < 1 {
+		if m.DeleteReviewMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.DeleteReview at\n%s", m.DeleteReviewMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ReviewRepositoryMock.GetReviews at\n%s with params: %#v", m.GetReviewsMock.defaultExpectation.expectationOrigins.origin, *m.GetReviewsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ReviewRepositoryMock.DeleteReview at\n%s with params: %#v", m.DeleteReviewMock.defaultExpectation.expectationOrigins.origin, *m.DeleteReviewMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetReviews != nil && afterGetReviewsCounter < 1 {
-		m.t.Errorf("Expected call to ReviewRepositoryMock.GetReviews at\n%s", m.funcGetReviewsOrigin)
+	if m.funcDeleteReview != nil && afterDeleteReviewCounter < 1 {
+		m.t.Errorf("Expected call to ReviewRepositoryMock.DeleteReview at\n%s", m.funcDeleteReviewOrigin)
 	}
 
-	if !m.GetReviewsMock.invocationsDone() && afterGetReviewsCounter > 0 {
-		m.t.Errorf("Expected %d calls to ReviewRepositoryMock.GetReviews at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetReviewsMock.expectedInvocations), m.GetReviewsMock.expectedInvocationsOrigin, afterGetReviewsCounter)
+	if !m.DeleteReviewMock.invocationsDone() && afterDeleteReviewCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReviewRepositoryMock.DeleteReview at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteReviewMock.expectedInvocations), m.DeleteReviewMock.expectedInvocationsOrigin, afterDeleteReviewCounter)
 	}
 }
 
-type mReviewRepositoryMockUpdateReview struct {
+type mReviewRepositoryMockGetDeletedReview struct {
 	optional           bool
 	mock               *ReviewRepositoryMock
-	defaultExpectation *ReviewRepositoryMockUpdateReviewExpectation
-	expectations       []*ReviewRepositoryMockUpdateReviewExpectation
+	defaultExpectation *ReviewRepositoryMockGetDeletedReviewExpectation
+	expectations       []*ReviewRepositoryMockGetDeletedReviewExpectation
 
-	callArgs []*ReviewRepositoryMockUpdateReviewParams
+	callArgs []*ReviewRepositoryMockGetDeletedReviewParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ReviewRepositoryMockUpdateReviewExpectation specifies expectation struct of the ReviewRepository.UpdateReview
-type ReviewRepositoryMockUpdateReviewExpectation struct {
+// ReviewRepositoryMockGetDeletedReviewExpectation specifies expectation struct of the ReviewRepository.GetDeletedReview
+type ReviewRepositoryMockGetDeletedReviewExpectation struct {
 	mock               *ReviewRepositoryMock
-	params             *ReviewRepositoryMockUpdateReviewParams
-	paramPtrs          *ReviewRepositoryMockUpdateReviewParamPtrs
-	expectationOrigins ReviewRepositoryMockUpdateReviewExpectationOrigins
-	results            *ReviewRepositoryMockUpdateReviewResults
+	params             *ReviewRepositoryMockGetDeletedReviewParams
+	paramPtrs          *ReviewRepositoryMockGetDeletedReviewParamPtrs
+	expectationOrigins ReviewRepositoryMockGetDeletedReviewExpectationOrigins
+	results            *ReviewRepositoryMockGetDeletedReviewResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ReviewRepositoryMockUpdateReviewParams contains parameters of the ReviewRepository.UpdateReview
-type ReviewRepositoryMockUpdateReviewParams struct {
-	ctx    context.Context
-	review mm_repository.Review
+// ReviewRepositoryMockGetDeletedReviewParams contains parameters of the ReviewRepository.GetDeletedReview
+type ReviewRepositoryMockGetDeletedReviewParams struct {
+	ctx     context.Context
+	userID  string
+	movieID string
 }
 
-// ReviewRepositoryMockUpdateReviewParamPtrs contains pointers to parameters of the ReviewRepository.UpdateReview
-type ReviewRepositoryMockUpdateReviewParamPtrs struct {
-	ctx    *context.Context
-	review *mm_repository.Review
+// ReviewRepositoryMockGetDeletedReviewParamPtrs contains pointers to parameters of the ReviewRepository.GetDeletedReview
+type ReviewRepositoryMockGetDeletedReviewParamPtrs struct {
+	ctx     *context.Context
+	userID  *string
+	movieID *string
 }
 
-// ReviewRepositoryMockUpdateReviewResults contains results of the ReviewRepository.UpdateReview
-type ReviewRepositoryMockUpdateReviewResults struct {
+// ReviewRepositoryMockGetDeletedReviewResults contains results of the ReviewRepository.GetDeletedReview
+type ReviewRepositoryMockGetDeletedReviewResults struct {
+	r1  mm_repository.Review
 	err error
 }
 
-// ReviewRepositoryMockUpdateReviewOrigins contains origins of expectations of the ReviewRepository.UpdateReview
-type ReviewRepositoryMockUpdateReviewExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originReview string
+// ReviewRepositoryMockGetDeletedReviewOrigins contains origins of expectations of the ReviewRepository.GetDeletedReview
+type ReviewRepositoryMockGetDeletedReviewExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originMovieID string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -801,85 +926,3747 @@ type ReviewRepositoryMockUpdateReviewExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmUpdateReview *mReviewRepositoryMockUpdateReview) Optional() *mReviewRepositoryMockUpdateReview {
-	mmUpdateReview.optional = true
-	return mmUpdateReview
+func (mmGetDeletedReview *mReviewRepositoryMockGetDeletedReview) Optional() *mReviewRepositoryMockGetDeletedReview {
+	mmGetDeletedReview.optional = true
+	return mmGetDeletedReview
 }
 
-// Expect sets up expected params for ReviewRepository.UpdateReview
-func (mmUpdateReview *mReviewRepositoryMockUpdateReview) Expect(ctx context.Context, review mm_repository.Review) *mReviewRepositoryMockUpdateReview {
-	if mmUpdateReview.mock.funcUpdateReview != nil {
-		mmUpdateReview.mock.t.Fatalf("ReviewRepositoryMock.UpdateReview mock is already set by Set")
+// Expect sets up expected params for ReviewRepository.GetDeletedReview
+func (mmGetDeletedReview *mReviewRepositoryMockGetDeletedReview) Expect(ctx context.Context, userID string, movieID string) *mReviewRepositoryMockGetDeletedReview {
+	if mmGetDeletedReview.mock.funcGetDeletedReview != nil {
+		mmGetDeletedReview.mock.t.Fatalf("ReviewRepositoryMock.GetDeletedReview mock is already set by Set")
 	}
 
-	if mmUpdateReview.defaultExpectation == nil {
-		mmUpdateReview.defaultExpectation = &ReviewRepositoryMockUpdateReviewExpectation{}
+	if mmGetDeletedReview.defaultExpectation == nil {
+		mmGetDeletedReview.defaultExpectation = &ReviewRepositoryMockGetDeletedReviewExpectation{}
 	}
 
-	if mmUpdateReview.defaultExpectation.paramPtrs != nil {
-		mmUpdateReview.mock.t.Fatalf("ReviewRepositoryMock.UpdateReview mock is already set by ExpectParams functions")
+	if mmGetDeletedReview.defaultExpectation.paramPtrs != nil {
+		mmGetDeletedReview.mock.t.Fatalf("ReviewRepositoryMock.GetDeletedReview mock is already set by ExpectParams functions")
 	}
 
-	mmUpdateReview.defaultExpectation.params = &ReviewRepositoryMockUpdateReviewParams{ctx, review}
-	mmUpdateReview.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmUpdateReview.expectations {
-		if minimock.Equal(e.params, mmUpdateReview.defaultExpectation.params) {
-			mmUpdateReview.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateReview.defaultExpectation.params)
+	mmGetDeletedReview.defaultExpectation.params = &ReviewRepositoryMockGetDeletedReviewParams{ctx, userID, movieID}
+	mmGetDeletedReview.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetDeletedReview.expectations {
+		if minimock.Equal(e.params, mmGetDeletedReview.defaultExpectation.params) {
+			mmGetDeletedReview.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetDeletedReview.defaultExpectation.params)
 		}
 	}
 
-	return mmUpdateReview
+	return mmGetDeletedReview
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ReviewRepository.UpdateReview
-func (mmUpdateReview *mReviewRepositoryMockUpdateReview) ExpectCtxParam1(ctx context.Context) *mReviewRepositoryMockUpdateReview {
-	if mmUpdateReview.mock.funcUpdateReview != nil {
-		mmUpdateReview.mock.t.Fatalf("ReviewRepositoryMock.UpdateReview mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ReviewRepository.GetDeletedReview
+func (mmGetDeletedReview *mReviewRepositoryMockGetDeletedReview) ExpectCtxParam1(ctx context.Context) *mReviewRepositoryMockGetDeletedReview {
+	if mmGetDeletedReview.mock.funcGetDeletedReview != nil {
+		mmGetDeletedReview.mock.t.Fatalf("ReviewRepositoryMock.GetDeletedReview mock is already set by Set")
 	}
 
-	if mmUpdateReview.defaultExpectation == nil {
-		mmUpdateReview.defaultExpectation = &ReviewRepositoryMockUpdateReviewExpectation{}
+	if mmGetDeletedReview.defaultExpectation == nil {
+		mmGetDeletedReview.defaultExpectation = &ReviewRepositoryMockGetDeletedReviewExpectation{}
 	}
 
-	if mmUpdateReview.defaultExpectation.params != nil {
-		mmUpdateReview.mock.t.Fatalf("ReviewRepositoryMock.UpdateReview mock is already set by Expect")
+	if mmGetDeletedReview.defaultExpectation.params != nil {
+		mmGetDeletedReview.mock.t.Fatalf("ReviewRepositoryMock.GetDeletedReview mock is already set by Expect")
 	}
 
-	if mmUpdateReview.defaultExpectation.paramPtrs == nil {
-		mmUpdateReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockUpdateReviewParamPtrs{}
+	if mmGetDeletedReview.defaultExpectation.paramPtrs == nil {
+		mmGetDeletedReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockGetDeletedReviewParamPtrs{}
 	}
-	mmUpdateReview.defaultExpectation.paramPtrs.ctx = &ctx
-	mmUpdateReview.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmGetDeletedReview.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetDeletedReview.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmUpdateReview
+	return mmGetDeletedReview
 }
 
-// ExpectReviewParam2 sets up expected param review for ReviewRepository.UpdateReview
-func (mmUpdateReview *mReviewRepositoryMockUpdateReview) ExpectReviewParam2(review mm_repository.Review) *mReviewRepositoryMockUpdateReview {
-	if mmUpdateReview.mock.funcUpdateReview != nil {
-		mmUpdateReview.mock.t.Fatalf("ReviewRepositoryMock.UpdateReview mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for ReviewRepository.GetDeletedReview
+func (mmGetDeletedReview *mReviewRepositoryMockGetDeletedReview) ExpectUserIDParam2(userID string) *mReviewRepositoryMockGetDeletedReview {
+	if mmGetDeletedReview.mock.funcGetDeletedReview != nil {
+		mmGetDeletedReview.mock.t.Fatalf("ReviewRepositoryMock.GetDeletedReview mock is already set by Set")
 	}
 
-	if mmUpdateReview.defaultExpectation == nil {
-		mmUpdateReview.defaultExpectation = &ReviewRepositoryMockUpdateReviewExpectation{}
+	if mmGetDeletedReview.defaultExpectation == nil {
+		mmGetDeletedReview.defaultExpectation = &ReviewRepositoryMockGetDeletedReviewExpectation{}
 	}
 
-	if mmUpdateReview.defaultExpectation.params != nil {
-		mmUpdateReview.mock.t.Fatalf("ReviewRepositoryMock.UpdateReview mock is already set by Expect")
+	if mmGetDeletedReview.defaultExpectation.params != nil {
+		mmGetDeletedReview.mock.t.Fatalf("ReviewRepositoryMock.GetDeletedReview mock is already set by Expect")
 	}
 
-	if mmUpdateReview.defaultExpectation.paramPtrs == nil {
-		mmUpdateReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockUpdateReviewParamPtrs{}
+	if mmGetDeletedReview.defaultExpectation.paramPtrs == nil {
+		mmGetDeletedReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockGetDeletedReviewParamPtrs{}
 	}
-	mmUpdateReview.defaultExpectation.paramPtrs.review = &review
-	mmUpdateReview.defaultExpectation.expectationOrigins.originReview = minimock.CallerInfo(1)
+	mmGetDeletedReview.defaultExpectation.paramPtrs.userID = &userID
+	mmGetDeletedReview.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmUpdateReview
+	return mmGetDeletedReview
 }
 
-// Inspect accepts an inspector function that has same arguments as the ReviewRepository.UpdateReview
-func (mmUpdateReview *mReviewRepositoryMockUpdateReview) Inspect(f func(ctx context.Context, review mm_repository.Review)) *mReviewRepositoryMockUpdateReview {
-	if mmUpdateReview.mock.inspectFuncUpdateReview != nil {
+// ExpectMovieIDParam3 sets up expected param movieID for ReviewRepository.GetDeletedReview
+func (mmGetDeletedReview *mReviewRepositoryMockGetDeletedReview) ExpectMovieIDParam3(movieID string) *mReviewRepositoryMockGetDeletedReview {
+	if mmGetDeletedReview.mock.funcGetDeletedReview != nil {
+		mmGetDeletedReview.mock.t.Fatalf("ReviewRepositoryMock.GetDeletedReview mock is already set by Set")
+	}
+
+	if mmGetDeletedReview.defaultExpectation == nil {
+		mmGetDeletedReview.defaultExpectation = &ReviewRepositoryMockGetDeletedReviewExpectation{}
+	}
+
+	if mmGetDeletedReview.defaultExpectation.params != nil {
+		mmGetDeletedReview.mock.t.Fatalf("ReviewRepositoryMock.GetDeletedReview mock is already set by Expect")
+	}
+
+	if mmGetDeletedReview.defaultExpectation.paramPtrs == nil {
+		mmGetDeletedReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockGetDeletedReviewParamPtrs{}
+	}
+	mmGetDeletedReview.defaultExpectation.paramPtrs.movieID = &movieID
+	mmGetDeletedReview.defaultExpectation.expectationOrigins.originMovieID = minimock.CallerInfo(1)
+
+	return mmGetDeletedReview
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReviewRepository.GetDeletedReview
+func (mmGetDeletedReview *mReviewRepositoryMockGetDeletedReview) Inspect(f func(ctx context.Context, userID string, movieID string)) *mReviewRepositoryMockGetDeletedReview {
+	if mmGetDeletedReview.mock.inspectFuncGetDeletedReview != nil {
+		mmGetDeletedReview.mock.t.Fatalf("Inspect function is already set for ReviewRepositoryMock.GetDeletedReview")
+	}
+
+	mmGetDeletedReview.mock.inspectFuncGetDeletedReview = f
+
+	return mmGetDeletedReview
+}
+
+// Return sets up results that will be returned by ReviewRepository.GetDeletedReview
+func (mmGetDeletedReview *mReviewRepositoryMockGetDeletedReview) Return(r1 mm_repository.Review, err error) *ReviewRepositoryMock {
+	if mmGetDeletedReview.mock.funcGetDeletedReview != nil {
+		mmGetDeletedReview.mock.t.Fatalf("ReviewRepositoryMock.GetDeletedReview mock is already set by Set")
+	}
+
+	if mmGetDeletedReview.defaultExpectation == nil {
+		mmGetDeletedReview.defaultExpectation = &ReviewRepositoryMockGetDeletedReviewExpectation{mock: mmGetDeletedReview.mock}
+	}
+	mmGetDeletedReview.defaultExpectation.results = &ReviewRepositoryMockGetDeletedReviewResults{r1, err}
+	mmGetDeletedReview.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetDeletedReview.mock
+}
+
+// Set uses given function f to mock the ReviewRepository.GetDeletedReview method
+func (mmGetDeletedReview *mReviewRepositoryMockGetDeletedReview) Set(f func(ctx context.Context, userID string, movieID string) (r1 mm_repository.Review, err error)) *ReviewRepositoryMock {
+	if mmGetDeletedReview.defaultExpectation != nil {
+		mmGetDeletedReview.mock.t.Fatalf("Default expectation is already set for the ReviewRepository.GetDeletedReview method")
+	}
+
+	if len(mmGetDeletedReview.expectations) > 0 {
+		mmGetDeletedReview.mock.t.Fatalf("Some expectations are already set for the ReviewRepository.GetDeletedReview method")
+	}
+
+	mmGetDeletedReview.mock.funcGetDeletedReview = f
+	mmGetDeletedReview.mock.funcGetDeletedReviewOrigin = minimock.CallerInfo(1)
+	return mmGetDeletedReview.mock
+}
+
+// When sets expectation for the ReviewRepository.GetDeletedReview which will trigger the result defined by the following
+// Then helper
+func (mmGetDeletedReview *mReviewRepositoryMockGetDeletedReview) When(ctx context.Context, userID string, movieID string) *ReviewRepositoryMockGetDeletedReviewExpectation {
+	if mmGetDeletedReview.mock.funcGetDeletedReview != nil {
+		mmGetDeletedReview.mock.t.Fatalf("ReviewRepositoryMock.GetDeletedReview mock is already set by Set")
+	}
+
+	expectation := &ReviewRepositoryMockGetDeletedReviewExpectation{
+		mock:               mmGetDeletedReview.mock,
+		params:             &ReviewRepositoryMockGetDeletedReviewParams{ctx, userID, movieID},
+		expectationOrigins: ReviewRepositoryMockGetDeletedReviewExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetDeletedReview.expectations = append(mmGetDeletedReview.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReviewRepository.GetDeletedReview return parameters for the expectation previously defined by the When method
+func (e *ReviewRepositoryMockGetDeletedReviewExpectation) Then(r1 mm_repository.Review, err error) *ReviewRepositoryMock {
+	e.results = &ReviewRepositoryMockGetDeletedReviewResults{r1, err}
+	return e.mock
+}
+
+// Times sets number of times ReviewRepository.GetDeletedReview should be invoked
+func (mmGetDeletedReview *mReviewRepositoryMockGetDeletedReview) Times(n uint64) *mReviewRepositoryMockGetDeletedReview {
+	if n == 0 {
+		mmGetDeletedReview.mock.t.Fatalf("Times of ReviewRepositoryMock.GetDeletedReview mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetDeletedReview.expectedInvocations, n)
+	mmGetDeletedReview.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetDeletedReview
+}
+
+func (mmGetDeletedReview *mReviewRepositoryMockGetDeletedReview) invocationsDone() bool {
+	if len(mmGetDeletedReview.expectations) == 0 && mmGetDeletedReview.defaultExpectation == nil && mmGetDeletedReview.mock.funcGetDeletedReview == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetDeletedReview.mock.afterGetDeletedReviewCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetDeletedReview.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetDeletedReview implements mm_repository.ReviewRepository
+func (mmGetDeletedReview *ReviewRepositoryMock) GetDeletedReview(ctx context.Context, userID string, movieID string) (r1 mm_repository.Review, err error) {
+	mm_atomic.AddUint64(&mmGetDeletedReview.beforeGetDeletedReviewCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetDeletedReview.afterGetDeletedReviewCounter, 1)
+
+	mmGetDeletedReview.t.Helper()
+
+	if mmGetDeletedReview.inspectFuncGetDeletedReview != nil {
+		mmGetDeletedReview.inspectFuncGetDeletedReview(ctx, userID, movieID)
+	}
+
+	mm_params := ReviewRepositoryMockGetDeletedReviewParams{ctx, userID, movieID}
+
+	// Record call args
+	mmGetDeletedReview.GetDeletedReviewMock.mutex.Lock()
+	mmGetDeletedReview.GetDeletedReviewMock.callArgs = append(mmGetDeletedReview.GetDeletedReviewMock.callArgs, &mm_params)
+	mmGetDeletedReview.GetDeletedReviewMock.mutex.Unlock()
+
+	for _, e := range mmGetDeletedReview.GetDeletedReviewMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.err
+		}
+	}
+
+	if mmGetDeletedReview.GetDeletedReviewMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetDeletedReview.GetDeletedReviewMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetDeletedReview.GetDeletedReviewMock.defaultExpectation.params
+		mm_want_ptrs := mmGetDeletedReview.GetDeletedReviewMock.defaultExpectation.paramPtrs
+
+		mm_got := ReviewRepositoryMockGetDeletedReviewParams{ctx, userID, movieID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetDeletedReview.t.Errorf("ReviewRepositoryMock.GetDeletedReview got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDeletedReview.GetDeletedReviewMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetDeletedReview.t.Errorf("ReviewRepositoryMock.GetDeletedReview got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDeletedReview.GetDeletedReviewMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.movieID != nil && !minimock.Equal(*mm_want_ptrs.movieID, mm_got.movieID) {
+				mmGetDeletedReview.t.Errorf("ReviewRepositoryMock.GetDeletedReview got unexpected parameter movieID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDeletedReview.GetDeletedReviewMock.defaultExpectation.expectationOrigins.originMovieID, *mm_want_ptrs.movieID, mm_got.movieID, minimock.Diff(*mm_want_ptrs.movieID, mm_got.movieID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetDeletedReview.t.Errorf("ReviewRepositoryMock.GetDeletedReview got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetDeletedReview.GetDeletedReviewMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetDeletedReview.GetDeletedReviewMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetDeletedReview.t.Fatal("No results are set for the ReviewRepositoryMock.GetDeletedReview")
+		}
+		return (*mm_results).r1, (*mm_results).err
+	}
+	if mmGetDeletedReview.funcGetDeletedReview != nil {
+		return mmGetDeletedReview.funcGetDeletedReview(ctx, userID, movieID)
+	}
+	mmGetDeletedReview.t.Fatalf("Unexpected call to ReviewRepositoryMock.GetDeletedReview. %v %v %v", ctx, userID, movieID)
+	return
+}
+
+// GetDeletedReviewAfterCounter returns a count of finished ReviewRepositoryMock.GetDeletedReview invocations
+func (mmGetDeletedReview *ReviewRepositoryMock) GetDeletedReviewAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDeletedReview.afterGetDeletedReviewCounter)
+}
+
+// GetDeletedReviewBeforeCounter returns a count of ReviewRepositoryMock.GetDeletedReview invocations
+func (mmGetDeletedReview *ReviewRepositoryMock) GetDeletedReviewBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDeletedReview.beforeGetDeletedReviewCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReviewRepositoryMock.GetDeletedReview.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetDeletedReview *mReviewRepositoryMockGetDeletedReview) Calls() []*ReviewRepositoryMockGetDeletedReviewParams {
+	mmGetDeletedReview.mutex.RLock()
+
+	argCopy := make([]*ReviewRepositoryMockGetDeletedReviewParams, len(mmGetDeletedReview.callArgs))
+	copy(argCopy, mmGetDeletedReview.callArgs)
+
+	mmGetDeletedReview.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDeletedReviewDone returns true if the count of the GetDeletedReview invocations corresponds
+// the number of defined expectations
+func (m *ReviewRepositoryMock) MinimockGetDeletedReviewDone() bool {
+	if m.GetDeletedReviewMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetDeletedReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetDeletedReviewMock.invocationsDone()
+}
+
+// MinimockGetDeletedReviewInspect logs each unmet expectation
+func (m *ReviewRepositoryMock) MinimockGetDeletedReviewInspect() {
+	for _, e := range m.GetDeletedReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.GetDeletedReview at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetDeletedReviewCounter := mm_atomic.LoadUint64(&m.afterGetDeletedReviewCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetDeletedReviewMock.defaultExpectation != nil && afterGetDeletedReviewCounter < 1 {
+		if m.GetDeletedReviewMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.GetDeletedReview at\n%s", m.GetDeletedReviewMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.GetDeletedReview at\n%s with params: %#v", m.GetDeletedReviewMock.defaultExpectation.expectationOrigins.origin, *m.GetDeletedReviewMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetDeletedReview != nil && afterGetDeletedReviewCounter < 1 {
+		m.t.Errorf("Expected call to ReviewRepositoryMock.GetDeletedReview at\n%s", m.funcGetDeletedReviewOrigin)
+	}
+
+	if !m.GetDeletedReviewMock.invocationsDone() && afterGetDeletedReviewCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReviewRepositoryMock.GetDeletedReview at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetDeletedReviewMock.expectedInvocations), m.GetDeletedReviewMock.expectedInvocationsOrigin, afterGetDeletedReviewCounter)
+	}
+}
+
+type mReviewRepositoryMockGetReview struct {
+	optional           bool
+	mock               *ReviewRepositoryMock
+	defaultExpectation *ReviewRepositoryMockGetReviewExpectation
+	expectations       []*ReviewRepositoryMockGetReviewExpectation
+
+	callArgs []*ReviewRepositoryMockGetReviewParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReviewRepositoryMockGetReviewExpectation specifies expectation struct of the ReviewRepository.GetReview
+type ReviewRepositoryMockGetReviewExpectation struct {
+	mock               *ReviewRepositoryMock
+	params             *ReviewRepositoryMockGetReviewParams
+	paramPtrs          *ReviewRepositoryMockGetReviewParamPtrs
+	expectationOrigins ReviewRepositoryMockGetReviewExpectationOrigins
+	results            *ReviewRepositoryMockGetReviewResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReviewRepositoryMockGetReviewParams contains parameters of the ReviewRepository.GetReview
+type ReviewRepositoryMockGetReviewParams struct {
+	ctx     context.Context
+	userID  string
+	movieID string
+}
+
+// ReviewRepositoryMockGetReviewParamPtrs contains pointers to parameters of the ReviewRepository.GetReview
+type ReviewRepositoryMockGetReviewParamPtrs struct {
+	ctx     *context.Context
+	userID  *string
+	movieID *string
+}
+
+// ReviewRepositoryMockGetReviewResults contains results of the ReviewRepository.GetReview
+type ReviewRepositoryMockGetReviewResults struct {
+	r1  mm_repository.Review
+	err error
+}
+
+// ReviewRepositoryMockGetReviewOrigins contains origins of expectations of the ReviewRepository.GetReview
+type ReviewRepositoryMockGetReviewExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originMovieID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetReview *mReviewRepositoryMockGetReview) Optional() *mReviewRepositoryMockGetReview {
+	mmGetReview.optional = true
+	return mmGetReview
+}
+
+// Expect sets up expected params for ReviewRepository.GetReview
+func (mmGetReview *mReviewRepositoryMockGetReview) Expect(ctx context.Context, userID string, movieID string) *mReviewRepositoryMockGetReview {
+	if mmGetReview.mock.funcGetReview != nil {
+		mmGetReview.mock.t.Fatalf("ReviewRepositoryMock.GetReview mock is already set by Set")
+	}
+
+	if mmGetReview.defaultExpectation == nil {
+		mmGetReview.defaultExpectation = &ReviewRepositoryMockGetReviewExpectation{}
+	}
+
+	if mmGetReview.defaultExpectation.paramPtrs != nil {
+		mmGetReview.mock.t.Fatalf("ReviewRepositoryMock.GetReview mock is already set by ExpectParams functions")
+	}
+
+	mmGetReview.defaultExpectation.params = &ReviewRepositoryMockGetReviewParams{ctx, userID, movieID}
+	mmGetReview.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetReview.expectations {
+		if minimock.Equal(e.params, mmGetReview.defaultExpectation.params) {
+			mmGetReview.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetReview.defaultExpectation.params)
+		}
+	}
+
+	return mmGetReview
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReviewRepository.GetReview
+func (mmGetReview *mReviewRepositoryMockGetReview) ExpectCtxParam1(ctx context.Context) *mReviewRepositoryMockGetReview {
+	if mmGetReview.mock.funcGetReview != nil {
+		mmGetReview.mock.t.Fatalf("ReviewRepositoryMock.GetReview mock is already set by Set")
+	}
+
+	if mmGetReview.defaultExpectation == nil {
+		mmGetReview.defaultExpectation = &ReviewRepositoryMockGetReviewExpectation{}
+	}
+
+	if mmGetReview.defaultExpectation.params != nil {
+		mmGetReview.mock.t.Fatalf("ReviewRepositoryMock.GetReview mock is already set by Expect")
+	}
+
+	if mmGetReview.defaultExpectation.paramPtrs == nil {
+		mmGetReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockGetReviewParamPtrs{}
+	}
+	mmGetReview.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetReview.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetReview
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ReviewRepository.GetReview
+func (mmGetReview *mReviewRepositoryMockGetReview) ExpectUserIDParam2(userID string) *mReviewRepositoryMockGetReview {
+	if mmGetReview.mock.funcGetReview != nil {
+		mmGetReview.mock.t.Fatalf("ReviewRepositoryMock.GetReview mock is already set by Set")
+	}
+
+	if mmGetReview.defaultExpectation == nil {
+		mmGetReview.defaultExpectation = &ReviewRepositoryMockGetReviewExpectation{}
+	}
+
+	if mmGetReview.defaultExpectation.params != nil {
+		mmGetReview.mock.t.Fatalf("ReviewRepositoryMock.GetReview mock is already set by Expect")
+	}
+
+	if mmGetReview.defaultExpectation.paramPtrs == nil {
+		mmGetReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockGetReviewParamPtrs{}
+	}
+	mmGetReview.defaultExpectation.paramPtrs.userID = &userID
+	mmGetReview.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetReview
+}
+
+// ExpectMovieIDParam3 sets up expected param movieID for ReviewRepository.GetReview
+func (mmGetReview *mReviewRepositoryMockGetReview) ExpectMovieIDParam3(movieID string) *mReviewRepositoryMockGetReview {
+	if mmGetReview.mock.funcGetReview != nil {
+		mmGetReview.mock.t.Fatalf("ReviewRepositoryMock.GetReview mock is already set by Set")
+	}
+
+	if mmGetReview.defaultExpectation == nil {
+		mmGetReview.defaultExpectation = &ReviewRepositoryMockGetReviewExpectation{}
+	}
+
+	if mmGetReview.defaultExpectation.params != nil {
+		mmGetReview.mock.t.Fatalf("ReviewRepositoryMock.GetReview mock is already set by Expect")
+	}
+
+	if mmGetReview.defaultExpectation.paramPtrs == nil {
+		mmGetReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockGetReviewParamPtrs{}
+	}
+	mmGetReview.defaultExpectation.paramPtrs.movieID = &movieID
+	mmGetReview.defaultExpectation.expectationOrigins.originMovieID = minimock.CallerInfo(1)
+
+	return mmGetReview
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReviewRepository.GetReview
+func (mmGetReview *mReviewRepositoryMockGetReview) Inspect(f func(ctx context.Context, userID string, movieID string)) *mReviewRepositoryMockGetReview {
+	if mmGetReview.mock.inspectFuncGetReview != nil {
+		mmGetReview.mock.t.Fatalf("Inspect function is already set for ReviewRepositoryMock.GetReview")
+	}
+
+	mmGetReview.mock.inspectFuncGetReview = f
+
+	return mmGetReview
+}
+
+// Return sets up results that will be returned by ReviewRepository.GetReview
+func (mmGetReview *mReviewRepositoryMockGetReview) Return(r1 mm_repository.Review, err error) *ReviewRepositoryMock {
+	if mmGetReview.mock.funcGetReview != nil {
+		mmGetReview.mock.t.Fatalf("ReviewRepositoryMock.GetReview mock is already set by Set")
+	}
+
+	if mmGetReview.defaultExpectation == nil {
+		mmGetReview.defaultExpectation = &ReviewRepositoryMockGetReviewExpectation{mock: mmGetReview.mock}
+	}
+	mmGetReview.defaultExpectation.results = &ReviewRepositoryMockGetReviewResults{r1, err}
+	mmGetReview.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetReview.mock
+}
+
+// Set uses given function f to mock the ReviewRepository.GetReview method
+func (mmGetReview *mReviewRepositoryMockGetReview) Set(f func(ctx context.Context, userID string, movieID string) (r1 mm_repository.Review, err error)) *ReviewRepositoryMock {
+	if mmGetReview.defaultExpectation != nil {
+		mmGetReview.mock.t.Fatalf("Default expectation is already set for the ReviewRepository.GetReview method")
+	}
+
+	if len(mmGetReview.expectations) > 0 {
+		mmGetReview.mock.t.Fatalf("Some expectations are already set for the ReviewRepository.GetReview method")
+	}
+
+	mmGetReview.mock.funcGetReview = f
+	mmGetReview.mock.funcGetReviewOrigin = minimock.CallerInfo(1)
+	return mmGetReview.mock
+}
+
+// When sets expectation for the ReviewRepository.GetReview which will trigger the result defined by the following
+// Then helper
+func (mmGetReview *mReviewRepositoryMockGetReview) When(ctx context.Context, userID string, movieID string) *ReviewRepositoryMockGetReviewExpectation {
+	if mmGetReview.mock.funcGetReview != nil {
+		mmGetReview.mock.t.Fatalf("ReviewRepositoryMock.GetReview mock is already set by Set")
+	}
+
+	expectation := &ReviewRepositoryMockGetReviewExpectation{
+		mock:               mmGetReview.mock,
+		params:             &ReviewRepositoryMockGetReviewParams{ctx, userID, movieID},
+		expectationOrigins: ReviewRepositoryMockGetReviewExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetReview.expectations = append(mmGetReview.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReviewRepository.GetReview return parameters for the expectation previously defined by the When method
+func (e *ReviewRepositoryMockGetReviewExpectation) Then(r1 mm_repository.Review, err error) *ReviewRepositoryMock {
+	e.results = &ReviewRepositoryMockGetReviewResults{r1, err}
+	return e.mock
+}
+
+// Times sets number of times ReviewRepository.GetReview should be invoked
+func (mmGetReview *mReviewRepositoryMockGetReview) Times(n uint64) *mReviewRepositoryMockGetReview {
+	if n == 0 {
+		mmGetReview.mock.t.Fatalf("Times of ReviewRepositoryMock.GetReview mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetReview.expectedInvocations, n)
+	mmGetReview.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetReview
+}
+
+func (mmGetReview *mReviewRepositoryMockGetReview) invocationsDone() bool {
+	if len(mmGetReview.expectations) == 0 && mmGetReview.defaultExpectation == nil && mmGetReview.mock.funcGetReview == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetReview.mock.afterGetReviewCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetReview.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetReview implements mm_repository.ReviewRepository
+func (mmGetReview *ReviewRepositoryMock) GetReview(ctx context.Context, userID string, movieID string) (r1 mm_repository.Review, err error) {
+	mm_atomic.AddUint64(&mmGetReview.beforeGetReviewCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetReview.afterGetReviewCounter, 1)
+
+	mmGetReview.t.Helper()
+
+	if mmGetReview.inspectFuncGetReview != nil {
+		mmGetReview.inspectFuncGetReview(ctx, userID, movieID)
+	}
+
+	mm_params := ReviewRepositoryMockGetReviewParams{ctx, userID, movieID}
+
+	// Record call args
+	mmGetReview.GetReviewMock.mutex.Lock()
+	mmGetReview.GetReviewMock.callArgs = append(mmGetReview.GetReviewMock.callArgs, &mm_params)
+	mmGetReview.GetReviewMock.mutex.Unlock()
+
+	for _, e := range mmGetReview.GetReviewMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.err
+		}
+	}
+
+	if mmGetReview.GetReviewMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetReview.GetReviewMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetReview.GetReviewMock.defaultExpectation.params
+		mm_want_ptrs := mmGetReview.GetReviewMock.defaultExpectation.paramPtrs
+
+		mm_got := ReviewRepositoryMockGetReviewParams{ctx, userID, movieID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetReview.t.Errorf("ReviewRepositoryMock.GetReview got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReview.GetReviewMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetReview.t.Errorf("ReviewRepositoryMock.GetReview got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReview.GetReviewMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.movieID != nil && !minimock.Equal(*mm_want_ptrs.movieID, mm_got.movieID) {
+				mmGetReview.t.Errorf("ReviewRepositoryMock.GetReview got unexpected parameter movieID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReview.GetReviewMock.defaultExpectation.expectationOrigins.originMovieID, *mm_want_ptrs.movieID, mm_got.movieID, minimock.Diff(*mm_want_ptrs.movieID, mm_got.movieID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetReview.t.Errorf("ReviewRepositoryMock.GetReview got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetReview.GetReviewMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetReview.GetReviewMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetReview.t.Fatal("No results are set for the ReviewRepositoryMock.GetReview")
+		}
+		return (*mm_results).r1, (*mm_results).err
+	}
+	if mmGetReview.funcGetReview != nil {
+		return mmGetReview.funcGetReview(ctx, userID, movieID)
+	}
+	mmGetReview.t.Fatalf("Unexpected call to ReviewRepositoryMock.GetReview. %v %v %v", ctx, userID, movieID)
+	return
+}
+
+// GetReviewAfterCounter returns a count of finished ReviewRepositoryMock.GetReview invocations
+func (mmGetReview *ReviewRepositoryMock) GetReviewAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReview.afterGetReviewCounter)
+}
+
+// GetReviewBeforeCounter returns a count of ReviewRepositoryMock.GetReview invocations
+func (mmGetReview *ReviewRepositoryMock) GetReviewBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReview.beforeGetReviewCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReviewRepositoryMock.GetReview.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetReview *mReviewRepositoryMockGetReview) Calls() []*ReviewRepositoryMockGetReviewParams {
+	mmGetReview.mutex.RLock()
+
+	argCopy := make([]*ReviewRepositoryMockGetReviewParams, len(mmGetReview.callArgs))
+	copy(argCopy, mmGetReview.callArgs)
+
+	mmGetReview.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetReviewDone returns true if the count of the GetReview invocations corresponds
+// the number of defined expectations
+func (m *ReviewRepositoryMock) MinimockGetReviewDone() bool {
+	if m.GetReviewMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetReviewMock.invocationsDone()
+}
+
+// MinimockGetReviewInspect logs each unmet expectation
+func (m *ReviewRepositoryMock) MinimockGetReviewInspect() {
+	for _, e := range m.GetReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.GetReview at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetReviewCounter := mm_atomic.LoadUint64(&m.afterGetReviewCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReviewMock.defaultExpectation != nil && afterGetReviewCounter < 1 {
+		if m.GetReviewMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.GetReview at\n%s", m.GetReviewMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.GetReview at\n%s with params: %#v", m.GetReviewMock.defaultExpectation.expectationOrigins.origin, *m.GetReviewMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReview != nil && afterGetReviewCounter < 1 {
+		m.t.Errorf("Expected call to ReviewRepositoryMock.GetReview at\n%s", m.funcGetReviewOrigin)
+	}
+
+	if !m.GetReviewMock.invocationsDone() && afterGetReviewCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReviewRepositoryMock.GetReview at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetReviewMock.expectedInvocations), m.GetReviewMock.expectedInvocationsOrigin, afterGetReviewCounter)
+	}
+}
+
+type mReviewRepositoryMockGetReviews struct {
+	optional           bool
+	mock               *ReviewRepositoryMock
+	defaultExpectation *ReviewRepositoryMockGetReviewsExpectation
+	expectations       []*ReviewRepositoryMockGetReviewsExpectation
+
+	callArgs []*ReviewRepositoryMockGetReviewsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReviewRepositoryMockGetReviewsExpectation specifies expectation struct of the ReviewRepository.GetReviews
+type ReviewRepositoryMockGetReviewsExpectation struct {
+	mock               *ReviewRepositoryMock
+	params             *ReviewRepositoryMockGetReviewsParams
+	paramPtrs          *ReviewRepositoryMockGetReviewsParamPtrs
+	expectationOrigins ReviewRepositoryMockGetReviewsExpectationOrigins
+	results            *ReviewRepositoryMockGetReviewsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReviewRepositoryMockGetReviewsParams contains parameters of the ReviewRepository.GetReviews
+type ReviewRepositoryMockGetReviewsParams struct {
+	ctx      context.Context
+	ID       string
+	statuses []mm_repository.ReviewStatus
+	sort     mm_repository.ReviewSort
+	after    *mm_repository.ReviewCursor
+	limit    int
+}
+
+// ReviewRepositoryMockGetReviewsParamPtrs contains pointers to parameters of the ReviewRepository.GetReviews
+type ReviewRepositoryMockGetReviewsParamPtrs struct {
+	ctx      *context.Context
+	ID       *string
+	statuses *[]mm_repository.ReviewStatus
+	sort     *mm_repository.ReviewSort
+	after    **mm_repository.ReviewCursor
+	limit    *int
+}
+
+// ReviewRepositoryMockGetReviewsResults contains results of the ReviewRepository.GetReviews
+type ReviewRepositoryMockGetReviewsResults struct {
+	ra1 []mm_repository.Review
+	err error
+}
+
+// ReviewRepositoryMockGetReviewsOrigins contains origins of expectations of the ReviewRepository.GetReviews
+type ReviewRepositoryMockGetReviewsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originID       string
+	originStatuses string
+	originSort     string
+	originAfter    string
+	originLimit    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetReviews *mReviewRepositoryMockGetReviews) Optional() *mReviewRepositoryMockGetReviews {
+	mmGetReviews.optional = true
+	return mmGetReviews
+}
+
+// Expect sets up expected params for ReviewRepository.GetReviews
+func (mmGetReviews *mReviewRepositoryMockGetReviews) Expect(ctx context.Context, ID string, statuses []mm_repository.ReviewStatus, sort mm_repository.ReviewSort, after *mm_repository.ReviewCursor, limit int) *mReviewRepositoryMockGetReviews {
+	if mmGetReviews.mock.funcGetReviews != nil {
+		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by Set")
+	}
+
+	if mmGetReviews.defaultExpectation == nil {
+		mmGetReviews.defaultExpectation = &ReviewRepositoryMockGetReviewsExpectation{}
+	}
+
+	if mmGetReviews.defaultExpectation.paramPtrs != nil {
+		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by ExpectParams functions")
+	}
+
+	mmGetReviews.defaultExpectation.params = &ReviewRepositoryMockGetReviewsParams{ctx, ID, statuses, sort, after, limit}
+	mmGetReviews.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetReviews.expectations {
+		if minimock.Equal(e.params, mmGetReviews.defaultExpectation.params) {
+			mmGetReviews.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetReviews.defaultExpectation.params)
+		}
+	}
+
+	return mmGetReviews
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReviewRepository.GetReviews
+func (mmGetReviews *mReviewRepositoryMockGetReviews) ExpectCtxParam1(ctx context.Context) *mReviewRepositoryMockGetReviews {
+	if mmGetReviews.mock.funcGetReviews != nil {
+		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by Set")
+	}
+
+	if mmGetReviews.defaultExpectation == nil {
+		mmGetReviews.defaultExpectation = &ReviewRepositoryMockGetReviewsExpectation{}
+	}
+
+	if mmGetReviews.defaultExpectation.params != nil {
+		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by Expect")
+	}
+
+	if mmGetReviews.defaultExpectation.paramPtrs == nil {
+		mmGetReviews.defaultExpectation.paramPtrs = &ReviewRepositoryMockGetReviewsParamPtrs{}
+	}
+	mmGetReviews.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetReviews.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetReviews
+}
+
+// ExpectIDParam2 sets up expected param ID for ReviewRepository.GetReviews
+func (mmGetReviews *mReviewRepositoryMockGetReviews) ExpectIDParam2(ID string) *mReviewRepositoryMockGetReviews {
+	if mmGetReviews.mock.funcGetReviews != nil {
+		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by Set")
+	}
+
+	if mmGetReviews.defaultExpectation == nil {
+		mmGetReviews.defaultExpectation = &ReviewRepositoryMockGetReviewsExpectation{}
+	}
+
+	if mmGetReviews.defaultExpectation.params != nil {
+		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by Expect")
+	}
+
+	if mmGetReviews.defaultExpectation.paramPtrs == nil {
+		mmGetReviews.defaultExpectation.paramPtrs = &ReviewRepositoryMockGetReviewsParamPtrs{}
+	}
+	mmGetReviews.defaultExpectation.paramPtrs.ID = &ID
+	mmGetReviews.defaultExpectation.expectationOrigins.originID = minimock.CallerInfo(1)
+
+	return mmGetReviews
+}
+
+// ExpectStatusesParam3 sets up expected param statuses for ReviewRepository.GetReviews
+func (mmGetReviews *mReviewRepositoryMockGetReviews) ExpectStatusesParam3(statuses []mm_repository.ReviewStatus) *mReviewRepositoryMockGetReviews {
+	if mmGetReviews.mock.funcGetReviews != nil {
+		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by Set")
+	}
+
+	if mmGetReviews.defaultExpectation == nil {
+		mmGetReviews.defaultExpectation = &ReviewRepositoryMockGetReviewsExpectation{}
+	}
+
+	if mmGetReviews.defaultExpectation.params != nil {
+		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by Expect")
+	}
+
+	if mmGetReviews.defaultExpectation.paramPtrs == nil {
+		mmGetReviews.defaultExpectation.paramPtrs = &ReviewRepositoryMockGetReviewsParamPtrs{}
+	}
+	mmGetReviews.defaultExpectation.paramPtrs.statuses = &statuses
+	mmGetReviews.defaultExpectation.expectationOrigins.originStatuses = minimock.CallerInfo(1)
+
+	return mmGetReviews
+}
+
+// ExpectSortParam4 sets up expected param sort for ReviewRepository.GetReviews
+func (mmGetReviews *mReviewRepositoryMockGetReviews) ExpectSortParam4(sort mm_repository.ReviewSort) *mReviewRepositoryMockGetReviews {
+	if mmGetReviews.mock.funcGetReviews != nil {
+		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by Set")
+	}
+
+	if mmGetReviews.defaultExpectation == nil {
+		mmGetReviews.defaultExpectation = &ReviewRepositoryMockGetReviewsExpectation{}
+	}
+
+	if mmGetReviews.defaultExpectation.params != nil {
+		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by Expect")
+	}
+
+	if mmGetReviews.defaultExpectation.paramPtrs == nil {
+		mmGetReviews.defaultExpectation.paramPtrs = &ReviewRepositoryMockGetReviewsParamPtrs{}
+	}
+	mmGetReviews.defaultExpectation.paramPtrs.sort = &sort
+	mmGetReviews.defaultExpectation.expectationOrigins.originSort = minimock.CallerInfo(1)
+
+	return mmGetReviews
+}
+
+// ExpectAfterParam5 sets up expected param after for ReviewRepository.GetReviews
+func (mmGetReviews *mReviewRepositoryMockGetReviews) ExpectAfterParam5(after *mm_repository.ReviewCursor) *mReviewRepositoryMockGetReviews {
+	if mmGetReviews.mock.funcGetReviews != nil {
+		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by Set")
+	}
+
+	if mmGetReviews.defaultExpectation == nil {
+		mmGetReviews.defaultExpectation = &ReviewRepositoryMockGetReviewsExpectation{}
+	}
+
+	if mmGetReviews.defaultExpectation.params != nil {
+		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by Expect")
+	}
+
+	if mmGetReviews.defaultExpectation.paramPtrs == nil {
+		mmGetReviews.defaultExpectation.paramPtrs = &ReviewRepositoryMockGetReviewsParamPtrs{}
+	}
+	mmGetReviews.defaultExpectation.paramPtrs.after = &after
+	mmGetReviews.defaultExpectation.expectationOrigins.originAfter = minimock.CallerInfo(1)
+
+	return mmGetReviews
+}
+
+// ExpectLimitParam6 sets up expected param limit for ReviewRepository.GetReviews
+func (mmGetReviews *mReviewRepositoryMockGetReviews) ExpectLimitParam6(limit int) *mReviewRepositoryMockGetReviews {
+	if mmGetReviews.mock.funcGetReviews != nil {
+		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by Set")
+	}
+
+	if mmGetReviews.defaultExpectation == nil {
+		mmGetReviews.defaultExpectation = &ReviewRepositoryMockGetReviewsExpectation{}
+	}
+
+	if mmGetReviews.defaultExpectation.params != nil {
+		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by Expect")
+	}
+
+	if mmGetReviews.defaultExpectation.paramPtrs == nil {
+		mmGetReviews.defaultExpectation.paramPtrs = &ReviewRepositoryMockGetReviewsParamPtrs{}
+	}
+	mmGetReviews.defaultExpectation.paramPtrs.limit = &limit
+	mmGetReviews.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmGetReviews
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReviewRepository.GetReviews
+func (mmGetReviews *mReviewRepositoryMockGetReviews) Inspect(f func(ctx context.Context, ID string, statuses []mm_repository.ReviewStatus, sort mm_repository.ReviewSort, after *mm_repository.ReviewCursor, limit int)) *mReviewRepositoryMockGetReviews {
+	if mmGetReviews.mock.inspectFuncGetReviews != nil {
+		mmGetReviews.mock.t.Fatalf("Inspect function is already set for ReviewRepositoryMock.GetReviews")
+	}
+
+	mmGetReviews.mock.inspectFuncGetReviews = f
+
+	return mmGetReviews
+}
+
+// Return sets up results that will be returned by ReviewRepository.GetReviews
+func (mmGetReviews *mReviewRepositoryMockGetReviews) Return(ra1 []mm_repository.Review, err error) *ReviewRepositoryMock {
+	if mmGetReviews.mock.funcGetReviews != nil {
+		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by Set")
+	}
+
+	if mmGetReviews.defaultExpectation == nil {
+		mmGetReviews.defaultExpectation = &ReviewRepositoryMockGetReviewsExpectation{mock: mmGetReviews.mock}
+	}
+	mmGetReviews.defaultExpectation.results = &ReviewRepositoryMockGetReviewsResults{ra1, err}
+	mmGetReviews.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetReviews.mock
+}
+
+// Set uses given function f to mock the ReviewRepository.GetReviews method
+func (mmGetReviews *mReviewRepositoryMockGetReviews) Set(f func(ctx context.Context, ID string, statuses []mm_repository.ReviewStatus, sort mm_repository.ReviewSort, after *mm_repository.ReviewCursor, limit int) (ra1 []mm_repository.Review, err error)) *ReviewRepositoryMock {
+	if mmGetReviews.defaultExpectation != nil {
+		mmGetReviews.mock.t.Fatalf("Default expectation is already set for the ReviewRepository.GetReviews method")
+	}
+
+	if len(mmGetReviews.expectations) > 0 {
+		mmGetReviews.mock.t.Fatalf("Some expectations are already set for the ReviewRepository.GetReviews method")
+	}
+
+	mmGetReviews.mock.funcGetReviews = f
+	mmGetReviews.mock.funcGetReviewsOrigin = minimock.CallerInfo(1)
+	return mmGetReviews.mock
+}
+
+// When sets expectation for the ReviewRepository.GetReviews which will trigger the result defined by the following
+// Then helper
+func (mmGetReviews *mReviewRepositoryMockGetReviews) When(ctx context.Context, ID string, statuses []mm_repository.ReviewStatus, sort mm_repository.ReviewSort, after *mm_repository.ReviewCursor, limit int) *ReviewRepositoryMockGetReviewsExpectation {
+	if mmGetReviews.mock.funcGetReviews != nil {
+		mmGetReviews.mock.t.Fatalf("ReviewRepositoryMock.GetReviews mock is already set by Set")
+	}
+
+	expectation := &ReviewRepositoryMockGetReviewsExpectation{
+		mock:               mmGetReviews.mock,
+		params:             &ReviewRepositoryMockGetReviewsParams{ctx, ID, statuses, sort, after, limit},
+		expectationOrigins: ReviewRepositoryMockGetReviewsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetReviews.expectations = append(mmGetReviews.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReviewRepository.GetReviews return parameters for the expectation previously defined by the When method
+func (e *ReviewRepositoryMockGetReviewsExpectation) Then(ra1 []mm_repository.Review, err error) *ReviewRepositoryMock {
+	e.results = &ReviewRepositoryMockGetReviewsResults{ra1, err}
+	return e.mock
+}
+
+// Times sets number of times ReviewRepository.GetReviews should be invoked
+func (mmGetReviews *mReviewRepositoryMockGetReviews) Times(n uint64) *mReviewRepositoryMockGetReviews {
+	if n == 0 {
+		mmGetReviews.mock.t.Fatalf("Times of ReviewRepositoryMock.GetReviews mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetReviews.expectedInvocations, n)
+	mmGetReviews.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetReviews
+}
+
+func (mmGetReviews *mReviewRepositoryMockGetReviews) invocationsDone() bool {
+	if len(mmGetReviews.expectations) == 0 && mmGetReviews.defaultExpectation == nil && mmGetReviews.mock.funcGetReviews == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetReviews.mock.afterGetReviewsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetReviews.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetReviews implements mm_repository.ReviewRepository
+func (mmGetReviews *ReviewRepositoryMock) GetReviews(ctx context.Context, ID string, statuses []mm_repository.ReviewStatus, sort mm_repository.ReviewSort, after *mm_repository.ReviewCursor, limit int) (ra1 []mm_repository.Review, err error) {
+	mm_atomic.AddUint64(&mmGetReviews.beforeGetReviewsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetReviews.afterGetReviewsCounter, 1)
+
+	mmGetReviews.t.Helper()
+
+	if mmGetReviews.inspectFuncGetReviews != nil {
+		mmGetReviews.inspectFuncGetReviews(ctx, ID, statuses, sort, after, limit)
+	}
+
+	mm_params := ReviewRepositoryMockGetReviewsParams{ctx, ID, statuses, sort, after, limit}
+
+	// Record call args
+	mmGetReviews.GetReviewsMock.mutex.Lock()
+	mmGetReviews.GetReviewsMock.callArgs = append(mmGetReviews.GetReviewsMock.callArgs, &mm_params)
+	mmGetReviews.GetReviewsMock.mutex.Unlock()
+
+	for _, e := range mmGetReviews.GetReviewsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmGetReviews.GetReviewsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetReviews.GetReviewsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetReviews.GetReviewsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetReviews.GetReviewsMock.defaultExpectation.paramPtrs
+
+		mm_got := ReviewRepositoryMockGetReviewsParams{ctx, ID, statuses, sort, after, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetReviews.t.Errorf("ReviewRepositoryMock.GetReviews got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReviews.GetReviewsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ID != nil && !minimock.Equal(*mm_want_ptrs.ID, mm_got.ID) {
+				mmGetReviews.t.Errorf("ReviewRepositoryMock.GetReviews got unexpected parameter ID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReviews.GetReviewsMock.defaultExpectation.expectationOrigins.originID, *mm_want_ptrs.ID, mm_got.ID, minimock.Diff(*mm_want_ptrs.ID, mm_got.ID))
+			}
+
+			if mm_want_ptrs.statuses != nil && !minimock.Equal(*mm_want_ptrs.statuses, mm_got.statuses) {
+				mmGetReviews.t.Errorf("ReviewRepositoryMock.GetReviews got unexpected parameter statuses, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReviews.GetReviewsMock.defaultExpectation.expectationOrigins.originStatuses, *mm_want_ptrs.statuses, mm_got.statuses, minimock.Diff(*mm_want_ptrs.statuses, mm_got.statuses))
+			}
+
+			if mm_want_ptrs.sort != nil && !minimock.Equal(*mm_want_ptrs.sort, mm_got.sort) {
+				mmGetReviews.t.Errorf("ReviewRepositoryMock.GetReviews got unexpected parameter sort, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReviews.GetReviewsMock.defaultExpectation.expectationOrigins.originSort, *mm_want_ptrs.sort, mm_got.sort, minimock.Diff(*mm_want_ptrs.sort, mm_got.sort))
+			}
+
+			if mm_want_ptrs.after != nil && !minimock.Equal(*mm_want_ptrs.after, mm_got.after) {
+				mmGetReviews.t.Errorf("ReviewRepositoryMock.GetReviews got unexpected parameter after, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReviews.GetReviewsMock.defaultExpectation.expectationOrigins.originAfter, *mm_want_ptrs.after, mm_got.after, minimock.Diff(*mm_want_ptrs.after, mm_got.after))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetReviews.t.Errorf("ReviewRepositoryMock.GetReviews got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReviews.GetReviewsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetReviews.t.Errorf("ReviewRepositoryMock.GetReviews got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetReviews.GetReviewsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetReviews.GetReviewsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetReviews.t.Fatal("No results are set for the ReviewRepositoryMock.GetReviews")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmGetReviews.funcGetReviews != nil {
+		return mmGetReviews.funcGetReviews(ctx, ID, statuses, sort, after, limit)
+	}
+	mmGetReviews.t.Fatalf("Unexpected call to ReviewRepositoryMock.GetReviews. %v %v %v %v %v %v", ctx, ID, statuses, sort, after, limit)
+	return
+}
+
+// GetReviewsAfterCounter returns a count of finished ReviewRepositoryMock.GetReviews invocations
+func (mmGetReviews *ReviewRepositoryMock) GetReviewsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReviews.afterGetReviewsCounter)
+}
+
+// GetReviewsBeforeCounter returns a count of ReviewRepositoryMock.GetReviews invocations
+func (mmGetReviews *ReviewRepositoryMock) GetReviewsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReviews.beforeGetReviewsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReviewRepositoryMock.GetReviews.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetReviews *mReviewRepositoryMockGetReviews) Calls() []*ReviewRepositoryMockGetReviewsParams {
+	mmGetReviews.mutex.RLock()
+
+	argCopy := make([]*ReviewRepositoryMockGetReviewsParams, len(mmGetReviews.callArgs))
+	copy(argCopy, mmGetReviews.callArgs)
+
+	mmGetReviews.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetReviewsDone returns true if the count of the GetReviews invocations corresponds
+// the number of defined expectations
+func (m *ReviewRepositoryMock) MinimockGetReviewsDone() bool {
+	if m.GetReviewsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetReviewsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetReviewsMock.invocationsDone()
+}
+
+// MinimockGetReviewsInspect logs each unmet expectation
+func (m *ReviewRepositoryMock) MinimockGetReviewsInspect() {
+	for _, e := range m.GetReviewsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.GetReviews at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetReviewsCounter := mm_atomic.LoadUint64(&m.afterGetReviewsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReviewsMock.defaultExpectation != nil && afterGetReviewsCounter < 1 {
+		if m.GetReviewsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.GetReviews at\n%s", m.GetReviewsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.GetReviews at\n%s with params: %#v", m.GetReviewsMock.defaultExpectation.expectationOrigins.origin, *m.GetReviewsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReviews != nil && afterGetReviewsCounter < 1 {
+		m.t.Errorf("Expected call to ReviewRepositoryMock.GetReviews at\n%s", m.funcGetReviewsOrigin)
+	}
+
+	if !m.GetReviewsMock.invocationsDone() && afterGetReviewsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReviewRepositoryMock.GetReviews at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetReviewsMock.expectedInvocations), m.GetReviewsMock.expectedInvocationsOrigin, afterGetReviewsCounter)
+	}
+}
+
+type mReviewRepositoryMockIncrementVotes struct {
+	optional           bool
+	mock               *ReviewRepositoryMock
+	defaultExpectation *ReviewRepositoryMockIncrementVotesExpectation
+	expectations       []*ReviewRepositoryMockIncrementVotesExpectation
+
+	callArgs []*ReviewRepositoryMockIncrementVotesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReviewRepositoryMockIncrementVotesExpectation specifies expectation struct of the ReviewRepository.IncrementVotes
+type ReviewRepositoryMockIncrementVotesExpectation struct {
+	mock               *ReviewRepositoryMock
+	params             *ReviewRepositoryMockIncrementVotesParams
+	paramPtrs          *ReviewRepositoryMockIncrementVotesParamPtrs
+	expectationOrigins ReviewRepositoryMockIncrementVotesExpectationOrigins
+	results            *ReviewRepositoryMockIncrementVotesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReviewRepositoryMockIncrementVotesParams contains parameters of the ReviewRepository.IncrementVotes
+type ReviewRepositoryMockIncrementVotesParams struct {
+	ctx      context.Context
+	userID   string
+	movieID  string
+	likes    int64
+	dislikes int64
+}
+
+// ReviewRepositoryMockIncrementVotesParamPtrs contains pointers to parameters of the ReviewRepository.IncrementVotes
+type ReviewRepositoryMockIncrementVotesParamPtrs struct {
+	ctx      *context.Context
+	userID   *string
+	movieID  *string
+	likes    *int64
+	dislikes *int64
+}
+
+// ReviewRepositoryMockIncrementVotesResults contains results of the ReviewRepository.IncrementVotes
+type ReviewRepositoryMockIncrementVotesResults struct {
+	err error
+}
+
+// ReviewRepositoryMockIncrementVotesOrigins contains origins of expectations of the ReviewRepository.IncrementVotes
+type ReviewRepositoryMockIncrementVotesExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originMovieID  string
+	originLikes    string
+	originDislikes string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIncrementVotes *mReviewRepositoryMockIncrementVotes) Optional() *mReviewRepositoryMockIncrementVotes {
+	mmIncrementVotes.optional = true
+	return mmIncrementVotes
+}
+
+// Expect sets up expected params for ReviewRepository.IncrementVotes
+func (mmIncrementVotes *mReviewRepositoryMockIncrementVotes) Expect(ctx context.Context, userID string, movieID string, likes int64, dislikes int64) *mReviewRepositoryMockIncrementVotes {
+	if mmIncrementVotes.mock.funcIncrementVotes != nil {
+		mmIncrementVotes.mock.t.Fatalf("ReviewRepositoryMock.IncrementVotes mock is already set by Set")
+	}
+
+	if mmIncrementVotes.defaultExpectation == nil {
+		mmIncrementVotes.defaultExpectation = &ReviewRepositoryMockIncrementVotesExpectation{}
+	}
+
+	if mmIncrementVotes.defaultExpectation.paramPtrs != nil {
+		mmIncrementVotes.mock.t.Fatalf("ReviewRepositoryMock.IncrementVotes mock is already set by ExpectParams functions")
+	}
+
+	mmIncrementVotes.defaultExpectation.params = &ReviewRepositoryMockIncrementVotesParams{ctx, userID, movieID, likes, dislikes}
+	mmIncrementVotes.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIncrementVotes.expectations {
+		if minimock.Equal(e.params, mmIncrementVotes.defaultExpectation.params) {
+			mmIncrementVotes.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIncrementVotes.defaultExpectation.params)
+		}
+	}
+
+	return mmIncrementVotes
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReviewRepository.IncrementVotes
+func (mmIncrementVotes *mReviewRepositoryMockIncrementVotes) ExpectCtxParam1(ctx context.Context) *mReviewRepositoryMockIncrementVotes {
+	if mmIncrementVotes.mock.funcIncrementVotes != nil {
+		mmIncrementVotes.mock.t.Fatalf("ReviewRepositoryMock.IncrementVotes mock is already set by Set")
+	}
+
+	if mmIncrementVotes.defaultExpectation == nil {
+		mmIncrementVotes.defaultExpectation = &ReviewRepositoryMockIncrementVotesExpectation{}
+	}
+
+	if mmIncrementVotes.defaultExpectation.params != nil {
+		mmIncrementVotes.mock.t.Fatalf("ReviewRepositoryMock.IncrementVotes mock is already set by Expect")
+	}
+
+	if mmIncrementVotes.defaultExpectation.paramPtrs == nil {
+		mmIncrementVotes.defaultExpectation.paramPtrs = &ReviewRepositoryMockIncrementVotesParamPtrs{}
+	}
+	mmIncrementVotes.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIncrementVotes.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmIncrementVotes
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ReviewRepository.IncrementVotes
+func (mmIncrementVotes *mReviewRepositoryMockIncrementVotes) ExpectUserIDParam2(userID string) *mReviewRepositoryMockIncrementVotes {
+	if mmIncrementVotes.mock.funcIncrementVotes != nil {
+		mmIncrementVotes.mock.t.Fatalf("ReviewRepositoryMock.IncrementVotes mock is already set by Set")
+	}
+
+	if mmIncrementVotes.defaultExpectation == nil {
+		mmIncrementVotes.defaultExpectation = &ReviewRepositoryMockIncrementVotesExpectation{}
+	}
+
+	if mmIncrementVotes.defaultExpectation.params != nil {
+		mmIncrementVotes.mock.t.Fatalf("ReviewRepositoryMock.IncrementVotes mock is already set by Expect")
+	}
+
+	if mmIncrementVotes.defaultExpectation.paramPtrs == nil {
+		mmIncrementVotes.defaultExpectation.paramPtrs = &ReviewRepositoryMockIncrementVotesParamPtrs{}
+	}
+	mmIncrementVotes.defaultExpectation.paramPtrs.userID = &userID
+	mmIncrementVotes.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmIncrementVotes
+}
+
+// ExpectMovieIDParam3 sets up expected param movieID for ReviewRepository.IncrementVotes
+func (mmIncrementVotes *mReviewRepositoryMockIncrementVotes) ExpectMovieIDParam3(movieID string) *mReviewRepositoryMockIncrementVotes {
+	if mmIncrementVotes.mock.funcIncrementVotes != nil {
+		mmIncrementVotes.mock.t.Fatalf("ReviewRepositoryMock.IncrementVotes mock is already set by Set")
+	}
+
+	if mmIncrementVotes.defaultExpectation == nil {
+		mmIncrementVotes.defaultExpectation = &ReviewRepositoryMockIncrementVotesExpectation{}
+	}
+
+	if mmIncrementVotes.defaultExpectation.params != nil {
+		mmIncrementVotes.mock.t.Fatalf("ReviewRepositoryMock.IncrementVotes mock is already set by Expect")
+	}
+
+	if mmIncrementVotes.defaultExpectation.paramPtrs == nil {
+		mmIncrementVotes.defaultExpectation.paramPtrs = &ReviewRepositoryMockIncrementVotesParamPtrs{}
+	}
+	mmIncrementVotes.defaultExpectation.paramPtrs.movieID = &movieID
+	mmIncrementVotes.defaultExpectation.expectationOrigins.originMovieID = minimock.CallerInfo(1)
+
+	return mmIncrementVotes
+}
+
+// ExpectLikesParam4 sets up expected param likes for ReviewRepository.IncrementVotes
+func (mmIncrementVotes *mReviewRepositoryMockIncrementVotes) ExpectLikesParam4(likes int64) *mReviewRepositoryMockIncrementVotes {
+	if mmIncrementVotes.mock.funcIncrementVotes != nil {
+		mmIncrementVotes.mock.t.Fatalf("ReviewRepositoryMock.IncrementVotes mock is already set by Set")
+	}
+
+	if mmIncrementVotes.defaultExpectation == nil {
+		mmIncrementVotes.defaultExpectation = &ReviewRepositoryMockIncrementVotesExpectation{}
+	}
+
+	if mmIncrementVotes.defaultExpectation.params != nil {
+		mmIncrementVotes.mock.t.Fatalf("ReviewRepositoryMock.IncrementVotes mock is already set by Expect")
+	}
+
+	if mmIncrementVotes.defaultExpectation.paramPtrs == nil {
+		mmIncrementVotes.defaultExpectation.paramPtrs = &ReviewRepositoryMockIncrementVotesParamPtrs{}
+	}
+	mmIncrementVotes.defaultExpectation.paramPtrs.likes = &likes
+	mmIncrementVotes.defaultExpectation.expectationOrigins.originLikes = minimock.CallerInfo(1)
+
+	return mmIncrementVotes
+}
+
+// ExpectDislikesParam5 sets up expected param dislikes for ReviewRepository.IncrementVotes
+func (mmIncrementVotes *mReviewRepositoryMockIncrementVotes) ExpectDislikesParam5(dislikes int64) *mReviewRepositoryMockIncrementVotes {
+	if mmIncrementVotes.mock.funcIncrementVotes != nil {
+		mmIncrementVotes.mock.t.Fatalf("ReviewRepositoryMock.IncrementVotes mock is already set by Set")
+	}
+
+	if mmIncrementVotes.defaultExpectation == nil {
+		mmIncrementVotes.defaultExpectation = &ReviewRepositoryMockIncrementVotesExpectation{}
+	}
+
+	if mmIncrementVotes.defaultExpectation.params != nil {
+		mmIncrementVotes.mock.t.Fatalf("ReviewRepositoryMock.IncrementVotes mock is already set by Expect")
+	}
+
+	if mmIncrementVotes.defaultExpectation.paramPtrs == nil {
+		mmIncrementVotes.defaultExpectation.paramPtrs = &ReviewRepositoryMockIncrementVotesParamPtrs{}
+	}
+	mmIncrementVotes.defaultExpectation.paramPtrs.dislikes = &dislikes
+	mmIncrementVotes.defaultExpectation.expectationOrigins.originDislikes = minimock.CallerInfo(1)
+
+	return mmIncrementVotes
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReviewRepository.IncrementVotes
+func (mmIncrementVotes *mReviewRepositoryMockIncrementVotes) Inspect(f func(ctx context.Context, userID string, movieID string, likes int64, dislikes int64)) *mReviewRepositoryMockIncrementVotes {
+	if mmIncrementVotes.mock.inspectFuncIncrementVotes != nil {
+		mmIncrementVotes.mock.t.Fatalf("Inspect function is already set for ReviewRepositoryMock.IncrementVotes")
+	}
+
+	mmIncrementVotes.mock.inspectFuncIncrementVotes = f
+
+	return mmIncrementVotes
+}
+
+// Return sets up results that will be returned by ReviewRepository.IncrementVotes
+func (mmIncrementVotes *mReviewRepositoryMockIncrementVotes) Return(err error) *ReviewRepositoryMock {
+	if mmIncrementVotes.mock.funcIncrementVotes != nil {
+		mmIncrementVotes.mock.t.Fatalf("ReviewRepositoryMock.IncrementVotes mock is already set by Set")
+	}
+
+	if mmIncrementVotes.defaultExpectation == nil {
+		mmIncrementVotes.defaultExpectation = &ReviewRepositoryMockIncrementVotesExpectation{mock: mmIncrementVotes.mock}
+	}
+	mmIncrementVotes.defaultExpectation.results = &ReviewRepositoryMockIncrementVotesResults{err}
+	mmIncrementVotes.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIncrementVotes.mock
+}
+
+// Set uses given function f to mock the ReviewRepository.IncrementVotes method
+func (mmIncrementVotes *mReviewRepositoryMockIncrementVotes) Set(f func(ctx context.Context, userID string, movieID string, likes int64, dislikes int64) (err error)) *ReviewRepositoryMock {
+	if mmIncrementVotes.defaultExpectation != nil {
+		mmIncrementVotes.mock.t.Fatalf("Default expectation is already set for the ReviewRepository.IncrementVotes method")
+	}
+
+	if len(mmIncrementVotes.expectations) > 0 {
+		mmIncrementVotes.mock.t.Fatalf("Some expectations are already set for the ReviewRepository.IncrementVotes method")
+	}
+
+	mmIncrementVotes.mock.funcIncrementVotes = f
+	mmIncrementVotes.mock.funcIncrementVotesOrigin = minimock.CallerInfo(1)
+	return mmIncrementVotes.mock
+}
+
+// When sets expectation for the ReviewRepository.IncrementVotes which will trigger the result defined by the following
+// Then helper
+func (mmIncrementVotes *mReviewRepositoryMockIncrementVotes) When(ctx context.Context, userID string, movieID string, likes int64, dislikes int64) *ReviewRepositoryMockIncrementVotesExpectation {
+	if mmIncrementVotes.mock.funcIncrementVotes != nil {
+		mmIncrementVotes.mock.t.Fatalf("ReviewRepositoryMock.IncrementVotes mock is already set by Set")
+	}
+
+	expectation := &ReviewRepositoryMockIncrementVotesExpectation{
+		mock:               mmIncrementVotes.mock,
+		params:             &ReviewRepositoryMockIncrementVotesParams{ctx, userID, movieID, likes, dislikes},
+		expectationOrigins: ReviewRepositoryMockIncrementVotesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmIncrementVotes.expectations = append(mmIncrementVotes.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReviewRepository.IncrementVotes return parameters for the expectation previously defined by the When method
+func (e *ReviewRepositoryMockIncrementVotesExpectation) Then(err error) *ReviewRepositoryMock {
+	e.results = &ReviewRepositoryMockIncrementVotesResults{err}
+	return e.mock
+}
+
+// Times sets number of times ReviewRepository.IncrementVotes should be invoked
+func (mmIncrementVotes *mReviewRepositoryMockIncrementVotes) Times(n uint64) *mReviewRepositoryMockIncrementVotes {
+	if n == 0 {
+		mmIncrementVotes.mock.t.Fatalf("Times of ReviewRepositoryMock.IncrementVotes mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmIncrementVotes.expectedInvocations, n)
+	mmIncrementVotes.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIncrementVotes
+}
+
+func (mmIncrementVotes *mReviewRepositoryMockIncrementVotes) invocationsDone() bool {
+	if len(mmIncrementVotes.expectations) == 0 && mmIncrementVotes.defaultExpectation == nil && mmIncrementVotes.mock.funcIncrementVotes == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmIncrementVotes.mock.afterIncrementVotesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIncrementVotes.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// IncrementVotes implements mm_repository.ReviewRepository
+func (mmIncrementVotes *ReviewRepositoryMock) IncrementVotes(ctx context.Context, userID string, movieID string, likes int64, dislikes int64) (err error) {
+	mm_atomic.AddUint64(&mmIncrementVotes.beforeIncrementVotesCounter, 1)
+	defer mm_atomic.AddUint64(&mmIncrementVotes.afterIncrementVotesCounter, 1)
+
+	mmIncrementVotes.t.Helper()
+
+	if mmIncrementVotes.inspectFuncIncrementVotes != nil {
+		mmIncrementVotes.inspectFuncIncrementVotes(ctx, userID, movieID, likes, dislikes)
+	}
+
+	mm_params := ReviewRepositoryMockIncrementVotesParams{ctx, userID, movieID, likes, dislikes}
+
+	// Record call args
+	mmIncrementVotes.IncrementVotesMock.mutex.Lock()
+	mmIncrementVotes.IncrementVotesMock.callArgs = append(mmIncrementVotes.IncrementVotesMock.callArgs, &mm_params)
+	mmIncrementVotes.IncrementVotesMock.mutex.Unlock()
+
+	for _, e := range mmIncrementVotes.IncrementVotesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmIncrementVotes.IncrementVotesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIncrementVotes.IncrementVotesMock.defaultExpectation.Counter, 1)
+		mm_want := mmIncrementVotes.IncrementVotesMock.defaultExpectation.params
+		mm_want_ptrs := mmIncrementVotes.IncrementVotesMock.defaultExpectation.paramPtrs
+
+		mm_got := ReviewRepositoryMockIncrementVotesParams{ctx, userID, movieID, likes, dislikes}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIncrementVotes.t.Errorf("ReviewRepositoryMock.IncrementVotes got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIncrementVotes.IncrementVotesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmIncrementVotes.t.Errorf("ReviewRepositoryMock.IncrementVotes got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIncrementVotes.IncrementVotesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.movieID != nil && !minimock.Equal(*mm_want_ptrs.movieID, mm_got.movieID) {
+				mmIncrementVotes.t.Errorf("ReviewRepositoryMock.IncrementVotes got unexpected parameter movieID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIncrementVotes.IncrementVotesMock.defaultExpectation.expectationOrigins.originMovieID, *mm_want_ptrs.movieID, mm_got.movieID, minimock.Diff(*mm_want_ptrs.movieID, mm_got.movieID))
+			}
+
+			if mm_want_ptrs.likes != nil && !minimock.Equal(*mm_want_ptrs.likes, mm_got.likes) {
+				mmIncrementVotes.t.Errorf("ReviewRepositoryMock.IncrementVotes got unexpected parameter likes, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIncrementVotes.IncrementVotesMock.defaultExpectation.expectationOrigins.originLikes, *mm_want_ptrs.likes, mm_got.likes, minimock.Diff(*mm_want_ptrs.likes, mm_got.likes))
+			}
+
+			if mm_want_ptrs.dislikes != nil && !minimock.Equal(*mm_want_ptrs.dislikes, mm_got.dislikes) {
+				mmIncrementVotes.t.Errorf("ReviewRepositoryMock.IncrementVotes got unexpected parameter dislikes, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIncrementVotes.IncrementVotesMock.defaultExpectation.expectationOrigins.originDislikes, *mm_want_ptrs.dislikes, mm_got.dislikes, minimock.Diff(*mm_want_ptrs.dislikes, mm_got.dislikes))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIncrementVotes.t.Errorf("ReviewRepositoryMock.IncrementVotes got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIncrementVotes.IncrementVotesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIncrementVotes.IncrementVotesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIncrementVotes.t.Fatal("No results are set for the ReviewRepositoryMock.IncrementVotes")
+		}
+		return (*mm_results).err
+	}
+	if mmIncrementVotes.funcIncrementVotes != nil {
+		return mmIncrementVotes.funcIncrementVotes(ctx, userID, movieID, likes, dislikes)
+	}
+	mmIncrementVotes.t.Fatalf("Unexpected call to ReviewRepositoryMock.IncrementVotes. %v %v %v %v %v", ctx, userID, movieID, likes, dislikes)
+	return
+}
+
+// IncrementVotesAfterCounter returns a count of finished ReviewRepositoryMock.IncrementVotes invocations
+func (mmIncrementVotes *ReviewRepositoryMock) IncrementVotesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIncrementVotes.afterIncrementVotesCounter)
+}
+
+// IncrementVotesBeforeCounter returns a count of ReviewRepositoryMock.IncrementVotes invocations
+func (mmIncrementVotes *ReviewRepositoryMock) IncrementVotesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIncrementVotes.beforeIncrementVotesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReviewRepositoryMock.IncrementVotes.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIncrementVotes *mReviewRepositoryMockIncrementVotes) Calls() []*ReviewRepositoryMockIncrementVotesParams {
+	mmIncrementVotes.mutex.RLock()
+
+	argCopy := make([]*ReviewRepositoryMockIncrementVotesParams, len(mmIncrementVotes.callArgs))
+	copy(argCopy, mmIncrementVotes.callArgs)
+
+	mmIncrementVotes.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIncrementVotesDone returns true if the count of the IncrementVotes invocations corresponds
+// the number of defined expectations
+func (m *ReviewRepositoryMock) MinimockIncrementVotesDone() bool {
+	if m.IncrementVotesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.IncrementVotesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.IncrementVotesMock.invocationsDone()
+}
+
+// MinimockIncrementVotesInspect logs each unmet expectation
+func (m *ReviewRepositoryMock) MinimockIncrementVotesInspect() {
+	for _, e := range m.IncrementVotesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.IncrementVotes at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterIncrementVotesCounter := mm_atomic.LoadUint64(&m.afterIncrementVotesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IncrementVotesMock.defaultExpectation != nil && afterIncrementVotesCounter < 1 {
+		if m.IncrementVotesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.IncrementVotes at\n%s", m.IncrementVotesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.IncrementVotes at\n%s with params: %#v", m.IncrementVotesMock.defaultExpectation.expectationOrigins.origin, *m.IncrementVotesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIncrementVotes != nil && afterIncrementVotesCounter < 1 {
+		m.t.Errorf("Expected call to ReviewRepositoryMock.IncrementVotes at\n%s", m.funcIncrementVotesOrigin)
+	}
+
+	if !m.IncrementVotesMock.invocationsDone() && afterIncrementVotesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReviewRepositoryMock.IncrementVotes at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IncrementVotesMock.expectedInvocations), m.IncrementVotesMock.expectedInvocationsOrigin, afterIncrementVotesCounter)
+	}
+}
+
+type mReviewRepositoryMockListAllReviews struct {
+	optional           bool
+	mock               *ReviewRepositoryMock
+	defaultExpectation *ReviewRepositoryMockListAllReviewsExpectation
+	expectations       []*ReviewRepositoryMockListAllReviewsExpectation
+
+	callArgs []*ReviewRepositoryMockListAllReviewsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReviewRepositoryMockListAllReviewsExpectation specifies expectation struct of the ReviewRepository.ListAllReviews
+type ReviewRepositoryMockListAllReviewsExpectation struct {
+	mock               *ReviewRepositoryMock
+	params             *ReviewRepositoryMockListAllReviewsParams
+	paramPtrs          *ReviewRepositoryMockListAllReviewsParamPtrs
+	expectationOrigins ReviewRepositoryMockListAllReviewsExpectationOrigins
+	results            *ReviewRepositoryMockListAllReviewsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReviewRepositoryMockListAllReviewsParams contains parameters of the ReviewRepository.ListAllReviews
+type ReviewRepositoryMockListAllReviewsParams struct {
+	ctx context.Context
+	ID  string
+}
+
+// ReviewRepositoryMockListAllReviewsParamPtrs contains pointers to parameters of the ReviewRepository.ListAllReviews
+type ReviewRepositoryMockListAllReviewsParamPtrs struct {
+	ctx *context.Context
+	ID  *string
+}
+
+// ReviewRepositoryMockListAllReviewsResults contains results of the ReviewRepository.ListAllReviews
+type ReviewRepositoryMockListAllReviewsResults struct {
+	ra1 []mm_repository.Review
+	err error
+}
+
+// ReviewRepositoryMockListAllReviewsOrigins contains origins of expectations of the ReviewRepository.ListAllReviews
+type ReviewRepositoryMockListAllReviewsExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originID  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAllReviews *mReviewRepositoryMockListAllReviews) Optional() *mReviewRepositoryMockListAllReviews {
+	mmListAllReviews.optional = true
+	return mmListAllReviews
+}
+
+// Expect sets up expected params for ReviewRepository.ListAllReviews
+func (mmListAllReviews *mReviewRepositoryMockListAllReviews) Expect(ctx context.Context, ID string) *mReviewRepositoryMockListAllReviews {
+	if mmListAllReviews.mock.funcListAllReviews != nil {
+		mmListAllReviews.mock.t.Fatalf("ReviewRepositoryMock.ListAllReviews mock is already set by Set")
+	}
+
+	if mmListAllReviews.defaultExpectation == nil {
+		mmListAllReviews.defaultExpectation = &ReviewRepositoryMockListAllReviewsExpectation{}
+	}
+
+	if mmListAllReviews.defaultExpectation.paramPtrs != nil {
+		mmListAllReviews.mock.t.Fatalf("ReviewRepositoryMock.ListAllReviews mock is already set by ExpectParams functions")
+	}
+
+	mmListAllReviews.defaultExpectation.params = &ReviewRepositoryMockListAllReviewsParams{ctx, ID}
+	mmListAllReviews.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListAllReviews.expectations {
+		if minimock.Equal(e.params, mmListAllReviews.defaultExpectation.params) {
+			mmListAllReviews.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAllReviews.defaultExpectation.params)
+		}
+	}
+
+	return mmListAllReviews
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReviewRepository.ListAllReviews
+func (mmListAllReviews *mReviewRepositoryMockListAllReviews) ExpectCtxParam1(ctx context.Context) *mReviewRepositoryMockListAllReviews {
+	if mmListAllReviews.mock.funcListAllReviews != nil {
+		mmListAllReviews.mock.t.Fatalf("ReviewRepositoryMock.ListAllReviews mock is already set by Set")
+	}
+
+	if mmListAllReviews.defaultExpectation == nil {
+		mmListAllReviews.defaultExpectation = &ReviewRepositoryMockListAllReviewsExpectation{}
+	}
+
+	if mmListAllReviews.defaultExpectation.params != nil {
+		mmListAllReviews.mock.t.Fatalf("ReviewRepositoryMock.ListAllReviews mock is already set by Expect")
+	}
+
+	if mmListAllReviews.defaultExpectation.paramPtrs == nil {
+		mmListAllReviews.defaultExpectation.paramPtrs = &ReviewRepositoryMockListAllReviewsParamPtrs{}
+	}
+	mmListAllReviews.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListAllReviews.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListAllReviews
+}
+
+// ExpectIDParam2 sets up expected param ID for ReviewRepository.ListAllReviews
+func (mmListAllReviews *mReviewRepositoryMockListAllReviews) ExpectIDParam2(ID string) *mReviewRepositoryMockListAllReviews {
+	if mmListAllReviews.mock.funcListAllReviews != nil {
+		mmListAllReviews.mock.t.Fatalf("ReviewRepositoryMock.ListAllReviews mock is already set by Set")
+	}
+
+	if mmListAllReviews.defaultExpectation == nil {
+		mmListAllReviews.defaultExpectation = &ReviewRepositoryMockListAllReviewsExpectation{}
+	}
+
+	if mmListAllReviews.defaultExpectation.params != nil {
+		mmListAllReviews.mock.t.Fatalf("ReviewRepositoryMock.ListAllReviews mock is already set by Expect")
+	}
+
+	if mmListAllReviews.defaultExpectation.paramPtrs == nil {
+		mmListAllReviews.defaultExpectation.paramPtrs = &ReviewRepositoryMockListAllReviewsParamPtrs{}
+	}
+	mmListAllReviews.defaultExpectation.paramPtrs.ID = &ID
+	mmListAllReviews.defaultExpectation.expectationOrigins.originID = minimock.CallerInfo(1)
+
+	return mmListAllReviews
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReviewRepository.ListAllReviews
+func (mmListAllReviews *mReviewRepositoryMockListAllReviews) Inspect(f func(ctx context.Context, ID string)) *mReviewRepositoryMockListAllReviews {
+	if mmListAllReviews.mock.inspectFuncListAllReviews != nil {
+		mmListAllReviews.mock.t.Fatalf("Inspect function is already set for ReviewRepositoryMock.ListAllReviews")
+	}
+
+	mmListAllReviews.mock.inspectFuncListAllReviews = f
+
+	return mmListAllReviews
+}
+
+// Return sets up results that will be returned by ReviewRepository.ListAllReviews
+func (mmListAllReviews *mReviewRepositoryMockListAllReviews) Return(ra1 []mm_repository.Review, err error) *ReviewRepositoryMock {
+	if mmListAllReviews.mock.funcListAllReviews != nil {
+		mmListAllReviews.mock.t.Fatalf("ReviewRepositoryMock.ListAllReviews mock is already set by Set")
+	}
+
+	if mmListAllReviews.defaultExpectation == nil {
+		mmListAllReviews.defaultExpectation = &ReviewRepositoryMockListAllReviewsExpectation{mock: mmListAllReviews.mock}
+	}
+	mmListAllReviews.defaultExpectation.results = &ReviewRepositoryMockListAllReviewsResults{ra1, err}
+	mmListAllReviews.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListAllReviews.mock
+}
+
+// Set uses given function f to mock the ReviewRepository.ListAllReviews method
+func (mmListAllReviews *mReviewRepositoryMockListAllReviews) Set(f func(ctx context.Context, ID string) (ra1 []mm_repository.Review, err error)) *ReviewRepositoryMock {
+	if mmListAllReviews.defaultExpectation != nil {
+		mmListAllReviews.mock.t.Fatalf("Default expectation is already set for the ReviewRepository.ListAllReviews method")
+	}
+
+	if len(mmListAllReviews.expectations) > 0 {
+		mmListAllReviews.mock.t.Fatalf("Some expectations are already set for the ReviewRepository.ListAllReviews method")
+	}
+
+	mmListAllReviews.mock.funcListAllReviews = f
+	mmListAllReviews.mock.funcListAllReviewsOrigin = minimock.CallerInfo(1)
+	return mmListAllReviews.mock
+}
+
+// When sets expectation for the ReviewRepository.ListAllReviews which will trigger the result defined by the following
+// Then helper
+func (mmListAllReviews *mReviewRepositoryMockListAllReviews) When(ctx context.Context, ID string) *ReviewRepositoryMockListAllReviewsExpectation {
+	if mmListAllReviews.mock.funcListAllReviews != nil {
+		mmListAllReviews.mock.t.Fatalf("ReviewRepositoryMock.ListAllReviews mock is already set by Set")
+	}
+
+	expectation := &ReviewRepositoryMockListAllReviewsExpectation{
+		mock:               mmListAllReviews.mock,
+		params:             &ReviewRepositoryMockListAllReviewsParams{ctx, ID},
+		expectationOrigins: ReviewRepositoryMockListAllReviewsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListAllReviews.expectations = append(mmListAllReviews.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReviewRepository.ListAllReviews return parameters for the expectation previously defined by the When method
+func (e *ReviewRepositoryMockListAllReviewsExpectation) Then(ra1 []mm_repository.Review, err error) *ReviewRepositoryMock {
+	e.results = &ReviewRepositoryMockListAllReviewsResults{ra1, err}
+	return e.mock
+}
+
+// Times sets number of times ReviewRepository.ListAllReviews should be invoked
+func (mmListAllReviews *mReviewRepositoryMockListAllReviews) Times(n uint64) *mReviewRepositoryMockListAllReviews {
+	if n == 0 {
+		mmListAllReviews.mock.t.Fatalf("Times of ReviewRepositoryMock.ListAllReviews mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAllReviews.expectedInvocations, n)
+	mmListAllReviews.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListAllReviews
+}
+
+func (mmListAllReviews *mReviewRepositoryMockListAllReviews) invocationsDone() bool {
+	if len(mmListAllReviews.expectations) == 0 && mmListAllReviews.defaultExpectation == nil && mmListAllReviews.mock.funcListAllReviews == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAllReviews.mock.afterListAllReviewsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAllReviews.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAllReviews implements mm_repository.ReviewRepository
+func (mmListAllReviews *ReviewRepositoryMock) ListAllReviews(ctx context.Context, ID string) (ra1 []mm_repository.Review, err error) {
+	mm_atomic.AddUint64(&mmListAllReviews.beforeListAllReviewsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAllReviews.afterListAllReviewsCounter, 1)
+
+	mmListAllReviews.t.Helper()
+
+	if mmListAllReviews.inspectFuncListAllReviews != nil {
+		mmListAllReviews.inspectFuncListAllReviews(ctx, ID)
+	}
+
+	mm_params := ReviewRepositoryMockListAllReviewsParams{ctx, ID}
+
+	// Record call args
+	mmListAllReviews.ListAllReviewsMock.mutex.Lock()
+	mmListAllReviews.ListAllReviewsMock.callArgs = append(mmListAllReviews.ListAllReviewsMock.callArgs, &mm_params)
+	mmListAllReviews.ListAllReviewsMock.mutex.Unlock()
+
+	for _, e := range mmListAllReviews.ListAllReviewsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmListAllReviews.ListAllReviewsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAllReviews.ListAllReviewsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAllReviews.ListAllReviewsMock.defaultExpectation.params
+		mm_want_ptrs := mmListAllReviews.ListAllReviewsMock.defaultExpectation.paramPtrs
+
+		mm_got := ReviewRepositoryMockListAllReviewsParams{ctx, ID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAllReviews.t.Errorf("ReviewRepositoryMock.ListAllReviews got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAllReviews.ListAllReviewsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ID != nil && !minimock.Equal(*mm_want_ptrs.ID, mm_got.ID) {
+				mmListAllReviews.t.Errorf("ReviewRepositoryMock.ListAllReviews got unexpected parameter ID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAllReviews.ListAllReviewsMock.defaultExpectation.expectationOrigins.originID, *mm_want_ptrs.ID, mm_got.ID, minimock.Diff(*mm_want_ptrs.ID, mm_got.ID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAllReviews.t.Errorf("ReviewRepositoryMock.ListAllReviews got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListAllReviews.ListAllReviewsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAllReviews.ListAllReviewsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAllReviews.t.Fatal("No results are set for the ReviewRepositoryMock.ListAllReviews")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmListAllReviews.funcListAllReviews != nil {
+		return mmListAllReviews.funcListAllReviews(ctx, ID)
+	}
+	mmListAllReviews.t.Fatalf("Unexpected call to ReviewRepositoryMock.ListAllReviews. %v %v", ctx, ID)
+	return
+}
+
+// ListAllReviewsAfterCounter returns a count of finished ReviewRepositoryMock.ListAllReviews invocations
+func (mmListAllReviews *ReviewRepositoryMock) ListAllReviewsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAllReviews.afterListAllReviewsCounter)
+}
+
+// ListAllReviewsBeforeCounter returns a count of ReviewRepositoryMock.ListAllReviews invocations
+func (mmListAllReviews *ReviewRepositoryMock) ListAllReviewsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAllReviews.beforeListAllReviewsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReviewRepositoryMock.ListAllReviews.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAllReviews *mReviewRepositoryMockListAllReviews) Calls() []*ReviewRepositoryMockListAllReviewsParams {
+	mmListAllReviews.mutex.RLock()
+
+	argCopy := make([]*ReviewRepositoryMockListAllReviewsParams, len(mmListAllReviews.callArgs))
+	copy(argCopy, mmListAllReviews.callArgs)
+
+	mmListAllReviews.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAllReviewsDone returns true if the count of the ListAllReviews invocations corresponds
+// the number of defined expectations
+func (m *ReviewRepositoryMock) MinimockListAllReviewsDone() bool {
+	if m.ListAllReviewsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAllReviewsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAllReviewsMock.invocationsDone()
+}
+
+// MinimockListAllReviewsInspect logs each unmet expectation
+func (m *ReviewRepositoryMock) MinimockListAllReviewsInspect() {
+	for _, e := range m.ListAllReviewsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.ListAllReviews at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListAllReviewsCounter := mm_atomic.LoadUint64(&m.afterListAllReviewsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAllReviewsMock.defaultExpectation != nil && afterListAllReviewsCounter < 1 {
+		if m.ListAllReviewsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.ListAllReviews at\n%s", m.ListAllReviewsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.ListAllReviews at\n%s with params: %#v", m.ListAllReviewsMock.defaultExpectation.expectationOrigins.origin, *m.ListAllReviewsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAllReviews != nil && afterListAllReviewsCounter < 1 {
+		m.t.Errorf("Expected call to ReviewRepositoryMock.ListAllReviews at\n%s", m.funcListAllReviewsOrigin)
+	}
+
+	if !m.ListAllReviewsMock.invocationsDone() && afterListAllReviewsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReviewRepositoryMock.ListAllReviews at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAllReviewsMock.expectedInvocations), m.ListAllReviewsMock.expectedInvocationsOrigin, afterListAllReviewsCounter)
+	}
+}
+
+type mReviewRepositoryMockPurgeReview struct {
+	optional           bool
+	mock               *ReviewRepositoryMock
+	defaultExpectation *ReviewRepositoryMockPurgeReviewExpectation
+	expectations       []*ReviewRepositoryMockPurgeReviewExpectation
+
+	callArgs []*ReviewRepositoryMockPurgeReviewParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReviewRepositoryMockPurgeReviewExpectation specifies expectation struct of the ReviewRepository.PurgeReview
+type ReviewRepositoryMockPurgeReviewExpectation struct {
+	mock               *ReviewRepositoryMock
+	params             *ReviewRepositoryMockPurgeReviewParams
+	paramPtrs          *ReviewRepositoryMockPurgeReviewParamPtrs
+	expectationOrigins ReviewRepositoryMockPurgeReviewExpectationOrigins
+	results            *ReviewRepositoryMockPurgeReviewResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReviewRepositoryMockPurgeReviewParams contains parameters of the ReviewRepository.PurgeReview
+type ReviewRepositoryMockPurgeReviewParams struct {
+	ctx     context.Context
+	userID  string
+	movieID string
+	before  time.Time
+}
+
+// ReviewRepositoryMockPurgeReviewParamPtrs contains pointers to parameters of the ReviewRepository.PurgeReview
+type ReviewRepositoryMockPurgeReviewParamPtrs struct {
+	ctx     *context.Context
+	userID  *string
+	movieID *string
+	before  *time.Time
+}
+
+// ReviewRepositoryMockPurgeReviewResults contains results of the ReviewRepository.PurgeReview
+type ReviewRepositoryMockPurgeReviewResults struct {
+	err error
+}
+
+// ReviewRepositoryMockPurgeReviewOrigins contains origins of expectations of the ReviewRepository.PurgeReview
+type ReviewRepositoryMockPurgeReviewExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originMovieID string
+	originBefore  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeReview *mReviewRepositoryMockPurgeReview) Optional() *mReviewRepositoryMockPurgeReview {
+	mmPurgeReview.optional = true
+	return mmPurgeReview
+}
+
+// Expect sets up expected params for ReviewRepository.PurgeReview
+func (mmPurgeReview *mReviewRepositoryMockPurgeReview) Expect(ctx context.Context, userID string, movieID string, before time.Time) *mReviewRepositoryMockPurgeReview {
+	if mmPurgeReview.mock.funcPurgeReview != nil {
+		mmPurgeReview.mock.t.Fatalf("ReviewRepositoryMock.PurgeReview mock is already set by Set")
+	}
+
+	if mmPurgeReview.defaultExpectation == nil {
+		mmPurgeReview.defaultExpectation = &ReviewRepositoryMockPurgeReviewExpectation{}
+	}
+
+	if mmPurgeReview.defaultExpectation.paramPtrs != nil {
+		mmPurgeReview.mock.t.Fatalf("ReviewRepositoryMock.PurgeReview mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeReview.defaultExpectation.params = &ReviewRepositoryMockPurgeReviewParams{ctx, userID, movieID, before}
+	mmPurgeReview.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeReview.expectations {
+		if minimock.Equal(e.params, mmPurgeReview.defaultExpectation.params) {
+			mmPurgeReview.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeReview.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeReview
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReviewRepository.PurgeReview
+func (mmPurgeReview *mReviewRepositoryMockPurgeReview) ExpectCtxParam1(ctx context.Context) *mReviewRepositoryMockPurgeReview {
+	if mmPurgeReview.mock.funcPurgeReview != nil {
+		mmPurgeReview.mock.t.Fatalf("ReviewRepositoryMock.PurgeReview mock is already set by Set")
+	}
+
+	if mmPurgeReview.defaultExpectation == nil {
+		mmPurgeReview.defaultExpectation = &ReviewRepositoryMockPurgeReviewExpectation{}
+	}
+
+	if mmPurgeReview.defaultExpectation.params != nil {
+		mmPurgeReview.mock.t.Fatalf("ReviewRepositoryMock.PurgeReview mock is already set by Expect")
+	}
+
+	if mmPurgeReview.defaultExpectation.paramPtrs == nil {
+		mmPurgeReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockPurgeReviewParamPtrs{}
+	}
+	mmPurgeReview.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeReview.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeReview
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ReviewRepository.PurgeReview
+func (mmPurgeReview *mReviewRepositoryMockPurgeReview) ExpectUserIDParam2(userID string) *mReviewRepositoryMockPurgeReview {
+	if mmPurgeReview.mock.funcPurgeReview != nil {
+		mmPurgeReview.mock.t.Fatalf("ReviewRepositoryMock.PurgeReview mock is already set by Set")
+	}
+
+	if mmPurgeReview.defaultExpectation == nil {
+		mmPurgeReview.defaultExpectation = &ReviewRepositoryMockPurgeReviewExpectation{}
+	}
+
+	if mmPurgeReview.defaultExpectation.params != nil {
+		mmPurgeReview.mock.t.Fatalf("ReviewRepositoryMock.PurgeReview mock is already set by Expect")
+	}
+
+	if mmPurgeReview.defaultExpectation.paramPtrs == nil {
+		mmPurgeReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockPurgeReviewParamPtrs{}
+	}
+	mmPurgeReview.defaultExpectation.paramPtrs.userID = &userID
+	mmPurgeReview.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmPurgeReview
+}
+
+// ExpectMovieIDParam3 sets up expected param movieID for ReviewRepository.PurgeReview
+func (mmPurgeReview *mReviewRepositoryMockPurgeReview) ExpectMovieIDParam3(movieID string) *mReviewRepositoryMockPurgeReview {
+	if mmPurgeReview.mock.funcPurgeReview != nil {
+		mmPurgeReview.mock.t.Fatalf("ReviewRepositoryMock.PurgeReview mock is already set by Set")
+	}
+
+	if mmPurgeReview.defaultExpectation == nil {
+		mmPurgeReview.defaultExpectation = &ReviewRepositoryMockPurgeReviewExpectation{}
+	}
+
+	if mmPurgeReview.defaultExpectation.params != nil {
+		mmPurgeReview.mock.t.Fatalf("ReviewRepositoryMock.PurgeReview mock is already set by Expect")
+	}
+
+	if mmPurgeReview.defaultExpectation.paramPtrs == nil {
+		mmPurgeReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockPurgeReviewParamPtrs{}
+	}
+	mmPurgeReview.defaultExpectation.paramPtrs.movieID = &movieID
+	mmPurgeReview.defaultExpectation.expectationOrigins.originMovieID = minimock.CallerInfo(1)
+
+	return mmPurgeReview
+}
+
+// ExpectBeforeParam4 sets up expected param before for ReviewRepository.PurgeReview
+func (mmPurgeReview *mReviewRepositoryMockPurgeReview) ExpectBeforeParam4(before time.Time) *mReviewRepositoryMockPurgeReview {
+	if mmPurgeReview.mock.funcPurgeReview != nil {
+		mmPurgeReview.mock.t.Fatalf("ReviewRepositoryMock.PurgeReview mock is already set by Set")
+	}
+
+	if mmPurgeReview.defaultExpectation == nil {
+		mmPurgeReview.defaultExpectation = &ReviewRepositoryMockPurgeReviewExpectation{}
+	}
+
+	if mmPurgeReview.defaultExpectation.params != nil {
+		mmPurgeReview.mock.t.Fatalf("ReviewRepositoryMock.PurgeReview mock is already set by Expect")
+	}
+
+	if mmPurgeReview.defaultExpectation.paramPtrs == nil {
+		mmPurgeReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockPurgeReviewParamPtrs{}
+	}
+	mmPurgeReview.defaultExpectation.paramPtrs.before = &before
+	mmPurgeReview.defaultExpectation.expectationOrigins.originBefore = minimock.CallerInfo(1)
+
+	return mmPurgeReview
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReviewRepository.PurgeReview
+func (mmPurgeReview *mReviewRepositoryMockPurgeReview) Inspect(f func(ctx context.Context, userID string, movieID string, before time.Time)) *mReviewRepositoryMockPurgeReview {
+	if mmPurgeReview.mock.inspectFuncPurgeReview != nil {
+		mmPurgeReview.mock.t.Fatalf("Inspect function is already set for ReviewRepositoryMock.PurgeReview")
+	}
+
+	mmPurgeReview.mock.inspectFuncPurgeReview = f
+
+	return mmPurgeReview
+}
+
+// Return sets up results that will be returned by ReviewRepository.PurgeReview
+func (mmPurgeReview *mReviewRepositoryMockPurgeReview) Return(err error) *ReviewRepositoryMock {
+	if mmPurgeReview.mock.funcPurgeReview != nil {
+		mmPurgeReview.mock.t.Fatalf("ReviewRepositoryMock.PurgeReview mock is already set by Set")
+	}
+
+	if mmPurgeReview.defaultExpectation == nil {
+		mmPurgeReview.defaultExpectation = &ReviewRepositoryMockPurgeReviewExpectation{mock: mmPurgeReview.mock}
+	}
+	mmPurgeReview.defaultExpectation.results = &ReviewRepositoryMockPurgeReviewResults{err}
+	mmPurgeReview.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeReview.mock
+}
+
+// Set uses given function f to mock the ReviewRepository.PurgeReview method
+func (mmPurgeReview *mReviewRepositoryMockPurgeReview) Set(f func(ctx context.Context, userID string, movieID string, before time.Time) (err error)) *ReviewRepositoryMock {
+	if mmPurgeReview.defaultExpectation != nil {
+		mmPurgeReview.mock.t.Fatalf("Default expectation is already set for the ReviewRepository.PurgeReview method")
+	}
+
+	if len(mmPurgeReview.expectations) > 0 {
+		mmPurgeReview.mock.t.Fatalf("Some expectations are already set for the ReviewRepository.PurgeReview method")
+	}
+
+	mmPurgeReview.mock.funcPurgeReview = f
+	mmPurgeReview.mock.funcPurgeReviewOrigin = minimock.CallerInfo(1)
+	return mmPurgeReview.mock
+}
+
+// When sets expectation for the ReviewRepository.PurgeReview which will trigger the result defined by the following
+// Then helper
+func (mmPurgeReview *mReviewRepositoryMockPurgeReview) When(ctx context.Context, userID string, movieID string, before time.Time) *ReviewRepositoryMockPurgeReviewExpectation {
+	if mmPurgeReview.mock.funcPurgeReview != nil {
+		mmPurgeReview.mock.t.Fatalf("ReviewRepositoryMock.PurgeReview mock is already set by Set")
+	}
+
+	expectation := &ReviewRepositoryMockPurgeReviewExpectation{
+		mock:               mmPurgeReview.mock,
+		params:             &ReviewRepositoryMockPurgeReviewParams{ctx, userID, movieID, before},
+		expectationOrigins: ReviewRepositoryMockPurgeReviewExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeReview.expectations = append(mmPurgeReview.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReviewRepository.PurgeReview return parameters for the expectation previously defined by the When method
+func (e *ReviewRepositoryMockPurgeReviewExpectation) Then(err error) *ReviewRepositoryMock {
+	e.results = &ReviewRepositoryMockPurgeReviewResults{err}
+	return e.mock
+}
+
+// Times sets number of times ReviewRepository.PurgeReview should be invoked
+func (mmPurgeReview *mReviewRepositoryMockPurgeReview) Times(n uint64) *mReviewRepositoryMockPurgeReview {
+	if n == 0 {
+		mmPurgeReview.mock.t.Fatalf("Times of ReviewRepositoryMock.PurgeReview mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeReview.expectedInvocations, n)
+	mmPurgeReview.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeReview
+}
+
+func (mmPurgeReview *mReviewRepositoryMockPurgeReview) invocationsDone() bool {
+	if len(mmPurgeReview.expectations) == 0 && mmPurgeReview.defaultExpectation == nil && mmPurgeReview.mock.funcPurgeReview == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeReview.mock.afterPurgeReviewCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeReview.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeReview implements mm_repository.ReviewRepository
+func (mmPurgeReview *ReviewRepositoryMock) PurgeReview(ctx context.Context, userID string, movieID string, before time.Time) (err error) {
+	mm_atomic.AddUint64(&mmPurgeReview.beforePurgeReviewCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeReview.afterPurgeReviewCounter, 1)
+
+	mmPurgeReview.t.Helper()
+
+	if mmPurgeReview.inspectFuncPurgeReview != nil {
+		mmPurgeReview.inspectFuncPurgeReview(ctx, userID, movieID, before)
+	}
+
+	mm_params := ReviewRepositoryMockPurgeReviewParams{ctx, userID, movieID, before}
+
+	// Record call args
+	mmPurgeReview.PurgeReviewMock.mutex.Lock()
+	mmPurgeReview.PurgeReviewMock.callArgs = append(mmPurgeReview.PurgeReviewMock.callArgs, &mm_params)
+	mmPurgeReview.PurgeReviewMock.mutex.Unlock()
+
+	for _, e := range mmPurgeReview.PurgeReviewMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPurgeReview.PurgeReviewMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeReview.PurgeReviewMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeReview.PurgeReviewMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeReview.PurgeReviewMock.defaultExpectation.paramPtrs
+
+		mm_got := ReviewRepositoryMockPurgeReviewParams{ctx, userID, movieID, before}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeReview.t.Errorf("ReviewRepositoryMock.PurgeReview got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeReview.PurgeReviewMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmPurgeReview.t.Errorf("ReviewRepositoryMock.PurgeReview got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeReview.PurgeReviewMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.movieID != nil && !minimock.Equal(*mm_want_ptrs.movieID, mm_got.movieID) {
+				mmPurgeReview.t.Errorf("ReviewRepositoryMock.PurgeReview got unexpected parameter movieID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeReview.PurgeReviewMock.defaultExpectation.expectationOrigins.originMovieID, *mm_want_ptrs.movieID, mm_got.movieID, minimock.Diff(*mm_want_ptrs.movieID, mm_got.movieID))
+			}
+
+			if mm_want_ptrs.before != nil && !minimock.Equal(*mm_want_ptrs.before, mm_got.before) {
+				mmPurgeReview.t.Errorf("ReviewRepositoryMock.PurgeReview got unexpected parameter before, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeReview.PurgeReviewMock.defaultExpectation.expectationOrigins.originBefore, *mm_want_ptrs.before, mm_got.before, minimock.Diff(*mm_want_ptrs.before, mm_got.before))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeReview.t.Errorf("ReviewRepositoryMock.PurgeReview got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeReview.PurgeReviewMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeReview.PurgeReviewMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeReview.t.Fatal("No results are set for the ReviewRepositoryMock.PurgeReview")
+		}
+		return (*mm_results).err
+	}
+	if mmPurgeReview.funcPurgeReview != nil {
+		return mmPurgeReview.funcPurgeReview(ctx, userID, movieID, before)
+	}
+	mmPurgeReview.t.Fatalf("Unexpected call to ReviewRepositoryMock.PurgeReview. %v %v %v %v", ctx, userID, movieID, before)
+	return
+}
+
+// PurgeReviewAfterCounter returns a count of finished ReviewRepositoryMock.PurgeReview invocations
+func (mmPurgeReview *ReviewRepositoryMock) PurgeReviewAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeReview.afterPurgeReviewCounter)
+}
+
+// PurgeReviewBeforeCounter returns a count of ReviewRepositoryMock.PurgeReview invocations
+func (mmPurgeReview *ReviewRepositoryMock) PurgeReviewBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeReview.beforePurgeReviewCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReviewRepositoryMock.PurgeReview.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeReview *mReviewRepositoryMockPurgeReview) Calls() []*ReviewRepositoryMockPurgeReviewParams {
+	mmPurgeReview.mutex.RLock()
+
+	argCopy := make([]*ReviewRepositoryMockPurgeReviewParams, len(mmPurgeReview.callArgs))
+	copy(argCopy, mmPurgeReview.callArgs)
+
+	mmPurgeReview.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeReviewDone returns true if the count of the PurgeReview invocations corresponds
+// the number of defined expectations
+func (m *ReviewRepositoryMock) MinimockPurgeReviewDone() bool {
+	if m.PurgeReviewMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeReviewMock.invocationsDone()
+}
+
+// MinimockPurgeReviewInspect logs each unmet expectation
+func (m *ReviewRepositoryMock) MinimockPurgeReviewInspect() {
+	for _, e := range m.PurgeReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.PurgeReview at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeReviewCounter := mm_atomic.LoadUint64(&m.afterPurgeReviewCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeReviewMock.defaultExpectation != nil && afterPurgeReviewCounter < 1 {
+		if m.PurgeReviewMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.PurgeReview at\n%s", m.PurgeReviewMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.PurgeReview at\n%s with params: %#v", m.PurgeReviewMock.defaultExpectation.expectationOrigins.origin, *m.PurgeReviewMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeReview != nil && afterPurgeReviewCounter < 1 {
+		m.t.Errorf("Expected call to ReviewRepositoryMock.PurgeReview at\n%s", m.funcPurgeReviewOrigin)
+	}
+
+	if !m.PurgeReviewMock.invocationsDone() && afterPurgeReviewCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReviewRepositoryMock.PurgeReview at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeReviewMock.expectedInvocations), m.PurgeReviewMock.expectedInvocationsOrigin, afterPurgeReviewCounter)
+	}
+}
+
+type mReviewRepositoryMockRestoreReview struct {
+	optional           bool
+	mock               *ReviewRepositoryMock
+	defaultExpectation *ReviewRepositoryMockRestoreReviewExpectation
+	expectations       []*ReviewRepositoryMockRestoreReviewExpectation
+
+	callArgs []*ReviewRepositoryMockRestoreReviewParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReviewRepositoryMockRestoreReviewExpectation specifies expectation struct of the ReviewRepository.RestoreReview
+type ReviewRepositoryMockRestoreReviewExpectation struct {
+	mock               *ReviewRepositoryMock
+	params             *ReviewRepositoryMockRestoreReviewParams
+	paramPtrs          *ReviewRepositoryMockRestoreReviewParamPtrs
+	expectationOrigins ReviewRepositoryMockRestoreReviewExpectationOrigins
+	results            *ReviewRepositoryMockRestoreReviewResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReviewRepositoryMockRestoreReviewParams contains parameters of the ReviewRepository.RestoreReview
+type ReviewRepositoryMockRestoreReviewParams struct {
+	ctx     context.Context
+	userID  string
+	movieID string
+}
+
+// ReviewRepositoryMockRestoreReviewParamPtrs contains pointers to parameters of the ReviewRepository.RestoreReview
+type ReviewRepositoryMockRestoreReviewParamPtrs struct {
+	ctx     *context.Context
+	userID  *string
+	movieID *string
+}
+
+// ReviewRepositoryMockRestoreReviewResults contains results of the ReviewRepository.RestoreReview
+type ReviewRepositoryMockRestoreReviewResults struct {
+	err error
+}
+
+// ReviewRepositoryMockRestoreReviewOrigins contains origins of expectations of the ReviewRepository.RestoreReview
+type ReviewRepositoryMockRestoreReviewExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originMovieID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRestoreReview *mReviewRepositoryMockRestoreReview) Optional() *mReviewRepositoryMockRestoreReview {
+	mmRestoreReview.optional = true
+	return mmRestoreReview
+}
+
+// Expect sets up expected params for ReviewRepository.RestoreReview
+func (mmRestoreReview *mReviewRepositoryMockRestoreReview) Expect(ctx context.Context, userID string, movieID string) *mReviewRepositoryMockRestoreReview {
+	if mmRestoreReview.mock.funcRestoreReview != nil {
+		mmRestoreReview.mock.t.Fatalf("ReviewRepositoryMock.RestoreReview mock is already set by Set")
+	}
+
+	if mmRestoreReview.defaultExpectation == nil {
+		mmRestoreReview.defaultExpectation = &ReviewRepositoryMockRestoreReviewExpectation{}
+	}
+
+	if mmRestoreReview.defaultExpectation.paramPtrs != nil {
+		mmRestoreReview.mock.t.Fatalf("ReviewRepositoryMock.RestoreReview mock is already set by ExpectParams functions")
+	}
+
+	mmRestoreReview.defaultExpectation.params = &ReviewRepositoryMockRestoreReviewParams{ctx, userID, movieID}
+	mmRestoreReview.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRestoreReview.expectations {
+		if minimock.Equal(e.params, mmRestoreReview.defaultExpectation.params) {
+			mmRestoreReview.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRestoreReview.defaultExpectation.params)
+		}
+	}
+
+	return mmRestoreReview
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReviewRepository.RestoreReview
+func (mmRestoreReview *mReviewRepositoryMockRestoreReview) ExpectCtxParam1(ctx context.Context) *mReviewRepositoryMockRestoreReview {
+	if mmRestoreReview.mock.funcRestoreReview != nil {
+		mmRestoreReview.mock.t.Fatalf("ReviewRepositoryMock.RestoreReview mock is already set by Set")
+	}
+
+	if mmRestoreReview.defaultExpectation == nil {
+		mmRestoreReview.defaultExpectation = &ReviewRepositoryMockRestoreReviewExpectation{}
+	}
+
+	if mmRestoreReview.defaultExpectation.params != nil {
+		mmRestoreReview.mock.t.Fatalf("ReviewRepositoryMock.RestoreReview mock is already set by Expect")
+	}
+
+	if mmRestoreReview.defaultExpectation.paramPtrs == nil {
+		mmRestoreReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockRestoreReviewParamPtrs{}
+	}
+	mmRestoreReview.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRestoreReview.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRestoreReview
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ReviewRepository.RestoreReview
+func (mmRestoreReview *mReviewRepositoryMockRestoreReview) ExpectUserIDParam2(userID string) *mReviewRepositoryMockRestoreReview {
+	if mmRestoreReview.mock.funcRestoreReview != nil {
+		mmRestoreReview.mock.t.Fatalf("ReviewRepositoryMock.RestoreReview mock is already set by Set")
+	}
+
+	if mmRestoreReview.defaultExpectation == nil {
+		mmRestoreReview.defaultExpectation = &ReviewRepositoryMockRestoreReviewExpectation{}
+	}
+
+	if mmRestoreReview.defaultExpectation.params != nil {
+		mmRestoreReview.mock.t.Fatalf("ReviewRepositoryMock.RestoreReview mock is already set by Expect")
+	}
+
+	if mmRestoreReview.defaultExpectation.paramPtrs == nil {
+		mmRestoreReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockRestoreReviewParamPtrs{}
+	}
+	mmRestoreReview.defaultExpectation.paramPtrs.userID = &userID
+	mmRestoreReview.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRestoreReview
+}
+
+// ExpectMovieIDParam3 sets up expected param movieID for ReviewRepository.RestoreReview
+func (mmRestoreReview *mReviewRepositoryMockRestoreReview) ExpectMovieIDParam3(movieID string) *mReviewRepositoryMockRestoreReview {
+	if mmRestoreReview.mock.funcRestoreReview != nil {
+		mmRestoreReview.mock.t.Fatalf("ReviewRepositoryMock.RestoreReview mock is already set by Set")
+	}
+
+	if mmRestoreReview.defaultExpectation == nil {
+		mmRestoreReview.defaultExpectation = &ReviewRepositoryMockRestoreReviewExpectation{}
+	}
+
+	if mmRestoreReview.defaultExpectation.params != nil {
+		mmRestoreReview.mock.t.Fatalf("ReviewRepositoryMock.RestoreReview mock is already set by Expect")
+	}
+
+	if mmRestoreReview.defaultExpectation.paramPtrs == nil {
+		mmRestoreReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockRestoreReviewParamPtrs{}
+	}
+	mmRestoreReview.defaultExpectation.paramPtrs.movieID = &movieID
+	mmRestoreReview.defaultExpectation.expectationOrigins.originMovieID = minimock.CallerInfo(1)
+
+	return mmRestoreReview
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReviewRepository.RestoreReview
+func (mmRestoreReview *mReviewRepositoryMockRestoreReview) Inspect(f func(ctx context.Context, userID string, movieID string)) *mReviewRepositoryMockRestoreReview {
+	if mmRestoreReview.mock.inspectFuncRestoreReview != nil {
+		mmRestoreReview.mock.t.Fatalf("Inspect function is already set for ReviewRepositoryMock.RestoreReview")
+	}
+
+	mmRestoreReview.mock.inspectFuncRestoreReview = f
+
+	return mmRestoreReview
+}
+
+// Return sets up results that will be returned by ReviewRepository.RestoreReview
+func (mmRestoreReview *mReviewRepositoryMockRestoreReview) Return(err error) *ReviewRepositoryMock {
+	if mmRestoreReview.mock.funcRestoreReview != nil {
+		mmRestoreReview.mock.t.Fatalf("ReviewRepositoryMock.RestoreReview mock is already set by Set")
+	}
+
+	if mmRestoreReview.defaultExpectation == nil {
+		mmRestoreReview.defaultExpectation = &ReviewRepositoryMockRestoreReviewExpectation{mock: mmRestoreReview.mock}
+	}
+	mmRestoreReview.defaultExpectation.results = &ReviewRepositoryMockRestoreReviewResults{err}
+	mmRestoreReview.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRestoreReview.mock
+}
+
+// Set uses given function f to mock the ReviewRepository.RestoreReview method
+func (mmRestoreReview *mReviewRepositoryMockRestoreReview) Set(f func(ctx context.Context, userID string, movieID string) (err error)) *ReviewRepositoryMock {
+	if mmRestoreReview.defaultExpectation != nil {
+		mmRestoreReview.mock.t.Fatalf("Default expectation is already set for the ReviewRepository.RestoreReview method")
+	}
+
+	if len(mmRestoreReview.expectations) > 0 {
+		mmRestoreReview.mock.t.Fatalf("Some expectations are already set for the ReviewRepository.RestoreReview method")
+	}
+
+	mmRestoreReview.mock.funcRestoreReview = f
+	mmRestoreReview.mock.funcRestoreReviewOrigin = minimock.CallerInfo(1)
+	return mmRestoreReview.mock
+}
+
+// When sets expectation for the ReviewRepository.RestoreReview which will trigger the result defined by the following
+// Then helper
+func (mmRestoreReview *mReviewRepositoryMockRestoreReview) When(ctx context.Context, userID string, movieID string) *ReviewRepositoryMockRestoreReviewExpectation {
+	if mmRestoreReview.mock.funcRestoreReview != nil {
+		mmRestoreReview.mock.t.Fatalf("ReviewRepositoryMock.RestoreReview mock is already set by Set")
+	}
+
+	expectation := &ReviewRepositoryMockRestoreReviewExpectation{
+		mock:               mmRestoreReview.mock,
+		params:             &ReviewRepositoryMockRestoreReviewParams{ctx, userID, movieID},
+		expectationOrigins: ReviewRepositoryMockRestoreReviewExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRestoreReview.expectations = append(mmRestoreReview.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReviewRepository.RestoreReview return parameters for the expectation previously defined by the When method
+func (e *ReviewRepositoryMockRestoreReviewExpectation) Then(err error) *ReviewRepositoryMock {
+	e.results = &ReviewRepositoryMockRestoreReviewResults{err}
+	return e.mock
+}
+
+// Times sets number of times ReviewRepository.RestoreReview should be invoked
+func (mmRestoreReview *mReviewRepositoryMockRestoreReview) Times(n uint64) *mReviewRepositoryMockRestoreReview {
+	if n == 0 {
+		mmRestoreReview.mock.t.Fatalf("Times of ReviewRepositoryMock.RestoreReview mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRestoreReview.expectedInvocations, n)
+	mmRestoreReview.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRestoreReview
+}
+
+func (mmRestoreReview *mReviewRepositoryMockRestoreReview) invocationsDone() bool {
+	if len(mmRestoreReview.expectations) == 0 && mmRestoreReview.defaultExpectation == nil && mmRestoreReview.mock.funcRestoreReview == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRestoreReview.mock.afterRestoreReviewCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRestoreReview.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RestoreReview implements mm_repository.ReviewRepository
+func (mmRestoreReview *ReviewRepositoryMock) RestoreReview(ctx context.Context, userID string, movieID string) (err error) {
+	mm_atomic.AddUint64(&mmRestoreReview.beforeRestoreReviewCounter, 1)
+	defer mm_atomic.AddUint64(&mmRestoreReview.afterRestoreReviewCounter, 1)
+
+	mmRestoreReview.t.Helper()
+
+	if mmRestoreReview.inspectFuncRestoreReview != nil {
+		mmRestoreReview.inspectFuncRestoreReview(ctx, userID, movieID)
+	}
+
+	mm_params := ReviewRepositoryMockRestoreReviewParams{ctx, userID, movieID}
+
+	// Record call args
+	mmRestoreReview.RestoreReviewMock.mutex.Lock()
+	mmRestoreReview.RestoreReviewMock.callArgs = append(mmRestoreReview.RestoreReviewMock.callArgs, &mm_params)
+	mmRestoreReview.RestoreReviewMock.mutex.Unlock()
+
+	for _, e := range mmRestoreReview.RestoreReviewMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRestoreReview.RestoreReviewMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRestoreReview.RestoreReviewMock.defaultExpectation.Counter, 1)
+		mm_want := mmRestoreReview.RestoreReviewMock.defaultExpectation.params
+		mm_want_ptrs := mmRestoreReview.RestoreReviewMock.defaultExpectation.paramPtrs
+
+		mm_got := ReviewRepositoryMockRestoreReviewParams{ctx, userID, movieID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRestoreReview.t.Errorf("ReviewRepositoryMock.RestoreReview got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreReview.RestoreReviewMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRestoreReview.t.Errorf("ReviewRepositoryMock.RestoreReview got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreReview.RestoreReviewMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.movieID != nil && !minimock.Equal(*mm_want_ptrs.movieID, mm_got.movieID) {
+				mmRestoreReview.t.Errorf("ReviewRepositoryMock.RestoreReview got unexpected parameter movieID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreReview.RestoreReviewMock.defaultExpectation.expectationOrigins.originMovieID, *mm_want_ptrs.movieID, mm_got.movieID, minimock.Diff(*mm_want_ptrs.movieID, mm_got.movieID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRestoreReview.t.Errorf("ReviewRepositoryMock.RestoreReview got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRestoreReview.RestoreReviewMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRestoreReview.RestoreReviewMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRestoreReview.t.Fatal("No results are set for the ReviewRepositoryMock.RestoreReview")
+		}
+		return (*mm_results).err
+	}
+	if mmRestoreReview.funcRestoreReview != nil {
+		return mmRestoreReview.funcRestoreReview(ctx, userID, movieID)
+	}
+	mmRestoreReview.t.Fatalf("Unexpected call to ReviewRepositoryMock.RestoreReview. %v %v %v", ctx, userID, movieID)
+	return
+}
+
+// RestoreReviewAfterCounter returns a count of finished ReviewRepositoryMock.RestoreReview invocations
+func (mmRestoreReview *ReviewRepositoryMock) RestoreReviewAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestoreReview.afterRestoreReviewCounter)
+}
+
+// RestoreReviewBeforeCounter returns a count of ReviewRepositoryMock.RestoreReview invocations
+func (mmRestoreReview *ReviewRepositoryMock) RestoreReviewBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestoreReview.beforeRestoreReviewCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReviewRepositoryMock.RestoreReview.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRestoreReview *mReviewRepositoryMockRestoreReview) Calls() []*ReviewRepositoryMockRestoreReviewParams {
+	mmRestoreReview.mutex.RLock()
+
+	argCopy := make([]*ReviewRepositoryMockRestoreReviewParams, len(mmRestoreReview.callArgs))
+	copy(argCopy, mmRestoreReview.callArgs)
+
+	mmRestoreReview.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRestoreReviewDone returns true if the count of the RestoreReview invocations corresponds
+// the number of defined expectations
+func (m *ReviewRepositoryMock) MinimockRestoreReviewDone() bool {
+	if m.RestoreReviewMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RestoreReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RestoreReviewMock.invocationsDone()
+}
+
+// MinimockRestoreReviewInspect logs each unmet expectation
+func (m *ReviewRepositoryMock) MinimockRestoreReviewInspect() {
+	for _, e := range m.RestoreReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.RestoreReview at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRestoreReviewCounter := mm_atomic.LoadUint64(&m.afterRestoreReviewCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RestoreReviewMock.defaultExpectation != nil && afterRestoreReviewCounter < 1 {
+		if m.RestoreReviewMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.RestoreReview at\n%s", m.RestoreReviewMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.RestoreReview at\n%s with params: %#v", m.RestoreReviewMock.defaultExpectation.expectationOrigins.origin, *m.RestoreReviewMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRestoreReview != nil && afterRestoreReviewCounter < 1 {
+		m.t.Errorf("Expected call to ReviewRepositoryMock.RestoreReview at\n%s", m.funcRestoreReviewOrigin)
+	}
+
+	if !m.RestoreReviewMock.invocationsDone() && afterRestoreReviewCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReviewRepositoryMock.RestoreReview at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RestoreReviewMock.expectedInvocations), m.RestoreReviewMock.expectedInvocationsOrigin, afterRestoreReviewCounter)
+	}
+}
+
+type mReviewRepositoryMockSetStatus struct {
+	optional           bool
+	mock               *ReviewRepositoryMock
+	defaultExpectation *ReviewRepositoryMockSetStatusExpectation
+	expectations       []*ReviewRepositoryMockSetStatusExpectation
+
+	callArgs []*ReviewRepositoryMockSetStatusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReviewRepositoryMockSetStatusExpectation specifies expectation struct of the ReviewRepository.SetStatus
+type ReviewRepositoryMockSetStatusExpectation struct {
+	mock               *ReviewRepositoryMock
+	params             *ReviewRepositoryMockSetStatusParams
+	paramPtrs          *ReviewRepositoryMockSetStatusParamPtrs
+	expectationOrigins ReviewRepositoryMockSetStatusExpectationOrigins
+	results            *ReviewRepositoryMockSetStatusResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReviewRepositoryMockSetStatusParams contains parameters of the ReviewRepository.SetStatus
+type ReviewRepositoryMockSetStatusParams struct {
+	ctx         context.Context
+	userID      string
+	movieID     string
+	status      mm_repository.ReviewStatus
+	reason      string
+	moderatedAt time.Time
+}
+
+// ReviewRepositoryMockSetStatusParamPtrs contains pointers to parameters of the ReviewRepository.SetStatus
+type ReviewRepositoryMockSetStatusParamPtrs struct {
+	ctx         *context.Context
+	userID      *string
+	movieID     *string
+	status      *mm_repository.ReviewStatus
+	reason      *string
+	moderatedAt *time.Time
+}
+
+// ReviewRepositoryMockSetStatusResults contains results of the ReviewRepository.SetStatus
+type ReviewRepositoryMockSetStatusResults struct {
+	err error
+}
+
+// ReviewRepositoryMockSetStatusOrigins contains origins of expectations of the ReviewRepository.SetStatus
+type ReviewRepositoryMockSetStatusExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originUserID      string
+	originMovieID     string
+	originStatus      string
+	originReason      string
+	originModeratedAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetStatus *mReviewRepositoryMockSetStatus) Optional() *mReviewRepositoryMockSetStatus {
+	mmSetStatus.optional = true
+	return mmSetStatus
+}
+
+// Expect sets up expected params for ReviewRepository.SetStatus
+func (mmSetStatus *mReviewRepositoryMockSetStatus) Expect(ctx context.Context, userID string, movieID string, status mm_repository.ReviewStatus, reason string, moderatedAt time.Time) *mReviewRepositoryMockSetStatus {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("ReviewRepositoryMock.SetStatus mock is already set by Set")
+	}
+
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &ReviewRepositoryMockSetStatusExpectation{}
+	}
+
+	if mmSetStatus.defaultExpectation.paramPtrs != nil {
+		mmSetStatus.mock.t.Fatalf("ReviewRepositoryMock.SetStatus mock is already set by ExpectParams functions")
+	}
+
+	mmSetStatus.defaultExpectation.params = &ReviewRepositoryMockSetStatusParams{ctx, userID, movieID, status, reason, moderatedAt}
+	mmSetStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetStatus.expectations {
+		if minimock.Equal(e.params, mmSetStatus.defaultExpectation.params) {
+			mmSetStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmSetStatus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReviewRepository.SetStatus
+func (mmSetStatus *mReviewRepositoryMockSetStatus) ExpectCtxParam1(ctx context.Context) *mReviewRepositoryMockSetStatus {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("ReviewRepositoryMock.SetStatus mock is already set by Set")
+	}
+
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &ReviewRepositoryMockSetStatusExpectation{}
+	}
+
+	if mmSetStatus.defaultExpectation.params != nil {
+		mmSetStatus.mock.t.Fatalf("ReviewRepositoryMock.SetStatus mock is already set by Expect")
+	}
+
+	if mmSetStatus.defaultExpectation.paramPtrs == nil {
+		mmSetStatus.defaultExpectation.paramPtrs = &ReviewRepositoryMockSetStatusParamPtrs{}
+	}
+	mmSetStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetStatus
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ReviewRepository.SetStatus
+func (mmSetStatus *mReviewRepositoryMockSetStatus) ExpectUserIDParam2(userID string) *mReviewRepositoryMockSetStatus {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("ReviewRepositoryMock.SetStatus mock is already set by Set")
+	}
+
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &ReviewRepositoryMockSetStatusExpectation{}
+	}
+
+	if mmSetStatus.defaultExpectation.params != nil {
+		mmSetStatus.mock.t.Fatalf("ReviewRepositoryMock.SetStatus mock is already set by Expect")
+	}
+
+	if mmSetStatus.defaultExpectation.paramPtrs == nil {
+		mmSetStatus.defaultExpectation.paramPtrs = &ReviewRepositoryMockSetStatusParamPtrs{}
+	}
+	mmSetStatus.defaultExpectation.paramPtrs.userID = &userID
+	mmSetStatus.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmSetStatus
+}
+
+// ExpectMovieIDParam3 sets up expected param movieID for ReviewRepository.SetStatus
+func (mmSetStatus *mReviewRepositoryMockSetStatus) ExpectMovieIDParam3(movieID string) *mReviewRepositoryMockSetStatus {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("ReviewRepositoryMock.SetStatus mock is already set by Set")
+	}
+
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &ReviewRepositoryMockSetStatusExpectation{}
+	}
+
+	if mmSetStatus.defaultExpectation.params != nil {
+		mmSetStatus.mock.t.Fatalf("ReviewRepositoryMock.SetStatus mock is already set by Expect")
+	}
+
+	if mmSetStatus.defaultExpectation.paramPtrs == nil {
+		mmSetStatus.defaultExpectation.paramPtrs = &ReviewRepositoryMockSetStatusParamPtrs{}
+	}
+	mmSetStatus.defaultExpectation.paramPtrs.movieID = &movieID
+	mmSetStatus.defaultExpectation.expectationOrigins.originMovieID = minimock.CallerInfo(1)
+
+	return mmSetStatus
+}
+
+// ExpectStatusParam4 sets up expected param status for ReviewRepository.SetStatus
+func (mmSetStatus *mReviewRepositoryMockSetStatus) ExpectStatusParam4(status mm_repository.ReviewStatus) *mReviewRepositoryMockSetStatus {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("ReviewRepositoryMock.SetStatus mock is already set by Set")
+	}
+
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &ReviewRepositoryMockSetStatusExpectation{}
+	}
+
+	if mmSetStatus.defaultExpectation.params != nil {
+		mmSetStatus.mock.t.Fatalf("ReviewRepositoryMock.SetStatus mock is already set by Expect")
+	}
+
+	if mmSetStatus.defaultExpectation.paramPtrs == nil {
+		mmSetStatus.defaultExpectation.paramPtrs = &ReviewRepositoryMockSetStatusParamPtrs{}
+	}
+	mmSetStatus.defaultExpectation.paramPtrs.status = &status
+	mmSetStatus.defaultExpectation.expectationOrigins.originStatus = minimock.CallerInfo(1)
+
+	return mmSetStatus
+}
+
+// ExpectReasonParam5 sets up expected param reason for ReviewRepository.SetStatus
+func (mmSetStatus *mReviewRepositoryMockSetStatus) ExpectReasonParam5(reason string) *mReviewRepositoryMockSetStatus {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("ReviewRepositoryMock.SetStatus mock is already set by Set")
+	}
+
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &ReviewRepositoryMockSetStatusExpectation{}
+	}
+
+	if mmSetStatus.defaultExpectation.params != nil {
+		mmSetStatus.mock.t.Fatalf("ReviewRepositoryMock.SetStatus mock is already set by Expect")
+	}
+
+	if mmSetStatus.defaultExpectation.paramPtrs == nil {
+		mmSetStatus.defaultExpectation.paramPtrs = &ReviewRepositoryMockSetStatusParamPtrs{}
+	}
+	mmSetStatus.defaultExpectation.paramPtrs.reason = &reason
+	mmSetStatus.defaultExpectation.expectationOrigins.originReason = minimock.CallerInfo(1)
+
+	return mmSetStatus
+}
+
+// ExpectModeratedAtParam6 sets up expected param moderatedAt for ReviewRepository.SetStatus
+func (mmSetStatus *mReviewRepositoryMockSetStatus) ExpectModeratedAtParam6(moderatedAt time.Time) *mReviewRepositoryMockSetStatus {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("ReviewRepositoryMock.SetStatus mock is already set by Set")
+	}
+
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &ReviewRepositoryMockSetStatusExpectation{}
+	}
+
+	if mmSetStatus.defaultExpectation.params != nil {
+		mmSetStatus.mock.t.Fatalf("ReviewRepositoryMock.SetStatus mock is already set by Expect")
+	}
+
+	if mmSetStatus.defaultExpectation.paramPtrs == nil {
+		mmSetStatus.defaultExpectation.paramPtrs = &ReviewRepositoryMockSetStatusParamPtrs{}
+	}
+	mmSetStatus.defaultExpectation.paramPtrs.moderatedAt = &moderatedAt
+	mmSetStatus.defaultExpectation.expectationOrigins.originModeratedAt = minimock.CallerInfo(1)
+
+	return mmSetStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReviewRepository.SetStatus
+func (mmSetStatus *mReviewRepositoryMockSetStatus) Inspect(f func(ctx context.Context, userID string, movieID string, status mm_repository.ReviewStatus, reason string, moderatedAt time.Time)) *mReviewRepositoryMockSetStatus {
+	if mmSetStatus.mock.inspectFuncSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("Inspect function is already set for ReviewRepositoryMock.SetStatus")
+	}
+
+	mmSetStatus.mock.inspectFuncSetStatus = f
+
+	return mmSetStatus
+}
+
+// Return sets up results that will be returned by ReviewRepository.SetStatus
+func (mmSetStatus *mReviewRepositoryMockSetStatus) Return(err error) *ReviewRepositoryMock {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("ReviewRepositoryMock.SetStatus mock is already set by Set")
+	}
+
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &ReviewRepositoryMockSetStatusExpectation{mock: mmSetStatus.mock}
+	}
+	mmSetStatus.defaultExpectation.results = &ReviewRepositoryMockSetStatusResults{err}
+	mmSetStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetStatus.mock
+}
+
+// Set uses given function f to mock the ReviewRepository.SetStatus method
+func (mmSetStatus *mReviewRepositoryMockSetStatus) Set(f func(ctx context.Context, userID string, movieID string, status mm_repository.ReviewStatus, reason string, moderatedAt time.Time) (err error)) *ReviewRepositoryMock {
+	if mmSetStatus.defaultExpectation != nil {
+		mmSetStatus.mock.t.Fatalf("Default expectation is already set for the ReviewRepository.SetStatus method")
+	}
+
+	if len(mmSetStatus.expectations) > 0 {
+		mmSetStatus.mock.t.Fatalf("Some expectations are already set for the ReviewRepository.SetStatus method")
+	}
+
+	mmSetStatus.mock.funcSetStatus = f
+	mmSetStatus.mock.funcSetStatusOrigin = minimock.CallerInfo(1)
+	return mmSetStatus.mock
+}
+
+// When sets expectation for the ReviewRepository.SetStatus which will trigger the result defined by the following
+// Then helper
+func (mmSetStatus *mReviewRepositoryMockSetStatus) When(ctx context.Context, userID string, movieID string, status mm_repository.ReviewStatus, reason string, moderatedAt time.Time) *ReviewRepositoryMockSetStatusExpectation {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("ReviewRepositoryMock.SetStatus mock is already set by Set")
+	}
+
+	expectation := &ReviewRepositoryMockSetStatusExpectation{
+		mock:               mmSetStatus.mock,
+		params:             &ReviewRepositoryMockSetStatusParams{ctx, userID, movieID, status, reason, moderatedAt},
+		expectationOrigins: ReviewRepositoryMockSetStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetStatus.expectations = append(mmSetStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReviewRepository.SetStatus return parameters for the expectation previously defined by the When method
+func (e *ReviewRepositoryMockSetStatusExpectation) Then(err error) *ReviewRepositoryMock {
+	e.results = &ReviewRepositoryMockSetStatusResults{err}
+	return e.mock
+}
+
+// Times sets number of times ReviewRepository.SetStatus should be invoked
+func (mmSetStatus *mReviewRepositoryMockSetStatus) Times(n uint64) *mReviewRepositoryMockSetStatus {
+	if n == 0 {
+		mmSetStatus.mock.t.Fatalf("Times of ReviewRepositoryMock.SetStatus mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetStatus.expectedInvocations, n)
+	mmSetStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetStatus
+}
+
+func (mmSetStatus *mReviewRepositoryMockSetStatus) invocationsDone() bool {
+	if len(mmSetStatus.expectations) == 0 && mmSetStatus.defaultExpectation == nil && mmSetStatus.mock.funcSetStatus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetStatus.mock.afterSetStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetStatus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetStatus implements mm_repository.ReviewRepository
+func (mmSetStatus *ReviewRepositoryMock) SetStatus(ctx context.Context, userID string, movieID string, status mm_repository.ReviewStatus, reason string, moderatedAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmSetStatus.beforeSetStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetStatus.afterSetStatusCounter, 1)
+
+	mmSetStatus.t.Helper()
+
+	if mmSetStatus.inspectFuncSetStatus != nil {
+		mmSetStatus.inspectFuncSetStatus(ctx, userID, movieID, status, reason, moderatedAt)
+	}
+
+	mm_params := ReviewRepositoryMockSetStatusParams{ctx, userID, movieID, status, reason, moderatedAt}
+
+	// Record call args
+	mmSetStatus.SetStatusMock.mutex.Lock()
+	mmSetStatus.SetStatusMock.callArgs = append(mmSetStatus.SetStatusMock.callArgs, &mm_params)
+	mmSetStatus.SetStatusMock.mutex.Unlock()
+
+	for _, e := range mmSetStatus.SetStatusMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetStatus.SetStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetStatus.SetStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetStatus.SetStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmSetStatus.SetStatusMock.defaultExpectation.paramPtrs
+
+		mm_got := ReviewRepositoryMockSetStatusParams{ctx, userID, movieID, status, reason, moderatedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetStatus.t.Errorf("ReviewRepositoryMock.SetStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetStatus.SetStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSetStatus.t.Errorf("ReviewRepositoryMock.SetStatus got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetStatus.SetStatusMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.movieID != nil && !minimock.Equal(*mm_want_ptrs.movieID, mm_got.movieID) {
+				mmSetStatus.t.Errorf("ReviewRepositoryMock.SetStatus got unexpected parameter movieID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetStatus.SetStatusMock.defaultExpectation.expectationOrigins.originMovieID, *mm_want_ptrs.movieID, mm_got.movieID, minimock.Diff(*mm_want_ptrs.movieID, mm_got.movieID))
+			}
+
+			if mm_want_ptrs.status != nil && !minimock.Equal(*mm_want_ptrs.status, mm_got.status) {
+				mmSetStatus.t.Errorf("ReviewRepositoryMock.SetStatus got unexpected parameter status, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetStatus.SetStatusMock.defaultExpectation.expectationOrigins.originStatus, *mm_want_ptrs.status, mm_got.status, minimock.Diff(*mm_want_ptrs.status, mm_got.status))
+			}
+
+			if mm_want_ptrs.reason != nil && !minimock.Equal(*mm_want_ptrs.reason, mm_got.reason) {
+				mmSetStatus.t.Errorf("ReviewRepositoryMock.SetStatus got unexpected parameter reason, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetStatus.SetStatusMock.defaultExpectation.expectationOrigins.originReason, *mm_want_ptrs.reason, mm_got.reason, minimock.Diff(*mm_want_ptrs.reason, mm_got.reason))
+			}
+
+			if mm_want_ptrs.moderatedAt != nil && !minimock.Equal(*mm_want_ptrs.moderatedAt, mm_got.moderatedAt) {
+				mmSetStatus.t.Errorf("ReviewRepositoryMock.SetStatus got unexpected parameter moderatedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetStatus.SetStatusMock.defaultExpectation.expectationOrigins.originModeratedAt, *mm_want_ptrs.moderatedAt, mm_got.moderatedAt, minimock.Diff(*mm_want_ptrs.moderatedAt, mm_got.moderatedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetStatus.t.Errorf("ReviewRepositoryMock.SetStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetStatus.SetStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetStatus.SetStatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetStatus.t.Fatal("No results are set for the ReviewRepositoryMock.SetStatus")
+		}
+		return (*mm_results).err
+	}
+	if mmSetStatus.funcSetStatus != nil {
+		return mmSetStatus.funcSetStatus(ctx, userID, movieID, status, reason, moderatedAt)
+	}
+	mmSetStatus.t.Fatalf("Unexpected call to ReviewRepositoryMock.SetStatus. %v %v %v %v %v %v", ctx, userID, movieID, status, reason, moderatedAt)
+	return
+}
+
+// SetStatusAfterCounter returns a count of finished ReviewRepositoryMock.SetStatus invocations
+func (mmSetStatus *ReviewRepositoryMock) SetStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetStatus.afterSetStatusCounter)
+}
+
+// SetStatusBeforeCounter returns a count of ReviewRepositoryMock.SetStatus invocations
+func (mmSetStatus *ReviewRepositoryMock) SetStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetStatus.beforeSetStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReviewRepositoryMock.SetStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetStatus *mReviewRepositoryMockSetStatus) Calls() []*ReviewRepositoryMockSetStatusParams {
+	mmSetStatus.mutex.RLock()
+
+	argCopy := make([]*ReviewRepositoryMockSetStatusParams, len(mmSetStatus.callArgs))
+	copy(argCopy, mmSetStatus.callArgs)
+
+	mmSetStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetStatusDone returns true if the count of the SetStatus invocations corresponds
+// the number of defined expectations
+func (m *ReviewRepositoryMock) MinimockSetStatusDone() bool {
+	if m.SetStatusMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetStatusMock.invocationsDone()
+}
+
+// MinimockSetStatusInspect logs each unmet expectation
+func (m *ReviewRepositoryMock) MinimockSetStatusInspect() {
+	for _, e := range m.SetStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.SetStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetStatusCounter := mm_atomic.LoadUint64(&m.afterSetStatusCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetStatusMock.defaultExpectation != nil && afterSetStatusCounter < 1 {
+		if m.SetStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.SetStatus at\n%s", m.SetStatusMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.SetStatus at\n%s with params: %#v", m.SetStatusMock.defaultExpectation.expectationOrigins.origin, *m.SetStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetStatus != nil && afterSetStatusCounter < 1 {
+		m.t.Errorf("Expected call to ReviewRepositoryMock.SetStatus at\n%s", m.funcSetStatusOrigin)
+	}
+
+	if !m.SetStatusMock.invocationsDone() && afterSetStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReviewRepositoryMock.SetStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetStatusMock.expectedInvocations), m.SetStatusMock.expectedInvocationsOrigin, afterSetStatusCounter)
+	}
+}
+
+type mReviewRepositoryMockSoftDeleteReview struct {
+	optional           bool
+	mock               *ReviewRepositoryMock
+	defaultExpectation *ReviewRepositoryMockSoftDeleteReviewExpectation
+	expectations       []*ReviewRepositoryMockSoftDeleteReviewExpectation
+
+	callArgs []*ReviewRepositoryMockSoftDeleteReviewParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReviewRepositoryMockSoftDeleteReviewExpectation specifies expectation struct of the ReviewRepository.SoftDeleteReview
+type ReviewRepositoryMockSoftDeleteReviewExpectation struct {
+	mock               *ReviewRepositoryMock
+	params             *ReviewRepositoryMockSoftDeleteReviewParams
+	paramPtrs          *ReviewRepositoryMockSoftDeleteReviewParamPtrs
+	expectationOrigins ReviewRepositoryMockSoftDeleteReviewExpectationOrigins
+	results            *ReviewRepositoryMockSoftDeleteReviewResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReviewRepositoryMockSoftDeleteReviewParams contains parameters of the ReviewRepository.SoftDeleteReview
+type ReviewRepositoryMockSoftDeleteReviewParams struct {
+	ctx       context.Context
+	userID    string
+	movieID   string
+	deletedAt time.Time
+}
+
+// ReviewRepositoryMockSoftDeleteReviewParamPtrs contains pointers to parameters of the ReviewRepository.SoftDeleteReview
+type ReviewRepositoryMockSoftDeleteReviewParamPtrs struct {
+	ctx       *context.Context
+	userID    *string
+	movieID   *string
+	deletedAt *time.Time
+}
+
+// ReviewRepositoryMockSoftDeleteReviewResults contains results of the ReviewRepository.SoftDeleteReview
+type ReviewRepositoryMockSoftDeleteReviewResults struct {
+	err error
+}
+
+// ReviewRepositoryMockSoftDeleteReviewOrigins contains origins of expectations of the ReviewRepository.SoftDeleteReview
+type ReviewRepositoryMockSoftDeleteReviewExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUserID    string
+	originMovieID   string
+	originDeletedAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSoftDeleteReview *mReviewRepositoryMockSoftDeleteReview) Optional() *mReviewRepositoryMockSoftDeleteReview {
+	mmSoftDeleteReview.optional = true
+	return mmSoftDeleteReview
+}
+
+// Expect sets up expected params for ReviewRepository.SoftDeleteReview
+func (mmSoftDeleteReview *mReviewRepositoryMockSoftDeleteReview) Expect(ctx context.Context, userID string, movieID string, deletedAt time.Time) *mReviewRepositoryMockSoftDeleteReview {
+	if mmSoftDeleteReview.mock.funcSoftDeleteReview != nil {
+		mmSoftDeleteReview.mock.t.Fatalf("ReviewRepositoryMock.SoftDeleteReview mock is already set by Set")
+	}
+
+	if mmSoftDeleteReview.defaultExpectation == nil {
+		mmSoftDeleteReview.defaultExpectation = &ReviewRepositoryMockSoftDeleteReviewExpectation{}
+	}
+
+	if mmSoftDeleteReview.defaultExpectation.paramPtrs != nil {
+		mmSoftDeleteReview.mock.t.Fatalf("ReviewRepositoryMock.SoftDeleteReview mock is already set by ExpectParams functions")
+	}
+
+	mmSoftDeleteReview.defaultExpectation.params = &ReviewRepositoryMockSoftDeleteReviewParams{ctx, userID, movieID, deletedAt}
+	mmSoftDeleteReview.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSoftDeleteReview.expectations {
+		if minimock.Equal(e.params, mmSoftDeleteReview.defaultExpectation.params) {
+			mmSoftDeleteReview.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSoftDeleteReview.defaultExpectation.params)
+		}
+	}
+
+	return mmSoftDeleteReview
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReviewRepository.SoftDeleteReview
+func (mmSoftDeleteReview *mReviewRepositoryMockSoftDeleteReview) ExpectCtxParam1(ctx context.Context) *mReviewRepositoryMockSoftDeleteReview {
+	if mmSoftDeleteReview.mock.funcSoftDeleteReview != nil {
+		mmSoftDeleteReview.mock.t.Fatalf("ReviewRepositoryMock.SoftDeleteReview mock is already set by Set")
+	}
+
+	if mmSoftDeleteReview.defaultExpectation == nil {
+		mmSoftDeleteReview.defaultExpectation = &ReviewRepositoryMockSoftDeleteReviewExpectation{}
+	}
+
+	if mmSoftDeleteReview.defaultExpectation.params != nil {
+		mmSoftDeleteReview.mock.t.Fatalf("ReviewRepositoryMock.SoftDeleteReview mock is already set by Expect")
+	}
+
+	if mmSoftDeleteReview.defaultExpectation.paramPtrs == nil {
+		mmSoftDeleteReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockSoftDeleteReviewParamPtrs{}
+	}
+	mmSoftDeleteReview.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSoftDeleteReview.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSoftDeleteReview
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ReviewRepository.SoftDeleteReview
+func (mmSoftDeleteReview *mReviewRepositoryMockSoftDeleteReview) ExpectUserIDParam2(userID string) *mReviewRepositoryMockSoftDeleteReview {
+	if mmSoftDeleteReview.mock.funcSoftDeleteReview != nil {
+		mmSoftDeleteReview.mock.t.Fatalf("ReviewRepositoryMock.SoftDeleteReview mock is already set by Set")
+	}
+
+	if mmSoftDeleteReview.defaultExpectation == nil {
+		mmSoftDeleteReview.defaultExpectation = &ReviewRepositoryMockSoftDeleteReviewExpectation{}
+	}
+
+	if mmSoftDeleteReview.defaultExpectation.params != nil {
+		mmSoftDeleteReview.mock.t.Fatalf("ReviewRepositoryMock.SoftDeleteReview mock is already set by Expect")
+	}
+
+	if mmSoftDeleteReview.defaultExpectation.paramPtrs == nil {
+		mmSoftDeleteReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockSoftDeleteReviewParamPtrs{}
+	}
+	mmSoftDeleteReview.defaultExpectation.paramPtrs.userID = &userID
+	mmSoftDeleteReview.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmSoftDeleteReview
+}
+
+// ExpectMovieIDParam3 sets up expected param movieID for ReviewRepository.SoftDeleteReview
+func (mmSoftDeleteReview *mReviewRepositoryMockSoftDeleteReview) ExpectMovieIDParam3(movieID string) *mReviewRepositoryMockSoftDeleteReview {
+	if mmSoftDeleteReview.mock.funcSoftDeleteReview != nil {
+		mmSoftDeleteReview.mock.t.Fatalf("ReviewRepositoryMock.SoftDeleteReview mock is already set by Set")
+	}
+
+	if mmSoftDeleteReview.defaultExpectation == nil {
+		mmSoftDeleteReview.defaultExpectation = &ReviewRepositoryMockSoftDeleteReviewExpectation{}
+	}
+
+	if mmSoftDeleteReview.defaultExpectation.params != nil {
+		mmSoftDeleteReview.mock.t.Fatalf("ReviewRepositoryMock.SoftDeleteReview mock is already set by Expect")
+	}
+
+	if mmSoftDeleteReview.defaultExpectation.paramPtrs == nil {
+		mmSoftDeleteReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockSoftDeleteReviewParamPtrs{}
+	}
+	mmSoftDeleteReview.defaultExpectation.paramPtrs.movieID = &movieID
+	mmSoftDeleteReview.defaultExpectation.expectationOrigins.originMovieID = minimock.CallerInfo(1)
+
+	return mmSoftDeleteReview
+}
+
+// ExpectDeletedAtParam4 sets up expected param deletedAt for ReviewRepository.SoftDeleteReview
+func (mmSoftDeleteReview *mReviewRepositoryMockSoftDeleteReview) ExpectDeletedAtParam4(deletedAt time.Time) *mReviewRepositoryMockSoftDeleteReview {
+	if mmSoftDeleteReview.mock.funcSoftDeleteReview != nil {
+		mmSoftDeleteReview.mock.t.Fatalf("ReviewRepositoryMock.SoftDeleteReview mock is already set by Set")
+	}
+
+	if mmSoftDeleteReview.defaultExpectation == nil {
+		mmSoftDeleteReview.defaultExpectation = &ReviewRepositoryMockSoftDeleteReviewExpectation{}
+	}
+
+	if mmSoftDeleteReview.defaultExpectation.params != nil {
+		mmSoftDeleteReview.mock.t.Fatalf("ReviewRepositoryMock.SoftDeleteReview mock is already set by Expect")
+	}
+
+	if mmSoftDeleteReview.defaultExpectation.paramPtrs == nil {
+		mmSoftDeleteReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockSoftDeleteReviewParamPtrs{}
+	}
+	mmSoftDeleteReview.defaultExpectation.paramPtrs.deletedAt = &deletedAt
+	mmSoftDeleteReview.defaultExpectation.expectationOrigins.originDeletedAt = minimock.CallerInfo(1)
+
+	return mmSoftDeleteReview
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReviewRepository.SoftDeleteReview
+func (mmSoftDeleteReview *mReviewRepositoryMockSoftDeleteReview) Inspect(f func(ctx context.Context, userID string, movieID string, deletedAt time.Time)) *mReviewRepositoryMockSoftDeleteReview {
+	if mmSoftDeleteReview.mock.inspectFuncSoftDeleteReview != nil {
+		mmSoftDeleteReview.mock.t.Fatalf("Inspect function is already set for ReviewRepositoryMock.SoftDeleteReview")
+	}
+
+	mmSoftDeleteReview.mock.inspectFuncSoftDeleteReview = f
+
+	return mmSoftDeleteReview
+}
+
+// Return sets up results that will be returned by ReviewRepository.SoftDeleteReview
+func (mmSoftDeleteReview *mReviewRepositoryMockSoftDeleteReview) Return(err error) *ReviewRepositoryMock {
+	if mmSoftDeleteReview.mock.funcSoftDeleteReview != nil {
+		mmSoftDeleteReview.mock.t.Fatalf("ReviewRepositoryMock.SoftDeleteReview mock is already set by Set")
+	}
+
+	if mmSoftDeleteReview.defaultExpectation == nil {
+		mmSoftDeleteReview.defaultExpectation = &ReviewRepositoryMockSoftDeleteReviewExpectation{mock: mmSoftDeleteReview.mock}
+	}
+	mmSoftDeleteReview.defaultExpectation.results = &ReviewRepositoryMockSoftDeleteReviewResults{err}
+	mmSoftDeleteReview.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSoftDeleteReview.mock
+}
+
+// Set uses given function f to mock the ReviewRepository.SoftDeleteReview method
+func (mmSoftDeleteReview *mReviewRepositoryMockSoftDeleteReview) Set(f func(ctx context.Context, userID string, movieID string, deletedAt time.Time) (err error)) *ReviewRepositoryMock {
+	if mmSoftDeleteReview.defaultExpectation != nil {
+		mmSoftDeleteReview.mock.t.Fatalf("Default expectation is already set for the ReviewRepository.SoftDeleteReview method")
+	}
+
+	if len(mmSoftDeleteReview.expectations) > 0 {
+		mmSoftDeleteReview.mock.t.Fatalf("Some expectations are already set for the ReviewRepository.SoftDeleteReview method")
+	}
+
+	mmSoftDeleteReview.mock.funcSoftDeleteReview = f
+	mmSoftDeleteReview.mock.funcSoftDeleteReviewOrigin = minimock.CallerInfo(1)
+	return mmSoftDeleteReview.mock
+}
+
+// When sets expectation for the ReviewRepository.SoftDeleteReview which will trigger the result defined by the following
+// Then helper
+func (mmSoftDeleteReview *mReviewRepositoryMockSoftDeleteReview) When(ctx context.Context, userID string, movieID string, deletedAt time.Time) *ReviewRepositoryMockSoftDeleteReviewExpectation {
+	if mmSoftDeleteReview.mock.funcSoftDeleteReview != nil {
+		mmSoftDeleteReview.mock.t.Fatalf("ReviewRepositoryMock.SoftDeleteReview mock is already set by Set")
+	}
+
+	expectation := &ReviewRepositoryMockSoftDeleteReviewExpectation{
+		mock:               mmSoftDeleteReview.mock,
+		params:             &ReviewRepositoryMockSoftDeleteReviewParams{ctx, userID, movieID, deletedAt},
+		expectationOrigins: ReviewRepositoryMockSoftDeleteReviewExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSoftDeleteReview.expectations = append(mmSoftDeleteReview.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReviewRepository.SoftDeleteReview return parameters for the expectation previously defined by the When method
+func (e *ReviewRepositoryMockSoftDeleteReviewExpectation) Then(err error) *ReviewRepositoryMock {
+	e.results = &ReviewRepositoryMockSoftDeleteReviewResults{err}
+	return e.mock
+}
+
+// Times sets number of times ReviewRepository.SoftDeleteReview should be invoked
+func (mmSoftDeleteReview *mReviewRepositoryMockSoftDeleteReview) Times(n uint64) *mReviewRepositoryMockSoftDeleteReview {
+	if n == 0 {
+		mmSoftDeleteReview.mock.t.Fatalf("Times of ReviewRepositoryMock.SoftDeleteReview mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSoftDeleteReview.expectedInvocations, n)
+	mmSoftDeleteReview.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSoftDeleteReview
+}
+
+func (mmSoftDeleteReview *mReviewRepositoryMockSoftDeleteReview) invocationsDone() bool {
+	if len(mmSoftDeleteReview.expectations) == 0 && mmSoftDeleteReview.defaultExpectation == nil && mmSoftDeleteReview.mock.funcSoftDeleteReview == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSoftDeleteReview.mock.afterSoftDeleteReviewCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSoftDeleteReview.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SoftDeleteReview implements mm_repository.ReviewRepository
+func (mmSoftDeleteReview *ReviewRepositoryMock) SoftDeleteReview(ctx context.Context, userID string, movieID string, deletedAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmSoftDeleteReview.beforeSoftDeleteReviewCounter, 1)
+	defer mm_atomic.AddUint64(&mmSoftDeleteReview.afterSoftDeleteReviewCounter, 1)
+
+	mmSoftDeleteReview.t.Helper()
+
+	if mmSoftDeleteReview.inspectFuncSoftDeleteReview != nil {
+		mmSoftDeleteReview.inspectFuncSoftDeleteReview(ctx, userID, movieID, deletedAt)
+	}
+
+	mm_params := ReviewRepositoryMockSoftDeleteReviewParams{ctx, userID, movieID, deletedAt}
+
+	// Record call args
+	mmSoftDeleteReview.SoftDeleteReviewMock.mutex.Lock()
+	mmSoftDeleteReview.SoftDeleteReviewMock.callArgs = append(mmSoftDeleteReview.SoftDeleteReviewMock.callArgs, &mm_params)
+	mmSoftDeleteReview.SoftDeleteReviewMock.mutex.Unlock()
+
+	for _, e := range mmSoftDeleteReview.SoftDeleteReviewMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSoftDeleteReview.SoftDeleteReviewMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSoftDeleteReview.SoftDeleteReviewMock.defaultExpectation.Counter, 1)
+		mm_want := mmSoftDeleteReview.SoftDeleteReviewMock.defaultExpectation.params
+		mm_want_ptrs := mmSoftDeleteReview.SoftDeleteReviewMock.defaultExpectation.paramPtrs
+
+		mm_got := ReviewRepositoryMockSoftDeleteReviewParams{ctx, userID, movieID, deletedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSoftDeleteReview.t.Errorf("ReviewRepositoryMock.SoftDeleteReview got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSoftDeleteReview.SoftDeleteReviewMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSoftDeleteReview.t.Errorf("ReviewRepositoryMock.SoftDeleteReview got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSoftDeleteReview.SoftDeleteReviewMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.movieID != nil && !minimock.Equal(*mm_want_ptrs.movieID, mm_got.movieID) {
+				mmSoftDeleteReview.t.Errorf("ReviewRepositoryMock.SoftDeleteReview got unexpected parameter movieID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSoftDeleteReview.SoftDeleteReviewMock.defaultExpectation.expectationOrigins.originMovieID, *mm_want_ptrs.movieID, mm_got.movieID, minimock.Diff(*mm_want_ptrs.movieID, mm_got.movieID))
+			}
+
+			if mm_want_ptrs.deletedAt != nil && !minimock.Equal(*mm_want_ptrs.deletedAt, mm_got.deletedAt) {
+				mmSoftDeleteReview.t.Errorf("ReviewRepositoryMock.SoftDeleteReview got unexpected parameter deletedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSoftDeleteReview.SoftDeleteReviewMock.defaultExpectation.expectationOrigins.originDeletedAt, *mm_want_ptrs.deletedAt, mm_got.deletedAt, minimock.Diff(*mm_want_ptrs.deletedAt, mm_got.deletedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSoftDeleteReview.t.Errorf("ReviewRepositoryMock.SoftDeleteReview got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSoftDeleteReview.SoftDeleteReviewMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSoftDeleteReview.SoftDeleteReviewMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSoftDeleteReview.t.Fatal("No results are set for the ReviewRepositoryMock.SoftDeleteReview")
+		}
+		return (*mm_results).err
+	}
+	if mmSoftDeleteReview.funcSoftDeleteReview != nil {
+		return mmSoftDeleteReview.funcSoftDeleteReview(ctx, userID, movieID, deletedAt)
+	}
+	mmSoftDeleteReview.t.Fatalf("Unexpected call to ReviewRepositoryMock.SoftDeleteReview. %v %v %v %v", ctx, userID, movieID, deletedAt)
+	return
+}
+
+// SoftDeleteReviewAfterCounter returns a count of finished ReviewRepositoryMock.SoftDeleteReview invocations
+func (mmSoftDeleteReview *ReviewRepositoryMock) SoftDeleteReviewAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSoftDeleteReview.afterSoftDeleteReviewCounter)
+}
+
+// SoftDeleteReviewBeforeCounter returns a count of ReviewRepositoryMock.SoftDeleteReview invocations
+func (mmSoftDeleteReview *ReviewRepositoryMock) SoftDeleteReviewBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSoftDeleteReview.beforeSoftDeleteReviewCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReviewRepositoryMock.SoftDeleteReview.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSoftDeleteReview *mReviewRepositoryMockSoftDeleteReview) Calls() []*ReviewRepositoryMockSoftDeleteReviewParams {
+	mmSoftDeleteReview.mutex.RLock()
+
+	argCopy := make([]*ReviewRepositoryMockSoftDeleteReviewParams, len(mmSoftDeleteReview.callArgs))
+	copy(argCopy, mmSoftDeleteReview.callArgs)
+
+	mmSoftDeleteReview.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSoftDeleteReviewDone returns true if the count of the SoftDeleteReview invocations corresponds
+// the number of defined expectations
+func (m *ReviewRepositoryMock) MinimockSoftDeleteReviewDone() bool {
+	if m.SoftDeleteReviewMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SoftDeleteReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SoftDeleteReviewMock.invocationsDone()
+}
+
+// MinimockSoftDeleteReviewInspect logs each unmet expectation
+func (m *ReviewRepositoryMock) MinimockSoftDeleteReviewInspect() {
+	for _, e := range m.SoftDeleteReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.SoftDeleteReview at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSoftDeleteReviewCounter := mm_atomic.LoadUint64(&m.afterSoftDeleteReviewCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SoftDeleteReviewMock.defaultExpectation != nil && afterSoftDeleteReviewCounter < 1 {
+		if m.SoftDeleteReviewMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.SoftDeleteReview at\n%s", m.SoftDeleteReviewMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReviewRepositoryMock.SoftDeleteReview at\n%s with params: %#v", m.SoftDeleteReviewMock.defaultExpectation.expectationOrigins.origin, *m.SoftDeleteReviewMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSoftDeleteReview != nil && afterSoftDeleteReviewCounter < 1 {
+		m.t.Errorf("Expected call to ReviewRepositoryMock.SoftDeleteReview at\n%s", m.funcSoftDeleteReviewOrigin)
+	}
+
+	if !m.SoftDeleteReviewMock.invocationsDone() && afterSoftDeleteReviewCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReviewRepositoryMock.SoftDeleteReview at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SoftDeleteReviewMock.expectedInvocations), m.SoftDeleteReviewMock.expectedInvocationsOrigin, afterSoftDeleteReviewCounter)
+	}
+}
+
+type mReviewRepositoryMockUpdateReview struct {
+	optional           bool
+	mock               *ReviewRepositoryMock
+	defaultExpectation *ReviewRepositoryMockUpdateReviewExpectation
+	expectations       []*ReviewRepositoryMockUpdateReviewExpectation
+
+	callArgs []*ReviewRepositoryMockUpdateReviewParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReviewRepositoryMockUpdateReviewExpectation specifies expectation struct of the ReviewRepository.UpdateReview
+type ReviewRepositoryMockUpdateReviewExpectation struct {
+	mock               *ReviewRepositoryMock
+	params             *ReviewRepositoryMockUpdateReviewParams
+	paramPtrs          *ReviewRepositoryMockUpdateReviewParamPtrs
+	expectationOrigins ReviewRepositoryMockUpdateReviewExpectationOrigins
+	results            *ReviewRepositoryMockUpdateReviewResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReviewRepositoryMockUpdateReviewParams contains parameters of the ReviewRepository.UpdateReview
+type ReviewRepositoryMockUpdateReviewParams struct {
+	ctx    context.Context
+	review mm_repository.Review
+	fields []mm_repository.ReviewField
+}
+
+// ReviewRepositoryMockUpdateReviewParamPtrs contains pointers to parameters of the ReviewRepository.UpdateReview
+type ReviewRepositoryMockUpdateReviewParamPtrs struct {
+	ctx    *context.Context
+	review *mm_repository.Review
+	fields *[]mm_repository.ReviewField
+}
+
+// ReviewRepositoryMockUpdateReviewResults contains results of the ReviewRepository.UpdateReview
+type ReviewRepositoryMockUpdateReviewResults struct {
+	err error
+}
+
+// ReviewRepositoryMockUpdateReviewOrigins contains origins of expectations of the ReviewRepository.UpdateReview
+type ReviewRepositoryMockUpdateReviewExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originReview string
+	originFields string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateReview *mReviewRepositoryMockUpdateReview) Optional() *mReviewRepositoryMockUpdateReview {
+	mmUpdateReview.optional = true
+	return mmUpdateReview
+}
+
+// Expect sets up expected params for ReviewRepository.UpdateReview
+func (mmUpdateReview *mReviewRepositoryMockUpdateReview) Expect(ctx context.Context, review mm_repository.Review, fields ...mm_repository.ReviewField) *mReviewRepositoryMockUpdateReview {
+	if mmUpdateReview.mock.funcUpdateReview != nil {
+		mmUpdateReview.mock.t.Fatalf("ReviewRepositoryMock.UpdateReview mock is already set by Set")
+	}
+
+	if mmUpdateReview.defaultExpectation == nil {
+		mmUpdateReview.defaultExpectation = &ReviewRepositoryMockUpdateReviewExpectation{}
+	}
+
+	if mmUpdateReview.defaultExpectation.paramPtrs != nil {
+		mmUpdateReview.mock.t.Fatalf("ReviewRepositoryMock.UpdateReview mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateReview.defaultExpectation.params = &ReviewRepositoryMockUpdateReviewParams{ctx, review, fields}
+	mmUpdateReview.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateReview.expectations {
+		if minimock.Equal(e.params, mmUpdateReview.defaultExpectation.params) {
+			mmUpdateReview.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateReview.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateReview
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReviewRepository.UpdateReview
+func (mmUpdateReview *mReviewRepositoryMockUpdateReview) ExpectCtxParam1(ctx context.Context) *mReviewRepositoryMockUpdateReview {
+	if mmUpdateReview.mock.funcUpdateReview != nil {
+		mmUpdateReview.mock.t.Fatalf("ReviewRepositoryMock.UpdateReview mock is already set by Set")
+	}
+
+	if mmUpdateReview.defaultExpectation == nil {
+		mmUpdateReview.defaultExpectation = &ReviewRepositoryMockUpdateReviewExpectation{}
+	}
+
+	if mmUpdateReview.defaultExpectation.params != nil {
+		mmUpdateReview.mock.t.Fatalf("ReviewRepositoryMock.UpdateReview mock is already set by Expect")
+	}
+
+	if mmUpdateReview.defaultExpectation.paramPtrs == nil {
+		mmUpdateReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockUpdateReviewParamPtrs{}
+	}
+	mmUpdateReview.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateReview.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateReview
+}
+
+// ExpectReviewParam2 sets up expected param review for ReviewRepository.UpdateReview
+func (mmUpdateReview *mReviewRepositoryMockUpdateReview) ExpectReviewParam2(review mm_repository.Review) *mReviewRepositoryMockUpdateReview {
+	if mmUpdateReview.mock.funcUpdateReview != nil {
+		mmUpdateReview.mock.t.Fatalf("ReviewRepositoryMock.UpdateReview mock is already set by Set")
+	}
+
+	if mmUpdateReview.defaultExpectation == nil {
+		mmUpdateReview.defaultExpectation = &ReviewRepositoryMockUpdateReviewExpectation{}
+	}
+
+	if mmUpdateReview.defaultExpectation.params != nil {
+		mmUpdateReview.mock.t.Fatalf("ReviewRepositoryMock.UpdateReview mock is already set by Expect")
+	}
+
+	if mmUpdateReview.defaultExpectation.paramPtrs == nil {
+		mmUpdateReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockUpdateReviewParamPtrs{}
+	}
+	mmUpdateReview.defaultExpectation.paramPtrs.review = &review
+	mmUpdateReview.defaultExpectation.expectationOrigins.originReview = minimock.CallerInfo(1)
+
+	return mmUpdateReview
+}
+
+// ExpectFieldsParam3 sets up expected param fields for ReviewRepository.UpdateReview
+func (mmUpdateReview *mReviewRepositoryMockUpdateReview) ExpectFieldsParam3(fields ...mm_repository.ReviewField) *mReviewRepositoryMockUpdateReview {
+	if mmUpdateReview.mock.funcUpdateReview != nil {
+		mmUpdateReview.mock.t.Fatalf("ReviewRepositoryMock.UpdateReview mock is already set by Set")
+	}
+
+	if mmUpdateReview.defaultExpectation == nil {
+		mmUpdateReview.defaultExpectation = &ReviewRepositoryMockUpdateReviewExpectation{}
+	}
+
+	if mmUpdateReview.defaultExpectation.params != nil {
+		mmUpdateReview.mock.t.Fatalf("ReviewRepositoryMock.UpdateReview mock is already set by Expect")
+	}
+
+	if mmUpdateReview.defaultExpectation.paramPtrs == nil {
+		mmUpdateReview.defaultExpectation.paramPtrs = &ReviewRepositoryMockUpdateReviewParamPtrs{}
+	}
+	mmUpdateReview.defaultExpectation.paramPtrs.fields = &fields
+	mmUpdateReview.defaultExpectation.expectationOrigins.originFields = minimock.CallerInfo(1)
+
+	return mmUpdateReview
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReviewRepository.UpdateReview
+func (mmUpdateReview *mReviewRepositoryMockUpdateReview) Inspect(f func(ctx context.Context, review mm_repository.Review, fields ...mm_repository.ReviewField)) *mReviewRepositoryMockUpdateReview {
+	if mmUpdateReview.mock.inspectFuncUpdateReview != nil {
 		mmUpdateReview.mock.t.Fatalf("Inspect function is already set for ReviewRepositoryMock.UpdateReview")
 	}
 
@@ -903,7 +4690,7 @@ func (mmUpdateReview *mReviewRepositoryMockUpdateReview) Return(err error) *Revi
 }
 
 // Set uses given function f to mock the ReviewRepository.UpdateReview method
-func (mmUpdateReview *mReviewRepositoryMockUpdateReview) Set(f func(ctx context.Context, review mm_repository.Review) (err error)) *ReviewRepositoryMock {
+func (mmUpdateReview *mReviewRepositoryMockUpdateReview) Set(f func(ctx context.Context, review mm_repository.Review, fields ...mm_repository.ReviewField) (err error)) *ReviewRepositoryMock {
 	if mmUpdateReview.defaultExpectation != nil {
 		mmUpdateReview.mock.t.Fatalf("Default expectation is already set for the ReviewRepository.UpdateReview method")
 	}
@@ -919,14 +4706,14 @@ func (mmUpdateReview *mReviewRepositoryMockUpdateReview) Set(f func(ctx context.
 
 // When sets expectation for the ReviewRepository.UpdateReview which will trigger the result defined by the following
 // Then helper
-func (mmUpdateReview *mReviewRepositoryMockUpdateReview) When(ctx context.Context, review mm_repository.Review) *ReviewRepositoryMockUpdateReviewExpectation {
+func (mmUpdateReview *mReviewRepositoryMockUpdateReview) When(ctx context.Context, review mm_repository.Review, fields ...mm_repository.ReviewField) *ReviewRepositoryMockUpdateReviewExpectation {
 	if mmUpdateReview.mock.funcUpdateReview != nil {
 		mmUpdateReview.mock.t.Fatalf("ReviewRepositoryMock.UpdateReview mock is already set by Set")
 	}
 
 	expectation := &ReviewRepositoryMockUpdateReviewExpectation{
 		mock:               mmUpdateReview.mock,
-		params:             &ReviewRepositoryMockUpdateReviewParams{ctx, review},
+		params:             &ReviewRepositoryMockUpdateReviewParams{ctx, review, fields},
 		expectationOrigins: ReviewRepositoryMockUpdateReviewExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmUpdateReview.expectations = append(mmUpdateReview.expectations, expectation)
@@ -961,17 +4748,17 @@ func (mmUpdateReview *mReviewRepositoryMockUpdateReview) invocationsDone() bool
 }
 
 // UpdateReview implements mm_repository.ReviewRepository
-func (mmUpdateReview *ReviewRepositoryMock) UpdateReview(ctx context.Context, review mm_repository.Review) (err error) {
+func (mmUpdateReview *ReviewRepositoryMock) UpdateReview(ctx context.Context, review mm_repository.Review, fields ...mm_repository.ReviewField) (err error) {
 	mm_atomic.AddUint64(&mmUpdateReview.beforeUpdateReviewCounter, 1)
 	defer mm_atomic.AddUint64(&mmUpdateReview.afterUpdateReviewCounter, 1)
 
 	mmUpdateReview.t.Helper()
 
 	if mmUpdateReview.inspectFuncUpdateReview != nil {
-		mmUpdateReview.inspectFuncUpdateReview(ctx, review)
+		mmUpdateReview.inspectFuncUpdateReview(ctx, review, fields...)
 	}
 
-	mm_params := ReviewRepositoryMockUpdateReviewParams{ctx, review}
+	mm_params := ReviewRepositoryMockUpdateReviewParams{ctx, review, fields}
 
 	// Record call args
 	mmUpdateReview.UpdateReviewMock.mutex.Lock()
@@ -990,7 +4777,7 @@ func (mmUpdateReview *ReviewRepositoryMock) UpdateReview(ctx context.Context, re
 		mm_want := mmUpdateReview.UpdateReviewMock.defaultExpectation.params
 		mm_want_ptrs := mmUpdateReview.UpdateReviewMock.defaultExpectation.paramPtrs
 
-		mm_got := ReviewRepositoryMockUpdateReviewParams{ctx, review}
+		mm_got := ReviewRepositoryMockUpdateReviewParams{ctx, review, fields}
 
 		if mm_want_ptrs != nil {
 
@@ -1004,6 +4791,11 @@ func (mmUpdateReview *ReviewRepositoryMock) UpdateReview(ctx context.Context, re
 					mmUpdateReview.UpdateReviewMock.defaultExpectation.expectationOrigins.originReview, *mm_want_ptrs.review, mm_got.review, minimock.Diff(*mm_want_ptrs.review, mm_got.review))
 			}
 
+			if mm_want_ptrs.fields != nil && !minimock.Equal(*mm_want_ptrs.fields, mm_got.fields) {
+				mmUpdateReview.t.Errorf("ReviewRepositoryMock.UpdateReview got unexpected parameter fields, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateReview.UpdateReviewMock.defaultExpectation.expectationOrigins.originFields, *mm_want_ptrs.fields, mm_got.fields, minimock.Diff(*mm_want_ptrs.fields, mm_got.fields))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmUpdateReview.t.Errorf("ReviewRepositoryMock.UpdateReview got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmUpdateReview.UpdateReviewMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -1016,9 +4808,9 @@ func (mmUpdateReview *ReviewRepositoryMock) UpdateReview(ctx context.Context, re
 		return (*mm_results).err
 	}
 	if mmUpdateReview.funcUpdateReview != nil {
-		return mmUpdateReview.funcUpdateReview(ctx, review)
+		return mmUpdateReview.funcUpdateReview(ctx, review, fields...)
 	}
-	mmUpdateReview.t.Fatalf("Unexpected call to ReviewRepositoryMock.UpdateReview. %v %v", ctx, review)
+	mmUpdateReview.t.Fatalf("Unexpected call to ReviewRepositoryMock.UpdateReview. %v %v %v", ctx, review, fields)
 	return
 }
 
@@ -1096,8 +4888,26 @@ func (m *ReviewRepositoryMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockCreateReviewInspect()
 
+			m.MinimockDeleteReviewInspect()
+
+			m.MinimockGetDeletedReviewInspect()
+
+			m.MinimockGetReviewInspect()
+
 			m.MinimockGetReviewsInspect()
 
+			m.MinimockIncrementVotesInspect()
+
+			m.MinimockListAllReviewsInspect()
+
+			m.MinimockPurgeReviewInspect()
+
+			m.MinimockRestoreReviewInspect()
+
+			m.MinimockSetStatusInspect()
+
+			m.MinimockSoftDeleteReviewInspect()
+
 			m.MinimockUpdateReviewInspect()
 		}
 	})
@@ -1123,6 +4933,15 @@ func (m *ReviewRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockCreateReviewDone() &&
+		m.MinimockDeleteReviewDone() &&
+		m.MinimockGetDeletedReviewDone() &&
+		m.MinimockGetReviewDone() &&
 		m.MinimockGetReviewsDone() &&
+		m.MinimockIncrementVotesDone() &&
+		m.MinimockListAllReviewsDone() &&
+		m.MinimockPurgeReviewDone() &&
+		m.MinimockRestoreReviewDone() &&
+		m.MinimockSetStatusDone() &&
+		m.MinimockSoftDeleteReviewDone() &&
 		m.MinimockUpdateReviewDone()
 }
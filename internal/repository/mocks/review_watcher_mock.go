@@ -0,0 +1,479 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/maisiq/go-ugc-service/internal/repository.ReviewWatcher -o review_watcher_mock.go -n ReviewWatcherMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_repository "github.com/maisiq/go-ugc-service/internal/repository"
+)
+
+// ReviewWatcherMock implements mm_repository.ReviewWatcher
+type ReviewWatcherMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcWatch          func(ctx context.Context, movieID string, resumeToken string, fn func(mm_repository.ReviewEvent) error) (err error)
+	funcWatchOrigin    string
+	inspectFuncWatch   func(ctx context.Context, movieID string, resumeToken string, fn func(mm_repository.ReviewEvent) error)
+	afterWatchCounter  uint64
+	beforeWatchCounter uint64
+	WatchMock          mReviewWatcherMockWatch
+}
+
+// NewReviewWatcherMock returns a mock for mm_repository.ReviewWatcher
+func NewReviewWatcherMock(t minimock.Tester) *ReviewWatcherMock {
+	m := &ReviewWatcherMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.WatchMock = mReviewWatcherMockWatch{mock: m}
+	m.WatchMock.callArgs = []*ReviewWatcherMockWatchParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mReviewWatcherMockWatch struct {
+	optional           bool
+	mock               *ReviewWatcherMock
+	defaultExpectation *ReviewWatcherMockWatchExpectation
+	expectations       []*ReviewWatcherMockWatchExpectation
+
+	callArgs []*ReviewWatcherMockWatchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReviewWatcherMockWatchExpectation specifies expectation struct of the ReviewWatcher.Watch
+type ReviewWatcherMockWatchExpectation struct {
+	mock               *ReviewWatcherMock
+	params             *ReviewWatcherMockWatchParams
+	paramPtrs          *ReviewWatcherMockWatchParamPtrs
+	expectationOrigins ReviewWatcherMockWatchExpectationOrigins
+	results            *ReviewWatcherMockWatchResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReviewWatcherMockWatchParams contains parameters of the ReviewWatcher.Watch
+type ReviewWatcherMockWatchParams struct {
+	ctx         context.Context
+	movieID     string
+	resumeToken string
+	fn          func(mm_repository.ReviewEvent) error
+}
+
+// ReviewWatcherMockWatchParamPtrs contains pointers to parameters of the ReviewWatcher.Watch
+type ReviewWatcherMockWatchParamPtrs struct {
+	ctx         *context.Context
+	movieID     *string
+	resumeToken *string
+	fn          *func(mm_repository.ReviewEvent) error
+}
+
+// ReviewWatcherMockWatchResults contains results of the ReviewWatcher.Watch
+type ReviewWatcherMockWatchResults struct {
+	err error
+}
+
+// ReviewWatcherMockWatchOrigins contains origins of expectations of the ReviewWatcher.Watch
+type ReviewWatcherMockWatchExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originMovieID     string
+	originResumeToken string
+	originFn          string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmWatch *mReviewWatcherMockWatch) Optional() *mReviewWatcherMockWatch {
+	mmWatch.optional = true
+	return mmWatch
+}
+
+// Expect sets up expected params for ReviewWatcher.Watch
+func (mmWatch *mReviewWatcherMockWatch) Expect(ctx context.Context, movieID string, resumeToken string, fn func(mm_repository.ReviewEvent) error) *mReviewWatcherMockWatch {
+	if mmWatch.mock.funcWatch != nil {
+		mmWatch.mock.t.Fatalf("ReviewWatcherMock.Watch mock is already set by Set")
+	}
+
+	if mmWatch.defaultExpectation == nil {
+		mmWatch.defaultExpectation = &ReviewWatcherMockWatchExpectation{}
+	}
+
+	if mmWatch.defaultExpectation.paramPtrs != nil {
+		mmWatch.mock.t.Fatalf("ReviewWatcherMock.Watch mock is already set by ExpectParams functions")
+	}
+
+	mmWatch.defaultExpectation.params = &ReviewWatcherMockWatchParams{ctx, movieID, resumeToken, fn}
+	mmWatch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmWatch.expectations {
+		if minimock.Equal(e.params, mmWatch.defaultExpectation.params) {
+			mmWatch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWatch.defaultExpectation.params)
+		}
+	}
+
+	return mmWatch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReviewWatcher.Watch
+func (mmWatch *mReviewWatcherMockWatch) ExpectCtxParam1(ctx context.Context) *mReviewWatcherMockWatch {
+	if mmWatch.mock.funcWatch != nil {
+		mmWatch.mock.t.Fatalf("ReviewWatcherMock.Watch mock is already set by Set")
+	}
+
+	if mmWatch.defaultExpectation == nil {
+		mmWatch.defaultExpectation = &ReviewWatcherMockWatchExpectation{}
+	}
+
+	if mmWatch.defaultExpectation.params != nil {
+		mmWatch.mock.t.Fatalf("ReviewWatcherMock.Watch mock is already set by Expect")
+	}
+
+	if mmWatch.defaultExpectation.paramPtrs == nil {
+		mmWatch.defaultExpectation.paramPtrs = &ReviewWatcherMockWatchParamPtrs{}
+	}
+	mmWatch.defaultExpectation.paramPtrs.ctx = &ctx
+	mmWatch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmWatch
+}
+
+// ExpectMovieIDParam2 sets up expected param movieID for ReviewWatcher.Watch
+func (mmWatch *mReviewWatcherMockWatch) ExpectMovieIDParam2(movieID string) *mReviewWatcherMockWatch {
+	if mmWatch.mock.funcWatch != nil {
+		mmWatch.mock.t.Fatalf("ReviewWatcherMock.Watch mock is already set by Set")
+	}
+
+	if mmWatch.defaultExpectation == nil {
+		mmWatch.defaultExpectation = &ReviewWatcherMockWatchExpectation{}
+	}
+
+	if mmWatch.defaultExpectation.params != nil {
+		mmWatch.mock.t.Fatalf("ReviewWatcherMock.Watch mock is already set by Expect")
+	}
+
+	if mmWatch.defaultExpectation.paramPtrs == nil {
+		mmWatch.defaultExpectation.paramPtrs = &ReviewWatcherMockWatchParamPtrs{}
+	}
+	mmWatch.defaultExpectation.paramPtrs.movieID = &movieID
+	mmWatch.defaultExpectation.expectationOrigins.originMovieID = minimock.CallerInfo(1)
+
+	return mmWatch
+}
+
+// ExpectResumeTokenParam3 sets up expected param resumeToken for ReviewWatcher.Watch
+func (mmWatch *mReviewWatcherMockWatch) ExpectResumeTokenParam3(resumeToken string) *mReviewWatcherMockWatch {
+	if mmWatch.mock.funcWatch != nil {
+		mmWatch.mock.t.Fatalf("ReviewWatcherMock.Watch mock is already set by Set")
+	}
+
+	if mmWatch.defaultExpectation == nil {
+		mmWatch.defaultExpectation = &ReviewWatcherMockWatchExpectation{}
+	}
+
+	if mmWatch.defaultExpectation.params != nil {
+		mmWatch.mock.t.Fatalf("ReviewWatcherMock.Watch mock is already set by Expect")
+	}
+
+	if mmWatch.defaultExpectation.paramPtrs == nil {
+		mmWatch.defaultExpectation.paramPtrs = &ReviewWatcherMockWatchParamPtrs{}
+	}
+	mmWatch.defaultExpectation.paramPtrs.resumeToken = &resumeToken
+	mmWatch.defaultExpectation.expectationOrigins.originResumeToken = minimock.CallerInfo(1)
+
+	return mmWatch
+}
+
+// ExpectFnParam4 sets up expected param fn for ReviewWatcher.Watch
+func (mmWatch *mReviewWatcherMockWatch) ExpectFnParam4(fn func(mm_repository.ReviewEvent) error) *mReviewWatcherMockWatch {
+	if mmWatch.mock.funcWatch != nil {
+		mmWatch.mock.t.Fatalf("ReviewWatcherMock.Watch mock is already set by Set")
+	}
+
+	if mmWatch.defaultExpectation == nil {
+		mmWatch.defaultExpectation = &ReviewWatcherMockWatchExpectation{}
+	}
+
+	if mmWatch.defaultExpectation.params != nil {
+		mmWatch.mock.t.Fatalf("ReviewWatcherMock.Watch mock is already set by Expect")
+	}
+
+	if mmWatch.defaultExpectation.paramPtrs == nil {
+		mmWatch.defaultExpectation.paramPtrs = &ReviewWatcherMockWatchParamPtrs{}
+	}
+	mmWatch.defaultExpectation.paramPtrs.fn = &fn
+	mmWatch.defaultExpectation.expectationOrigins.originFn = minimock.CallerInfo(1)
+
+	return mmWatch
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReviewWatcher.Watch
+func (mmWatch *mReviewWatcherMockWatch) Inspect(f func(ctx context.Context, movieID string, resumeToken string, fn func(mm_repository.ReviewEvent) error)) *mReviewWatcherMockWatch {
+	if mmWatch.mock.inspectFuncWatch != nil {
+		mmWatch.mock.t.Fatalf("Inspect function is already set for ReviewWatcherMock.Watch")
+	}
+
+	mmWatch.mock.inspectFuncWatch = f
+
+	return mmWatch
+}
+
+// Return sets up results that will be returned by ReviewWatcher.Watch
+func (mmWatch *mReviewWatcherMockWatch) Return(err error) *ReviewWatcherMock {
+	if mmWatch.mock.funcWatch != nil {
+		mmWatch.mock.t.Fatalf("ReviewWatcherMock.Watch mock is already set by Set")
+	}
+
+	if mmWatch.defaultExpectation == nil {
+		mmWatch.defaultExpectation = &ReviewWatcherMockWatchExpectation{mock: mmWatch.mock}
+	}
+	mmWatch.defaultExpectation.results = &ReviewWatcherMockWatchResults{err}
+	mmWatch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmWatch.mock
+}
+
+// Set uses given function f to mock the ReviewWatcher.Watch method
+func (mmWatch *mReviewWatcherMockWatch) Set(f func(ctx context.Context, movieID string, resumeToken string, fn func(mm_repository.ReviewEvent) error) (err error)) *ReviewWatcherMock {
+	if mmWatch.defaultExpectation != nil {
+		mmWatch.mock.t.Fatalf("Default expectation is already set for the ReviewWatcher.Watch method")
+	}
+
+	if len(mmWatch.expectations) > 0 {
+		mmWatch.mock.t.Fatalf("Some expectations are already set for the ReviewWatcher.Watch method")
+	}
+
+	mmWatch.mock.funcWatch = f
+	mmWatch.mock.funcWatchOrigin = minimock.CallerInfo(1)
+	return mmWatch.mock
+}
+
+// When sets expectation for the ReviewWatcher.Watch which will trigger the result defined by the following
+// Then helper
+func (mmWatch *mReviewWatcherMockWatch) When(ctx context.Context, movieID string, resumeToken string, fn func(mm_repository.ReviewEvent) error) *ReviewWatcherMockWatchExpectation {
+	if mmWatch.mock.funcWatch != nil {
+		mmWatch.mock.t.Fatalf("ReviewWatcherMock.Watch mock is already set by Set")
+	}
+
+	expectation := &ReviewWatcherMockWatchExpectation{
+		mock:               mmWatch.mock,
+		params:             &ReviewWatcherMockWatchParams{ctx, movieID, resumeToken, fn},
+		expectationOrigins: ReviewWatcherMockWatchExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmWatch.expectations = append(mmWatch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReviewWatcher.Watch return parameters for the expectation previously defined by the When method
+func (e *ReviewWatcherMockWatchExpectation) Then(err error) *ReviewWatcherMock {
+	e.results = &ReviewWatcherMockWatchResults{err}
+	return e.mock
+}
+
+// Times sets number of times ReviewWatcher.Watch should be invoked
+func (mmWatch *mReviewWatcherMockWatch) Times(n uint64) *mReviewWatcherMockWatch {
+	if n == 0 {
+		mmWatch.mock.t.Fatalf("Times of ReviewWatcherMock.Watch mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmWatch.expectedInvocations, n)
+	mmWatch.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmWatch
+}
+
+func (mmWatch *mReviewWatcherMockWatch) invocationsDone() bool {
+	if len(mmWatch.expectations) == 0 && mmWatch.defaultExpectation == nil && mmWatch.mock.funcWatch == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmWatch.mock.afterWatchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWatch.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Watch implements mm_repository.ReviewWatcher
+func (mmWatch *ReviewWatcherMock) Watch(ctx context.Context, movieID string, resumeToken string, fn func(mm_repository.ReviewEvent) error) (err error) {
+	mm_atomic.AddUint64(&mmWatch.beforeWatchCounter, 1)
+	defer mm_atomic.AddUint64(&mmWatch.afterWatchCounter, 1)
+
+	mmWatch.t.Helper()
+
+	if mmWatch.inspectFuncWatch != nil {
+		mmWatch.inspectFuncWatch(ctx, movieID, resumeToken, fn)
+	}
+
+	mm_params := ReviewWatcherMockWatchParams{ctx, movieID, resumeToken, fn}
+
+	// Record call args
+	mmWatch.WatchMock.mutex.Lock()
+	mmWatch.WatchMock.callArgs = append(mmWatch.WatchMock.callArgs, &mm_params)
+	mmWatch.WatchMock.mutex.Unlock()
+
+	for _, e := range mmWatch.WatchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmWatch.WatchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWatch.WatchMock.defaultExpectation.Counter, 1)
+		mm_want := mmWatch.WatchMock.defaultExpectation.params
+		mm_want_ptrs := mmWatch.WatchMock.defaultExpectation.paramPtrs
+
+		mm_got := ReviewWatcherMockWatchParams{ctx, movieID, resumeToken, fn}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmWatch.t.Errorf("ReviewWatcherMock.Watch got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWatch.WatchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.movieID != nil && !minimock.Equal(*mm_want_ptrs.movieID, mm_got.movieID) {
+				mmWatch.t.Errorf("ReviewWatcherMock.Watch got unexpected parameter movieID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWatch.WatchMock.defaultExpectation.expectationOrigins.originMovieID, *mm_want_ptrs.movieID, mm_got.movieID, minimock.Diff(*mm_want_ptrs.movieID, mm_got.movieID))
+			}
+
+			if mm_want_ptrs.resumeToken != nil && !minimock.Equal(*mm_want_ptrs.resumeToken, mm_got.resumeToken) {
+				mmWatch.t.Errorf("ReviewWatcherMock.Watch got unexpected parameter resumeToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWatch.WatchMock.defaultExpectation.expectationOrigins.originResumeToken, *mm_want_ptrs.resumeToken, mm_got.resumeToken, minimock.Diff(*mm_want_ptrs.resumeToken, mm_got.resumeToken))
+			}
+
+			if mm_want_ptrs.fn != nil && !minimock.Equal(*mm_want_ptrs.fn, mm_got.fn) {
+				mmWatch.t.Errorf("ReviewWatcherMock.Watch got unexpected parameter fn, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWatch.WatchMock.defaultExpectation.expectationOrigins.originFn, *mm_want_ptrs.fn, mm_got.fn, minimock.Diff(*mm_want_ptrs.fn, mm_got.fn))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmWatch.t.Errorf("ReviewWatcherMock.Watch got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmWatch.WatchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmWatch.WatchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmWatch.t.Fatal("No results are set for the ReviewWatcherMock.Watch")
+		}
+		return (*mm_results).err
+	}
+	if mmWatch.funcWatch != nil {
+		return mmWatch.funcWatch(ctx, movieID, resumeToken, fn)
+	}
+	mmWatch.t.Fatalf("Unexpected call to ReviewWatcherMock.Watch. %v %v %v %v", ctx, movieID, resumeToken, fn)
+	return
+}
+
+// WatchAfterCounter returns a count of finished ReviewWatcherMock.Watch invocations
+func (mmWatch *ReviewWatcherMock) WatchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWatch.afterWatchCounter)
+}
+
+// WatchBeforeCounter returns a count of ReviewWatcherMock.Watch invocations
+func (mmWatch *ReviewWatcherMock) WatchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWatch.beforeWatchCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReviewWatcherMock.Watch.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmWatch *mReviewWatcherMockWatch) Calls() []*ReviewWatcherMockWatchParams {
+	mmWatch.mutex.RLock()
+
+	argCopy := make([]*ReviewWatcherMockWatchParams, len(mmWatch.callArgs))
+	copy(argCopy, mmWatch.callArgs)
+
+	mmWatch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockWatchDone returns true if the count of the Watch invocations corresponds
+// the number of defined expectations
+func (m *ReviewWatcherMock) MinimockWatchDone() bool {
+	if m.WatchMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.WatchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.WatchMock.invocationsDone()
+}
+
+// MinimockWatchInspect logs each unmet expectation
+func (m *ReviewWatcherMock) MinimockWatchInspect() {
+	for _, e := range m.WatchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReviewWatcherMock.Watch at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterWatchCounter := mm_atomic.LoadUint64(&m.afterWatchCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.WatchMock.defaultExpectation != nil && afterWatchCounter < 1 {
+		if m.WatchMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReviewWatcherMock.Watch at\n%s", m.WatchMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReviewWatcherMock.Watch at\n%s with params: %#v", m.WatchMock.defaultExpectation.expectationOrigins.origin, *m.WatchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcWatch != nil && afterWatchCounter < 1 {
+		m.t.Errorf("Expected call to ReviewWatcherMock.Watch at\n%s", m.funcWatchOrigin)
+	}
+
+	if !m.WatchMock.invocationsDone() && afterWatchCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReviewWatcherMock.Watch at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.WatchMock.expectedInvocations), m.WatchMock.expectedInvocationsOrigin, afterWatchCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ReviewWatcherMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockWatchInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ReviewWatcherMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ReviewWatcherMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockWatchDone()
+}
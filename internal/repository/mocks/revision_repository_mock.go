@@ -0,0 +1,1252 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/maisiq/go-ugc-service/internal/repository.RevisionRepository -o revision_repository_mock.go -n RevisionRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_repository "github.com/maisiq/go-ugc-service/internal/repository"
+)
+
+// RevisionRepositoryMock implements mm_repository.RevisionRepository
+type RevisionRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddRevision          func(ctx context.Context, revision mm_repository.Revision) (err error)
+	funcAddRevisionOrigin    string
+	inspectFuncAddRevision   func(ctx context.Context, revision mm_repository.Revision)
+	afterAddRevisionCounter  uint64
+	beforeAddRevisionCounter uint64
+	AddRevisionMock          mRevisionRepositoryMockAddRevision
+
+	funcDeleteRevisions          func(ctx context.Context, userID string, movieID string) (err error)
+	funcDeleteRevisionsOrigin    string
+	inspectFuncDeleteRevisions   func(ctx context.Context, userID string, movieID string)
+	afterDeleteRevisionsCounter  uint64
+	beforeDeleteRevisionsCounter uint64
+	DeleteRevisionsMock          mRevisionRepositoryMockDeleteRevisions
+
+	funcListRevisions          func(ctx context.Context, userID string, movieID string, offset int, limit int) (ra1 []mm_repository.Revision, err error)
+	funcListRevisionsOrigin    string
+	inspectFuncListRevisions   func(ctx context.Context, userID string, movieID string, offset int, limit int)
+	afterListRevisionsCounter  uint64
+	beforeListRevisionsCounter uint64
+	ListRevisionsMock          mRevisionRepositoryMockListRevisions
+}
+
+// NewRevisionRepositoryMock returns a mock for mm_repository.RevisionRepository
+func NewRevisionRepositoryMock(t minimock.Tester) *RevisionRepositoryMock {
+	m := &RevisionRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddRevisionMock = mRevisionRepositoryMockAddRevision{mock: m}
+	m.AddRevisionMock.callArgs = []*RevisionRepositoryMockAddRevisionParams{}
+
+	m.DeleteRevisionsMock = mRevisionRepositoryMockDeleteRevisions{mock: m}
+	m.DeleteRevisionsMock.callArgs = []*RevisionRepositoryMockDeleteRevisionsParams{}
+
+	m.ListRevisionsMock = mRevisionRepositoryMockListRevisions{mock: m}
+	m.ListRevisionsMock.callArgs = []*RevisionRepositoryMockListRevisionsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRevisionRepositoryMockAddRevision struct {
+	optional           bool
+	mock               *RevisionRepositoryMock
+	defaultExpectation *RevisionRepositoryMockAddRevisionExpectation
+	expectations       []*RevisionRepositoryMockAddRevisionExpectation
+
+	callArgs []*RevisionRepositoryMockAddRevisionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RevisionRepositoryMockAddRevisionExpectation specifies expectation struct of the RevisionRepository.AddRevision
+type RevisionRepositoryMockAddRevisionExpectation struct {
+	mock               *RevisionRepositoryMock
+	params             *RevisionRepositoryMockAddRevisionParams
+	paramPtrs          *RevisionRepositoryMockAddRevisionParamPtrs
+	expectationOrigins RevisionRepositoryMockAddRevisionExpectationOrigins
+	results            *RevisionRepositoryMockAddRevisionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RevisionRepositoryMockAddRevisionParams contains parameters of the RevisionRepository.AddRevision
+type RevisionRepositoryMockAddRevisionParams struct {
+	ctx      context.Context
+	revision mm_repository.Revision
+}
+
+// RevisionRepositoryMockAddRevisionParamPtrs contains pointers to parameters of the RevisionRepository.AddRevision
+type RevisionRepositoryMockAddRevisionParamPtrs struct {
+	ctx      *context.Context
+	revision *mm_repository.Revision
+}
+
+// RevisionRepositoryMockAddRevisionResults contains results of the RevisionRepository.AddRevision
+type RevisionRepositoryMockAddRevisionResults struct {
+	err error
+}
+
+// RevisionRepositoryMockAddRevisionOrigins contains origins of expectations of the RevisionRepository.AddRevision
+type RevisionRepositoryMockAddRevisionExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originRevision string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddRevision *mRevisionRepositoryMockAddRevision) Optional() *mRevisionRepositoryMockAddRevision {
+	mmAddRevision.optional = true
+	return mmAddRevision
+}
+
+// Expect sets up expected params for RevisionRepository.AddRevision
+func (mmAddRevision *mRevisionRepositoryMockAddRevision) Expect(ctx context.Context, revision mm_repository.Revision) *mRevisionRepositoryMockAddRevision {
+	if mmAddRevision.mock.funcAddRevision != nil {
+		mmAddRevision.mock.t.Fatalf("RevisionRepositoryMock.AddRevision mock is already set by Set")
+	}
+
+	if mmAddRevision.defaultExpectation == nil {
+		mmAddRevision.defaultExpectation = &RevisionRepositoryMockAddRevisionExpectation{}
+	}
+
+	if mmAddRevision.defaultExpectation.paramPtrs != nil {
+		mmAddRevision.mock.t.Fatalf("RevisionRepositoryMock.AddRevision mock is already set by ExpectParams functions")
+	}
+
+	mmAddRevision.defaultExpectation.params = &RevisionRepositoryMockAddRevisionParams{ctx, revision}
+	mmAddRevision.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddRevision.expectations {
+		if minimock.Equal(e.params, mmAddRevision.defaultExpectation.params) {
+			mmAddRevision.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddRevision.defaultExpectation.params)
+		}
+	}
+
+	return mmAddRevision
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RevisionRepository.AddRevision
+func (mmAddRevision *mRevisionRepositoryMockAddRevision) ExpectCtxParam1(ctx context.Context) *mRevisionRepositoryMockAddRevision {
+	if mmAddRevision.mock.funcAddRevision != nil {
+		mmAddRevision.mock.t.Fatalf("RevisionRepositoryMock.AddRevision mock is already set by Set")
+	}
+
+	if mmAddRevision.defaultExpectation == nil {
+		mmAddRevision.defaultExpectation = &RevisionRepositoryMockAddRevisionExpectation{}
+	}
+
+	if mmAddRevision.defaultExpectation.params != nil {
+		mmAddRevision.mock.t.Fatalf("RevisionRepositoryMock.AddRevision mock is already set by Expect")
+	}
+
+	if mmAddRevision.defaultExpectation.paramPtrs == nil {
+		mmAddRevision.defaultExpectation.paramPtrs = &RevisionRepositoryMockAddRevisionParamPtrs{}
+	}
+	mmAddRevision.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddRevision.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddRevision
+}
+
+// ExpectRevisionParam2 sets up expected param revision for RevisionRepository.AddRevision
+func (mmAddRevision *mRevisionRepositoryMockAddRevision) ExpectRevisionParam2(revision mm_repository.Revision) *mRevisionRepositoryMockAddRevision {
+	if mmAddRevision.mock.funcAddRevision != nil {
+		mmAddRevision.mock.t.Fatalf("RevisionRepositoryMock.AddRevision mock is already set by Set")
+	}
+
+	if mmAddRevision.defaultExpectation == nil {
+		mmAddRevision.defaultExpectation = &RevisionRepositoryMockAddRevisionExpectation{}
+	}
+
+	if mmAddRevision.defaultExpectation.params != nil {
+		mmAddRevision.mock.t.Fatalf("RevisionRepositoryMock.AddRevision mock is already set by Expect")
+	}
+
+	if mmAddRevision.defaultExpectation.paramPtrs == nil {
+		mmAddRevision.defaultExpectation.paramPtrs = &RevisionRepositoryMockAddRevisionParamPtrs{}
+	}
+	mmAddRevision.defaultExpectation.paramPtrs.revision = &revision
+	mmAddRevision.defaultExpectation.expectationOrigins.originRevision = minimock.CallerInfo(1)
+
+	return mmAddRevision
+}
+
+// Inspect accepts an inspector function that has same arguments as the RevisionRepository.AddRevision
+func (mmAddRevision *mRevisionRepositoryMockAddRevision) Inspect(f func(ctx context.Context, revision mm_repository.Revision)) *mRevisionRepositoryMockAddRevision {
+	if mmAddRevision.mock.inspectFuncAddRevision != nil {
+		mmAddRevision.mock.t.Fatalf("Inspect function is already set for RevisionRepositoryMock.AddRevision")
+	}
+
+	mmAddRevision.mock.inspectFuncAddRevision = f
+
+	return mmAddRevision
+}
+
+// Return sets up results that will be returned by RevisionRepository.AddRevision
+func (mmAddRevision *mRevisionRepositoryMockAddRevision) Return(err error) *RevisionRepositoryMock {
+	if mmAddRevision.mock.funcAddRevision != nil {
+		mmAddRevision.mock.t.Fatalf("RevisionRepositoryMock.AddRevision mock is already set by Set")
+	}
+
+	if mmAddRevision.defaultExpectation == nil {
+		mmAddRevision.defaultExpectation = &RevisionRepositoryMockAddRevisionExpectation{mock: mmAddRevision.mock}
+	}
+	mmAddRevision.defaultExpectation.results = &RevisionRepositoryMockAddRevisionResults{err}
+	mmAddRevision.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddRevision.mock
+}
+
+// Set uses given function f to mock the RevisionRepository.AddRevision method
+func (mmAddRevision *mRevisionRepositoryMockAddRevision) Set(f func(ctx context.Context, revision mm_repository.Revision) (err error)) *RevisionRepositoryMock {
+	if mmAddRevision.defaultExpectation != nil {
+		mmAddRevision.mock.t.Fatalf("Default expectation is already set for the RevisionRepository.AddRevision method")
+	}
+
+	if len(mmAddRevision.expectations) > 0 {
+		mmAddRevision.mock.t.Fatalf("Some expectations are already set for the RevisionRepository.AddRevision method")
+	}
+
+	mmAddRevision.mock.funcAddRevision = f
+	mmAddRevision.mock.funcAddRevisionOrigin = minimock.CallerInfo(1)
+	return mmAddRevision.mock
+}
+
+// When sets expectation for the RevisionRepository.AddRevision which will trigger the result defined by the following
+// Then helper
+func (mmAddRevision *mRevisionRepositoryMockAddRevision) When(ctx context.Context, revision mm_repository.Revision) *RevisionRepositoryMockAddRevisionExpectation {
+	if mmAddRevision.mock.funcAddRevision != nil {
+		mmAddRevision.mock.t.Fatalf("RevisionRepositoryMock.AddRevision mock is already set by Set")
+	}
+
+	expectation := &RevisionRepositoryMockAddRevisionExpectation{
+		mock:               mmAddRevision.mock,
+		params:             &RevisionRepositoryMockAddRevisionParams{ctx, revision},
+		expectationOrigins: RevisionRepositoryMockAddRevisionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddRevision.expectations = append(mmAddRevision.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RevisionRepository.AddRevision return parameters for the expectation previously defined by the When method
+func (e *RevisionRepositoryMockAddRevisionExpectation) Then(err error) *RevisionRepositoryMock {
+	e.results = &RevisionRepositoryMockAddRevisionResults{err}
+	return e.mock
+}
+
+// Times sets number of times RevisionRepository.AddRevision should be invoked
+func (mmAddRevision *mRevisionRepositoryMockAddRevision) Times(n uint64) *mRevisionRepositoryMockAddRevision {
+	if n == 0 {
+		mmAddRevision.mock.t.Fatalf("Times of RevisionRepositoryMock.AddRevision mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddRevision.expectedInvocations, n)
+	mmAddRevision.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddRevision
+}
+
+func (mmAddRevision *mRevisionRepositoryMockAddRevision) invocationsDone() bool {
+	if len(mmAddRevision.expectations) == 0 && mmAddRevision.defaultExpectation == nil && mmAddRevision.mock.funcAddRevision == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddRevision.mock.afterAddRevisionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddRevision.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddRevision implements mm_repository.RevisionRepository
+func (mmAddRevision *RevisionRepositoryMock) AddRevision(ctx context.Context, revision mm_repository.Revision) (err error) {
+	mm_atomic.AddUint64(&mmAddRevision.beforeAddRevisionCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddRevision.afterAddRevisionCounter, 1)
+
+	mmAddRevision.t.Helper()
+
+	if mmAddRevision.inspectFuncAddRevision != nil {
+		mmAddRevision.inspectFuncAddRevision(ctx, revision)
+	}
+
+	mm_params := RevisionRepositoryMockAddRevisionParams{ctx, revision}
+
+	// Record call args
+	mmAddRevision.AddRevisionMock.mutex.Lock()
+	mmAddRevision.AddRevisionMock.callArgs = append(mmAddRevision.AddRevisionMock.callArgs, &mm_params)
+	mmAddRevision.AddRevisionMock.mutex.Unlock()
+
+	for _, e := range mmAddRevision.AddRevisionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddRevision.AddRevisionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddRevision.AddRevisionMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddRevision.AddRevisionMock.defaultExpectation.params
+		mm_want_ptrs := mmAddRevision.AddRevisionMock.defaultExpectation.paramPtrs
+
+		mm_got := RevisionRepositoryMockAddRevisionParams{ctx, revision}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddRevision.t.Errorf("RevisionRepositoryMock.AddRevision got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddRevision.AddRevisionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.revision != nil && !minimock.Equal(*mm_want_ptrs.revision, mm_got.revision) {
+				mmAddRevision.t.Errorf("RevisionRepositoryMock.AddRevision got unexpected parameter revision, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddRevision.AddRevisionMock.defaultExpectation.expectationOrigins.originRevision, *mm_want_ptrs.revision, mm_got.revision, minimock.Diff(*mm_want_ptrs.revision, mm_got.revision))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddRevision.t.Errorf("RevisionRepositoryMock.AddRevision got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddRevision.AddRevisionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddRevision.AddRevisionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddRevision.t.Fatal("No results are set for the RevisionRepositoryMock.AddRevision")
+		}
+		return (*mm_results).err
+	}
+	if mmAddRevision.funcAddRevision != nil {
+		return mmAddRevision.funcAddRevision(ctx, revision)
+	}
+	mmAddRevision.t.Fatalf("Unexpected call to RevisionRepositoryMock.AddRevision. %v %v", ctx, revision)
+	return
+}
+
+// AddRevisionAfterCounter returns a count of finished RevisionRepositoryMock.AddRevision invocations
+func (mmAddRevision *RevisionRepositoryMock) AddRevisionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddRevision.afterAddRevisionCounter)
+}
+
+// AddRevisionBeforeCounter returns a count of RevisionRepositoryMock.AddRevision invocations
+func (mmAddRevision *RevisionRepositoryMock) AddRevisionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddRevision.beforeAddRevisionCounter)
+}
+
+// Calls returns a list of arguments used in each call to RevisionRepositoryMock.AddRevision.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddRevision *mRevisionRepositoryMockAddRevision) Calls() []*RevisionRepositoryMockAddRevisionParams {
+	mmAddRevision.mutex.RLock()
+
+	argCopy := make([]*RevisionRepositoryMockAddRevisionParams, len(mmAddRevision.callArgs))
+	copy(argCopy, mmAddRevision.callArgs)
+
+	mmAddRevision.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddRevisionDone returns true if the count of the AddRevision invocations corresponds
+// the number of defined expectations
+func (m *RevisionRepositoryMock) MinimockAddRevisionDone() bool {
+	if m.AddRevisionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddRevisionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddRevisionMock.invocationsDone()
+}
+
+// MinimockAddRevisionInspect logs each unmet expectation
+func (m *RevisionRepositoryMock) MinimockAddRevisionInspect() {
+	for _, e := range m.AddRevisionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RevisionRepositoryMock.AddRevision at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddRevisionCounter := mm_atomic.LoadUint64(&m.afterAddRevisionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddRevisionMock.defaultExpectation != nil && afterAddRevisionCounter < 1 {
+		if m.AddRevisionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RevisionRepositoryMock.AddRevision at\n%s", m.AddRevisionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RevisionRepositoryMock.AddRevision at\n%s with params: %#v", m.AddRevisionMock.defaultExpectation.expectationOrigins.origin, *m.AddRevisionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddRevision != nil && afterAddRevisionCounter < 1 {
+		m.t.Errorf("Expected call to RevisionRepositoryMock.AddRevision at\n%s", m.funcAddRevisionOrigin)
+	}
+
+	if !m.AddRevisionMock.invocationsDone() && afterAddRevisionCounter > 0 {
+		m.t.Errorf("Expected %d calls to RevisionRepositoryMock.AddRevision at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddRevisionMock.expectedInvocations), m.AddRevisionMock.expectedInvocationsOrigin, afterAddRevisionCounter)
+	}
+}
+
+type mRevisionRepositoryMockDeleteRevisions struct {
+	optional           bool
+	mock               *RevisionRepositoryMock
+	defaultExpectation *RevisionRepositoryMockDeleteRevisionsExpectation
+	expectations       []*RevisionRepositoryMockDeleteRevisionsExpectation
+
+	callArgs []*RevisionRepositoryMockDeleteRevisionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RevisionRepositoryMockDeleteRevisionsExpectation specifies expectation struct of the RevisionRepository.DeleteRevisions
+type RevisionRepositoryMockDeleteRevisionsExpectation struct {
+	mock               *RevisionRepositoryMock
+	params             *RevisionRepositoryMockDeleteRevisionsParams
+	paramPtrs          *RevisionRepositoryMockDeleteRevisionsParamPtrs
+	expectationOrigins RevisionRepositoryMockDeleteRevisionsExpectationOrigins
+	results            *RevisionRepositoryMockDeleteRevisionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RevisionRepositoryMockDeleteRevisionsParams contains parameters of the RevisionRepository.DeleteRevisions
+type RevisionRepositoryMockDeleteRevisionsParams struct {
+	ctx     context.Context
+	userID  string
+	movieID string
+}
+
+// RevisionRepositoryMockDeleteRevisionsParamPtrs contains pointers to parameters of the RevisionRepository.DeleteRevisions
+type RevisionRepositoryMockDeleteRevisionsParamPtrs struct {
+	ctx     *context.Context
+	userID  *string
+	movieID *string
+}
+
+// RevisionRepositoryMockDeleteRevisionsResults contains results of the RevisionRepository.DeleteRevisions
+type RevisionRepositoryMockDeleteRevisionsResults struct {
+	err error
+}
+
+// RevisionRepositoryMockDeleteRevisionsOrigins contains origins of expectations of the RevisionRepository.DeleteRevisions
+type RevisionRepositoryMockDeleteRevisionsExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originMovieID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteRevisions *mRevisionRepositoryMockDeleteRevisions) Optional() *mRevisionRepositoryMockDeleteRevisions {
+	mmDeleteRevisions.optional = true
+	return mmDeleteRevisions
+}
+
+// Expect sets up expected params for RevisionRepository.DeleteRevisions
+func (mmDeleteRevisions *mRevisionRepositoryMockDeleteRevisions) Expect(ctx context.Context, userID string, movieID string) *mRevisionRepositoryMockDeleteRevisions {
+	if mmDeleteRevisions.mock.funcDeleteRevisions != nil {
+		mmDeleteRevisions.mock.t.Fatalf("RevisionRepositoryMock.DeleteRevisions mock is already set by Set")
+	}
+
+	if mmDeleteRevisions.defaultExpectation == nil {
+		mmDeleteRevisions.defaultExpectation = &RevisionRepositoryMockDeleteRevisionsExpectation{}
+	}
+
+	if mmDeleteRevisions.defaultExpectation.paramPtrs != nil {
+		mmDeleteRevisions.mock.t.Fatalf("RevisionRepositoryMock.DeleteRevisions mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteRevisions.defaultExpectation.params = &RevisionRepositoryMockDeleteRevisionsParams{ctx, userID, movieID}
+	mmDeleteRevisions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteRevisions.expectations {
+		if minimock.Equal(e.params, mmDeleteRevisions.defaultExpectation.params) {
+			mmDeleteRevisions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteRevisions.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteRevisions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RevisionRepository.DeleteRevisions
+func (mmDeleteRevisions *mRevisionRepositoryMockDeleteRevisions) ExpectCtxParam1(ctx context.Context) *mRevisionRepositoryMockDeleteRevisions {
+	if mmDeleteRevisions.mock.funcDeleteRevisions != nil {
+		mmDeleteRevisions.mock.t.Fatalf("RevisionRepositoryMock.DeleteRevisions mock is already set by Set")
+	}
+
+	if mmDeleteRevisions.defaultExpectation == nil {
+		mmDeleteRevisions.defaultExpectation = &RevisionRepositoryMockDeleteRevisionsExpectation{}
+	}
+
+	if mmDeleteRevisions.defaultExpectation.params != nil {
+		mmDeleteRevisions.mock.t.Fatalf("RevisionRepositoryMock.DeleteRevisions mock is already set by Expect")
+	}
+
+	if mmDeleteRevisions.defaultExpectation.paramPtrs == nil {
+		mmDeleteRevisions.defaultExpectation.paramPtrs = &RevisionRepositoryMockDeleteRevisionsParamPtrs{}
+	}
+	mmDeleteRevisions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteRevisions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteRevisions
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RevisionRepository.DeleteRevisions
+func (mmDeleteRevisions *mRevisionRepositoryMockDeleteRevisions) ExpectUserIDParam2(userID string) *mRevisionRepositoryMockDeleteRevisions {
+	if mmDeleteRevisions.mock.funcDeleteRevisions != nil {
+		mmDeleteRevisions.mock.t.Fatalf("RevisionRepositoryMock.DeleteRevisions mock is already set by Set")
+	}
+
+	if mmDeleteRevisions.defaultExpectation == nil {
+		mmDeleteRevisions.defaultExpectation = &RevisionRepositoryMockDeleteRevisionsExpectation{}
+	}
+
+	if mmDeleteRevisions.defaultExpectation.params != nil {
+		mmDeleteRevisions.mock.t.Fatalf("RevisionRepositoryMock.DeleteRevisions mock is already set by Expect")
+	}
+
+	if mmDeleteRevisions.defaultExpectation.paramPtrs == nil {
+		mmDeleteRevisions.defaultExpectation.paramPtrs = &RevisionRepositoryMockDeleteRevisionsParamPtrs{}
+	}
+	mmDeleteRevisions.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteRevisions.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteRevisions
+}
+
+// ExpectMovieIDParam3 sets up expected param movieID for RevisionRepository.DeleteRevisions
+func (mmDeleteRevisions *mRevisionRepositoryMockDeleteRevisions) ExpectMovieIDParam3(movieID string) *mRevisionRepositoryMockDeleteRevisions {
+	if mmDeleteRevisions.mock.funcDeleteRevisions != nil {
+		mmDeleteRevisions.mock.t.Fatalf("RevisionRepositoryMock.DeleteRevisions mock is already set by Set")
+	}
+
+	if mmDeleteRevisions.defaultExpectation == nil {
+		mmDeleteRevisions.defaultExpectation = &RevisionRepositoryMockDeleteRevisionsExpectation{}
+	}
+
+	if mmDeleteRevisions.defaultExpectation.params != nil {
+		mmDeleteRevisions.mock.t.Fatalf("RevisionRepositoryMock.DeleteRevisions mock is already set by Expect")
+	}
+
+	if mmDeleteRevisions.defaultExpectation.paramPtrs == nil {
+		mmDeleteRevisions.defaultExpectation.paramPtrs = &RevisionRepositoryMockDeleteRevisionsParamPtrs{}
+	}
+	mmDeleteRevisions.defaultExpectation.paramPtrs.movieID = &movieID
+	mmDeleteRevisions.defaultExpectation.expectationOrigins.originMovieID = minimock.CallerInfo(1)
+
+	return mmDeleteRevisions
+}
+
+// Inspect accepts an inspector function that has same arguments as the RevisionRepository.DeleteRevisions
+func (mmDeleteRevisions *mRevisionRepositoryMockDeleteRevisions) Inspect(f func(ctx context.Context, userID string, movieID string)) *mRevisionRepositoryMockDeleteRevisions {
+	if mmDeleteRevisions.mock.inspectFuncDeleteRevisions != nil {
+		mmDeleteRevisions.mock.t.Fatalf("Inspect function is already set for RevisionRepositoryMock.DeleteRevisions")
+	}
+
+	mmDeleteRevisions.mock.inspectFuncDeleteRevisions = f
+
+	return mmDeleteRevisions
+}
+
+// Return sets up results that will be returned by RevisionRepository.DeleteRevisions
+func (mmDeleteRevisions *mRevisionRepositoryMockDeleteRevisions) Return(err error) *RevisionRepositoryMock {
+	if mmDeleteRevisions.mock.funcDeleteRevisions != nil {
+		mmDeleteRevisions.mock.t.Fatalf("RevisionRepositoryMock.DeleteRevisions mock is already set by Set")
+	}
+
+	if mmDeleteRevisions.defaultExpectation == nil {
+		mmDeleteRevisions.defaultExpectation = &RevisionRepositoryMockDeleteRevisionsExpectation{mock: mmDeleteRevisions.mock}
+	}
+	mmDeleteRevisions.defaultExpectation.results = &RevisionRepositoryMockDeleteRevisionsResults{err}
+	mmDeleteRevisions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteRevisions.mock
+}
+
+// Set uses given function f to mock the RevisionRepository.DeleteRevisions method
+func (mmDeleteRevisions *mRevisionRepositoryMockDeleteRevisions) Set(f func(ctx context.Context, userID string, movieID string) (err error)) *RevisionRepositoryMock {
+	if mmDeleteRevisions.defaultExpectation != nil {
+		mmDeleteRevisions.mock.t.Fatalf("Default expectation is already set for the RevisionRepository.DeleteRevisions method")
+	}
+
+	if len(mmDeleteRevisions.expectations) > 0 {
+		mmDeleteRevisions.mock.t.Fatalf("Some expectations are already set for the RevisionRepository.DeleteRevisions method")
+	}
+
+	mmDeleteRevisions.mock.funcDeleteRevisions = f
+	mmDeleteRevisions.mock.funcDeleteRevisionsOrigin = minimock.CallerInfo(1)
+	return mmDeleteRevisions.mock
+}
+
+// When sets expectation for the RevisionRepository.DeleteRevisions which will trigger the result defined by the following
+// Then helper
+func (mmDeleteRevisions *mRevisionRepositoryMockDeleteRevisions) When(ctx context.Context, userID string, movieID string) *RevisionRepositoryMockDeleteRevisionsExpectation {
+	if mmDeleteRevisions.mock.funcDeleteRevisions != nil {
+		mmDeleteRevisions.mock.t.Fatalf("RevisionRepositoryMock.DeleteRevisions mock is already set by Set")
+	}
+
+	expectation := &RevisionRepositoryMockDeleteRevisionsExpectation{
+		mock:               mmDeleteRevisions.mock,
+		params:             &RevisionRepositoryMockDeleteRevisionsParams{ctx, userID, movieID},
+		expectationOrigins: RevisionRepositoryMockDeleteRevisionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteRevisions.expectations = append(mmDeleteRevisions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RevisionRepository.DeleteRevisions return parameters for the expectation previously defined by the When method
+func (e *RevisionRepositoryMockDeleteRevisionsExpectation) Then(err error) *RevisionRepositoryMock {
+	e.results = &RevisionRepositoryMockDeleteRevisionsResults{err}
+	return e.mock
+}
+
+// Times sets number of times RevisionRepository.DeleteRevisions should be invoked
+func (mmDeleteRevisions *mRevisionRepositoryMockDeleteRevisions) Times(n uint64) *mRevisionRepositoryMockDeleteRevisions {
+	if n == 0 {
+		mmDeleteRevisions.mock.t.Fatalf("Times of RevisionRepositoryMock.DeleteRevisions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteRevisions.expectedInvocations, n)
+	mmDeleteRevisions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteRevisions
+}
+
+func (mmDeleteRevisions *mRevisionRepositoryMockDeleteRevisions) invocationsDone() bool {
+	if len(mmDeleteRevisions.expectations) == 0 && mmDeleteRevisions.defaultExpectation == nil && mmDeleteRevisions.mock.funcDeleteRevisions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteRevisions.mock.afterDeleteRevisionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteRevisions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteRevisions implements mm_repository.RevisionRepository
+func (mmDeleteRevisions *RevisionRepositoryMock) DeleteRevisions(ctx context.Context, userID string, movieID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteRevisions.beforeDeleteRevisionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteRevisions.afterDeleteRevisionsCounter, 1)
+
+	mmDeleteRevisions.t.Helper()
+
+	if mmDeleteRevisions.inspectFuncDeleteRevisions != nil {
+		mmDeleteRevisions.inspectFuncDeleteRevisions(ctx, userID, movieID)
+	}
+
+	mm_params := RevisionRepositoryMockDeleteRevisionsParams{ctx, userID, movieID}
+
+	// Record call args
+	mmDeleteRevisions.DeleteRevisionsMock.mutex.Lock()
+	mmDeleteRevisions.DeleteRevisionsMock.callArgs = append(mmDeleteRevisions.DeleteRevisionsMock.callArgs, &mm_params)
+	mmDeleteRevisions.DeleteRevisionsMock.mutex.Unlock()
+
+	for _, e := range mmDeleteRevisions.DeleteRevisionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteRevisions.DeleteRevisionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteRevisions.DeleteRevisionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteRevisions.DeleteRevisionsMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteRevisions.DeleteRevisionsMock.defaultExpectation.paramPtrs
+
+		mm_got := RevisionRepositoryMockDeleteRevisionsParams{ctx, userID, movieID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteRevisions.t.Errorf("RevisionRepositoryMock.DeleteRevisions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRevisions.DeleteRevisionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteRevisions.t.Errorf("RevisionRepositoryMock.DeleteRevisions got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRevisions.DeleteRevisionsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.movieID != nil && !minimock.Equal(*mm_want_ptrs.movieID, mm_got.movieID) {
+				mmDeleteRevisions.t.Errorf("RevisionRepositoryMock.DeleteRevisions got unexpected parameter movieID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRevisions.DeleteRevisionsMock.defaultExpectation.expectationOrigins.originMovieID, *mm_want_ptrs.movieID, mm_got.movieID, minimock.Diff(*mm_want_ptrs.movieID, mm_got.movieID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteRevisions.t.Errorf("RevisionRepositoryMock.DeleteRevisions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteRevisions.DeleteRevisionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteRevisions.DeleteRevisionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteRevisions.t.Fatal("No results are set for the RevisionRepositoryMock.DeleteRevisions")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteRevisions.funcDeleteRevisions != nil {
+		return mmDeleteRevisions.funcDeleteRevisions(ctx, userID, movieID)
+	}
+	mmDeleteRevisions.t.Fatalf("Unexpected call to RevisionRepositoryMock.DeleteRevisions. %v %v %v", ctx, userID, movieID)
+	return
+}
+
+// DeleteRevisionsAfterCounter returns a count of finished RevisionRepositoryMock.DeleteRevisions invocations
+func (mmDeleteRevisions *RevisionRepositoryMock) DeleteRevisionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRevisions.afterDeleteRevisionsCounter)
+}
+
+// DeleteRevisionsBeforeCounter returns a count of RevisionRepositoryMock.DeleteRevisions invocations
+func (mmDeleteRevisions *RevisionRepositoryMock) DeleteRevisionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRevisions.beforeDeleteRevisionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RevisionRepositoryMock.DeleteRevisions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteRevisions *mRevisionRepositoryMockDeleteRevisions) Calls() []*RevisionRepositoryMockDeleteRevisionsParams {
+	mmDeleteRevisions.mutex.RLock()
+
+	argCopy := make([]*RevisionRepositoryMockDeleteRevisionsParams, len(mmDeleteRevisions.callArgs))
+	copy(argCopy, mmDeleteRevisions.callArgs)
+
+	mmDeleteRevisions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteRevisionsDone returns true if the count of the DeleteRevisions invocations corresponds
+// the number of defined expectations
+func (m *RevisionRepositoryMock) MinimockDeleteRevisionsDone() bool {
+	if m.DeleteRevisionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteRevisionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteRevisionsMock.invocationsDone()
+}
+
+// MinimockDeleteRevisionsInspect logs each unmet expectation
+func (m *RevisionRepositoryMock) MinimockDeleteRevisionsInspect() {
+	for _, e := range m.DeleteRevisionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RevisionRepositoryMock.DeleteRevisions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteRevisionsCounter := mm_atomic.LoadUint64(&m.afterDeleteRevisionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteRevisionsMock.defaultExpectation != nil && afterDeleteRevisionsCounter < 1 {
+		if m.DeleteRevisionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RevisionRepositoryMock.DeleteRevisions at\n%s", m.DeleteRevisionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RevisionRepositoryMock.DeleteRevisions at\n%s with params: %#v", m.DeleteRevisionsMock.defaultExpectation.expectationOrigins.origin, *m.DeleteRevisionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteRevisions != nil && afterDeleteRevisionsCounter < 1 {
+		m.t.Errorf("Expected call to RevisionRepositoryMock.DeleteRevisions at\n%s", m.funcDeleteRevisionsOrigin)
+	}
+
+	if !m.DeleteRevisionsMock.invocationsDone() && afterDeleteRevisionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RevisionRepositoryMock.DeleteRevisions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteRevisionsMock.expectedInvocations), m.DeleteRevisionsMock.expectedInvocationsOrigin, afterDeleteRevisionsCounter)
+	}
+}
+
+type mRevisionRepositoryMockListRevisions struct {
+	optional           bool
+	mock               *RevisionRepositoryMock
+	defaultExpectation *RevisionRepositoryMockListRevisionsExpectation
+	expectations       []*RevisionRepositoryMockListRevisionsExpectation
+
+	callArgs []*RevisionRepositoryMockListRevisionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RevisionRepositoryMockListRevisionsExpectation specifies expectation struct of the RevisionRepository.ListRevisions
+type RevisionRepositoryMockListRevisionsExpectation struct {
+	mock               *RevisionRepositoryMock
+	params             *RevisionRepositoryMockListRevisionsParams
+	paramPtrs          *RevisionRepositoryMockListRevisionsParamPtrs
+	expectationOrigins RevisionRepositoryMockListRevisionsExpectationOrigins
+	results            *RevisionRepositoryMockListRevisionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RevisionRepositoryMockListRevisionsParams contains parameters of the RevisionRepository.ListRevisions
+type RevisionRepositoryMockListRevisionsParams struct {
+	ctx     context.Context
+	userID  string
+	movieID string
+	offset  int
+	limit   int
+}
+
+// RevisionRepositoryMockListRevisionsParamPtrs contains pointers to parameters of the RevisionRepository.ListRevisions
+type RevisionRepositoryMockListRevisionsParamPtrs struct {
+	ctx     *context.Context
+	userID  *string
+	movieID *string
+	offset  *int
+	limit   *int
+}
+
+// RevisionRepositoryMockListRevisionsResults contains results of the RevisionRepository.ListRevisions
+type RevisionRepositoryMockListRevisionsResults struct {
+	ra1 []mm_repository.Revision
+	err error
+}
+
+// RevisionRepositoryMockListRevisionsOrigins contains origins of expectations of the RevisionRepository.ListRevisions
+type RevisionRepositoryMockListRevisionsExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originMovieID string
+	originOffset  string
+	originLimit   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListRevisions *mRevisionRepositoryMockListRevisions) Optional() *mRevisionRepositoryMockListRevisions {
+	mmListRevisions.optional = true
+	return mmListRevisions
+}
+
+// Expect sets up expected params for RevisionRepository.ListRevisions
+func (mmListRevisions *mRevisionRepositoryMockListRevisions) Expect(ctx context.Context, userID string, movieID string, offset int, limit int) *mRevisionRepositoryMockListRevisions {
+	if mmListRevisions.mock.funcListRevisions != nil {
+		mmListRevisions.mock.t.Fatalf("RevisionRepositoryMock.ListRevisions mock is already set by Set")
+	}
+
+	if mmListRevisions.defaultExpectation == nil {
+		mmListRevisions.defaultExpectation = &RevisionRepositoryMockListRevisionsExpectation{}
+	}
+
+	if mmListRevisions.defaultExpectation.paramPtrs != nil {
+		mmListRevisions.mock.t.Fatalf("RevisionRepositoryMock.ListRevisions mock is already set by ExpectParams functions")
+	}
+
+	mmListRevisions.defaultExpectation.params = &RevisionRepositoryMockListRevisionsParams{ctx, userID, movieID, offset, limit}
+	mmListRevisions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListRevisions.expectations {
+		if minimock.Equal(e.params, mmListRevisions.defaultExpectation.params) {
+			mmListRevisions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListRevisions.defaultExpectation.params)
+		}
+	}
+
+	return mmListRevisions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RevisionRepository.ListRevisions
+func (mmListRevisions *mRevisionRepositoryMockListRevisions) ExpectCtxParam1(ctx context.Context) *mRevisionRepositoryMockListRevisions {
+	if mmListRevisions.mock.funcListRevisions != nil {
+		mmListRevisions.mock.t.Fatalf("RevisionRepositoryMock.ListRevisions mock is already set by Set")
+	}
+
+	if mmListRevisions.defaultExpectation == nil {
+		mmListRevisions.defaultExpectation = &RevisionRepositoryMockListRevisionsExpectation{}
+	}
+
+	if mmListRevisions.defaultExpectation.params != nil {
+		mmListRevisions.mock.t.Fatalf("RevisionRepositoryMock.ListRevisions mock is already set by Expect")
+	}
+
+	if mmListRevisions.defaultExpectation.paramPtrs == nil {
+		mmListRevisions.defaultExpectation.paramPtrs = &RevisionRepositoryMockListRevisionsParamPtrs{}
+	}
+	mmListRevisions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListRevisions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListRevisions
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RevisionRepository.ListRevisions
+func (mmListRevisions *mRevisionRepositoryMockListRevisions) ExpectUserIDParam2(userID string) *mRevisionRepositoryMockListRevisions {
+	if mmListRevisions.mock.funcListRevisions != nil {
+		mmListRevisions.mock.t.Fatalf("RevisionRepositoryMock.ListRevisions mock is already set by Set")
+	}
+
+	if mmListRevisions.defaultExpectation == nil {
+		mmListRevisions.defaultExpectation = &RevisionRepositoryMockListRevisionsExpectation{}
+	}
+
+	if mmListRevisions.defaultExpectation.params != nil {
+		mmListRevisions.mock.t.Fatalf("RevisionRepositoryMock.ListRevisions mock is already set by Expect")
+	}
+
+	if mmListRevisions.defaultExpectation.paramPtrs == nil {
+		mmListRevisions.defaultExpectation.paramPtrs = &RevisionRepositoryMockListRevisionsParamPtrs{}
+	}
+	mmListRevisions.defaultExpectation.paramPtrs.userID = &userID
+	mmListRevisions.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListRevisions
+}
+
+// ExpectMovieIDParam3 sets up expected param movieID for RevisionRepository.ListRevisions
+func (mmListRevisions *mRevisionRepositoryMockListRevisions) ExpectMovieIDParam3(movieID string) *mRevisionRepositoryMockListRevisions {
+	if mmListRevisions.mock.funcListRevisions != nil {
+		mmListRevisions.mock.t.Fatalf("RevisionRepositoryMock.ListRevisions mock is already set by Set")
+	}
+
+	if mmListRevisions.defaultExpectation == nil {
+		mmListRevisions.defaultExpectation = &RevisionRepositoryMockListRevisionsExpectation{}
+	}
+
+	if mmListRevisions.defaultExpectation.params != nil {
+		mmListRevisions.mock.t.Fatalf("RevisionRepositoryMock.ListRevisions mock is already set by Expect")
+	}
+
+	if mmListRevisions.defaultExpectation.paramPtrs == nil {
+		mmListRevisions.defaultExpectation.paramPtrs = &RevisionRepositoryMockListRevisionsParamPtrs{}
+	}
+	mmListRevisions.defaultExpectation.paramPtrs.movieID = &movieID
+	mmListRevisions.defaultExpectation.expectationOrigins.originMovieID = minimock.CallerInfo(1)
+
+	return mmListRevisions
+}
+
+// ExpectOffsetParam4 sets up expected param offset for RevisionRepository.ListRevisions
+func (mmListRevisions *mRevisionRepositoryMockListRevisions) ExpectOffsetParam4(offset int) *mRevisionRepositoryMockListRevisions {
+	if mmListRevisions.mock.funcListRevisions != nil {
+		mmListRevisions.mock.t.Fatalf("RevisionRepositoryMock.ListRevisions mock is already set by Set")
+	}
+
+	if mmListRevisions.defaultExpectation == nil {
+		mmListRevisions.defaultExpectation = &RevisionRepositoryMockListRevisionsExpectation{}
+	}
+
+	if mmListRevisions.defaultExpectation.params != nil {
+		mmListRevisions.mock.t.Fatalf("RevisionRepositoryMock.ListRevisions mock is already set by Expect")
+	}
+
+	if mmListRevisions.defaultExpectation.paramPtrs == nil {
+		mmListRevisions.defaultExpectation.paramPtrs = &RevisionRepositoryMockListRevisionsParamPtrs{}
+	}
+	mmListRevisions.defaultExpectation.paramPtrs.offset = &offset
+	mmListRevisions.defaultExpectation.expectationOrigins.originOffset = minimock.CallerInfo(1)
+
+	return mmListRevisions
+}
+
+// ExpectLimitParam5 sets up expected param limit for RevisionRepository.ListRevisions
+func (mmListRevisions *mRevisionRepositoryMockListRevisions) ExpectLimitParam5(limit int) *mRevisionRepositoryMockListRevisions {
+	if mmListRevisions.mock.funcListRevisions != nil {
+		mmListRevisions.mock.t.Fatalf("RevisionRepositoryMock.ListRevisions mock is already set by Set")
+	}
+
+	if mmListRevisions.defaultExpectation == nil {
+		mmListRevisions.defaultExpectation = &RevisionRepositoryMockListRevisionsExpectation{}
+	}
+
+	if mmListRevisions.defaultExpectation.params != nil {
+		mmListRevisions.mock.t.Fatalf("RevisionRepositoryMock.ListRevisions mock is already set by Expect")
+	}
+
+	if mmListRevisions.defaultExpectation.paramPtrs == nil {
+		mmListRevisions.defaultExpectation.paramPtrs = &RevisionRepositoryMockListRevisionsParamPtrs{}
+	}
+	mmListRevisions.defaultExpectation.paramPtrs.limit = &limit
+	mmListRevisions.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListRevisions
+}
+
+// Inspect accepts an inspector function that has same arguments as the RevisionRepository.ListRevisions
+func (mmListRevisions *mRevisionRepositoryMockListRevisions) Inspect(f func(ctx context.Context, userID string, movieID string, offset int, limit int)) *mRevisionRepositoryMockListRevisions {
+	if mmListRevisions.mock.inspectFuncListRevisions != nil {
+		mmListRevisions.mock.t.Fatalf("Inspect function is already set for RevisionRepositoryMock.ListRevisions")
+	}
+
+	mmListRevisions.mock.inspectFuncListRevisions = f
+
+	return mmListRevisions
+}
+
+// Return sets up results that will be returned by RevisionRepository.ListRevisions
+func (mmListRevisions *mRevisionRepositoryMockListRevisions) Return(ra1 []mm_repository.Revision, err error) *RevisionRepositoryMock {
+	if mmListRevisions.mock.funcListRevisions != nil {
+		mmListRevisions.mock.t.Fatalf("RevisionRepositoryMock.ListRevisions mock is already set by Set")
+	}
+
+	if mmListRevisions.defaultExpectation == nil {
+		mmListRevisions.defaultExpectation = &RevisionRepositoryMockListRevisionsExpectation{mock: mmListRevisions.mock}
+	}
+	mmListRevisions.defaultExpectation.results = &RevisionRepositoryMockListRevisionsResults{ra1, err}
+	mmListRevisions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListRevisions.mock
+}
+
+// Set uses given function f to mock the RevisionRepository.ListRevisions method
+func (mmListRevisions *mRevisionRepositoryMockListRevisions) Set(f func(ctx context.Context, userID string, movieID string, offset int, limit int) (ra1 []mm_repository.Revision, err error)) *RevisionRepositoryMock {
+	if mmListRevisions.defaultExpectation != nil {
+		mmListRevisions.mock.t.Fatalf("Default expectation is already set for the RevisionRepository.ListRevisions method")
+	}
+
+	if len(mmListRevisions.expectations) > 0 {
+		mmListRevisions.mock.t.Fatalf("Some expectations are already set for the RevisionRepository.ListRevisions method")
+	}
+
+	mmListRevisions.mock.funcListRevisions = f
+	mmListRevisions.mock.funcListRevisionsOrigin = minimock.CallerInfo(1)
+	return mmListRevisions.mock
+}
+
+// When sets expectation for the RevisionRepository.ListRevisions which will trigger the result defined by the following
+// Then helper
+func (mmListRevisions *mRevisionRepositoryMockListRevisions) When(ctx context.Context, userID string, movieID string, offset int, limit int) *RevisionRepositoryMockListRevisionsExpectation {
+	if mmListRevisions.mock.funcListRevisions != nil {
+		mmListRevisions.mock.t.Fatalf("RevisionRepositoryMock.ListRevisions mock is already set by Set")
+	}
+
+	expectation := &RevisionRepositoryMockListRevisionsExpectation{
+		mock:               mmListRevisions.mock,
+		params:             &RevisionRepositoryMockListRevisionsParams{ctx, userID, movieID, offset, limit},
+		expectationOrigins: RevisionRepositoryMockListRevisionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListRevisions.expectations = append(mmListRevisions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RevisionRepository.ListRevisions return parameters for the expectation previously defined by the When method
+func (e *RevisionRepositoryMockListRevisionsExpectation) Then(ra1 []mm_repository.Revision, err error) *RevisionRepositoryMock {
+	e.results = &RevisionRepositoryMockListRevisionsResults{ra1, err}
+	return e.mock
+}
+
+// Times sets number of times RevisionRepository.ListRevisions should be invoked
+func (mmListRevisions *mRevisionRepositoryMockListRevisions) Times(n uint64) *mRevisionRepositoryMockListRevisions {
+	if n == 0 {
+		mmListRevisions.mock.t.Fatalf("Times of RevisionRepositoryMock.ListRevisions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListRevisions.expectedInvocations, n)
+	mmListRevisions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListRevisions
+}
+
+func (mmListRevisions *mRevisionRepositoryMockListRevisions) invocationsDone() bool {
+	if len(mmListRevisions.expectations) == 0 && mmListRevisions.defaultExpectation == nil && mmListRevisions.mock.funcListRevisions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListRevisions.mock.afterListRevisionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListRevisions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListRevisions implements mm_repository.RevisionRepository
+func (mmListRevisions *RevisionRepositoryMock) ListRevisions(ctx context.Context, userID string, movieID string, offset int, limit int) (ra1 []mm_repository.Revision, err error) {
+	mm_atomic.AddUint64(&mmListRevisions.beforeListRevisionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListRevisions.afterListRevisionsCounter, 1)
+
+	mmListRevisions.t.Helper()
+
+	if mmListRevisions.inspectFuncListRevisions != nil {
+		mmListRevisions.inspectFuncListRevisions(ctx, userID, movieID, offset, limit)
+	}
+
+	mm_params := RevisionRepositoryMockListRevisionsParams{ctx, userID, movieID, offset, limit}
+
+	// Record call args
+	mmListRevisions.ListRevisionsMock.mutex.Lock()
+	mmListRevisions.ListRevisionsMock.callArgs = append(mmListRevisions.ListRevisionsMock.callArgs, &mm_params)
+	mmListRevisions.ListRevisionsMock.mutex.Unlock()
+
+	for _, e := range mmListRevisions.ListRevisionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmListRevisions.ListRevisionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListRevisions.ListRevisionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListRevisions.ListRevisionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListRevisions.ListRevisionsMock.defaultExpectation.paramPtrs
+
+		mm_got := RevisionRepositoryMockListRevisionsParams{ctx, userID, movieID, offset, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListRevisions.t.Errorf("RevisionRepositoryMock.ListRevisions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRevisions.ListRevisionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListRevisions.t.Errorf("RevisionRepositoryMock.ListRevisions got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRevisions.ListRevisionsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.movieID != nil && !minimock.Equal(*mm_want_ptrs.movieID, mm_got.movieID) {
+				mmListRevisions.t.Errorf("RevisionRepositoryMock.ListRevisions got unexpected parameter movieID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRevisions.ListRevisionsMock.defaultExpectation.expectationOrigins.originMovieID, *mm_want_ptrs.movieID, mm_got.movieID, minimock.Diff(*mm_want_ptrs.movieID, mm_got.movieID))
+			}
+
+			if mm_want_ptrs.offset != nil && !minimock.Equal(*mm_want_ptrs.offset, mm_got.offset) {
+				mmListRevisions.t.Errorf("RevisionRepositoryMock.ListRevisions got unexpected parameter offset, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRevisions.ListRevisionsMock.defaultExpectation.expectationOrigins.originOffset, *mm_want_ptrs.offset, mm_got.offset, minimock.Diff(*mm_want_ptrs.offset, mm_got.offset))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListRevisions.t.Errorf("RevisionRepositoryMock.ListRevisions got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRevisions.ListRevisionsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListRevisions.t.Errorf("RevisionRepositoryMock.ListRevisions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListRevisions.ListRevisionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListRevisions.ListRevisionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListRevisions.t.Fatal("No results are set for the RevisionRepositoryMock.ListRevisions")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmListRevisions.funcListRevisions != nil {
+		return mmListRevisions.funcListRevisions(ctx, userID, movieID, offset, limit)
+	}
+	mmListRevisions.t.Fatalf("Unexpected call to RevisionRepositoryMock.ListRevisions. %v %v %v %v %v", ctx, userID, movieID, offset, limit)
+	return
+}
+
+// ListRevisionsAfterCounter returns a count of finished RevisionRepositoryMock.ListRevisions invocations
+func (mmListRevisions *RevisionRepositoryMock) ListRevisionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRevisions.afterListRevisionsCounter)
+}
+
+// ListRevisionsBeforeCounter returns a count of RevisionRepositoryMock.ListRevisions invocations
+func (mmListRevisions *RevisionRepositoryMock) ListRevisionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRevisions.beforeListRevisionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RevisionRepositoryMock.ListRevisions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListRevisions *mRevisionRepositoryMockListRevisions) Calls() []*RevisionRepositoryMockListRevisionsParams {
+	mmListRevisions.mutex.RLock()
+
+	argCopy := make([]*RevisionRepositoryMockListRevisionsParams, len(mmListRevisions.callArgs))
+	copy(argCopy, mmListRevisions.callArgs)
+
+	mmListRevisions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListRevisionsDone returns true if the count of the ListRevisions invocations corresponds
+// the number of defined expectations
+func (m *RevisionRepositoryMock) MinimockListRevisionsDone() bool {
+	if m.ListRevisionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListRevisionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListRevisionsMock.invocationsDone()
+}
+
+// MinimockListRevisionsInspect logs each unmet expectation
+func (m *RevisionRepositoryMock) MinimockListRevisionsInspect() {
+	for _, e := range m.ListRevisionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RevisionRepositoryMock.ListRevisions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListRevisionsCounter := mm_atomic.LoadUint64(&m.afterListRevisionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListRevisionsMock.defaultExpectation != nil && afterListRevisionsCounter < 1 {
+		if m.ListRevisionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RevisionRepositoryMock.ListRevisions at\n%s", m.ListRevisionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RevisionRepositoryMock.ListRevisions at\n%s with params: %#v", m.ListRevisionsMock.defaultExpectation.expectationOrigins.origin, *m.ListRevisionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListRevisions != nil && afterListRevisionsCounter < 1 {
+		m.t.Errorf("Expected call to RevisionRepositoryMock.ListRevisions at\n%s", m.funcListRevisionsOrigin)
+	}
+
+	if !m.ListRevisionsMock.invocationsDone() && afterListRevisionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RevisionRepositoryMock.ListRevisions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListRevisionsMock.expectedInvocations), m.ListRevisionsMock.expectedInvocationsOrigin, afterListRevisionsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RevisionRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddRevisionInspect()
+
+			m.MinimockDeleteRevisionsInspect()
+
+			m.MinimockListRevisionsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RevisionRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RevisionRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddRevisionDone() &&
+		m.MinimockDeleteRevisionsDone() &&
+		m.MinimockListRevisionsDone()
+}
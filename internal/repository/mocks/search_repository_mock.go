@@ -0,0 +1,1283 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/maisiq/go-ugc-service/internal/repository.SearchRepository -o search_repository_mock.go -n SearchRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_repository "github.com/maisiq/go-ugc-service/internal/repository"
+)
+
+// SearchRepositoryMock implements mm_repository.SearchRepository
+type SearchRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcIndexReview          func(ctx context.Context, review mm_repository.Review) (err error)
+	funcIndexReviewOrigin    string
+	inspectFuncIndexReview   func(ctx context.Context, review mm_repository.Review)
+	afterIndexReviewCounter  uint64
+	beforeIndexReviewCounter uint64
+	IndexReviewMock          mSearchRepositoryMockIndexReview
+
+	funcRemoveReview          func(ctx context.Context, userID string, movieID string) (err error)
+	funcRemoveReviewOrigin    string
+	inspectFuncRemoveReview   func(ctx context.Context, userID string, movieID string)
+	afterRemoveReviewCounter  uint64
+	beforeRemoveReviewCounter uint64
+	RemoveReviewMock          mSearchRepositoryMockRemoveReview
+
+	funcSearch          func(ctx context.Context, query string, userID string, movieID string, offset int, limit int) (sa1 []mm_repository.SearchHit, err error)
+	funcSearchOrigin    string
+	inspectFuncSearch   func(ctx context.Context, query string, userID string, movieID string, offset int, limit int)
+	afterSearchCounter  uint64
+	beforeSearchCounter uint64
+	SearchMock          mSearchRepositoryMockSearch
+}
+
+// NewSearchRepositoryMock returns a mock for mm_repository.SearchRepository
+func NewSearchRepositoryMock(t minimock.Tester) *SearchRepositoryMock {
+	m := &SearchRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.IndexReviewMock = mSearchRepositoryMockIndexReview{mock: m}
+	m.IndexReviewMock.callArgs = []*SearchRepositoryMockIndexReviewParams{}
+
+	m.RemoveReviewMock = mSearchRepositoryMockRemoveReview{mock: m}
+	m.RemoveReviewMock.callArgs = []*SearchRepositoryMockRemoveReviewParams{}
+
+	m.SearchMock = mSearchRepositoryMockSearch{mock: m}
+	m.SearchMock.callArgs = []*SearchRepositoryMockSearchParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mSearchRepositoryMockIndexReview struct {
+	optional           bool
+	mock               *SearchRepositoryMock
+	defaultExpectation *SearchRepositoryMockIndexReviewExpectation
+	expectations       []*SearchRepositoryMockIndexReviewExpectation
+
+	callArgs []*SearchRepositoryMockIndexReviewParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SearchRepositoryMockIndexReviewExpectation specifies expectation struct of the SearchRepository.IndexReview
+type SearchRepositoryMockIndexReviewExpectation struct {
+	mock               *SearchRepositoryMock
+	params             *SearchRepositoryMockIndexReviewParams
+	paramPtrs          *SearchRepositoryMockIndexReviewParamPtrs
+	expectationOrigins SearchRepositoryMockIndexReviewExpectationOrigins
+	results            *SearchRepositoryMockIndexReviewResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SearchRepositoryMockIndexReviewParams contains parameters of the SearchRepository.IndexReview
+type SearchRepositoryMockIndexReviewParams struct {
+	ctx    context.Context
+	review mm_repository.Review
+}
+
+// SearchRepositoryMockIndexReviewParamPtrs contains pointers to parameters of the SearchRepository.IndexReview
+type SearchRepositoryMockIndexReviewParamPtrs struct {
+	ctx    *context.Context
+	review *mm_repository.Review
+}
+
+// SearchRepositoryMockIndexReviewResults contains results of the SearchRepository.IndexReview
+type SearchRepositoryMockIndexReviewResults struct {
+	err error
+}
+
+// SearchRepositoryMockIndexReviewOrigins contains origins of expectations of the SearchRepository.IndexReview
+type SearchRepositoryMockIndexReviewExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originReview string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIndexReview *mSearchRepositoryMockIndexReview) Optional() *mSearchRepositoryMockIndexReview {
+	mmIndexReview.optional = true
+	return mmIndexReview
+}
+
+// Expect sets up expected params for SearchRepository.IndexReview
+func (mmIndexReview *mSearchRepositoryMockIndexReview) Expect(ctx context.Context, review mm_repository.Review) *mSearchRepositoryMockIndexReview {
+	if mmIndexReview.mock.funcIndexReview != nil {
+		mmIndexReview.mock.t.Fatalf("SearchRepositoryMock.IndexReview mock is already set by Set")
+	}
+
+	if mmIndexReview.defaultExpectation == nil {
+		mmIndexReview.defaultExpectation = &SearchRepositoryMockIndexReviewExpectation{}
+	}
+
+	if mmIndexReview.defaultExpectation.paramPtrs != nil {
+		mmIndexReview.mock.t.Fatalf("SearchRepositoryMock.IndexReview mock is already set by ExpectParams functions")
+	}
+
+	mmIndexReview.defaultExpectation.params = &SearchRepositoryMockIndexReviewParams{ctx, review}
+	mmIndexReview.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIndexReview.expectations {
+		if minimock.Equal(e.params, mmIndexReview.defaultExpectation.params) {
+			mmIndexReview.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIndexReview.defaultExpectation.params)
+		}
+	}
+
+	return mmIndexReview
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SearchRepository.IndexReview
+func (mmIndexReview *mSearchRepositoryMockIndexReview) ExpectCtxParam1(ctx context.Context) *mSearchRepositoryMockIndexReview {
+	if mmIndexReview.mock.funcIndexReview != nil {
+		mmIndexReview.mock.t.Fatalf("SearchRepositoryMock.IndexReview mock is already set by Set")
+	}
+
+	if mmIndexReview.defaultExpectation == nil {
+		mmIndexReview.defaultExpectation = &SearchRepositoryMockIndexReviewExpectation{}
+	}
+
+	if mmIndexReview.defaultExpectation.params != nil {
+		mmIndexReview.mock.t.Fatalf("SearchRepositoryMock.IndexReview mock is already set by Expect")
+	}
+
+	if mmIndexReview.defaultExpectation.paramPtrs == nil {
+		mmIndexReview.defaultExpectation.paramPtrs = &SearchRepositoryMockIndexReviewParamPtrs{}
+	}
+	mmIndexReview.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIndexReview.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmIndexReview
+}
+
+// ExpectReviewParam2 sets up expected param review for SearchRepository.IndexReview
+func (mmIndexReview *mSearchRepositoryMockIndexReview) ExpectReviewParam2(review mm_repository.Review) *mSearchRepositoryMockIndexReview {
+	if mmIndexReview.mock.funcIndexReview != nil {
+		mmIndexReview.mock.t.Fatalf("SearchRepositoryMock.IndexReview mock is already set by Set")
+	}
+
+	if mmIndexReview.defaultExpectation == nil {
+		mmIndexReview.defaultExpectation = &SearchRepositoryMockIndexReviewExpectation{}
+	}
+
+	if mmIndexReview.defaultExpectation.params != nil {
+		mmIndexReview.mock.t.Fatalf("SearchRepositoryMock.IndexReview mock is already set by Expect")
+	}
+
+	if mmIndexReview.defaultExpectation.paramPtrs == nil {
+		mmIndexReview.defaultExpectation.paramPtrs = &SearchRepositoryMockIndexReviewParamPtrs{}
+	}
+	mmIndexReview.defaultExpectation.paramPtrs.review = &review
+	mmIndexReview.defaultExpectation.expectationOrigins.originReview = minimock.CallerInfo(1)
+
+	return mmIndexReview
+}
+
+// Inspect accepts an inspector function that has same arguments as the SearchRepository.IndexReview
+func (mmIndexReview *mSearchRepositoryMockIndexReview) Inspect(f func(ctx context.Context, review mm_repository.Review)) *mSearchRepositoryMockIndexReview {
+	if mmIndexReview.mock.inspectFuncIndexReview != nil {
+		mmIndexReview.mock.t.Fatalf("Inspect function is already set for SearchRepositoryMock.IndexReview")
+	}
+
+	mmIndexReview.mock.inspectFuncIndexReview = f
+
+	return mmIndexReview
+}
+
+// Return sets up results that will be returned by SearchRepository.IndexReview
+func (mmIndexReview *mSearchRepositoryMockIndexReview) Return(err error) *SearchRepositoryMock {
+	if mmIndexReview.mock.funcIndexReview != nil {
+		mmIndexReview.mock.t.Fatalf("SearchRepositoryMock.IndexReview mock is already set by Set")
+	}
+
+	if mmIndexReview.defaultExpectation == nil {
+		mmIndexReview.defaultExpectation = &SearchRepositoryMockIndexReviewExpectation{mock: mmIndexReview.mock}
+	}
+	mmIndexReview.defaultExpectation.results = &SearchRepositoryMockIndexReviewResults{err}
+	mmIndexReview.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIndexReview.mock
+}
+
+// Set uses given function f to mock the SearchRepository.IndexReview method
+func (mmIndexReview *mSearchRepositoryMockIndexReview) Set(f func(ctx context.Context, review mm_repository.Review) (err error)) *SearchRepositoryMock {
+	if mmIndexReview.defaultExpectation != nil {
+		mmIndexReview.mock.t.Fatalf("Default expectation is already set for the SearchRepository.IndexReview method")
+	}
+
+	if len(mmIndexReview.expectations) > 0 {
+		mmIndexReview.mock.t.Fatalf("Some expectations are already set for the SearchRepository.IndexReview method")
+	}
+
+	mmIndexReview.mock.funcIndexReview = f
+	mmIndexReview.mock.funcIndexReviewOrigin = minimock.CallerInfo(1)
+	return mmIndexReview.mock
+}
+
+// When sets expectation for the SearchRepository.IndexReview which will trigger the result defined by the following
+// Then helper
+func (mmIndexReview *mSearchRepositoryMockIndexReview) When(ctx context.Context, review mm_repository.Review) *SearchRepositoryMockIndexReviewExpectation {
+	if mmIndexReview.mock.funcIndexReview != nil {
+		mmIndexReview.mock.t.Fatalf("SearchRepositoryMock.IndexReview mock is already set by Set")
+	}
+
+	expectation := &SearchRepositoryMockIndexReviewExpectation{
+		mock:               mmIndexReview.mock,
+		params:             &SearchRepositoryMockIndexReviewParams{ctx, review},
+		expectationOrigins: SearchRepositoryMockIndexReviewExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmIndexReview.expectations = append(mmIndexReview.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SearchRepository.IndexReview return parameters for the expectation previously defined by the When method
+func (e *SearchRepositoryMockIndexReviewExpectation) Then(err error) *SearchRepositoryMock {
+	e.results = &SearchRepositoryMockIndexReviewResults{err}
+	return e.mock
+}
+
+// Times sets number of times SearchRepository.IndexReview should be invoked
+func (mmIndexReview *mSearchRepositoryMockIndexReview) Times(n uint64) *mSearchRepositoryMockIndexReview {
+	if n == 0 {
+		mmIndexReview.mock.t.Fatalf("Times of SearchRepositoryMock.IndexReview mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmIndexReview.expectedInvocations, n)
+	mmIndexReview.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIndexReview
+}
+
+func (mmIndexReview *mSearchRepositoryMockIndexReview) invocationsDone() bool {
+	if len(mmIndexReview.expectations) == 0 && mmIndexReview.defaultExpectation == nil && mmIndexReview.mock.funcIndexReview == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmIndexReview.mock.afterIndexReviewCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIndexReview.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// IndexReview implements mm_repository.SearchRepository
+func (mmIndexReview *SearchRepositoryMock) IndexReview(ctx context.Context, review mm_repository.Review) (err error) {
+	mm_atomic.AddUint64(&mmIndexReview.beforeIndexReviewCounter, 1)
+	defer mm_atomic.AddUint64(&mmIndexReview.afterIndexReviewCounter, 1)
+
+	mmIndexReview.t.Helper()
+
+	if mmIndexReview.inspectFuncIndexReview != nil {
+		mmIndexReview.inspectFuncIndexReview(ctx, review)
+	}
+
+	mm_params := SearchRepositoryMockIndexReviewParams{ctx, review}
+
+	// Record call args
+	mmIndexReview.IndexReviewMock.mutex.Lock()
+	mmIndexReview.IndexReviewMock.callArgs = append(mmIndexReview.IndexReviewMock.callArgs, &mm_params)
+	mmIndexReview.IndexReviewMock.mutex.Unlock()
+
+	for _, e := range mmIndexReview.IndexReviewMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmIndexReview.IndexReviewMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIndexReview.IndexReviewMock.defaultExpectation.Counter, 1)
+		mm_want := mmIndexReview.IndexReviewMock.defaultExpectation.params
+		mm_want_ptrs := mmIndexReview.IndexReviewMock.defaultExpectation.paramPtrs
+
+		mm_got := SearchRepositoryMockIndexReviewParams{ctx, review}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIndexReview.t.Errorf("SearchRepositoryMock.IndexReview got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIndexReview.IndexReviewMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.review != nil && !minimock.Equal(*mm_want_ptrs.review, mm_got.review) {
+				mmIndexReview.t.Errorf("SearchRepositoryMock.IndexReview got unexpected parameter review, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIndexReview.IndexReviewMock.defaultExpectation.expectationOrigins.originReview, *mm_want_ptrs.review, mm_got.review, minimock.Diff(*mm_want_ptrs.review, mm_got.review))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIndexReview.t.Errorf("SearchRepositoryMock.IndexReview got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIndexReview.IndexReviewMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIndexReview.IndexReviewMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIndexReview.t.Fatal("No results are set for the SearchRepositoryMock.IndexReview")
+		}
+		return (*mm_results).err
+	}
+	if mmIndexReview.funcIndexReview != nil {
+		return mmIndexReview.funcIndexReview(ctx, review)
+	}
+	mmIndexReview.t.Fatalf("Unexpected call to SearchRepositoryMock.IndexReview. %v %v", ctx, review)
+	return
+}
+
+// IndexReviewAfterCounter returns a count of finished SearchRepositoryMock.IndexReview invocations
+func (mmIndexReview *SearchRepositoryMock) IndexReviewAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIndexReview.afterIndexReviewCounter)
+}
+
+// IndexReviewBeforeCounter returns a count of SearchRepositoryMock.IndexReview invocations
+func (mmIndexReview *SearchRepositoryMock) IndexReviewBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIndexReview.beforeIndexReviewCounter)
+}
+
+// Calls returns a list of arguments used in each call to SearchRepositoryMock.IndexReview.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIndexReview *mSearchRepositoryMockIndexReview) Calls() []*SearchRepositoryMockIndexReviewParams {
+	mmIndexReview.mutex.RLock()
+
+	argCopy := make([]*SearchRepositoryMockIndexReviewParams, len(mmIndexReview.callArgs))
+	copy(argCopy, mmIndexReview.callArgs)
+
+	mmIndexReview.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIndexReviewDone returns true if the count of the IndexReview invocations corresponds
+// the number of defined expectations
+func (m *SearchRepositoryMock) MinimockIndexReviewDone() bool {
+	if m.IndexReviewMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.IndexReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.IndexReviewMock.invocationsDone()
+}
+
+// MinimockIndexReviewInspect logs each unmet expectation
+func (m *SearchRepositoryMock) MinimockIndexReviewInspect() {
+	for _, e := range m.IndexReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SearchRepositoryMock.IndexReview at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterIndexReviewCounter := mm_atomic.LoadUint64(&m.afterIndexReviewCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IndexReviewMock.defaultExpectation != nil && afterIndexReviewCounter < 1 {
+		if m.IndexReviewMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SearchRepositoryMock.IndexReview at\n%s", m.IndexReviewMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SearchRepositoryMock.IndexReview at\n%s with params: %#v", m.IndexReviewMock.defaultExpectation.expectationOrigins.origin, *m.IndexReviewMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIndexReview != nil && afterIndexReviewCounter < 1 {
+		m.t.Errorf("Expected call to SearchRepositoryMock.IndexReview at\n%s", m.funcIndexReviewOrigin)
+	}
+
+	if !m.IndexReviewMock.invocationsDone() && afterIndexReviewCounter > 0 {
+		m.t.Errorf("Expected %d calls to SearchRepositoryMock.IndexReview at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IndexReviewMock.expectedInvocations), m.IndexReviewMock.expectedInvocationsOrigin, afterIndexReviewCounter)
+	}
+}
+
+type mSearchRepositoryMockRemoveReview struct {
+	optional           bool
+	mock               *SearchRepositoryMock
+	defaultExpectation *SearchRepositoryMockRemoveReviewExpectation
+	expectations       []*SearchRepositoryMockRemoveReviewExpectation
+
+	callArgs []*SearchRepositoryMockRemoveReviewParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SearchRepositoryMockRemoveReviewExpectation specifies expectation struct of the SearchRepository.RemoveReview
+type SearchRepositoryMockRemoveReviewExpectation struct {
+	mock               *SearchRepositoryMock
+	params             *SearchRepositoryMockRemoveReviewParams
+	paramPtrs          *SearchRepositoryMockRemoveReviewParamPtrs
+	expectationOrigins SearchRepositoryMockRemoveReviewExpectationOrigins
+	results            *SearchRepositoryMockRemoveReviewResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SearchRepositoryMockRemoveReviewParams contains parameters of the SearchRepository.RemoveReview
+type SearchRepositoryMockRemoveReviewParams struct {
+	ctx     context.Context
+	userID  string
+	movieID string
+}
+
+// SearchRepositoryMockRemoveReviewParamPtrs contains pointers to parameters of the SearchRepository.RemoveReview
+type SearchRepositoryMockRemoveReviewParamPtrs struct {
+	ctx     *context.Context
+	userID  *string
+	movieID *string
+}
+
+// SearchRepositoryMockRemoveReviewResults contains results of the SearchRepository.RemoveReview
+type SearchRepositoryMockRemoveReviewResults struct {
+	err error
+}
+
+// SearchRepositoryMockRemoveReviewOrigins contains origins of expectations of the SearchRepository.RemoveReview
+type SearchRepositoryMockRemoveReviewExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originMovieID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveReview *mSearchRepositoryMockRemoveReview) Optional() *mSearchRepositoryMockRemoveReview {
+	mmRemoveReview.optional = true
+	return mmRemoveReview
+}
+
+// Expect sets up expected params for SearchRepository.RemoveReview
+func (mmRemoveReview *mSearchRepositoryMockRemoveReview) Expect(ctx context.Context, userID string, movieID string) *mSearchRepositoryMockRemoveReview {
+	if mmRemoveReview.mock.funcRemoveReview != nil {
+		mmRemoveReview.mock.t.Fatalf("SearchRepositoryMock.RemoveReview mock is already set by Set")
+	}
+
+	if mmRemoveReview.defaultExpectation == nil {
+		mmRemoveReview.defaultExpectation = &SearchRepositoryMockRemoveReviewExpectation{}
+	}
+
+	if mmRemoveReview.defaultExpectation.paramPtrs != nil {
+		mmRemoveReview.mock.t.Fatalf("SearchRepositoryMock.RemoveReview mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveReview.defaultExpectation.params = &SearchRepositoryMockRemoveReviewParams{ctx, userID, movieID}
+	mmRemoveReview.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveReview.expectations {
+		if minimock.Equal(e.params, mmRemoveReview.defaultExpectation.params) {
+			mmRemoveReview.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveReview.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveReview
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SearchRepository.RemoveReview
+func (mmRemoveReview *mSearchRepositoryMockRemoveReview) ExpectCtxParam1(ctx context.Context) *mSearchRepositoryMockRemoveReview {
+	if mmRemoveReview.mock.funcRemoveReview != nil {
+		mmRemoveReview.mock.t.Fatalf("SearchRepositoryMock.RemoveReview mock is already set by Set")
+	}
+
+	if mmRemoveReview.defaultExpectation == nil {
+		mmRemoveReview.defaultExpectation = &SearchRepositoryMockRemoveReviewExpectation{}
+	}
+
+	if mmRemoveReview.defaultExpectation.params != nil {
+		mmRemoveReview.mock.t.Fatalf("SearchRepositoryMock.RemoveReview mock is already set by Expect")
+	}
+
+	if mmRemoveReview.defaultExpectation.paramPtrs == nil {
+		mmRemoveReview.defaultExpectation.paramPtrs = &SearchRepositoryMockRemoveReviewParamPtrs{}
+	}
+	mmRemoveReview.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveReview.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveReview
+}
+
+// ExpectUserIDParam2 sets up expected param userID for SearchRepository.RemoveReview
+func (mmRemoveReview *mSearchRepositoryMockRemoveReview) ExpectUserIDParam2(userID string) *mSearchRepositoryMockRemoveReview {
+	if mmRemoveReview.mock.funcRemoveReview != nil {
+		mmRemoveReview.mock.t.Fatalf("SearchRepositoryMock.RemoveReview mock is already set by Set")
+	}
+
+	if mmRemoveReview.defaultExpectation == nil {
+		mmRemoveReview.defaultExpectation = &SearchRepositoryMockRemoveReviewExpectation{}
+	}
+
+	if mmRemoveReview.defaultExpectation.params != nil {
+		mmRemoveReview.mock.t.Fatalf("SearchRepositoryMock.RemoveReview mock is already set by Expect")
+	}
+
+	if mmRemoveReview.defaultExpectation.paramPtrs == nil {
+		mmRemoveReview.defaultExpectation.paramPtrs = &SearchRepositoryMockRemoveReviewParamPtrs{}
+	}
+	mmRemoveReview.defaultExpectation.paramPtrs.userID = &userID
+	mmRemoveReview.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRemoveReview
+}
+
+// ExpectMovieIDParam3 sets up expected param movieID for SearchRepository.RemoveReview
+func (mmRemoveReview *mSearchRepositoryMockRemoveReview) ExpectMovieIDParam3(movieID string) *mSearchRepositoryMockRemoveReview {
+	if mmRemoveReview.mock.funcRemoveReview != nil {
+		mmRemoveReview.mock.t.Fatalf("SearchRepositoryMock.RemoveReview mock is already set by Set")
+	}
+
+	if mmRemoveReview.defaultExpectation == nil {
+		mmRemoveReview.defaultExpectation = &SearchRepositoryMockRemoveReviewExpectation{}
+	}
+
+	if mmRemoveReview.defaultExpectation.params != nil {
+		mmRemoveReview.mock.t.Fatalf("SearchRepositoryMock.RemoveReview mock is already set by Expect")
+	}
+
+	if mmRemoveReview.defaultExpectation.paramPtrs == nil {
+		mmRemoveReview.defaultExpectation.paramPtrs = &SearchRepositoryMockRemoveReviewParamPtrs{}
+	}
+	mmRemoveReview.defaultExpectation.paramPtrs.movieID = &movieID
+	mmRemoveReview.defaultExpectation.expectationOrigins.originMovieID = minimock.CallerInfo(1)
+
+	return mmRemoveReview
+}
+
+// Inspect accepts an inspector function that has same arguments as the SearchRepository.RemoveReview
+func (mmRemoveReview *mSearchRepositoryMockRemoveReview) Inspect(f func(ctx context.Context, userID string, movieID string)) *mSearchRepositoryMockRemoveReview {
+	if mmRemoveReview.mock.inspectFuncRemoveReview != nil {
+		mmRemoveReview.mock.t.Fatalf("Inspect function is already set for SearchRepositoryMock.RemoveReview")
+	}
+
+	mmRemoveReview.mock.inspectFuncRemoveReview = f
+
+	return mmRemoveReview
+}
+
+// Return sets up results that will be returned by SearchRepository.RemoveReview
+func (mmRemoveReview *mSearchRepositoryMockRemoveReview) Return(err error) *SearchRepositoryMock {
+	if mmRemoveReview.mock.funcRemoveReview != nil {
+		mmRemoveReview.mock.t.Fatalf("SearchRepositoryMock.RemoveReview mock is already set by Set")
+	}
+
+	if mmRemoveReview.defaultExpectation == nil {
+		mmRemoveReview.defaultExpectation = &SearchRepositoryMockRemoveReviewExpectation{mock: mmRemoveReview.mock}
+	}
+	mmRemoveReview.defaultExpectation.results = &SearchRepositoryMockRemoveReviewResults{err}
+	mmRemoveReview.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveReview.mock
+}
+
+// Set uses given function f to mock the SearchRepository.RemoveReview method
+func (mmRemoveReview *mSearchRepositoryMockRemoveReview) Set(f func(ctx context.Context, userID string, movieID string) (err error)) *SearchRepositoryMock {
+	if mmRemoveReview.defaultExpectation != nil {
+		mmRemoveReview.mock.t.Fatalf("Default expectation is already set for the SearchRepository.RemoveReview method")
+	}
+
+	if len(mmRemoveReview.expectations) > 0 {
+		mmRemoveReview.mock.t.Fatalf("Some expectations are already set for the SearchRepository.RemoveReview method")
+	}
+
+	mmRemoveReview.mock.funcRemoveReview = f
+	mmRemoveReview.mock.funcRemoveReviewOrigin = minimock.CallerInfo(1)
+	return mmRemoveReview.mock
+}
+
+// When sets expectation for the SearchRepository.RemoveReview which will trigger the result defined by the following
+// Then helper
+func (mmRemoveReview *mSearchRepositoryMockRemoveReview) When(ctx context.Context, userID string, movieID string) *SearchRepositoryMockRemoveReviewExpectation {
+	if mmRemoveReview.mock.funcRemoveReview != nil {
+		mmRemoveReview.mock.t.Fatalf("SearchRepositoryMock.RemoveReview mock is already set by Set")
+	}
+
+	expectation := &SearchRepositoryMockRemoveReviewExpectation{
+		mock:               mmRemoveReview.mock,
+		params:             &SearchRepositoryMockRemoveReviewParams{ctx, userID, movieID},
+		expectationOrigins: SearchRepositoryMockRemoveReviewExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveReview.expectations = append(mmRemoveReview.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SearchRepository.RemoveReview return parameters for the expectation previously defined by the When method
+func (e *SearchRepositoryMockRemoveReviewExpectation) Then(err error) *SearchRepositoryMock {
+	e.results = &SearchRepositoryMockRemoveReviewResults{err}
+	return e.mock
+}
+
+// Times sets number of times SearchRepository.RemoveReview should be invoked
+func (mmRemoveReview *mSearchRepositoryMockRemoveReview) Times(n uint64) *mSearchRepositoryMockRemoveReview {
+	if n == 0 {
+		mmRemoveReview.mock.t.Fatalf("Times of SearchRepositoryMock.RemoveReview mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveReview.expectedInvocations, n)
+	mmRemoveReview.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveReview
+}
+
+func (mmRemoveReview *mSearchRepositoryMockRemoveReview) invocationsDone() bool {
+	if len(mmRemoveReview.expectations) == 0 && mmRemoveReview.defaultExpectation == nil && mmRemoveReview.mock.funcRemoveReview == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveReview.mock.afterRemoveReviewCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveReview.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveReview implements mm_repository.SearchRepository
+func (mmRemoveReview *SearchRepositoryMock) RemoveReview(ctx context.Context, userID string, movieID string) (err error) {
+	mm_atomic.AddUint64(&mmRemoveReview.beforeRemoveReviewCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveReview.afterRemoveReviewCounter, 1)
+
+	mmRemoveReview.t.Helper()
+
+	if mmRemoveReview.inspectFuncRemoveReview != nil {
+		mmRemoveReview.inspectFuncRemoveReview(ctx, userID, movieID)
+	}
+
+	mm_params := SearchRepositoryMockRemoveReviewParams{ctx, userID, movieID}
+
+	// Record call args
+	mmRemoveReview.RemoveReviewMock.mutex.Lock()
+	mmRemoveReview.RemoveReviewMock.callArgs = append(mmRemoveReview.RemoveReviewMock.callArgs, &mm_params)
+	mmRemoveReview.RemoveReviewMock.mutex.Unlock()
+
+	for _, e := range mmRemoveReview.RemoveReviewMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveReview.RemoveReviewMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveReview.RemoveReviewMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveReview.RemoveReviewMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveReview.RemoveReviewMock.defaultExpectation.paramPtrs
+
+		mm_got := SearchRepositoryMockRemoveReviewParams{ctx, userID, movieID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveReview.t.Errorf("SearchRepositoryMock.RemoveReview got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReview.RemoveReviewMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRemoveReview.t.Errorf("SearchRepositoryMock.RemoveReview got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReview.RemoveReviewMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.movieID != nil && !minimock.Equal(*mm_want_ptrs.movieID, mm_got.movieID) {
+				mmRemoveReview.t.Errorf("SearchRepositoryMock.RemoveReview got unexpected parameter movieID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReview.RemoveReviewMock.defaultExpectation.expectationOrigins.originMovieID, *mm_want_ptrs.movieID, mm_got.movieID, minimock.Diff(*mm_want_ptrs.movieID, mm_got.movieID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveReview.t.Errorf("SearchRepositoryMock.RemoveReview got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveReview.RemoveReviewMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveReview.RemoveReviewMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveReview.t.Fatal("No results are set for the SearchRepositoryMock.RemoveReview")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveReview.funcRemoveReview != nil {
+		return mmRemoveReview.funcRemoveReview(ctx, userID, movieID)
+	}
+	mmRemoveReview.t.Fatalf("Unexpected call to SearchRepositoryMock.RemoveReview. %v %v %v", ctx, userID, movieID)
+	return
+}
+
+// RemoveReviewAfterCounter returns a count of finished SearchRepositoryMock.RemoveReview invocations
+func (mmRemoveReview *SearchRepositoryMock) RemoveReviewAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveReview.afterRemoveReviewCounter)
+}
+
+// RemoveReviewBeforeCounter returns a count of SearchRepositoryMock.RemoveReview invocations
+func (mmRemoveReview *SearchRepositoryMock) RemoveReviewBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveReview.beforeRemoveReviewCounter)
+}
+
+// Calls returns a list of arguments used in each call to SearchRepositoryMock.RemoveReview.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveReview *mSearchRepositoryMockRemoveReview) Calls() []*SearchRepositoryMockRemoveReviewParams {
+	mmRemoveReview.mutex.RLock()
+
+	argCopy := make([]*SearchRepositoryMockRemoveReviewParams, len(mmRemoveReview.callArgs))
+	copy(argCopy, mmRemoveReview.callArgs)
+
+	mmRemoveReview.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveReviewDone returns true if the count of the RemoveReview invocations corresponds
+// the number of defined expectations
+func (m *SearchRepositoryMock) MinimockRemoveReviewDone() bool {
+	if m.RemoveReviewMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveReviewMock.invocationsDone()
+}
+
+// MinimockRemoveReviewInspect logs each unmet expectation
+func (m *SearchRepositoryMock) MinimockRemoveReviewInspect() {
+	for _, e := range m.RemoveReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SearchRepositoryMock.RemoveReview at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveReviewCounter := mm_atomic.LoadUint64(&m.afterRemoveReviewCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveReviewMock.defaultExpectation != nil && afterRemoveReviewCounter < 1 {
+		if m.RemoveReviewMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SearchRepositoryMock.RemoveReview at\n%s", m.RemoveReviewMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SearchRepositoryMock.RemoveReview at\n%s with params: %#v", m.RemoveReviewMock.defaultExpectation.expectationOrigins.origin, *m.RemoveReviewMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveReview != nil && afterRemoveReviewCounter < 1 {
+		m.t.Errorf("Expected call to SearchRepositoryMock.RemoveReview at\n%s", m.funcRemoveReviewOrigin)
+	}
+
+	if !m.RemoveReviewMock.invocationsDone() && afterRemoveReviewCounter > 0 {
+		m.t.Errorf("Expected %d calls to SearchRepositoryMock.RemoveReview at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveReviewMock.expectedInvocations), m.RemoveReviewMock.expectedInvocationsOrigin, afterRemoveReviewCounter)
+	}
+}
+
+type mSearchRepositoryMockSearch struct {
+	optional           bool
+	mock               *SearchRepositoryMock
+	defaultExpectation *SearchRepositoryMockSearchExpectation
+	expectations       []*SearchRepositoryMockSearchExpectation
+
+	callArgs []*SearchRepositoryMockSearchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SearchRepositoryMockSearchExpectation specifies expectation struct of the SearchRepository.Search
+type SearchRepositoryMockSearchExpectation struct {
+	mock               *SearchRepositoryMock
+	params             *SearchRepositoryMockSearchParams
+	paramPtrs          *SearchRepositoryMockSearchParamPtrs
+	expectationOrigins SearchRepositoryMockSearchExpectationOrigins
+	results            *SearchRepositoryMockSearchResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SearchRepositoryMockSearchParams contains parameters of the SearchRepository.Search
+type SearchRepositoryMockSearchParams struct {
+	ctx     context.Context
+	query   string
+	userID  string
+	movieID string
+	offset  int
+	limit   int
+}
+
+// SearchRepositoryMockSearchParamPtrs contains pointers to parameters of the SearchRepository.Search
+type SearchRepositoryMockSearchParamPtrs struct {
+	ctx     *context.Context
+	query   *string
+	userID  *string
+	movieID *string
+	offset  *int
+	limit   *int
+}
+
+// SearchRepositoryMockSearchResults contains results of the SearchRepository.Search
+type SearchRepositoryMockSearchResults struct {
+	sa1 []mm_repository.SearchHit
+	err error
+}
+
+// SearchRepositoryMockSearchOrigins contains origins of expectations of the SearchRepository.Search
+type SearchRepositoryMockSearchExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originQuery   string
+	originUserID  string
+	originMovieID string
+	originOffset  string
+	originLimit   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSearch *mSearchRepositoryMockSearch) Optional() *mSearchRepositoryMockSearch {
+	mmSearch.optional = true
+	return mmSearch
+}
+
+// Expect sets up expected params for SearchRepository.Search
+func (mmSearch *mSearchRepositoryMockSearch) Expect(ctx context.Context, query string, userID string, movieID string, offset int, limit int) *mSearchRepositoryMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("SearchRepositoryMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &SearchRepositoryMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs != nil {
+		mmSearch.mock.t.Fatalf("SearchRepositoryMock.Search mock is already set by ExpectParams functions")
+	}
+
+	mmSearch.defaultExpectation.params = &SearchRepositoryMockSearchParams{ctx, query, userID, movieID, offset, limit}
+	mmSearch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSearch.expectations {
+		if minimock.Equal(e.params, mmSearch.defaultExpectation.params) {
+			mmSearch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearch.defaultExpectation.params)
+		}
+	}
+
+	return mmSearch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SearchRepository.Search
+func (mmSearch *mSearchRepositoryMockSearch) ExpectCtxParam1(ctx context.Context) *mSearchRepositoryMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("SearchRepositoryMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &SearchRepositoryMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.params != nil {
+		mmSearch.mock.t.Fatalf("SearchRepositoryMock.Search mock is already set by Expect")
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs == nil {
+		mmSearch.defaultExpectation.paramPtrs = &SearchRepositoryMockSearchParamPtrs{}
+	}
+	mmSearch.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSearch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSearch
+}
+
+// ExpectQueryParam2 sets up expected param query for SearchRepository.Search
+func (mmSearch *mSearchRepositoryMockSearch) ExpectQueryParam2(query string) *mSearchRepositoryMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("SearchRepositoryMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &SearchRepositoryMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.params != nil {
+		mmSearch.mock.t.Fatalf("SearchRepositoryMock.Search mock is already set by Expect")
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs == nil {
+		mmSearch.defaultExpectation.paramPtrs = &SearchRepositoryMockSearchParamPtrs{}
+	}
+	mmSearch.defaultExpectation.paramPtrs.query = &query
+	mmSearch.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
+
+	return mmSearch
+}
+
+// ExpectUserIDParam3 sets up expected param userID for SearchRepository.Search
+func (mmSearch *mSearchRepositoryMockSearch) ExpectUserIDParam3(userID string) *mSearchRepositoryMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("SearchRepositoryMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &SearchRepositoryMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.params != nil {
+		mmSearch.mock.t.Fatalf("SearchRepositoryMock.Search mock is already set by Expect")
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs == nil {
+		mmSearch.defaultExpectation.paramPtrs = &SearchRepositoryMockSearchParamPtrs{}
+	}
+	mmSearch.defaultExpectation.paramPtrs.userID = &userID
+	mmSearch.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmSearch
+}
+
+// ExpectMovieIDParam4 sets up expected param movieID for SearchRepository.Search
+func (mmSearch *mSearchRepositoryMockSearch) ExpectMovieIDParam4(movieID string) *mSearchRepositoryMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("SearchRepositoryMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &SearchRepositoryMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.params != nil {
+		mmSearch.mock.t.Fatalf("SearchRepositoryMock.Search mock is already set by Expect")
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs == nil {
+		mmSearch.defaultExpectation.paramPtrs = &SearchRepositoryMockSearchParamPtrs{}
+	}
+	mmSearch.defaultExpectation.paramPtrs.movieID = &movieID
+	mmSearch.defaultExpectation.expectationOrigins.originMovieID = minimock.CallerInfo(1)
+
+	return mmSearch
+}
+
+// ExpectOffsetParam5 sets up expected param offset for SearchRepository.Search
+func (mmSearch *mSearchRepositoryMockSearch) ExpectOffsetParam5(offset int) *mSearchRepositoryMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("SearchRepositoryMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &SearchRepositoryMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.params != nil {
+		mmSearch.mock.t.Fatalf("SearchRepositoryMock.Search mock is already set by Expect")
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs == nil {
+		mmSearch.defaultExpectation.paramPtrs = &SearchRepositoryMockSearchParamPtrs{}
+	}
+	mmSearch.defaultExpectation.paramPtrs.offset = &offset
+	mmSearch.defaultExpectation.expectationOrigins.originOffset = minimock.CallerInfo(1)
+
+	return mmSearch
+}
+
+// ExpectLimitParam6 sets up expected param limit for SearchRepository.Search
+func (mmSearch *mSearchRepositoryMockSearch) ExpectLimitParam6(limit int) *mSearchRepositoryMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("SearchRepositoryMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &SearchRepositoryMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.params != nil {
+		mmSearch.mock.t.Fatalf("SearchRepositoryMock.Search mock is already set by Expect")
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs == nil {
+		mmSearch.defaultExpectation.paramPtrs = &SearchRepositoryMockSearchParamPtrs{}
+	}
+	mmSearch.defaultExpectation.paramPtrs.limit = &limit
+	mmSearch.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmSearch
+}
+
+// Inspect accepts an inspector function that has same arguments as the SearchRepository.Search
+func (mmSearch *mSearchRepositoryMockSearch) Inspect(f func(ctx context.Context, query string, userID string, movieID string, offset int, limit int)) *mSearchRepositoryMockSearch {
+	if mmSearch.mock.inspectFuncSearch != nil {
+		mmSearch.mock.t.Fatalf("Inspect function is already set for SearchRepositoryMock.Search")
+	}
+
+	mmSearch.mock.inspectFuncSearch = f
+
+	return mmSearch
+}
+
+// Return sets up results that will be returned by SearchRepository.Search
+func (mmSearch *mSearchRepositoryMockSearch) Return(sa1 []mm_repository.SearchHit, err error) *SearchRepositoryMock {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("SearchRepositoryMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &SearchRepositoryMockSearchExpectation{mock: mmSearch.mock}
+	}
+	mmSearch.defaultExpectation.results = &SearchRepositoryMockSearchResults{sa1, err}
+	mmSearch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSearch.mock
+}
+
+// Set uses given function f to mock the SearchRepository.Search method
+func (mmSearch *mSearchRepositoryMockSearch) Set(f func(ctx context.Context, query string, userID string, movieID string, offset int, limit int) (sa1 []mm_repository.SearchHit, err error)) *SearchRepositoryMock {
+	if mmSearch.defaultExpectation != nil {
+		mmSearch.mock.t.Fatalf("Default expectation is already set for the SearchRepository.Search method")
+	}
+
+	if len(mmSearch.expectations) > 0 {
+		mmSearch.mock.t.Fatalf("Some expectations are already set for the SearchRepository.Search method")
+	}
+
+	mmSearch.mock.funcSearch = f
+	mmSearch.mock.funcSearchOrigin = minimock.CallerInfo(1)
+	return mmSearch.mock
+}
+
+// When sets expectation for the SearchRepository.Search which will trigger the result defined by the following
+// Then helper
+func (mmSearch *mSearchRepositoryMockSearch) When(ctx context.Context, query string, userID string, movieID string, offset int, limit int) *SearchRepositoryMockSearchExpectation {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("SearchRepositoryMock.Search mock is already set by Set")
+	}
+
+	expectation := &SearchRepositoryMockSearchExpectation{
+		mock:               mmSearch.mock,
+		params:             &SearchRepositoryMockSearchParams{ctx, query, userID, movieID, offset, limit},
+		expectationOrigins: SearchRepositoryMockSearchExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSearch.expectations = append(mmSearch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SearchRepository.Search return parameters for the expectation previously defined by the When method
+func (e *SearchRepositoryMockSearchExpectation) Then(sa1 []mm_repository.SearchHit, err error) *SearchRepositoryMock {
+	e.results = &SearchRepositoryMockSearchResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times SearchRepository.Search should be invoked
+func (mmSearch *mSearchRepositoryMockSearch) Times(n uint64) *mSearchRepositoryMockSearch {
+	if n == 0 {
+		mmSearch.mock.t.Fatalf("Times of SearchRepositoryMock.Search mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSearch.expectedInvocations, n)
+	mmSearch.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSearch
+}
+
+func (mmSearch *mSearchRepositoryMockSearch) invocationsDone() bool {
+	if len(mmSearch.expectations) == 0 && mmSearch.defaultExpectation == nil && mmSearch.mock.funcSearch == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSearch.mock.afterSearchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSearch.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Search implements mm_repository.SearchRepository
+func (mmSearch *SearchRepositoryMock) Search(ctx context.Context, query string, userID string, movieID string, offset int, limit int) (sa1 []mm_repository.SearchHit, err error) {
+	mm_atomic.AddUint64(&mmSearch.beforeSearchCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearch.afterSearchCounter, 1)
+
+	mmSearch.t.Helper()
+
+	if mmSearch.inspectFuncSearch != nil {
+		mmSearch.inspectFuncSearch(ctx, query, userID, movieID, offset, limit)
+	}
+
+	mm_params := SearchRepositoryMockSearchParams{ctx, query, userID, movieID, offset, limit}
+
+	// Record call args
+	mmSearch.SearchMock.mutex.Lock()
+	mmSearch.SearchMock.callArgs = append(mmSearch.SearchMock.callArgs, &mm_params)
+	mmSearch.SearchMock.mutex.Unlock()
+
+	for _, e := range mmSearch.SearchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmSearch.SearchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearch.SearchMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearch.SearchMock.defaultExpectation.params
+		mm_want_ptrs := mmSearch.SearchMock.defaultExpectation.paramPtrs
+
+		mm_got := SearchRepositoryMockSearchParams{ctx, query, userID, movieID, offset, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSearch.t.Errorf("SearchRepositoryMock.Search got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearch.SearchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmSearch.t.Errorf("SearchRepositoryMock.Search got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearch.SearchMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSearch.t.Errorf("SearchRepositoryMock.Search got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearch.SearchMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.movieID != nil && !minimock.Equal(*mm_want_ptrs.movieID, mm_got.movieID) {
+				mmSearch.t.Errorf("SearchRepositoryMock.Search got unexpected parameter movieID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearch.SearchMock.defaultExpectation.expectationOrigins.originMovieID, *mm_want_ptrs.movieID, mm_got.movieID, minimock.Diff(*mm_want_ptrs.movieID, mm_got.movieID))
+			}
+
+			if mm_want_ptrs.offset != nil && !minimock.Equal(*mm_want_ptrs.offset, mm_got.offset) {
+				mmSearch.t.Errorf("SearchRepositoryMock.Search got unexpected parameter offset, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearch.SearchMock.defaultExpectation.expectationOrigins.originOffset, *mm_want_ptrs.offset, mm_got.offset, minimock.Diff(*mm_want_ptrs.offset, mm_got.offset))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmSearch.t.Errorf("SearchRepositoryMock.Search got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearch.SearchMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSearch.t.Errorf("SearchRepositoryMock.Search got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSearch.SearchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSearch.SearchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSearch.t.Fatal("No results are set for the SearchRepositoryMock.Search")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmSearch.funcSearch != nil {
+		return mmSearch.funcSearch(ctx, query, userID, movieID, offset, limit)
+	}
+	mmSearch.t.Fatalf("Unexpected call to SearchRepositoryMock.Search. %v %v %v %v %v %v", ctx, query, userID, movieID, offset, limit)
+	return
+}
+
+// SearchAfterCounter returns a count of finished SearchRepositoryMock.Search invocations
+func (mmSearch *SearchRepositoryMock) SearchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearch.afterSearchCounter)
+}
+
+// SearchBeforeCounter returns a count of SearchRepositoryMock.Search invocations
+func (mmSearch *SearchRepositoryMock) SearchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearch.beforeSearchCounter)
+}
+
+// Calls returns a list of arguments used in each call to SearchRepositoryMock.Search.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSearch *mSearchRepositoryMockSearch) Calls() []*SearchRepositoryMockSearchParams {
+	mmSearch.mutex.RLock()
+
+	argCopy := make([]*SearchRepositoryMockSearchParams, len(mmSearch.callArgs))
+	copy(argCopy, mmSearch.callArgs)
+
+	mmSearch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSearchDone returns true if the count of the Search invocations corresponds
+// the number of defined expectations
+func (m *SearchRepositoryMock) MinimockSearchDone() bool {
+	if m.SearchMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SearchMock.invocationsDone()
+}
+
+// MinimockSearchInspect logs each unmet expectation
+func (m *SearchRepositoryMock) MinimockSearchInspect() {
+	for _, e := range m.SearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SearchRepositoryMock.Search at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSearchCounter := mm_atomic.LoadUint64(&m.afterSearchCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SearchMock.defaultExpectation != nil && afterSearchCounter < 1 {
+		if m.SearchMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SearchRepositoryMock.Search at\n%s", m.SearchMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SearchRepositoryMock.Search at\n%s with params: %#v", m.SearchMock.defaultExpectation.expectationOrigins.origin, *m.SearchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSearch != nil && afterSearchCounter < 1 {
+		m.t.Errorf("Expected call to SearchRepositoryMock.Search at\n%s", m.funcSearchOrigin)
+	}
+
+	if !m.SearchMock.invocationsDone() && afterSearchCounter > 0 {
+		m.t.Errorf("Expected %d calls to SearchRepositoryMock.Search at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SearchMock.expectedInvocations), m.SearchMock.expectedInvocationsOrigin, afterSearchCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *SearchRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockIndexReviewInspect()
+
+			m.MinimockRemoveReviewInspect()
+
+			m.MinimockSearchInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *SearchRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *SearchRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockIndexReviewDone() &&
+		m.MinimockRemoveReviewDone() &&
+		m.MinimockSearchDone()
+}
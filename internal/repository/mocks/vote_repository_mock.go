@@ -0,0 +1,1933 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/maisiq/go-ugc-service/internal/repository.VoteRepository -o vote_repository_mock.go -n VoteRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_repository "github.com/maisiq/go-ugc-service/internal/repository"
+)
+
+// VoteRepositoryMock implements mm_repository.VoteRepository
+type VoteRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDeleteReviewVotes          func(ctx context.Context, userID string, movieID string) (err error)
+	funcDeleteReviewVotesOrigin    string
+	inspectFuncDeleteReviewVotes   func(ctx context.Context, userID string, movieID string)
+	afterDeleteReviewVotesCounter  uint64
+	beforeDeleteReviewVotesCounter uint64
+	DeleteReviewVotesMock          mVoteRepositoryMockDeleteReviewVotes
+
+	funcDeleteUserVotes          func(ctx context.Context, userID string) (err error)
+	funcDeleteUserVotesOrigin    string
+	inspectFuncDeleteUserVotes   func(ctx context.Context, userID string)
+	afterDeleteUserVotesCounter  uint64
+	beforeDeleteUserVotesCounter uint64
+	DeleteUserVotesMock          mVoteRepositoryMockDeleteUserVotes
+
+	funcDeleteVote          func(ctx context.Context, voterID string, userID string, movieID string) (i1 int32, err error)
+	funcDeleteVoteOrigin    string
+	inspectFuncDeleteVote   func(ctx context.Context, voterID string, userID string, movieID string)
+	afterDeleteVoteCounter  uint64
+	beforeDeleteVoteCounter uint64
+	DeleteVoteMock          mVoteRepositoryMockDeleteVote
+
+	funcListVotesBy          func(ctx context.Context, voterID string) (va1 []mm_repository.Vote, err error)
+	funcListVotesByOrigin    string
+	inspectFuncListVotesBy   func(ctx context.Context, voterID string)
+	afterListVotesByCounter  uint64
+	beforeListVotesByCounter uint64
+	ListVotesByMock          mVoteRepositoryMockListVotesBy
+
+	funcSetVote          func(ctx context.Context, vote mm_repository.Vote) (i1 int32, err error)
+	funcSetVoteOrigin    string
+	inspectFuncSetVote   func(ctx context.Context, vote mm_repository.Vote)
+	afterSetVoteCounter  uint64
+	beforeSetVoteCounter uint64
+	SetVoteMock          mVoteRepositoryMockSetVote
+}
+
+// NewVoteRepositoryMock returns a mock for mm_repository.VoteRepository
+func NewVoteRepositoryMock(t minimock.Tester) *VoteRepositoryMock {
+	m := &VoteRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteReviewVotesMock = mVoteRepositoryMockDeleteReviewVotes{mock: m}
+	m.DeleteReviewVotesMock.callArgs = []*VoteRepositoryMockDeleteReviewVotesParams{}
+
+	m.DeleteUserVotesMock = mVoteRepositoryMockDeleteUserVotes{mock: m}
+	m.DeleteUserVotesMock.callArgs = []*VoteRepositoryMockDeleteUserVotesParams{}
+
+	m.DeleteVoteMock = mVoteRepositoryMockDeleteVote{mock: m}
+	m.DeleteVoteMock.callArgs = []*VoteRepositoryMockDeleteVoteParams{}
+
+	m.ListVotesByMock = mVoteRepositoryMockListVotesBy{mock: m}
+	m.ListVotesByMock.callArgs = []*VoteRepositoryMockListVotesByParams{}
+
+	m.SetVoteMock = mVoteRepositoryMockSetVote{mock: m}
+	m.SetVoteMock.callArgs = []*VoteRepositoryMockSetVoteParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mVoteRepositoryMockDeleteReviewVotes struct {
+	optional           bool
+	mock               *VoteRepositoryMock
+	defaultExpectation *VoteRepositoryMockDeleteReviewVotesExpectation
+	expectations       []*VoteRepositoryMockDeleteReviewVotesExpectation
+
+	callArgs []*VoteRepositoryMockDeleteReviewVotesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VoteRepositoryMockDeleteReviewVotesExpectation specifies expectation struct of the VoteRepository.DeleteReviewVotes
+type VoteRepositoryMockDeleteReviewVotesExpectation struct {
+	mock               *VoteRepositoryMock
+	params             *VoteRepositoryMockDeleteReviewVotesParams
+	paramPtrs          *VoteRepositoryMockDeleteReviewVotesParamPtrs
+	expectationOrigins VoteRepositoryMockDeleteReviewVotesExpectationOrigins
+	results            *VoteRepositoryMockDeleteReviewVotesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VoteRepositoryMockDeleteReviewVotesParams contains parameters of the VoteRepository.DeleteReviewVotes
+type VoteRepositoryMockDeleteReviewVotesParams struct {
+	ctx     context.Context
+	userID  string
+	movieID string
+}
+
+// VoteRepositoryMockDeleteReviewVotesParamPtrs contains pointers to parameters of the VoteRepository.DeleteReviewVotes
+type VoteRepositoryMockDeleteReviewVotesParamPtrs struct {
+	ctx     *context.Context
+	userID  *string
+	movieID *string
+}
+
+// VoteRepositoryMockDeleteReviewVotesResults contains results of the VoteRepository.DeleteReviewVotes
+type VoteRepositoryMockDeleteReviewVotesResults struct {
+	err error
+}
+
+// VoteRepositoryMockDeleteReviewVotesOrigins contains origins of expectations of the VoteRepository.DeleteReviewVotes
+type VoteRepositoryMockDeleteReviewVotesExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originMovieID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteReviewVotes *mVoteRepositoryMockDeleteReviewVotes) Optional() *mVoteRepositoryMockDeleteReviewVotes {
+	mmDeleteReviewVotes.optional = true
+	return mmDeleteReviewVotes
+}
+
+// Expect sets up expected params for VoteRepository.DeleteReviewVotes
+func (mmDeleteReviewVotes *mVoteRepositoryMockDeleteReviewVotes) Expect(ctx context.Context, userID string, movieID string) *mVoteRepositoryMockDeleteReviewVotes {
+	if mmDeleteReviewVotes.mock.funcDeleteReviewVotes != nil {
+		mmDeleteReviewVotes.mock.t.Fatalf("VoteRepositoryMock.DeleteReviewVotes mock is already set by Set")
+	}
+
+	if mmDeleteReviewVotes.defaultExpectation == nil {
+		mmDeleteReviewVotes.defaultExpectation = &VoteRepositoryMockDeleteReviewVotesExpectation{}
+	}
+
+	if mmDeleteReviewVotes.defaultExpectation.paramPtrs != nil {
+		mmDeleteReviewVotes.mock.t.Fatalf("VoteRepositoryMock.DeleteReviewVotes mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteReviewVotes.defaultExpectation.params = &VoteRepositoryMockDeleteReviewVotesParams{ctx, userID, movieID}
+	mmDeleteReviewVotes.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteReviewVotes.expectations {
+		if minimock.Equal(e.params, mmDeleteReviewVotes.defaultExpectation.params) {
+			mmDeleteReviewVotes.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteReviewVotes.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteReviewVotes
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VoteRepository.DeleteReviewVotes
+func (mmDeleteReviewVotes *mVoteRepositoryMockDeleteReviewVotes) ExpectCtxParam1(ctx context.Context) *mVoteRepositoryMockDeleteReviewVotes {
+	if mmDeleteReviewVotes.mock.funcDeleteReviewVotes != nil {
+		mmDeleteReviewVotes.mock.t.Fatalf("VoteRepositoryMock.DeleteReviewVotes mock is already set by Set")
+	}
+
+	if mmDeleteReviewVotes.defaultExpectation == nil {
+		mmDeleteReviewVotes.defaultExpectation = &VoteRepositoryMockDeleteReviewVotesExpectation{}
+	}
+
+	if mmDeleteReviewVotes.defaultExpectation.params != nil {
+		mmDeleteReviewVotes.mock.t.Fatalf("VoteRepositoryMock.DeleteReviewVotes mock is already set by Expect")
+	}
+
+	if mmDeleteReviewVotes.defaultExpectation.paramPtrs == nil {
+		mmDeleteReviewVotes.defaultExpectation.paramPtrs = &VoteRepositoryMockDeleteReviewVotesParamPtrs{}
+	}
+	mmDeleteReviewVotes.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteReviewVotes.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteReviewVotes
+}
+
+// ExpectUserIDParam2 sets up expected param userID for VoteRepository.DeleteReviewVotes
+func (mmDeleteReviewVotes *mVoteRepositoryMockDeleteReviewVotes) ExpectUserIDParam2(userID string) *mVoteRepositoryMockDeleteReviewVotes {
+	if mmDeleteReviewVotes.mock.funcDeleteReviewVotes != nil {
+		mmDeleteReviewVotes.mock.t.Fatalf("VoteRepositoryMock.DeleteReviewVotes mock is already set by Set")
+	}
+
+	if mmDeleteReviewVotes.defaultExpectation == nil {
+		mmDeleteReviewVotes.defaultExpectation = &VoteRepositoryMockDeleteReviewVotesExpectation{}
+	}
+
+	if mmDeleteReviewVotes.defaultExpectation.params != nil {
+		mmDeleteReviewVotes.mock.t.Fatalf("VoteRepositoryMock.DeleteReviewVotes mock is already set by Expect")
+	}
+
+	if mmDeleteReviewVotes.defaultExpectation.paramPtrs == nil {
+		mmDeleteReviewVotes.defaultExpectation.paramPtrs = &VoteRepositoryMockDeleteReviewVotesParamPtrs{}
+	}
+	mmDeleteReviewVotes.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteReviewVotes.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteReviewVotes
+}
+
+// ExpectMovieIDParam3 sets up expected param movieID for VoteRepository.DeleteReviewVotes
+func (mmDeleteReviewVotes *mVoteRepositoryMockDeleteReviewVotes) ExpectMovieIDParam3(movieID string) *mVoteRepositoryMockDeleteReviewVotes {
+	if mmDeleteReviewVotes.mock.funcDeleteReviewVotes != nil {
+		mmDeleteReviewVotes.mock.t.Fatalf("VoteRepositoryMock.DeleteReviewVotes mock is already set by Set")
+	}
+
+	if mmDeleteReviewVotes.defaultExpectation == nil {
+		mmDeleteReviewVotes.defaultExpectation = &VoteRepositoryMockDeleteReviewVotesExpectation{}
+	}
+
+	if mmDeleteReviewVotes.defaultExpectation.params != nil {
+		mmDeleteReviewVotes.mock.t.Fatalf("VoteRepositoryMock.DeleteReviewVotes mock is already set by Expect")
+	}
+
+	if mmDeleteReviewVotes.defaultExpectation.paramPtrs == nil {
+		mmDeleteReviewVotes.defaultExpectation.paramPtrs = &VoteRepositoryMockDeleteReviewVotesParamPtrs{}
+	}
+	mmDeleteReviewVotes.defaultExpectation.paramPtrs.movieID = &movieID
+	mmDeleteReviewVotes.defaultExpectation.expectationOrigins.originMovieID = minimock.CallerInfo(1)
+
+	return mmDeleteReviewVotes
+}
+
+// Inspect accepts an inspector function that has same arguments as the VoteRepository.DeleteReviewVotes
+func (mmDeleteReviewVotes *mVoteRepositoryMockDeleteReviewVotes) Inspect(f func(ctx context.Context, userID string, movieID string)) *mVoteRepositoryMockDeleteReviewVotes {
+	if mmDeleteReviewVotes.mock.inspectFuncDeleteReviewVotes != nil {
+		mmDeleteReviewVotes.mock.t.Fatalf("Inspect function is already set for VoteRepositoryMock.DeleteReviewVotes")
+	}
+
+	mmDeleteReviewVotes.mock.inspectFuncDeleteReviewVotes = f
+
+	return mmDeleteReviewVotes
+}
+
+// Return sets up results that will be returned by VoteRepository.DeleteReviewVotes
+func (mmDeleteReviewVotes *mVoteRepositoryMockDeleteReviewVotes) Return(err error) *VoteRepositoryMock {
+	if mmDeleteReviewVotes.mock.funcDeleteReviewVotes != nil {
+		mmDeleteReviewVotes.mock.t.Fatalf("VoteRepositoryMock.DeleteReviewVotes mock is already set by Set")
+	}
+
+	if mmDeleteReviewVotes.defaultExpectation == nil {
+		mmDeleteReviewVotes.defaultExpectation = &VoteRepositoryMockDeleteReviewVotesExpectation{mock: mmDeleteReviewVotes.mock}
+	}
+	mmDeleteReviewVotes.defaultExpectation.results = &VoteRepositoryMockDeleteReviewVotesResults{err}
+	mmDeleteReviewVotes.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteReviewVotes.mock
+}
+
+// Set uses given function f to mock the VoteRepository.DeleteReviewVotes method
+func (mmDeleteReviewVotes *mVoteRepositoryMockDeleteReviewVotes) Set(f func(ctx context.Context, userID string, movieID string) (err error)) *VoteRepositoryMock {
+	if mmDeleteReviewVotes.defaultExpectation != nil {
+		mmDeleteReviewVotes.mock.t.Fatalf("Default expectation is already set for the VoteRepository.DeleteReviewVotes method")
+	}
+
+	if len(mmDeleteReviewVotes.expectations) > 0 {
+		mmDeleteReviewVotes.mock.t.Fatalf("Some expectations are already set for the VoteRepository.DeleteReviewVotes method")
+	}
+
+	mmDeleteReviewVotes.mock.funcDeleteReviewVotes = f
+	mmDeleteReviewVotes.mock.funcDeleteReviewVotesOrigin = minimock.CallerInfo(1)
+	return mmDeleteReviewVotes.mock
+}
+
+// When sets expectation for the VoteRepository.DeleteReviewVotes which will trigger the result defined by the following
+// Then helper
+func (mmDeleteReviewVotes *mVoteRepositoryMockDeleteReviewVotes) When(ctx context.Context, userID string, movieID string) *VoteRepositoryMockDeleteReviewVotesExpectation {
+	if mmDeleteReviewVotes.mock.funcDeleteReviewVotes != nil {
+		mmDeleteReviewVotes.mock.t.Fatalf("VoteRepositoryMock.DeleteReviewVotes mock is already set by Set")
+	}
+
+	expectation := &VoteRepositoryMockDeleteReviewVotesExpectation{
+		mock:               mmDeleteReviewVotes.mock,
+		params:             &VoteRepositoryMockDeleteReviewVotesParams{ctx, userID, movieID},
+		expectationOrigins: VoteRepositoryMockDeleteReviewVotesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteReviewVotes.expectations = append(mmDeleteReviewVotes.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VoteRepository.DeleteReviewVotes return parameters for the expectation previously defined by the When method
+func (e *VoteRepositoryMockDeleteReviewVotesExpectation) Then(err error) *VoteRepositoryMock {
+	e.results = &VoteRepositoryMockDeleteReviewVotesResults{err}
+	return e.mock
+}
+
+// Times sets number of times VoteRepository.DeleteReviewVotes should be invoked
+func (mmDeleteReviewVotes *mVoteRepositoryMockDeleteReviewVotes) Times(n uint64) *mVoteRepositoryMockDeleteReviewVotes {
+	if n == 0 {
+		mmDeleteReviewVotes.mock.t.Fatalf("Times of VoteRepositoryMock.DeleteReviewVotes mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteReviewVotes.expectedInvocations, n)
+	mmDeleteReviewVotes.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteReviewVotes
+}
+
+func (mmDeleteReviewVotes *mVoteRepositoryMockDeleteReviewVotes) invocationsDone() bool {
+	if len(mmDeleteReviewVotes.expectations) == 0 && mmDeleteReviewVotes.defaultExpectation == nil && mmDeleteReviewVotes.mock.funcDeleteReviewVotes == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteReviewVotes.mock.afterDeleteReviewVotesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteReviewVotes.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteReviewVotes implements mm_repository.VoteRepository
+func (mmDeleteReviewVotes *VoteRepositoryMock) DeleteReviewVotes(ctx context.Context, userID string, movieID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteReviewVotes.beforeDeleteReviewVotesCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteReviewVotes.afterDeleteReviewVotesCounter, 1)
+
+	mmDeleteReviewVotes.t.Helper()
+
+	if mmDeleteReviewVotes.inspectFuncDeleteReviewVotes != nil {
+		mmDeleteReviewVotes.inspectFuncDeleteReviewVotes(ctx, userID, movieID)
+	}
+
+	mm_params := VoteRepositoryMockDeleteReviewVotesParams{ctx, userID, movieID}
+
+	// Record call args
+	mmDeleteReviewVotes.DeleteReviewVotesMock.mutex.Lock()
+	mmDeleteReviewVotes.DeleteReviewVotesMock.callArgs = append(mmDeleteReviewVotes.DeleteReviewVotesMock.callArgs, &mm_params)
+	mmDeleteReviewVotes.DeleteReviewVotesMock.mutex.Unlock()
+
+	for _, e := range mmDeleteReviewVotes.DeleteReviewVotesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteReviewVotes.DeleteReviewVotesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteReviewVotes.DeleteReviewVotesMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteReviewVotes.DeleteReviewVotesMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteReviewVotes.DeleteReviewVotesMock.defaultExpectation.paramPtrs
+
+		mm_got := VoteRepositoryMockDeleteReviewVotesParams{ctx, userID, movieID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteReviewVotes.t.Errorf("VoteRepositoryMock.DeleteReviewVotes got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteReviewVotes.DeleteReviewVotesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteReviewVotes.t.Errorf("VoteRepositoryMock.DeleteReviewVotes got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteReviewVotes.DeleteReviewVotesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.movieID != nil && !minimock.Equal(*mm_want_ptrs.movieID, mm_got.movieID) {
+				mmDeleteReviewVotes.t.Errorf("VoteRepositoryMock.DeleteReviewVotes got unexpected parameter movieID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteReviewVotes.DeleteReviewVotesMock.defaultExpectation.expectationOrigins.originMovieID, *mm_want_ptrs.movieID, mm_got.movieID, minimock.Diff(*mm_want_ptrs.movieID, mm_got.movieID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteReviewVotes.t.Errorf("VoteRepositoryMock.DeleteReviewVotes got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteReviewVotes.DeleteReviewVotesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteReviewVotes.DeleteReviewVotesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteReviewVotes.t.Fatal("No results are set for the VoteRepositoryMock.DeleteReviewVotes")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteReviewVotes.funcDeleteReviewVotes != nil {
+		return mmDeleteReviewVotes.funcDeleteReviewVotes(ctx, userID, movieID)
+	}
+	mmDeleteReviewVotes.t.Fatalf("Unexpected call to VoteRepositoryMock.DeleteReviewVotes. %v %v %v", ctx, userID, movieID)
+	return
+}
+
+// DeleteReviewVotesAfterCounter returns a count of finished VoteRepositoryMock.DeleteReviewVotes invocations
+func (mmDeleteReviewVotes *VoteRepositoryMock) DeleteReviewVotesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteReviewVotes.afterDeleteReviewVotesCounter)
+}
+
+// DeleteReviewVotesBeforeCounter returns a count of VoteRepositoryMock.DeleteReviewVotes invocations
+func (mmDeleteReviewVotes *VoteRepositoryMock) DeleteReviewVotesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteReviewVotes.beforeDeleteReviewVotesCounter)
+}
+
+// Calls returns a list of arguments used in each call to VoteRepositoryMock.DeleteReviewVotes.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteReviewVotes *mVoteRepositoryMockDeleteReviewVotes) Calls() []*VoteRepositoryMockDeleteReviewVotesParams {
+	mmDeleteReviewVotes.mutex.RLock()
+
+	argCopy := make([]*VoteRepositoryMockDeleteReviewVotesParams, len(mmDeleteReviewVotes.callArgs))
+	copy(argCopy, mmDeleteReviewVotes.callArgs)
+
+	mmDeleteReviewVotes.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteReviewVotesDone returns true if the count of the DeleteReviewVotes invocations corresponds
+// the number of defined expectations
+func (m *VoteRepositoryMock) MinimockDeleteReviewVotesDone() bool {
+	if m.DeleteReviewVotesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteReviewVotesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteReviewVotesMock.invocationsDone()
+}
+
+// MinimockDeleteReviewVotesInspect logs each unmet expectation
+func (m *VoteRepositoryMock) MinimockDeleteReviewVotesInspect() {
+	for _, e := range m.DeleteReviewVotesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VoteRepositoryMock.DeleteReviewVotes at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteReviewVotesCounter := mm_atomic.LoadUint64(&m.afterDeleteReviewVotesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteReviewVotesMock.defaultExpectation != nil && afterDeleteReviewVotesCounter < 1 {
+		if m.DeleteReviewVotesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VoteRepositoryMock.DeleteReviewVotes at\n%s", m.DeleteReviewVotesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VoteRepositoryMock.DeleteReviewVotes at\n%s with params: %#v", m.DeleteReviewVotesMock.defaultExpectation.expectationOrigins.origin, *m.DeleteReviewVotesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteReviewVotes != nil && afterDeleteReviewVotesCounter < 1 {
+		m.t.Errorf("Expected call to VoteRepositoryMock.DeleteReviewVotes at\n%s", m.funcDeleteReviewVotesOrigin)
+	}
+
+	if !m.DeleteReviewVotesMock.invocationsDone() && afterDeleteReviewVotesCounter > 0 {
+		m.t.Errorf("Expected %d calls to VoteRepositoryMock.DeleteReviewVotes at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteReviewVotesMock.expectedInvocations), m.DeleteReviewVotesMock.expectedInvocationsOrigin, afterDeleteReviewVotesCounter)
+	}
+}
+
+type mVoteRepositoryMockDeleteUserVotes struct {
+	optional           bool
+	mock               *VoteRepositoryMock
+	defaultExpectation *VoteRepositoryMockDeleteUserVotesExpectation
+	expectations       []*VoteRepositoryMockDeleteUserVotesExpectation
+
+	callArgs []*VoteRepositoryMockDeleteUserVotesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VoteRepositoryMockDeleteUserVotesExpectation specifies expectation struct of the VoteRepository.DeleteUserVotes
+type VoteRepositoryMockDeleteUserVotesExpectation struct {
+	mock               *VoteRepositoryMock
+	params             *VoteRepositoryMockDeleteUserVotesParams
+	paramPtrs          *VoteRepositoryMockDeleteUserVotesParamPtrs
+	expectationOrigins VoteRepositoryMockDeleteUserVotesExpectationOrigins
+	results            *VoteRepositoryMockDeleteUserVotesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VoteRepositoryMockDeleteUserVotesParams contains parameters of the VoteRepository.DeleteUserVotes
+type VoteRepositoryMockDeleteUserVotesParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// VoteRepositoryMockDeleteUserVotesParamPtrs contains pointers to parameters of the VoteRepository.DeleteUserVotes
+type VoteRepositoryMockDeleteUserVotesParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// VoteRepositoryMockDeleteUserVotesResults contains results of the VoteRepository.DeleteUserVotes
+type VoteRepositoryMockDeleteUserVotesResults struct {
+	err error
+}
+
+// VoteRepositoryMockDeleteUserVotesOrigins contains origins of expectations of the VoteRepository.DeleteUserVotes
+type VoteRepositoryMockDeleteUserVotesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteUserVotes *mVoteRepositoryMockDeleteUserVotes) Optional() *mVoteRepositoryMockDeleteUserVotes {
+	mmDeleteUserVotes.optional = true
+	return mmDeleteUserVotes
+}
+
+// Expect sets up expected params for VoteRepository.DeleteUserVotes
+func (mmDeleteUserVotes *mVoteRepositoryMockDeleteUserVotes) Expect(ctx context.Context, userID string) *mVoteRepositoryMockDeleteUserVotes {
+	if mmDeleteUserVotes.mock.funcDeleteUserVotes != nil {
+		mmDeleteUserVotes.mock.t.Fatalf("VoteRepositoryMock.DeleteUserVotes mock is already set by Set")
+	}
+
+	if mmDeleteUserVotes.defaultExpectation == nil {
+		mmDeleteUserVotes.defaultExpectation = &VoteRepositoryMockDeleteUserVotesExpectation{}
+	}
+
+	if mmDeleteUserVotes.defaultExpectation.paramPtrs != nil {
+		mmDeleteUserVotes.mock.t.Fatalf("VoteRepositoryMock.DeleteUserVotes mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteUserVotes.defaultExpectation.params = &VoteRepositoryMockDeleteUserVotesParams{ctx, userID}
+	mmDeleteUserVotes.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteUserVotes.expectations {
+		if minimock.Equal(e.params, mmDeleteUserVotes.defaultExpectation.params) {
+			mmDeleteUserVotes.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUserVotes.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteUserVotes
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VoteRepository.DeleteUserVotes
+func (mmDeleteUserVotes *mVoteRepositoryMockDeleteUserVotes) ExpectCtxParam1(ctx context.Context) *mVoteRepositoryMockDeleteUserVotes {
+	if mmDeleteUserVotes.mock.funcDeleteUserVotes != nil {
+		mmDeleteUserVotes.mock.t.Fatalf("VoteRepositoryMock.DeleteUserVotes mock is already set by Set")
+	}
+
+	if mmDeleteUserVotes.defaultExpectation == nil {
+		mmDeleteUserVotes.defaultExpectation = &VoteRepositoryMockDeleteUserVotesExpectation{}
+	}
+
+	if mmDeleteUserVotes.defaultExpectation.params != nil {
+		mmDeleteUserVotes.mock.t.Fatalf("VoteRepositoryMock.DeleteUserVotes mock is already set by Expect")
+	}
+
+	if mmDeleteUserVotes.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserVotes.defaultExpectation.paramPtrs = &VoteRepositoryMockDeleteUserVotesParamPtrs{}
+	}
+	mmDeleteUserVotes.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteUserVotes.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteUserVotes
+}
+
+// ExpectUserIDParam2 sets up expected param userID for VoteRepository.DeleteUserVotes
+func (mmDeleteUserVotes *mVoteRepositoryMockDeleteUserVotes) ExpectUserIDParam2(userID string) *mVoteRepositoryMockDeleteUserVotes {
+	if mmDeleteUserVotes.mock.funcDeleteUserVotes != nil {
+		mmDeleteUserVotes.mock.t.Fatalf("VoteRepositoryMock.DeleteUserVotes mock is already set by Set")
+	}
+
+	if mmDeleteUserVotes.defaultExpectation == nil {
+		mmDeleteUserVotes.defaultExpectation = &VoteRepositoryMockDeleteUserVotesExpectation{}
+	}
+
+	if mmDeleteUserVotes.defaultExpectation.params != nil {
+		mmDeleteUserVotes.mock.t.Fatalf("VoteRepositoryMock.DeleteUserVotes mock is already set by Expect")
+	}
+
+	if mmDeleteUserVotes.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserVotes.defaultExpectation.paramPtrs = &VoteRepositoryMockDeleteUserVotesParamPtrs{}
+	}
+	mmDeleteUserVotes.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteUserVotes.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteUserVotes
+}
+
+// Inspect accepts an inspector function that has same arguments as the VoteRepository.DeleteUserVotes
+func (mmDeleteUserVotes *mVoteRepositoryMockDeleteUserVotes) Inspect(f func(ctx context.Context, userID string)) *mVoteRepositoryMockDeleteUserVotes {
+	if mmDeleteUserVotes.mock.inspectFuncDeleteUserVotes != nil {
+		mmDeleteUserVotes.mock.t.Fatalf("Inspect function is already set for VoteRepositoryMock.DeleteUserVotes")
+	}
+
+	mmDeleteUserVotes.mock.inspectFuncDeleteUserVotes = f
+
+	return mmDeleteUserVotes
+}
+
+// Return sets up results that will be returned by VoteRepository.DeleteUserVotes
+func (mmDeleteUserVotes *mVoteRepositoryMockDeleteUserVotes) Return(err error) *VoteRepositoryMock {
+	if mmDeleteUserVotes.mock.funcDeleteUserVotes != nil {
+		mmDeleteUserVotes.mock.t.Fatalf("VoteRepositoryMock.DeleteUserVotes mock is already set by Set")
+	}
+
+	if mmDeleteUserVotes.defaultExpectation == nil {
+		mmDeleteUserVotes.defaultExpectation = &VoteRepositoryMockDeleteUserVotesExpectation{mock: mmDeleteUserVotes.mock}
+	}
+	mmDeleteUserVotes.defaultExpectation.results = &VoteRepositoryMockDeleteUserVotesResults{err}
+	mmDeleteUserVotes.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserVotes.mock
+}
+
+// Set uses given function f to mock the VoteRepository.DeleteUserVotes method
+func (mmDeleteUserVotes *mVoteRepositoryMockDeleteUserVotes) Set(f func(ctx context.Context, userID string) (err error)) *VoteRepositoryMock {
+	if mmDeleteUserVotes.defaultExpectation != nil {
+		mmDeleteUserVotes.mock.t.Fatalf("Default expectation is already set for the VoteRepository.DeleteUserVotes method")
+	}
+
+	if len(mmDeleteUserVotes.expectations) > 0 {
+		mmDeleteUserVotes.mock.t.Fatalf("Some expectations are already set for the VoteRepository.DeleteUserVotes method")
+	}
+
+	mmDeleteUserVotes.mock.funcDeleteUserVotes = f
+	mmDeleteUserVotes.mock.funcDeleteUserVotesOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserVotes.mock
+}
+
+// When sets expectation for the VoteRepository.DeleteUserVotes which will trigger the result defined by the following
+// Then helper
+func (mmDeleteUserVotes *mVoteRepositoryMockDeleteUserVotes) When(ctx context.Context, userID string) *VoteRepositoryMockDeleteUserVotesExpectation {
+	if mmDeleteUserVotes.mock.funcDeleteUserVotes != nil {
+		mmDeleteUserVotes.mock.t.Fatalf("VoteRepositoryMock.DeleteUserVotes mock is already set by Set")
+	}
+
+	expectation := &VoteRepositoryMockDeleteUserVotesExpectation{
+		mock:               mmDeleteUserVotes.mock,
+		params:             &VoteRepositoryMockDeleteUserVotesParams{ctx, userID},
+		expectationOrigins: VoteRepositoryMockDeleteUserVotesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteUserVotes.expectations = append(mmDeleteUserVotes.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VoteRepository.DeleteUserVotes return parameters for the expectation previously defined by the When method
+func (e *VoteRepositoryMockDeleteUserVotesExpectation) Then(err error) *VoteRepositoryMock {
+	e.results = &VoteRepositoryMockDeleteUserVotesResults{err}
+	return e.mock
+}
+
+// Times sets number of times VoteRepository.DeleteUserVotes should be invoked
+func (mmDeleteUserVotes *mVoteRepositoryMockDeleteUserVotes) Times(n uint64) *mVoteRepositoryMockDeleteUserVotes {
+	if n == 0 {
+		mmDeleteUserVotes.mock.t.Fatalf("Times of VoteRepositoryMock.DeleteUserVotes mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteUserVotes.expectedInvocations, n)
+	mmDeleteUserVotes.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserVotes
+}
+
+func (mmDeleteUserVotes *mVoteRepositoryMockDeleteUserVotes) invocationsDone() bool {
+	if len(mmDeleteUserVotes.expectations) == 0 && mmDeleteUserVotes.defaultExpectation == nil && mmDeleteUserVotes.mock.funcDeleteUserVotes == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUserVotes.mock.afterDeleteUserVotesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUserVotes.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteUserVotes implements mm_repository.VoteRepository
+func (mmDeleteUserVotes *VoteRepositoryMock) DeleteUserVotes(ctx context.Context, userID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteUserVotes.beforeDeleteUserVotesCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUserVotes.afterDeleteUserVotesCounter, 1)
+
+	mmDeleteUserVotes.t.Helper()
+
+	if mmDeleteUserVotes.inspectFuncDeleteUserVotes != nil {
+		mmDeleteUserVotes.inspectFuncDeleteUserVotes(ctx, userID)
+	}
+
+	mm_params := VoteRepositoryMockDeleteUserVotesParams{ctx, userID}
+
+	// Record call args
+	mmDeleteUserVotes.DeleteUserVotesMock.mutex.Lock()
+	mmDeleteUserVotes.DeleteUserVotesMock.callArgs = append(mmDeleteUserVotes.DeleteUserVotesMock.callArgs, &mm_params)
+	mmDeleteUserVotes.DeleteUserVotesMock.mutex.Unlock()
+
+	for _, e := range mmDeleteUserVotes.DeleteUserVotesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteUserVotes.DeleteUserVotesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUserVotes.DeleteUserVotesMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUserVotes.DeleteUserVotesMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUserVotes.DeleteUserVotesMock.defaultExpectation.paramPtrs
+
+		mm_got := VoteRepositoryMockDeleteUserVotesParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteUserVotes.t.Errorf("VoteRepositoryMock.DeleteUserVotes got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserVotes.DeleteUserVotesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteUserVotes.t.Errorf("VoteRepositoryMock.DeleteUserVotes got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserVotes.DeleteUserVotesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteUserVotes.t.Errorf("VoteRepositoryMock.DeleteUserVotes got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteUserVotes.DeleteUserVotesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteUserVotes.DeleteUserVotesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteUserVotes.t.Fatal("No results are set for the VoteRepositoryMock.DeleteUserVotes")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteUserVotes.funcDeleteUserVotes != nil {
+		return mmDeleteUserVotes.funcDeleteUserVotes(ctx, userID)
+	}
+	mmDeleteUserVotes.t.Fatalf("Unexpected call to VoteRepositoryMock.DeleteUserVotes. %v %v", ctx, userID)
+	return
+}
+
+// DeleteUserVotesAfterCounter returns a count of finished VoteRepositoryMock.DeleteUserVotes invocations
+func (mmDeleteUserVotes *VoteRepositoryMock) DeleteUserVotesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserVotes.afterDeleteUserVotesCounter)
+}
+
+// DeleteUserVotesBeforeCounter returns a count of VoteRepositoryMock.DeleteUserVotes invocations
+func (mmDeleteUserVotes *VoteRepositoryMock) DeleteUserVotesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserVotes.beforeDeleteUserVotesCounter)
+}
+
+// Calls returns a list of arguments used in each call to VoteRepositoryMock.DeleteUserVotes.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteUserVotes *mVoteRepositoryMockDeleteUserVotes) Calls() []*VoteRepositoryMockDeleteUserVotesParams {
+	mmDeleteUserVotes.mutex.RLock()
+
+	argCopy := make([]*VoteRepositoryMockDeleteUserVotesParams, len(mmDeleteUserVotes.callArgs))
+	copy(argCopy, mmDeleteUserVotes.callArgs)
+
+	mmDeleteUserVotes.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteUserVotesDone returns true if the count of the DeleteUserVotes invocations corresponds
+// the number of defined expectations
+func (m *VoteRepositoryMock) MinimockDeleteUserVotesDone() bool {
+	if m.DeleteUserVotesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteUserVotesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteUserVotesMock.invocationsDone()
+}
+
+// MinimockDeleteUserVotesInspect logs each unmet expectation
+func (m *VoteRepositoryMock) MinimockDeleteUserVotesInspect() {
+	for _, e := range m.DeleteUserVotesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VoteRepositoryMock.DeleteUserVotes at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteUserVotesCounter := mm_atomic.LoadUint64(&m.afterDeleteUserVotesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteUserVotesMock.defaultExpectation != nil && afterDeleteUserVotesCounter < 1 {
+		if m.DeleteUserVotesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VoteRepositoryMock.DeleteUserVotes at\n%s", m.DeleteUserVotesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VoteRepositoryMock.DeleteUserVotes at\n%s with params: %#v", m.DeleteUserVotesMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserVotesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteUserVotes != nil && afterDeleteUserVotesCounter < 1 {
+		m.t.Errorf("Expected call to VoteRepositoryMock.DeleteUserVotes at\n%s", m.funcDeleteUserVotesOrigin)
+	}
+
+	if !m.DeleteUserVotesMock.invocationsDone() && afterDeleteUserVotesCounter > 0 {
+		m.t.Errorf("Expected %d calls to VoteRepositoryMock.DeleteUserVotes at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUserVotesMock.expectedInvocations), m.DeleteUserVotesMock.expectedInvocationsOrigin, afterDeleteUserVotesCounter)
+	}
+}
+
+type mVoteRepositoryMockDeleteVote struct {
+	optional           bool
+	mock               *VoteRepositoryMock
+	defaultExpectation *VoteRepositoryMockDeleteVoteExpectation
+	expectations       []*VoteRepositoryMockDeleteVoteExpectation
+
+	callArgs []*VoteRepositoryMockDeleteVoteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VoteRepositoryMockDeleteVoteExpectation specifies expectation struct of the VoteRepository.DeleteVote
+type VoteRepositoryMockDeleteVoteExpectation struct {
+	mock               *VoteRepositoryMock
+	params             *VoteRepositoryMockDeleteVoteParams
+	paramPtrs          *VoteRepositoryMockDeleteVoteParamPtrs
+	expectationOrigins VoteRepositoryMockDeleteVoteExpectationOrigins
+	results            *VoteRepositoryMockDeleteVoteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VoteRepositoryMockDeleteVoteParams contains parameters of the VoteRepository.DeleteVote
+type VoteRepositoryMockDeleteVoteParams struct {
+	ctx     context.Context
+	voterID string
+	userID  string
+	movieID string
+}
+
+// VoteRepositoryMockDeleteVoteParamPtrs contains pointers to parameters of the VoteRepository.DeleteVote
+type VoteRepositoryMockDeleteVoteParamPtrs struct {
+	ctx     *context.Context
+	voterID *string
+	userID  *string
+	movieID *string
+}
+
+// VoteRepositoryMockDeleteVoteResults contains results of the VoteRepository.DeleteVote
+type VoteRepositoryMockDeleteVoteResults struct {
+	i1  int32
+	err error
+}
+
+// VoteRepositoryMockDeleteVoteOrigins contains origins of expectations of the VoteRepository.DeleteVote
+type VoteRepositoryMockDeleteVoteExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originVoterID string
+	originUserID  string
+	originMovieID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteVote *mVoteRepositoryMockDeleteVote) Optional() *mVoteRepositoryMockDeleteVote {
+	mmDeleteVote.optional = true
+	return mmDeleteVote
+}
+
+// Expect sets up expected params for VoteRepository.DeleteVote
+func (mmDeleteVote *mVoteRepositoryMockDeleteVote) Expect(ctx context.Context, voterID string, userID string, movieID string) *mVoteRepositoryMockDeleteVote {
+	if mmDeleteVote.mock.funcDeleteVote != nil {
+		mmDeleteVote.mock.t.Fatalf("VoteRepositoryMock.DeleteVote mock is already set by Set")
+	}
+
+	if mmDeleteVote.defaultExpectation == nil {
+		mmDeleteVote.defaultExpectation = &VoteRepositoryMockDeleteVoteExpectation{}
+	}
+
+	if mmDeleteVote.defaultExpectation.paramPtrs != nil {
+		mmDeleteVote.mock.t.Fatalf("VoteRepositoryMock.DeleteVote mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteVote.defaultExpectation.params = &VoteRepositoryMockDeleteVoteParams{ctx, voterID, userID, movieID}
+	mmDeleteVote.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteVote.expectations {
+		if minimock.Equal(e.params, mmDeleteVote.defaultExpectation.params) {
+			mmDeleteVote.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteVote.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteVote
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VoteRepository.DeleteVote
+func (mmDeleteVote *mVoteRepositoryMockDeleteVote) ExpectCtxParam1(ctx context.Context) *mVoteRepositoryMockDeleteVote {
+	if mmDeleteVote.mock.funcDeleteVote != nil {
+		mmDeleteVote.mock.t.Fatalf("VoteRepositoryMock.DeleteVote mock is already set by Set")
+	}
+
+	if mmDeleteVote.defaultExpectation == nil {
+		mmDeleteVote.defaultExpectation = &VoteRepositoryMockDeleteVoteExpectation{}
+	}
+
+	if mmDeleteVote.defaultExpectation.params != nil {
+		mmDeleteVote.mock.t.Fatalf("VoteRepositoryMock.DeleteVote mock is already set by Expect")
+	}
+
+	if mmDeleteVote.defaultExpectation.paramPtrs == nil {
+		mmDeleteVote.defaultExpectation.paramPtrs = &VoteRepositoryMockDeleteVoteParamPtrs{}
+	}
+	mmDeleteVote.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteVote.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteVote
+}
+
+// ExpectVoterIDParam2 sets up expected param voterID for VoteRepository.DeleteVote
+func (mmDeleteVote *mVoteRepositoryMockDeleteVote) ExpectVoterIDParam2(voterID string) *mVoteRepositoryMockDeleteVote {
+	if mmDeleteVote.mock.funcDeleteVote != nil {
+		mmDeleteVote.mock.t.Fatalf("VoteRepositoryMock.DeleteVote mock is already set by Set")
+	}
+
+	if mmDeleteVote.defaultExpectation == nil {
+		mmDeleteVote.defaultExpectation = &VoteRepositoryMockDeleteVoteExpectation{}
+	}
+
+	if mmDeleteVote.defaultExpectation.params != nil {
+		mmDeleteVote.mock.t.Fatalf("VoteRepositoryMock.DeleteVote mock is already set by Expect")
+	}
+
+	if mmDeleteVote.defaultExpectation.paramPtrs == nil {
+		mmDeleteVote.defaultExpectation.paramPtrs = &VoteRepositoryMockDeleteVoteParamPtrs{}
+	}
+	mmDeleteVote.defaultExpectation.paramPtrs.voterID = &voterID
+	mmDeleteVote.defaultExpectation.expectationOrigins.originVoterID = minimock.CallerInfo(1)
+
+	return mmDeleteVote
+}
+
+// ExpectUserIDParam3 sets up expected param userID for VoteRepository.DeleteVote
+func (mmDeleteVote *mVoteRepositoryMockDeleteVote) ExpectUserIDParam3(userID string) *mVoteRepositoryMockDeleteVote {
+	if mmDeleteVote.mock.funcDeleteVote != nil {
+		mmDeleteVote.mock.t.Fatalf("VoteRepositoryMock.DeleteVote mock is already set by Set")
+	}
+
+	if mmDeleteVote.defaultExpectation == nil {
+		mmDeleteVote.defaultExpectation = &VoteRepositoryMockDeleteVoteExpectation{}
+	}
+
+	if mmDeleteVote.defaultExpectation.params != nil {
+		mmDeleteVote.mock.t.Fatalf("VoteRepositoryMock.DeleteVote mock is already set by Expect")
+	}
+
+	if mmDeleteVote.defaultExpectation.paramPtrs == nil {
+		mmDeleteVote.defaultExpectation.paramPtrs = &VoteRepositoryMockDeleteVoteParamPtrs{}
+	}
+	mmDeleteVote.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteVote.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteVote
+}
+
+// ExpectMovieIDParam4 sets up expected param movieID for VoteRepository.DeleteVote
+func (mmDeleteVote *mVoteRepositoryMockDeleteVote) ExpectMovieIDParam4(movieID string) *mVoteRepositoryMockDeleteVote {
+	if mmDeleteVote.mock.funcDeleteVote != nil {
+		mmDeleteVote.mock.t.Fatalf("VoteRepositoryMock.DeleteVote mock is already set by Set")
+	}
+
+	if mmDeleteVote.defaultExpectation == nil {
+		mmDeleteVote.defaultExpectation = &VoteRepositoryMockDeleteVoteExpectation{}
+	}
+
+	if mmDeleteVote.defaultExpectation.params != nil {
+		mmDeleteVote.mock.t.Fatalf("VoteRepositoryMock.DeleteVote mock is already set by Expect")
+	}
+
+	if mmDeleteVote.defaultExpectation.paramPtrs == nil {
+		mmDeleteVote.defaultExpectation.paramPtrs = &VoteRepositoryMockDeleteVoteParamPtrs{}
+	}
+	mmDeleteVote.defaultExpectation.paramPtrs.movieID = &movieID
+	mmDeleteVote.defaultExpectation.expectationOrigins.originMovieID = minimock.CallerInfo(1)
+
+	return mmDeleteVote
+}
+
+// Inspect accepts an inspector function that has same arguments as the VoteRepository.DeleteVote
+func (mmDeleteVote *mVoteRepositoryMockDeleteVote) Inspect(f func(ctx context.Context, voterID string, userID string, movieID string)) *mVoteRepositoryMockDeleteVote {
+	if mmDeleteVote.mock.inspectFuncDeleteVote != nil {
+		mmDeleteVote.mock.t.Fatalf("Inspect function is already set for VoteRepositoryMock.DeleteVote")
+	}
+
+	mmDeleteVote.mock.inspectFuncDeleteVote = f
+
+	return mmDeleteVote
+}
+
+// Return sets up results that will be returned by VoteRepository.DeleteVote
+func (mmDeleteVote *mVoteRepositoryMockDeleteVote) Return(i1 int32, err error) *VoteRepositoryMock {
+	if mmDeleteVote.mock.funcDeleteVote != nil {
+		mmDeleteVote.mock.t.Fatalf("VoteRepositoryMock.DeleteVote mock is already set by Set")
+	}
+
+	if mmDeleteVote.defaultExpectation == nil {
+		mmDeleteVote.defaultExpectation = &VoteRepositoryMockDeleteVoteExpectation{mock: mmDeleteVote.mock}
+	}
+	mmDeleteVote.defaultExpectation.results = &VoteRepositoryMockDeleteVoteResults{i1, err}
+	mmDeleteVote.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteVote.mock
+}
+
+// Set uses given function f to mock the VoteRepository.DeleteVote method
+func (mmDeleteVote *mVoteRepositoryMockDeleteVote) Set(f func(ctx context.Context, voterID string, userID string, movieID string) (i1 int32, err error)) *VoteRepositoryMock {
+	if mmDeleteVote.defaultExpectation != nil {
+		mmDeleteVote.mock.t.Fatalf("Default expectation is already set for the VoteRepository.DeleteVote method")
+	}
+
+	if len(mmDeleteVote.expectations) > 0 {
+		mmDeleteVote.mock.t.Fatalf("Some expectations are already set for the VoteRepository.DeleteVote method")
+	}
+
+	mmDeleteVote.mock.funcDeleteVote = f
+	mmDeleteVote.mock.funcDeleteVoteOrigin = minimock.CallerInfo(1)
+	return mmDeleteVote.mock
+}
+
+// When sets expectation for the VoteRepository.DeleteVote which will trigger the result defined by the following
+// Then helper
+func (mmDeleteVote *mVoteRepositoryMockDeleteVote) When(ctx context.Context, voterID string, userID string, movieID string) *VoteRepositoryMockDeleteVoteExpectation {
+	if mmDeleteVote.mock.funcDeleteVote != nil {
+		mmDeleteVote.mock.t.Fatalf("VoteRepositoryMock.DeleteVote mock is already set by Set")
+	}
+
+	expectation := &VoteRepositoryMockDeleteVoteExpectation{
+		mock:               mmDeleteVote.mock,
+		params:             &VoteRepositoryMockDeleteVoteParams{ctx, voterID, userID, movieID},
+		expectationOrigins: VoteRepositoryMockDeleteVoteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteVote.expectations = append(mmDeleteVote.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VoteRepository.DeleteVote return parameters for the expectation previously defined by the When method
+func (e *VoteRepositoryMockDeleteVoteExpectation) Then(i1 int32, err error) *VoteRepositoryMock {
+	e.results = &VoteRepositoryMockDeleteVoteResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times VoteRepository.DeleteVote should be invoked
+func (mmDeleteVote *mVoteRepositoryMockDeleteVote) Times(n uint64) *mVoteRepositoryMockDeleteVote {
+	if n == 0 {
+		mmDeleteVote.mock.t.Fatalf("Times of VoteRepositoryMock.DeleteVote mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteVote.expectedInvocations, n)
+	mmDeleteVote.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteVote
+}
+
+func (mmDeleteVote *mVoteRepositoryMockDeleteVote) invocationsDone() bool {
+	if len(mmDeleteVote.expectations) == 0 && mmDeleteVote.defaultExpectation == nil && mmDeleteVote.mock.funcDeleteVote == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteVote.mock.afterDeleteVoteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteVote.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteVote implements mm_repository.VoteRepository
+func (mmDeleteVote *VoteRepositoryMock) DeleteVote(ctx context.Context, voterID string, userID string, movieID string) (i1 int32, err error) {
+	mm_atomic.AddUint64(&mmDeleteVote.beforeDeleteVoteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteVote.afterDeleteVoteCounter, 1)
+
+	mmDeleteVote.t.Helper()
+
+	if mmDeleteVote.inspectFuncDeleteVote != nil {
+		mmDeleteVote.inspectFuncDeleteVote(ctx, voterID, userID, movieID)
+	}
+
+	mm_params := VoteRepositoryMockDeleteVoteParams{ctx, voterID, userID, movieID}
+
+	// Record call args
+	mmDeleteVote.DeleteVoteMock.mutex.Lock()
+	mmDeleteVote.DeleteVoteMock.callArgs = append(mmDeleteVote.DeleteVoteMock.callArgs, &mm_params)
+	mmDeleteVote.DeleteVoteMock.mutex.Unlock()
+
+	for _, e := range mmDeleteVote.DeleteVoteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmDeleteVote.DeleteVoteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteVote.DeleteVoteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteVote.DeleteVoteMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteVote.DeleteVoteMock.defaultExpectation.paramPtrs
+
+		mm_got := VoteRepositoryMockDeleteVoteParams{ctx, voterID, userID, movieID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteVote.t.Errorf("VoteRepositoryMock.DeleteVote got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteVote.DeleteVoteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.voterID != nil && !minimock.Equal(*mm_want_ptrs.voterID, mm_got.voterID) {
+				mmDeleteVote.t.Errorf("VoteRepositoryMock.DeleteVote got unexpected parameter voterID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteVote.DeleteVoteMock.defaultExpectation.expectationOrigins.originVoterID, *mm_want_ptrs.voterID, mm_got.voterID, minimock.Diff(*mm_want_ptrs.voterID, mm_got.voterID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteVote.t.Errorf("VoteRepositoryMock.DeleteVote got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteVote.DeleteVoteMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.movieID != nil && !minimock.Equal(*mm_want_ptrs.movieID, mm_got.movieID) {
+				mmDeleteVote.t.Errorf("VoteRepositoryMock.DeleteVote got unexpected parameter movieID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteVote.DeleteVoteMock.defaultExpectation.expectationOrigins.originMovieID, *mm_want_ptrs.movieID, mm_got.movieID, minimock.Diff(*mm_want_ptrs.movieID, mm_got.movieID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteVote.t.Errorf("VoteRepositoryMock.DeleteVote got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteVote.DeleteVoteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteVote.DeleteVoteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteVote.t.Fatal("No results are set for the VoteRepositoryMock.DeleteVote")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmDeleteVote.funcDeleteVote != nil {
+		return mmDeleteVote.funcDeleteVote(ctx, voterID, userID, movieID)
+	}
+	mmDeleteVote.t.Fatalf("Unexpected call to VoteRepositoryMock.DeleteVote. %v %v %v %v", ctx, voterID, userID, movieID)
+	return
+}
+
+// DeleteVoteAfterCounter returns a count of finished VoteRepositoryMock.DeleteVote invocations
+func (mmDeleteVote *VoteRepositoryMock) DeleteVoteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteVote.afterDeleteVoteCounter)
+}
+
+// DeleteVoteBeforeCounter returns a count of VoteRepositoryMock.DeleteVote invocations
+func (mmDeleteVote *VoteRepositoryMock) DeleteVoteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteVote.beforeDeleteVoteCounter)
+}
+
+// Calls returns a list of arguments used in each call to VoteRepositoryMock.DeleteVote.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteVote *mVoteRepositoryMockDeleteVote) Calls() []*VoteRepositoryMockDeleteVoteParams {
+	mmDeleteVote.mutex.RLock()
+
+	argCopy := make([]*VoteRepositoryMockDeleteVoteParams, len(mmDeleteVote.callArgs))
+	copy(argCopy, mmDeleteVote.callArgs)
+
+	mmDeleteVote.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteVoteDone returns true if the count of the DeleteVote invocations corresponds
+// the number of defined expectations
+func (m *VoteRepositoryMock) MinimockDeleteVoteDone() bool {
+	if m.DeleteVoteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteVoteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteVoteMock.invocationsDone()
+}
+
+// MinimockDeleteVoteInspect logs each unmet expectation
+func (m *VoteRepositoryMock) MinimockDeleteVoteInspect() {
+	for _, e := range m.DeleteVoteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VoteRepositoryMock.DeleteVote at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteVoteCounter := mm_atomic.LoadUint64(&m.afterDeleteVoteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteVoteMock.defaultExpectation != nil && afterDeleteVoteCounter < 1 {
+		if m.DeleteVoteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VoteRepositoryMock.DeleteVote at\n%s", m.DeleteVoteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VoteRepositoryMock.DeleteVote at\n%s with params: %#v", m.DeleteVoteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteVoteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteVote != nil && afterDeleteVoteCounter < 1 {
+		m.t.Errorf("Expected call to VoteRepositoryMock.DeleteVote at\n%s", m.funcDeleteVoteOrigin)
+	}
+
+	if !m.DeleteVoteMock.invocationsDone() && afterDeleteVoteCounter > 0 {
+		m.t.Errorf("Expected %d calls to VoteRepositoryMock.DeleteVote at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteVoteMock.expectedInvocations), m.DeleteVoteMock.expectedInvocationsOrigin, afterDeleteVoteCounter)
+	}
+}
+
+type mVoteRepositoryMockListVotesBy struct {
+	optional           bool
+	mock               *VoteRepositoryMock
+	defaultExpectation *VoteRepositoryMockListVotesByExpectation
+	expectations       []*VoteRepositoryMockListVotesByExpectation
+
+	callArgs []*VoteRepositoryMockListVotesByParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VoteRepositoryMockListVotesByExpectation specifies expectation struct of the VoteRepository.ListVotesBy
+type VoteRepositoryMockListVotesByExpectation struct {
+	mock               *VoteRepositoryMock
+	params             *VoteRepositoryMockListVotesByParams
+	paramPtrs          *VoteRepositoryMockListVotesByParamPtrs
+	expectationOrigins VoteRepositoryMockListVotesByExpectationOrigins
+	results            *VoteRepositoryMockListVotesByResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VoteRepositoryMockListVotesByParams contains parameters of the VoteRepository.ListVotesBy
+type VoteRepositoryMockListVotesByParams struct {
+	ctx     context.Context
+	voterID string
+}
+
+// VoteRepositoryMockListVotesByParamPtrs contains pointers to parameters of the VoteRepository.ListVotesBy
+type VoteRepositoryMockListVotesByParamPtrs struct {
+	ctx     *context.Context
+	voterID *string
+}
+
+// VoteRepositoryMockListVotesByResults contains results of the VoteRepository.ListVotesBy
+type VoteRepositoryMockListVotesByResults struct {
+	va1 []mm_repository.Vote
+	err error
+}
+
+// VoteRepositoryMockListVotesByOrigins contains origins of expectations of the VoteRepository.ListVotesBy
+type VoteRepositoryMockListVotesByExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originVoterID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListVotesBy *mVoteRepositoryMockListVotesBy) Optional() *mVoteRepositoryMockListVotesBy {
+	mmListVotesBy.optional = true
+	return mmListVotesBy
+}
+
+// Expect sets up expected params for VoteRepository.ListVotesBy
+func (mmListVotesBy *mVoteRepositoryMockListVotesBy) Expect(ctx context.Context, voterID string) *mVoteRepositoryMockListVotesBy {
+	if mmListVotesBy.mock.funcListVotesBy != nil {
+		mmListVotesBy.mock.t.Fatalf("VoteRepositoryMock.ListVotesBy mock is already set by Set")
+	}
+
+	if mmListVotesBy.defaultExpectation == nil {
+		mmListVotesBy.defaultExpectation = &VoteRepositoryMockListVotesByExpectation{}
+	}
+
+	if mmListVotesBy.defaultExpectation.paramPtrs != nil {
+		mmListVotesBy.mock.t.Fatalf("VoteRepositoryMock.ListVotesBy mock is already set by ExpectParams functions")
+	}
+
+	mmListVotesBy.defaultExpectation.params = &VoteRepositoryMockListVotesByParams{ctx, voterID}
+	mmListVotesBy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListVotesBy.expectations {
+		if minimock.Equal(e.params, mmListVotesBy.defaultExpectation.params) {
+			mmListVotesBy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListVotesBy.defaultExpectation.params)
+		}
+	}
+
+	return mmListVotesBy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VoteRepository.ListVotesBy
+func (mmListVotesBy *mVoteRepositoryMockListVotesBy) ExpectCtxParam1(ctx context.Context) *mVoteRepositoryMockListVotesBy {
+	if mmListVotesBy.mock.funcListVotesBy != nil {
+		mmListVotesBy.mock.t.Fatalf("VoteRepositoryMock.ListVotesBy mock is already set by Set")
+	}
+
+	if mmListVotesBy.defaultExpectation == nil {
+		mmListVotesBy.defaultExpectation = &VoteRepositoryMockListVotesByExpectation{}
+	}
+
+	if mmListVotesBy.defaultExpectation.params != nil {
+		mmListVotesBy.mock.t.Fatalf("VoteRepositoryMock.ListVotesBy mock is already set by Expect")
+	}
+
+	if mmListVotesBy.defaultExpectation.paramPtrs == nil {
+		mmListVotesBy.defaultExpectation.paramPtrs = &VoteRepositoryMockListVotesByParamPtrs{}
+	}
+	mmListVotesBy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListVotesBy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListVotesBy
+}
+
+// ExpectVoterIDParam2 sets up expected param voterID for VoteRepository.ListVotesBy
+func (mmListVotesBy *mVoteRepositoryMockListVotesBy) ExpectVoterIDParam2(voterID string) *mVoteRepositoryMockListVotesBy {
+	if mmListVotesBy.mock.funcListVotesBy != nil {
+		mmListVotesBy.mock.t.Fatalf("VoteRepositoryMock.ListVotesBy mock is already set by Set")
+	}
+
+	if mmListVotesBy.defaultExpectation == nil {
+		mmListVotesBy.defaultExpectation = &VoteRepositoryMockListVotesByExpectation{}
+	}
+
+	if mmListVotesBy.defaultExpectation.params != nil {
+		mmListVotesBy.mock.t.Fatalf("VoteRepositoryMock.ListVotesBy mock is already set by Expect")
+	}
+
+	if mmListVotesBy.defaultExpectation.paramPtrs == nil {
+		mmListVotesBy.defaultExpectation.paramPtrs = &VoteRepositoryMockListVotesByParamPtrs{}
+	}
+	mmListVotesBy.defaultExpectation.paramPtrs.voterID = &voterID
+	mmListVotesBy.defaultExpectation.expectationOrigins.originVoterID = minimock.CallerInfo(1)
+
+	return mmListVotesBy
+}
+
+// Inspect accepts an inspector function that has same arguments as the VoteRepository.ListVotesBy
+func (mmListVotesBy *mVoteRepositoryMockListVotesBy) Inspect(f func(ctx context.Context, voterID string)) *mVoteRepositoryMockListVotesBy {
+	if mmListVotesBy.mock.inspectFuncListVotesBy != nil {
+		mmListVotesBy.mock.t.Fatalf("Inspect function is already set for VoteRepositoryMock.ListVotesBy")
+	}
+
+	mmListVotesBy.mock.inspectFuncListVotesBy = f
+
+	return mmListVotesBy
+}
+
+// Return sets up results that will be returned by VoteRepository.ListVotesBy
+func (mmListVotesBy *mVoteRepositoryMockListVotesBy) Return(va1 []mm_repository.Vote, err error) *VoteRepositoryMock {
+	if mmListVotesBy.mock.funcListVotesBy != nil {
+		mmListVotesBy.mock.t.Fatalf("VoteRepositoryMock.ListVotesBy mock is already set by Set")
+	}
+
+	if mmListVotesBy.defaultExpectation == nil {
+		mmListVotesBy.defaultExpectation = &VoteRepositoryMockListVotesByExpectation{mock: mmListVotesBy.mock}
+	}
+	mmListVotesBy.defaultExpectation.results = &VoteRepositoryMockListVotesByResults{va1, err}
+	mmListVotesBy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListVotesBy.mock
+}
+
+// Set uses given function f to mock the VoteRepository.ListVotesBy method
+func (mmListVotesBy *mVoteRepositoryMockListVotesBy) Set(f func(ctx context.Context, voterID string) (va1 []mm_repository.Vote, err error)) *VoteRepositoryMock {
+	if mmListVotesBy.defaultExpectation != nil {
+		mmListVotesBy.mock.t.Fatalf("Default expectation is already set for the VoteRepository.ListVotesBy method")
+	}
+
+	if len(mmListVotesBy.expectations) > 0 {
+		mmListVotesBy.mock.t.Fatalf("Some expectations are already set for the VoteRepository.ListVotesBy method")
+	}
+
+	mmListVotesBy.mock.funcListVotesBy = f
+	mmListVotesBy.mock.funcListVotesByOrigin = minimock.CallerInfo(1)
+	return mmListVotesBy.mock
+}
+
+// When sets expectation for the VoteRepository.ListVotesBy which will trigger the result defined by the following
+// Then helper
+func (mmListVotesBy *mVoteRepositoryMockListVotesBy) When(ctx context.Context, voterID string) *VoteRepositoryMockListVotesByExpectation {
+	if mmListVotesBy.mock.funcListVotesBy != nil {
+		mmListVotesBy.mock.t.Fatalf("VoteRepositoryMock.ListVotesBy mock is already set by Set")
+	}
+
+	expectation := &VoteRepositoryMockListVotesByExpectation{
+		mock:               mmListVotesBy.mock,
+		params:             &VoteRepositoryMockListVotesByParams{ctx, voterID},
+		expectationOrigins: VoteRepositoryMockListVotesByExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListVotesBy.expectations = append(mmListVotesBy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VoteRepository.ListVotesBy return parameters for the expectation previously defined by the When method
+func (e *VoteRepositoryMockListVotesByExpectation) Then(va1 []mm_repository.Vote, err error) *VoteRepositoryMock {
+	e.results = &VoteRepositoryMockListVotesByResults{va1, err}
+	return e.mock
+}
+
+// Times sets number of times VoteRepository.ListVotesBy should be invoked
+func (mmListVotesBy *mVoteRepositoryMockListVotesBy) Times(n uint64) *mVoteRepositoryMockListVotesBy {
+	if n == 0 {
+		mmListVotesBy.mock.t.Fatalf("Times of VoteRepositoryMock.ListVotesBy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListVotesBy.expectedInvocations, n)
+	mmListVotesBy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListVotesBy
+}
+
+func (mmListVotesBy *mVoteRepositoryMockListVotesBy) invocationsDone() bool {
+	if len(mmListVotesBy.expectations) == 0 && mmListVotesBy.defaultExpectation == nil && mmListVotesBy.mock.funcListVotesBy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListVotesBy.mock.afterListVotesByCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListVotesBy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListVotesBy implements mm_repository.VoteRepository
+func (mmListVotesBy *VoteRepositoryMock) ListVotesBy(ctx context.Context, voterID string) (va1 []mm_repository.Vote, err error) {
+	mm_atomic.AddUint64(&mmListVotesBy.beforeListVotesByCounter, 1)
+	defer mm_atomic.AddUint64(&mmListVotesBy.afterListVotesByCounter, 1)
+
+	mmListVotesBy.t.Helper()
+
+	if mmListVotesBy.inspectFuncListVotesBy != nil {
+		mmListVotesBy.inspectFuncListVotesBy(ctx, voterID)
+	}
+
+	mm_params := VoteRepositoryMockListVotesByParams{ctx, voterID}
+
+	// Record call args
+	mmListVotesBy.ListVotesByMock.mutex.Lock()
+	mmListVotesBy.ListVotesByMock.callArgs = append(mmListVotesBy.ListVotesByMock.callArgs, &mm_params)
+	mmListVotesBy.ListVotesByMock.mutex.Unlock()
+
+	for _, e := range mmListVotesBy.ListVotesByMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.va1, e.results.err
+		}
+	}
+
+	if mmListVotesBy.ListVotesByMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListVotesBy.ListVotesByMock.defaultExpectation.Counter, 1)
+		mm_want := mmListVotesBy.ListVotesByMock.defaultExpectation.params
+		mm_want_ptrs := mmListVotesBy.ListVotesByMock.defaultExpectation.paramPtrs
+
+		mm_got := VoteRepositoryMockListVotesByParams{ctx, voterID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListVotesBy.t.Errorf("VoteRepositoryMock.ListVotesBy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListVotesBy.ListVotesByMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.voterID != nil && !minimock.Equal(*mm_want_ptrs.voterID, mm_got.voterID) {
+				mmListVotesBy.t.Errorf("VoteRepositoryMock.ListVotesBy got unexpected parameter voterID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListVotesBy.ListVotesByMock.defaultExpectation.expectationOrigins.originVoterID, *mm_want_ptrs.voterID, mm_got.voterID, minimock.Diff(*mm_want_ptrs.voterID, mm_got.voterID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListVotesBy.t.Errorf("VoteRepositoryMock.ListVotesBy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListVotesBy.ListVotesByMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListVotesBy.ListVotesByMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListVotesBy.t.Fatal("No results are set for the VoteRepositoryMock.ListVotesBy")
+		}
+		return (*mm_results).va1, (*mm_results).err
+	}
+	if mmListVotesBy.funcListVotesBy != nil {
+		return mmListVotesBy.funcListVotesBy(ctx, voterID)
+	}
+	mmListVotesBy.t.Fatalf("Unexpected call to VoteRepositoryMock.ListVotesBy. %v %v", ctx, voterID)
+	return
+}
+
+// ListVotesByAfterCounter returns a count of finished VoteRepositoryMock.ListVotesBy invocations
+func (mmListVotesBy *VoteRepositoryMock) ListVotesByAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListVotesBy.afterListVotesByCounter)
+}
+
+// ListVotesByBeforeCounter returns a count of VoteRepositoryMock.ListVotesBy invocations
+func (mmListVotesBy *VoteRepositoryMock) ListVotesByBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListVotesBy.beforeListVotesByCounter)
+}
+
+// Calls returns a list of arguments used in each call to VoteRepositoryMock.ListVotesBy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListVotesBy *mVoteRepositoryMockListVotesBy) Calls() []*VoteRepositoryMockListVotesByParams {
+	mmListVotesBy.mutex.RLock()
+
+	argCopy := make([]*VoteRepositoryMockListVotesByParams, len(mmListVotesBy.callArgs))
+	copy(argCopy, mmListVotesBy.callArgs)
+
+	mmListVotesBy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListVotesByDone returns true if the count of the ListVotesBy invocations corresponds
+// the number of defined expectations
+func (m *VoteRepositoryMock) MinimockListVotesByDone() bool {
+	if m.ListVotesByMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListVotesByMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListVotesByMock.invocationsDone()
+}
+
+// MinimockListVotesByInspect logs each unmet expectation
+func (m *VoteRepositoryMock) MinimockListVotesByInspect() {
+	for _, e := range m.ListVotesByMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VoteRepositoryMock.ListVotesBy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListVotesByCounter := mm_atomic.LoadUint64(&m.afterListVotesByCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListVotesByMock.defaultExpectation != nil && afterListVotesByCounter < 1 {
+		if m.ListVotesByMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VoteRepositoryMock.ListVotesBy at\n%s", m.ListVotesByMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VoteRepositoryMock.ListVotesBy at\n%s with params: %#v", m.ListVotesByMock.defaultExpectation.expectationOrigins.origin, *m.ListVotesByMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListVotesBy != nil && afterListVotesByCounter < 1 {
+		m.t.Errorf("Expected call to VoteRepositoryMock.ListVotesBy at\n%s", m.funcListVotesByOrigin)
+	}
+
+	if !m.ListVotesByMock.invocationsDone() && afterListVotesByCounter > 0 {
+		m.t.Errorf("Expected %d calls to VoteRepositoryMock.ListVotesBy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListVotesByMock.expectedInvocations), m.ListVotesByMock.expectedInvocationsOrigin, afterListVotesByCounter)
+	}
+}
+
+type mVoteRepositoryMockSetVote struct {
+	optional           bool
+	mock               *VoteRepositoryMock
+	defaultExpectation *VoteRepositoryMockSetVoteExpectation
+	expectations       []*VoteRepositoryMockSetVoteExpectation
+
+	callArgs []*VoteRepositoryMockSetVoteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VoteRepositoryMockSetVoteExpectation specifies expectation struct of the VoteRepository.SetVote
+type VoteRepositoryMockSetVoteExpectation struct {
+	mock               *VoteRepositoryMock
+	params             *VoteRepositoryMockSetVoteParams
+	paramPtrs          *VoteRepositoryMockSetVoteParamPtrs
+	expectationOrigins VoteRepositoryMockSetVoteExpectationOrigins
+	results            *VoteRepositoryMockSetVoteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VoteRepositoryMockSetVoteParams contains parameters of the VoteRepository.SetVote
+type VoteRepositoryMockSetVoteParams struct {
+	ctx  context.Context
+	vote mm_repository.Vote
+}
+
+// VoteRepositoryMockSetVoteParamPtrs contains pointers to parameters of the VoteRepository.SetVote
+type VoteRepositoryMockSetVoteParamPtrs struct {
+	ctx  *context.Context
+	vote *mm_repository.Vote
+}
+
+// VoteRepositoryMockSetVoteResults contains results of the VoteRepository.SetVote
+type VoteRepositoryMockSetVoteResults struct {
+	i1  int32
+	err error
+}
+
+// VoteRepositoryMockSetVoteOrigins contains origins of expectations of the VoteRepository.SetVote
+type VoteRepositoryMockSetVoteExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originVote string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetVote *mVoteRepositoryMockSetVote) Optional() *mVoteRepositoryMockSetVote {
+	mmSetVote.optional = true
+	return mmSetVote
+}
+
+// Expect sets up expected params for VoteRepository.SetVote
+func (mmSetVote *mVoteRepositoryMockSetVote) Expect(ctx context.Context, vote mm_repository.Vote) *mVoteRepositoryMockSetVote {
+	if mmSetVote.mock.funcSetVote != nil {
+		mmSetVote.mock.t.Fatalf("VoteRepositoryMock.SetVote mock is already set by Set")
+	}
+
+	if mmSetVote.defaultExpectation == nil {
+		mmSetVote.defaultExpectation = &VoteRepositoryMockSetVoteExpectation{}
+	}
+
+	if mmSetVote.defaultExpectation.paramPtrs != nil {
+		mmSetVote.mock.t.Fatalf("VoteRepositoryMock.SetVote mock is already set by ExpectParams functions")
+	}
+
+	mmSetVote.defaultExpectation.params = &VoteRepositoryMockSetVoteParams{ctx, vote}
+	mmSetVote.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetVote.expectations {
+		if minimock.Equal(e.params, mmSetVote.defaultExpectation.params) {
+			mmSetVote.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetVote.defaultExpectation.params)
+		}
+	}
+
+	return mmSetVote
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VoteRepository.SetVote
+func (mmSetVote *mVoteRepositoryMockSetVote) ExpectCtxParam1(ctx context.Context) *mVoteRepositoryMockSetVote {
+	if mmSetVote.mock.funcSetVote != nil {
+		mmSetVote.mock.t.Fatalf("VoteRepositoryMock.SetVote mock is already set by Set")
+	}
+
+	if mmSetVote.defaultExpectation == nil {
+		mmSetVote.defaultExpectation = &VoteRepositoryMockSetVoteExpectation{}
+	}
+
+	if mmSetVote.defaultExpectation.params != nil {
+		mmSetVote.mock.t.Fatalf("VoteRepositoryMock.SetVote mock is already set by Expect")
+	}
+
+	if mmSetVote.defaultExpectation.paramPtrs == nil {
+		mmSetVote.defaultExpectation.paramPtrs = &VoteRepositoryMockSetVoteParamPtrs{}
+	}
+	mmSetVote.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetVote.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetVote
+}
+
+// ExpectVoteParam2 sets up expected param vote for VoteRepository.SetVote
+func (mmSetVote *mVoteRepositoryMockSetVote) ExpectVoteParam2(vote mm_repository.Vote) *mVoteRepositoryMockSetVote {
+	if mmSetVote.mock.funcSetVote != nil {
+		mmSetVote.mock.t.Fatalf("VoteRepositoryMock.SetVote mock is already set by Set")
+	}
+
+	if mmSetVote.defaultExpectation == nil {
+		mmSetVote.defaultExpectation = &VoteRepositoryMockSetVoteExpectation{}
+	}
+
+	if mmSetVote.defaultExpectation.params != nil {
+		mmSetVote.mock.t.Fatalf("VoteRepositoryMock.SetVote mock is already set by Expect")
+	}
+
+	if mmSetVote.defaultExpectation.paramPtrs == nil {
+		mmSetVote.defaultExpectation.paramPtrs = &VoteRepositoryMockSetVoteParamPtrs{}
+	}
+	mmSetVote.defaultExpectation.paramPtrs.vote = &vote
+	mmSetVote.defaultExpectation.expectationOrigins.originVote = minimock.CallerInfo(1)
+
+	return mmSetVote
+}
+
+// Inspect accepts an inspector function that has same arguments as the VoteRepository.SetVote
+func (mmSetVote *mVoteRepositoryMockSetVote) Inspect(f func(ctx context.Context, vote mm_repository.Vote)) *mVoteRepositoryMockSetVote {
+	if mmSetVote.mock.inspectFuncSetVote != nil {
+		mmSetVote.mock.t.Fatalf("Inspect function is already set for VoteRepositoryMock.SetVote")
+	}
+
+	mmSetVote.mock.inspectFuncSetVote = f
+
+	return mmSetVote
+}
+
+// Return sets up results that will be returned by VoteRepository.SetVote
+func (mmSetVote *mVoteRepositoryMockSetVote) Return(i1 int32, err error) *VoteRepositoryMock {
+	if mmSetVote.mock.funcSetVote != nil {
+		mmSetVote.mock.t.Fatalf("VoteRepositoryMock.SetVote mock is already set by Set")
+	}
+
+	if mmSetVote.defaultExpectation == nil {
+		mmSetVote.defaultExpectation = &VoteRepositoryMockSetVoteExpectation{mock: mmSetVote.mock}
+	}
+	mmSetVote.defaultExpectation.results = &VoteRepositoryMockSetVoteResults{i1, err}
+	mmSetVote.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetVote.mock
+}
+
+// Set uses given function f to mock the VoteRepository.SetVote method
+func (mmSetVote *mVoteRepositoryMockSetVote) Set(f func(ctx context.Context, vote mm_repository.Vote) (i1 int32, err error)) *VoteRepositoryMock {
+	if mmSetVote.defaultExpectation != nil {
+		mmSetVote.mock.t.Fatalf("Default expectation is already set for the VoteRepository.SetVote method")
+	}
+
+	if len(mmSetVote.expectations) > 0 {
+		mmSetVote.mock.t.Fatalf("Some expectations are already set for the VoteRepository.SetVote method")
+	}
+
+	mmSetVote.mock.funcSetVote = f
+	mmSetVote.mock.funcSetVoteOrigin = minimock.CallerInfo(1)
+	return mmSetVote.mock
+}
+
+// When sets expectation for the VoteRepository.SetVote which will trigger the result defined by the following
+// Then helper
+func (mmSetVote *mVoteRepositoryMockSetVote) When(ctx context.Context, vote mm_repository.Vote) *VoteRepositoryMockSetVoteExpectation {
+	if mmSetVote.mock.funcSetVote != nil {
+		mmSetVote.mock.t.Fatalf("VoteRepositoryMock.SetVote mock is already set by Set")
+	}
+
+	expectation := &VoteRepositoryMockSetVoteExpectation{
+		mock:               mmSetVote.mock,
+		params:             &VoteRepositoryMockSetVoteParams{ctx, vote},
+		expectationOrigins: VoteRepositoryMockSetVoteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetVote.expectations = append(mmSetVote.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VoteRepository.SetVote return parameters for the expectation previously defined by the When method
+func (e *VoteRepositoryMockSetVoteExpectation) Then(i1 int32, err error) *VoteRepositoryMock {
+	e.results = &VoteRepositoryMockSetVoteResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times VoteRepository.SetVote should be invoked
+func (mmSetVote *mVoteRepositoryMockSetVote) Times(n uint64) *mVoteRepositoryMockSetVote {
+	if n == 0 {
+		mmSetVote.mock.t.Fatalf("Times of VoteRepositoryMock.SetVote mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetVote.expectedInvocations, n)
+	mmSetVote.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetVote
+}
+
+func (mmSetVote *mVoteRepositoryMockSetVote) invocationsDone() bool {
+	if len(mmSetVote.expectations) == 0 && mmSetVote.defaultExpectation == nil && mmSetVote.mock.funcSetVote == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetVote.mock.afterSetVoteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetVote.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetVote implements mm_repository.VoteRepository
+func (mmSetVote *VoteRepositoryMock) SetVote(ctx context.Context, vote mm_repository.Vote) (i1 int32, err error) {
+	mm_atomic.AddUint64(&mmSetVote.beforeSetVoteCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetVote.afterSetVoteCounter, 1)
+
+	mmSetVote.t.Helper()
+
+	if mmSetVote.inspectFuncSetVote != nil {
+		mmSetVote.inspectFuncSetVote(ctx, vote)
+	}
+
+	mm_params := VoteRepositoryMockSetVoteParams{ctx, vote}
+
+	// Record call args
+	mmSetVote.SetVoteMock.mutex.Lock()
+	mmSetVote.SetVoteMock.callArgs = append(mmSetVote.SetVoteMock.callArgs, &mm_params)
+	mmSetVote.SetVoteMock.mutex.Unlock()
+
+	for _, e := range mmSetVote.SetVoteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmSetVote.SetVoteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetVote.SetVoteMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetVote.SetVoteMock.defaultExpectation.params
+		mm_want_ptrs := mmSetVote.SetVoteMock.defaultExpectation.paramPtrs
+
+		mm_got := VoteRepositoryMockSetVoteParams{ctx, vote}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetVote.t.Errorf("VoteRepositoryMock.SetVote got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetVote.SetVoteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.vote != nil && !minimock.Equal(*mm_want_ptrs.vote, mm_got.vote) {
+				mmSetVote.t.Errorf("VoteRepositoryMock.SetVote got unexpected parameter vote, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetVote.SetVoteMock.defaultExpectation.expectationOrigins.originVote, *mm_want_ptrs.vote, mm_got.vote, minimock.Diff(*mm_want_ptrs.vote, mm_got.vote))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetVote.t.Errorf("VoteRepositoryMock.SetVote got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetVote.SetVoteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetVote.SetVoteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetVote.t.Fatal("No results are set for the VoteRepositoryMock.SetVote")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmSetVote.funcSetVote != nil {
+		return mmSetVote.funcSetVote(ctx, vote)
+	}
+	mmSetVote.t.Fatalf("Unexpected call to VoteRepositoryMock.SetVote. %v %v", ctx, vote)
+	return
+}
+
+// SetVoteAfterCounter returns a count of finished VoteRepositoryMock.SetVote invocations
+func (mmSetVote *VoteRepositoryMock) SetVoteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetVote.afterSetVoteCounter)
+}
+
+// SetVoteBeforeCounter returns a count of VoteRepositoryMock.SetVote invocations
+func (mmSetVote *VoteRepositoryMock) SetVoteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetVote.beforeSetVoteCounter)
+}
+
+// Calls returns a list of arguments used in each call to VoteRepositoryMock.SetVote.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetVote *mVoteRepositoryMockSetVote) Calls() []*VoteRepositoryMockSetVoteParams {
+	mmSetVote.mutex.RLock()
+
+	argCopy := make([]*VoteRepositoryMockSetVoteParams, len(mmSetVote.callArgs))
+	copy(argCopy, mmSetVote.callArgs)
+
+	mmSetVote.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetVoteDone returns true if the count of the SetVote invocations corresponds
+// the number of defined expectations
+func (m *VoteRepositoryMock) MinimockSetVoteDone() bool {
+	if m.SetVoteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetVoteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetVoteMock.invocationsDone()
+}
+
+// MinimockSetVoteInspect logs each unmet expectation
+func (m *VoteRepositoryMock) MinimockSetVoteInspect() {
+	for _, e := range m.SetVoteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VoteRepositoryMock.SetVote at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetVoteCounter := mm_atomic.LoadUint64(&m.afterSetVoteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetVoteMock.defaultExpectation != nil && afterSetVoteCounter < 1 {
+		if m.SetVoteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VoteRepositoryMock.SetVote at\n%s", m.SetVoteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VoteRepositoryMock.SetVote at\n%s with params: %#v", m.SetVoteMock.defaultExpectation.expectationOrigins.origin, *m.SetVoteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetVote != nil && afterSetVoteCounter < 1 {
+		m.t.Errorf("Expected call to VoteRepositoryMock.SetVote at\n%s", m.funcSetVoteOrigin)
+	}
+
+	if !m.SetVoteMock.invocationsDone() && afterSetVoteCounter > 0 {
+		m.t.Errorf("Expected %d calls to VoteRepositoryMock.SetVote at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetVoteMock.expectedInvocations), m.SetVoteMock.expectedInvocationsOrigin, afterSetVoteCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *VoteRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDeleteReviewVotesInspect()
+
+			m.MinimockDeleteUserVotesInspect()
+
+			m.MinimockDeleteVoteInspect()
+
+			m.MinimockListVotesByInspect()
+
+			m.MinimockSetVoteInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *VoteRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *VoteRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeleteReviewVotesDone() &&
+		m.MinimockDeleteUserVotesDone() &&
+		m.MinimockDeleteVoteDone() &&
+		m.MinimockListVotesByDone() &&
+		m.MinimockSetVoteDone()
+}
@@ -1,7 +1,281 @@
 package repository
 
+import (
+	"slices"
+	"strconv"
+	"time"
+)
+
 type Review struct {
-	UserID  string `bson:"userID"`
-	MovieID string `bson:"movieID"`
-	Text    string `bson:"text"`
+	UserID           string       `bson:"userID"`
+	MovieID          string       `bson:"movieID"`
+	Text             string       `bson:"text"`
+	Rating           int32        `bson:"rating"`
+	CreatedAt        time.Time    `bson:"createdAt"`
+	UpdatedAt        time.Time    `bson:"updatedAt"`
+	Likes            int64        `bson:"likes"`
+	Dislikes         int64        `bson:"dislikes"`
+	Status           ReviewStatus `bson:"status"`
+	ModerationReason string       `bson:"moderationReason"`
+	// Version is bumped on every edit of the author.
+	Version int64 `bson:"version"`
+	// DeletedAt is set while the review is soft deleted, until it is restored or purged.
+	DeletedAt *time.Time `bson:"deletedAt,omitempty"`
+	// ModeratedAt is the time the status was last set by moderation.
+	ModeratedAt *time.Time `bson:"moderatedAt,omitempty"`
+}
+
+// ReviewField is a field of the review its author can change, named as it is stored.
+type ReviewField string
+
+const (
+	FieldText   ReviewField = "text"
+	FieldRating ReviewField = "rating"
+)
+
+// EditableFields are the fields an update without an explicit field list changes.
+var EditableFields = []ReviewField{FieldText, FieldRating}
+
+// Visible tells whether the review is shown to everyone and counted in the movie rating.
+func (r Review) Visible() bool {
+	return r.Status == StatusApproved || r.Status == ""
+}
+
+// ReviewStatus is the moderation state of a review. Reviews stored before
+// moderation was introduced have no status and are treated as approved.
+type ReviewStatus string
+
+const (
+	StatusPending  ReviewStatus = "pending"
+	StatusApproved ReviewStatus = "approved"
+	StatusRejected ReviewStatus = "rejected"
+	StatusHidden   ReviewStatus = "hidden"
+)
+
+const (
+	VoteUp   int32 = 1
+	VoteDown int32 = -1
+)
+
+// Vote is a helpfulness vote a user left on someone else's review.
+type Vote struct {
+	VoterID   string    `bson:"voterID"`
+	UserID    string    `bson:"userID"`
+	MovieID   string    `bson:"movieID"`
+	Value     int32     `bson:"value"`
+	CreatedAt time.Time `bson:"createdAt"`
+}
+
+// SearchHit is a review matched by a text search together with its relevance.
+// Snippet is filled by the service for presentation.
+type SearchHit struct {
+	Review  `bson:",inline"`
+	Score   float64 `bson:"score"`
+	Snippet string  `bson:"-"`
+}
+
+type ReviewEventType int
+
+const (
+	ReviewCreated ReviewEventType = iota + 1
+	ReviewUpdated
+	ReviewDeleted
+)
+
+// ReviewEvent is a change of a review read from the change stream.
+// Review of a deleted review carries only its ids.
+type ReviewEvent struct {
+	Type        ReviewEventType
+	Review      Review
+	ResumeToken string
+}
+
+type ReportReason string
+
+const (
+	ReportSpam     ReportReason = "spam"
+	ReportSpoiler  ReportReason = "spoiler"
+	ReportHate     ReportReason = "hate"
+	ReportOffTopic ReportReason = "off_topic"
+)
+
+// Report is a complaint a user filed about someone else's review.
+type Report struct {
+	ReporterID string       `bson:"reporterID"`
+	UserID     string       `bson:"userID"`
+	MovieID    string       `bson:"movieID"`
+	Reason     ReportReason `bson:"reason"`
+	Text       string       `bson:"text"`
+	CreatedAt  time.Time    `bson:"createdAt"`
+}
+
+// Bookmark is a movie the user saved to watch later.
+type Bookmark struct {
+	UserID    string    `bson:"userID"`
+	MovieID   string    `bson:"movieID"`
+	CreatedAt time.Time `bson:"createdAt"`
+}
+
+// Comment is a reply to a review. Replies to a comment carry ParentID and
+// are never nested deeper than one level.
+type Comment struct {
+	ID           string    `bson:"_id"`
+	ReviewUserID string    `bson:"reviewUserID"`
+	MovieID      string    `bson:"movieID"`
+	AuthorID     string    `bson:"authorID"`
+	ParentID     string    `bson:"parentID"`
+	Text         string    `bson:"text"`
+	CreatedAt    time.Time `bson:"createdAt"`
+	UpdatedAt    time.Time `bson:"updatedAt"`
+	Replies      []Comment `bson:"-"`
+}
+
+type ReviewSort int
+
+const (
+	// SortDefault keeps reviews in the order they were added.
+	SortDefault ReviewSort = iota
+	SortNewest
+	SortOldest
+	SortMostHelpful
+	SortHighestRated
+)
+
+// ReviewCursor is the position after the last review of a page. It holds the values
+// the listings are sorted by, so the next page starts right after that review
+// whatever was added or removed before it in the meantime.
+type ReviewCursor struct {
+	UserID    string
+	MovieID   string
+	CreatedAt time.Time
+	Likes     int64
+	Dislikes  int64
+	Rating    int32
+}
+
+// CursorAfter returns the cursor of the page ending with review.
+func CursorAfter(review Review) ReviewCursor {
+	return ReviewCursor{
+		UserID:    review.UserID,
+		MovieID:   review.MovieID,
+		CreatedAt: review.CreatedAt,
+		Likes:     review.Likes,
+		Dislikes:  review.Dislikes,
+		Rating:    review.Rating,
+	}
+}
+
+// MovieRating is an aggregate of review ratings stored alongside the movie document.
+// Histogram is keyed by the rating value.
+type MovieRating struct {
+	MovieID   string           `bson:"-"`
+	Count     int64            `bson:"count"`
+	Sum       int64            `bson:"sum"`
+	Histogram map[string]int64 `bson:"histogram"`
+}
+
+// ReviewSummary is the part of the movie rating aggregate shown in catalogue listings.
+type ReviewSummary struct {
+	MovieID string `bson:"_id"`
+	Count   int64  `bson:"count"`
+	Sum     int64  `bson:"sum"`
+}
+
+func (r ReviewSummary) Average() float64 {
+	if r.Count == 0 {
+		return 0
+	}
+	return float64(r.Sum) / float64(r.Count)
+}
+
+func (r MovieRating) Average() float64 {
+	if r.Count == 0 {
+		return 0
+	}
+	return float64(r.Sum) / float64(r.Count)
+}
+
+func (r MovieRating) CountOf(rating int32) int64 {
+	return r.Histogram[strconv.Itoa(int(rating))]
+}
+
+// Revision is the content a review had at Version, before EditorID replaced it at EditedAt.
+type Revision struct {
+	UserID   string    `bson:"userID"`
+	MovieID  string    `bson:"movieID"`
+	Version  int64     `bson:"version"`
+	Text     string    `bson:"text"`
+	Rating   int32     `bson:"rating"`
+	EditorID string    `bson:"editorID"`
+	EditedAt time.Time `bson:"editedAt"`
+}
+
+// EditorImport is the editor of revisions replaced by a review import.
+const EditorImport = "import"
+
+// AnalyticsEvent is a review event of the user as it was loaded into clickhouse by the ETL.
+// TimestampMS is milliseconds since the epoch, rows written in seconds by the first ETL
+// versions are converted by the timestamp_ms migration.
+type AnalyticsEvent struct {
+	UserID      string
+	MovieID     string
+	Event       string
+	TimestampMS int64
+}
+
+// ErasureStep is a stage of erasing a user, recorded once it is done.
+type ErasureStep string
+
+const (
+	ErasureReviews   ErasureStep = "reviews"
+	ErasureVotes     ErasureStep = "votes"
+	ErasureComments  ErasureStep = "comments"
+	ErasureReports   ErasureStep = "reports"
+	ErasureBookmarks ErasureStep = "bookmarks"
+	ErasureProgress  ErasureStep = "progress"
+	ErasureCache     ErasureStep = "cache"
+	ErasureAnalytics ErasureStep = "analytics"
+	ErasureEvent     ErasureStep = "event"
+)
+
+// OutboxEvent is an event written together with the change it is about and
+// published to the broker later. Payload is the encoded message. An unsent event
+// can be claimed by a relay once LockedUntil has passed.
+type OutboxEvent struct {
+	ID          string     `bson:"_id"`
+	Payload     []byte     `bson:"payload"`
+	CreatedAt   time.Time  `bson:"createdAt"`
+	LockedUntil time.Time  `bson:"lockedUntil"`
+	SentAt      *time.Time `bson:"sentAt"`
+}
+
+// Erasure is the progress of erasing a user. MovieIDs are the movies the user
+// had reviews for, their cached listings are dropped after the reviews are gone.
+type Erasure struct {
+	UserID    string        `bson:"_id"`
+	MovieIDs  []string      `bson:"movieIDs"`
+	Done      []ErasureStep `bson:"done"`
+	StartedAt time.Time     `bson:"startedAt"`
+}
+
+func (e Erasure) IsDone(step ErasureStep) bool {
+	return slices.Contains(e.Done, step)
+}
+
+// finishedRatio is the part of the movie after which it counts as watched,
+// the rest is usually end credits.
+const finishedRatio = 0.95
+
+// Progress is the playback position of the user in the movie.
+type Progress struct {
+	UserID     string    `bson:"userID"`
+	MovieID    string    `bson:"movieID"`
+	PositionMS int64     `bson:"positionMS"`
+	DurationMS int64     `bson:"durationMS"`
+	UpdatedAt  time.Time `bson:"updatedAt"`
+}
+
+// Finished tells whether the movie was watched to the end, such movies are not continued.
+func (p Progress) Finished() bool {
+	return float64(p.PositionMS) >= float64(p.DurationMS)*finishedRatio
 }
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// MovieModerationRepository looks up reviews by status or deletion time across all movies.
+type MovieModerationRepository struct {
+	coll *mongo.Collection
+}
+
+func NewMovieModerationRepository(c *mongo.Collection) ModerationRepository {
+	return &MovieModerationRepository{
+		coll: c,
+	}
+}
+
+// CreateModerationIndexes creates the index used to find movies with reviews in a given status.
+func CreateModerationIndexes(ctx context.Context, c *mongo.Collection) error {
+	_, err := c.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "reviews.status", Value: 1}}},
+		{Keys: bson.D{{Key: "reviews.deletedAt", Value: 1}}, Options: options.Index().SetSparse(true)},
+	})
+	return err
+}
+
+func (r *MovieModerationRepository) ListReviews(ctx context.Context, statuses []ReviewStatus, offset, limit int) ([]Review, error) {
+	filter := bson.M{"reviews.status": bson.M{"$in": statuses}}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$unwind", Value: "$reviews"}},
+		{{Key: "$match", Value: bson.M{"reviews.status": bson.M{"$in": statuses}, "reviews.deletedAt": nil}}},
+		{{Key: "$replaceRoot", Value: bson.M{
+			"newRoot": bson.M{"$mergeObjects": bson.A{"$reviews", bson.M{"movieID": "$_id"}}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "createdAt", Value: 1}, {Key: "movieID", Value: 1}, {Key: "userID", Value: 1}}}},
+		{{Key: "$skip", Value: offset}},
+		{{Key: "$limit", Value: limit}},
+	}
+
+	cursor, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return []Review{}, fmt.Errorf("failed to aggregate %v reviews: %w", statuses, err)
+	}
+
+	reviews := []Review{}
+	if err := cursor.All(ctx, &reviews); err != nil {
+		return []Review{}, fmt.Errorf("failed to decode %v reviews: %w", statuses, err)
+	}
+
+	return reviews, nil
+}
+
+func (r *MovieModerationRepository) ListDeleted(ctx context.Context, before time.Time, limit int) ([]Review, error) {
+	filter := bson.M{"reviews.deletedAt": bson.M{"$lt": before}}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$unwind", Value: "$reviews"}},
+		{{Key: "$match", Value: filter}},
+		{{Key: "$replaceRoot", Value: bson.M{
+			"newRoot": bson.M{"$mergeObjects": bson.A{"$reviews", bson.M{"movieID": "$_id"}}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "deletedAt", Value: 1}, {Key: "movieID", Value: 1}, {Key: "userID", Value: 1}}}},
+		{{Key: "$limit", Value: limit}},
+	}
+
+	cursor, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return []Review{}, fmt.Errorf("failed to aggregate reviews deleted before %v: %w", before, err)
+	}
+
+	reviews := []Review{}
+	if err := cursor.All(ctx, &reviews); err != nil {
+		return []Review{}, fmt.Errorf("failed to decode reviews deleted before %v: %w", before, err)
+	}
+
+	return reviews, nil
+}
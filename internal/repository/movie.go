@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
@@ -20,23 +21,54 @@ func NewMovieReviewRepository(c *mongo.Collection) ReviewRepository {
 	}
 }
 
-func (r *MovieReviewRepository) GetReviews(ctx context.Context, ID string) ([]Review, error) {
-	var result map[string][]Review
+func (r *MovieReviewRepository) GetReviews(ctx context.Context, ID string, statuses []ReviewStatus, sort ReviewSort, after *ReviewCursor, limit int) ([]Review, error) {
+	return getReviews(ctx, r.coll, ID, "userID", statuses, sort, after, limit)
+}
+
+func (r *MovieReviewRepository) GetReview(ctx context.Context, userID, movieID string) (Review, error) {
+	review, err := findReview(ctx, r.coll, movieID, liveReview("userID", userID))
+
+	if errors.Is(err, ErrNotFound) {
+		return Review{}, ErrNotFound
+	} else if err != nil {
+		return Review{}, fmt.Errorf("failed to find review of user %v for movie %v: %w", userID, movieID, err)
+	}
+
+	review.UserID, review.MovieID = userID, movieID
+
+	return review, nil
+}
 
-	filter := bson.M{"_id": ID}
-	opts := options.FindOne().SetProjection(bson.M{"_id": 0, "reviews": 1})
-	err := r.coll.FindOne(ctx, filter, opts).Decode(&result)
+func (r *MovieReviewRepository) GetDeletedReview(ctx context.Context, userID, movieID string) (Review, error) {
+	review, err := findReview(ctx, r.coll, movieID, deletedReview("userID", userID))
 
-	if errors.Is(err, mongo.ErrNoDocuments) {
-		return []Review{}, ErrNotFound
+	if errors.Is(err, ErrNotFound) {
+		return Review{}, ErrNotFound
 	} else if err != nil {
-		return []Review{}, fmt.Errorf("failed to find review for userID %v: %w", ID, err)
+		return Review{}, fmt.Errorf("failed to find deleted review of user %v for movie %v: %w", userID, movieID, err)
+	}
+
+	review.UserID, review.MovieID = userID, movieID
+
+	return review, nil
+}
+
+func (r *MovieReviewRepository) ListAllReviews(ctx context.Context, ID string) ([]Review, error) {
+	reviews, err := listAllReviews(ctx, r.coll, ID)
+
+	if err != nil {
+		return []Review{}, fmt.Errorf("failed to list all reviews of movie %v: %w", ID, err)
+	}
+
+	for i := range reviews {
+		reviews[i].MovieID = ID
 	}
-	return result["reviews"], nil
+
+	return reviews, nil
 }
 
 func (r *MovieReviewRepository) CreateReview(ctx context.Context, review Review) error {
-	filter := bson.M{"_id": review.MovieID, "reviews.userID": review.UserID}
+	filter := bson.M{"_id": review.MovieID, "reviews": liveReview("userID", review.UserID)}
 	mResult := r.coll.FindOne(ctx, filter)
 
 	if err := mResult.Err(); err == nil {
@@ -44,13 +76,26 @@ func (r *MovieReviewRepository) CreateReview(ctx context.Context, review Review)
 		return ErrAlreadyExists
 	}
 
+	// a soft deleted review of the same pair gives way to the new one
+	if err := dropDeleted(ctx, r.coll, review.MovieID, "userID", review.UserID); err != nil {
+		return fmt.Errorf("failed to drop deleted review %v: %w", review, err)
+	}
+
 	opts := options.UpdateOne().SetUpsert(true)
 
 	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": review.MovieID}, bson.M{
 		"$push": map[string]interface{}{
-			"reviews": map[string]string{
-				"userID": review.UserID,
-				"text":   review.Text,
+			"reviews": bson.M{
+				"userID":           review.UserID,
+				"text":             review.Text,
+				"rating":           review.Rating,
+				"createdAt":        review.CreatedAt,
+				"updatedAt":        review.UpdatedAt,
+				"likes":            review.Likes,
+				"dislikes":         review.Dislikes,
+				"status":           review.Status,
+				"moderationReason": review.ModerationReason,
+				"version":          review.Version,
 			},
 		},
 	},
@@ -64,20 +109,96 @@ func (r *MovieReviewRepository) CreateReview(ctx context.Context, review Review)
 	return nil
 }
 
-func (r *MovieReviewRepository) UpdateReview(ctx context.Context, review Review) error {
-	filter := bson.M{"_id": review.MovieID, "reviews.userID": review.UserID}
+func (r *MovieReviewRepository) UpdateReview(ctx context.Context, review Review, fields ...ReviewField) error {
+	return updateReview(ctx, r.coll, review.MovieID, "userID", review.UserID, review, fields)
+}
+
+func (r *MovieReviewRepository) DeleteReview(ctx context.Context, userID, movieID string) error {
+	filter := bson.M{"_id": movieID, "reviews.userID": userID}
+
+	result, err := r.coll.UpdateOne(ctx, filter, bson.M{
+		"$pull": bson.M{
+			"reviews": bson.M{"userID": userID},
+		},
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to delete review of user %v for movie %v: %w", userID, movieID, err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *MovieReviewRepository) IncrementVotes(ctx context.Context, userID, movieID string, likes, dislikes int64) error {
+	filter := bson.M{"_id": movieID, "reviews": liveReview("userID", userID)}
+
+	result, err := r.coll.UpdateOne(ctx, filter, bson.M{
+		"$inc": bson.M{
+			"reviews.$.likes":    likes,
+			"reviews.$.dislikes": dislikes,
+		},
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to update votes of user %v review for movie %v: %w", userID, movieID, err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *MovieReviewRepository) SetStatus(ctx context.Context, userID, movieID string, status ReviewStatus, reason string, moderatedAt time.Time) error {
+	filter := bson.M{"_id": movieID, "reviews": liveReview("userID", userID)}
 
-	userUpdResult := r.coll.FindOneAndUpdate(ctx, filter, bson.M{
-		"$set": map[string]string{
-			"reviews.$.text": review.Text,
+	result, err := r.coll.UpdateOne(ctx, filter, bson.M{
+		"$set": bson.M{
+			"reviews.$.status":           status,
+			"reviews.$.moderationReason": reason,
+			"reviews.$.moderatedAt":      moderatedAt,
 		},
 	})
 
-	if err := userUpdResult.Err(); err != nil {
-		if errors.Is(err, mongo.ErrNoDocuments) {
-			return ErrNotFound
-		}
-		return fmt.Errorf("failed to update %v: %w", review, err)
+	if err != nil {
+		return fmt.Errorf("failed to set status of user %v review for movie %v: %w", userID, movieID, err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *MovieReviewRepository) SoftDeleteReview(ctx context.Context, userID, movieID string, deletedAt time.Time) error {
+	err := setDeleted(ctx, r.coll, movieID, "userID", userID, &deletedAt)
+
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("failed to soft delete review of user %v for movie %v: %w", userID, movieID, err)
+	}
+
+	return err
+}
+
+func (r *MovieReviewRepository) RestoreReview(ctx context.Context, userID, movieID string) error {
+	err := setDeleted(ctx, r.coll, movieID, "userID", userID, nil)
+
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("failed to restore review of user %v for movie %v: %w", userID, movieID, err)
+	}
+
+	return err
+}
+
+func (r *MovieReviewRepository) PurgeReview(ctx context.Context, userID, movieID string, before time.Time) error {
+	if err := purgeDeleted(ctx, r.coll, movieID, "userID", userID, before); err != nil {
+		return fmt.Errorf("failed to purge review of user %v for movie %v: %w", userID, movieID, err)
 	}
 
 	return nil
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type MongoOutboxRepository struct {
+	coll *mongo.Collection
+}
+
+func NewMongoOutboxRepository(c *mongo.Collection) OutboxRepository {
+	return &MongoOutboxRepository{
+		coll: c,
+	}
+}
+
+// CreateOutboxIndexes creates the index used to claim unsent events and the one
+// removing sent events after retention.
+func CreateOutboxIndexes(ctx context.Context, c *mongo.Collection, retention time.Duration) error {
+	_, err := c.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "sentAt", Value: 1}, {Key: "createdAt", Value: 1}}},
+		{Keys: bson.D{{Key: "claim", Value: 1}}},
+		{
+			Keys:    bson.D{{Key: "sentAt", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32(retention.Seconds())),
+		},
+	})
+	return err
+}
+
+func (r *MongoOutboxRepository) AddEvents(ctx context.Context, events []OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	for i := range events {
+		if events[i].ID == "" {
+			events[i].ID = bson.NewObjectID().Hex()
+		}
+		if events[i].LockedUntil.IsZero() {
+			events[i].LockedUntil = events[i].CreatedAt
+		}
+	}
+
+	if _, err := r.coll.InsertMany(ctx, events); err != nil {
+		return fmt.Errorf("failed to add %d outbox events: %w", len(events), err)
+	}
+
+	return nil
+}
+
+func (r *MongoOutboxRepository) ClaimEvents(ctx context.Context, limit int, now, lockedUntil time.Time) ([]OutboxEvent, error) {
+	claimable := bson.M{"sentAt": nil, "lockedUntil": bson.M{"$lte": now}}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "createdAt", Value: 1}}).
+		SetLimit(int64(limit)).
+		SetProjection(bson.M{"_id": 1})
+
+	cursor, err := r.coll.Find(ctx, claimable, opts)
+	if err != nil {
+		return []OutboxEvent{}, fmt.Errorf("failed to find unsent outbox events: %w", err)
+	}
+
+	var found []struct {
+		ID string `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &found); err != nil {
+		return []OutboxEvent{}, fmt.Errorf("failed to decode unsent outbox events: %w", err)
+	}
+
+	if len(found) == 0 {
+		return []OutboxEvent{}, nil
+	}
+
+	IDs := make([]string, 0, len(found))
+	for _, f := range found {
+		IDs = append(IDs, f.ID)
+	}
+
+	// events claimed by another relay in between no longer match and are left to it
+	claim := bson.NewObjectID().Hex()
+	claimable["_id"] = bson.M{"$in": IDs}
+
+	_, err = r.coll.UpdateMany(ctx, claimable, bson.M{
+		"$set": bson.M{"lockedUntil": lockedUntil, "claim": claim},
+	})
+	if err != nil {
+		return []OutboxEvent{}, fmt.Errorf("failed to claim outbox events: %w", err)
+	}
+
+	cursor, err = r.coll.Find(ctx, bson.M{"claim": claim}, options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}}))
+	if err != nil {
+		return []OutboxEvent{}, fmt.Errorf("failed to find claimed outbox events: %w", err)
+	}
+
+	events := []OutboxEvent{}
+	if err := cursor.All(ctx, &events); err != nil {
+		return []OutboxEvent{}, fmt.Errorf("failed to decode claimed outbox events: %w", err)
+	}
+
+	return events, nil
+}
+
+func (r *MongoOutboxRepository) MarkSent(ctx context.Context, IDs []string, sentAt time.Time) error {
+	if len(IDs) == 0 {
+		return nil
+	}
+
+	_, err := r.coll.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": IDs}}, bson.M{
+		"$set": bson.M{"sentAt": sentAt},
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to mark %d outbox events sent: %w", len(IDs), err)
+	}
+
+	return nil
+}
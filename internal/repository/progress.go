@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// PlaybackProgressRepository stores one document per user and movie, the _id
+// is built from both ids.
+type PlaybackProgressRepository struct {
+	coll *mongo.Collection
+}
+
+func NewPlaybackProgressRepository(c *mongo.Collection) ProgressRepository {
+	return &PlaybackProgressRepository{
+		coll: c,
+	}
+}
+
+// CreateProgressIndexes creates the index used to list positions of a user.
+func CreateProgressIndexes(ctx context.Context, c *mongo.Collection) error {
+	_, err := c.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "userID", Value: 1}, {Key: "updatedAt", Value: -1}},
+	})
+	return err
+}
+
+func progressID(userID, movieID string) string {
+	return movieID + ":" + userID
+}
+
+func (r *PlaybackProgressRepository) SaveProgress(ctx context.Context, progress []Progress) error {
+	if len(progress) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(progress))
+
+	for _, p := range progress {
+		// a newer stored position makes the filter miss and the upsert fail with a duplicate key
+		filter := bson.M{
+			"_id":       progressID(p.UserID, p.MovieID),
+			"updatedAt": bson.M{"$lt": p.UpdatedAt},
+		}
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(filter).
+			SetUpdate(bson.M{"$set": p}).
+			SetUpsert(true),
+		)
+	}
+
+	_, err := r.coll.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+
+	if err != nil && !onlyDuplicateKeys(err) {
+		return fmt.Errorf("failed to save %d positions: %w", len(progress), err)
+	}
+
+	return nil
+}
+
+// onlyDuplicateKeys tells whether every write of a bulk failed on an existing _id.
+func onlyDuplicateKeys(err error) bool {
+	var bulkErr mongo.BulkWriteException
+
+	if !errors.As(err, &bulkErr) || bulkErr.WriteConcernError != nil {
+		return false
+	}
+
+	for _, writeErr := range bulkErr.WriteErrors {
+		if !mongo.IsDuplicateKeyError(writeErr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *PlaybackProgressRepository) GetProgress(ctx context.Context, userID, movieID string) (Progress, error) {
+	var progress Progress
+
+	err := r.coll.FindOne(ctx, bson.M{"_id": progressID(userID, movieID)}).Decode(&progress)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return Progress{}, ErrNotFound
+	} else if err != nil {
+		return Progress{}, fmt.Errorf("failed to find position of user %v in movie %v: %w", userID, movieID, err)
+	}
+
+	return progress, nil
+}
+
+func (r *PlaybackProgressRepository) ListProgress(ctx context.Context, userID string) ([]Progress, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "updatedAt", Value: -1}})
+
+	cursor, err := r.coll.Find(ctx, bson.M{"userID": userID}, opts)
+	if err != nil {
+		return []Progress{}, fmt.Errorf("failed to find positions of user %v: %w", userID, err)
+	}
+
+	progress := []Progress{}
+	if err := cursor.All(ctx, &progress); err != nil {
+		return []Progress{}, fmt.Errorf("failed to decode positions of user %v: %w", userID, err)
+	}
+
+	return progress, nil
+}
+
+func (r *PlaybackProgressRepository) DeleteUserProgress(ctx context.Context, userID string) error {
+	if _, err := r.coll.DeleteMany(ctx, bson.M{"userID": userID}); err != nil {
+		return fmt.Errorf("failed to delete positions of user %v: %w", userID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// MovieRatingRepository keeps the rating aggregate in the "rating" field
+// of the movie document, so reads never have to scan the reviews array.
+type MovieRatingRepository struct {
+	coll *mongo.Collection
+}
+
+func NewMovieRatingRepository(c *mongo.Collection) RatingRepository {
+	return &MovieRatingRepository{
+		coll: c,
+	}
+}
+
+func (r *MovieRatingRepository) GetRating(ctx context.Context, movieID string) (MovieRating, error) {
+	var result struct {
+		Rating MovieRating `bson:"rating"`
+	}
+
+	filter := bson.M{"_id": movieID}
+	opts := options.FindOne().SetProjection(bson.M{"_id": 0, "rating": 1})
+	err := r.coll.FindOne(ctx, filter, opts).Decode(&result)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return MovieRating{}, ErrNotFound
+	} else if err != nil {
+		return MovieRating{}, fmt.Errorf("failed to find rating for movieID %v: %w", movieID, err)
+	}
+
+	result.Rating.MovieID = movieID
+	return result.Rating, nil
+}
+
+func (r *MovieRatingRepository) GetSummaries(ctx context.Context, movieIDs []string) ([]ReviewSummary, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"_id": bson.M{"$in": movieIDs}, "rating": bson.M{"$exists": true}}}},
+		{{Key: "$project", Value: bson.M{"count": "$rating.count", "sum": "$rating.sum"}}},
+	}
+
+	cursor, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate rating summaries: %w", err)
+	}
+
+	var summaries []ReviewSummary
+	if err := cursor.All(ctx, &summaries); err != nil {
+		return nil, fmt.Errorf("failed to decode rating summaries: %w", err)
+	}
+
+	return summaries, nil
+}
+
+func (r *MovieRatingRepository) UpdateRating(ctx context.Context, movieID string, oldRating, newRating int32) error {
+	if oldRating == newRating {
+		return nil
+	}
+
+	inc := bson.M{"rating.sum": newRating - oldRating}
+
+	if oldRating != 0 {
+		inc["rating.histogram."+strconv.Itoa(int(oldRating))] = -1
+	}
+	if newRating != 0 {
+		inc["rating.histogram."+strconv.Itoa(int(newRating))] = 1
+	}
+
+	switch {
+	case oldRating == 0:
+		inc["rating.count"] = 1
+	case newRating == 0:
+		inc["rating.count"] = -1
+	}
+
+	opts := options.UpdateOne().SetUpsert(true)
+
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": movieID}, bson.M{"$inc": inc}, opts)
+
+	if err != nil {
+		return fmt.Errorf("failed to update rating for movieID %v: %w", movieID, err)
+	}
+
+	return nil
+}
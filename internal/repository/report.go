@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// ReviewReportRepository stores one document per reporter and review, the _id
+// is built the same way as for votes.
+type ReviewReportRepository struct {
+	coll *mongo.Collection
+}
+
+func NewReviewReportRepository(c *mongo.Collection) ReportRepository {
+	return &ReviewReportRepository{
+		coll: c,
+	}
+}
+
+// CreateReportIndexes creates the indexes used to count reports of a review
+// and to list reports of a user.
+func CreateReportIndexes(ctx context.Context, c *mongo.Collection) error {
+	_, err := c.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "movieID", Value: 1}, {Key: "userID", Value: 1}}},
+		{Keys: bson.D{{Key: "reporterID", Value: 1}, {Key: "createdAt", Value: 1}}},
+	})
+	return err
+}
+
+func (r *ReviewReportRepository) AddReport(ctx context.Context, report Report) (bool, error) {
+	filter := bson.M{"_id": voteID(report.ReporterID, report.UserID, report.MovieID)}
+	update := bson.M{"$setOnInsert": report}
+	opts := options.UpdateOne().SetUpsert(true)
+
+	result, err := r.coll.UpdateOne(ctx, filter, update, opts)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to add report %v: %w", report, err)
+	}
+
+	return result.UpsertedCount > 0, nil
+}
+
+func (r *ReviewReportRepository) CountReports(ctx context.Context, userID, movieID string, after time.Time) (int64, error) {
+	n, err := r.coll.CountDocuments(ctx, bson.M{"movieID": movieID, "userID": userID, "createdAt": bson.M{"$gt": after}})
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to count reports of user %v review for movie %v: %w", userID, movieID, err)
+	}
+
+	return n, nil
+}
+
+func (r *ReviewReportRepository) ListReportsBy(ctx context.Context, reporterID string) ([]Report, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}})
+
+	cursor, err := r.coll.Find(ctx, bson.M{"reporterID": reporterID}, opts)
+	if err != nil {
+		return []Report{}, fmt.Errorf("failed to find reports of %v: %w", reporterID, err)
+	}
+
+	reports := []Report{}
+	if err := cursor.All(ctx, &reports); err != nil {
+		return []Report{}, fmt.Errorf("failed to decode reports of %v: %w", reporterID, err)
+	}
+
+	return reports, nil
+}
+
+func (r *ReviewReportRepository) DeleteUserReports(ctx context.Context, userID string) error {
+	filter := bson.M{"$or": bson.A{bson.M{"reporterID": userID}, bson.M{"userID": userID}}}
+
+	if _, err := r.coll.DeleteMany(ctx, filter); err != nil {
+		return fmt.Errorf("failed to delete reports of user %v: %w", userID, err)
+	}
+
+	return nil
+}
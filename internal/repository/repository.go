@@ -1,10 +1,180 @@
 package repository
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 //go:generate minimock -i ReviewRepository -o ./mocks/ -s "_mock.go"
 type ReviewRepository interface {
-	GetReviews(ctx context.Context, ID string) ([]Review, error)
+	// GetReviews returns at most limit reviews with one of the given statuses
+	// in sort order, those coming after the cursor or from the first one when after is nil.
+	// Soft deleted reviews are left out by every method but GetDeletedReview,
+	// RestoreReview and DeleteReview.
+	GetReviews(ctx context.Context, ID string, statuses []ReviewStatus, sort ReviewSort, after *ReviewCursor, limit int) ([]Review, error)
+	GetReview(ctx context.Context, userID, movieID string) (Review, error)
+	// GetDeletedReview returns the review only while it is soft deleted.
+	GetDeletedReview(ctx context.Context, userID, movieID string) (Review, error)
+	// ListAllReviews returns every review stored under the document with the given id
+	// in the order they were added, whatever their status, soft deleted ones included.
+	ListAllReviews(ctx context.Context, ID string) ([]Review, error)
+	// CreateReview replaces a soft deleted review of the same user and movie.
 	CreateReview(ctx context.Context, review Review) error
-	UpdateReview(ctx context.Context, review Review) error
+	// UpdateReview applies the edit only when the stored version equals review.Version,
+	// otherwise it returns ErrVersionConflict. The stored version is bumped by one.
+	// Only the given fields are written, all EditableFields when none are given.
+	UpdateReview(ctx context.Context, review Review, fields ...ReviewField) error
+	// DeleteReview removes the review for good, soft deleted or not.
+	DeleteReview(ctx context.Context, userID, movieID string) error
+	SoftDeleteReview(ctx context.Context, userID, movieID string, deletedAt time.Time) error
+	RestoreReview(ctx context.Context, userID, movieID string) error
+	// PurgeReview removes the review for good if it was soft deleted before the given time.
+	PurgeReview(ctx context.Context, userID, movieID string, before time.Time) error
+	IncrementVotes(ctx context.Context, userID, movieID string, likes, dislikes int64) error
+	SetStatus(ctx context.Context, userID, movieID string, status ReviewStatus, reason string, moderatedAt time.Time) error
+}
+
+//go:generate minimock -i ModerationRepository -o ./mocks/ -s "_mock.go"
+type ModerationRepository interface {
+	// ListReviews returns reviews of every movie with one of the given statuses, oldest first.
+	ListReviews(ctx context.Context, statuses []ReviewStatus, offset, limit int) ([]Review, error)
+	// ListDeleted returns at most limit reviews soft deleted before the given time, oldest first.
+	ListDeleted(ctx context.Context, before time.Time, limit int) ([]Review, error)
+}
+
+//go:generate minimock -i ReportRepository -o ./mocks/ -s "_mock.go"
+type ReportRepository interface {
+	// AddReport stores the report and tells whether it is new. A reporter
+	// can only report a review once, repeated reports are ignored.
+	AddReport(ctx context.Context, report Report) (bool, error)
+	// CountReports returns the number of distinct reporters of the review filed after the given time.
+	CountReports(ctx context.Context, userID, movieID string, after time.Time) (int64, error)
+	// ListReportsBy returns the reports the user filed, oldest first.
+	ListReportsBy(ctx context.Context, reporterID string) ([]Report, error)
+	// DeleteUserReports removes the reports the user filed and the reports about the user reviews.
+	DeleteUserReports(ctx context.Context, userID string) error
+}
+
+//go:generate minimock -i RatingRepository -o ./mocks/ -s "_mock.go"
+type RatingRepository interface {
+	GetRating(ctx context.Context, movieID string) (MovieRating, error)
+	// GetSummaries returns summaries of the movies that have a rating, in no particular order.
+	GetSummaries(ctx context.Context, movieIDs []string) ([]ReviewSummary, error)
+	// UpdateRating replaces oldRating with newRating in the movie aggregate.
+	// Zero value of either argument means there is no rating to remove or add.
+	UpdateRating(ctx context.Context, movieID string, oldRating, newRating int32) error
+}
+
+//go:generate minimock -i VoteRepository -o ./mocks/ -s "_mock.go"
+type VoteRepository interface {
+	// SetVote stores the vote and returns the value it replaced, zero if the voter had not voted yet.
+	SetVote(ctx context.Context, vote Vote) (int32, error)
+	// DeleteVote removes the vote and returns its value.
+	DeleteVote(ctx context.Context, voterID, userID, movieID string) (int32, error)
+	// ListVotesBy returns the votes the user left, oldest first.
+	ListVotesBy(ctx context.Context, voterID string) ([]Vote, error)
+	// DeleteUserVotes removes the votes the user left and the votes on the user reviews.
+	// Vote counters of the reviews are left as they are.
+	DeleteUserVotes(ctx context.Context, userID string) error
+	// DeleteReviewVotes removes the votes left on the review.
+	DeleteReviewVotes(ctx context.Context, userID, movieID string) error
+}
+
+//go:generate minimock -i CommentRepository -o ./mocks/ -s "_mock.go"
+type CommentRepository interface {
+	AddComment(ctx context.Context, comment Comment) (Comment, error)
+	GetComment(ctx context.Context, ID string) (Comment, error)
+	// ListComments returns top level comments of the review, oldest first.
+	ListComments(ctx context.Context, reviewUserID, movieID string, offset, limit int) ([]Comment, error)
+	// ListReplies returns replies to any of the given comments, oldest first.
+	ListReplies(ctx context.Context, parentIDs []string) ([]Comment, error)
+	UpdateComment(ctx context.Context, ID, text string, updatedAt time.Time) (Comment, error)
+	// DeleteComment removes the comment together with its replies.
+	DeleteComment(ctx context.Context, ID string) error
+	// ListCommentsBy returns comments and replies the user wrote, oldest first.
+	ListCommentsBy(ctx context.Context, authorID string) ([]Comment, error)
+	// DeleteUserComments removes the comments the user wrote with their replies
+	// and the comments on the user reviews.
+	DeleteUserComments(ctx context.Context, userID string) error
+	// DeleteReviewComments removes the comments and replies left on the review.
+	DeleteReviewComments(ctx context.Context, reviewUserID, movieID string) error
+}
+
+//go:generate minimock -i BookmarkRepository -o ./mocks/ -s "_mock.go"
+type BookmarkRepository interface {
+	// AddBookmark returns ErrAlreadyExists when the movie is bookmarked already.
+	AddBookmark(ctx context.Context, bookmark Bookmark) error
+	RemoveBookmark(ctx context.Context, userID, movieID string) error
+	// ListBookmarks returns bookmarks of the user, newest first.
+	ListBookmarks(ctx context.Context, userID string, offset, limit int) ([]Bookmark, error)
+	DeleteUserBookmarks(ctx context.Context, userID string) error
+}
+
+//go:generate minimock -i SearchRepository -o ./mocks/ -s "_mock.go"
+type SearchRepository interface {
+	// IndexReview adds the review to the search index or replaces the indexed copy.
+	IndexReview(ctx context.Context, review Review) error
+	RemoveReview(ctx context.Context, userID, movieID string) error
+	// Search returns visible reviews matching the query, most relevant first.
+	// Empty userID or movieID does not restrict the search.
+	Search(ctx context.Context, query, userID, movieID string, offset, limit int) ([]SearchHit, error)
+}
+
+//go:generate minimock -i RevisionRepository -o ./mocks/ -s "_mock.go"
+type RevisionRepository interface {
+	// AddRevision appends the revision to the history of the review, dropping
+	// the oldest revisions above the configured cap.
+	AddRevision(ctx context.Context, revision Revision) error
+	// ListRevisions returns revisions of the review, newest first.
+	ListRevisions(ctx context.Context, userID, movieID string, offset, limit int) ([]Revision, error)
+	DeleteRevisions(ctx context.Context, userID, movieID string) error
+}
+
+//go:generate minimock -i AnalyticsRepository -o ./mocks/ -s "_mock.go"
+type AnalyticsRepository interface {
+	// ListUserEvents returns the analytics events of the user reviews, oldest first.
+	ListUserEvents(ctx context.Context, userID string) ([]AnalyticsEvent, error)
+	// DeleteUserEvents schedules removal of the analytics rows of the user from every
+	// table. Clickhouse applies the deletion in the background.
+	DeleteUserEvents(ctx context.Context, userID string) error
+}
+
+//go:generate minimock -i ErasureRepository -o ./mocks/ -s "_mock.go"
+type ErasureRepository interface {
+	// StartErasure returns the unfinished erasure of the user, starting a new one if there is none.
+	StartErasure(ctx context.Context, userID string, startedAt time.Time) (Erasure, error)
+	// AddMovies records movies the erased reviews belonged to.
+	AddMovies(ctx context.Context, userID string, movieIDs []string) error
+	CompleteStep(ctx context.Context, userID string, step ErasureStep) error
+	// FinishErasure drops the progress of the erasure once every step is done.
+	FinishErasure(ctx context.Context, userID string) error
+	// ListErasures returns unfinished erasures, oldest first.
+	ListErasures(ctx context.Context) ([]Erasure, error)
+}
+
+//go:generate minimock -i OutboxRepository -o ./mocks/ -s "_mock.go"
+type OutboxRepository interface {
+	// AddEvents stores the events, it is run within the transaction of the change they are about.
+	AddEvents(ctx context.Context, events []OutboxEvent) error
+	// ClaimEvents locks up to limit unsent events until lockedUntil, oldest first,
+	// so other relays skip them, and returns them.
+	ClaimEvents(ctx context.Context, limit int, now, lockedUntil time.Time) ([]OutboxEvent, error)
+	MarkSent(ctx context.Context, IDs []string, sentAt time.Time) error
+}
+
+//go:generate minimock -i ProgressRepository -o ./mocks/ -s "_mock.go"
+type ProgressRepository interface {
+	// SaveProgress stores the positions, an entry older than the stored one is skipped.
+	SaveProgress(ctx context.Context, progress []Progress) error
+	GetProgress(ctx context.Context, userID, movieID string) (Progress, error)
+	// ListProgress returns every stored position of the user, latest first.
+	ListProgress(ctx context.Context, userID string) ([]Progress, error)
+	DeleteUserProgress(ctx context.Context, userID string) error
+}
+
+//go:generate minimock -i ReviewWatcher -o ./mocks/ -s "_mock.go"
+type ReviewWatcher interface {
+	// Watch calls fn for every change of the movie reviews until ctx is done or fn
+	// returns an error. A non-empty resumeToken continues right after that event.
+	Watch(ctx context.Context, movieID, resumeToken string, fn func(ReviewEvent) error) error
 }
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// ReviewRevisionRepository stores one document per revision. Documents are never
+// changed, only the ones above maxRevisions per review are dropped.
+type ReviewRevisionRepository struct {
+	coll         *mongo.Collection
+	maxRevisions int
+}
+
+// NewReviewRevisionRepository keeps at most maxRevisions revisions of each review,
+// zero keeps all of them.
+func NewReviewRevisionRepository(c *mongo.Collection, maxRevisions int) RevisionRepository {
+	return &ReviewRevisionRepository{
+		coll:         c,
+		maxRevisions: maxRevisions,
+	}
+}
+
+// CreateRevisionIndexes creates the index used to list and trim revisions of a review.
+func CreateRevisionIndexes(ctx context.Context, c *mongo.Collection) error {
+	_, err := c.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "movieID", Value: 1}, {Key: "userID", Value: 1}, {Key: "version", Value: -1}},
+	})
+	return err
+}
+
+func (r *ReviewRevisionRepository) AddRevision(ctx context.Context, revision Revision) error {
+	if _, err := r.coll.InsertOne(ctx, revision); err != nil {
+		return fmt.Errorf("failed to add revision %v: %w", revision, err)
+	}
+
+	if r.maxRevisions <= 0 {
+		return nil
+	}
+
+	filter := bson.M{"movieID": revision.MovieID, "userID": revision.UserID}
+
+	// the newest revision past the cap, it and everything older goes
+	var oldest struct {
+		Version int64 `bson:"version"`
+	}
+	opts := options.FindOne().
+		SetSort(bson.D{{Key: "version", Value: -1}}).
+		SetSkip(int64(r.maxRevisions)).
+		SetProjection(bson.M{"_id": 0, "version": 1})
+
+	err := r.coll.FindOne(ctx, filter, opts).Decode(&oldest)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to find revisions of user %v review for movie %v: %w", revision.UserID, revision.MovieID, err)
+	}
+
+	filter["version"] = bson.M{"$lte": oldest.Version}
+
+	if _, err := r.coll.DeleteMany(ctx, filter); err != nil {
+		return fmt.Errorf("failed to trim revisions of user %v review for movie %v: %w", revision.UserID, revision.MovieID, err)
+	}
+
+	return nil
+}
+
+func (r *ReviewRevisionRepository) ListRevisions(ctx context.Context, userID, movieID string, offset, limit int) ([]Revision, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "version", Value: -1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit)).
+		SetProjection(bson.M{"_id": 0})
+
+	cursor, err := r.coll.Find(ctx, bson.M{"movieID": movieID, "userID": userID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions of user %v review for movie %v: %w", userID, movieID, err)
+	}
+
+	revisions := []Revision{}
+	if err := cursor.All(ctx, &revisions); err != nil {
+		return nil, fmt.Errorf("failed to decode revisions of user %v review for movie %v: %w", userID, movieID, err)
+	}
+
+	return revisions, nil
+}
+
+func (r *ReviewRevisionRepository) DeleteRevisions(ctx context.Context, userID, movieID string) error {
+	if _, err := r.coll.DeleteMany(ctx, bson.M{"movieID": movieID, "userID": userID}); err != nil {
+		return fmt.Errorf("failed to delete revisions of user %v review for movie %v: %w", userID, movieID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// ReviewSearchRepository keeps a flat copy of every review, one document per
+// review, so it can be covered by a text index. Embedded review arrays cannot:
+// a text index on them scores whole user or movie documents, not single reviews.
+type ReviewSearchRepository struct {
+	coll *mongo.Collection
+}
+
+func NewReviewSearchRepository(c *mongo.Collection) SearchRepository {
+	return &ReviewSearchRepository{
+		coll: c,
+	}
+}
+
+// CreateSearchIndexes creates the text index over review texts.
+func CreateSearchIndexes(ctx context.Context, c *mongo.Collection) error {
+	_, err := c.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "text", Value: "text"}},
+	})
+	return err
+}
+
+// BackfillSearch copies the live reviews of the movies collection into the search
+// collection. Reviews already indexed are kept as they are, so it is safe to run while
+// the service writes and to run more than once.
+func BackfillSearch(ctx context.Context, movies, search *mongo.Collection) error {
+	pipeline := mongo.Pipeline{
+		{{Key: "$unwind", Value: "$reviews"}},
+		{{Key: "$match", Value: bson.M{"reviews.deletedAt": nil}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":              bson.M{"$concat": bson.A{"$_id", ":", "$reviews.userID"}},
+			"userID":           "$reviews.userID",
+			"movieID":          "$_id",
+			"text":             "$reviews.text",
+			"rating":           "$reviews.rating",
+			"createdAt":        "$reviews.createdAt",
+			"updatedAt":        "$reviews.updatedAt",
+			"status":           "$reviews.status",
+			"moderationReason": "$reviews.moderationReason",
+			"version":          "$reviews.version",
+		}}},
+		{{Key: "$merge", Value: bson.M{
+			"into":           search.Name(),
+			"on":             "_id",
+			"whenMatched":    "keepExisting",
+			"whenNotMatched": "insert",
+		}}},
+	}
+
+	cursor, err := movies.Aggregate(ctx, pipeline)
+	if err != nil {
+		return fmt.Errorf("failed to backfill search index: %w", err)
+	}
+
+	return cursor.Close(ctx)
+}
+
+func searchID(userID, movieID string) string {
+	return movieID + ":" + userID
+}
+
+func (r *ReviewSearchRepository) IndexReview(ctx context.Context, review Review) error {
+	filter := bson.M{"_id": searchID(review.UserID, review.MovieID)}
+	update := bson.M{
+		"$set": bson.M{
+			"userID":           review.UserID,
+			"movieID":          review.MovieID,
+			"text":             review.Text,
+			"rating":           review.Rating,
+			"updatedAt":        review.UpdatedAt,
+			"status":           review.Status,
+			"moderationReason": review.ModerationReason,
+			"version":          review.Version,
+		},
+		"$setOnInsert": bson.M{"createdAt": review.CreatedAt},
+	}
+	opts := options.UpdateOne().SetUpsert(true)
+
+	if _, err := r.coll.UpdateOne(ctx, filter, update, opts); err != nil {
+		return fmt.Errorf("failed to index review of user %v for movie %v: %w", review.UserID, review.MovieID, err)
+	}
+
+	return nil
+}
+
+func (r *ReviewSearchRepository) RemoveReview(ctx context.Context, userID, movieID string) error {
+	if _, err := r.coll.DeleteOne(ctx, bson.M{"_id": searchID(userID, movieID)}); err != nil {
+		return fmt.Errorf("failed to remove review of user %v for movie %v from index: %w", userID, movieID, err)
+	}
+
+	return nil
+}
+
+func (r *ReviewSearchRepository) Search(ctx context.Context, query, userID, movieID string, offset, limit int) ([]SearchHit, error) {
+	filter := statusFilter([]ReviewStatus{StatusApproved})
+	filter["$text"] = bson.M{"$search": query}
+
+	if userID != "" {
+		filter["userID"] = userID
+	}
+	if movieID != "" {
+		filter["movieID"] = movieID
+	}
+
+	score := bson.M{"$meta": "textScore"}
+	opts := options.Find().
+		SetProjection(bson.M{"_id": 0, "score": score}).
+		SetSort(bson.D{{Key: "score", Value: score}, {Key: "_id", Value: 1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+
+	cursor, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return []SearchHit{}, fmt.Errorf("failed to search reviews for %q: %w", query, err)
+	}
+
+	hits := []SearchHit{}
+	if err := cursor.All(ctx, &hits); err != nil {
+		return []SearchHit{}, fmt.Errorf("failed to decode search results for %q: %w", query, err)
+	}
+
+	return hits, nil
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// liveReview matches the embedded review whose key field equals value and which is not soft deleted.
+func liveReview(key, value string) bson.M {
+	return bson.M{"$elemMatch": bson.M{key: value, "deletedAt": nil}}
+}
+
+// deletedReview matches the embedded review whose key field equals value while it is soft deleted.
+func deletedReview(key, value string) bson.M {
+	return bson.M{"$elemMatch": bson.M{key: value, "deletedAt": bson.M{"$ne": nil}}}
+}
+
+// findReview returns the first embedded review of the document ID matching the element filter.
+func findReview(ctx context.Context, coll *mongo.Collection, ID string, elem bson.M) (Review, error) {
+	var result struct {
+		Reviews []Review `bson:"reviews"`
+	}
+
+	opts := options.FindOne().SetProjection(bson.M{"_id": 0, "reviews.$": 1})
+	err := coll.FindOne(ctx, bson.M{"_id": ID, "reviews": elem}, opts).Decode(&result)
+
+	if errors.Is(err, mongo.ErrNoDocuments) || (err == nil && len(result.Reviews) == 0) {
+		return Review{}, ErrNotFound
+	} else if err != nil {
+		return Review{}, err
+	}
+
+	return result.Reviews[0], nil
+}
+
+// setDeleted marks the live review deleted at deletedAt, or restores the deleted one when deletedAt is nil.
+func setDeleted(ctx context.Context, coll *mongo.Collection, ID, key, value string, deletedAt *time.Time) error {
+	var (
+		elem   = liveReview(key, value)
+		update = bson.M{"$set": bson.M{"reviews.$.deletedAt": deletedAt}}
+	)
+
+	if deletedAt == nil {
+		elem = deletedReview(key, value)
+		update = bson.M{"$unset": bson.M{"reviews.$.deletedAt": ""}}
+	}
+
+	result, err := coll.UpdateOne(ctx, bson.M{"_id": ID, "reviews": elem}, update)
+
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// dropDeleted removes the soft deleted review, so a new one can take its place.
+func dropDeleted(ctx context.Context, coll *mongo.Collection, ID, key, value string) error {
+	_, err := coll.UpdateOne(ctx, bson.M{"_id": ID}, bson.M{
+		"$pull": bson.M{"reviews": bson.M{key: value, "deletedAt": bson.M{"$ne": nil}}},
+	})
+	return err
+}
+
+// purgeDeleted removes the review only while it is soft deleted since before the given time,
+// so a review restored or written anew in the meantime stays.
+func purgeDeleted(ctx context.Context, coll *mongo.Collection, ID, key, value string, before time.Time) error {
+	_, err := coll.UpdateOne(ctx, bson.M{"_id": ID}, bson.M{
+		"$pull": bson.M{"reviews": bson.M{key: value, "deletedAt": bson.M{"$lt": before}}},
+	})
+	return err
+}
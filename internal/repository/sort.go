@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// sortStage returns the $sort stage for the embedded reviews. tieBreaker is the
+// id field of the other side of the review, it keeps pages stable between requests.
+func sortStage(sort ReviewSort, tieBreaker string) bson.D {
+	var keys bson.D
+
+	switch sort {
+	case SortNewest:
+		keys = bson.D{{Key: "createdAt", Value: -1}}
+	case SortOldest:
+		keys = bson.D{{Key: "createdAt", Value: 1}}
+	case SortMostHelpful:
+		// helpfulness is measured by the votes other users left on the review
+		keys = bson.D{{Key: "likes", Value: -1}, {Key: "dislikes", Value: 1}, {Key: "createdAt", Value: -1}}
+	case SortHighestRated:
+		keys = bson.D{{Key: "rating", Value: -1}, {Key: "createdAt", Value: -1}}
+	}
+
+	return append(keys, bson.E{Key: tieBreaker, Value: 1})
+}
+
+// statusFilter matches reviews with any of the given statuses. Reviews without
+// a status predate moderation and are matched as approved.
+func statusFilter(statuses []ReviewStatus) bson.M {
+	return bson.M{"status": bson.M{"$in": statusValues(statuses)}}
+}
+
+func statusValues(statuses []ReviewStatus) bson.A {
+	values := bson.A{}
+	for _, status := range statuses {
+		values = append(values, status)
+		if status == StatusApproved {
+			values = append(values, nil, "")
+		}
+	}
+	return values
+}
+
+// afterCursor is the condition on $$review of the reviews coming after the cursor
+// in sort order: the first sort key the review differs from the cursor on decides.
+func afterCursor(sort ReviewSort, tieBreaker string, after *ReviewCursor) bson.M {
+	values := map[string]any{
+		"createdAt": after.CreatedAt,
+		"likes":     after.Likes,
+		"dislikes":  after.Dislikes,
+		"rating":    after.Rating,
+		tieBreaker:  cursorID(after, tieBreaker),
+	}
+
+	var conditions, equal bson.A
+
+	for _, key := range sortStage(sort, tieBreaker) {
+		field := "$$review." + key.Key
+		// ids are user input, one starting with $ must not be read as a field path
+		value := bson.M{"$literal": values[key.Key]}
+
+		op := "$gt"
+		if key.Value == -1 {
+			op = "$lt"
+		}
+
+		conditions = append(conditions, bson.M{"$and": append(slices.Clone(equal), bson.M{op: bson.A{field, value}})})
+		equal = append(equal, bson.M{"$eq": bson.A{field, value}})
+	}
+
+	return bson.M{"$or": conditions}
+}
+
+// cursorID is the id of the cursor review on the side named by tieBreaker.
+func cursorID(after *ReviewCursor, tieBreaker string) string {
+	if tieBreaker == "userID" {
+		return after.UserID
+	}
+	return after.MovieID
+}
+
+// listAllReviews returns the embedded reviews of the document with the given id as they are
+// stored, an empty list if there is no such document.
+func listAllReviews(ctx context.Context, coll *mongo.Collection, ID string) ([]Review, error) {
+	var result struct {
+		Reviews []Review `bson:"reviews"`
+	}
+
+	opts := options.FindOne().SetProjection(bson.M{"_id": 0, "reviews": 1})
+	err := coll.FindOne(ctx, bson.M{"_id": ID}, opts).Decode(&result)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return []Review{}, nil
+	} else if err != nil {
+		return []Review{}, err
+	}
+
+	if result.Reviews == nil {
+		return []Review{}, nil
+	}
+
+	return result.Reviews, nil
+}
+
+// getReviews returns one page of the embedded reviews of the document with the given id
+// with the given statuses, leaving soft deleted ones out. The page is cut from the array
+// in a single projection, reviews are never unwound into documents of their own.
+// SortDefault keeps the order in which the reviews were added, its pages start after
+// the position of the cursor review, from the first one again if it was removed for good.
+func getReviews(ctx context.Context, coll *mongo.Collection, ID, tieBreaker string, statuses []ReviewStatus, sort ReviewSort, after *ReviewCursor, limit int) ([]Review, error) {
+	var reviews any = bson.M{"$ifNull": bson.A{"$reviews", bson.A{}}}
+
+	if sort == SortDefault && after != nil {
+		ids := bson.M{"$ifNull": bson.A{"$reviews." + tieBreaker, bson.A{}}}
+		start := bson.M{"$add": bson.A{bson.M{"$indexOfArray": bson.A{ids, bson.M{"$literal": cursorID(after, tieBreaker)}}}, 1}}
+		reviews = bson.M{"$slice": bson.A{reviews, start, bson.M{"$max": bson.A{bson.M{"$size": reviews}, 1}}}}
+	}
+
+	visible := bson.M{"$filter": bson.M{"input": reviews, "as": "review", "cond": bson.M{"$and": bson.A{
+		bson.M{"$in": bson.A{bson.M{"$ifNull": bson.A{"$$review.status", ""}}, statusValues(statuses)}},
+		bson.M{"$eq": bson.A{bson.M{"$ifNull": bson.A{"$$review.deletedAt", nil}}, nil}},
+	}}}}
+
+	page := visible
+
+	if sort != SortDefault {
+		// reviews stored before votes were counted have no counters, they have no votes. Older
+		// ones have no timestamp or rating either, they get the zero values the cursor of a
+		// page ending on them holds, otherwise they would sort before every cursor value
+		counted := bson.M{"$map": bson.M{"input": visible, "as": "review", "in": bson.M{"$mergeObjects": bson.A{"$$review", bson.M{
+			"likes":     bson.M{"$ifNull": bson.A{"$$review.likes", 0}},
+			"dislikes":  bson.M{"$ifNull": bson.A{"$$review.dislikes", 0}},
+			"createdAt": bson.M{"$ifNull": bson.A{"$$review.createdAt", time.Time{}}},
+			"rating":    bson.M{"$ifNull": bson.A{"$$review.rating", 0}},
+		}}}}}
+
+		if after != nil {
+			counted = bson.M{"$filter": bson.M{"input": counted, "as": "review", "cond": afterCursor(sort, tieBreaker, after)}}
+		}
+
+		page = bson.M{"$sortArray": bson.M{"input": counted, "sortBy": sortStage(sort, tieBreaker)}}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"_id": ID}}},
+		{{Key: "$project", Value: bson.M{"_id": 0, "reviews": bson.M{"$slice": bson.A{page, limit}}}}},
+	}
+
+	cursor, err := coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return []Review{}, fmt.Errorf("failed to aggregate reviews for %v: %w", ID, err)
+	}
+
+	var result []struct {
+		Reviews []Review `bson:"reviews"`
+	}
+	if err := cursor.All(ctx, &result); err != nil {
+		return []Review{}, fmt.Errorf("failed to decode reviews for %v: %w", ID, err)
+	}
+
+	if len(result) == 0 {
+		return []Review{}, ErrNotFound
+	}
+
+	if result[0].Reviews == nil {
+		return []Review{}, nil
+	}
+
+	return result[0].Reviews, nil
+}
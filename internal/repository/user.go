@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
@@ -20,23 +21,54 @@ func NewUserReviewRepository(c *mongo.Collection) ReviewRepository {
 	}
 }
 
-func (r *UserReviewRepository) GetReviews(ctx context.Context, ID string) ([]Review, error) {
-	var result map[string][]Review
+func (r *UserReviewRepository) GetReviews(ctx context.Context, ID string, statuses []ReviewStatus, sort ReviewSort, after *ReviewCursor, limit int) ([]Review, error) {
+	return getReviews(ctx, r.coll, ID, "movieID", statuses, sort, after, limit)
+}
+
+func (r *UserReviewRepository) GetReview(ctx context.Context, userID, movieID string) (Review, error) {
+	review, err := findReview(ctx, r.coll, userID, liveReview("movieID", movieID))
+
+	if errors.Is(err, ErrNotFound) {
+		return Review{}, ErrNotFound
+	} else if err != nil {
+		return Review{}, fmt.Errorf("failed to find review of user %v for movie %v: %w", userID, movieID, err)
+	}
+
+	review.UserID, review.MovieID = userID, movieID
+
+	return review, nil
+}
 
-	filter := bson.M{"_id": ID}
-	opts := options.FindOne().SetProjection(bson.M{"_id": 0, "reviews": 1})
-	err := r.coll.FindOne(ctx, filter, opts).Decode(&result)
+func (r *UserReviewRepository) GetDeletedReview(ctx context.Context, userID, movieID string) (Review, error) {
+	review, err := findReview(ctx, r.coll, userID, deletedReview("movieID", movieID))
 
-	if errors.Is(err, mongo.ErrNoDocuments) {
-		return []Review{}, ErrNotFound
+	if errors.Is(err, ErrNotFound) {
+		return Review{}, ErrNotFound
 	} else if err != nil {
-		return []Review{}, fmt.Errorf("failed to find review for userID %v: %w", ID, err)
+		return Review{}, fmt.Errorf("failed to find deleted review of user %v for movie %v: %w", userID, movieID, err)
+	}
+
+	review.UserID, review.MovieID = userID, movieID
+
+	return review, nil
+}
+
+func (r *UserReviewRepository) ListAllReviews(ctx context.Context, ID string) ([]Review, error) {
+	reviews, err := listAllReviews(ctx, r.coll, ID)
+
+	if err != nil {
+		return []Review{}, fmt.Errorf("failed to list all reviews of user %v: %w", ID, err)
+	}
+
+	for i := range reviews {
+		reviews[i].UserID = ID
 	}
-	return result["reviews"], nil
+
+	return reviews, nil
 }
 
 func (r *UserReviewRepository) CreateReview(ctx context.Context, review Review) error {
-	filter := bson.M{"_id": review.UserID, "reviews.movieID": review.MovieID}
+	filter := bson.M{"_id": review.UserID, "reviews": liveReview("movieID", review.MovieID)}
 	mResult := r.coll.FindOne(ctx, filter)
 
 	if err := mResult.Err(); err == nil {
@@ -44,13 +76,26 @@ func (r *UserReviewRepository) CreateReview(ctx context.Context, review Review)
 		return ErrAlreadyExists
 	}
 
+	// a soft deleted review of the same pair gives way to the new one
+	if err := dropDeleted(ctx, r.coll, review.UserID, "movieID", review.MovieID); err != nil {
+		return fmt.Errorf("failed to drop deleted review %v: %w", review, err)
+	}
+
 	opts := options.UpdateOne().SetUpsert(true)
 
 	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": review.UserID}, bson.M{
 		"$push": map[string]interface{}{
-			"reviews": map[string]string{
-				"movieID": review.MovieID,
-				"text":    review.Text,
+			"reviews": bson.M{
+				"movieID":          review.MovieID,
+				"text":             review.Text,
+				"rating":           review.Rating,
+				"createdAt":        review.CreatedAt,
+				"updatedAt":        review.UpdatedAt,
+				"likes":            review.Likes,
+				"dislikes":         review.Dislikes,
+				"status":           review.Status,
+				"moderationReason": review.ModerationReason,
+				"version":          review.Version,
 			},
 		},
 	},
@@ -64,20 +109,96 @@ func (r *UserReviewRepository) CreateReview(ctx context.Context, review Review)
 	return nil
 }
 
-func (r *UserReviewRepository) UpdateReview(ctx context.Context, review Review) error {
-	filter := bson.M{"_id": review.UserID, "reviews.movieID": review.MovieID}
+func (r *UserReviewRepository) UpdateReview(ctx context.Context, review Review, fields ...ReviewField) error {
+	return updateReview(ctx, r.coll, review.UserID, "movieID", review.MovieID, review, fields)
+}
+
+func (r *UserReviewRepository) DeleteReview(ctx context.Context, userID, movieID string) error {
+	filter := bson.M{"_id": userID, "reviews.movieID": movieID}
+
+	result, err := r.coll.UpdateOne(ctx, filter, bson.M{
+		"$pull": bson.M{
+			"reviews": bson.M{"movieID": movieID},
+		},
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to delete review of user %v for movie %v: %w", userID, movieID, err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *UserReviewRepository) IncrementVotes(ctx context.Context, userID, movieID string, likes, dislikes int64) error {
+	filter := bson.M{"_id": userID, "reviews": liveReview("movieID", movieID)}
+
+	result, err := r.coll.UpdateOne(ctx, filter, bson.M{
+		"$inc": bson.M{
+			"reviews.$.likes":    likes,
+			"reviews.$.dislikes": dislikes,
+		},
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to update votes of user %v review for movie %v: %w", userID, movieID, err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *UserReviewRepository) SetStatus(ctx context.Context, userID, movieID string, status ReviewStatus, reason string, moderatedAt time.Time) error {
+	filter := bson.M{"_id": userID, "reviews": liveReview("movieID", movieID)}
 
-	userUpdResult := r.coll.FindOneAndUpdate(ctx, filter, bson.M{
-		"$set": map[string]string{
-			"reviews.$.text": review.Text,
+	result, err := r.coll.UpdateOne(ctx, filter, bson.M{
+		"$set": bson.M{
+			"reviews.$.status":           status,
+			"reviews.$.moderationReason": reason,
+			"reviews.$.moderatedAt":      moderatedAt,
 		},
 	})
 
-	if err := userUpdResult.Err(); err != nil {
-		if errors.Is(err, mongo.ErrNoDocuments) {
-			return ErrNotFound
-		}
-		return fmt.Errorf("failed to update %v: %w", review, err)
+	if err != nil {
+		return fmt.Errorf("failed to set status of user %v review for movie %v: %w", userID, movieID, err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *UserReviewRepository) SoftDeleteReview(ctx context.Context, userID, movieID string, deletedAt time.Time) error {
+	err := setDeleted(ctx, r.coll, userID, "movieID", movieID, &deletedAt)
+
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("failed to soft delete review of user %v for movie %v: %w", userID, movieID, err)
+	}
+
+	return err
+}
+
+func (r *UserReviewRepository) RestoreReview(ctx context.Context, userID, movieID string) error {
+	err := setDeleted(ctx, r.coll, userID, "movieID", movieID, nil)
+
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("failed to restore review of user %v for movie %v: %w", userID, movieID, err)
+	}
+
+	return err
+}
+
+func (r *UserReviewRepository) PurgeReview(ctx context.Context, userID, movieID string, before time.Time) error {
+	if err := purgeDeleted(ctx, r.coll, userID, "movieID", movieID, before); err != nil {
+		return fmt.Errorf("failed to purge review of user %v for movie %v: %w", userID, movieID, err)
 	}
 
 	return nil
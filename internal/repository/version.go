@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// versionFilter matches the stored version of a review. Reviews written before
+// versioning have no version field and count as version 0.
+func versionFilter(version int64) any {
+	if version == 0 {
+		return bson.M{"$in": bson.A{0, nil}}
+	}
+	return version
+}
+
+// updateReview applies the edit to the review whose key field equals value in the document ID,
+// only when its stored version equals review.Version, and bumps the version. The version is
+// checked in the filter, so a concurrent edit can't slip in between the check and the write.
+// Only the given fields are set besides the ones the service derives from the edit.
+func updateReview(ctx context.Context, coll *mongo.Collection, ID, key, value string, review Review, fields []ReviewField) error {
+	filter := bson.M{
+		"_id":     ID,
+		"reviews": bson.M{"$elemMatch": bson.M{key: value, "version": versionFilter(review.Version), "deletedAt": nil}},
+	}
+
+	set := bson.M{
+		"reviews.$.updatedAt":        review.UpdatedAt,
+		"reviews.$.status":           review.Status,
+		"reviews.$.moderationReason": review.ModerationReason,
+	}
+
+	if len(fields) == 0 {
+		fields = EditableFields
+	}
+	for _, field := range fields {
+		switch field {
+		case FieldText:
+			set["reviews.$.text"] = review.Text
+		case FieldRating:
+			set["reviews.$.rating"] = review.Rating
+		default:
+			return fmt.Errorf("field %q of the review can't be updated", field)
+		}
+	}
+
+	result, err := coll.UpdateOne(ctx, filter, bson.M{
+		"$set": set,
+		"$inc": bson.M{"reviews.$.version": 1},
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to update %v: %w", review, err)
+	}
+
+	if result.MatchedCount > 0 {
+		return nil
+	}
+
+	err = coll.FindOne(ctx, bson.M{"_id": ID, "reviews": liveReview(key, value)}).Err()
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return ErrNotFound
+	} else if err != nil {
+		return fmt.Errorf("failed to update %v: %w", review, err)
+	}
+
+	return ErrVersionConflict
+}
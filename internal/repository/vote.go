@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// ReviewVoteRepository stores one document per voter and review. The _id is built
+// from all three ids, so a voter can never have two votes on the same review.
+type ReviewVoteRepository struct {
+	coll *mongo.Collection
+}
+
+func NewReviewVoteRepository(c *mongo.Collection) VoteRepository {
+	return &ReviewVoteRepository{
+		coll: c,
+	}
+}
+
+// CreateVoteIndexes creates the indexes used to list votes of a user
+// and to find the votes on a review.
+func CreateVoteIndexes(ctx context.Context, c *mongo.Collection) error {
+	_, err := c.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "voterID", Value: 1}, {Key: "createdAt", Value: 1}}},
+		{Keys: bson.D{{Key: "movieID", Value: 1}, {Key: "userID", Value: 1}}},
+	})
+	return err
+}
+
+func voteID(voterID, userID, movieID string) string {
+	return movieID + ":" + userID + ":" + voterID
+}
+
+func (r *ReviewVoteRepository) SetVote(ctx context.Context, vote Vote) (int32, error) {
+	var previous Vote
+
+	filter := bson.M{"_id": voteID(vote.VoterID, vote.UserID, vote.MovieID)}
+	update := bson.M{
+		"$set": bson.M{"value": vote.Value},
+		"$setOnInsert": bson.M{
+			"voterID":   vote.VoterID,
+			"userID":    vote.UserID,
+			"movieID":   vote.MovieID,
+			"createdAt": vote.CreatedAt,
+		},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.Before)
+
+	err := r.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&previous)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, nil
+	} else if err != nil {
+		return 0, fmt.Errorf("failed to set vote %v: %w", vote, err)
+	}
+
+	return previous.Value, nil
+}
+
+func (r *ReviewVoteRepository) DeleteVote(ctx context.Context, voterID, userID, movieID string) (int32, error) {
+	var deleted Vote
+
+	filter := bson.M{"_id": voteID(voterID, userID, movieID)}
+	err := r.coll.FindOneAndDelete(ctx, filter).Decode(&deleted)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, ErrNotFound
+	} else if err != nil {
+		return 0, fmt.Errorf("failed to delete vote of %v for user %v review on movie %v: %w", voterID, userID, movieID, err)
+	}
+
+	return deleted.Value, nil
+}
+
+func (r *ReviewVoteRepository) ListVotesBy(ctx context.Context, voterID string) ([]Vote, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}})
+
+	cursor, err := r.coll.Find(ctx, bson.M{"voterID": voterID}, opts)
+	if err != nil {
+		return []Vote{}, fmt.Errorf("failed to find votes of %v: %w", voterID, err)
+	}
+
+	votes := []Vote{}
+	if err := cursor.All(ctx, &votes); err != nil {
+		return []Vote{}, fmt.Errorf("failed to decode votes of %v: %w", voterID, err)
+	}
+
+	return votes, nil
+}
+
+func (r *ReviewVoteRepository) DeleteUserVotes(ctx context.Context, userID string) error {
+	filter := bson.M{"$or": bson.A{bson.M{"voterID": userID}, bson.M{"userID": userID}}}
+
+	if _, err := r.coll.DeleteMany(ctx, filter); err != nil {
+		return fmt.Errorf("failed to delete votes of user %v: %w", userID, err)
+	}
+
+	return nil
+}
+
+func (r *ReviewVoteRepository) DeleteReviewVotes(ctx context.Context, userID, movieID string) error {
+	if _, err := r.coll.DeleteMany(ctx, bson.M{"movieID": movieID, "userID": userID}); err != nil {
+		return fmt.Errorf("failed to delete votes on user %v review for movie %v: %w", userID, movieID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// ErrInvalidResumeToken is returned when the change stream can not be resumed from the token.
+var ErrInvalidResumeToken = errors.New("invalid resume token")
+
+// ReviewChangeWatcher follows the change stream of the search collection. It holds
+// one document per review, so every change maps to a single review, and it is written
+// in the same transaction as the review collections.
+type ReviewChangeWatcher struct {
+	coll *mongo.Collection
+}
+
+func NewReviewChangeWatcher(c *mongo.Collection) ReviewWatcher {
+	return &ReviewChangeWatcher{
+		coll: c,
+	}
+}
+
+type changeEvent struct {
+	ID            bson.Raw `bson:"_id"`
+	OperationType string   `bson:"operationType"`
+	FullDocument  *Review  `bson:"fullDocument"`
+	DocumentKey   struct {
+		ID string `bson:"_id"`
+	} `bson:"documentKey"`
+}
+
+func (w *ReviewChangeWatcher) Watch(ctx context.Context, movieID, resumeToken string, fn func(ReviewEvent) error) error {
+	prefix := searchID("", movieID)
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"operationType": bson.M{"$in": bson.A{"insert", "update", "replace", "delete"}},
+			// deleted documents have no full document, only the key
+			"documentKey._id": bson.M{"$regex": "^" + regexp.QuoteMeta(prefix)},
+		}}},
+	}
+
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+	if resumeToken != "" {
+		opts.SetResumeAfter(bson.M{"_data": resumeToken})
+	}
+
+	stream, err := w.coll.Watch(ctx, pipeline, opts)
+	if err != nil {
+		var cmdErr mongo.CommandError
+		if resumeToken != "" && errors.As(err, &cmdErr) {
+			return ErrInvalidResumeToken
+		}
+		return fmt.Errorf("failed to watch reviews of movie %v: %w", movieID, err)
+	}
+	defer stream.Close(context.WithoutCancel(ctx))
+
+	for stream.Next(ctx) {
+		var change changeEvent
+		if err := stream.Decode(&change); err != nil {
+			return fmt.Errorf("failed to decode change of movie %v reviews: %w", movieID, err)
+		}
+
+		token, _ := change.ID.Lookup("_data").StringValueOK()
+		event := ReviewEvent{ResumeToken: token}
+
+		switch change.OperationType {
+		case "insert":
+			event.Type = ReviewCreated
+		case "delete":
+			event.Type = ReviewDeleted
+		default:
+			event.Type = ReviewUpdated
+		}
+
+		if change.FullDocument != nil {
+			event.Review = *change.FullDocument
+		} else {
+			// the document was deleted, possibly before the update could be looked up
+			event.Type = ReviewDeleted
+			event.Review = Review{MovieID: movieID, UserID: change.DocumentKey.ID[len(prefix):]}
+		}
+
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+
+	if err := stream.Err(); err != nil && ctx.Err() == nil {
+		return fmt.Errorf("change stream of movie %v reviews failed: %w", movieID, err)
+	}
+
+	return nil
+}
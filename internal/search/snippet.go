@@ -0,0 +1,124 @@
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+const (
+	highlightOpen  = "<em>"
+	highlightClose = "</em>"
+	ellipsis       = "…"
+)
+
+type span struct {
+	start, end int
+	match      bool
+}
+
+// Snippet cuts about width characters of text around the first word matching
+// the query and highlights every matching word in it. A word matches when it
+// starts with one of the query terms, which roughly follows the stemming of
+// the text index ("run" matches "running"). Words cut by the window are dropped.
+// The snippet is HTML: the text is escaped, only the highlight tags are markup.
+func Snippet(text, query string, width int) string {
+	runes := []rune(text)
+
+	queryRunes := []rune(strings.ToLower(query))
+	var terms []string
+	for _, sp := range words(queryRunes) {
+		terms = append(terms, string(queryRunes[sp.start:sp.end]))
+	}
+
+	spans := words(runes)
+	first := -1
+
+	for i := range spans {
+		word := strings.ToLower(string(runes[spans[i].start:spans[i].end]))
+		for _, t := range terms {
+			if strings.HasPrefix(word, t) {
+				spans[i].match = true
+				break
+			}
+		}
+		if spans[i].match && first == -1 {
+			first = i
+		}
+	}
+
+	start, end := 0, len(runes)
+
+	if width > 0 && len(runes) > width {
+		if first != -1 {
+			start = max(0, spans[first].start-width/2)
+		}
+		end = min(len(runes), start+width)
+		start = max(0, end-width)
+	}
+
+	var b strings.Builder
+	pos := -1
+
+	for _, sp := range spans {
+		if sp.start < start || sp.end > end {
+			continue
+		}
+
+		switch {
+		case pos != -1:
+			b.WriteString(escape(runes[pos:sp.start]))
+		case start == 0:
+			b.WriteString(escape(runes[:sp.start]))
+		default:
+			b.WriteString(ellipsis)
+		}
+
+		if sp.match {
+			b.WriteString(highlightOpen + escape(runes[sp.start:sp.end]) + highlightClose)
+		} else {
+			b.WriteString(escape(runes[sp.start:sp.end]))
+		}
+		pos = sp.end
+	}
+
+	if pos == -1 {
+		return strings.TrimSpace(escape(runes[start:end]))
+	}
+
+	if end == len(runes) {
+		b.WriteString(escape(runes[pos:]))
+	} else {
+		b.WriteString(ellipsis)
+	}
+
+	return strings.TrimSpace(b.String())
+}
+
+func escape(runes []rune) string {
+	return html.EscapeString(string(runes))
+}
+
+// words returns the spans of letters and digits in runes.
+func words(runes []rune) []span {
+	var (
+		spans []span
+		start = -1
+	)
+
+	for i, r := range runes {
+		inWord := unicode.IsLetter(r) || unicode.IsDigit(r)
+
+		if inWord && start == -1 {
+			start = i
+		} else if !inWord && start != -1 {
+			spans = append(spans, span{start: start, end: i})
+			start = -1
+		}
+	}
+
+	if start != -1 {
+		spans = append(spans, span{start: start, end: len(runes)})
+	}
+	return spans
+}
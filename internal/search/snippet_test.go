@@ -0,0 +1,41 @@
+package search
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnippet(t *testing.T) {
+	t.Run("Short text is highlighted as a whole", func(t *testing.T) {
+		require.Equal(t, "A <em>great</em>, <em>Running</em> start.", Snippet("A great, Running start.", "run GREAT", 100))
+	})
+
+	t.Run("Long text is cut around the first match", func(t *testing.T) {
+		text := strings.Repeat("filler ", 20) + "the twist ending" + strings.Repeat(" filler", 20)
+
+		snippet := Snippet(text, "twist", 30)
+
+		require.True(t, strings.HasPrefix(snippet, "…"))
+		require.True(t, strings.HasSuffix(snippet, "…"))
+		require.Contains(t, snippet, "<em>twist</em>")
+		require.NotContains(t, snippet, "fil ")
+	})
+
+	t.Run("Text without matches starts from the beginning", func(t *testing.T) {
+		require.Equal(t, "alpha beta…", Snippet("alpha beta gamma delta", "omega", 12))
+	})
+
+	t.Run("Markup in the text is escaped", func(t *testing.T) {
+		require.Equal(t,
+			"&lt;script&gt;<em>alert</em>(1)&lt;/script&gt; &amp; more",
+			Snippet("<script>alert(1)</script> & more", "alert", 100),
+		)
+		require.Equal(t, "&lt;b&gt;bold&lt;/b&gt;", Snippet("<b>bold</b>", "omega", 100))
+	})
+
+	t.Run("Multibyte text is cut on characters", func(t *testing.T) {
+		require.Equal(t, "<em>фильм</em> отличный", Snippet("фильм отличный", "Фильм", 50))
+	})
+}
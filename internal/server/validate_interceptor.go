@@ -25,3 +25,29 @@ var ValidateInterceptor grpc.UnaryServerInterceptor = func(
 	}
 	return handler(ctx, req)
 }
+
+// validatingStream validates every message the client sends on the stream.
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if v, ok := m.(validator); ok {
+		if err := v.Validate(); err != nil {
+			return status.New(codes.InvalidArgument, err.Error()).Err()
+		}
+	}
+	return nil
+}
+
+var StreamValidateInterceptor grpc.StreamServerInterceptor = func(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return handler(srv, &validatingStream{ServerStream: ss})
+}
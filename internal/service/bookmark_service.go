@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/maisiq/go-ugc-service/internal/cache"
+	"github.com/maisiq/go-ugc-service/internal/db"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/pagination"
+	"github.com/maisiq/go-ugc-service/internal/producer"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	"go.uber.org/zap"
+)
+
+// BookmarkService keeps the movies users saved to watch later.
+type BookmarkService struct {
+	bookmarkRepo repository.BookmarkRepository
+	outboxRepo   repository.OutboxRepository
+	log          *zap.SugaredLogger
+	cache        *cache.Cache
+	uow          db.UOW
+	paginator    *pagination.Paginator
+}
+
+func NewBookmarkService(
+	bookmarkRepo repository.BookmarkRepository,
+	outboxRepo repository.OutboxRepository,
+	log *zap.SugaredLogger,
+	cache *cache.Cache,
+	uow db.UOW,
+	paginator *pagination.Paginator,
+) *BookmarkService {
+	return &BookmarkService{
+		bookmarkRepo: bookmarkRepo,
+		outboxRepo:   outboxRepo,
+		log:          log,
+		cache:        cache,
+		uow:          uow,
+		paginator:    paginator,
+	}
+}
+
+func (s *BookmarkService) AddBookmark(ctx context.Context, UserID, MovieID string) error {
+	err := s.uow.RunWithinTx(ctx, func(ctx context.Context) error {
+		err := s.bookmarkRepo.AddBookmark(ctx, repository.Bookmark{
+			UserID:    UserID,
+			MovieID:   MovieID,
+			CreatedAt: time.Now().UTC(),
+		})
+		if err != nil {
+			return err
+		}
+
+		return enqueue(ctx, s.outboxRepo, bookmarkMessage(UserID, MovieID, producer.EventBookmarkAdded))
+	})
+
+	if err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return apperrors.ErrAlreadyExists
+		}
+		s.log.Errorf("failed to add bookmark: %v", err)
+		return apperrors.ErrInternal
+	}
+
+	s.invalidate(ctx, UserID)
+
+	return nil
+}
+
+func (s *BookmarkService) RemoveBookmark(ctx context.Context, UserID, MovieID string) error {
+	err := s.uow.RunWithinTx(ctx, func(ctx context.Context) error {
+		if err := s.bookmarkRepo.RemoveBookmark(ctx, UserID, MovieID); err != nil {
+			return err
+		}
+
+		return enqueue(ctx, s.outboxRepo, bookmarkMessage(UserID, MovieID, producer.EventBookmarkRemoved))
+	})
+
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return apperrors.ErrNotFound
+		}
+		s.log.Errorf("failed to remove bookmark: %v", err)
+		return apperrors.ErrInternal
+	}
+
+	s.invalidate(ctx, UserID)
+
+	return nil
+}
+
+// ListBookmarks returns a page of the user bookmarks, newest first.
+func (s *BookmarkService) ListBookmarks(ctx context.Context, UserID string, PageSize int32, PageToken string) ([]repository.Bookmark, string, error) {
+	offset, err := s.paginator.Offset(PageToken, "bookmark", UserID)
+	if err != nil {
+		return []repository.Bookmark{}, "", apperrors.ErrInvalidArgument
+	}
+	limit := s.paginator.PageSize(PageSize)
+
+	gen, err := s.cache.Generation(ctx, bookmarksScope(UserID))
+	if err != nil {
+		s.log.Errorf("failed to get bookmark cache generation: %v", err)
+		return []repository.Bookmark{}, "", apperrors.ErrInternal
+	}
+
+	key := cache.BuildKey("bookmark", UserID, "page", gen, strconv.Itoa(offset), strconv.Itoa(limit))
+
+	bookmarks, err := cache.GetOrSet(s.cache, ctx, key, time.Minute, func() ([]repository.Bookmark, error) {
+		// one extra bookmark tells whether there is a next page
+		return s.bookmarkRepo.ListBookmarks(ctx, UserID, offset, limit+1)
+	})
+
+	if err != nil {
+		s.log.Errorf("failed to list bookmarks: %v", err)
+		return []repository.Bookmark{}, "", apperrors.ErrInternal
+	}
+
+	var nextPageToken string
+
+	if len(bookmarks) > limit {
+		bookmarks = bookmarks[:limit]
+		nextPageToken = s.paginator.NextToken(offset+limit, "bookmark", UserID)
+	}
+
+	return bookmarks, nextPageToken, nil
+}
+
+// bookmarksScope is the cache scope of the bookmark pages of the user.
+func bookmarksScope(UserID string) string {
+	return cache.BuildKey("bookmark", UserID)
+}
+
+func (s *BookmarkService) invalidate(ctx context.Context, UserID string) {
+	if err := s.cache.Bump(ctx, bookmarksScope(UserID)); err != nil {
+		s.log.Warnf("failed to invalidate bookmark cache: %v", err)
+	}
+}
+
+func bookmarkMessage(UserID, MovieID, Event string) producer.AnalyticsMessage {
+	return producer.NewBookmarkMessage(Event, time.Now(), producer.BookmarkPayload{UserID: UserID, MovieID: MovieID})
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/maisiq/go-ugc-service/internal/cache"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/pagination"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	"go.uber.org/zap"
+)
+
+type CommentService struct {
+	reviewRepo  repository.ReviewRepository
+	commentRepo repository.CommentRepository
+	log         *zap.SugaredLogger
+	cache       *cache.Cache
+	paginator   *pagination.Paginator
+}
+
+func NewCommentService(
+	reviewRepo repository.ReviewRepository,
+	commentRepo repository.CommentRepository,
+	log *zap.SugaredLogger,
+	cache *cache.Cache,
+	paginator *pagination.Paginator,
+) *CommentService {
+	return &CommentService{
+		reviewRepo:  reviewRepo,
+		commentRepo: commentRepo,
+		log:         log,
+		cache:       cache,
+		paginator:   paginator,
+	}
+}
+
+// AddComment adds a comment to the review of ReviewUserID for MovieID.
+// If ParentID is set the comment is a reply, and only top level comments can be replied to.
+func (s *CommentService) AddComment(ctx context.Context, ReviewUserID, MovieID, AuthorID, ParentID, Text string) (repository.Comment, error) {
+	_, err := s.reviewRepo.GetReview(ctx, ReviewUserID, MovieID)
+	if err != nil {
+		return repository.Comment{}, s.mapError(err, "failed to get commented review")
+	}
+
+	if ParentID != "" {
+		parent, err := s.commentRepo.GetComment(ctx, ParentID)
+		if err != nil {
+			return repository.Comment{}, s.mapError(err, "failed to get parent comment")
+		}
+
+		if parent.ParentID != "" || parent.MovieID != MovieID || parent.ReviewUserID != ReviewUserID {
+			return repository.Comment{}, apperrors.ErrInvalidArgument
+		}
+	}
+
+	now := time.Now().UTC()
+
+	comment, err := s.commentRepo.AddComment(ctx, repository.Comment{
+		ReviewUserID: ReviewUserID,
+		MovieID:      MovieID,
+		AuthorID:     AuthorID,
+		ParentID:     ParentID,
+		Text:         Text,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	})
+	if err != nil {
+		return repository.Comment{}, s.mapError(err, "failed to add comment")
+	}
+
+	s.invalidate(ctx, ReviewUserID, MovieID)
+
+	return comment, nil
+}
+
+// ListComments returns a page of top level comments, each with all of its replies.
+func (s *CommentService) ListComments(ctx context.Context, ReviewUserID, MovieID string, PageSize int32, PageToken string) ([]repository.Comment, string, error) {
+	offset, err := s.paginator.Offset(PageToken, "comment", MovieID, ReviewUserID)
+	if err != nil {
+		return []repository.Comment{}, "", apperrors.ErrInvalidArgument
+	}
+	limit := s.paginator.PageSize(PageSize)
+
+	gen, err := s.cache.Generation(ctx, commentsScope(ReviewUserID, MovieID))
+	if err != nil {
+		s.log.Errorf("failed to get comment cache generation: %v", err)
+		return []repository.Comment{}, "", apperrors.ErrInternal
+	}
+
+	key := cache.BuildKey("comment", MovieID, ReviewUserID, "page", gen, strconv.Itoa(offset), strconv.Itoa(limit))
+
+	comments, err := cache.GetOrSet(s.cache, ctx, key, time.Minute, func() ([]repository.Comment, error) {
+		// one extra comment tells whether there is a next page
+		comments, err := s.commentRepo.ListComments(ctx, ReviewUserID, MovieID, offset, limit+1)
+		if err != nil {
+			return nil, err
+		}
+
+		parentIDs := make([]string, 0, len(comments))
+		for _, c := range comments {
+			parentIDs = append(parentIDs, c.ID)
+		}
+
+		replies, err := s.commentRepo.ListReplies(ctx, parentIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		byParent := make(map[string][]repository.Comment, len(comments))
+		for _, reply := range replies {
+			byParent[reply.ParentID] = append(byParent[reply.ParentID], reply)
+		}
+
+		for i := range comments {
+			comments[i].Replies = byParent[comments[i].ID]
+		}
+
+		return comments, nil
+	})
+
+	if err != nil {
+		return []repository.Comment{}, "", s.mapError(err, "failed to list comments")
+	}
+
+	var nextPageToken string
+
+	if len(comments) > limit {
+		comments = comments[:limit]
+		nextPageToken = s.paginator.NextToken(offset+limit, "comment", MovieID, ReviewUserID)
+	}
+
+	return comments, nextPageToken, nil
+}
+
+func (s *CommentService) EditComment(ctx context.Context, CommentID, AuthorID, Text string) (repository.Comment, error) {
+	if _, err := s.authoredComment(ctx, CommentID, AuthorID); err != nil {
+		return repository.Comment{}, err
+	}
+
+	comment, err := s.commentRepo.UpdateComment(ctx, CommentID, Text, time.Now().UTC())
+	if err != nil {
+		return repository.Comment{}, s.mapError(err, "failed to edit comment")
+	}
+
+	s.invalidate(ctx, comment.ReviewUserID, comment.MovieID)
+
+	return comment, nil
+}
+
+func (s *CommentService) DeleteComment(ctx context.Context, CommentID, AuthorID string) error {
+	comment, err := s.authoredComment(ctx, CommentID, AuthorID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.commentRepo.DeleteComment(ctx, CommentID); err != nil {
+		return s.mapError(err, "failed to delete comment")
+	}
+
+	s.invalidate(ctx, comment.ReviewUserID, comment.MovieID)
+
+	return nil
+}
+
+// authoredComment returns the comment if it was written by AuthorID.
+func (s *CommentService) authoredComment(ctx context.Context, CommentID, AuthorID string) (repository.Comment, error) {
+	comment, err := s.commentRepo.GetComment(ctx, CommentID)
+	if err != nil {
+		return repository.Comment{}, s.mapError(err, "failed to get comment")
+	}
+
+	if comment.AuthorID != AuthorID {
+		return repository.Comment{}, apperrors.ErrPermissionDenied
+	}
+	return comment, nil
+}
+
+// commentsScope is the cache scope of the comment pages of a review.
+func commentsScope(ReviewUserID, MovieID string) string {
+	return cache.BuildKey("comment", MovieID, ReviewUserID)
+}
+
+func (s *CommentService) invalidate(ctx context.Context, ReviewUserID, MovieID string) {
+	invalidateComments(ctx, s.cache, s.log, ReviewUserID, MovieID)
+}
+
+func invalidateComments(ctx context.Context, c *cache.Cache, log *zap.SugaredLogger, ReviewUserID, MovieID string) {
+	if err := c.Bump(ctx, commentsScope(ReviewUserID, MovieID)); err != nil {
+		log.Warnf("failed to invalidate comment cache: %v", err)
+	}
+}
+
+func (s *CommentService) mapError(err error, msg string) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return apperrors.ErrNotFound
+	}
+	s.log.Errorf("%s: %v", msg, err)
+	return apperrors.ErrInternal
+}
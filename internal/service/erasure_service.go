@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/producer"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+)
+
+// ErasureService removes everything stored about a user from every store on request.
+// Progress is recorded after each step, so an erasure that failed or was cut
+// short by a crash picks up where it stopped, either when it is requested
+// again or when the service resumes unfinished erasures on start.
+type ErasureService struct {
+	reviews       *UGCService
+	voteRepo      repository.VoteRepository
+	commentRepo   repository.CommentRepository
+	reportRepo    repository.ReportRepository
+	bookmarkRepo  repository.BookmarkRepository
+	progressRepo  repository.ProgressRepository
+	erasureRepo   repository.ErasureRepository
+	analyticsRepo repository.AnalyticsRepository
+	cancel        context.CancelFunc
+	wg            sync.WaitGroup
+}
+
+func NewErasureService(
+	reviews *UGCService,
+	voteRepo repository.VoteRepository,
+	commentRepo repository.CommentRepository,
+	reportRepo repository.ReportRepository,
+	bookmarkRepo repository.BookmarkRepository,
+	progressRepo repository.ProgressRepository,
+	erasureRepo repository.ErasureRepository,
+	analyticsRepo repository.AnalyticsRepository,
+) *ErasureService {
+	return &ErasureService{
+		reviews:       reviews,
+		voteRepo:      voteRepo,
+		commentRepo:   commentRepo,
+		reportRepo:    reportRepo,
+		bookmarkRepo:  bookmarkRepo,
+		progressRepo:  progressRepo,
+		erasureRepo:   erasureRepo,
+		analyticsRepo: analyticsRepo,
+	}
+}
+
+// EraseUser removes the reviews of the user from both collections together with
+// their rating, search entries and revisions, then the votes, comments and reports
+// the user left or got, the bookmarks and playback positions. It drops cached
+// listings of the user and the affected movies, deletes the user rows from every
+// analytics table and publishes an erasure event for downstream consumers.
+func (s *ErasureService) EraseUser(ctx context.Context, UserID string) error {
+	erasure, err := s.erasureRepo.StartErasure(ctx, UserID, time.Now().UTC())
+
+	if err == nil {
+		err = s.erase(ctx, erasure)
+	}
+
+	if err != nil {
+		s.reviews.log.Errorf("failed to erase user %v: %v", UserID, err)
+		return apperrors.ErrInternal
+	}
+
+	return nil
+}
+
+// Resume finishes erasures left unfinished in the background until Close is called.
+func (s *ErasureService) Resume() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		erasures, err := s.erasureRepo.ListErasures(ctx)
+		if err != nil {
+			s.reviews.log.Errorf("failed to list unfinished erasures: %v", err)
+			return
+		}
+
+		for _, erasure := range erasures {
+			if err := s.erase(ctx, erasure); err != nil {
+				s.reviews.log.Errorf("failed to resume erasure of user %v: %v", erasure.UserID, err)
+				continue
+			}
+			s.reviews.log.Infof("Resumed erasure of user %v", erasure.UserID)
+		}
+	}()
+}
+
+// Close stops resuming erasures and waits for the running one to stop.
+func (s *ErasureService) Close() error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	return nil
+}
+
+// erase runs the steps the erasure has not done yet, in order, and drops its progress at the end.
+func (s *ErasureService) erase(ctx context.Context, erasure repository.Erasure) error {
+	steps := []struct {
+		step repository.ErasureStep
+		run  func(context.Context, *repository.Erasure) error
+	}{
+		{repository.ErasureReviews, s.eraseReviews},
+		{repository.ErasureVotes, s.eraseVotes},
+		{repository.ErasureComments, s.eraseComments},
+		{repository.ErasureReports, s.eraseReports},
+		{repository.ErasureBookmarks, s.eraseBookmarks},
+		{repository.ErasureProgress, s.eraseProgress},
+		{repository.ErasureCache, s.eraseCache},
+		{repository.ErasureAnalytics, s.eraseAnalytics},
+		{repository.ErasureEvent, s.publishErasure},
+	}
+
+	for _, step := range steps {
+		if erasure.IsDone(step.step) {
+			continue
+		}
+
+		if err := step.run(ctx, &erasure); err != nil {
+			return fmt.Errorf("%v step: %w", step.step, err)
+		}
+
+		if err := s.erasureRepo.CompleteStep(ctx, erasure.UserID, step.step); err != nil {
+			return err
+		}
+	}
+
+	return s.erasureRepo.FinishErasure(ctx, erasure.UserID)
+}
+
+// eraseReviews removes every review of the user, soft deleted ones included, each in its
+// own transaction. Reviews removed before a failure are not listed again on resume.
+func (s *ErasureService) eraseReviews(ctx context.Context, erasure *repository.Erasure) error {
+	r := s.reviews
+
+	reviews, err := r.userRepo.ListAllReviews(ctx, erasure.UserID)
+	if err != nil {
+		return err
+	}
+
+	movieIDs := make([]string, 0, len(reviews))
+	for _, review := range reviews {
+		movieIDs = append(movieIDs, review.MovieID)
+	}
+
+	// the movies are recorded first, their cache still has to go if the reviews are gone by the resume
+	if err := s.erasureRepo.AddMovies(ctx, erasure.UserID, movieIDs); err != nil {
+		return err
+	}
+	erasure.MovieIDs = append(erasure.MovieIDs, movieIDs...)
+
+	for _, review := range reviews {
+		err := r.uow.RunWithinTx(ctx, func(ctx context.Context) error {
+			for _, repo := range []repository.ReviewRepository{r.userRepo, r.movieRepo} {
+				if err := repo.DeleteReview(ctx, review.UserID, review.MovieID); err != nil && !errors.Is(err, repository.ErrNotFound) {
+					return err
+				}
+			}
+
+			if err := r.searchRepo.RemoveReview(ctx, review.UserID, review.MovieID); err != nil {
+				return err
+			}
+
+			if err := r.revisionRepo.DeleteRevisions(ctx, review.UserID, review.MovieID); err != nil {
+				return err
+			}
+
+			// soft deleted reviews left the rating when they were deleted
+			if review.DeletedAt != nil {
+				return nil
+			}
+
+			return updateRating(ctx, r.ratingRepo, review.MovieID, review, repository.Review{})
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// eraseVotes removes the votes the user left and got. Vote counters of the reviews
+// the user voted on stay, they no longer tell who voted.
+func (s *ErasureService) eraseVotes(ctx context.Context, erasure *repository.Erasure) error {
+	return s.voteRepo.DeleteUserVotes(ctx, erasure.UserID)
+}
+
+func (s *ErasureService) eraseComments(ctx context.Context, erasure *repository.Erasure) error {
+	return s.commentRepo.DeleteUserComments(ctx, erasure.UserID)
+}
+
+func (s *ErasureService) eraseReports(ctx context.Context, erasure *repository.Erasure) error {
+	return s.reportRepo.DeleteUserReports(ctx, erasure.UserID)
+}
+
+func (s *ErasureService) eraseBookmarks(ctx context.Context, erasure *repository.Erasure) error {
+	return s.bookmarkRepo.DeleteUserBookmarks(ctx, erasure.UserID)
+}
+
+// eraseProgress drops the positions buffered in redis before the stored ones,
+// so the flush job has nothing of the user left to write back.
+func (s *ErasureService) eraseProgress(ctx context.Context, erasure *repository.Erasure) error {
+	if err := s.reviews.cache.Client.Del(ctx, progressKey(erasure.UserID)).Err(); err != nil {
+		return err
+	}
+
+	return s.progressRepo.DeleteUserProgress(ctx, erasure.UserID)
+}
+
+// eraseCache drops cached listings of the user, the user bookmarks, the listings of every
+// movie the user reviewed with the comments of those reviews, and the cached single reviews
+// and summaries of those movies.
+func (s *ErasureService) eraseCache(ctx context.Context, erasure *repository.Erasure) error {
+	scopes := []string{reviewsScope(erasure.UserID), bookmarksScope(erasure.UserID)}
+	var keys []string
+
+	for _, movieID := range erasure.MovieIDs {
+		scopes = append(scopes, reviewsScope(movieID), commentsScope(erasure.UserID, movieID))
+		keys = append(keys, reviewKey(erasure.UserID, movieID), summaryKey(movieID))
+	}
+
+	if err := s.reviews.cache.Bump(ctx, scopes...); err != nil {
+		return err
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	return s.reviews.cache.Delete(ctx, keys...)
+}
+
+func (s *ErasureService) eraseAnalytics(ctx context.Context, erasure *repository.Erasure) error {
+	return s.analyticsRepo.DeleteUserEvents(ctx, erasure.UserID)
+}
+
+// publishErasure is the last step, consumers hear about the erasure only once
+// nothing of the user is left here. The event goes through the outbox, a resume
+// may enqueue it twice.
+func (s *ErasureService) publishErasure(ctx context.Context, erasure *repository.Erasure) error {
+	return enqueue(ctx, s.reviews.outboxRepo, producer.NewUserErasedMessage(time.Now(), erasure.UserID))
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	"go.uber.org/zap"
+)
+
+// UserDataRecord is one record of a user data export, exactly one of the fields is set.
+type UserDataRecord struct {
+	Review  *repository.Review
+	Vote    *repository.Vote
+	Comment *repository.Comment
+	Report  *repository.Report
+	Event   *repository.AnalyticsEvent
+}
+
+// ExportService gathers everything stored about a user for data subject access requests.
+type ExportService struct {
+	userRepo      repository.ReviewRepository
+	voteRepo      repository.VoteRepository
+	commentRepo   repository.CommentRepository
+	reportRepo    repository.ReportRepository
+	analyticsRepo repository.AnalyticsRepository
+	log           *zap.SugaredLogger
+}
+
+func NewExportService(
+	userRepo repository.ReviewRepository,
+	voteRepo repository.VoteRepository,
+	commentRepo repository.CommentRepository,
+	reportRepo repository.ReportRepository,
+	analyticsRepo repository.AnalyticsRepository,
+	log *zap.SugaredLogger,
+) *ExportService {
+	return &ExportService{
+		userRepo:      userRepo,
+		voteRepo:      voteRepo,
+		commentRepo:   commentRepo,
+		reportRepo:    reportRepo,
+		analyticsRepo: analyticsRepo,
+		log:           log,
+	}
+}
+
+// ExportUserData calls send for every record stored about the user: reviews, soft
+// deleted ones included, votes, comments, reports and analytics events, in that order.
+// Each kind is loaded only after the previous one was sent, so a large export is
+// never held in memory as a whole.
+func (s *ExportService) ExportUserData(ctx context.Context, UserID string, send func(UserDataRecord) error) error {
+	sources := []struct {
+		name string
+		load func() ([]UserDataRecord, error)
+	}{
+		{"reviews", func() ([]UserDataRecord, error) {
+			reviews, err := s.userRepo.ListAllReviews(ctx, UserID)
+			return toRecords(reviews, err, func(r *repository.Review) UserDataRecord { return UserDataRecord{Review: r} })
+		}},
+		{"votes", func() ([]UserDataRecord, error) {
+			votes, err := s.voteRepo.ListVotesBy(ctx, UserID)
+			return toRecords(votes, err, func(v *repository.Vote) UserDataRecord { return UserDataRecord{Vote: v} })
+		}},
+		{"comments", func() ([]UserDataRecord, error) {
+			comments, err := s.commentRepo.ListCommentsBy(ctx, UserID)
+			return toRecords(comments, err, func(c *repository.Comment) UserDataRecord { return UserDataRecord{Comment: c} })
+		}},
+		{"reports", func() ([]UserDataRecord, error) {
+			reports, err := s.reportRepo.ListReportsBy(ctx, UserID)
+			return toRecords(reports, err, func(r *repository.Report) UserDataRecord { return UserDataRecord{Report: r} })
+		}},
+		{"analytics events", func() ([]UserDataRecord, error) {
+			events, err := s.analyticsRepo.ListUserEvents(ctx, UserID)
+			return toRecords(events, err, func(e *repository.AnalyticsEvent) UserDataRecord { return UserDataRecord{Event: e} })
+		}},
+	}
+
+	for _, source := range sources {
+		records, err := source.load()
+
+		if err != nil {
+			s.log.Errorf("failed to export %v of user %v: %v", source.name, UserID, err)
+			return apperrors.ErrInternal
+		}
+
+		for _, record := range records {
+			// the client went away, the error already carries the stream status
+			if err := send(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func toRecords[T any](items []T, err error, wrap func(*T) UserDataRecord) ([]UserDataRecord, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]UserDataRecord, 0, len(items))
+	for i := range items {
+		records = append(records, wrap(&items[i]))
+	}
+
+	return records, nil
+}
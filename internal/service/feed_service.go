@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	"go.uber.org/zap"
+)
+
+// FeedService streams live review changes. Close ends every open stream,
+// otherwise a graceful stop of the grpc server would wait for them forever.
+type FeedService struct {
+	watcher repository.ReviewWatcher
+	log     *zap.SugaredLogger
+	done    chan struct{}
+	once    sync.Once
+}
+
+func NewFeedService(watcher repository.ReviewWatcher, log *zap.SugaredLogger) *FeedService {
+	return &FeedService{
+		watcher: watcher,
+		log:     log,
+		done:    make(chan struct{}),
+	}
+}
+
+// WatchMovieReviews calls send for every public change of the movie reviews until
+// ctx is done. Reviews that stop being visible are reported as deleted, and reviews
+// created invisible are not reported at all.
+func (s *FeedService) WatchMovieReviews(ctx context.Context, MovieID, ResumeToken string, send func(repository.ReviewEvent) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-s.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	var sendErr error
+
+	err := s.watcher.Watch(ctx, MovieID, ResumeToken, func(event repository.ReviewEvent) error {
+		if event.Type != repository.ReviewDeleted && !event.Review.Visible() {
+			if event.Type == repository.ReviewCreated {
+				return nil
+			}
+			event.Type = repository.ReviewDeleted
+			event.Review = repository.Review{UserID: event.Review.UserID, MovieID: event.Review.MovieID}
+		}
+
+		sendErr = send(event)
+		return sendErr
+	})
+
+	switch {
+	case err == nil:
+		return nil
+	case sendErr != nil:
+		return sendErr
+	case errors.Is(err, repository.ErrInvalidResumeToken):
+		return apperrors.ErrInvalidArgument
+	}
+
+	s.log.Errorf("failed to watch movie reviews: %v", err)
+	return apperrors.ErrInternal
+}
+
+func (s *FeedService) Close() error {
+	s.once.Do(func() {
+		close(s.done)
+	})
+	return nil
+}
@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/filter"
+	"github.com/maisiq/go-ugc-service/internal/producer"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+)
+
+// ImportItem is one review of an import. Invalid is set when the review
+// failed validation, such items are reported without being written.
+type ImportItem struct {
+	Review    repository.Review
+	Overwrite bool
+	Invalid   error
+}
+
+type ImportError struct {
+	Index   int64
+	UserID  string
+	MovieID string
+	Err     error
+}
+
+type ImportReport struct {
+	Created     int64
+	Overwritten int64
+	Skipped     int64
+	Failed      int64
+	Errors      []ImportError
+}
+
+type importOutcome int
+
+const (
+	importFailed importOutcome = iota
+	importCreated
+	importOverwritten
+	importSkipped
+)
+
+// ImportService loads reviews from other platforms in batches, one transaction
+// and one analytics write per batch. It writes through the same repositories
+// and follows the same moderation rules as UGCService.
+type ImportService struct {
+	reviews   *UGCService
+	batchSize int
+}
+
+func NewImportService(reviews *UGCService, batchSize int) *ImportService {
+	return &ImportService{
+		reviews:   reviews,
+		batchSize: max(batchSize, 1),
+	}
+}
+
+// ImportReviews reads items from next until it returns io.EOF. Cached entries of
+// overwritten reviews are dropped, cached pages are left to expire on their own.
+func (s *ImportService) ImportReviews(ctx context.Context, next func() (ImportItem, error)) (ImportReport, error) {
+	var (
+		report ImportReport
+		index  int64
+		batch  = make([]ImportItem, 0, s.batchSize)
+	)
+
+	for {
+		item, err := next()
+
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return report, err
+		}
+
+		batch = append(batch, item)
+
+		if len(batch) == s.batchSize {
+			s.importBatch(ctx, index, batch, &report)
+			index += int64(len(batch))
+			batch = batch[:0]
+		}
+	}
+
+	if len(batch) > 0 {
+		s.importBatch(ctx, index, batch, &report)
+	}
+
+	return report, nil
+}
+
+func (s *ImportService) importBatch(ctx context.Context, offset int64, batch []ImportItem, report *ImportReport) {
+	var (
+		outcomes []importOutcome
+		errs     = make([]error, len(batch))
+		reviews  = make([]repository.Review, len(batch))
+		now      = time.Now().UTC()
+	)
+
+	for i, item := range batch {
+		reviews[i], errs[i] = s.prepare(item, now)
+	}
+
+	err := s.reviews.uow.RunWithinTx(ctx, func(ctx context.Context) error {
+		outcomes = make([]importOutcome, len(batch))
+
+		var messages []producer.AnalyticsMessage
+
+		for i, item := range batch {
+			if errs[i] != nil {
+				continue
+			}
+
+			outcome, err := s.write(ctx, reviews[i], item.Overwrite)
+			if err != nil {
+				return err
+			}
+			outcomes[i] = outcome
+
+			switch outcome {
+			case importCreated:
+				messages = append(messages, reviewMessage(producer.EventReviewCreated, now, reviews[i]))
+			case importOverwritten:
+				messages = append(messages, reviewMessage(producer.EventReviewUpdated, now, reviews[i]))
+			}
+		}
+
+		return enqueue(ctx, s.reviews.outboxRepo, messages...)
+	})
+
+	if err != nil {
+		s.reviews.log.Errorf("failed to import batch at %d: %v", offset, err)
+
+		// the transaction is rolled back, so none of the batch was written
+		outcomes = make([]importOutcome, len(batch))
+		for i := range errs {
+			if errs[i] == nil {
+				errs[i] = apperrors.ErrInternal
+			}
+		}
+	}
+
+	var scopes, keys []string
+
+	for i, outcome := range outcomes {
+		if outcome == importCreated || outcome == importOverwritten {
+			scopes = append(scopes, reviewsScope(reviews[i].MovieID), reviewsScope(reviews[i].UserID))
+			keys = append(keys, reviewKey(reviews[i].UserID, reviews[i].MovieID), summaryKey(reviews[i].MovieID))
+		}
+		if outcome == importCreated {
+			scopes = append(scopes, commentsScope(reviews[i].UserID, reviews[i].MovieID))
+		}
+
+		switch outcome {
+		case importCreated:
+			report.Created++
+		case importOverwritten:
+			report.Overwritten++
+		case importSkipped:
+			report.Skipped++
+		default:
+			report.Failed++
+			report.Errors = append(report.Errors, ImportError{
+				Index: offset + int64(i), UserID: batch[i].Review.UserID, MovieID: batch[i].Review.MovieID, Err: errs[i],
+			})
+		}
+	}
+
+	// listings and summaries are dropped once for the whole batch
+	if len(keys) > 0 {
+		if err := s.reviews.cache.Bump(ctx, scopes...); err != nil {
+			s.reviews.log.Warnf("failed to invalidate review listings cache: %v", err)
+		}
+		if err := s.reviews.cache.Delete(ctx, keys...); err != nil {
+			s.reviews.log.Warnf("failed to invalidate review cache: %v", err)
+		}
+	}
+}
+
+// prepare runs validation and content filters and fills what the source platform did not set.
+func (s *ImportService) prepare(item ImportItem, now time.Time) (repository.Review, error) {
+	if item.Invalid != nil {
+		return repository.Review{}, fmt.Errorf("%w: %v", apperrors.ErrInvalidArgument, item.Invalid)
+	}
+
+	verdict := s.reviews.filters.Check(item.Review.Text)
+	if verdict.Decision == filter.Reject {
+		return repository.Review{}, fmt.Errorf("%w: %s", apperrors.ErrContentRejected, verdict.Reason)
+	}
+
+	review := item.Review
+	if review.CreatedAt.IsZero() {
+		review.CreatedAt = now
+	}
+	if review.UpdatedAt.IsZero() {
+		review.UpdatedAt = review.CreatedAt
+	}
+	review.Likes, review.Dislikes = 0, 0
+	review.Status, review.ModerationReason = s.reviews.newStatus(), ""
+	review.Version = 1
+	flag(&review, verdict)
+
+	return review, nil
+}
+
+func (s *ImportService) write(ctx context.Context, review repository.Review, overwrite bool) (importOutcome, error) {
+	r := s.reviews
+
+	current, err := r.movieRepo.GetReview(ctx, review.UserID, review.MovieID)
+
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		if err := r.userRepo.CreateReview(ctx, review); err != nil {
+			return importFailed, err
+		}
+		if err := r.movieRepo.CreateReview(ctx, review); err != nil {
+			return importFailed, err
+		}
+		if err := dropActivity(ctx, r.voteRepo, r.commentRepo, review.UserID, review.MovieID); err != nil {
+			return importFailed, err
+		}
+		if err := r.searchRepo.IndexReview(ctx, review); err != nil {
+			return importFailed, err
+		}
+		return importCreated, updateRating(ctx, r.ratingRepo, review.MovieID, repository.Review{}, review)
+	case err != nil:
+		return importFailed, err
+	case !overwrite:
+		return importSkipped, nil
+	}
+
+	// imports overwrite whatever edits were made, so they apply on top of the current version
+	review.Version = current.Version
+
+	// like an edit, an import never lifts a moderator's decision to reject or hide the review
+	if !current.Visible() {
+		review.Status, review.ModerationReason = r.editedStatus(current), current.ModerationReason
+	}
+
+	err = r.revisionRepo.AddRevision(ctx, repository.Revision{
+		UserID:   review.UserID,
+		MovieID:  review.MovieID,
+		Version:  current.Version,
+		Text:     current.Text,
+		Rating:   current.Rating,
+		EditorID: repository.EditorImport,
+		EditedAt: review.UpdatedAt,
+	})
+	if err != nil {
+		return importFailed, err
+	}
+
+	if err := r.userRepo.UpdateReview(ctx, review); err != nil {
+		return importFailed, err
+	}
+	if err := r.movieRepo.UpdateReview(ctx, review); err != nil {
+		return importFailed, err
+	}
+
+	review.CreatedAt = current.CreatedAt
+	review.Version++
+	if err := r.searchRepo.IndexReview(ctx, review); err != nil {
+		return importFailed, err
+	}
+	return importOverwritten, updateRating(ctx, r.ratingRepo, review.MovieID, current, review)
+}
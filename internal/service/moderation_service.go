@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/maisiq/go-ugc-service/internal/cache"
+	"github.com/maisiq/go-ugc-service/internal/db"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/pagination"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	"go.uber.org/zap"
+)
+
+// transitions lists the statuses a review can be moved to by a moderator.
+// Reviews without a status predate moderation and move like approved ones.
+var transitions = map[repository.ReviewStatus][]repository.ReviewStatus{
+	repository.StatusPending:  {repository.StatusApproved, repository.StatusRejected, repository.StatusHidden},
+	repository.StatusApproved: {repository.StatusRejected, repository.StatusHidden},
+	"":                        {repository.StatusRejected, repository.StatusHidden},
+	repository.StatusHidden:   {repository.StatusApproved, repository.StatusRejected},
+	repository.StatusRejected: {repository.StatusApproved},
+}
+
+type ModerationService struct {
+	userRepo       repository.ReviewRepository
+	movieRepo      repository.ReviewRepository
+	moderationRepo repository.ModerationRepository
+	ratingRepo     repository.RatingRepository
+	searchRepo     repository.SearchRepository
+	revisionRepo   repository.RevisionRepository
+	log            *zap.SugaredLogger
+	cache          *cache.Cache
+	uow            db.UOW
+	paginator      *pagination.Paginator
+}
+
+func NewModerationService(
+	userRepo repository.ReviewRepository,
+	movieRepo repository.ReviewRepository,
+	moderationRepo repository.ModerationRepository,
+	ratingRepo repository.RatingRepository,
+	searchRepo repository.SearchRepository,
+	revisionRepo repository.RevisionRepository,
+	log *zap.SugaredLogger,
+	cache *cache.Cache,
+	uow db.UOW,
+	paginator *pagination.Paginator,
+) *ModerationService {
+	return &ModerationService{
+		userRepo:       userRepo,
+		movieRepo:      movieRepo,
+		moderationRepo: moderationRepo,
+		ratingRepo:     ratingRepo,
+		searchRepo:     searchRepo,
+		revisionRepo:   revisionRepo,
+		log:            log,
+		cache:          cache,
+		uow:            uow,
+		paginator:      paginator,
+	}
+}
+
+// awaitingModeration are the statuses of reviews a moderator has to look at.
+var awaitingModeration = []repository.ReviewStatus{repository.StatusPending, repository.StatusHidden}
+
+// ListPendingReviews returns a page of reviews waiting for a moderator, oldest first.
+// Reviews hidden after user reports wait for a moderator as well.
+func (s *ModerationService) ListPendingReviews(ctx context.Context, PageSize int32, PageToken string) ([]repository.Review, string, error) {
+	offset, err := s.paginator.Offset(PageToken, "moderation", string(repository.StatusPending))
+	if err != nil {
+		return []repository.Review{}, "", apperrors.ErrInvalidArgument
+	}
+	limit := s.paginator.PageSize(PageSize)
+
+	// one extra review tells whether there is a next page
+	reviews, err := s.moderationRepo.ListReviews(ctx, awaitingModeration, offset, limit+1)
+	if err != nil {
+		s.log.Errorf("failed to list pending reviews: %v", err)
+		return []repository.Review{}, "", apperrors.ErrInternal
+	}
+
+	var nextPageToken string
+
+	if len(reviews) > limit {
+		reviews = reviews[:limit]
+		nextPageToken = s.paginator.NextToken(offset+limit, "moderation", string(repository.StatusPending))
+	}
+
+	return reviews, nextPageToken, nil
+}
+
+// ListReviewRevisions returns a page of past revisions of the review, newest first.
+func (s *ModerationService) ListReviewRevisions(ctx context.Context, UserID, MovieID string, PageSize int32, PageToken string) ([]repository.Revision, string, error) {
+	offset, err := s.paginator.Offset(PageToken, "revisions", MovieID, UserID)
+	if err != nil {
+		return []repository.Revision{}, "", apperrors.ErrInvalidArgument
+	}
+	limit := s.paginator.PageSize(PageSize)
+
+	// one extra revision tells whether there is a next page
+	revisions, err := s.revisionRepo.ListRevisions(ctx, UserID, MovieID, offset, limit+1)
+	if err != nil {
+		s.log.Errorf("failed to list review revisions: %v", err)
+		return []repository.Revision{}, "", apperrors.ErrInternal
+	}
+
+	var nextPageToken string
+
+	if len(revisions) > limit {
+		revisions = revisions[:limit]
+		nextPageToken = s.paginator.NextToken(offset+limit, "revisions", MovieID, UserID)
+	}
+
+	return revisions, nextPageToken, nil
+}
+
+func (s *ModerationService) ApproveReview(ctx context.Context, UserID, MovieID string) error {
+	return s.setStatus(ctx, UserID, MovieID, repository.StatusApproved, "")
+}
+
+func (s *ModerationService) RejectReview(ctx context.Context, UserID, MovieID, Reason string) error {
+	return s.setStatus(ctx, UserID, MovieID, repository.StatusRejected, Reason)
+}
+
+func (s *ModerationService) HideReview(ctx context.Context, UserID, MovieID, Reason string) error {
+	return s.setStatus(ctx, UserID, MovieID, repository.StatusHidden, Reason)
+}
+
+// RestoreReview brings back a soft deleted review that was not purged yet.
+func (s *ModerationService) RestoreReview(ctx context.Context, UserID, MovieID string) error {
+	err := s.uow.RunWithinTx(ctx, func(ctx context.Context) error {
+		deleted, err := s.movieRepo.GetDeletedReview(ctx, UserID, MovieID)
+		if err != nil {
+			return err
+		}
+
+		err = s.userRepo.RestoreReview(ctx, UserID, MovieID)
+		if err != nil {
+			return err
+		}
+
+		err = s.movieRepo.RestoreReview(ctx, UserID, MovieID)
+		if err != nil {
+			return err
+		}
+
+		restored := deleted
+		restored.UserID, restored.MovieID, restored.DeletedAt = UserID, MovieID, nil
+
+		err = s.searchRepo.IndexReview(ctx, restored)
+		if err != nil {
+			return err
+		}
+
+		return updateRating(ctx, s.ratingRepo, MovieID, repository.Review{}, restored)
+	})
+
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return apperrors.ErrNotFound
+		}
+		s.log.Errorf("failed to restore review: %v", err)
+		return apperrors.ErrInternal
+	}
+
+	invalidateReviews(ctx, s.cache, s.log, UserID, MovieID)
+
+	return nil
+}
+
+// setStatus moves the review to the status in both collections and
+// keeps the movie rating in line with the review visibility.
+func (s *ModerationService) setStatus(ctx context.Context, UserID, MovieID string, status repository.ReviewStatus, reason string) error {
+	moderatedAt := time.Now().UTC()
+
+	err := s.uow.RunWithinTx(ctx, func(ctx context.Context) error {
+		current, err := s.movieRepo.GetReview(ctx, UserID, MovieID)
+		if err != nil {
+			return err
+		}
+
+		if !slices.Contains(transitions[current.Status], status) {
+			return apperrors.ErrFailedPrecondition
+		}
+
+		err = s.userRepo.SetStatus(ctx, UserID, MovieID, status, reason, moderatedAt)
+		if err != nil {
+			return err
+		}
+
+		err = s.movieRepo.SetStatus(ctx, UserID, MovieID, status, reason, moderatedAt)
+		if err != nil {
+			return err
+		}
+
+		updated := current
+		updated.UserID, updated.MovieID = UserID, MovieID
+		updated.Status, updated.ModerationReason, updated.ModeratedAt = status, reason, &moderatedAt
+
+		err = s.searchRepo.IndexReview(ctx, updated)
+		if err != nil {
+			return err
+		}
+
+		return updateRating(ctx, s.ratingRepo, MovieID, current, updated)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			return apperrors.ErrNotFound
+		case errors.Is(err, apperrors.ErrFailedPrecondition):
+			return err
+		}
+		s.log.Errorf("failed to set review status to %v: %v", status, err)
+		return apperrors.ErrInternal
+	}
+
+	invalidateReviews(ctx, s.cache, s.log, UserID, MovieID)
+
+	return nil
+}
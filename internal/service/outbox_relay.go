@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/maisiq/go-ugc-service/internal/producer"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	"github.com/maisiq/go-ugc-service/pkg/config"
+	"go.uber.org/zap"
+)
+
+// enqueue writes the messages to the outbox. It is called within the transaction of
+// the change the messages are about, so they are published only if the change is committed.
+func enqueue(ctx context.Context, outboxRepo repository.OutboxRepository, messages ...producer.AnalyticsMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	events := make([]repository.OutboxEvent, 0, len(messages))
+
+	for _, msg := range messages {
+		payload, err := json.Marshal(msg)
+		if err != nil {
+			return fmt.Errorf("failed to marshal message %+v: %w", msg, err)
+		}
+		events = append(events, repository.OutboxEvent{ID: msg.EventID, Payload: payload, CreatedAt: now})
+	}
+
+	return outboxRepo.AddEvents(ctx, events)
+}
+
+// defaultOutboxConfig stands in for the settings a config without them leaves at zero,
+// a zero interval would poll the database in a tight loop.
+var defaultOutboxConfig = config.OutboxConfig{
+	RelayInterval: time.Second, Lease: 30 * time.Second, MaxBackoff: time.Minute, DrainTimeout: 5 * time.Second,
+}
+
+// OutboxRelay publishes events written to the outbox to the broker and marks them sent.
+// Events are published at least once: a relay that stops between publishing and marking
+// leaves them to be claimed again once their lease is over.
+type OutboxRelay struct {
+	outboxRepo repository.OutboxRepository
+	producer   producer.Producer
+	log        *zap.SugaredLogger
+	cfg        config.OutboxConfig
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+func NewOutboxRelay(
+	outboxRepo repository.OutboxRepository,
+	producer producer.Producer,
+	log *zap.SugaredLogger,
+	cfg config.OutboxConfig,
+) *OutboxRelay {
+	if cfg.RelayInterval <= 0 {
+		cfg.RelayInterval = defaultOutboxConfig.RelayInterval
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = defaultOutboxConfig.MaxBackoff
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = defaultOutboxConfig.Lease
+	}
+	if cfg.DrainTimeout <= 0 {
+		cfg.DrainTimeout = defaultOutboxConfig.DrainTimeout
+	}
+
+	return &OutboxRelay{
+		outboxRepo: outboxRepo,
+		producer:   producer,
+		log:        log,
+		cfg:        cfg,
+	}
+}
+
+// Start relays events every RelayInterval until Close is called. While relaying
+// fails the wait doubles after every failure, up to MaxBackoff.
+func (s *OutboxRelay) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		wait := s.cfg.RelayInterval
+
+		for {
+			timer := time.NewTimer(wait)
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+
+			if _, err := s.Relay(ctx); err != nil {
+				wait = min(wait*2, max(s.cfg.MaxBackoff, s.cfg.RelayInterval))
+				s.log.Errorf("failed to relay outbox events, retrying in %v: %v", wait, err)
+				continue
+			}
+
+			wait = s.cfg.RelayInterval
+		}
+	}()
+}
+
+// Relay publishes unsent events in batches until there are none left and
+// returns how many were published.
+func (s *OutboxRelay) Relay(ctx context.Context) (int, error) {
+	var (
+		relayed   int
+		batchSize = max(s.cfg.BatchSize, 1)
+	)
+
+	for {
+		now := time.Now().UTC()
+
+		events, err := s.outboxRepo.ClaimEvents(ctx, batchSize, now, now.Add(s.cfg.Lease))
+		if err != nil {
+			return relayed, err
+		}
+
+		if len(events) == 0 {
+			return relayed, nil
+		}
+
+		messages := make([]producer.AnalyticsMessage, 0, len(events))
+		IDs := make([]string, 0, len(events))
+
+		for _, event := range events {
+			IDs = append(IDs, event.ID)
+
+			var msg producer.AnalyticsMessage
+			// a malformed event would block the outbox forever, it is marked sent without publishing
+			if err := json.Unmarshal(event.Payload, &msg); err != nil {
+				s.log.Warnf("skipping malformed outbox event %v: %v", event.ID, err)
+				continue
+			}
+			messages = append(messages, msg)
+		}
+
+		if len(messages) > 0 {
+			if err := s.producer.Send(ctx, messages); err != nil {
+				return relayed, err
+			}
+		}
+
+		if err := s.outboxRepo.MarkSent(ctx, IDs, time.Now().UTC()); err != nil {
+			return relayed, err
+		}
+
+		relayed += len(messages)
+
+		if len(events) < batchSize {
+			return relayed, nil
+		}
+	}
+}
+
+// Close stops the relay and publishes what is left in the outbox within DrainTimeout.
+// Events it could not publish stay in the outbox for the next start.
+func (s *OutboxRelay) Close() error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.DrainTimeout)
+	defer cancel()
+
+	n, err := s.Relay(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to drain outbox after %d events: %w", n, err)
+	}
+
+	s.log.Infof("Drained %d outbox events", n)
+
+	return nil
+}
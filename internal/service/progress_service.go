@@ -0,0 +1,305 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/maisiq/go-ugc-service/internal/cache"
+	"github.com/maisiq/go-ugc-service/internal/db"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/producer"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	"github.com/maisiq/go-ugc-service/pkg/config"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// progressDirtyKey is the set of users with positions not flushed to the database yet.
+const progressDirtyKey = "progress:dirty"
+
+// defaultProgressConfig stands in for the settings a config without them leaves at zero.
+var defaultProgressConfig = config.ProgressConfig{FlushInterval: 5 * time.Second, TTL: 72 * time.Hour}
+
+// progressKey is the hash of the user positions, one field per movie.
+func progressKey(UserID string) string {
+	return "progress:" + UserID
+}
+
+// ProgressService tracks where users stopped watching movies. Reports come often,
+// so positions are written to redis only and flushed to the database in the background.
+// Redis holds the latest positions, the database everything flushed so far. Events go
+// to the outbox with the flush, one for every position written to the database.
+type ProgressService struct {
+	progressRepo repository.ProgressRepository
+	outboxRepo   repository.OutboxRepository
+	log          *zap.SugaredLogger
+	cache        *cache.Cache
+	uow          db.UOW
+	cfg          config.ProgressConfig
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+}
+
+func NewProgressService(
+	progressRepo repository.ProgressRepository,
+	outboxRepo repository.OutboxRepository,
+	log *zap.SugaredLogger,
+	cache *cache.Cache,
+	uow db.UOW,
+	cfg config.ProgressConfig,
+) *ProgressService {
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = defaultProgressConfig.FlushInterval
+	}
+	if cfg.TTL <= 0 {
+		cfg.TTL = defaultProgressConfig.TTL
+	}
+
+	return &ProgressService{
+		progressRepo: progressRepo,
+		outboxRepo:   outboxRepo,
+		log:          log,
+		cache:        cache,
+		uow:          uow,
+		cfg:          cfg,
+	}
+}
+
+func (s *ProgressService) ReportProgress(ctx context.Context, UserID, MovieID string, PositionMS, DurationMS int64) error {
+	if PositionMS > DurationMS {
+		return apperrors.ErrInvalidArgument
+	}
+
+	progress := repository.Progress{
+		UserID:     UserID,
+		MovieID:    MovieID,
+		PositionMS: PositionMS,
+		DurationMS: DurationMS,
+		UpdatedAt:  time.Now().UTC(),
+	}
+
+	data, err := json.Marshal(progress)
+	if err != nil {
+		s.log.Errorf("failed to marshal progress: %v", err)
+		return apperrors.ErrInternal
+	}
+
+	_, err = s.cache.Client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, progressKey(UserID), MovieID, data)
+		pipe.Expire(ctx, progressKey(UserID), s.cfg.TTL)
+		pipe.SAdd(ctx, progressDirtyKey, UserID)
+		return nil
+	})
+
+	if err != nil {
+		s.log.Errorf("failed to buffer progress: %v", err)
+		return apperrors.ErrInternal
+	}
+
+	return nil
+}
+
+// GetProgress returns the latest position of the user in the movie.
+func (s *ProgressService) GetProgress(ctx context.Context, UserID, MovieID string) (repository.Progress, error) {
+	data, err := s.cache.Client.HGet(ctx, progressKey(UserID), MovieID).Bytes()
+
+	if err == nil {
+		var progress repository.Progress
+		if err := json.Unmarshal(data, &progress); err == nil {
+			return progress, nil
+		}
+	} else if !errors.Is(err, redis.Nil) {
+		s.log.Warnf("failed to read buffered progress: %v", err)
+	}
+
+	progress, err := s.progressRepo.GetProgress(ctx, UserID, MovieID)
+
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return repository.Progress{}, apperrors.ErrNotFound
+		}
+		s.log.Errorf("failed to get progress: %v", err)
+		return repository.Progress{}, apperrors.ErrInternal
+	}
+
+	return progress, nil
+}
+
+// ListInProgress returns the movies the user started and did not finish, latest first.
+func (s *ProgressService) ListInProgress(ctx context.Context, UserID string) ([]repository.Progress, error) {
+	stored, err := s.progressRepo.ListProgress(ctx, UserID)
+	if err != nil {
+		s.log.Errorf("failed to list progress: %v", err)
+		return []repository.Progress{}, apperrors.ErrInternal
+	}
+
+	latest := make(map[string]repository.Progress, len(stored))
+	for _, progress := range stored {
+		latest[progress.MovieID] = progress
+	}
+
+	buffered, err := s.cache.Client.HGetAll(ctx, progressKey(UserID)).Result()
+	if err != nil {
+		s.log.Warnf("failed to read buffered progress: %v", err)
+	}
+
+	for _, data := range buffered {
+		var progress repository.Progress
+		if err := json.Unmarshal([]byte(data), &progress); err != nil {
+			continue
+		}
+		if current, ok := latest[progress.MovieID]; !ok || progress.UpdatedAt.After(current.UpdatedAt) {
+			latest[progress.MovieID] = progress
+		}
+	}
+
+	inProgress := []repository.Progress{}
+	for _, progress := range latest {
+		if progress.PositionMS > 0 && !progress.Finished() {
+			inProgress = append(inProgress, progress)
+		}
+	}
+
+	slices.SortFunc(inProgress, func(a, b repository.Progress) int {
+		return b.UpdatedAt.Compare(a.UpdatedAt)
+	})
+
+	return inProgress, nil
+}
+
+// Start flushes buffered positions every FlushInterval until Close is called.
+// Without the setting they are flushed every 5 seconds.
+func (s *ProgressService) Start() {
+	s.log.Infof("Flushing buffered progress every %v", s.cfg.FlushInterval)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(s.cfg.FlushInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			if _, err := s.Flush(ctx); err != nil {
+				s.log.Errorf("failed to flush progress: %v", err)
+			}
+		}
+	}()
+}
+
+// Flush writes the buffered positions of every user reported since the last flush
+// to the database together with their events and returns how many positions were
+// written. Users of a failed round are marked again, so their positions go with the next flush.
+func (s *ProgressService) Flush(ctx context.Context) (int, error) {
+	var (
+		flushed   int
+		batchSize = max(s.cfg.BatchSize, 1)
+	)
+
+	for {
+		users, err := s.cache.Client.SPopN(ctx, progressDirtyKey, int64(batchSize)).Result()
+		if err != nil {
+			return flushed, err
+		}
+
+		if len(users) == 0 {
+			return flushed, nil
+		}
+
+		progress, err := s.readBuffered(ctx, users)
+
+		if err == nil {
+			err = s.uow.RunWithinTx(ctx, func(ctx context.Context) error {
+				if err := s.progressRepo.SaveProgress(ctx, progress); err != nil {
+					return err
+				}
+				return enqueue(ctx, s.outboxRepo, progressMessages(progress)...)
+			})
+		}
+
+		if err != nil {
+			members := make([]any, 0, len(users))
+			for _, user := range users {
+				members = append(members, user)
+			}
+			if err := s.cache.Client.SAdd(context.WithoutCancel(ctx), progressDirtyKey, members...).Err(); err != nil {
+				s.log.Errorf("failed to mark %d users for the next flush: %v", len(users), err)
+			}
+			return flushed, err
+		}
+
+		flushed += len(progress)
+
+		// users reporting during the flush are left to the next one
+		if len(users) < batchSize {
+			return flushed, nil
+		}
+	}
+}
+
+func progressMessages(progress []repository.Progress) []producer.AnalyticsMessage {
+	messages := make([]producer.AnalyticsMessage, 0, len(progress))
+	for _, p := range progress {
+		messages = append(messages, producer.NewProgressMessage(p.UpdatedAt, producer.ProgressPayload{
+			UserID:     p.UserID,
+			MovieID:    p.MovieID,
+			PositionMS: p.PositionMS,
+			DurationMS: p.DurationMS,
+		}))
+	}
+	return messages
+}
+
+// readBuffered reads the positions of the users with one pipeline.
+func (s *ProgressService) readBuffered(ctx context.Context, users []string) ([]repository.Progress, error) {
+	cmds := make([]*redis.MapStringStringCmd, 0, len(users))
+
+	_, err := s.cache.Client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, user := range users {
+			cmds = append(cmds, pipe.HGetAll(ctx, progressKey(user)))
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var progress []repository.Progress
+
+	for _, cmd := range cmds {
+		for _, data := range cmd.Val() {
+			var p repository.Progress
+			if err := json.Unmarshal([]byte(data), &p); err != nil {
+				s.log.Warnf("skipping malformed buffered progress: %v", err)
+				continue
+			}
+			progress = append(progress, p)
+		}
+	}
+
+	return progress, nil
+}
+
+// Close stops the flush job and waits for the running flush to finish. Positions
+// left in the buffer stay marked in redis and go with the next flush after a restart.
+func (s *ProgressService) Close() error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	return nil
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/maisiq/go-ugc-service/internal/db"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	"github.com/maisiq/go-ugc-service/pkg/config"
+	"go.uber.org/zap"
+)
+
+// purgeBatchSize is the number of reviews removed in one transaction.
+const purgeBatchSize = 100
+
+// PurgeService removes soft deleted reviews for good once the retention period is over.
+// Their rating and search entries are gone already, only the reviews themselves are left
+// with the votes and comments on them.
+type PurgeService struct {
+	userRepo       repository.ReviewRepository
+	movieRepo      repository.ReviewRepository
+	moderationRepo repository.ModerationRepository
+	voteRepo       repository.VoteRepository
+	commentRepo    repository.CommentRepository
+	log            *zap.SugaredLogger
+	uow            db.UOW
+	cfg            config.SoftDeleteConfig
+	cancel         context.CancelFunc
+	wg             sync.WaitGroup
+}
+
+func NewPurgeService(
+	userRepo repository.ReviewRepository,
+	movieRepo repository.ReviewRepository,
+	moderationRepo repository.ModerationRepository,
+	voteRepo repository.VoteRepository,
+	commentRepo repository.CommentRepository,
+	log *zap.SugaredLogger,
+	uow db.UOW,
+	cfg config.SoftDeleteConfig,
+) *PurgeService {
+	return &PurgeService{
+		userRepo:       userRepo,
+		movieRepo:      movieRepo,
+		moderationRepo: moderationRepo,
+		voteRepo:       voteRepo,
+		commentRepo:    commentRepo,
+		log:            log,
+		uow:            uow,
+		cfg:            cfg,
+	}
+}
+
+// Start runs the purge every PurgeInterval until Close is called.
+// Nothing is started when the retention period is not set.
+func (s *PurgeService) Start() {
+	if s.cfg.Retention <= 0 || s.cfg.PurgeInterval <= 0 {
+		s.log.Info("Purge of soft deleted reviews is off")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(s.cfg.PurgeInterval)
+		defer ticker.Stop()
+
+		for {
+			if n, err := s.Purge(ctx); err != nil {
+				s.log.Errorf("failed to purge deleted reviews: %v", err)
+			} else if n > 0 {
+				s.log.Infof("Purged %d deleted reviews", n)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Purge removes every review soft deleted longer than the retention period ago
+// and returns how many were removed.
+func (s *PurgeService) Purge(ctx context.Context) (int, error) {
+	before := time.Now().UTC().Add(-s.cfg.Retention)
+	var purged int
+
+	for {
+		reviews, err := s.moderationRepo.ListDeleted(ctx, before, purgeBatchSize)
+		if err != nil {
+			return purged, err
+		}
+
+		if len(reviews) == 0 {
+			return purged, nil
+		}
+
+		err = s.uow.RunWithinTx(ctx, func(ctx context.Context) error {
+			for _, review := range reviews {
+				// a review restored or deleted again since it was listed keeps its votes and comments
+				deleted, err := s.movieRepo.GetDeletedReview(ctx, review.UserID, review.MovieID)
+				if errors.Is(err, repository.ErrNotFound) || err == nil && !deleted.DeletedAt.Before(before) {
+					continue
+				} else if err != nil {
+					return err
+				}
+
+				if err := s.userRepo.PurgeReview(ctx, review.UserID, review.MovieID, before); err != nil {
+					return err
+				}
+				if err := s.movieRepo.PurgeReview(ctx, review.UserID, review.MovieID, before); err != nil {
+					return err
+				}
+				if err := dropActivity(ctx, s.voteRepo, s.commentRepo, review.UserID, review.MovieID); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+
+		if err != nil {
+			return purged, err
+		}
+		purged += len(reviews)
+
+		if len(reviews) < purgeBatchSize {
+			return purged, nil
+		}
+	}
+}
+
+// Close stops the purge job and waits for the running purge to finish.
+func (s *PurgeService) Close() error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	return nil
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/maisiq/go-ugc-service/internal/db"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/producer"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	"go.uber.org/zap"
+)
+
+type ReportService struct {
+	reviewRepo repository.ReviewRepository
+	reportRepo repository.ReportRepository
+	outboxRepo repository.OutboxRepository
+	moderation *ModerationService
+	log        *zap.SugaredLogger
+	uow        db.UOW
+	threshold  int
+}
+
+func NewReportService(
+	reviewRepo repository.ReviewRepository,
+	reportRepo repository.ReportRepository,
+	outboxRepo repository.OutboxRepository,
+	moderation *ModerationService,
+	log *zap.SugaredLogger,
+	uow db.UOW,
+	threshold int,
+) *ReportService {
+	return &ReportService{
+		reviewRepo: reviewRepo,
+		reportRepo: reportRepo,
+		outboxRepo: outboxRepo,
+		moderation: moderation,
+		log:        log,
+		uow:        uow,
+		threshold:  threshold,
+	}
+}
+
+// ReportReview files a report of ReporterID about the review. Repeated reports
+// of the same reporter are ignored. Every new report once the number of distinct
+// reporters reached the threshold hides the review until a moderator acts, so a
+// count that skipped past the threshold under concurrent reports still hides it.
+// Only reports filed after the last moderation decision count, a review a moderator
+// approved is hidden again only when as many new reports come in.
+// Hiding a review that is hidden or rejected already changes nothing.
+func (s *ReportService) ReportReview(ctx context.Context, ReporterID, UserID, MovieID string, Reason repository.ReportReason, Text string) error {
+	if ReporterID == UserID {
+		return apperrors.ErrInvalidArgument
+	}
+
+	review, err := s.reviewRepo.GetReview(ctx, UserID, MovieID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return apperrors.ErrNotFound
+		}
+		s.log.Errorf("failed to get reported review: %v", err)
+		return apperrors.ErrInternal
+	}
+
+	var created bool
+
+	err = s.uow.RunWithinTx(ctx, func(ctx context.Context) error {
+		var err error
+
+		created, err = s.reportRepo.AddReport(ctx, repository.Report{
+			ReporterID: ReporterID,
+			UserID:     UserID,
+			MovieID:    MovieID,
+			Reason:     Reason,
+			Text:       Text,
+			CreatedAt:  time.Now().UTC(),
+		})
+		if err != nil || !created {
+			return err
+		}
+
+		return enqueue(ctx, s.outboxRepo, producer.NewReportMessage(time.Now(), producer.ReportPayload{
+			ReporterID: ReporterID, UserID: UserID, MovieID: MovieID, Reason: string(Reason),
+		}))
+	})
+	if err != nil {
+		s.log.Errorf("failed to add report: %v", err)
+		return apperrors.ErrInternal
+	}
+
+	if !created {
+		return nil
+	}
+
+	if s.threshold <= 0 {
+		return nil
+	}
+
+	var moderatedAt time.Time
+	if review.ModeratedAt != nil {
+		moderatedAt = *review.ModeratedAt
+	}
+
+	n, err := s.reportRepo.CountReports(ctx, UserID, MovieID, moderatedAt)
+	if err != nil {
+		s.log.Errorf("failed to count reports: %v", err)
+		return nil
+	}
+
+	if n >= int64(s.threshold) {
+		err = s.moderation.HideReview(ctx, UserID, MovieID, fmt.Sprintf("reported by %d users", n))
+
+		// rejected reviews are already out of sight
+		if err != nil && !errors.Is(err, apperrors.ErrFailedPrecondition) {
+			s.log.Errorf("failed to hide reported review: %v", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/pagination"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	"github.com/maisiq/go-ugc-service/internal/search"
+	"go.uber.org/zap"
+)
+
+// snippetWidth is the number of characters of review text shown around the first match.
+const snippetWidth = 160
+
+type SearchService struct {
+	searchRepo repository.SearchRepository
+	log        *zap.SugaredLogger
+	paginator  *pagination.Paginator
+}
+
+func NewSearchService(searchRepo repository.SearchRepository, log *zap.SugaredLogger, paginator *pagination.Paginator) *SearchService {
+	return &SearchService{
+		searchRepo: searchRepo,
+		log:        log,
+		paginator:  paginator,
+	}
+}
+
+// SearchReviews returns a page of visible reviews matching Query, most relevant first,
+// optionally limited to the reviews of one user or one movie.
+func (s *SearchService) SearchReviews(ctx context.Context, Query, UserID, MovieID string, PageSize int32, PageToken string) ([]repository.SearchHit, string, error) {
+	offset, err := s.paginator.Offset(PageToken, "search", Query, MovieID, UserID)
+	if err != nil {
+		return []repository.SearchHit{}, "", apperrors.ErrInvalidArgument
+	}
+	limit := s.paginator.PageSize(PageSize)
+
+	// one extra hit tells whether there is a next page
+	hits, err := s.searchRepo.Search(ctx, Query, UserID, MovieID, offset, limit+1)
+	if err != nil {
+		s.log.Errorf("failed to search reviews: %v", err)
+		return []repository.SearchHit{}, "", apperrors.ErrInternal
+	}
+
+	var nextPageToken string
+
+	if len(hits) > limit {
+		hits = hits[:limit]
+		nextPageToken = s.paginator.NextToken(offset+limit, "search", Query, MovieID, UserID)
+	}
+
+	for i := range hits {
+		hits[i].Snippet = search.Snippet(hits[i].Text, Query, snippetWidth)
+	}
+
+	return hits, nextPageToken, nil
+}
@@ -0,0 +1,101 @@
+package unit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/maisiq/go-ugc-service/internal/cache"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddComment(t *testing.T) {
+	t.Parallel()
+	var (
+		userID   = gofakeit.UUID()
+		movieID  = gofakeit.UUID()
+		authorID = gofakeit.UUID()
+		parentID = gofakeit.LetterN(24)
+		text     = gofakeit.Comment()
+		ctx      = context.Background()
+	)
+
+	t.Run("Add comment stores it and drops cached pages", func(t *testing.T) {
+		t.Parallel()
+
+		rs := miniredis.RunT(t)
+		c := redis.NewClient(&redis.Options{Addr: rs.Addr()})
+		cache := &cache.Cache{Client: c}
+
+		reviewMocked := repoMocks.NewReviewRepositoryMock(t)
+		commentMocked := repoMocks.NewCommentRepositoryMock(t)
+		s := service.NewCommentService(reviewMocked, commentMocked, nil, cache, nil)
+
+		reviewMocked.GetReviewMock.Expect(ctx, userID, movieID).Return(repository.Review{}, nil)
+		commentMocked.AddCommentMock.Set(func(ctx context.Context, comment repository.Comment) (repository.Comment, error) {
+			require.Equal(t, text, comment.Text)
+			require.Equal(t, authorID, comment.AuthorID)
+			require.False(t, comment.CreatedAt.IsZero())
+			comment.ID = parentID
+			return comment, nil
+		})
+
+		comment, err := s.AddComment(ctx, userID, movieID, authorID, "", text)
+
+		require.NoError(t, err)
+		require.Equal(t, parentID, comment.ID)
+		requireBumped(t, rs, "cache:comment:"+movieID+":"+userID)
+	})
+
+	t.Run("Add comment to missing review returns ErrNotFound", func(t *testing.T) {
+		t.Parallel()
+
+		reviewMocked := repoMocks.NewReviewRepositoryMock(t)
+		s := service.NewCommentService(reviewMocked, nil, nil, nil, nil)
+		reviewMocked.GetReviewMock.Return(repository.Review{}, repository.ErrNotFound)
+
+		_, err := s.AddComment(ctx, userID, movieID, authorID, "", text)
+
+		require.ErrorIs(t, err, apperrors.ErrNotFound)
+	})
+
+	t.Run("Reply to a reply returns ErrInvalidArgument", func(t *testing.T) {
+		t.Parallel()
+
+		reviewMocked := repoMocks.NewReviewRepositoryMock(t)
+		commentMocked := repoMocks.NewCommentRepositoryMock(t)
+		s := service.NewCommentService(reviewMocked, commentMocked, nil, nil, nil)
+
+		reviewMocked.GetReviewMock.Return(repository.Review{}, nil)
+		commentMocked.GetCommentMock.Expect(ctx, parentID).Return(repository.Comment{
+			ID: parentID, ReviewUserID: userID, MovieID: movieID, ParentID: gofakeit.LetterN(24),
+		}, nil)
+
+		_, err := s.AddComment(ctx, userID, movieID, authorID, parentID, text)
+
+		require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	})
+
+	t.Run("Reply to a comment of another review returns ErrInvalidArgument", func(t *testing.T) {
+		t.Parallel()
+
+		reviewMocked := repoMocks.NewReviewRepositoryMock(t)
+		commentMocked := repoMocks.NewCommentRepositoryMock(t)
+		s := service.NewCommentService(reviewMocked, commentMocked, nil, nil, nil)
+
+		reviewMocked.GetReviewMock.Return(repository.Review{}, nil)
+		commentMocked.GetCommentMock.Return(repository.Comment{
+			ID: parentID, ReviewUserID: gofakeit.UUID(), MovieID: movieID,
+		}, nil)
+
+		_, err := s.AddComment(ctx, userID, movieID, authorID, parentID, text)
+
+		require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	})
+}
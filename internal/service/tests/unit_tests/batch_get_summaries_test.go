@@ -0,0 +1,89 @@
+package unit_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/maisiq/go-ugc-service/internal/cache"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/maisiq/go-ugc-service/pkg/config"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestBatchGetMovieReviewSummaries(t *testing.T) {
+	t.Parallel()
+	var (
+		cachedID  = gofakeit.UUID()
+		ratedID   = gofakeit.UUID()
+		unratedID = gofakeit.UUID()
+		ctx       = context.Background()
+		logger, _ = zap.NewDevelopment()
+	)
+
+	t.Run("Batch get reads cached summaries and fetches the rest in one query", func(t *testing.T) {
+		t.Parallel()
+		rs := miniredis.RunT(t)
+		c := &cache.Cache{Client: redis.NewClient(&redis.Options{Addr: rs.Addr()})}
+		cached, _ := json.Marshal(repository.ReviewSummary{MovieID: cachedID, Count: 2, Sum: 15})
+		rs.Set(fmt.Sprintf("cache:review:%v:summary", cachedID), string(cached))
+
+		ratingMocked := repoMocks.NewRatingRepositoryMock(t)
+		s := service.NewUGCService(nil, nil, ratingMocked, nil, nil, nil, nil, nil, nil, nil, c, nil, nil, config.ModerationConfig{}, nil)
+
+		ratingMocked.GetSummariesMock.Expect(ctx, []string{ratedID, unratedID}).Return([]repository.ReviewSummary{
+			{MovieID: ratedID, Count: 4, Sum: 30},
+		}, nil)
+
+		summaries, err := s.BatchGetMovieReviewSummaries(ctx, []string{ratedID, cachedID, unratedID})
+		require.NoError(t, err)
+
+		require.Equal(t, []repository.ReviewSummary{
+			{MovieID: ratedID, Count: 4, Sum: 30},
+			{MovieID: cachedID, Count: 2, Sum: 15},
+			{MovieID: unratedID},
+		}, summaries)
+		require.True(t, rs.Exists(fmt.Sprintf("cache:review:%v:summary", ratedID)))
+		require.True(t, rs.Exists(fmt.Sprintf("cache:review:%v:summary", unratedID)))
+	})
+
+	t.Run("Batch get does not query the database when everything is cached", func(t *testing.T) {
+		t.Parallel()
+		rs := miniredis.RunT(t)
+		c := &cache.Cache{Client: redis.NewClient(&redis.Options{Addr: rs.Addr()})}
+		cached, _ := json.Marshal(repository.ReviewSummary{MovieID: cachedID, Count: 1, Sum: 7})
+		rs.Set(fmt.Sprintf("cache:review:%v:summary", cachedID), string(cached))
+
+		ratingMocked := repoMocks.NewRatingRepositoryMock(t)
+		s := service.NewUGCService(nil, nil, ratingMocked, nil, nil, nil, nil, nil, nil, nil, c, nil, nil, config.ModerationConfig{}, nil)
+
+		summaries, err := s.BatchGetMovieReviewSummaries(ctx, []string{cachedID})
+		require.NoError(t, err)
+
+		require.Equal(t, []repository.ReviewSummary{{MovieID: cachedID, Count: 1, Sum: 7}}, summaries)
+		require.Zero(t, ratingMocked.GetSummariesAfterCounter())
+	})
+
+	t.Run("Batch get returns internal error", func(t *testing.T) {
+		t.Parallel()
+		rs := miniredis.RunT(t)
+		c := &cache.Cache{Client: redis.NewClient(&redis.Options{Addr: rs.Addr()})}
+
+		ratingMocked := repoMocks.NewRatingRepositoryMock(t)
+		s := service.NewUGCService(nil, nil, ratingMocked, nil, nil, nil, nil, nil, logger.Sugar(), nil, c, nil, nil, config.ModerationConfig{}, nil)
+
+		ratingMocked.GetSummariesMock.Return(nil, fmt.Errorf("arbitrary error"))
+
+		_, err := s.BatchGetMovieReviewSummaries(ctx, []string{ratedID})
+
+		require.ErrorIs(t, err, apperrors.ErrInternal)
+	})
+}
@@ -0,0 +1,165 @@
+package unit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/pagination"
+	"github.com/maisiq/go-ugc-service/internal/producer"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/maisiq/go-ugc-service/pkg/config"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestBookmarks(t *testing.T) {
+	t.Parallel()
+	var (
+		userID    = gofakeit.UUID()
+		movieID   = gofakeit.UUID()
+		ctx       = context.Background()
+		logger, _ = zap.NewDevelopment()
+		paginator = pagination.New(config.PaginationConfig{DefaultPageSize: 20, MaxPageSize: 100, TokenSecret: "secret"})
+	)
+
+	newUOW := func(t *testing.T) *repoMocks.UOWMock {
+		uowMocked := repoMocks.NewUOWMock(t)
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		return uowMocked
+	}
+
+	t.Run("Add bookmark drops cached listings and enqueues an event", func(t *testing.T) {
+		t.Parallel()
+
+		c, rs := newCache(t)
+		bookmarkMocked := repoMocks.NewBookmarkRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		s := service.NewBookmarkService(bookmarkMocked, outboxMocked, logger.Sugar(), c, newUOW(t), paginator)
+
+		bookmarkMocked.AddBookmarkMock.Set(func(ctx context.Context, bookmark repository.Bookmark) error {
+			require.Equal(t, userID, bookmark.UserID)
+			require.Equal(t, movieID, bookmark.MovieID)
+			require.False(t, bookmark.CreatedAt.IsZero())
+			return nil
+		})
+		outboxMocked.AddEventsMock.Set(func(ctx context.Context, events []repository.OutboxEvent) error {
+			messages := outboxMessages(t, events)
+			require.Len(t, messages, 1)
+			require.Equal(t, producer.EventBookmarkAdded, messages[0].EventType)
+			require.Equal(t, movieID, messages[0].Bookmark.MovieID)
+			return nil
+		})
+
+		err := s.AddBookmark(ctx, userID, movieID)
+		require.NoError(t, err)
+
+		requireBumped(t, rs, "cache:bookmark:"+userID)
+	})
+
+	t.Run("Add bookmark twice returns ErrAlreadyExists", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newCache(t)
+		bookmarkMocked := repoMocks.NewBookmarkRepositoryMock(t)
+		s := service.NewBookmarkService(bookmarkMocked, nil, logger.Sugar(), c, newUOW(t), paginator)
+
+		bookmarkMocked.AddBookmarkMock.Return(repository.ErrAlreadyExists)
+
+		err := s.AddBookmark(ctx, userID, movieID)
+
+		require.ErrorIs(t, err, apperrors.ErrAlreadyExists)
+	})
+
+	t.Run("Remove bookmark enqueues an event", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newCache(t)
+		bookmarkMocked := repoMocks.NewBookmarkRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		s := service.NewBookmarkService(bookmarkMocked, outboxMocked, logger.Sugar(), c, newUOW(t), paginator)
+
+		bookmarkMocked.RemoveBookmarkMock.Expect(ctx, userID, movieID).Return(nil)
+		outboxMocked.AddEventsMock.Set(func(ctx context.Context, events []repository.OutboxEvent) error {
+			require.Equal(t, producer.EventBookmarkRemoved, outboxMessages(t, events)[0].EventType)
+			return nil
+		})
+
+		err := s.RemoveBookmark(ctx, userID, movieID)
+		require.NoError(t, err)
+	})
+
+	t.Run("Remove missing bookmark returns ErrNotFound", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newCache(t)
+		bookmarkMocked := repoMocks.NewBookmarkRepositoryMock(t)
+		s := service.NewBookmarkService(bookmarkMocked, nil, logger.Sugar(), c, newUOW(t), paginator)
+
+		bookmarkMocked.RemoveBookmarkMock.Return(repository.ErrNotFound)
+
+		err := s.RemoveBookmark(ctx, userID, movieID)
+
+		require.ErrorIs(t, err, apperrors.ErrNotFound)
+	})
+
+	t.Run("List bookmarks pages and caches the result", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newCache(t)
+		bookmarkMocked := repoMocks.NewBookmarkRepositoryMock(t)
+		s := service.NewBookmarkService(bookmarkMocked, nil, logger.Sugar(), c, nil, paginator)
+
+		now := time.Now().UTC().Truncate(time.Millisecond)
+		stored := []repository.Bookmark{
+			{UserID: userID, MovieID: gofakeit.UUID(), CreatedAt: now},
+			{UserID: userID, MovieID: gofakeit.UUID(), CreatedAt: now.Add(-time.Minute)},
+			{UserID: userID, MovieID: gofakeit.UUID(), CreatedAt: now.Add(-time.Hour)},
+		}
+
+		bookmarkMocked.ListBookmarksMock.Expect(ctx, userID, 0, 3).Return(stored, nil)
+
+		bookmarks, nextPageToken, err := s.ListBookmarks(ctx, userID, 2, "")
+
+		require.NoError(t, err)
+		require.Equal(t, stored[:2], bookmarks)
+		require.NotEmpty(t, nextPageToken)
+
+		cached, _, err := s.ListBookmarks(ctx, userID, 2, "")
+
+		require.NoError(t, err)
+		require.Equal(t, bookmarks, cached)
+		require.Equal(t, uint64(1), bookmarkMocked.ListBookmarksAfterCounter())
+	})
+
+	t.Run("List bookmarks with invalid page token returns ErrInvalidArgument", func(t *testing.T) {
+		t.Parallel()
+
+		s := service.NewBookmarkService(nil, nil, logger.Sugar(), nil, nil, paginator)
+
+		_, _, err := s.ListBookmarks(ctx, userID, 0, "invalid")
+
+		require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	})
+
+	t.Run("List bookmarks with unexpected error returns ErrInternal", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newCache(t)
+		bookmarkMocked := repoMocks.NewBookmarkRepositoryMock(t)
+		s := service.NewBookmarkService(bookmarkMocked, nil, logger.Sugar(), c, nil, paginator)
+
+		bookmarkMocked.ListBookmarksMock.Return(nil, errors.New("unexpected error"))
+
+		_, _, err := s.ListBookmarks(ctx, userID, 0, "")
+
+		require.ErrorIs(t, err, apperrors.ErrInternal)
+	})
+}
@@ -8,11 +8,12 @@ import (
 
 	"github.com/brianvoe/gofakeit/v7"
 	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/filter"
 	"github.com/maisiq/go-ugc-service/internal/producer"
-	prodMocks "github.com/maisiq/go-ugc-service/internal/producer/mocks"
 	"github.com/maisiq/go-ugc-service/internal/repository"
 	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
 	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/maisiq/go-ugc-service/pkg/config"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
@@ -23,87 +24,216 @@ func TestCreateReview(t *testing.T) {
 		userID     = gofakeit.UUID()
 		movieID    = gofakeit.UUID()
 		reviewText = gofakeit.Comment()
+		rating     = int32(gofakeit.IntRange(1, 10))
 		ctx        = context.Background()
 		logger, _  = zap.NewDevelopment()
 		_          = []producer.AnalyticsMessage{
-			{UserID: userID, MovieID: movieID, TimestampMS: time.Now().Unix()},
+			producer.NewReviewMessage(producer.EventReviewCreated, time.Now(), producer.ReviewPayload{UserID: userID, MovieID: movieID}),
 		}
 	)
 
 	t.Run("Create review returns no error", func(t *testing.T) {
 		t.Parallel()
 		uowMocked := repoMocks.NewUOWMock(t)
-		producerMocked := prodMocks.NewProducerMock(t)
-		s := service.NewUGCService(nil, nil, nil, producerMocked, nil, uowMocked)
-		done := make(chan struct{})
+		c, _ := newCache(t)
+		s := service.NewUGCService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, c, uowMocked, nil, config.ModerationConfig{}, nil)
 
 		uowMocked.RunWithinTxMock.Return(nil)
-		producerMocked.WriteMessagesMock.Set(func(ctx context.Context, cancel context.CancelFunc, messages []producer.AnalyticsMessage) {
-			close(done)
-		})
 
-		err := s.CreateReview(ctx, userID, movieID, reviewText)
+		err := s.CreateReview(ctx, userID, movieID, reviewText, rating)
 		require.NoError(t, err)
-
-		<-done
 	})
 
 	t.Run("Create review returns ErrAlreadyExists", func(t *testing.T) {
 		t.Parallel()
 		uowMocked := repoMocks.NewUOWMock(t)
-		producerMocked := prodMocks.NewProducerMock(t)
-		s := service.NewUGCService(nil, nil, nil, producerMocked, nil, uowMocked)
+		s := service.NewUGCService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, uowMocked, nil, config.ModerationConfig{}, nil)
 
 		uowMocked.RunWithinTxMock.Return(repository.ErrAlreadyExists)
 
-		err := s.CreateReview(ctx, userID, movieID, reviewText)
+		err := s.CreateReview(ctx, userID, movieID, reviewText, rating)
 		require.ErrorIs(t, err, apperrors.ErrAlreadyExists)
 	})
 
 	t.Run("Create review returns internal error", func(t *testing.T) {
 		t.Parallel()
 		uowMocked := repoMocks.NewUOWMock(t)
-		producerMocked := prodMocks.NewProducerMock(t)
-		s := service.NewUGCService(nil, nil, logger.Sugar(), producerMocked, nil, uowMocked)
+		s := service.NewUGCService(nil, nil, nil, nil, nil, nil, nil, nil, logger.Sugar(), nil, nil, uowMocked, nil, config.ModerationConfig{}, nil)
 
 		uowMocked.RunWithinTxMock.Return(fmt.Errorf("arbitrary error"))
 
-		err := s.CreateReview(ctx, userID, movieID, reviewText)
+		err := s.CreateReview(ctx, userID, movieID, reviewText, rating)
 		require.ErrorIs(t, err, apperrors.ErrInternal)
 
 	})
 
-	t.Run("Create review method writes message to the broker", func(t *testing.T) {
+	t.Run("Create review writes the event to the outbox within the transaction and drops cached listings", func(t *testing.T) {
 		t.Parallel()
 		uowMocked := repoMocks.NewUOWMock(t)
-		producerMocked := prodMocks.NewProducerMock(t)
-		s := service.NewUGCService(nil, nil, nil, producerMocked, nil, uowMocked)
-		done := make(chan struct{})
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		ratingMocked := repoMocks.NewRatingRepositoryMock(t)
+		searchMocked := repoMocks.NewSearchRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		c, rs := newCache(t)
+		votesMocked, commentsMocked := droppedActivity(t)
+		s := service.NewUGCService(userRepoMocked, movieRepoMocked, ratingMocked, searchMocked, nil, outboxMocked, votesMocked, commentsMocked, nil, nil, c, uowMocked, nil, config.ModerationConfig{}, nil)
 
-		uowMocked.RunWithinTxMock.Return(nil)
-		producerMocked.WriteMessagesMock.Set(func(ctx context.Context, cancel context.CancelFunc, msgs []producer.AnalyticsMessage) {
-			defer close(done)
-			if len(msgs) != 1 {
-				t.Errorf("expected 1 message, got %d", len(msgs))
-				return
-			}
-
-			msg := msgs[0]
-
-			if msg.UserID != userID || msg.MovieID != movieID {
-				t.Errorf("unexpected user or movie ID: %+v", msg)
-			}
-
-			now := time.Now().Unix()
-			if msg.TimestampMS < now-2 || msg.TimestampMS > now+2 {
-				t.Errorf("timestamp is not recent: %d", msg.TimestampMS)
-			}
+		rs.Set(fmt.Sprintf("cache:review:%v:summary", movieID), "{}")
+
+		type txKey struct{}
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(context.WithValue(ctx, txKey{}, true))
+		})
+		userRepoMocked.CreateReviewMock.Return(nil)
+		movieRepoMocked.CreateReviewMock.Return(nil)
+		searchMocked.IndexReviewMock.Return(nil)
+		ratingMocked.UpdateRatingMock.Return(nil)
+		outboxMocked.AddEventsMock.Set(func(ctx context.Context, events []repository.OutboxEvent) error {
+			require.Equal(t, true, ctx.Value(txKey{}), "the event has to be written within the transaction")
+
+			msgs := outboxMessages(t, events)
+			require.Len(t, msgs, 1)
+			require.Equal(t, producer.EventReviewCreated, msgs[0].EventType)
+			require.Equal(t, int32(producer.SchemaVersion), msgs[0].SchemaVersion)
+			require.Equal(t, events[0].ID, msgs[0].EventID)
+			require.WithinDuration(t, time.Now(), msgs[0].OccurredAt, 2*time.Second)
+			require.Equal(t, userID, msgs[0].Review.UserID)
+			require.Equal(t, movieID, msgs[0].Review.MovieID)
+			require.Equal(t, rating, msgs[0].Review.Rating)
+			return nil
+		})
+
+		err := s.CreateReview(ctx, userID, movieID, reviewText, rating)
+		require.NoError(t, err)
+		require.False(t, rs.Exists(fmt.Sprintf("cache:review:%v:summary", movieID)))
+		requireBumped(t, rs, "cache:review:"+movieID, "cache:review:"+userID)
+	})
+
+	t.Run("Create review fails when the outbox write fails", func(t *testing.T) {
+		t.Parallel()
+		uowMocked := repoMocks.NewUOWMock(t)
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		ratingMocked := repoMocks.NewRatingRepositoryMock(t)
+		searchMocked := repoMocks.NewSearchRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		votesMocked, commentsMocked := droppedActivity(t)
+		s := service.NewUGCService(userRepoMocked, movieRepoMocked, ratingMocked, searchMocked, nil, outboxMocked, votesMocked, commentsMocked, logger.Sugar(), nil, nil, uowMocked, nil, config.ModerationConfig{}, nil)
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		userRepoMocked.CreateReviewMock.Return(nil)
+		movieRepoMocked.CreateReviewMock.Return(nil)
+		searchMocked.IndexReviewMock.Return(nil)
+		ratingMocked.UpdateRatingMock.Return(nil)
+		outboxMocked.AddEventsMock.Return(fmt.Errorf("arbitrary error"))
+
+		err := s.CreateReview(ctx, userID, movieID, reviewText, rating)
+		require.ErrorIs(t, err, apperrors.ErrInternal)
+	})
+
+	t.Run("Create review sets timestamps", func(t *testing.T) {
+		t.Parallel()
+		uowMocked := repoMocks.NewUOWMock(t)
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		ratingMocked := repoMocks.NewRatingRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		searchMocked := repoMocks.NewSearchRepositoryMock(t)
+		c, _ := newCache(t)
+		votesMocked, commentsMocked := droppedActivity(t)
+		s := service.NewUGCService(userRepoMocked, movieRepoMocked, ratingMocked, searchMocked, nil, outboxMocked, votesMocked, commentsMocked, nil, nil, c, uowMocked, nil, config.ModerationConfig{}, nil)
+
+		checkReview := func(ctx context.Context, review repository.Review) error {
+			require.False(t, review.CreatedAt.IsZero())
+			require.Equal(t, review.CreatedAt, review.UpdatedAt)
+			return nil
+		}
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
 		})
+		searchMocked.IndexReviewMock.Return(nil)
+		userRepoMocked.CreateReviewMock.Set(checkReview)
+		movieRepoMocked.CreateReviewMock.Set(checkReview)
+		ratingMocked.UpdateRatingMock.Expect(ctx, movieID, 0, rating).Return(nil)
+		outboxMocked.AddEventsMock.Return(nil)
 
-		err := s.CreateReview(ctx, userID, movieID, reviewText)
+		err := s.CreateReview(ctx, userID, movieID, reviewText, rating)
 		require.NoError(t, err)
+	})
+
+	t.Run("Create review holds it for moderation without touching the rating", func(t *testing.T) {
+		t.Parallel()
+		uowMocked := repoMocks.NewUOWMock(t)
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		ratingMocked := repoMocks.NewRatingRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		searchMocked := repoMocks.NewSearchRepositoryMock(t)
+		c, _ := newCache(t)
+		votesMocked, commentsMocked := droppedActivity(t)
+		s := service.NewUGCService(userRepoMocked, movieRepoMocked, ratingMocked, searchMocked, nil, outboxMocked, votesMocked, commentsMocked, nil, nil, c, uowMocked, nil, config.ModerationConfig{PreModerate: true}, nil)
+
+		checkReview := func(ctx context.Context, review repository.Review) error {
+			require.Equal(t, repository.StatusPending, review.Status)
+			return nil
+		}
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		searchMocked.IndexReviewMock.Return(nil)
+		userRepoMocked.CreateReviewMock.Set(checkReview)
+		movieRepoMocked.CreateReviewMock.Set(checkReview)
+		outboxMocked.AddEventsMock.Return(nil)
+
+		err := s.CreateReview(ctx, userID, movieID, reviewText, rating)
+		require.NoError(t, err)
+
+		require.Zero(t, ratingMocked.UpdateRatingAfterCounter())
+	})
 
-		<-done
+	t.Run("Create review rejected by a content filter returns ErrContentRejected", func(t *testing.T) {
+		t.Parallel()
+		filters, _ := filter.NewChain([]config.ContentFilterConfig{{Name: "length", MaxLength: 3}})
+		s := service.NewUGCService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, config.ModerationConfig{}, filters)
+
+		err := s.CreateReview(ctx, userID, movieID, "too long", rating)
+
+		require.ErrorIs(t, err, apperrors.ErrContentRejected)
 	})
 
+	t.Run("Create review flagged by a content filter is held for moderation", func(t *testing.T) {
+		t.Parallel()
+		uowMocked := repoMocks.NewUOWMock(t)
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		filters, _ := filter.NewChain([]config.ContentFilterConfig{{Name: "links", MaxLinks: 0}})
+		searchMocked := repoMocks.NewSearchRepositoryMock(t)
+		c, _ := newCache(t)
+		votesMocked, commentsMocked := droppedActivity(t)
+		s := service.NewUGCService(userRepoMocked, movieRepoMocked, nil, searchMocked, nil, outboxMocked, votesMocked, commentsMocked, nil, nil, c, uowMocked, nil, config.ModerationConfig{}, filters)
+
+		checkReview := func(ctx context.Context, review repository.Review) error {
+			require.Equal(t, repository.StatusPending, review.Status)
+			require.NotEmpty(t, review.ModerationReason)
+			return nil
+		}
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		searchMocked.IndexReviewMock.Return(nil)
+		userRepoMocked.CreateReviewMock.Set(checkReview)
+		movieRepoMocked.CreateReviewMock.Set(checkReview)
+		outboxMocked.AddEventsMock.Return(nil)
+
+		err := s.CreateReview(ctx, userID, movieID, "see https://example.com", rating)
+		require.NoError(t, err)
+	})
 }
@@ -0,0 +1,56 @@
+package unit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/maisiq/go-ugc-service/internal/cache"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteComment(t *testing.T) {
+	t.Parallel()
+	var (
+		commentID = gofakeit.LetterN(24)
+		authorID  = gofakeit.UUID()
+		ctx       = context.Background()
+		stored    = repository.Comment{ID: commentID, AuthorID: authorID, ReviewUserID: gofakeit.UUID(), MovieID: gofakeit.UUID()}
+	)
+
+	t.Run("Delete comment removes it", func(t *testing.T) {
+		t.Parallel()
+
+		rs := miniredis.RunT(t)
+		c := redis.NewClient(&redis.Options{Addr: rs.Addr()})
+		cache := &cache.Cache{Client: c}
+
+		commentMocked := repoMocks.NewCommentRepositoryMock(t)
+		s := service.NewCommentService(nil, commentMocked, nil, cache, nil)
+
+		commentMocked.GetCommentMock.Expect(ctx, commentID).Return(stored, nil)
+		commentMocked.DeleteCommentMock.Expect(ctx, commentID).Return(nil)
+
+		err := s.DeleteComment(ctx, commentID, authorID)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Delete comment of another author returns ErrPermissionDenied", func(t *testing.T) {
+		t.Parallel()
+
+		commentMocked := repoMocks.NewCommentRepositoryMock(t)
+		s := service.NewCommentService(nil, commentMocked, nil, nil, nil)
+		commentMocked.GetCommentMock.Return(stored, nil)
+
+		err := s.DeleteComment(ctx, commentID, gofakeit.UUID())
+
+		require.ErrorIs(t, err, apperrors.ErrPermissionDenied)
+	})
+}
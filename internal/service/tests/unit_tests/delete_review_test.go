@@ -0,0 +1,133 @@
+package unit_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/maisiq/go-ugc-service/internal/cache"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/producer"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/maisiq/go-ugc-service/pkg/config"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestDeleteReview(t *testing.T) {
+	t.Parallel()
+	var (
+		userID    = gofakeit.UUID()
+		movieID   = gofakeit.UUID()
+		rating    = int32(gofakeit.IntRange(1, 10))
+		ctx       = context.Background()
+		logger, _ = zap.NewDevelopment()
+	)
+
+	t.Run("Permanent delete removes it everywhere with its votes and comments and publishes event", func(t *testing.T) {
+		t.Parallel()
+
+		rs := miniredis.RunT(t)
+		c := redis.NewClient(&redis.Options{Addr: rs.Addr()})
+		cache := &cache.Cache{Client: c}
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		ratingMocked := repoMocks.NewRatingRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		searchMocked := repoMocks.NewSearchRepositoryMock(t)
+		votesMocked, commentsMocked := droppedActivity(t)
+		s := service.NewUGCService(userRepoMocked, movieRepoMocked, ratingMocked, searchMocked, nil, outboxMocked, votesMocked, commentsMocked, nil, nil, cache, uowMocked, nil, config.ModerationConfig{}, nil)
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		movieRepoMocked.GetReviewMock.Expect(ctx, userID, movieID).Return(repository.Review{Rating: rating}, nil)
+		userRepoMocked.DeleteReviewMock.Expect(ctx, userID, movieID).Return(nil)
+		movieRepoMocked.DeleteReviewMock.Expect(ctx, userID, movieID).Return(nil)
+		searchMocked.RemoveReviewMock.Expect(ctx, userID, movieID).Return(nil)
+		ratingMocked.UpdateRatingMock.Expect(ctx, movieID, rating, 0).Return(nil)
+		outboxMocked.AddEventsMock.Set(func(ctx context.Context, events []repository.OutboxEvent) error {
+			msgs := outboxMessages(t, events)
+			if len(msgs) != 1 || msgs[0].EventType != producer.EventReviewDeleted || !msgs[0].Review.Permanent {
+				t.Errorf("unexpected messages: %+v", msgs)
+			}
+			return nil
+		})
+
+		votesMocked.DeleteReviewVotesMock.Expect(ctx, userID, movieID).Return(nil)
+		commentsMocked.DeleteReviewCommentsMock.Expect(ctx, userID, movieID).Return(nil)
+
+		err := s.DeleteReview(ctx, userID, movieID, true)
+		require.NoError(t, err)
+
+		requireBumped(t, rs, "cache:review:"+movieID, "cache:review:"+userID, "cache:comment:"+movieID+":"+userID)
+	})
+
+	t.Run("Delete review returns ErrNotFound", func(t *testing.T) {
+		t.Parallel()
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		s := service.NewUGCService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, uowMocked, nil, config.ModerationConfig{}, nil)
+		uowMocked.RunWithinTxMock.Return(repository.ErrNotFound)
+
+		err := s.DeleteReview(ctx, userID, movieID, false)
+
+		require.ErrorIs(t, err, apperrors.ErrNotFound)
+	})
+
+	t.Run("Delete review returns internal error", func(t *testing.T) {
+		t.Parallel()
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		s := service.NewUGCService(nil, nil, nil, nil, nil, nil, nil, nil, logger.Sugar(), nil, nil, uowMocked, nil, config.ModerationConfig{}, nil)
+		uowMocked.RunWithinTxMock.Return(fmt.Errorf("arbitrary error"))
+
+		err := s.DeleteReview(ctx, userID, movieID, false)
+
+		require.ErrorIs(t, err, apperrors.ErrInternal)
+	})
+
+	t.Run("Delete review soft deletes it and drops it from the rating and search", func(t *testing.T) {
+		t.Parallel()
+
+		c, rs := newCache(t)
+		rs.Set(fmt.Sprintf("cache:review:%v:%v", movieID, userID), "{}")
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		ratingMocked := repoMocks.NewRatingRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		searchMocked := repoMocks.NewSearchRepositoryMock(t)
+		s := service.NewUGCService(userRepoMocked, movieRepoMocked, ratingMocked, searchMocked, nil, outboxMocked, nil, nil, nil, nil, c, uowMocked, nil, config.ModerationConfig{}, nil)
+
+		checkDeletedAt := func(ctx context.Context, userID, movieID string, deletedAt time.Time) error {
+			require.False(t, deletedAt.IsZero())
+			return nil
+		}
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		movieRepoMocked.GetReviewMock.Expect(ctx, userID, movieID).Return(repository.Review{Rating: rating}, nil)
+		userRepoMocked.SoftDeleteReviewMock.Set(checkDeletedAt)
+		movieRepoMocked.SoftDeleteReviewMock.Set(checkDeletedAt)
+		searchMocked.RemoveReviewMock.Expect(ctx, userID, movieID).Return(nil)
+		ratingMocked.UpdateRatingMock.Expect(ctx, movieID, rating, 0).Return(nil)
+		outboxMocked.AddEventsMock.Return(nil)
+
+		err := s.DeleteReview(ctx, userID, movieID, false)
+		require.NoError(t, err)
+
+		require.Zero(t, userRepoMocked.DeleteReviewAfterCounter())
+		require.False(t, rs.Exists(fmt.Sprintf("cache:review:%v:%v", movieID, userID)))
+	})
+}
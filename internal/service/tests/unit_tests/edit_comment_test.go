@@ -0,0 +1,75 @@
+package unit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/maisiq/go-ugc-service/internal/cache"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditComment(t *testing.T) {
+	t.Parallel()
+	var (
+		commentID = gofakeit.LetterN(24)
+		authorID  = gofakeit.UUID()
+		text      = gofakeit.Comment()
+		ctx       = context.Background()
+		stored    = repository.Comment{ID: commentID, AuthorID: authorID, ReviewUserID: gofakeit.UUID(), MovieID: gofakeit.UUID()}
+	)
+
+	t.Run("Edit comment updates the text", func(t *testing.T) {
+		t.Parallel()
+
+		rs := miniredis.RunT(t)
+		c := redis.NewClient(&redis.Options{Addr: rs.Addr()})
+		cache := &cache.Cache{Client: c}
+
+		commentMocked := repoMocks.NewCommentRepositoryMock(t)
+		s := service.NewCommentService(nil, commentMocked, nil, cache, nil)
+
+		commentMocked.GetCommentMock.Expect(ctx, commentID).Return(stored, nil)
+		commentMocked.UpdateCommentMock.Set(func(ctx context.Context, ID, newText string, updatedAt time.Time) (repository.Comment, error) {
+			edited := stored
+			edited.Text, edited.UpdatedAt = newText, updatedAt
+			return edited, nil
+		})
+
+		comment, err := s.EditComment(ctx, commentID, authorID, text)
+
+		require.NoError(t, err)
+		require.Equal(t, text, comment.Text)
+	})
+
+	t.Run("Edit comment of another author returns ErrPermissionDenied", func(t *testing.T) {
+		t.Parallel()
+
+		commentMocked := repoMocks.NewCommentRepositoryMock(t)
+		s := service.NewCommentService(nil, commentMocked, nil, nil, nil)
+		commentMocked.GetCommentMock.Return(stored, nil)
+
+		_, err := s.EditComment(ctx, commentID, gofakeit.UUID(), text)
+
+		require.ErrorIs(t, err, apperrors.ErrPermissionDenied)
+	})
+
+	t.Run("Edit missing comment returns ErrNotFound", func(t *testing.T) {
+		t.Parallel()
+
+		commentMocked := repoMocks.NewCommentRepositoryMock(t)
+		s := service.NewCommentService(nil, commentMocked, nil, nil, nil)
+		commentMocked.GetCommentMock.Return(repository.Comment{}, repository.ErrNotFound)
+
+		_, err := s.EditComment(ctx, commentID, authorID, text)
+
+		require.ErrorIs(t, err, apperrors.ErrNotFound)
+	})
+}
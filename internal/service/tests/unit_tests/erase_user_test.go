@@ -0,0 +1,161 @@
+package unit_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/producer"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/maisiq/go-ugc-service/pkg/config"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestEraseUser(t *testing.T) {
+	t.Parallel()
+	var (
+		userID    = gofakeit.UUID()
+		movieID   = gofakeit.UUID()
+		deletedID = gofakeit.UUID()
+		rating    = int32(gofakeit.IntRange(1, 10))
+		ctx       = context.Background()
+		logger, _ = zap.NewDevelopment()
+		deletedAt = time.Now()
+	)
+
+	t.Run("Erase removes reviews everywhere and publishes the erasure", func(t *testing.T) {
+		t.Parallel()
+
+		c, rs := newCache(t)
+		rs.Set(fmt.Sprintf("cache:review:%v:%v", movieID, userID), "{}")
+		rs.Set(fmt.Sprintf("cache:review:%v:summary", movieID), "{}")
+		rs.HSet("progress:"+userID, movieID, "{}")
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		ratingMocked := repoMocks.NewRatingRepositoryMock(t)
+		searchMocked := repoMocks.NewSearchRepositoryMock(t)
+		revisionMocked := repoMocks.NewRevisionRepositoryMock(t)
+		voteMocked := repoMocks.NewVoteRepositoryMock(t)
+		commentMocked := repoMocks.NewCommentRepositoryMock(t)
+		reportMocked := repoMocks.NewReportRepositoryMock(t)
+		bookmarkMocked := repoMocks.NewBookmarkRepositoryMock(t)
+		progressMocked := repoMocks.NewProgressRepositoryMock(t)
+		erasureMocked := repoMocks.NewErasureRepositoryMock(t)
+		analyticsMocked := repoMocks.NewAnalyticsRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		reviews := service.NewUGCService(
+			userRepoMocked, movieRepoMocked, ratingMocked, searchMocked, revisionMocked, outboxMocked, nil, nil, nil, nil, c, uowMocked, nil, config.ModerationConfig{}, nil,
+		)
+		s := service.NewErasureService(
+			reviews, voteMocked, commentMocked, reportMocked, bookmarkMocked, progressMocked, erasureMocked, analyticsMocked,
+		)
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		erasureMocked.StartErasureMock.Return(repository.Erasure{UserID: userID}, nil)
+		userRepoMocked.ListAllReviewsMock.Expect(ctx, userID).Return([]repository.Review{
+			{UserID: userID, MovieID: movieID, Rating: rating, Status: repository.StatusApproved},
+			{UserID: userID, MovieID: deletedID, Rating: rating, DeletedAt: &deletedAt},
+		}, nil)
+		erasureMocked.AddMoviesMock.Expect(ctx, userID, []string{movieID, deletedID}).Return(nil)
+		userRepoMocked.DeleteReviewMock.Return(nil)
+		// the movie side of a review may be gone already when an erasure is resumed
+		movieRepoMocked.DeleteReviewMock.When(ctx, userID, movieID).Then(nil)
+		movieRepoMocked.DeleteReviewMock.When(ctx, userID, deletedID).Then(repository.ErrNotFound)
+		searchMocked.RemoveReviewMock.Return(nil)
+		revisionMocked.DeleteRevisionsMock.Return(nil)
+		// only the live review is still counted in the rating
+		ratingMocked.UpdateRatingMock.Expect(ctx, movieID, rating, 0).Return(nil)
+		voteMocked.DeleteUserVotesMock.Expect(ctx, userID).Return(nil)
+		commentMocked.DeleteUserCommentsMock.Expect(ctx, userID).Return(nil)
+		reportMocked.DeleteUserReportsMock.Expect(ctx, userID).Return(nil)
+		bookmarkMocked.DeleteUserBookmarksMock.Expect(ctx, userID).Return(nil)
+		progressMocked.DeleteUserProgressMock.Set(func(ctx context.Context, UserID string) error {
+			// the buffered positions are gone first, the flush job can't write them back
+			require.False(t, rs.Exists("progress:"+userID))
+			return nil
+		})
+		analyticsMocked.DeleteUserEventsMock.Expect(ctx, userID).Return(nil)
+		outboxMocked.AddEventsMock.Set(func(ctx context.Context, events []repository.OutboxEvent) error {
+			messages := outboxMessages(t, events)
+			require.Len(t, messages, 1)
+			require.Equal(t, userID, messages[0].User.UserID)
+			require.Equal(t, producer.EventUserErased, messages[0].EventType)
+			return nil
+		})
+		erasureMocked.CompleteStepMock.Return(nil)
+		erasureMocked.FinishErasureMock.Expect(ctx, userID).Return(nil)
+
+		err := s.EraseUser(ctx, userID)
+
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), userRepoMocked.DeleteReviewAfterCounter())
+		require.Equal(t, uint64(9), erasureMocked.CompleteStepAfterCounter())
+		requireBumped(t, rs,
+			"cache:review:"+userID, "cache:bookmark:"+userID,
+			"cache:review:"+movieID, "cache:comment:"+movieID+":"+userID,
+			"cache:review:"+deletedID, "cache:comment:"+deletedID+":"+userID,
+		)
+		require.False(t, rs.Exists(fmt.Sprintf("cache:review:%v:%v", movieID, userID)))
+		require.False(t, rs.Exists(fmt.Sprintf("cache:review:%v:summary", movieID)))
+		require.False(t, rs.Exists("progress:"+userID))
+	})
+
+	t.Run("Erase resumes after the steps already done", func(t *testing.T) {
+		t.Parallel()
+
+		erasureMocked := repoMocks.NewErasureRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		reviews := service.NewUGCService(nil, nil, nil, nil, nil, outboxMocked, nil, nil, nil, nil, nil, nil, nil, config.ModerationConfig{}, nil)
+		s := service.NewErasureService(reviews, nil, nil, nil, nil, nil, erasureMocked, nil)
+
+		erasureMocked.StartErasureMock.Return(repository.Erasure{
+			UserID:   userID,
+			MovieIDs: []string{movieID},
+			Done: []repository.ErasureStep{
+				repository.ErasureReviews, repository.ErasureVotes, repository.ErasureComments, repository.ErasureReports,
+				repository.ErasureBookmarks, repository.ErasureProgress, repository.ErasureCache, repository.ErasureAnalytics,
+			},
+		}, nil)
+		outboxMocked.AddEventsMock.Return(nil)
+		erasureMocked.CompleteStepMock.Expect(ctx, userID, repository.ErasureEvent).Return(nil)
+		erasureMocked.FinishErasureMock.Expect(ctx, userID).Return(nil)
+
+		err := s.EraseUser(ctx, userID)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Erase keeps the progress when a step fails", func(t *testing.T) {
+		t.Parallel()
+
+		erasureMocked := repoMocks.NewErasureRepositoryMock(t)
+		analyticsMocked := repoMocks.NewAnalyticsRepositoryMock(t)
+		reviews := service.NewUGCService(nil, nil, nil, nil, nil, nil, nil, nil, logger.Sugar(), nil, nil, nil, nil, config.ModerationConfig{}, nil)
+		s := service.NewErasureService(reviews, nil, nil, nil, nil, nil, erasureMocked, analyticsMocked)
+
+		erasureMocked.StartErasureMock.Return(repository.Erasure{
+			UserID: userID,
+			Done: []repository.ErasureStep{
+				repository.ErasureReviews, repository.ErasureVotes, repository.ErasureComments, repository.ErasureReports,
+				repository.ErasureBookmarks, repository.ErasureProgress, repository.ErasureCache,
+			},
+		}, nil)
+		analyticsMocked.DeleteUserEventsMock.Return(fmt.Errorf("arbitrary error"))
+
+		err := s.EraseUser(ctx, userID)
+
+		require.ErrorIs(t, err, apperrors.ErrInternal)
+		require.Zero(t, erasureMocked.CompleteStepAfterCounter())
+		require.Zero(t, erasureMocked.FinishErasureAfterCounter())
+	})
+}
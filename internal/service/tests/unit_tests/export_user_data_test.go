@@ -0,0 +1,105 @@
+package unit_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestExportUserData(t *testing.T) {
+	t.Parallel()
+	var (
+		userID    = gofakeit.UUID()
+		movieID   = gofakeit.UUID()
+		ctx       = context.Background()
+		logger, _ = zap.NewDevelopment()
+		deletedAt = time.Now()
+		reviews   = []repository.Review{
+			{UserID: userID, MovieID: movieID, Text: gofakeit.Comment(), Rating: 7},
+			{UserID: userID, MovieID: gofakeit.UUID(), Text: gofakeit.Comment(), Rating: 3, DeletedAt: &deletedAt},
+		}
+		votes    = []repository.Vote{{VoterID: userID, UserID: gofakeit.UUID(), MovieID: movieID, Value: repository.VoteUp}}
+		comments = []repository.Comment{{ID: "c1", AuthorID: userID, MovieID: movieID, Text: gofakeit.Comment()}}
+		reports  = []repository.Report{{ReporterID: userID, UserID: gofakeit.UUID(), MovieID: movieID, Reason: repository.ReportSpam}}
+		events   = []repository.AnalyticsEvent{{UserID: userID, MovieID: movieID, Event: "review_created", TimestampMS: 1}}
+	)
+
+	t.Run("Export sends every kind of record in order", func(t *testing.T) {
+		t.Parallel()
+
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		voteMocked := repoMocks.NewVoteRepositoryMock(t)
+		commentMocked := repoMocks.NewCommentRepositoryMock(t)
+		reportMocked := repoMocks.NewReportRepositoryMock(t)
+		analyticsMocked := repoMocks.NewAnalyticsRepositoryMock(t)
+		s := service.NewExportService(userRepoMocked, voteMocked, commentMocked, reportMocked, analyticsMocked, nil)
+
+		userRepoMocked.ListAllReviewsMock.Expect(ctx, userID).Return(reviews, nil)
+		voteMocked.ListVotesByMock.Expect(ctx, userID).Return(votes, nil)
+		commentMocked.ListCommentsByMock.Expect(ctx, userID).Return(comments, nil)
+		reportMocked.ListReportsByMock.Expect(ctx, userID).Return(reports, nil)
+		analyticsMocked.ListUserEventsMock.Expect(ctx, userID).Return(events, nil)
+
+		var records []service.UserDataRecord
+
+		err := s.ExportUserData(ctx, userID, func(record service.UserDataRecord) error {
+			records = append(records, record)
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Len(t, records, 6)
+		require.Equal(t, reviews[0], *records[0].Review)
+		require.Equal(t, reviews[1], *records[1].Review)
+		require.Equal(t, votes[0], *records[2].Vote)
+		require.Equal(t, comments[0], *records[3].Comment)
+		require.Equal(t, reports[0], *records[4].Report)
+		require.Equal(t, events[0], *records[5].Event)
+	})
+
+	t.Run("Export stops when the client goes away", func(t *testing.T) {
+		t.Parallel()
+
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		s := service.NewExportService(userRepoMocked, nil, nil, nil, nil, nil)
+		sendErr := fmt.Errorf("stream closed")
+
+		userRepoMocked.ListAllReviewsMock.Expect(ctx, userID).Return(reviews, nil)
+
+		err := s.ExportUserData(ctx, userID, func(service.UserDataRecord) error {
+			return sendErr
+		})
+
+		require.ErrorIs(t, err, sendErr)
+	})
+
+	t.Run("Export returns internal error when clickhouse fails", func(t *testing.T) {
+		t.Parallel()
+
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		voteMocked := repoMocks.NewVoteRepositoryMock(t)
+		commentMocked := repoMocks.NewCommentRepositoryMock(t)
+		reportMocked := repoMocks.NewReportRepositoryMock(t)
+		analyticsMocked := repoMocks.NewAnalyticsRepositoryMock(t)
+		s := service.NewExportService(userRepoMocked, voteMocked, commentMocked, reportMocked, analyticsMocked, logger.Sugar())
+
+		userRepoMocked.ListAllReviewsMock.Return([]repository.Review{}, nil)
+		voteMocked.ListVotesByMock.Return([]repository.Vote{}, nil)
+		commentMocked.ListCommentsByMock.Return([]repository.Comment{}, nil)
+		reportMocked.ListReportsByMock.Return([]repository.Report{}, nil)
+		analyticsMocked.ListUserEventsMock.Return(nil, fmt.Errorf("arbitrary error"))
+
+		err := s.ExportUserData(ctx, userID, func(service.UserDataRecord) error { return nil })
+
+		require.ErrorIs(t, err, apperrors.ErrInternal)
+	})
+}
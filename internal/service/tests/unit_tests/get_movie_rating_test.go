@@ -0,0 +1,71 @@
+package unit_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/maisiq/go-ugc-service/pkg/config"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestGetMovieRating(t *testing.T) {
+	t.Parallel()
+	var (
+		movieID   = gofakeit.UUID()
+		ctx       = context.Background()
+		logger, _ = zap.NewDevelopment()
+		ratingExp = repository.MovieRating{
+			MovieID:   movieID,
+			Count:     3,
+			Sum:       21,
+			Histogram: map[string]int64{"5": 1, "8": 2},
+		}
+	)
+
+	t.Run("Get movie rating returns aggregate", func(t *testing.T) {
+		t.Parallel()
+
+		ratingMocked := repoMocks.NewRatingRepositoryMock(t)
+		s := service.NewUGCService(nil, nil, ratingMocked, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, config.ModerationConfig{}, nil)
+		ratingMocked.GetRatingMock.Expect(ctx, movieID).Return(ratingExp, nil)
+
+		rating, err := s.GetMovieRating(ctx, movieID)
+
+		require.NoError(t, err)
+		require.Equal(t, ratingExp, rating)
+		require.Equal(t, float64(7), rating.Average())
+		require.Equal(t, int64(2), rating.CountOf(8))
+		require.Equal(t, int64(0), rating.CountOf(1))
+	})
+
+	t.Run("Get movie rating returns ErrNotFound", func(t *testing.T) {
+		t.Parallel()
+
+		ratingMocked := repoMocks.NewRatingRepositoryMock(t)
+		s := service.NewUGCService(nil, nil, ratingMocked, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, config.ModerationConfig{}, nil)
+		ratingMocked.GetRatingMock.Return(repository.MovieRating{}, repository.ErrNotFound)
+
+		_, err := s.GetMovieRating(ctx, movieID)
+
+		require.ErrorIs(t, err, apperrors.ErrNotFound)
+	})
+
+	t.Run("Get movie rating returns internal error", func(t *testing.T) {
+		t.Parallel()
+
+		ratingMocked := repoMocks.NewRatingRepositoryMock(t)
+		s := service.NewUGCService(nil, nil, ratingMocked, nil, nil, nil, nil, nil, logger.Sugar(), nil, nil, nil, nil, config.ModerationConfig{}, nil)
+		ratingMocked.GetRatingMock.Return(repository.MovieRating{}, fmt.Errorf("arbitrary error"))
+
+		_, err := s.GetMovieRating(ctx, movieID)
+
+		require.ErrorIs(t, err, apperrors.ErrInternal)
+	})
+}
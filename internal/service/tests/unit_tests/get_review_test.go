@@ -11,10 +11,12 @@ import (
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/maisiq/go-ugc-service/internal/cache"
 	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/pagination"
 	"github.com/maisiq/go-ugc-service/internal/producer"
 	"github.com/maisiq/go-ugc-service/internal/repository"
 	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
 	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/maisiq/go-ugc-service/pkg/config"
 	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -29,14 +31,18 @@ func TestGetReview(t *testing.T) {
 		userID     = gofakeit.UUID()
 		movieID    = gofakeit.UUID()
 		reviewText = gofakeit.Comment()
+		rating     = int32(gofakeit.IntRange(1, 10))
 		ctx        = context.Background()
 		reviewsExp = []repository.Review{
-			{UserID: userID, MovieID: movieID, Text: reviewText},
+			{UserID: userID, MovieID: movieID, Text: reviewText, Rating: rating},
 		}
 		_ = []producer.AnalyticsMessage{
-			{UserID: userID, MovieID: movieID, TimestampMS: time.Now().Unix()},
+			producer.NewReviewMessage(producer.EventReviewCreated, time.Now(), producer.ReviewPayload{UserID: userID, MovieID: movieID}),
 		}
 		sugLogger = log.Sugar()
+		public    = []repository.ReviewStatus{repository.StatusApproved}
+		paginator = pagination.New(config.PaginationConfig{DefaultPageSize: 20, MaxPageSize: 100, TokenSecret: "secret"})
+		firstPage = cache.HashArgs("")
 	)
 	t.Run("Get user reviews returns review, no cache", func(t *testing.T) {
 		t.Parallel()
@@ -46,13 +52,14 @@ func TestGetReview(t *testing.T) {
 		cache := &cache.Cache{Client: c}
 
 		repoMocked := repoMocks.NewReviewRepositoryMock(t)
-		s := service.NewUGCService(repoMocked, repoMocked, nil, nil, cache, nil)
+		s := service.NewUGCService(repoMocked, repoMocked, nil, nil, nil, nil, nil, nil, nil, nil, cache, nil, paginator, config.ModerationConfig{}, nil)
 
-		repoMocked.GetReviewsMock.Expect(ctx, userID).Return(reviewsExp, nil)
-		review, err := s.GetReviews(ctx, userID, "")
+		repoMocked.GetReviewsMock.Expect(ctx, userID, public, repository.SortDefault, nil, 21).Return(reviewsExp, nil)
+		review, nextPageToken, err := s.GetReviews(ctx, userID, "", "", repository.SortDefault, 0, "")
 
 		require.NoError(t, err)
 		require.Equal(t, reviewsExp, review)
+		require.Empty(t, nextPageToken)
 	})
 
 	t.Run("Get user reviews returns review using cache", func(t *testing.T) {
@@ -62,14 +69,14 @@ func TestGetReview(t *testing.T) {
 		c := redis.NewClient(&redis.Options{Addr: rs.Addr()})
 		cache := &cache.Cache{Client: c}
 
-		key := fmt.Sprintf("cache:%v:%v", "review", userID)
+		key := fmt.Sprintf("cache:%v:%v:page:0:0:%v:20", "review", userID, firstPage)
 		b, _ := json.Marshal(reviewsExp)
 		rs.Set(key, string(b))
 
 		repoMocked := repoMocks.NewReviewRepositoryMock(t)
-		s := service.NewUGCService(repoMocked, repoMocked, nil, nil, cache, nil)
+		s := service.NewUGCService(repoMocked, repoMocked, nil, nil, nil, nil, nil, nil, nil, nil, cache, nil, paginator, config.ModerationConfig{}, nil)
 
-		review, err := s.GetReviews(ctx, userID, "")
+		review, _, err := s.GetReviews(ctx, userID, "", "", repository.SortDefault, 0, "")
 
 		require.NoError(t, err)
 		require.Equal(t, reviewsExp, review)
@@ -84,11 +91,143 @@ func TestGetReview(t *testing.T) {
 
 		repoMocked := repoMocks.NewReviewRepositoryMock(t)
 		repoMocked.GetReviewsMock.Return([]repository.Review{}, repository.ErrNotFound)
-		s := service.NewUGCService(repoMocked, repoMocked, sugLogger, nil, cache, nil)
+		s := service.NewUGCService(repoMocked, repoMocked, nil, nil, nil, nil, nil, nil, sugLogger, nil, cache, nil, paginator, config.ModerationConfig{}, nil)
 
-		review, err := s.GetReviews(ctx, userID, "")
+		review, _, err := s.GetReviews(ctx, userID, "", "", repository.SortDefault, 0, "")
 
 		require.ErrorIs(t, err, apperrors.ErrNotFound)
 		require.Equal(t, []repository.Review{}, review)
 	})
+
+	t.Run("Get movie reviews returns next page token", func(t *testing.T) {
+		t.Parallel()
+
+		rs := miniredis.RunT(t)
+		c := redis.NewClient(&redis.Options{Addr: rs.Addr()})
+		cache := &cache.Cache{Client: c}
+
+		page := []repository.Review{
+			{UserID: gofakeit.UUID(), MovieID: movieID, Text: reviewText, Rating: rating},
+			{UserID: gofakeit.UUID(), MovieID: movieID, Text: reviewText, Rating: rating},
+			{UserID: gofakeit.UUID(), MovieID: movieID, Text: reviewText, Rating: rating},
+		}
+
+		repoMocked := repoMocks.NewReviewRepositoryMock(t)
+		s := service.NewUGCService(repoMocked, repoMocked, nil, nil, nil, nil, nil, nil, nil, nil, cache, nil, paginator, config.ModerationConfig{}, nil)
+
+		after := repository.CursorAfter(page[1])
+
+		repoMocked.GetReviewsMock.When(ctx, movieID, public, repository.SortNewest, nil, 3).Then(page, nil)
+		repoMocked.GetReviewsMock.When(ctx, movieID, public, repository.SortNewest, &after, 3).Then(page[2:], nil)
+
+		first, nextPageToken, err := s.GetReviews(ctx, "", movieID, "", repository.SortNewest, 2, "")
+
+		require.NoError(t, err)
+		require.Equal(t, page[:2], first)
+		require.NotEmpty(t, nextPageToken)
+
+		second, nextPageToken, err := s.GetReviews(ctx, "", movieID, "", repository.SortNewest, 2, nextPageToken)
+
+		require.NoError(t, err)
+		require.Equal(t, page[2:], second)
+		require.Empty(t, nextPageToken)
+	})
+
+	t.Run("Get reviews rejects a token issued for another query", func(t *testing.T) {
+		t.Parallel()
+
+		s := service.NewUGCService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, paginator, config.ModerationConfig{}, nil)
+		token := paginator.CursorToken(repository.ReviewCursor{MovieID: movieID}, movieID, "", "", "0")
+
+		_, _, err := s.GetReviews(ctx, userID, "", "", repository.SortDefault, 0, token)
+
+		require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	})
+
+	t.Run("Get own reviews includes pending ones", func(t *testing.T) {
+		t.Parallel()
+
+		rs := miniredis.RunT(t)
+		c := redis.NewClient(&redis.Options{Addr: rs.Addr()})
+		cache := &cache.Cache{Client: c}
+
+		pending := []repository.Review{
+			{UserID: userID, MovieID: movieID, Text: reviewText, Rating: rating, Status: repository.StatusPending},
+		}
+		own := []repository.ReviewStatus{repository.StatusApproved, repository.StatusPending}
+
+		repoMocked := repoMocks.NewReviewRepositoryMock(t)
+		s := service.NewUGCService(repoMocked, repoMocked, nil, nil, nil, nil, nil, nil, nil, nil, cache, nil, paginator, config.ModerationConfig{}, nil)
+
+		repoMocked.GetReviewsMock.Expect(ctx, userID, own, repository.SortDefault, nil, 21).Return(pending, nil)
+		review, _, err := s.GetReviews(ctx, userID, "", userID, repository.SortDefault, 0, "")
+
+		require.NoError(t, err)
+		require.Equal(t, pending, review)
+		require.True(t, rs.Exists(fmt.Sprintf("cache:review:%v:page:0:own:0:%v:20", userID, firstPage)))
+	})
+}
+
+func TestGetSingleReview(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID    = gofakeit.UUID()
+		movieID   = gofakeit.UUID()
+		ctx       = context.Background()
+		key       = fmt.Sprintf("cache:review:%v:%v", movieID, userID)
+		reviewExp = repository.Review{UserID: userID, MovieID: movieID, Text: gofakeit.Comment(), Rating: 7, Status: repository.StatusApproved}
+	)
+
+	t.Run("Get review reads it once and caches it", func(t *testing.T) {
+		t.Parallel()
+		c, rs := newCache(t)
+
+		repoMocked := repoMocks.NewReviewRepositoryMock(t)
+		s := service.NewUGCService(nil, repoMocked, nil, nil, nil, nil, nil, nil, nil, nil, c, nil, nil, config.ModerationConfig{}, nil)
+
+		repoMocked.GetReviewMock.Expect(ctx, userID, movieID).Return(reviewExp, nil)
+
+		review, err := s.GetReview(ctx, userID, movieID, "")
+		require.NoError(t, err)
+		require.Equal(t, reviewExp, review)
+		require.True(t, rs.Exists(key))
+
+		review, err = s.GetReview(ctx, userID, movieID, "")
+		require.NoError(t, err)
+		require.Equal(t, reviewExp, review)
+		require.Equal(t, uint64(1), repoMocked.GetReviewAfterCounter())
+	})
+
+	t.Run("Get review returns ErrNotFound", func(t *testing.T) {
+		t.Parallel()
+		c, _ := newCache(t)
+
+		repoMocked := repoMocks.NewReviewRepositoryMock(t)
+		s := service.NewUGCService(nil, repoMocked, nil, nil, nil, nil, nil, nil, nil, nil, c, nil, nil, config.ModerationConfig{}, nil)
+
+		repoMocked.GetReviewMock.Return(repository.Review{}, repository.ErrNotFound)
+
+		_, err := s.GetReview(ctx, userID, movieID, "")
+
+		require.ErrorIs(t, err, apperrors.ErrNotFound)
+	})
+
+	t.Run("Get review shows a pending review to its author only", func(t *testing.T) {
+		t.Parallel()
+		c, rs := newCache(t)
+		pending := reviewExp
+		pending.Status = repository.StatusPending
+		b, _ := json.Marshal(pending)
+		rs.Set(key, string(b))
+
+		s := service.NewUGCService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, c, nil, nil, config.ModerationConfig{}, nil)
+
+		_, err := s.GetReview(ctx, userID, movieID, gofakeit.UUID())
+		require.ErrorIs(t, err, apperrors.ErrNotFound)
+
+		review, err := s.GetReview(ctx, userID, movieID, userID)
+		require.NoError(t, err)
+		require.Equal(t, pending, review)
+	})
 }
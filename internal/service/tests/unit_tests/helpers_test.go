@@ -0,0 +1,52 @@
+package unit_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/maisiq/go-ugc-service/internal/cache"
+	"github.com/maisiq/go-ugc-service/internal/producer"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+// newCache returns a cache backed by an in-memory redis that is stopped with the test.
+func newCache(t *testing.T) (*cache.Cache, *miniredis.Miniredis) {
+	rs := miniredis.RunT(t)
+	return &cache.Cache{Client: redis.NewClient(&redis.Options{Addr: rs.Addr()})}, rs
+}
+
+// droppedActivity returns vote and comment repositories expecting the votes and comments
+// of a review removed for good or replaced to be deleted.
+func droppedActivity(t *testing.T) (*repoMocks.VoteRepositoryMock, *repoMocks.CommentRepositoryMock) {
+	votes := repoMocks.NewVoteRepositoryMock(t)
+	comments := repoMocks.NewCommentRepositoryMock(t)
+	votes.DeleteReviewVotesMock.Return(nil)
+	comments.DeleteReviewCommentsMock.Return(nil)
+	return votes, comments
+}
+
+// requireBumped checks the cached pages of every scope were dropped by a generation bump.
+func requireBumped(t *testing.T, rs *miniredis.Miniredis, scopes ...string) {
+	for _, scope := range scopes {
+		gen, err := rs.Get("generation:" + scope)
+		require.NoError(t, err, scope)
+		require.Equal(t, "1", gen, scope)
+	}
+}
+
+// outboxMessages decodes the messages of events written to the outbox.
+func outboxMessages(t *testing.T, events []repository.OutboxEvent) []producer.AnalyticsMessage {
+	messages := make([]producer.AnalyticsMessage, 0, len(events))
+
+	for _, event := range events {
+		var msg producer.AnalyticsMessage
+		require.NoError(t, json.Unmarshal(event.Payload, &msg))
+		messages = append(messages, msg)
+	}
+
+	return messages
+}
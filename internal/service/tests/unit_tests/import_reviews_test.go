@@ -0,0 +1,223 @@
+package unit_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/producer"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/maisiq/go-ugc-service/pkg/config"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func importSource(items ...service.ImportItem) func() (service.ImportItem, error) {
+	return func() (service.ImportItem, error) {
+		if len(items) == 0 {
+			return service.ImportItem{}, io.EOF
+		}
+		item := items[0]
+		items = items[1:]
+		return item, nil
+	}
+}
+
+func TestImportReviews(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx       = context.Background()
+		logger, _ = zap.NewDevelopment()
+	)
+
+	newReview := func() repository.Review {
+		return repository.Review{
+			UserID:  gofakeit.UUID(),
+			MovieID: gofakeit.UUID(),
+			Text:    gofakeit.Comment(),
+			Rating:  int32(gofakeit.IntRange(1, 10)),
+		}
+	}
+
+	t.Run("Import creates new reviews, skips and overwrites existing ones", func(t *testing.T) {
+		t.Parallel()
+		uowMocked := repoMocks.NewUOWMock(t)
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		ratingMocked := repoMocks.NewRatingRepositoryMock(t)
+		searchMocked := repoMocks.NewSearchRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		c, _ := newCache(t)
+		revisionMocked := repoMocks.NewRevisionRepositoryMock(t)
+		votesMocked, commentsMocked := droppedActivity(t)
+		ugc := service.NewUGCService(userRepoMocked, movieRepoMocked, ratingMocked, searchMocked, revisionMocked, outboxMocked, votesMocked, commentsMocked, nil, nil, c, uowMocked, nil, config.ModerationConfig{}, nil)
+		s := service.NewImportService(ugc, 2)
+
+		created, skipped, overwritten := newReview(), newReview(), newReview()
+		existing := map[string]repository.Review{
+			skipped.MovieID:     {Rating: 1},
+			overwritten.MovieID: {Text: "old text", Rating: 2, Version: 4},
+		}
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		movieRepoMocked.GetReviewMock.Set(func(ctx context.Context, userID, movieID string) (repository.Review, error) {
+			if review, ok := existing[movieID]; ok {
+				return review, nil
+			}
+			return repository.Review{}, repository.ErrNotFound
+		})
+		userRepoMocked.CreateReviewMock.Return(nil)
+		movieRepoMocked.CreateReviewMock.Return(nil)
+		revisionMocked.AddRevisionMock.Set(func(ctx context.Context, revision repository.Revision) error {
+			require.Equal(t, overwritten.MovieID, revision.MovieID)
+			require.Equal(t, "old text", revision.Text)
+			require.Equal(t, int64(4), revision.Version)
+			require.Equal(t, repository.EditorImport, revision.EditorID)
+			return nil
+		})
+		userRepoMocked.UpdateReviewMock.Return(nil)
+		movieRepoMocked.UpdateReviewMock.Return(nil)
+		searchMocked.IndexReviewMock.Return(nil)
+		ratingMocked.UpdateRatingMock.Return(nil)
+		var msgs []producer.AnalyticsMessage
+		outboxMocked.AddEventsMock.Set(func(ctx context.Context, events []repository.OutboxEvent) error {
+			msgs = append(msgs, outboxMessages(t, events)...)
+			return nil
+		})
+
+		report, err := s.ImportReviews(ctx, importSource(
+			service.ImportItem{Review: created},
+			service.ImportItem{Review: skipped},
+			service.ImportItem{Review: overwritten, Overwrite: true},
+		))
+		require.NoError(t, err)
+		require.Equal(t, int64(1), report.Created)
+		require.Equal(t, int64(1), report.Skipped)
+		require.Equal(t, int64(1), report.Overwritten)
+		require.Zero(t, report.Failed)
+		require.Equal(t, uint64(2), uowMocked.RunWithinTxAfterCounter())
+		require.Equal(t, uint64(1), movieRepoMocked.UpdateReviewAfterCounter())
+
+		require.Len(t, msgs, 2)
+		require.Equal(t, producer.EventReviewCreated, msgs[0].EventType)
+		require.Equal(t, created.MovieID, msgs[0].Review.MovieID)
+		require.Equal(t, producer.EventReviewUpdated, msgs[1].EventType)
+		require.Equal(t, overwritten.MovieID, msgs[1].Review.MovieID)
+	})
+
+	t.Run("Import reports invalid items and keeps going", func(t *testing.T) {
+		t.Parallel()
+		uowMocked := repoMocks.NewUOWMock(t)
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		ratingMocked := repoMocks.NewRatingRepositoryMock(t)
+		searchMocked := repoMocks.NewSearchRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		c, _ := newCache(t)
+		votesMocked, commentsMocked := droppedActivity(t)
+		ugc := service.NewUGCService(userRepoMocked, movieRepoMocked, ratingMocked, searchMocked, nil, outboxMocked, votesMocked, commentsMocked, nil, nil, c, uowMocked, nil, config.ModerationConfig{}, nil)
+		s := service.NewImportService(ugc, 10)
+
+		invalid, valid := newReview(), newReview()
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		movieRepoMocked.GetReviewMock.Expect(ctx, valid.UserID, valid.MovieID).Return(repository.Review{}, repository.ErrNotFound)
+		userRepoMocked.CreateReviewMock.Return(nil)
+		movieRepoMocked.CreateReviewMock.Return(nil)
+		searchMocked.IndexReviewMock.Return(nil)
+		ratingMocked.UpdateRatingMock.Expect(ctx, valid.MovieID, 0, valid.Rating).Return(nil)
+		outboxMocked.AddEventsMock.Return(nil)
+
+		report, err := s.ImportReviews(ctx, importSource(
+			service.ImportItem{Review: invalid, Invalid: errors.New("invalid rating")},
+			service.ImportItem{Review: valid},
+		))
+		require.NoError(t, err)
+		require.Equal(t, int64(1), report.Created)
+		require.Equal(t, int64(1), report.Failed)
+		require.Len(t, report.Errors, 1)
+		require.Equal(t, int64(0), report.Errors[0].Index)
+		require.Equal(t, invalid.UserID, report.Errors[0].UserID)
+		require.ErrorIs(t, report.Errors[0].Err, apperrors.ErrInvalidArgument)
+	})
+
+	t.Run("Import overwrite keeps the moderator's decision on a rejected review", func(t *testing.T) {
+		t.Parallel()
+		uowMocked := repoMocks.NewUOWMock(t)
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		ratingMocked := repoMocks.NewRatingRepositoryMock(t)
+		searchMocked := repoMocks.NewSearchRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		c, _ := newCache(t)
+		revisionMocked := repoMocks.NewRevisionRepositoryMock(t)
+		ugc := service.NewUGCService(userRepoMocked, movieRepoMocked, ratingMocked, searchMocked, revisionMocked, outboxMocked, nil, nil, nil, nil, c, uowMocked, nil, config.ModerationConfig{}, nil)
+		s := service.NewImportService(ugc, 2)
+
+		overwritten := newReview()
+		current := repository.Review{Rating: 2, Status: repository.StatusRejected, ModerationReason: "spam", Version: 3}
+		checkReview := func(ctx context.Context, review repository.Review, fields ...repository.ReviewField) error {
+			require.Equal(t, repository.StatusRejected, review.Status)
+			require.Equal(t, "spam", review.ModerationReason)
+			return nil
+		}
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		movieRepoMocked.GetReviewMock.Return(current, nil)
+		revisionMocked.AddRevisionMock.Return(nil)
+		userRepoMocked.UpdateReviewMock.Set(checkReview)
+		movieRepoMocked.UpdateReviewMock.Set(checkReview)
+		searchMocked.IndexReviewMock.Return(nil)
+		outboxMocked.AddEventsMock.Return(nil)
+
+		report, err := s.ImportReviews(ctx, importSource(service.ImportItem{Review: overwritten, Overwrite: true}))
+
+		require.NoError(t, err)
+		require.Equal(t, int64(1), report.Overwritten)
+	})
+
+	t.Run("Import fails the whole batch when its transaction fails", func(t *testing.T) {
+		t.Parallel()
+		uowMocked := repoMocks.NewUOWMock(t)
+		ugc := service.NewUGCService(nil, nil, nil, nil, nil, nil, nil, nil, logger.Sugar(), nil, nil, uowMocked, nil, config.ModerationConfig{}, nil)
+		s := service.NewImportService(ugc, 2)
+
+		uowMocked.RunWithinTxMock.Return(fmt.Errorf("arbitrary error"))
+
+		report, err := s.ImportReviews(ctx, importSource(
+			service.ImportItem{Review: newReview()},
+			service.ImportItem{Review: newReview()},
+		))
+		require.NoError(t, err)
+
+		require.Zero(t, report.Created)
+		require.Equal(t, int64(2), report.Failed)
+		require.Len(t, report.Errors, 2)
+		require.Equal(t, int64(1), report.Errors[1].Index)
+		require.ErrorIs(t, report.Errors[1].Err, apperrors.ErrInternal)
+	})
+
+	t.Run("Import returns the error of a broken stream", func(t *testing.T) {
+		t.Parallel()
+		s := service.NewImportService(service.NewUGCService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, config.ModerationConfig{}, nil), 2)
+		streamErr := errors.New("stream closed")
+
+		_, err := s.ImportReviews(ctx, func() (service.ImportItem, error) {
+			return service.ImportItem{}, streamErr
+		})
+
+		require.ErrorIs(t, err, streamErr)
+	})
+}
@@ -0,0 +1,64 @@
+package unit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/maisiq/go-ugc-service/internal/cache"
+	"github.com/maisiq/go-ugc-service/internal/pagination"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/maisiq/go-ugc-service/pkg/config"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListComments(t *testing.T) {
+	t.Parallel()
+	var (
+		userID    = gofakeit.UUID()
+		movieID   = gofakeit.UUID()
+		ctx       = context.Background()
+		paginator = pagination.New(config.PaginationConfig{DefaultPageSize: 20, MaxPageSize: 100, TokenSecret: "secret"})
+	)
+
+	t.Run("List comments attaches replies to their parents", func(t *testing.T) {
+		t.Parallel()
+
+		rs := miniredis.RunT(t)
+		c := redis.NewClient(&redis.Options{Addr: rs.Addr()})
+		cache := &cache.Cache{Client: c}
+
+		commentMocked := repoMocks.NewCommentRepositoryMock(t)
+		s := service.NewCommentService(nil, commentMocked, nil, cache, paginator)
+
+		top := []repository.Comment{
+			{ID: "1", ReviewUserID: userID, MovieID: movieID, Text: gofakeit.Comment()},
+			{ID: "2", ReviewUserID: userID, MovieID: movieID, Text: gofakeit.Comment()},
+			{ID: "3", ReviewUserID: userID, MovieID: movieID, Text: gofakeit.Comment()},
+		}
+		replies := []repository.Comment{
+			{ID: "4", ReviewUserID: userID, MovieID: movieID, ParentID: "1", Text: gofakeit.Comment()},
+			{ID: "5", ReviewUserID: userID, MovieID: movieID, ParentID: "1", Text: gofakeit.Comment()},
+		}
+
+		commentMocked.ListCommentsMock.Expect(ctx, userID, movieID, 0, 3).Return(top, nil)
+		commentMocked.ListRepliesMock.Expect(ctx, []string{"1", "2", "3"}).Return(replies, nil)
+
+		comments, nextPageToken, err := s.ListComments(ctx, userID, movieID, 2, "")
+
+		require.NoError(t, err)
+		require.Len(t, comments, 2)
+		require.Equal(t, replies, comments[0].Replies)
+		require.Empty(t, comments[1].Replies)
+		require.NotEmpty(t, nextPageToken)
+
+		cached, _, err := s.ListComments(ctx, userID, movieID, 2, "")
+
+		require.NoError(t, err)
+		require.Equal(t, comments, cached)
+	})
+}
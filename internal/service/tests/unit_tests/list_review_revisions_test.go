@@ -0,0 +1,77 @@
+package unit_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/pagination"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/maisiq/go-ugc-service/pkg/config"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestListReviewRevisions(t *testing.T) {
+	t.Parallel()
+	var (
+		userID    = gofakeit.UUID()
+		movieID   = gofakeit.UUID()
+		ctx       = context.Background()
+		logger, _ = zap.NewDevelopment()
+		paginator = pagination.New(config.PaginationConfig{DefaultPageSize: 2, MaxPageSize: 100, TokenSecret: "secret"})
+		revisions = []repository.Revision{
+			{UserID: userID, MovieID: movieID, Version: 3, Text: gofakeit.Comment(), EditorID: userID},
+			{UserID: userID, MovieID: movieID, Version: 2, Text: gofakeit.Comment(), EditorID: userID},
+			{UserID: userID, MovieID: movieID, Version: 1, Text: gofakeit.Comment(), EditorID: userID},
+		}
+	)
+
+	t.Run("List revisions pages through the history", func(t *testing.T) {
+		t.Parallel()
+
+		revisionMocked := repoMocks.NewRevisionRepositoryMock(t)
+		s := service.NewModerationService(nil, nil, nil, nil, nil, revisionMocked, nil, nil, nil, paginator)
+
+		revisionMocked.ListRevisionsMock.When(ctx, userID, movieID, 0, 3).Then(revisions, nil)
+		revisionMocked.ListRevisionsMock.When(ctx, userID, movieID, 2, 3).Then(revisions[2:], nil)
+
+		page, token, err := s.ListReviewRevisions(ctx, userID, movieID, 0, "")
+		require.NoError(t, err)
+		require.Equal(t, revisions[:2], page)
+		require.NotEmpty(t, token)
+
+		page, token, err = s.ListReviewRevisions(ctx, userID, movieID, 0, token)
+		require.NoError(t, err)
+		require.Equal(t, revisions[2:], page)
+		require.Empty(t, token)
+	})
+
+	t.Run("List revisions rejects a token of another review", func(t *testing.T) {
+		t.Parallel()
+
+		s := service.NewModerationService(nil, nil, nil, nil, nil, nil, nil, nil, nil, paginator)
+		token := paginator.NextToken(2, "revisions", gofakeit.UUID(), userID)
+
+		_, _, err := s.ListReviewRevisions(ctx, userID, movieID, 0, token)
+
+		require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	})
+
+	t.Run("List revisions returns internal error", func(t *testing.T) {
+		t.Parallel()
+
+		revisionMocked := repoMocks.NewRevisionRepositoryMock(t)
+		s := service.NewModerationService(nil, nil, nil, nil, nil, revisionMocked, logger.Sugar(), nil, nil, paginator)
+
+		revisionMocked.ListRevisionsMock.Return(nil, fmt.Errorf("arbitrary error"))
+
+		_, _, err := s.ListReviewRevisions(ctx, userID, movieID, 0, "")
+
+		require.ErrorIs(t, err, apperrors.ErrInternal)
+	})
+}
@@ -0,0 +1,237 @@
+package unit_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/maisiq/go-ugc-service/internal/cache"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/pagination"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/maisiq/go-ugc-service/pkg/config"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestModeration(t *testing.T) {
+	t.Parallel()
+	var (
+		userID    = gofakeit.UUID()
+		movieID   = gofakeit.UUID()
+		rating    = int32(gofakeit.IntRange(1, 10))
+		reason    = gofakeit.Sentence(5)
+		ctx       = context.Background()
+		logger, _ = zap.NewDevelopment()
+		paginator = pagination.New(config.PaginationConfig{DefaultPageSize: 20, MaxPageSize: 100, TokenSecret: "secret"})
+	)
+
+	t.Run("Approve review adds it to the rating and drops cached pages", func(t *testing.T) {
+		t.Parallel()
+
+		rs := miniredis.RunT(t)
+		c := redis.NewClient(&redis.Options{Addr: rs.Addr()})
+		cache := &cache.Cache{Client: c}
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		ratingMocked := repoMocks.NewRatingRepositoryMock(t)
+		searchMocked := repoMocks.NewSearchRepositoryMock(t)
+		s := service.NewModerationService(userRepoMocked, movieRepoMocked, nil, ratingMocked, searchMocked, nil, nil, cache, uowMocked, nil)
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		searchMocked.IndexReviewMock.Set(func(ctx context.Context, review repository.Review) error {
+			require.Equal(t, userID, review.UserID)
+			require.Equal(t, movieID, review.MovieID)
+			require.Equal(t, repository.StatusApproved, review.Status)
+			return nil
+		})
+		movieRepoMocked.GetReviewMock.Expect(ctx, userID, movieID).
+			Return(repository.Review{Rating: rating, Status: repository.StatusPending}, nil)
+		userRepoMocked.SetStatusMock.ExpectStatusParam4(repository.StatusApproved).ExpectReasonParam5("").Return(nil)
+		movieRepoMocked.SetStatusMock.ExpectStatusParam4(repository.StatusApproved).ExpectReasonParam5("").Return(nil)
+		ratingMocked.UpdateRatingMock.Expect(ctx, movieID, 0, rating).Return(nil)
+
+		err := s.ApproveReview(ctx, userID, movieID)
+
+		require.NoError(t, err)
+		requireBumped(t, rs, "cache:review:"+movieID, "cache:review:"+userID)
+	})
+
+	t.Run("Reject review removes it from the rating", func(t *testing.T) {
+		t.Parallel()
+
+		rs := miniredis.RunT(t)
+		c := redis.NewClient(&redis.Options{Addr: rs.Addr()})
+		cache := &cache.Cache{Client: c}
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		ratingMocked := repoMocks.NewRatingRepositoryMock(t)
+		searchMocked := repoMocks.NewSearchRepositoryMock(t)
+		s := service.NewModerationService(userRepoMocked, movieRepoMocked, nil, ratingMocked, searchMocked, nil, nil, cache, uowMocked, nil)
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		searchMocked.IndexReviewMock.Return(nil)
+		// reviews stored before moderation have no status and count as approved
+		movieRepoMocked.GetReviewMock.Expect(ctx, userID, movieID).Return(repository.Review{Rating: rating}, nil)
+		userRepoMocked.SetStatusMock.ExpectStatusParam4(repository.StatusRejected).ExpectReasonParam5(reason).Return(nil)
+		movieRepoMocked.SetStatusMock.ExpectStatusParam4(repository.StatusRejected).ExpectReasonParam5(reason).Return(nil)
+		ratingMocked.UpdateRatingMock.Expect(ctx, movieID, rating, 0).Return(nil)
+
+		err := s.RejectReview(ctx, userID, movieID, reason)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Reject review twice returns ErrFailedPrecondition", func(t *testing.T) {
+		t.Parallel()
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		s := service.NewModerationService(nil, movieRepoMocked, nil, nil, nil, nil, nil, nil, uowMocked, nil)
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		movieRepoMocked.GetReviewMock.Expect(ctx, userID, movieID).
+			Return(repository.Review{Rating: rating, Status: repository.StatusRejected}, nil)
+
+		err := s.RejectReview(ctx, userID, movieID, reason)
+
+		require.ErrorIs(t, err, apperrors.ErrFailedPrecondition)
+	})
+
+	t.Run("Approve review returns ErrNotFound", func(t *testing.T) {
+		t.Parallel()
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		s := service.NewModerationService(nil, nil, nil, nil, nil, nil, nil, nil, uowMocked, nil)
+		uowMocked.RunWithinTxMock.Return(repository.ErrNotFound)
+
+		err := s.ApproveReview(ctx, userID, movieID)
+
+		require.ErrorIs(t, err, apperrors.ErrNotFound)
+	})
+
+	t.Run("Approve review returns internal error", func(t *testing.T) {
+		t.Parallel()
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		s := service.NewModerationService(nil, nil, nil, nil, nil, nil, logger.Sugar(), nil, uowMocked, nil)
+		uowMocked.RunWithinTxMock.Return(fmt.Errorf("arbitrary error"))
+
+		err := s.ApproveReview(ctx, userID, movieID)
+
+		require.ErrorIs(t, err, apperrors.ErrInternal)
+	})
+
+	t.Run("List pending reviews returns next page token", func(t *testing.T) {
+		t.Parallel()
+
+		page := []repository.Review{
+			{UserID: gofakeit.UUID(), MovieID: movieID, Rating: rating, Status: repository.StatusPending},
+			{UserID: gofakeit.UUID(), MovieID: movieID, Rating: rating, Status: repository.StatusPending},
+		}
+
+		moderationMocked := repoMocks.NewModerationRepositoryMock(t)
+		s := service.NewModerationService(nil, nil, moderationMocked, nil, nil, nil, nil, nil, nil, paginator)
+
+		moderationMocked.ListReviewsMock.When(ctx, []repository.ReviewStatus{repository.StatusPending, repository.StatusHidden}, 0, 2).Then(page, nil)
+		moderationMocked.ListReviewsMock.When(ctx, []repository.ReviewStatus{repository.StatusPending, repository.StatusHidden}, 1, 2).Then(page[1:], nil)
+
+		first, nextPageToken, err := s.ListPendingReviews(ctx, 1, "")
+
+		require.NoError(t, err)
+		require.Equal(t, page[:1], first)
+		require.NotEmpty(t, nextPageToken)
+
+		second, nextPageToken, err := s.ListPendingReviews(ctx, 1, nextPageToken)
+
+		require.NoError(t, err)
+		require.Equal(t, page[1:], second)
+		require.Empty(t, nextPageToken)
+	})
+}
+
+func TestRestoreReview(t *testing.T) {
+	t.Parallel()
+	var (
+		userID    = gofakeit.UUID()
+		movieID   = gofakeit.UUID()
+		rating    = int32(gofakeit.IntRange(1, 10))
+		ctx       = context.Background()
+		logger, _ = zap.NewDevelopment()
+	)
+
+	t.Run("Restore review puts it back into the rating and search", func(t *testing.T) {
+		t.Parallel()
+
+		c, rs := newCache(t)
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		ratingMocked := repoMocks.NewRatingRepositoryMock(t)
+		searchMocked := repoMocks.NewSearchRepositoryMock(t)
+		s := service.NewModerationService(userRepoMocked, movieRepoMocked, nil, ratingMocked, searchMocked, nil, nil, c, uowMocked, nil)
+
+		deletedAt := time.Now()
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		movieRepoMocked.GetDeletedReviewMock.Expect(ctx, userID, movieID).
+			Return(repository.Review{Rating: rating, Status: repository.StatusApproved, DeletedAt: &deletedAt}, nil)
+		userRepoMocked.RestoreReviewMock.Expect(ctx, userID, movieID).Return(nil)
+		movieRepoMocked.RestoreReviewMock.Expect(ctx, userID, movieID).Return(nil)
+		searchMocked.IndexReviewMock.Set(func(ctx context.Context, review repository.Review) error {
+			require.Equal(t, userID, review.UserID)
+			require.Equal(t, movieID, review.MovieID)
+			require.Nil(t, review.DeletedAt)
+			return nil
+		})
+		ratingMocked.UpdateRatingMock.Expect(ctx, movieID, 0, rating).Return(nil)
+
+		err := s.RestoreReview(ctx, userID, movieID)
+
+		require.NoError(t, err)
+		requireBumped(t, rs, "cache:review:"+movieID, "cache:review:"+userID)
+	})
+
+	t.Run("Restore review returns ErrNotFound", func(t *testing.T) {
+		t.Parallel()
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		s := service.NewModerationService(nil, nil, nil, nil, nil, nil, nil, nil, uowMocked, nil)
+		uowMocked.RunWithinTxMock.Return(repository.ErrNotFound)
+
+		err := s.RestoreReview(ctx, userID, movieID)
+
+		require.ErrorIs(t, err, apperrors.ErrNotFound)
+	})
+
+	t.Run("Restore review returns internal error", func(t *testing.T) {
+		t.Parallel()
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		s := service.NewModerationService(nil, nil, nil, nil, nil, nil, logger.Sugar(), nil, uowMocked, nil)
+		uowMocked.RunWithinTxMock.Return(fmt.Errorf("arbitrary error"))
+
+		err := s.RestoreReview(ctx, userID, movieID)
+
+		require.ErrorIs(t, err, apperrors.ErrInternal)
+	})
+}
@@ -0,0 +1,161 @@
+package unit_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/maisiq/go-ugc-service/internal/producer"
+	prodMocks "github.com/maisiq/go-ugc-service/internal/producer/mocks"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/maisiq/go-ugc-service/pkg/config"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestOutboxRelay(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx       = context.Background()
+		logger, _ = zap.NewDevelopment()
+		cfg       = config.OutboxConfig{RelayInterval: time.Second, BatchSize: 2, Lease: time.Minute, DrainTimeout: time.Second}
+	)
+
+	newEvent := func(t *testing.T, event string) repository.OutboxEvent {
+		payload, err := json.Marshal(producer.NewReviewMessage(event, time.Now(), producer.ReviewPayload{UserID: gofakeit.UUID(), MovieID: gofakeit.UUID()}))
+		require.NoError(t, err)
+		return repository.OutboxEvent{ID: gofakeit.UUID(), Payload: payload, CreatedAt: time.Now().UTC()}
+	}
+
+	t.Run("Relay publishes events in batches and marks them sent", func(t *testing.T) {
+		t.Parallel()
+
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		producerMocked := prodMocks.NewProducerMock(t)
+		s := service.NewOutboxRelay(outboxMocked, producerMocked, logger.Sugar(), cfg)
+
+		batches := [][]repository.OutboxEvent{
+			{newEvent(t, producer.EventReviewCreated), newEvent(t, producer.EventReviewVoted)},
+			{newEvent(t, producer.EventReviewDeleted)},
+		}
+
+		outboxMocked.ClaimEventsMock.Set(func(ctx context.Context, limit int, now, lockedUntil time.Time) ([]repository.OutboxEvent, error) {
+			require.Equal(t, cfg.BatchSize, limit)
+			require.Equal(t, now.Add(cfg.Lease), lockedUntil)
+
+			batch := batches[0]
+			batches = batches[1:]
+			return batch, nil
+		})
+
+		var sent []producer.AnalyticsMessage
+		producerMocked.SendMock.Set(func(ctx context.Context, messages []producer.AnalyticsMessage) error {
+			sent = append(sent, messages...)
+			return nil
+		})
+
+		var marked []string
+		outboxMocked.MarkSentMock.Set(func(ctx context.Context, IDs []string, sentAt time.Time) error {
+			marked = append(marked, IDs...)
+			return nil
+		})
+
+		n, err := s.Relay(ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, 3, n)
+		require.Len(t, sent, 3)
+		require.Equal(t, producer.EventReviewDeleted, sent[2].EventType)
+		require.Len(t, marked, 3)
+		require.Equal(t, uint64(2), outboxMocked.ClaimEventsAfterCounter())
+	})
+
+	t.Run("Relay leaves events unsent when the broker fails", func(t *testing.T) {
+		t.Parallel()
+
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		producerMocked := prodMocks.NewProducerMock(t)
+		s := service.NewOutboxRelay(outboxMocked, producerMocked, logger.Sugar(), cfg)
+
+		outboxMocked.ClaimEventsMock.Return([]repository.OutboxEvent{newEvent(t, producer.EventReviewCreated)}, nil)
+		producerMocked.SendMock.Return(fmt.Errorf("broker is down"))
+
+		n, err := s.Relay(ctx)
+
+		require.Error(t, err)
+		require.Zero(t, n)
+		require.Zero(t, outboxMocked.MarkSentAfterCounter())
+	})
+
+	t.Run("Relay marks malformed events sent without publishing them", func(t *testing.T) {
+		t.Parallel()
+
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		producerMocked := prodMocks.NewProducerMock(t)
+		s := service.NewOutboxRelay(outboxMocked, producerMocked, logger.Sugar(), cfg)
+
+		valid := newEvent(t, producer.EventReviewCreated)
+		malformed := repository.OutboxEvent{ID: gofakeit.UUID(), Payload: []byte("{")}
+
+		batches := [][]repository.OutboxEvent{{valid, malformed}, {}}
+
+		outboxMocked.ClaimEventsMock.Set(func(ctx context.Context, limit int, now, lockedUntil time.Time) ([]repository.OutboxEvent, error) {
+			batch := batches[0]
+			batches = batches[1:]
+			return batch, nil
+		})
+		producerMocked.SendMock.Set(func(ctx context.Context, messages []producer.AnalyticsMessage) error {
+			require.Len(t, messages, 1)
+			return nil
+		})
+		outboxMocked.MarkSentMock.Set(func(ctx context.Context, IDs []string, sentAt time.Time) error {
+			require.Equal(t, []string{valid.ID, malformed.ID}, IDs)
+			return nil
+		})
+
+		n, err := s.Relay(ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+	})
+
+	t.Run("Close drains the outbox", func(t *testing.T) {
+		t.Parallel()
+
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		producerMocked := prodMocks.NewProducerMock(t)
+		s := service.NewOutboxRelay(outboxMocked, producerMocked, logger.Sugar(), cfg)
+
+		outboxMocked.ClaimEventsMock.Return([]repository.OutboxEvent{newEvent(t, producer.EventReviewCreated)}, nil)
+		producerMocked.SendMock.Return(nil)
+		outboxMocked.MarkSentMock.Return(nil)
+
+		s.Start()
+		err := s.Close()
+
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), producerMocked.SendAfterCounter())
+	})
+
+	t.Run("Relay without settings waits the default interval between rounds", func(t *testing.T) {
+		t.Parallel()
+
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		s := service.NewOutboxRelay(outboxMocked, nil, logger.Sugar(), config.OutboxConfig{})
+
+		outboxMocked.ClaimEventsMock.Return([]repository.OutboxEvent{}, nil)
+
+		s.Start()
+		time.Sleep(100 * time.Millisecond)
+		require.Zero(t, outboxMocked.ClaimEventsAfterCounter())
+
+		// only the drain on close claims events
+		require.NoError(t, s.Close())
+		require.Equal(t, uint64(1), outboxMocked.ClaimEventsAfterCounter())
+	})
+}
@@ -0,0 +1,185 @@
+package unit_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/producer"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/maisiq/go-ugc-service/pkg/config"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestProgress(t *testing.T) {
+	t.Parallel()
+	var (
+		userID    = gofakeit.UUID()
+		movieID   = gofakeit.UUID()
+		ctx       = context.Background()
+		logger, _ = zap.NewDevelopment()
+		cfg       = config.ProgressConfig{FlushInterval: time.Second, BatchSize: 100, TTL: time.Hour}
+		duration  = int64(2 * time.Hour / time.Millisecond)
+	)
+
+	t.Run("Report progress buffers the position", func(t *testing.T) {
+		t.Parallel()
+
+		c, rs := newCache(t)
+		s := service.NewProgressService(nil, nil, nil, c, nil, cfg)
+
+		err := s.ReportProgress(ctx, userID, movieID, 1000, duration)
+		require.NoError(t, err)
+
+		progress, err := s.GetProgress(ctx, userID, movieID)
+		require.NoError(t, err)
+		require.Equal(t, int64(1000), progress.PositionMS)
+		require.True(t, rs.Exists("progress:"+userID))
+		require.Greater(t, rs.TTL("progress:"+userID), time.Duration(0))
+
+		members, err := rs.Members("progress:dirty")
+		require.NoError(t, err)
+		require.Equal(t, []string{userID}, members)
+	})
+
+	t.Run("Progress without settings falls back to the defaults", func(t *testing.T) {
+		t.Parallel()
+
+		c, rs := newCache(t)
+		s := service.NewProgressService(nil, nil, logger.Sugar(), c, nil, config.ProgressConfig{})
+
+		require.NoError(t, s.ReportProgress(ctx, userID, movieID, 1000, duration))
+		require.Greater(t, rs.TTL("progress:"+userID), time.Duration(0))
+
+		s.Start()
+		require.NoError(t, s.Close())
+	})
+
+	t.Run("Report progress past the duration returns ErrInvalidArgument", func(t *testing.T) {
+		t.Parallel()
+
+		s := service.NewProgressService(nil, nil, nil, nil, nil, cfg)
+
+		err := s.ReportProgress(ctx, userID, movieID, duration+1, duration)
+
+		require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	})
+
+	t.Run("Get progress falls back to the database", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newCache(t)
+		progressMocked := repoMocks.NewProgressRepositoryMock(t)
+		s := service.NewProgressService(progressMocked, nil, nil, c, nil, cfg)
+
+		progressMocked.GetProgressMock.Expect(ctx, userID, movieID).Return(repository.Progress{}, repository.ErrNotFound)
+
+		_, err := s.GetProgress(ctx, userID, movieID)
+
+		require.ErrorIs(t, err, apperrors.ErrNotFound)
+	})
+
+	t.Run("List in progress merges buffered positions and skips finished movies", func(t *testing.T) {
+		t.Parallel()
+
+		c, rs := newCache(t)
+		progressMocked := repoMocks.NewProgressRepositoryMock(t)
+		s := service.NewProgressService(progressMocked, nil, nil, c, nil, cfg)
+
+		var (
+			now      = time.Now().UTC()
+			finished = gofakeit.UUID()
+			older    = gofakeit.UUID()
+		)
+		// movieID was flushed mid-way and then watched further
+		buffered, _ := json.Marshal(repository.Progress{UserID: userID, MovieID: movieID, PositionMS: 5000, DurationMS: duration, UpdatedAt: now})
+		rs.HSet("progress:"+userID, movieID, string(buffered))
+
+		progressMocked.ListProgressMock.Expect(ctx, userID).Return([]repository.Progress{
+			{UserID: userID, MovieID: movieID, PositionMS: 1000, DurationMS: duration, UpdatedAt: now.Add(-time.Minute)},
+			{UserID: userID, MovieID: finished, PositionMS: duration, DurationMS: duration, UpdatedAt: now.Add(-time.Hour)},
+			{UserID: userID, MovieID: older, PositionMS: 1000, DurationMS: duration, UpdatedAt: now.Add(-2 * time.Hour)},
+		}, nil)
+
+		progress, err := s.ListInProgress(ctx, userID)
+
+		require.NoError(t, err)
+		require.Len(t, progress, 2)
+		require.Equal(t, movieID, progress[0].MovieID)
+		require.Equal(t, int64(5000), progress[0].PositionMS)
+		require.Equal(t, older, progress[1].MovieID)
+	})
+
+	t.Run("Flush writes buffered positions to the database", func(t *testing.T) {
+		t.Parallel()
+
+		c, rs := newCache(t)
+		progressMocked := repoMocks.NewProgressRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		uowMocked := repoMocks.NewUOWMock(t)
+		s := service.NewProgressService(progressMocked, outboxMocked, nil, c, uowMocked, cfg)
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		progressMocked.SaveProgressMock.Set(func(ctx context.Context, progress []repository.Progress) error {
+			require.Len(t, progress, 2)
+			return nil
+		})
+		// one event per flushed position, however many reports came before the flush
+		outboxMocked.AddEventsMock.Set(func(ctx context.Context, events []repository.OutboxEvent) error {
+			messages := outboxMessages(t, events)
+			require.Len(t, messages, 2)
+			for _, msg := range messages {
+				require.Equal(t, producer.EventProgressReported, msg.EventType)
+				require.Contains(t, []int64{1500, 2000}, msg.Progress.PositionMS)
+			}
+			return nil
+		})
+
+		require.NoError(t, s.ReportProgress(ctx, userID, movieID, 1000, duration))
+		require.NoError(t, s.ReportProgress(ctx, userID, movieID, 1500, duration))
+		require.NoError(t, s.ReportProgress(ctx, userID, gofakeit.UUID(), 2000, duration))
+
+		n, err := s.Flush(ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, 2, n)
+		require.False(t, rs.Exists("progress:dirty"))
+		// positions stay readable from redis after the flush
+		require.True(t, rs.Exists("progress:"+userID))
+	})
+
+	t.Run("Failed flush marks the users again", func(t *testing.T) {
+		t.Parallel()
+
+		c, rs := newCache(t)
+		progressMocked := repoMocks.NewProgressRepositoryMock(t)
+		uowMocked := repoMocks.NewUOWMock(t)
+		s := service.NewProgressService(progressMocked, nil, logger.Sugar(), c, uowMocked, cfg)
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+
+		buffered, _ := json.Marshal(repository.Progress{UserID: userID, MovieID: movieID, PositionMS: 1000, DurationMS: duration})
+		rs.HSet("progress:"+userID, movieID, string(buffered))
+		rs.SAdd("progress:dirty", userID)
+
+		progressMocked.SaveProgressMock.Return(fmt.Errorf("arbitrary error"))
+
+		_, err := s.Flush(ctx)
+
+		require.Error(t, err)
+		members, err := rs.Members("progress:dirty")
+		require.NoError(t, err)
+		require.Equal(t, []string{userID}, members)
+	})
+}
@@ -0,0 +1,123 @@
+package unit_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/maisiq/go-ugc-service/pkg/config"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestPurgeReviews(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx       = context.Background()
+		logger, _ = zap.NewDevelopment()
+		retention = 24 * time.Hour
+		cfg       = config.SoftDeleteConfig{Retention: retention, PurgeInterval: time.Hour}
+	)
+
+	t.Run("Purge removes reviews deleted before the retention period", func(t *testing.T) {
+		t.Parallel()
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		moderationMocked := repoMocks.NewModerationRepositoryMock(t)
+		votesMocked, commentsMocked := droppedActivity(t)
+		s := service.NewPurgeService(userRepoMocked, movieRepoMocked, moderationMocked, votesMocked, commentsMocked, logger.Sugar(), uowMocked, cfg)
+
+		deletedAt := time.Now().Add(-2 * retention)
+		deleted := []repository.Review{
+			{UserID: gofakeit.UUID(), MovieID: gofakeit.UUID(), DeletedAt: &deletedAt},
+			{UserID: gofakeit.UUID(), MovieID: gofakeit.UUID(), DeletedAt: &deletedAt},
+		}
+		checkBefore := func(ctx context.Context, userID, movieID string, before time.Time) error {
+			require.WithinDuration(t, time.Now().Add(-retention), before, time.Minute)
+			return nil
+		}
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		moderationMocked.ListDeletedMock.Set(func(ctx context.Context, before time.Time, limit int) ([]repository.Review, error) {
+			require.WithinDuration(t, time.Now().Add(-retention), before, time.Minute)
+			return deleted, nil
+		})
+		movieRepoMocked.GetDeletedReviewMock.Set(func(ctx context.Context, userID, movieID string) (repository.Review, error) {
+			return repository.Review{DeletedAt: &deletedAt}, nil
+		})
+		userRepoMocked.PurgeReviewMock.Set(checkBefore)
+		movieRepoMocked.PurgeReviewMock.Set(checkBefore)
+
+		n, err := s.Purge(ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, 2, n)
+		require.Equal(t, uint64(2), movieRepoMocked.PurgeReviewAfterCounter())
+		require.Equal(t, uint64(2), votesMocked.DeleteReviewVotesAfterCounter())
+		require.Equal(t, uint64(2), commentsMocked.DeleteReviewCommentsAfterCounter())
+		require.Equal(t, uint64(1), moderationMocked.ListDeletedAfterCounter())
+	})
+
+	t.Run("Purge keeps the votes and comments of a review restored since it was listed", func(t *testing.T) {
+		t.Parallel()
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		moderationMocked := repoMocks.NewModerationRepositoryMock(t)
+		s := service.NewPurgeService(nil, movieRepoMocked, moderationMocked, nil, nil, logger.Sugar(), uowMocked, cfg)
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		moderationMocked.ListDeletedMock.Return([]repository.Review{{UserID: gofakeit.UUID(), MovieID: gofakeit.UUID()}}, nil)
+		movieRepoMocked.GetDeletedReviewMock.Return(repository.Review{}, repository.ErrNotFound)
+
+		_, err := s.Purge(ctx)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Purge returns the error of a failed batch", func(t *testing.T) {
+		t.Parallel()
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		moderationMocked := repoMocks.NewModerationRepositoryMock(t)
+		s := service.NewPurgeService(nil, nil, moderationMocked, nil, nil, logger.Sugar(), uowMocked, cfg)
+
+		moderationMocked.ListDeletedMock.Return([]repository.Review{{UserID: gofakeit.UUID(), MovieID: gofakeit.UUID()}}, nil)
+		uowMocked.RunWithinTxMock.Return(fmt.Errorf("arbitrary error"))
+
+		n, err := s.Purge(ctx)
+
+		require.Error(t, err)
+		require.Zero(t, n)
+	})
+
+	t.Run("Purge job stops on close", func(t *testing.T) {
+		t.Parallel()
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		moderationMocked := repoMocks.NewModerationRepositoryMock(t)
+		s := service.NewPurgeService(nil, nil, moderationMocked, nil, nil, logger.Sugar(), uowMocked, cfg)
+		listed := make(chan struct{})
+
+		moderationMocked.ListDeletedMock.Set(func(ctx context.Context, before time.Time, limit int) ([]repository.Review, error) {
+			close(listed)
+			return []repository.Review{}, nil
+		})
+
+		s.Start()
+		<-listed
+
+		require.NoError(t, s.Close())
+	})
+}
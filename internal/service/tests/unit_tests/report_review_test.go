@@ -0,0 +1,206 @@
+package unit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/maisiq/go-ugc-service/internal/cache"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/producer"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestReportReview(t *testing.T) {
+	t.Parallel()
+	var (
+		reporterID = gofakeit.UUID()
+		userID     = gofakeit.UUID()
+		movieID    = gofakeit.UUID()
+		rating     = int32(gofakeit.IntRange(1, 10))
+		text       = gofakeit.Sentence(5)
+		ctx        = context.Background()
+		logger, _  = zap.NewDevelopment()
+	)
+
+	t.Run("Report own review returns ErrInvalidArgument", func(t *testing.T) {
+		t.Parallel()
+
+		s := service.NewReportService(nil, nil, nil, nil, nil, nil, 3)
+
+		err := s.ReportReview(ctx, userID, userID, movieID, repository.ReportSpam, text)
+
+		require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	})
+
+	t.Run("Report missing review returns ErrNotFound", func(t *testing.T) {
+		t.Parallel()
+
+		reviewRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		s := service.NewReportService(reviewRepoMocked, nil, nil, nil, logger.Sugar(), nil, 3)
+		reviewRepoMocked.GetReviewMock.Return(repository.Review{}, repository.ErrNotFound)
+
+		err := s.ReportReview(ctx, reporterID, userID, movieID, repository.ReportSpam, text)
+
+		require.ErrorIs(t, err, apperrors.ErrNotFound)
+	})
+
+	t.Run("Repeated report is ignored", func(t *testing.T) {
+		t.Parallel()
+
+		reviewRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		reportRepoMocked := repoMocks.NewReportRepositoryMock(t)
+		uowMocked := repoMocks.NewUOWMock(t)
+		s := service.NewReportService(reviewRepoMocked, reportRepoMocked, nil, nil, nil, uowMocked, 3)
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		reviewRepoMocked.GetReviewMock.Return(repository.Review{Rating: rating}, nil)
+		reportRepoMocked.AddReportMock.Return(false, nil)
+
+		err := s.ReportReview(ctx, reporterID, userID, movieID, repository.ReportSpam, text)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Report below threshold enqueues event and keeps review visible", func(t *testing.T) {
+		t.Parallel()
+
+		reviewRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		reportRepoMocked := repoMocks.NewReportRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		uowMocked := repoMocks.NewUOWMock(t)
+		s := service.NewReportService(reviewRepoMocked, reportRepoMocked, outboxMocked, nil, nil, uowMocked, 3)
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		reviewRepoMocked.GetReviewMock.Return(repository.Review{Rating: rating}, nil)
+		reportRepoMocked.AddReportMock.Set(func(ctx context.Context, report repository.Report) (bool, error) {
+			require.Equal(t, reporterID, report.ReporterID)
+			require.Equal(t, repository.ReportSpoiler, report.Reason)
+			require.Equal(t, text, report.Text)
+			return true, nil
+		})
+		reportRepoMocked.CountReportsMock.Expect(ctx, userID, movieID, time.Time{}).Return(2, nil)
+		outboxMocked.AddEventsMock.Set(func(ctx context.Context, events []repository.OutboxEvent) error {
+			msgs := outboxMessages(t, events)
+			require.Len(t, msgs, 1)
+			require.Equal(t, producer.EventReviewReported, msgs[0].EventType)
+			require.Equal(t, string(repository.ReportSpoiler), msgs[0].Report.Reason)
+			return nil
+		})
+
+		err := s.ReportReview(ctx, reporterID, userID, movieID, repository.ReportSpoiler, text)
+		require.NoError(t, err)
+	})
+
+	t.Run("Report with failed enqueue returns ErrInternal", func(t *testing.T) {
+		t.Parallel()
+
+		reviewRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		reportRepoMocked := repoMocks.NewReportRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		uowMocked := repoMocks.NewUOWMock(t)
+		s := service.NewReportService(reviewRepoMocked, reportRepoMocked, outboxMocked, nil, logger.Sugar(), uowMocked, 3)
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		reviewRepoMocked.GetReviewMock.Return(repository.Review{Rating: rating}, nil)
+		reportRepoMocked.AddReportMock.Return(true, nil)
+		outboxMocked.AddEventsMock.Return(errors.New("unexpected error"))
+
+		err := s.ReportReview(ctx, reporterID, userID, movieID, repository.ReportSpam, text)
+
+		require.ErrorIs(t, err, apperrors.ErrInternal)
+	})
+
+	t.Run("Report reaching threshold hides the review", func(t *testing.T) {
+		t.Parallel()
+
+		rs := miniredis.RunT(t)
+		c := redis.NewClient(&redis.Options{Addr: rs.Addr()})
+		cache := &cache.Cache{Client: c}
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		ratingMocked := repoMocks.NewRatingRepositoryMock(t)
+		reportRepoMocked := repoMocks.NewReportRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		searchMocked := repoMocks.NewSearchRepositoryMock(t)
+		moderation := service.NewModerationService(userRepoMocked, movieRepoMocked, nil, ratingMocked, searchMocked, nil, nil, cache, uowMocked, nil)
+		s := service.NewReportService(movieRepoMocked, reportRepoMocked, outboxMocked, moderation, nil, uowMocked, 3)
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		searchMocked.IndexReviewMock.Return(nil)
+		movieRepoMocked.GetReviewMock.Expect(ctx, userID, movieID).Return(repository.Review{Rating: rating}, nil)
+		reportRepoMocked.AddReportMock.Return(true, nil)
+		reportRepoMocked.CountReportsMock.Return(3, nil)
+		userRepoMocked.SetStatusMock.ExpectStatusParam4(repository.StatusHidden).ExpectReasonParam5("reported by 3 users").Return(nil)
+		movieRepoMocked.SetStatusMock.ExpectStatusParam4(repository.StatusHidden).ExpectReasonParam5("reported by 3 users").Return(nil)
+		ratingMocked.UpdateRatingMock.Expect(ctx, movieID, rating, 0).Return(nil)
+		outboxMocked.AddEventsMock.Return(nil)
+
+		err := s.ReportReview(ctx, reporterID, userID, movieID, repository.ReportHate, text)
+		require.NoError(t, err)
+	})
+
+	t.Run("Reports filed before a moderator approved the review do not count", func(t *testing.T) {
+		t.Parallel()
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		reportRepoMocked := repoMocks.NewReportRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		s := service.NewReportService(movieRepoMocked, reportRepoMocked, outboxMocked, nil, nil, uowMocked, 3)
+
+		approvedAt := time.Now().UTC().Add(-time.Hour)
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		movieRepoMocked.GetReviewMock.Return(repository.Review{Rating: rating, Status: repository.StatusApproved, ModeratedAt: &approvedAt}, nil)
+		reportRepoMocked.AddReportMock.Return(true, nil)
+		reportRepoMocked.CountReportsMock.Expect(ctx, userID, movieID, approvedAt).Return(1, nil)
+		outboxMocked.AddEventsMock.Return(nil)
+
+		err := s.ReportReview(ctx, reporterID, userID, movieID, repository.ReportHate, text)
+		require.NoError(t, err)
+	})
+
+	t.Run("Report past threshold of a hidden review leaves it as it is", func(t *testing.T) {
+		t.Parallel()
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		reportRepoMocked := repoMocks.NewReportRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		moderation := service.NewModerationService(nil, movieRepoMocked, nil, nil, nil, nil, nil, nil, uowMocked, nil)
+		s := service.NewReportService(movieRepoMocked, reportRepoMocked, outboxMocked, moderation, nil, uowMocked, 3)
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		movieRepoMocked.GetReviewMock.Return(repository.Review{Rating: rating, Status: repository.StatusHidden}, nil)
+		reportRepoMocked.AddReportMock.Return(true, nil)
+		reportRepoMocked.CountReportsMock.Return(4, nil)
+		outboxMocked.AddEventsMock.Return(nil)
+
+		err := s.ReportReview(ctx, reporterID, userID, movieID, repository.ReportHate, text)
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), movieRepoMocked.GetReviewAfterCounter())
+	})
+}
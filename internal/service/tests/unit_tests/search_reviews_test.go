@@ -0,0 +1,79 @@
+package unit_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/pagination"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/maisiq/go-ugc-service/pkg/config"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSearchReviews(t *testing.T) {
+	t.Parallel()
+	var (
+		movieID   = gofakeit.UUID()
+		ctx       = context.Background()
+		logger, _ = zap.NewDevelopment()
+		paginator = pagination.New(config.PaginationConfig{DefaultPageSize: 20, MaxPageSize: 100, TokenSecret: "secret"})
+	)
+
+	t.Run("Search returns highlighted hits and next page token", func(t *testing.T) {
+		t.Parallel()
+
+		hits := []repository.SearchHit{
+			{Review: repository.Review{UserID: gofakeit.UUID(), MovieID: movieID, Text: "Great acting"}, Score: 1.5},
+			{Review: repository.Review{UserID: gofakeit.UUID(), MovieID: movieID, Text: "Acting was fine"}, Score: 1.1},
+		}
+
+		searchMocked := repoMocks.NewSearchRepositoryMock(t)
+		s := service.NewSearchService(searchMocked, nil, paginator)
+
+		searchMocked.SearchMock.When(ctx, "acting", "", movieID, 0, 2).Then(hits, nil)
+		searchMocked.SearchMock.When(ctx, "acting", "", movieID, 1, 2).Then(hits[1:], nil)
+
+		first, nextPageToken, err := s.SearchReviews(ctx, "acting", "", movieID, 1, "")
+
+		require.NoError(t, err)
+		require.Len(t, first, 1)
+		require.Equal(t, "Great <em>acting</em>", first[0].Snippet)
+		require.NotEmpty(t, nextPageToken)
+
+		second, nextPageToken, err := s.SearchReviews(ctx, "acting", "", movieID, 1, nextPageToken)
+
+		require.NoError(t, err)
+		require.Len(t, second, 1)
+		require.Equal(t, "<em>Acting</em> was fine", second[0].Snippet)
+		require.Empty(t, nextPageToken)
+	})
+
+	t.Run("Search rejects a token issued for another query", func(t *testing.T) {
+		t.Parallel()
+
+		s := service.NewSearchService(nil, nil, paginator)
+		token := paginator.NextToken(20, "search", "acting", movieID, "")
+
+		_, _, err := s.SearchReviews(ctx, "directing", "", movieID, 0, token)
+
+		require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	})
+
+	t.Run("Search returns internal error", func(t *testing.T) {
+		t.Parallel()
+
+		searchMocked := repoMocks.NewSearchRepositoryMock(t)
+		s := service.NewSearchService(searchMocked, logger.Sugar(), paginator)
+		searchMocked.SearchMock.Return(nil, fmt.Errorf("arbitrary error"))
+
+		_, _, err := s.SearchReviews(ctx, "acting", "", "", 0, "")
+
+		require.ErrorIs(t, err, apperrors.ErrInternal)
+	})
+}
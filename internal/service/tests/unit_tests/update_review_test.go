@@ -7,9 +7,11 @@ import (
 
 	"github.com/brianvoe/gofakeit/v7"
 	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/producer"
 	"github.com/maisiq/go-ugc-service/internal/repository"
 	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
 	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/maisiq/go-ugc-service/pkg/config"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
@@ -20,30 +22,38 @@ func TestUpdateReview(t *testing.T) {
 		userID     = gofakeit.UUID()
 		movieID    = gofakeit.UUID()
 		reviewText = gofakeit.Comment()
+		rating     = int32(gofakeit.IntRange(1, 10))
 		ctx        = context.Background()
 		logger, _  = zap.NewDevelopment()
 	)
-	t.Run("Update review returns nil", func(t *testing.T) {
+	t.Run("Update review returns nil and drops the cached review, listings and summary", func(t *testing.T) {
 		t.Parallel()
 
+		c, rs := newCache(t)
+		key := fmt.Sprintf("cache:review:%v:%v", movieID, userID)
+		rs.Set(key, "{}")
+		rs.Set(fmt.Sprintf("cache:review:%v:summary", movieID), "{}")
+
 		uowMocked := repoMocks.NewUOWMock(t)
-		s := service.NewUGCService(nil, nil, nil, nil, nil, uowMocked)
+		s := service.NewUGCService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, c, uowMocked, nil, config.ModerationConfig{}, nil)
 		uowMocked.RunWithinTxMock.Return(nil)
 
-		err := s.UpdateReview(ctx, userID, movieID, reviewText)
+		err := s.UpdateReview(ctx, userID, movieID, reviewText, rating, 0, nil)
 
 		require.NoError(t, err)
-
+		require.False(t, rs.Exists(key))
+		require.False(t, rs.Exists(fmt.Sprintf("cache:review:%v:summary", movieID)))
+		requireBumped(t, rs, "cache:review:"+movieID, "cache:review:"+userID)
 	})
 
 	t.Run("Update review returns ErrNotFound", func(t *testing.T) {
 		t.Parallel()
 
 		uowMocked := repoMocks.NewUOWMock(t)
-		s := service.NewUGCService(nil, nil, nil, nil, nil, uowMocked)
+		s := service.NewUGCService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, uowMocked, nil, config.ModerationConfig{}, nil)
 		uowMocked.RunWithinTxMock.Return(repository.ErrNotFound)
 
-		err := s.UpdateReview(ctx, userID, movieID, reviewText)
+		err := s.UpdateReview(ctx, userID, movieID, reviewText, rating, 0, nil)
 
 		require.ErrorIs(t, err, apperrors.ErrNotFound)
 	})
@@ -52,11 +62,162 @@ func TestUpdateReview(t *testing.T) {
 		t.Parallel()
 
 		uowMocked := repoMocks.NewUOWMock(t)
-		s := service.NewUGCService(nil, nil, logger.Sugar(), nil, nil, uowMocked)
+		s := service.NewUGCService(nil, nil, nil, nil, nil, nil, nil, nil, logger.Sugar(), nil, nil, uowMocked, nil, config.ModerationConfig{}, nil)
 		uowMocked.RunWithinTxMock.Return(fmt.Errorf("arbitrary error"))
 
-		err := s.UpdateReview(ctx, userID, movieID, reviewText)
+		err := s.UpdateReview(ctx, userID, movieID, reviewText, rating, 0, nil)
 
 		require.ErrorIs(t, err, apperrors.ErrInternal)
 	})
+
+	t.Run("Update review replaces old rating in the movie aggregate", func(t *testing.T) {
+		t.Parallel()
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		ratingMocked := repoMocks.NewRatingRepositoryMock(t)
+		searchMocked := repoMocks.NewSearchRepositoryMock(t)
+		c, _ := newCache(t)
+		revisionMocked := repoMocks.NewRevisionRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		s := service.NewUGCService(userRepoMocked, movieRepoMocked, ratingMocked, searchMocked, revisionMocked, outboxMocked, nil, nil, nil, nil, c, uowMocked, nil, config.ModerationConfig{}, nil)
+
+		oldRating := rating%10 + 1
+		checkReview := func(ctx context.Context, review repository.Review, fields ...repository.ReviewField) error {
+			require.Equal(t, reviewText, review.Text)
+			require.Equal(t, rating, review.Rating)
+			require.False(t, review.UpdatedAt.IsZero())
+			return nil
+		}
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		searchMocked.IndexReviewMock.Set(func(ctx context.Context, review repository.Review) error {
+			require.Equal(t, int64(1), review.Version)
+			return nil
+		})
+		movieRepoMocked.GetReviewMock.Expect(ctx, userID, movieID).Return(repository.Review{Text: "old text", Rating: oldRating}, nil)
+		revisionMocked.AddRevisionMock.Set(func(ctx context.Context, revision repository.Revision) error {
+			require.Equal(t, "old text", revision.Text)
+			require.Equal(t, oldRating, revision.Rating)
+			require.Equal(t, int64(0), revision.Version)
+			require.Equal(t, userID, revision.EditorID)
+			require.False(t, revision.EditedAt.IsZero())
+			return nil
+		})
+		userRepoMocked.UpdateReviewMock.Set(checkReview)
+		movieRepoMocked.UpdateReviewMock.Set(checkReview)
+		ratingMocked.UpdateRatingMock.Expect(ctx, movieID, oldRating, rating).Return(nil)
+		outboxMocked.AddEventsMock.Set(func(ctx context.Context, events []repository.OutboxEvent) error {
+			msgs := outboxMessages(t, events)
+			require.Len(t, msgs, 1)
+			require.Equal(t, producer.EventReviewUpdated, msgs[0].EventType)
+			require.Equal(t, userID, msgs[0].Review.UserID)
+			require.Equal(t, rating, msgs[0].Review.Rating)
+			require.Equal(t, int64(1), msgs[0].Review.Version)
+			return nil
+		})
+
+		err := s.UpdateReview(ctx, userID, movieID, reviewText, rating, 0, nil)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Update review of a changed version returns the current one", func(t *testing.T) {
+		t.Parallel()
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		s := service.NewUGCService(nil, movieRepoMocked, nil, nil, nil, nil, nil, nil, nil, nil, nil, uowMocked, nil, config.ModerationConfig{}, nil)
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		movieRepoMocked.GetReviewMock.Expect(ctx, userID, movieID).Return(repository.Review{Rating: rating, Version: 3}, nil)
+
+		err := s.UpdateReview(ctx, userID, movieID, reviewText, rating, 2, nil)
+
+		var conflict *apperrors.VersionConflictError
+		require.ErrorIs(t, err, apperrors.ErrVersionConflict)
+		require.ErrorAs(t, err, &conflict)
+		require.Equal(t, int64(3), conflict.Current)
+	})
+
+	t.Run("Update review caught by the version filter returns the version read again", func(t *testing.T) {
+		t.Parallel()
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		revisionMocked := repoMocks.NewRevisionRepositoryMock(t)
+		s := service.NewUGCService(userRepoMocked, movieRepoMocked, nil, nil, revisionMocked, nil, nil, nil, logger.Sugar(), nil, nil, uowMocked, nil, config.ModerationConfig{}, nil)
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		// the edit lands between the read in the transaction and the update
+		versions := []int64{2, 3}
+		movieRepoMocked.GetReviewMock.Set(func(ctx context.Context, UserID, MovieID string) (repository.Review, error) {
+			version := versions[0]
+			versions = versions[1:]
+			return repository.Review{Rating: rating, Version: version}, nil
+		})
+		revisionMocked.AddRevisionMock.Return(nil)
+		userRepoMocked.UpdateReviewMock.Return(repository.ErrVersionConflict)
+
+		err := s.UpdateReview(ctx, userID, movieID, reviewText, rating, 2, nil)
+
+		var conflict *apperrors.VersionConflictError
+		require.ErrorAs(t, err, &conflict)
+		require.Equal(t, int64(3), conflict.Current)
+	})
+
+	t.Run("Update review with a field list changes only those fields", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newCache(t)
+		uowMocked := repoMocks.NewUOWMock(t)
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		ratingMocked := repoMocks.NewRatingRepositoryMock(t)
+		searchMocked := repoMocks.NewSearchRepositoryMock(t)
+		revisionMocked := repoMocks.NewRevisionRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		s := service.NewUGCService(userRepoMocked, movieRepoMocked, ratingMocked, searchMocked, revisionMocked, outboxMocked, nil, nil, nil, nil, c, uowMocked, nil, config.ModerationConfig{PreModerate: true}, nil)
+
+		oldRating := rating%10 + 1
+		current := repository.Review{Text: "old text", Rating: oldRating, Status: repository.StatusApproved}
+		checkReview := func(ctx context.Context, review repository.Review, fields ...repository.ReviewField) error {
+			require.Equal(t, []repository.ReviewField{repository.FieldRating}, fields)
+			require.Equal(t, rating, review.Rating)
+			require.Equal(t, repository.StatusApproved, review.Status)
+			return nil
+		}
+
+		uowMocked.RunWithinTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		movieRepoMocked.GetReviewMock.Expect(ctx, userID, movieID).Return(current, nil)
+		revisionMocked.AddRevisionMock.Return(nil)
+		userRepoMocked.UpdateReviewMock.Set(checkReview)
+		movieRepoMocked.UpdateReviewMock.Set(checkReview)
+		searchMocked.IndexReviewMock.Set(func(ctx context.Context, review repository.Review) error {
+			require.Equal(t, "old text", review.Text)
+			require.Equal(t, rating, review.Rating)
+			return nil
+		})
+		ratingMocked.UpdateRatingMock.Expect(ctx, movieID, oldRating, rating).Return(nil)
+		outboxMocked.AddEventsMock.Set(func(ctx context.Context, events []repository.OutboxEvent) error {
+			msgs := outboxMessages(t, events)
+			require.Len(t, msgs, 1)
+			require.Equal(t, []string{string(repository.FieldRating)}, msgs[0].Review.Fields)
+			return nil
+		})
+
+		err := s.UpdateReview(ctx, userID, movieID, "", rating, 0, []repository.ReviewField{repository.FieldRating})
+
+		require.NoError(t, err)
+	})
 }
@@ -0,0 +1,183 @@
+package unit_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/maisiq/go-ugc-service/internal/cache"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/producer"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestVoteReview(t *testing.T) {
+	t.Parallel()
+	var (
+		voterID   = gofakeit.UUID()
+		userID    = gofakeit.UUID()
+		movieID   = gofakeit.UUID()
+		ctx       = context.Background()
+		logger, _ = zap.NewDevelopment()
+	)
+
+	newCache := func(t *testing.T) *cache.Cache {
+		rs := miniredis.RunT(t)
+		return &cache.Cache{Client: redis.NewClient(&redis.Options{Addr: rs.Addr()})}
+	}
+
+	runTx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+
+	cases := []struct {
+		name     string
+		previous int32
+		vote     int32
+		likes    int64
+		dislikes int64
+	}{
+		{name: "First up vote adds a like", previous: 0, vote: repository.VoteUp, likes: 1},
+		{name: "First down vote adds a dislike", previous: 0, vote: repository.VoteDown, dislikes: 1},
+		{name: "Changing vote moves it", previous: repository.VoteUp, vote: repository.VoteDown, likes: -1, dislikes: 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uowMocked := repoMocks.NewUOWMock(t)
+			userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+			movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+			voteMocked := repoMocks.NewVoteRepositoryMock(t)
+			outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+			s := service.NewVoteService(userRepoMocked, movieRepoMocked, voteMocked, outboxMocked, nil, newCache(t), uowMocked)
+
+			uowMocked.RunWithinTxMock.Set(runTx)
+			voteMocked.SetVoteMock.Return(tc.previous, nil)
+			userRepoMocked.IncrementVotesMock.Expect(ctx, userID, movieID, tc.likes, tc.dislikes).Return(nil)
+			movieRepoMocked.IncrementVotesMock.Expect(ctx, userID, movieID, tc.likes, tc.dislikes).Return(nil)
+			outboxMocked.AddEventsMock.Set(func(ctx context.Context, events []repository.OutboxEvent) error {
+				msgs := outboxMessages(t, events)
+				if len(msgs) != 1 || msgs[0].EventType != producer.EventReviewVoted || msgs[0].Vote.VoterID != voterID || msgs[0].Vote.Vote != tc.vote {
+					t.Errorf("unexpected messages: %+v", msgs)
+				}
+				return nil
+			})
+
+			err := s.VoteReview(ctx, voterID, userID, movieID, tc.vote)
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("Repeated vote is idempotent", func(t *testing.T) {
+		t.Parallel()
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		voteMocked := repoMocks.NewVoteRepositoryMock(t)
+		s := service.NewVoteService(nil, nil, voteMocked, nil, nil, nil, uowMocked)
+
+		uowMocked.RunWithinTxMock.Set(runTx)
+		voteMocked.SetVoteMock.Return(repository.VoteUp, nil)
+
+		err := s.VoteReview(ctx, voterID, userID, movieID, repository.VoteUp)
+		require.NoError(t, err)
+	})
+
+	t.Run("Voting for own review returns ErrInvalidArgument", func(t *testing.T) {
+		t.Parallel()
+
+		s := service.NewVoteService(nil, nil, nil, nil, nil, nil, nil)
+
+		err := s.VoteReview(ctx, userID, userID, movieID, repository.VoteUp)
+		require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	})
+
+	t.Run("Voting for missing review returns ErrNotFound", func(t *testing.T) {
+		t.Parallel()
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		s := service.NewVoteService(nil, nil, nil, nil, nil, nil, uowMocked)
+		uowMocked.RunWithinTxMock.Return(repository.ErrNotFound)
+
+		err := s.VoteReview(ctx, voterID, userID, movieID, repository.VoteUp)
+		require.ErrorIs(t, err, apperrors.ErrNotFound)
+	})
+
+	t.Run("Remove vote takes back the counter", func(t *testing.T) {
+		t.Parallel()
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		movieRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		voteMocked := repoMocks.NewVoteRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		s := service.NewVoteService(userRepoMocked, movieRepoMocked, voteMocked, outboxMocked, nil, newCache(t), uowMocked)
+
+		uowMocked.RunWithinTxMock.Set(runTx)
+		voteMocked.DeleteVoteMock.Expect(ctx, voterID, userID, movieID).Return(repository.VoteDown, nil)
+		userRepoMocked.IncrementVotesMock.Expect(ctx, userID, movieID, 0, -1).Return(nil)
+		movieRepoMocked.IncrementVotesMock.Expect(ctx, userID, movieID, 0, -1).Return(nil)
+		outboxMocked.AddEventsMock.Set(func(ctx context.Context, events []repository.OutboxEvent) error {
+			msgs := outboxMessages(t, events)
+			if len(msgs) != 1 || msgs[0].EventType != producer.EventReviewVoteRemoved {
+				t.Errorf("unexpected messages: %+v", msgs)
+			}
+			return nil
+		})
+
+		err := s.RemoveVote(ctx, voterID, userID, movieID)
+		require.NoError(t, err)
+	})
+
+	t.Run("Remove missing vote is a no-op", func(t *testing.T) {
+		t.Parallel()
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		voteMocked := repoMocks.NewVoteRepositoryMock(t)
+		s := service.NewVoteService(nil, nil, voteMocked, nil, nil, nil, uowMocked)
+
+		uowMocked.RunWithinTxMock.Set(runTx)
+		voteMocked.DeleteVoteMock.Return(0, repository.ErrNotFound)
+
+		err := s.RemoveVote(ctx, voterID, userID, movieID)
+		require.NoError(t, err)
+	})
+
+	t.Run("Remove vote of a deleted review removes the vote without counters", func(t *testing.T) {
+		t.Parallel()
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		userRepoMocked := repoMocks.NewReviewRepositoryMock(t)
+		voteMocked := repoMocks.NewVoteRepositoryMock(t)
+		outboxMocked := repoMocks.NewOutboxRepositoryMock(t)
+		s := service.NewVoteService(userRepoMocked, nil, voteMocked, outboxMocked, nil, newCache(t), uowMocked)
+
+		uowMocked.RunWithinTxMock.Set(runTx)
+		voteMocked.DeleteVoteMock.Return(repository.VoteUp, nil)
+		userRepoMocked.IncrementVotesMock.Return(repository.ErrNotFound)
+		outboxMocked.AddEventsMock.Return(nil)
+
+		err := s.RemoveVote(ctx, voterID, userID, movieID)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), outboxMocked.AddEventsAfterCounter())
+	})
+
+	t.Run("Remove vote returns internal error", func(t *testing.T) {
+		t.Parallel()
+
+		uowMocked := repoMocks.NewUOWMock(t)
+		s := service.NewVoteService(nil, nil, nil, nil, logger.Sugar(), nil, uowMocked)
+		uowMocked.RunWithinTxMock.Return(fmt.Errorf("arbitrary error"))
+
+		err := s.RemoveVote(ctx, voterID, userID, movieID)
+		require.ErrorIs(t, err, apperrors.ErrInternal)
+	})
+}
@@ -0,0 +1,113 @@
+package unit_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	repoMocks "github.com/maisiq/go-ugc-service/internal/repository/mocks"
+	"github.com/maisiq/go-ugc-service/internal/service"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestWatchMovieReviews(t *testing.T) {
+	t.Parallel()
+	var (
+		userID    = gofakeit.UUID()
+		movieID   = gofakeit.UUID()
+		ctx       = context.Background()
+		logger, _ = zap.NewDevelopment()
+	)
+
+	t.Run("Watch sends only public changes", func(t *testing.T) {
+		t.Parallel()
+
+		watcherMocked := repoMocks.NewReviewWatcherMock(t)
+		s := service.NewFeedService(watcherMocked, nil)
+
+		watcherMocked.WatchMock.Set(func(ctx context.Context, movieID, resumeToken string, fn func(repository.ReviewEvent) error) error {
+			require.Equal(t, "token", resumeToken)
+
+			for _, event := range []repository.ReviewEvent{
+				{Type: repository.ReviewCreated, Review: repository.Review{UserID: userID, MovieID: movieID, Status: repository.StatusApproved}, ResumeToken: "1"},
+				{Type: repository.ReviewCreated, Review: repository.Review{UserID: gofakeit.UUID(), MovieID: movieID, Status: repository.StatusPending}, ResumeToken: "2"},
+				{Type: repository.ReviewUpdated, Review: repository.Review{UserID: userID, MovieID: movieID, Text: "hidden", Status: repository.StatusHidden}, ResumeToken: "3"},
+			} {
+				if err := fn(event); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+
+		var sent []repository.ReviewEvent
+		err := s.WatchMovieReviews(ctx, movieID, "token", func(event repository.ReviewEvent) error {
+			sent = append(sent, event)
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Len(t, sent, 2)
+		require.Equal(t, repository.ReviewCreated, sent[0].Type)
+		require.Equal(t, repository.ReviewDeleted, sent[1].Type)
+		require.Equal(t, repository.Review{UserID: userID, MovieID: movieID}, sent[1].Review)
+		require.Equal(t, "3", sent[1].ResumeToken)
+	})
+
+	t.Run("Watch returns the send error", func(t *testing.T) {
+		t.Parallel()
+
+		watcherMocked := repoMocks.NewReviewWatcherMock(t)
+		s := service.NewFeedService(watcherMocked, nil)
+		sendErr := fmt.Errorf("client is gone")
+
+		watcherMocked.WatchMock.Set(func(ctx context.Context, movieID, resumeToken string, fn func(repository.ReviewEvent) error) error {
+			return fn(repository.ReviewEvent{Type: repository.ReviewDeleted})
+		})
+
+		err := s.WatchMovieReviews(ctx, movieID, "", func(event repository.ReviewEvent) error {
+			return sendErr
+		})
+
+		require.ErrorIs(t, err, sendErr)
+	})
+
+	t.Run("Watch with invalid resume token returns ErrInvalidArgument", func(t *testing.T) {
+		t.Parallel()
+
+		watcherMocked := repoMocks.NewReviewWatcherMock(t)
+		s := service.NewFeedService(watcherMocked, logger.Sugar())
+		watcherMocked.WatchMock.Return(repository.ErrInvalidResumeToken)
+
+		err := s.WatchMovieReviews(ctx, movieID, "garbage", func(event repository.ReviewEvent) error { return nil })
+
+		require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	})
+
+	t.Run("Close ends open streams", func(t *testing.T) {
+		t.Parallel()
+
+		watcherMocked := repoMocks.NewReviewWatcherMock(t)
+		s := service.NewFeedService(watcherMocked, nil)
+		started := make(chan struct{})
+
+		watcherMocked.WatchMock.Set(func(ctx context.Context, movieID, resumeToken string, fn func(repository.ReviewEvent) error) error {
+			close(started)
+			<-ctx.Done()
+			return nil
+		})
+
+		done := make(chan error)
+		go func() {
+			done <- s.WatchMovieReviews(ctx, movieID, "", func(event repository.ReviewEvent) error { return nil })
+		}()
+
+		<-started
+		require.NoError(t, s.Close())
+		require.NoError(t, <-done)
+	})
+}
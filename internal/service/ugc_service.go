@@ -3,80 +3,190 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/maisiq/go-ugc-service/internal/cache"
 	"github.com/maisiq/go-ugc-service/internal/db"
 	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/filter"
+	"github.com/maisiq/go-ugc-service/internal/pagination"
 	"github.com/maisiq/go-ugc-service/internal/producer"
 	"github.com/maisiq/go-ugc-service/internal/repository"
+	"github.com/maisiq/go-ugc-service/pkg/config"
 	"go.uber.org/zap"
 )
 
 type UGCService struct {
-	userRepo  repository.ReviewRepository
-	movieRepo repository.ReviewRepository
-	log       *zap.SugaredLogger
-	producer  producer.Producer
-	cache     *cache.Cache
-	uow       db.UOW
+	userRepo     repository.ReviewRepository
+	movieRepo    repository.ReviewRepository
+	ratingRepo   repository.RatingRepository
+	searchRepo   repository.SearchRepository
+	revisionRepo repository.RevisionRepository
+	outboxRepo   repository.OutboxRepository
+	voteRepo     repository.VoteRepository
+	commentRepo  repository.CommentRepository
+	log          *zap.SugaredLogger
+	producer     producer.Producer
+	cache        *cache.Cache
+	uow          db.UOW
+	paginator    *pagination.Paginator
+	moderation   config.ModerationConfig
+	filters      filter.Chain
 }
 
 func NewUGCService(
 	userRepo repository.ReviewRepository,
 	movieRepo repository.ReviewRepository,
+	ratingRepo repository.RatingRepository,
+	searchRepo repository.SearchRepository,
+	revisionRepo repository.RevisionRepository,
+	outboxRepo repository.OutboxRepository,
+	voteRepo repository.VoteRepository,
+	commentRepo repository.CommentRepository,
 	log *zap.SugaredLogger,
 	producer producer.Producer,
 	cache *cache.Cache,
 	uow db.UOW,
+	paginator *pagination.Paginator,
+	moderation config.ModerationConfig,
+	filters filter.Chain,
 ) *UGCService {
 	return &UGCService{
-		userRepo:  userRepo,
-		movieRepo: movieRepo,
-		log:       log,
-		producer:  producer,
-		cache:     cache,
-		uow:       uow,
+		userRepo:     userRepo,
+		movieRepo:    movieRepo,
+		ratingRepo:   ratingRepo,
+		searchRepo:   searchRepo,
+		revisionRepo: revisionRepo,
+		outboxRepo:   outboxRepo,
+		voteRepo:     voteRepo,
+		commentRepo:  commentRepo,
+		log:          log,
+		producer:     producer,
+		cache:        cache,
+		uow:          uow,
+		paginator:    paginator,
+		moderation:   moderation,
+		filters:      filters,
 	}
 }
 
-func (s *UGCService) GetReviews(ctx context.Context, UserID, MovieID string) ([]repository.Review, error) {
-	key := cache.BuildKey("review", MovieID, UserID)
+// GetReviews returns approved reviews of the user or the movie. When ViewerID is
+// the author of the listed user reviews, their pending reviews are returned as well.
+func (s *UGCService) GetReviews(ctx context.Context, UserID, MovieID, ViewerID string, Sort repository.ReviewSort, PageSize int32, PageToken string) ([]repository.Review, string, error) {
+	sortKey := strconv.Itoa(int(Sort))
+
+	statuses := []repository.ReviewStatus{repository.StatusApproved}
+	var scope string
+
+	if UserID != "" && UserID == ViewerID {
+		statuses = append(statuses, repository.StatusPending)
+		scope = "own"
+	}
+
+	query := []string{MovieID, UserID, scope, sortKey}
+
+	var cursor repository.ReviewCursor
+	var after *repository.ReviewCursor
+
+	ok, err := s.paginator.Cursor(PageToken, &cursor, query...)
+	if err != nil {
+		return []repository.Review{}, "", apperrors.ErrInvalidArgument
+	}
+	if ok {
+		after = &cursor
+	}
+	limit := s.paginator.PageSize(PageSize)
 
 	var fn func() ([]repository.Review, error)
+	var listing string
 
+	// one extra review tells whether there is a next page
 	if MovieID == "" {
+		listing = UserID
 		fn = func() ([]repository.Review, error) {
-			return s.userRepo.GetReviews(ctx, UserID)
+			return s.userRepo.GetReviews(ctx, UserID, statuses, Sort, after, limit+1)
 		}
 	} else if UserID == "" {
+		listing = MovieID
 		fn = func() ([]repository.Review, error) {
-			return s.movieRepo.GetReviews(ctx, MovieID)
+			return s.movieRepo.GetReviews(ctx, MovieID, statuses, Sort, after, limit+1)
 		}
 	}
 
+	gen, err := s.cache.Generation(ctx, reviewsScope(listing))
+	if err != nil {
+		s.log.Errorf("failed to get review cache generation: %v", err)
+		return []repository.Review{}, "", apperrors.ErrInternal
+	}
+
+	key := cache.BuildKey("review", listing, "page", gen, scope, sortKey, cache.HashArgs(PageToken), strconv.Itoa(limit))
+
 	reviews, err := cache.GetOrSet(s.cache, ctx, key, time.Minute, fn)
 
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
-			return []repository.Review{}, apperrors.ErrNotFound
+			return []repository.Review{}, "", apperrors.ErrNotFound
 		}
 		s.log.Errorw("failed to get review",
 			"err", err,
 		)
-		return []repository.Review{}, apperrors.ErrInternal
+		return []repository.Review{}, "", apperrors.ErrInternal
+	}
+
+	var nextPageToken string
+
+	if len(reviews) > limit {
+		reviews = reviews[:limit]
+		nextPageToken = s.paginator.CursorToken(repository.CursorAfter(reviews[limit-1]), query...)
+	}
+
+	return reviews, nextPageToken, nil
+}
+
+// GetReview returns the review of the user for the movie. Reviews that are not
+// visible are only returned to their author while pending.
+func (s *UGCService) GetReview(ctx context.Context, UserID, MovieID, ViewerID string) (repository.Review, error) {
+	review, err := cache.GetOrSet(s.cache, ctx, reviewKey(UserID, MovieID), time.Minute, func() (repository.Review, error) {
+		return s.movieRepo.GetReview(ctx, UserID, MovieID)
+	})
+
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return repository.Review{}, apperrors.ErrNotFound
+		}
+		s.log.Errorf("failed to get review: %v", err)
+		return repository.Review{}, apperrors.ErrInternal
+	}
+
+	if !review.Visible() && !(review.Status == repository.StatusPending && UserID == ViewerID) {
+		return repository.Review{}, apperrors.ErrNotFound
 	}
 
-	return reviews, nil
+	return review, nil
 }
 
-func (s *UGCService) CreateReview(ctx context.Context, UserID, MovieID, Text string) error {
+func (s *UGCService) CreateReview(ctx context.Context, UserID, MovieID, Text string, Rating int32) error {
+	verdict := s.filters.Check(Text)
+	if verdict.Decision == filter.Reject {
+		return fmt.Errorf("%w: %s", apperrors.ErrContentRejected, verdict.Reason)
+	}
+
+	now := time.Now().UTC()
 
 	review := repository.Review{
-		UserID:  UserID,
-		MovieID: MovieID,
-		Text:    Text,
+		UserID:    UserID,
+		MovieID:   MovieID,
+		Text:      Text,
+		Rating:    Rating,
+		CreatedAt: now,
+		UpdatedAt: now,
+		Status:    s.newStatus(),
+		Version:   1,
 	}
+	flag(&review, verdict)
 
 	err := s.uow.RunWithinTx(ctx, func(ctx context.Context) error {
 		err := s.userRepo.CreateReview(ctx, review)
@@ -84,7 +194,28 @@ func (s *UGCService) CreateReview(ctx context.Context, UserID, MovieID, Text str
 			return err
 		}
 
-		return s.movieRepo.CreateReview(ctx, review)
+		err = s.movieRepo.CreateReview(ctx, review)
+		if err != nil {
+			return err
+		}
+
+		// a soft deleted review the new one replaced leaves its votes and comments behind
+		err = dropActivity(ctx, s.voteRepo, s.commentRepo, review.UserID, review.MovieID)
+		if err != nil {
+			return err
+		}
+
+		err = s.searchRepo.IndexReview(ctx, review)
+		if err != nil {
+			return err
+		}
+
+		err = updateRating(ctx, s.ratingRepo, review.MovieID, repository.Review{}, review)
+		if err != nil {
+			return err
+		}
+
+		return enqueue(ctx, s.outboxRepo, reviewMessage(producer.EventReviewCreated, now, review))
 	})
 
 	if err != nil {
@@ -95,37 +226,355 @@ func (s *UGCService) CreateReview(ctx context.Context, UserID, MovieID, Text str
 		return apperrors.ErrInternal
 	}
 
-	detachedCtx, cancel := context.WithTimeout(ctx, time.Duration(5*time.Second))
+	invalidateReviews(ctx, s.cache, s.log, review.UserID, review.MovieID)
+	invalidateComments(ctx, s.cache, s.log, review.UserID, review.MovieID)
+
+	return nil
+}
 
-	go s.producer.WriteMessages(detachedCtx, cancel, []producer.AnalyticsMessage{
-		{UserID: review.UserID, MovieID: review.MovieID, TimestampMS: time.Now().Unix()},
+// UpdateReview applies the edit only when Version is the current version of the review,
+// otherwise it returns a VersionConflictError with the current one. Only Fields are changed,
+// all editable fields when it is empty. Only text edits are moderated again. The replaced
+// content is kept as a revision of the review.
+func (s *UGCService) UpdateReview(ctx context.Context, UserID, MovieID, Text string, Rating int32, Version int64, Fields []repository.ReviewField) error {
+	if len(Fields) == 0 {
+		Fields = repository.EditableFields
+	}
+	editsText := slices.Contains(Fields, repository.FieldText)
+
+	var verdict filter.Verdict
+
+	if editsText {
+		verdict = s.filters.Check(Text)
+		if verdict.Decision == filter.Reject {
+			return fmt.Errorf("%w: %s", apperrors.ErrContentRejected, verdict.Reason)
+		}
+	}
+
+	review := repository.Review{
+		UserID:    UserID,
+		MovieID:   MovieID,
+		Text:      Text,
+		Rating:    Rating,
+		UpdatedAt: time.Now().UTC(),
+		Version:   Version,
+	}
+
+	var current repository.Review
+
+	err := s.uow.RunWithinTx(ctx, func(ctx context.Context) error {
+		var err error
+
+		current, err = s.movieRepo.GetReview(ctx, review.UserID, review.MovieID)
+		if err != nil {
+			return err
+		}
+
+		if current.Version != review.Version {
+			return repository.ErrVersionConflict
+		}
+
+		// fields left out keep their values, the search index and the rating need the whole review
+		if !editsText {
+			review.Text = current.Text
+		}
+		if !slices.Contains(Fields, repository.FieldRating) {
+			review.Rating = current.Rating
+		}
+
+		review.Status, review.ModerationReason = current.Status, current.ModerationReason
+		if editsText {
+			review.Status = s.editedStatus(current)
+			if review.Status != current.Status {
+				review.ModerationReason = ""
+			}
+			flag(&review, verdict)
+		}
+
+		err = s.revisionRepo.AddRevision(ctx, repository.Revision{
+			UserID:   UserID,
+			MovieID:  MovieID,
+			Version:  current.Version,
+			Text:     current.Text,
+			Rating:   current.Rating,
+			EditorID: UserID,
+			EditedAt: review.UpdatedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = s.userRepo.UpdateReview(ctx, review, Fields...)
+		if err != nil {
+			return err
+		}
+
+		err = s.movieRepo.UpdateReview(ctx, review, Fields...)
+		if err != nil {
+			return err
+		}
+
+		review.CreatedAt = current.CreatedAt
+		review.Version++
+		err = s.searchRepo.IndexReview(ctx, review)
+		if err != nil {
+			return err
+		}
+
+		err = updateRating(ctx, s.ratingRepo, review.MovieID, current, review)
+		if err != nil {
+			return err
+		}
+
+		msg := reviewMessage(producer.EventReviewUpdated, review.UpdatedAt, review)
+		for _, field := range Fields {
+			msg.Review.Fields = append(msg.Review.Fields, string(field))
+		}
+
+		return enqueue(ctx, s.outboxRepo, msg)
 	})
 
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			return apperrors.ErrNotFound
+		case errors.Is(err, repository.ErrVersionConflict):
+			return s.versionConflict(ctx, UserID, MovieID, Version, current.Version)
+		}
+		s.log.Errorf("Failed to update review: %v", err)
+		return apperrors.ErrInternal
+	}
+
+	invalidateReviews(ctx, s.cache, s.log, UserID, MovieID)
+
 	return nil
 }
 
-func (s *UGCService) UpdateReview(ctx context.Context, UserID, MovieID, Text string) error {
-	review := repository.Review{
-		UserID:  UserID,
-		MovieID: MovieID,
-		Text:    Text,
+// versionConflict reports the current version of the review. When the read in the transaction
+// still saw the requested version, a concurrent edit was only caught by the version filter of
+// the update, so the review is read again.
+func (s *UGCService) versionConflict(ctx context.Context, UserID, MovieID string, requested, seen int64) error {
+	if seen != requested {
+		return &apperrors.VersionConflictError{Current: seen}
+	}
+
+	latest, err := s.movieRepo.GetReview(ctx, UserID, MovieID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return apperrors.ErrNotFound
+		}
+		s.log.Errorf("Failed to read review version: %v", err)
+		return apperrors.ErrInternal
 	}
+
+	return &apperrors.VersionConflictError{Current: latest.Version}
+}
+
+// DeleteReview soft deletes the review, it can be restored until the purge job removes it
+// after the retention period. Permanent removes the review right away.
+func (s *UGCService) DeleteReview(ctx context.Context, UserID, MovieID string, Permanent bool) error {
+	deletedAt := time.Now().UTC()
+
 	err := s.uow.RunWithinTx(ctx, func(ctx context.Context) error {
-		err := s.userRepo.UpdateReview(ctx, review)
+		current, err := s.movieRepo.GetReview(ctx, UserID, MovieID)
 		if err != nil {
 			return err
 		}
 
-		return s.movieRepo.UpdateReview(ctx, review)
+		for _, repo := range []repository.ReviewRepository{s.userRepo, s.movieRepo} {
+			if Permanent {
+				err = repo.DeleteReview(ctx, UserID, MovieID)
+			} else {
+				err = repo.SoftDeleteReview(ctx, UserID, MovieID, deletedAt)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		if Permanent {
+			err = dropActivity(ctx, s.voteRepo, s.commentRepo, UserID, MovieID)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = s.searchRepo.RemoveReview(ctx, UserID, MovieID)
+		if err != nil {
+			return err
+		}
+
+		err = updateRating(ctx, s.ratingRepo, MovieID, current, repository.Review{})
+		if err != nil {
+			return err
+		}
+
+		msg := reviewMessage(producer.EventReviewDeleted, deletedAt, current)
+		msg.Review.UserID, msg.Review.MovieID, msg.Review.Permanent = UserID, MovieID, Permanent
+
+		return enqueue(ctx, s.outboxRepo, msg)
 	})
 
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return apperrors.ErrNotFound
 		}
-		s.log.Errorf("Failed to update review: %v", err)
+		s.log.Errorf("failed to delete review: %v", err)
 		return apperrors.ErrInternal
 	}
 
+	invalidateReviews(ctx, s.cache, s.log, UserID, MovieID)
+	if Permanent {
+		invalidateComments(ctx, s.cache, s.log, UserID, MovieID)
+	}
+
 	return nil
 }
+
+func (s *UGCService) GetMovieRating(ctx context.Context, MovieID string) (repository.MovieRating, error) {
+	rating, err := s.ratingRepo.GetRating(ctx, MovieID)
+
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return repository.MovieRating{}, apperrors.ErrNotFound
+		}
+		s.log.Errorf("failed to get movie rating: %v", err)
+		return repository.MovieRating{}, apperrors.ErrInternal
+	}
+
+	return rating, nil
+}
+
+// BatchGetMovieReviewSummaries returns summaries in the order of MovieIDs. Summaries
+// are dropped together with the listings of the movie by invalidateReviews.
+func (s *UGCService) BatchGetMovieReviewSummaries(ctx context.Context, MovieIDs []string) ([]repository.ReviewSummary, error) {
+	keys := make([]string, len(MovieIDs))
+	movieIDs := make(map[string]string, len(MovieIDs))
+
+	for i, MovieID := range MovieIDs {
+		keys[i] = summaryKey(MovieID)
+		movieIDs[keys[i]] = MovieID
+	}
+
+	summaries, err := cache.GetOrSetMany(s.cache, ctx, keys, time.Minute, func(missing []string) (map[string]repository.ReviewSummary, error) {
+		ids := make([]string, len(missing))
+		for i, key := range missing {
+			ids[i] = movieIDs[key]
+		}
+
+		found, err := s.ratingRepo.GetSummaries(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		// movies without reviews are cached as well, so they do not hit the database again
+		fetched := make(map[string]repository.ReviewSummary, len(missing))
+		for _, key := range missing {
+			fetched[key] = repository.ReviewSummary{MovieID: movieIDs[key]}
+		}
+		for _, summary := range found {
+			fetched[summaryKey(summary.MovieID)] = summary
+		}
+		return fetched, nil
+	})
+
+	if err != nil {
+		s.log.Errorf("failed to get review summaries: %v", err)
+		return nil, apperrors.ErrInternal
+	}
+
+	result := make([]repository.ReviewSummary, len(keys))
+	for i, key := range keys {
+		result[i] = summaries[key]
+	}
+
+	return result, nil
+}
+
+// newStatus is the status of a freshly created review.
+func (s *UGCService) newStatus() repository.ReviewStatus {
+	if s.moderation.PreModerate {
+		return repository.StatusPending
+	}
+	return repository.StatusApproved
+}
+
+// editedStatus is the status of the review after its author changed it.
+// Edits never lift a moderator's decision to reject or hide the review.
+func (s *UGCService) editedStatus(current repository.Review) repository.ReviewStatus {
+	if !current.Visible() {
+		return current.Status
+	}
+	return s.newStatus()
+}
+
+// flag holds an approved review for moderation when a content filter asked for it.
+func flag(review *repository.Review, verdict filter.Verdict) {
+	if verdict.Decision == filter.Moderate && review.Status == repository.StatusApproved {
+		review.Status = repository.StatusPending
+		review.ModerationReason = verdict.Reason
+	}
+}
+
+// updateRating moves the rating of the review from before to after in the movie aggregate.
+// Only visible reviews count, the zero Review stands for a missing one.
+func updateRating(ctx context.Context, repo repository.RatingRepository, MovieID string, before, after repository.Review) error {
+	var oldRating, newRating int32
+
+	if before.Rating != 0 && before.Visible() {
+		oldRating = before.Rating
+	}
+	if after.Rating != 0 && after.Visible() {
+		newRating = after.Rating
+	}
+	if oldRating == 0 && newRating == 0 {
+		return nil
+	}
+
+	return repo.UpdateRating(ctx, MovieID, oldRating, newRating)
+}
+
+// reviewMessage builds the analytics event of a change to the review.
+func reviewMessage(eventType string, occurredAt time.Time, review repository.Review) producer.AnalyticsMessage {
+	return producer.NewReviewMessage(eventType, occurredAt, producer.ReviewPayload{
+		UserID:  review.UserID,
+		MovieID: review.MovieID,
+		Rating:  review.Rating,
+		Status:  string(review.Status),
+		Version: review.Version,
+	})
+}
+
+func reviewKey(UserID, MovieID string) string {
+	return cache.BuildKey("review", MovieID, UserID)
+}
+
+func summaryKey(MovieID string) string {
+	return cache.BuildKey("review", MovieID, "summary")
+}
+
+// reviewsScope is the cache scope of the listing pages of the user or the movie with the given id.
+func reviewsScope(ID string) string {
+	return cache.BuildKey("review", ID)
+}
+
+// invalidateReviews drops the cached review, the summary of the movie and every cached
+// page of the user and the movie listings.
+// dropActivity removes the votes and comments left on a review that is gone for good,
+// a review later written for the same movie starts without them.
+func dropActivity(ctx context.Context, votes repository.VoteRepository, comments repository.CommentRepository, UserID, MovieID string) error {
+	if err := votes.DeleteReviewVotes(ctx, UserID, MovieID); err != nil {
+		return err
+	}
+	return comments.DeleteReviewComments(ctx, UserID, MovieID)
+}
+
+func invalidateReviews(ctx context.Context, c *cache.Cache, log *zap.SugaredLogger, UserID, MovieID string) {
+	if err := c.Bump(ctx, reviewsScope(MovieID), reviewsScope(UserID)); err != nil {
+		log.Warnf("failed to invalidate review listings cache: %v", err)
+	}
+
+	if err := c.Delete(ctx, reviewKey(UserID, MovieID), summaryKey(MovieID)); err != nil {
+		log.Warnf("failed to invalidate review cache: %v", err)
+	}
+}
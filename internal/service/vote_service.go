@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maisiq/go-ugc-service/internal/cache"
+	"github.com/maisiq/go-ugc-service/internal/db"
+	apperrors "github.com/maisiq/go-ugc-service/internal/errors"
+	"github.com/maisiq/go-ugc-service/internal/producer"
+	"github.com/maisiq/go-ugc-service/internal/repository"
+	"go.uber.org/zap"
+)
+
+type VoteService struct {
+	userRepo   repository.ReviewRepository
+	movieRepo  repository.ReviewRepository
+	voteRepo   repository.VoteRepository
+	outboxRepo repository.OutboxRepository
+	log        *zap.SugaredLogger
+	cache      *cache.Cache
+	uow        db.UOW
+}
+
+func NewVoteService(
+	userRepo repository.ReviewRepository,
+	movieRepo repository.ReviewRepository,
+	voteRepo repository.VoteRepository,
+	outboxRepo repository.OutboxRepository,
+	log *zap.SugaredLogger,
+	cache *cache.Cache,
+	uow db.UOW,
+) *VoteService {
+	return &VoteService{
+		userRepo:   userRepo,
+		movieRepo:  movieRepo,
+		voteRepo:   voteRepo,
+		outboxRepo: outboxRepo,
+		log:        log,
+		cache:      cache,
+		uow:        uow,
+	}
+}
+
+// VoteReview stores the vote of VoterID on the review of UserID for MovieID.
+// Voting the same way twice changes nothing, voting the other way moves the vote.
+func (s *VoteService) VoteReview(ctx context.Context, VoterID, UserID, MovieID string, Value int32) error {
+	if VoterID == UserID {
+		return apperrors.ErrInvalidArgument
+	}
+
+	vote := repository.Vote{
+		VoterID:   VoterID,
+		UserID:    UserID,
+		MovieID:   MovieID,
+		Value:     Value,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	var changed bool
+
+	err := s.uow.RunWithinTx(ctx, func(ctx context.Context) error {
+		previous, err := s.voteRepo.SetVote(ctx, vote)
+		if err != nil {
+			return err
+		}
+
+		if previous == Value {
+			return nil
+		}
+		changed = true
+
+		likes, dislikes := voteDelta(previous, Value)
+		if err := s.incrementVotes(ctx, UserID, MovieID, likes, dislikes); err != nil {
+			return err
+		}
+
+		return enqueue(ctx, s.outboxRepo, voteMessage(VoterID, UserID, MovieID, Value, producer.EventReviewVoted))
+	})
+
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return apperrors.ErrNotFound
+		}
+		s.log.Errorf("failed to vote review: %v", err)
+		return apperrors.ErrInternal
+	}
+
+	if changed {
+		invalidateReviews(ctx, s.cache, s.log, UserID, MovieID)
+	}
+
+	return nil
+}
+
+// RemoveVote takes back the vote of VoterID. Removing a vote that does not exist is a no-op.
+// The vote on a review deleted in the meantime is removed without touching any counter.
+func (s *VoteService) RemoveVote(ctx context.Context, VoterID, UserID, MovieID string) error {
+	var removed bool
+
+	err := s.uow.RunWithinTx(ctx, func(ctx context.Context) error {
+		value, err := s.voteRepo.DeleteVote(ctx, VoterID, UserID, MovieID)
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		removed = true
+
+		likes, dislikes := voteDelta(value, 0)
+		if err := s.incrementVotes(ctx, UserID, MovieID, likes, dislikes); err != nil && !errors.Is(err, repository.ErrNotFound) {
+			return err
+		}
+
+		return enqueue(ctx, s.outboxRepo, voteMessage(VoterID, UserID, MovieID, value, producer.EventReviewVoteRemoved))
+	})
+
+	if err != nil {
+		s.log.Errorf("failed to remove vote: %v", err)
+		return apperrors.ErrInternal
+	}
+
+	if removed {
+		invalidateReviews(ctx, s.cache, s.log, UserID, MovieID)
+	}
+
+	return nil
+}
+
+func (s *VoteService) incrementVotes(ctx context.Context, UserID, MovieID string, likes, dislikes int64) error {
+	err := s.userRepo.IncrementVotes(ctx, UserID, MovieID, likes, dislikes)
+	if err != nil {
+		return err
+	}
+
+	return s.movieRepo.IncrementVotes(ctx, UserID, MovieID, likes, dislikes)
+}
+
+func voteMessage(VoterID, UserID, MovieID string, Value int32, Event string) producer.AnalyticsMessage {
+	return producer.NewVoteMessage(Event, time.Now(), producer.VotePayload{
+		VoterID: VoterID, UserID: UserID, MovieID: MovieID, Vote: Value,
+	})
+}
+
+// voteDelta returns how likes and dislikes change when a vote goes from previous to current.
+func voteDelta(previous, current int32) (likes, dislikes int64) {
+	switch previous {
+	case repository.VoteUp:
+		likes--
+	case repository.VoteDown:
+		dislikes--
+	}
+
+	switch current {
+	case repository.VoteUp:
+		likes++
+	case repository.VoteDown:
+		dislikes++
+	}
+	return likes, dislikes
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
@@ -18,8 +19,17 @@ type DatabaseConfig struct {
 	DSN         string `yaml:"dsn" mapstructure:"dsn"`
 	Name        string `yaml:"dbname" mapstructure:"dbname"`
 	Collections struct {
-		Movies string `yaml:"movies" mapstructure:"movies"`
-		Users  string `yaml:"users" mapstructure:"users"`
+		Movies    string `yaml:"movies" mapstructure:"movies"`
+		Users     string `yaml:"users" mapstructure:"users"`
+		Votes     string `yaml:"votes" mapstructure:"votes"`
+		Comments  string `yaml:"comments" mapstructure:"comments"`
+		Reports   string `yaml:"reports" mapstructure:"reports"`
+		Search    string `yaml:"search" mapstructure:"search"`
+		Revisions string `yaml:"revisions" mapstructure:"revisions"`
+		Erasures  string `yaml:"erasures" mapstructure:"erasures"`
+		Progress  string `yaml:"progress" mapstructure:"progress"`
+		Bookmarks string `yaml:"bookmarks" mapstructure:"bookmarks"`
+		Outbox    string `yaml:"outbox" mapstructure:"outbox"`
 	} `yaml:"collections" mapstructure:"collections"`
 }
 
@@ -43,18 +53,107 @@ type ServerConfig struct {
 	Port int    `yaml:"port" mapstructure:"port"`
 }
 
+type PaginationConfig struct {
+	DefaultPageSize int    `yaml:"default_page_size" mapstructure:"default_page_size"`
+	MaxPageSize     int    `yaml:"max_page_size" mapstructure:"max_page_size"`
+	TokenSecret     string `yaml:"token_secret" mapstructure:"token_secret"`
+}
+
+type ModerationConfig struct {
+	// PreModerate holds every new or edited review as pending until a moderator approves it.
+	PreModerate bool `yaml:"pre_moderate" mapstructure:"pre_moderate"`
+	// ReportThreshold is the number of distinct reporters that hides a review
+	// until a moderator acts. Zero turns automatic hiding off.
+	ReportThreshold int `yaml:"report_threshold" mapstructure:"report_threshold"`
+}
+
+// ContentFilterConfig configures one filter of the chain run on review writes.
+// Name is one of length, profanity, links or shouting, Action is moderate or
+// reject, and only the options of the named filter are read.
+type ContentFilterConfig struct {
+	Name             string   `yaml:"name" mapstructure:"name"`
+	Action           string   `yaml:"action" mapstructure:"action"`
+	MinLength        int      `yaml:"min_length" mapstructure:"min_length"`
+	MaxLength        int      `yaml:"max_length" mapstructure:"max_length"`
+	Words            []string `yaml:"words" mapstructure:"words"`
+	MaxLinks         int      `yaml:"max_links" mapstructure:"max_links"`
+	MaxRepeatedChars int      `yaml:"max_repeated_chars" mapstructure:"max_repeated_chars"`
+	MaxUpperRatio    float64  `yaml:"max_upper_ratio" mapstructure:"max_upper_ratio"`
+}
+
+type HistoryConfig struct {
+	// MaxRevisions is the number of past revisions kept per review. Zero keeps all of them.
+	MaxRevisions int `yaml:"max_revisions" mapstructure:"max_revisions"`
+}
+
+type SoftDeleteConfig struct {
+	// Retention is how long soft deleted reviews can be restored before they are purged.
+	// Zero keeps them forever.
+	Retention time.Duration `yaml:"retention" mapstructure:"retention"`
+	// PurgeInterval is how often the purge job looks for reviews past the retention period.
+	PurgeInterval time.Duration `yaml:"purge_interval" mapstructure:"purge_interval"`
+}
+
+type ProgressConfig struct {
+	// FlushInterval is how often positions buffered in redis are written to the database,
+	// 5 seconds when not set.
+	FlushInterval time.Duration `yaml:"flush_interval" mapstructure:"flush_interval"`
+	// BatchSize is the number of users whose positions are written in one flush round.
+	BatchSize int `yaml:"batch_size" mapstructure:"batch_size"`
+	// TTL is how long positions of an inactive user stay in redis after they were flushed,
+	// 72 hours when not set.
+	TTL time.Duration `yaml:"ttl" mapstructure:"ttl"`
+}
+
+type OutboxConfig struct {
+	// RelayInterval is how often the relay looks for unsent events, every second when not set.
+	RelayInterval time.Duration `yaml:"relay_interval" mapstructure:"relay_interval"`
+	// BatchSize is the number of events published in one round.
+	BatchSize int `yaml:"batch_size" mapstructure:"batch_size"`
+	// Lease is how long claimed events are kept from other relays, an event
+	// not sent by then is claimed again. 30 seconds when not set.
+	Lease time.Duration `yaml:"lease" mapstructure:"lease"`
+	// MaxBackoff caps the wait between rounds while the broker keeps failing, a minute when not set.
+	MaxBackoff time.Duration `yaml:"max_backoff" mapstructure:"max_backoff"`
+	// DrainTimeout bounds publishing the remaining events on shutdown, 5 seconds when not set.
+	DrainTimeout time.Duration `yaml:"drain_timeout" mapstructure:"drain_timeout"`
+	// Retention is how long sent events are kept.
+	Retention time.Duration `yaml:"retention" mapstructure:"retention"`
+}
+
+type SearchConfig struct {
+	// Backfill indexes the reviews written before the search index existed on startup.
+	Backfill bool `yaml:"backfill" mapstructure:"backfill"`
+}
+
+type ImportConfig struct {
+	// BatchSize is the number of imported reviews written in one transaction.
+	BatchSize int `yaml:"batch_size" mapstructure:"batch_size"`
+}
+
 type AppConfig struct {
 	Debug        bool `yaml:"debug" mapstructure:"debug"`
 	ShutdownTime int  `yaml:"shutdown_time" mapstructure:"shutdown_time"`
 }
 
 type Config struct {
-	Server   ServerConfig   `yaml:"server" mapstructure:"server"`
-	Database DatabaseConfig `yaml:"db" mapstructure:"db"`
-	Kafka    KafkaConfig    `yaml:"kafka" mapstructure:"kafka"`
-	Cache    CacheConfig    `yaml:"cache" mapstructure:"cache"`
-	Swagger  SwaggerConfig  `yaml:"swagger" mapstructure:"swagger"`
-	App      AppConfig      `yaml:"app" mapstructure:"app"`
+	Server      ServerConfig          `yaml:"server" mapstructure:"server"`
+	AdminServer ServerConfig          `yaml:"admin_server" mapstructure:"admin_server"`
+	Database    DatabaseConfig        `yaml:"db" mapstructure:"db"`
+	Kafka       KafkaConfig           `yaml:"kafka" mapstructure:"kafka"`
+	Cache       CacheConfig           `yaml:"cache" mapstructure:"cache"`
+	Swagger     SwaggerConfig         `yaml:"swagger" mapstructure:"swagger"`
+	Pagination  PaginationConfig      `yaml:"pagination" mapstructure:"pagination"`
+	Moderation  ModerationConfig      `yaml:"moderation" mapstructure:"moderation"`
+	Filters     []ContentFilterConfig `yaml:"content_filters" mapstructure:"content_filters"`
+	Import      ImportConfig          `yaml:"import" mapstructure:"import"`
+	Search      SearchConfig          `yaml:"search" mapstructure:"search"`
+	History     HistoryConfig         `yaml:"history" mapstructure:"history"`
+	SoftDelete  SoftDeleteConfig      `yaml:"soft_delete" mapstructure:"soft_delete"`
+	Clickhouse  ClickhouseConfig      `yaml:"clickhouse" mapstructure:"clickhouse"`
+	Progress    ProgressConfig        `yaml:"progress" mapstructure:"progress"`
+	Outbox      OutboxConfig          `yaml:"outbox" mapstructure:"outbox"`
+	App         AppConfig             `yaml:"app" mapstructure:"app"`
 }
 
 func initViperConfig(path string) (*viper.Viper, error) {
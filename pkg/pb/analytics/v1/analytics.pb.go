@@ -0,0 +1,720 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: analytics/v1/analytics.proto
+
+package analyticsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AnalyticsEvent is a message of the analytics topic. Exactly one payload is set,
+// which one depends on event_type. The schema version is sent in the message
+// headers as well, so consumers can pick a decoder before reading the value.
+type AnalyticsEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	SchemaVersion int32                  `protobuf:"varint,3,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*AnalyticsEvent_Review
+	//	*AnalyticsEvent_Vote
+	//	*AnalyticsEvent_Report
+	//	*AnalyticsEvent_Progress
+	//	*AnalyticsEvent_Bookmark
+	//	*AnalyticsEvent_User
+	Payload       isAnalyticsEvent_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyticsEvent) Reset() {
+	*x = AnalyticsEvent{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyticsEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyticsEvent) ProtoMessage() {}
+
+func (x *AnalyticsEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyticsEvent.ProtoReflect.Descriptor instead.
+func (*AnalyticsEvent) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AnalyticsEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *AnalyticsEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *AnalyticsEvent) GetSchemaVersion() int32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *AnalyticsEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *AnalyticsEvent) GetPayload() isAnalyticsEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *AnalyticsEvent) GetReview() *ReviewPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*AnalyticsEvent_Review); ok {
+			return x.Review
+		}
+	}
+	return nil
+}
+
+func (x *AnalyticsEvent) GetVote() *VotePayload {
+	if x != nil {
+		if x, ok := x.Payload.(*AnalyticsEvent_Vote); ok {
+			return x.Vote
+		}
+	}
+	return nil
+}
+
+func (x *AnalyticsEvent) GetReport() *ReportPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*AnalyticsEvent_Report); ok {
+			return x.Report
+		}
+	}
+	return nil
+}
+
+func (x *AnalyticsEvent) GetProgress() *ProgressPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*AnalyticsEvent_Progress); ok {
+			return x.Progress
+		}
+	}
+	return nil
+}
+
+func (x *AnalyticsEvent) GetBookmark() *BookmarkPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*AnalyticsEvent_Bookmark); ok {
+			return x.Bookmark
+		}
+	}
+	return nil
+}
+
+func (x *AnalyticsEvent) GetUser() *UserPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*AnalyticsEvent_User); ok {
+			return x.User
+		}
+	}
+	return nil
+}
+
+type isAnalyticsEvent_Payload interface {
+	isAnalyticsEvent_Payload()
+}
+
+type AnalyticsEvent_Review struct {
+	Review *ReviewPayload `protobuf:"bytes,5,opt,name=review,proto3,oneof"`
+}
+
+type AnalyticsEvent_Vote struct {
+	Vote *VotePayload `protobuf:"bytes,6,opt,name=vote,proto3,oneof"`
+}
+
+type AnalyticsEvent_Report struct {
+	Report *ReportPayload `protobuf:"bytes,7,opt,name=report,proto3,oneof"`
+}
+
+type AnalyticsEvent_Progress struct {
+	Progress *ProgressPayload `protobuf:"bytes,8,opt,name=progress,proto3,oneof"`
+}
+
+type AnalyticsEvent_Bookmark struct {
+	Bookmark *BookmarkPayload `protobuf:"bytes,9,opt,name=bookmark,proto3,oneof"`
+}
+
+type AnalyticsEvent_User struct {
+	User *UserPayload `protobuf:"bytes,10,opt,name=user,proto3,oneof"`
+}
+
+func (*AnalyticsEvent_Review) isAnalyticsEvent_Payload() {}
+
+func (*AnalyticsEvent_Vote) isAnalyticsEvent_Payload() {}
+
+func (*AnalyticsEvent_Report) isAnalyticsEvent_Payload() {}
+
+func (*AnalyticsEvent_Progress) isAnalyticsEvent_Payload() {}
+
+func (*AnalyticsEvent_Bookmark) isAnalyticsEvent_Payload() {}
+
+func (*AnalyticsEvent_User) isAnalyticsEvent_Payload() {}
+
+type ReviewPayload struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	UserId  string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MovieId string                 `protobuf:"bytes,2,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	Rating  int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	Status  string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Version int64                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	// fields are the fields changed by an update.
+	Fields []string `protobuf:"bytes,6,rep,name=fields,proto3" json:"fields,omitempty"`
+	// permanent is set when a deleted review cannot be restored.
+	Permanent     bool `protobuf:"varint,7,opt,name=permanent,proto3" json:"permanent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewPayload) Reset() {
+	*x = ReviewPayload{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewPayload) ProtoMessage() {}
+
+func (x *ReviewPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewPayload.ProtoReflect.Descriptor instead.
+func (*ReviewPayload) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReviewPayload) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReviewPayload) GetMovieId() string {
+	if x != nil {
+		return x.MovieId
+	}
+	return ""
+}
+
+func (x *ReviewPayload) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *ReviewPayload) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReviewPayload) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ReviewPayload) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ReviewPayload) GetPermanent() bool {
+	if x != nil {
+		return x.Permanent
+	}
+	return false
+}
+
+type VotePayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VoterId       string                 `protobuf:"bytes,1,opt,name=voter_id,json=voterId,proto3" json:"voter_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MovieId       string                 `protobuf:"bytes,3,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	Vote          int32                  `protobuf:"varint,4,opt,name=vote,proto3" json:"vote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VotePayload) Reset() {
+	*x = VotePayload{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePayload) ProtoMessage() {}
+
+func (x *VotePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePayload.ProtoReflect.Descriptor instead.
+func (*VotePayload) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VotePayload) GetVoterId() string {
+	if x != nil {
+		return x.VoterId
+	}
+	return ""
+}
+
+func (x *VotePayload) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VotePayload) GetMovieId() string {
+	if x != nil {
+		return x.MovieId
+	}
+	return ""
+}
+
+func (x *VotePayload) GetVote() int32 {
+	if x != nil {
+		return x.Vote
+	}
+	return 0
+}
+
+type ReportPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReporterId    string                 `protobuf:"bytes,1,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MovieId       string                 `protobuf:"bytes,3,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportPayload) Reset() {
+	*x = ReportPayload{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportPayload) ProtoMessage() {}
+
+func (x *ReportPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportPayload.ProtoReflect.Descriptor instead.
+func (*ReportPayload) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReportPayload) GetReporterId() string {
+	if x != nil {
+		return x.ReporterId
+	}
+	return ""
+}
+
+func (x *ReportPayload) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReportPayload) GetMovieId() string {
+	if x != nil {
+		return x.MovieId
+	}
+	return ""
+}
+
+func (x *ReportPayload) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ProgressPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MovieId       string                 `protobuf:"bytes,2,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	PositionMs    int64                  `protobuf:"varint,3,opt,name=position_ms,json=positionMs,proto3" json:"position_ms,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProgressPayload) Reset() {
+	*x = ProgressPayload{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProgressPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProgressPayload) ProtoMessage() {}
+
+func (x *ProgressPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProgressPayload.ProtoReflect.Descriptor instead.
+func (*ProgressPayload) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProgressPayload) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ProgressPayload) GetMovieId() string {
+	if x != nil {
+		return x.MovieId
+	}
+	return ""
+}
+
+func (x *ProgressPayload) GetPositionMs() int64 {
+	if x != nil {
+		return x.PositionMs
+	}
+	return 0
+}
+
+func (x *ProgressPayload) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type BookmarkPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MovieId       string                 `protobuf:"bytes,2,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookmarkPayload) Reset() {
+	*x = BookmarkPayload{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookmarkPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookmarkPayload) ProtoMessage() {}
+
+func (x *BookmarkPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookmarkPayload.ProtoReflect.Descriptor instead.
+func (*BookmarkPayload) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BookmarkPayload) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BookmarkPayload) GetMovieId() string {
+	if x != nil {
+		return x.MovieId
+	}
+	return ""
+}
+
+type UserPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserPayload) Reset() {
+	*x = UserPayload{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPayload) ProtoMessage() {}
+
+func (x *UserPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPayload.ProtoReflect.Descriptor instead.
+func (*UserPayload) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UserPayload) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+var File_analytics_v1_analytics_proto protoreflect.FileDescriptor
+
+const file_analytics_v1_analytics_proto_rawDesc = "" +
+	"\n" +
+	"\x1canalytics/v1/analytics.proto\x12-github.com.maisiq.go_ugc_service.analytics.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc9\x05\n" +
+	"\x0eAnalyticsEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12%\n" +
+	"\x0eschema_version\x18\x03 \x01(\x05R\rschemaVersion\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12V\n" +
+	"\x06review\x18\x05 \x01(\v2<.github.com.maisiq.go_ugc_service.analytics.v1.ReviewPayloadH\x00R\x06review\x12P\n" +
+	"\x04vote\x18\x06 \x01(\v2:.github.com.maisiq.go_ugc_service.analytics.v1.VotePayloadH\x00R\x04vote\x12V\n" +
+	"\x06report\x18\a \x01(\v2<.github.com.maisiq.go_ugc_service.analytics.v1.ReportPayloadH\x00R\x06report\x12\\\n" +
+	"\bprogress\x18\b \x01(\v2>.github.com.maisiq.go_ugc_service.analytics.v1.ProgressPayloadH\x00R\bprogress\x12\\\n" +
+	"\bbookmark\x18\t \x01(\v2>.github.com.maisiq.go_ugc_service.analytics.v1.BookmarkPayloadH\x00R\bbookmark\x12P\n" +
+	"\x04user\x18\n" +
+	" \x01(\v2:.github.com.maisiq.go_ugc_service.analytics.v1.UserPayloadH\x00R\x04userB\t\n" +
+	"\apayload\"\xc3\x01\n" +
+	"\rReviewPayload\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bmovie_id\x18\x02 \x01(\tR\amovieId\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x03R\aversion\x12\x16\n" +
+	"\x06fields\x18\x06 \x03(\tR\x06fields\x12\x1c\n" +
+	"\tpermanent\x18\a \x01(\bR\tpermanent\"p\n" +
+	"\vVotePayload\x12\x19\n" +
+	"\bvoter_id\x18\x01 \x01(\tR\avoterId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
+	"\bmovie_id\x18\x03 \x01(\tR\amovieId\x12\x12\n" +
+	"\x04vote\x18\x04 \x01(\x05R\x04vote\"|\n" +
+	"\rReportPayload\x12\x1f\n" +
+	"\vreporter_id\x18\x01 \x01(\tR\n" +
+	"reporterId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
+	"\bmovie_id\x18\x03 \x01(\tR\amovieId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x87\x01\n" +
+	"\x0fProgressPayload\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bmovie_id\x18\x02 \x01(\tR\amovieId\x12\x1f\n" +
+	"\vposition_ms\x18\x03 \x01(\x03R\n" +
+	"positionMs\x12\x1f\n" +
+	"\vduration_ms\x18\x04 \x01(\x03R\n" +
+	"durationMs\"E\n" +
+	"\x0fBookmarkPayload\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bmovie_id\x18\x02 \x01(\tR\amovieId\"&\n" +
+	"\vUserPayload\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userIdBBZ@github.com/maisiq/go-ugc-service/pkg/pb/analytics/v1;analyticsv1b\x06proto3"
+
+var (
+	file_analytics_v1_analytics_proto_rawDescOnce sync.Once
+	file_analytics_v1_analytics_proto_rawDescData []byte
+)
+
+func file_analytics_v1_analytics_proto_rawDescGZIP() []byte {
+	file_analytics_v1_analytics_proto_rawDescOnce.Do(func() {
+		file_analytics_v1_analytics_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_analytics_v1_analytics_proto_rawDesc), len(file_analytics_v1_analytics_proto_rawDesc)))
+	})
+	return file_analytics_v1_analytics_proto_rawDescData
+}
+
+var file_analytics_v1_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_analytics_v1_analytics_proto_goTypes = []any{
+	(*AnalyticsEvent)(nil),        // 0: github.com.maisiq.go_ugc_service.analytics.v1.AnalyticsEvent
+	(*ReviewPayload)(nil),         // 1: github.com.maisiq.go_ugc_service.analytics.v1.ReviewPayload
+	(*VotePayload)(nil),           // 2: github.com.maisiq.go_ugc_service.analytics.v1.VotePayload
+	(*ReportPayload)(nil),         // 3: github.com.maisiq.go_ugc_service.analytics.v1.ReportPayload
+	(*ProgressPayload)(nil),       // 4: github.com.maisiq.go_ugc_service.analytics.v1.ProgressPayload
+	(*BookmarkPayload)(nil),       // 5: github.com.maisiq.go_ugc_service.analytics.v1.BookmarkPayload
+	(*UserPayload)(nil),           // 6: github.com.maisiq.go_ugc_service.analytics.v1.UserPayload
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+}
+var file_analytics_v1_analytics_proto_depIdxs = []int32{
+	7, // 0: github.com.maisiq.go_ugc_service.analytics.v1.AnalyticsEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	1, // 1: github.com.maisiq.go_ugc_service.analytics.v1.AnalyticsEvent.review:type_name -> github.com.maisiq.go_ugc_service.analytics.v1.ReviewPayload
+	2, // 2: github.com.maisiq.go_ugc_service.analytics.v1.AnalyticsEvent.vote:type_name -> github.com.maisiq.go_ugc_service.analytics.v1.VotePayload
+	3, // 3: github.com.maisiq.go_ugc_service.analytics.v1.AnalyticsEvent.report:type_name -> github.com.maisiq.go_ugc_service.analytics.v1.ReportPayload
+	4, // 4: github.com.maisiq.go_ugc_service.analytics.v1.AnalyticsEvent.progress:type_name -> github.com.maisiq.go_ugc_service.analytics.v1.ProgressPayload
+	5, // 5: github.com.maisiq.go_ugc_service.analytics.v1.AnalyticsEvent.bookmark:type_name -> github.com.maisiq.go_ugc_service.analytics.v1.BookmarkPayload
+	6, // 6: github.com.maisiq.go_ugc_service.analytics.v1.AnalyticsEvent.user:type_name -> github.com.maisiq.go_ugc_service.analytics.v1.UserPayload
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_analytics_v1_analytics_proto_init() }
+func file_analytics_v1_analytics_proto_init() {
+	if File_analytics_v1_analytics_proto != nil {
+		return
+	}
+	file_analytics_v1_analytics_proto_msgTypes[0].OneofWrappers = []any{
+		(*AnalyticsEvent_Review)(nil),
+		(*AnalyticsEvent_Vote)(nil),
+		(*AnalyticsEvent_Report)(nil),
+		(*AnalyticsEvent_Progress)(nil),
+		(*AnalyticsEvent_Bookmark)(nil),
+		(*AnalyticsEvent_User)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_analytics_v1_analytics_proto_rawDesc), len(file_analytics_v1_analytics_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_analytics_v1_analytics_proto_goTypes,
+		DependencyIndexes: file_analytics_v1_analytics_proto_depIdxs,
+		MessageInfos:      file_analytics_v1_analytics_proto_msgTypes,
+	}.Build()
+	File_analytics_v1_analytics_proto = out.File
+	file_analytics_v1_analytics_proto_goTypes = nil
+	file_analytics_v1_analytics_proto_depIdxs = nil
+}
@@ -85,6 +85,114 @@ func (m *Review) validate(all bool) error {
 
 	// no validation rules for Text
 
+	if val := m.GetRating(); val < 1 || val > 10 {
+		err := ReviewValidationError{
+			field:  "Rating",
+			reason: "value must be inside range [1, 10]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Likes
+
+	// no validation rules for Dislikes
+
+	// no validation rules for Status
+
+	// no validation rules for ModerationReason
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetDeletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ReviewMultiError(errors)
 	}
@@ -192,10 +300,45 @@ func (m *GetReviewsRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for PageSize
+	if m.GetPageSize() < 0 {
+		err := GetReviewsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for PageToken
 
+	if _, ok := ReviewSort_name[int32(m.GetSort())]; !ok {
+		err := GetReviewsRequestValidationError{
+			field:  "Sort",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetViewerId() != "" {
+		if err := m._validateUuid(m.GetViewerId()); err != nil {
+			err = GetReviewsRequestValidationError{
+				field:  "ViewerId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	switch v := m.For.(type) {
 	case *GetReviewsRequest_MovieId:
 		if v == nil {
@@ -337,78 +480,89 @@ var _ interface {
 	ErrorName() string
 } = GetReviewsRequestValidationError{}
 
-// Validate checks the field values on GetReviewsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetReviewsResponse) Validate() error {
+// Validate checks the field values on GetReviewRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetReviewRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReviewsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetReviewsResponseMultiError, or nil if none found.
-func (m *GetReviewsResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// GetReviewRequestMultiError, or nil if none found.
+func (m *GetReviewRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReviewsResponse) validate(all bool) error {
+func (m *GetReviewRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetReviews() {
-		_, _ = idx, item
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = GetReviewRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetReviewsResponseValidationError{
-						field:  fmt.Sprintf("Reviews[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetReviewsResponseValidationError{
-						field:  fmt.Sprintf("Reviews[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if err := m._validateUuid(m.GetMovieId()); err != nil {
+		err = GetReviewRequestValidationError{
+			field:  "MovieId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetViewerId() != "" {
+		if err := m._validateUuid(m.GetViewerId()); err != nil {
+			err = GetReviewRequestValidationError{
+				field:  "ViewerId",
+				reason: "value must be a valid UUID",
+				cause:  err,
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetReviewsResponseValidationError{
-					field:  fmt.Sprintf("Reviews[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
 
 	}
 
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return GetReviewsResponseMultiError(errors)
+		return GetReviewRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReviewsResponseMultiError is an error wrapping multiple validation errors
-// returned by GetReviewsResponse.ValidateAll() if the designated constraints
+func (m *GetReviewRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetReviewRequestMultiError is an error wrapping multiple validation errors
+// returned by GetReviewRequest.ValidateAll() if the designated constraints
 // aren't met.
-type GetReviewsResponseMultiError []error
+type GetReviewRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReviewsResponseMultiError) Error() string {
+func (m GetReviewRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -417,11 +571,11 @@ func (m GetReviewsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReviewsResponseMultiError) AllErrors() []error { return m }
+func (m GetReviewRequestMultiError) AllErrors() []error { return m }
 
-// GetReviewsResponseValidationError is the validation error returned by
-// GetReviewsResponse.Validate if the designated constraints aren't met.
-type GetReviewsResponseValidationError struct {
+// GetReviewRequestValidationError is the validation error returned by
+// GetReviewRequest.Validate if the designated constraints aren't met.
+type GetReviewRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -429,24 +583,22 @@ type GetReviewsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReviewsResponseValidationError) Field() string { return e.field }
+func (e GetReviewRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReviewsResponseValidationError) Reason() string { return e.reason }
+func (e GetReviewRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReviewsResponseValidationError) Cause() error { return e.cause }
+func (e GetReviewRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReviewsResponseValidationError) Key() bool { return e.key }
+func (e GetReviewRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReviewsResponseValidationError) ErrorName() string {
-	return "GetReviewsResponseValidationError"
-}
+func (e GetReviewRequestValidationError) ErrorName() string { return "GetReviewRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetReviewsResponseValidationError) Error() string {
+func (e GetReviewRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -458,14 +610,14 @@ func (e GetReviewsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReviewsResponse.%s: %s%s",
+		"invalid %sGetReviewRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReviewsResponseValidationError{}
+var _ error = GetReviewRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -473,24 +625,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReviewsResponseValidationError{}
+} = GetReviewRequestValidationError{}
 
-// Validate checks the field values on CreateReviewRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateReviewRequest) Validate() error {
+// Validate checks the field values on GetReviewResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetReviewResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateReviewRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateReviewRequestMultiError, or nil if none found.
-func (m *CreateReviewRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetReviewResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// GetReviewResponseMultiError, or nil if none found.
+func (m *GetReviewResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateReviewRequest) validate(all bool) error {
+func (m *GetReviewResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -501,7 +653,7 @@ func (m *CreateReviewRequest) validate(all bool) error {
 		switch v := interface{}(m.GetReview()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateReviewRequestValidationError{
+				errors = append(errors, GetReviewResponseValidationError{
 					field:  "Review",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -509,7 +661,7 @@ func (m *CreateReviewRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateReviewRequestValidationError{
+				errors = append(errors, GetReviewResponseValidationError{
 					field:  "Review",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -518,7 +670,7 @@ func (m *CreateReviewRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetReview()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateReviewRequestValidationError{
+			return GetReviewResponseValidationError{
 				field:  "Review",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -527,19 +679,19 @@ func (m *CreateReviewRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateReviewRequestMultiError(errors)
+		return GetReviewResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateReviewRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateReviewRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateReviewRequestMultiError []error
+// GetReviewResponseMultiError is an error wrapping multiple validation errors
+// returned by GetReviewResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetReviewResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateReviewRequestMultiError) Error() string {
+func (m GetReviewResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -548,11 +700,11 @@ func (m CreateReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateReviewRequestMultiError) AllErrors() []error { return m }
+func (m GetReviewResponseMultiError) AllErrors() []error { return m }
 
-// CreateReviewRequestValidationError is the validation error returned by
-// CreateReviewRequest.Validate if the designated constraints aren't met.
-type CreateReviewRequestValidationError struct {
+// GetReviewResponseValidationError is the validation error returned by
+// GetReviewResponse.Validate if the designated constraints aren't met.
+type GetReviewResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -560,24 +712,24 @@ type CreateReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateReviewRequestValidationError) Field() string { return e.field }
+func (e GetReviewResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateReviewRequestValidationError) Reason() string { return e.reason }
+func (e GetReviewResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateReviewRequestValidationError) Cause() error { return e.cause }
+func (e GetReviewResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateReviewRequestValidationError) Key() bool { return e.key }
+func (e GetReviewResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateReviewRequestValidationError) ErrorName() string {
-	return "CreateReviewRequestValidationError"
+func (e GetReviewResponseValidationError) ErrorName() string {
+	return "GetReviewResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateReviewRequestValidationError) Error() string {
+func (e GetReviewResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -589,14 +741,14 @@ func (e CreateReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateReviewRequest.%s: %s%s",
+		"invalid %sGetReviewResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateReviewRequestValidationError{}
+var _ error = GetReviewResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -604,44 +756,182 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateReviewRequestValidationError{}
+} = GetReviewResponseValidationError{}
 
-// Validate checks the field values on UpdateReviewRequest with the rules
+// Validate checks the field values on GetReviewsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateReviewRequest) Validate() error {
+func (m *GetReviewsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateReviewRequest with the rules
+// ValidateAll checks the field values on GetReviewsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateReviewRequestMultiError, or nil if none found.
-func (m *UpdateReviewRequest) ValidateAll() error {
+// GetReviewsResponseMultiError, or nil if none found.
+func (m *GetReviewsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateReviewRequest) validate(all bool) error {
+func (m *GetReviewsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetReview()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateReviewRequestValidationError{
-					field:  "Review",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateReviewRequestValidationError{
-					field:  "Review",
+	for idx, item := range m.GetReviews() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetReviewsResponseValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetReviewsResponseValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetReviewsResponseValidationError{
+					field:  fmt.Sprintf("Reviews[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return GetReviewsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReviewsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetReviewsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetReviewsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReviewsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReviewsResponseMultiError) AllErrors() []error { return m }
+
+// GetReviewsResponseValidationError is the validation error returned by
+// GetReviewsResponse.Validate if the designated constraints aren't met.
+type GetReviewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReviewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReviewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReviewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReviewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReviewsResponseValidationError) ErrorName() string {
+	return "GetReviewsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReviewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReviewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReviewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReviewsResponseValidationError{}
+
+// Validate checks the field values on CreateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReviewRequestMultiError, or nil if none found.
+func (m *CreateReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReview()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateReviewRequestValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateReviewRequestValidationError{
+					field:  "Review",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
@@ -649,7 +939,7 @@ func (m *UpdateReviewRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetReview()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateReviewRequestValidationError{
+			return CreateReviewRequestValidationError{
 				field:  "Review",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -658,19 +948,19 @@ func (m *UpdateReviewRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateReviewRequestMultiError(errors)
+		return CreateReviewRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateReviewRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateReviewRequest.ValidateAll() if the designated
+// CreateReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateReviewRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateReviewRequestMultiError []error
+type CreateReviewRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateReviewRequestMultiError) Error() string {
+func (m CreateReviewRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -679,11 +969,11 @@ func (m UpdateReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateReviewRequestMultiError) AllErrors() []error { return m }
+func (m CreateReviewRequestMultiError) AllErrors() []error { return m }
 
-// UpdateReviewRequestValidationError is the validation error returned by
-// UpdateReviewRequest.Validate if the designated constraints aren't met.
-type UpdateReviewRequestValidationError struct {
+// CreateReviewRequestValidationError is the validation error returned by
+// CreateReviewRequest.Validate if the designated constraints aren't met.
+type CreateReviewRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -691,24 +981,24 @@ type UpdateReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateReviewRequestValidationError) Field() string { return e.field }
+func (e CreateReviewRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateReviewRequestValidationError) Reason() string { return e.reason }
+func (e CreateReviewRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateReviewRequestValidationError) Cause() error { return e.cause }
+func (e CreateReviewRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateReviewRequestValidationError) Key() bool { return e.key }
+func (e CreateReviewRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateReviewRequestValidationError) ErrorName() string {
-	return "UpdateReviewRequestValidationError"
+func (e CreateReviewRequestValidationError) ErrorName() string {
+	return "CreateReviewRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateReviewRequestValidationError) Error() string {
+func (e CreateReviewRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -720,14 +1010,14 @@ func (e UpdateReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateReviewRequest.%s: %s%s",
+		"invalid %sCreateReviewRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateReviewRequestValidationError{}
+var _ error = CreateReviewRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -735,4 +1025,6979 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateReviewRequestValidationError{}
+} = CreateReviewRequestValidationError{}
+
+// Validate checks the field values on UpdateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateReviewRequestMultiError, or nil if none found.
+func (m *UpdateReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReview() == nil {
+		err := UpdateReviewRequestValidationError{
+			field:  "Review",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// skipping validation for review
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateReviewRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateReviewRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateReviewRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReviewRequestMultiError) AllErrors() []error { return m }
+
+// UpdateReviewRequestValidationError is the validation error returned by
+// UpdateReviewRequest.Validate if the designated constraints aren't met.
+type UpdateReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReviewRequestValidationError) ErrorName() string {
+	return "UpdateReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReviewRequestValidationError{}
+
+// Validate checks the field values on DeleteReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// DeleteReviewRequestMultiError, or nil if none found.
+func (m *DeleteReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = DeleteReviewRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetMovieId()); err != nil {
+		err = DeleteReviewRequestValidationError{
+			field:  "MovieId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Permanent
+
+	if len(errors) > 0 {
+		return DeleteReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteReviewRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReviewRequestMultiError) AllErrors() []error { return m }
+
+// DeleteReviewRequestValidationError is the validation error returned by
+// DeleteReviewRequest.Validate if the designated constraints aren't met.
+type DeleteReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReviewRequestValidationError) ErrorName() string {
+	return "DeleteReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReviewRequestValidationError{}
+
+// Validate checks the field values on VoteReviewRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VoteReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VoteReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// VoteReviewRequestMultiError, or nil if none found.
+func (m *VoteReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VoteReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetVoterId()); err != nil {
+		err = VoteReviewRequestValidationError{
+			field:  "VoterId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = VoteReviewRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetMovieId()); err != nil {
+		err = VoteReviewRequestValidationError{
+			field:  "MovieId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _VoteReviewRequest_Vote_NotInLookup[m.GetVote()]; ok {
+		err := VoteReviewRequestValidationError{
+			field:  "Vote",
+			reason: "value must not be in list [VOTE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Vote_name[int32(m.GetVote())]; !ok {
+		err := VoteReviewRequestValidationError{
+			field:  "Vote",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return VoteReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *VoteReviewRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// VoteReviewRequestMultiError is an error wrapping multiple validation errors
+// returned by VoteReviewRequest.ValidateAll() if the designated constraints
+// aren't met.
+type VoteReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VoteReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VoteReviewRequestMultiError) AllErrors() []error { return m }
+
+// VoteReviewRequestValidationError is the validation error returned by
+// VoteReviewRequest.Validate if the designated constraints aren't met.
+type VoteReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VoteReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VoteReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VoteReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VoteReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VoteReviewRequestValidationError) ErrorName() string {
+	return "VoteReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VoteReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVoteReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VoteReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VoteReviewRequestValidationError{}
+
+var _VoteReviewRequest_Vote_NotInLookup = map[Vote]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on RemoveVoteRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RemoveVoteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveVoteRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// RemoveVoteRequestMultiError, or nil if none found.
+func (m *RemoveVoteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveVoteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetVoterId()); err != nil {
+		err = RemoveVoteRequestValidationError{
+			field:  "VoterId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RemoveVoteRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetMovieId()); err != nil {
+		err = RemoveVoteRequestValidationError{
+			field:  "MovieId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveVoteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RemoveVoteRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RemoveVoteRequestMultiError is an error wrapping multiple validation errors
+// returned by RemoveVoteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RemoveVoteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveVoteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveVoteRequestMultiError) AllErrors() []error { return m }
+
+// RemoveVoteRequestValidationError is the validation error returned by
+// RemoveVoteRequest.Validate if the designated constraints aren't met.
+type RemoveVoteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveVoteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveVoteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveVoteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveVoteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveVoteRequestValidationError) ErrorName() string {
+	return "RemoveVoteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveVoteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveVoteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveVoteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveVoteRequestValidationError{}
+
+// Validate checks the field values on Comment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Comment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Comment with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CommentMultiError, or nil if none
+// found.
+func (m *Comment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Comment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ReviewUserId
+
+	// no validation rules for MovieId
+
+	// no validation rules for AuthorId
+
+	// no validation rules for ParentId
+
+	// no validation rules for Text
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommentValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommentValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetReplies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CommentValidationError{
+						field:  fmt.Sprintf("Replies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CommentValidationError{
+						field:  fmt.Sprintf("Replies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CommentValidationError{
+					field:  fmt.Sprintf("Replies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CommentMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommentMultiError is an error wrapping multiple validation errors returned
+// by Comment.ValidateAll() if the designated constraints aren't met.
+type CommentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommentMultiError) AllErrors() []error { return m }
+
+// CommentValidationError is the validation error returned by Comment.Validate
+// if the designated constraints aren't met.
+type CommentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommentValidationError) ErrorName() string { return "CommentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CommentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommentValidationError{}
+
+// Validate checks the field values on AddCommentRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddCommentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// AddCommentRequestMultiError, or nil if none found.
+func (m *AddCommentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddCommentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetReviewUserId()); err != nil {
+		err = AddCommentRequestValidationError{
+			field:  "ReviewUserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetMovieId()); err != nil {
+		err = AddCommentRequestValidationError{
+			field:  "MovieId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetAuthorId()); err != nil {
+		err = AddCommentRequestValidationError{
+			field:  "AuthorId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetParentId() != "" {
+		if utf8.RuneCountInString(m.GetParentId()) != 24 {
+			err := AddCommentRequestValidationError{
+				field:  "ParentId",
+				reason: "value length must be 24 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if l := utf8.RuneCountInString(m.GetText()); l < 1 || l > 2000 {
+		err := AddCommentRequestValidationError{
+			field:  "Text",
+			reason: "value length must be between 1 and 2000 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddCommentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AddCommentRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AddCommentRequestMultiError is an error wrapping multiple validation errors
+// returned by AddCommentRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddCommentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddCommentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddCommentRequestMultiError) AllErrors() []error { return m }
+
+// AddCommentRequestValidationError is the validation error returned by
+// AddCommentRequest.Validate if the designated constraints aren't met.
+type AddCommentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddCommentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddCommentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddCommentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddCommentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddCommentRequestValidationError) ErrorName() string {
+	return "AddCommentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddCommentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddCommentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddCommentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddCommentRequestValidationError{}
+
+// Validate checks the field values on ListCommentsRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ListCommentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCommentsRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ListCommentsRequestMultiError, or nil if none found.
+func (m *ListCommentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCommentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetReviewUserId()); err != nil {
+		err = ListCommentsRequestValidationError{
+			field:  "ReviewUserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetMovieId()); err != nil {
+		err = ListCommentsRequestValidationError{
+			field:  "MovieId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() < 0 {
+		err := ListCommentsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListCommentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListCommentsRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListCommentsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCommentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCommentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCommentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCommentsRequestMultiError) AllErrors() []error { return m }
+
+// ListCommentsRequestValidationError is the validation error returned by
+// ListCommentsRequest.Validate if the designated constraints aren't met.
+type ListCommentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCommentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCommentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCommentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCommentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCommentsRequestValidationError) ErrorName() string {
+	return "ListCommentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCommentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCommentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCommentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCommentsRequestValidationError{}
+
+// Validate checks the field values on ListCommentsResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ListCommentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCommentsResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ListCommentsResponseMultiError, or nil if none found.
+func (m *ListCommentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCommentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetComments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCommentsResponseValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCommentsResponseValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCommentsResponseValidationError{
+					field:  fmt.Sprintf("Comments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListCommentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCommentsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListCommentsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListCommentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCommentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCommentsResponseMultiError) AllErrors() []error { return m }
+
+// ListCommentsResponseValidationError is the validation error returned by
+// ListCommentsResponse.Validate if the designated constraints aren't met.
+type ListCommentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCommentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCommentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCommentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCommentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCommentsResponseValidationError) ErrorName() string {
+	return "ListCommentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCommentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCommentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCommentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCommentsResponseValidationError{}
+
+// Validate checks the field values on EditCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *EditCommentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EditCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// EditCommentRequestMultiError, or nil if none found.
+func (m *EditCommentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EditCommentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCommentId()) != 24 {
+		err := EditCommentRequestValidationError{
+			field:  "CommentId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetAuthorId()); err != nil {
+		err = EditCommentRequestValidationError{
+			field:  "AuthorId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetText()); l < 1 || l > 2000 {
+		err := EditCommentRequestValidationError{
+			field:  "Text",
+			reason: "value length must be between 1 and 2000 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return EditCommentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *EditCommentRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// EditCommentRequestMultiError is an error wrapping multiple validation errors
+// returned by EditCommentRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EditCommentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EditCommentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EditCommentRequestMultiError) AllErrors() []error { return m }
+
+// EditCommentRequestValidationError is the validation error returned by
+// EditCommentRequest.Validate if the designated constraints aren't met.
+type EditCommentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EditCommentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EditCommentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EditCommentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EditCommentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EditCommentRequestValidationError) ErrorName() string {
+	return "EditCommentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EditCommentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEditCommentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EditCommentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EditCommentRequestValidationError{}
+
+// Validate checks the field values on DeleteCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCommentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// DeleteCommentRequestMultiError, or nil if none found.
+func (m *DeleteCommentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCommentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCommentId()) != 24 {
+		err := DeleteCommentRequestValidationError{
+			field:  "CommentId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetAuthorId()); err != nil {
+		err = DeleteCommentRequestValidationError{
+			field:  "AuthorId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteCommentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteCommentRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteCommentRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteCommentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCommentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCommentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCommentRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCommentRequestValidationError is the validation error returned by
+// DeleteCommentRequest.Validate if the designated constraints aren't met.
+type DeleteCommentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCommentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCommentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCommentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCommentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCommentRequestValidationError) ErrorName() string {
+	return "DeleteCommentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCommentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCommentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCommentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCommentRequestValidationError{}
+
+// Validate checks the field values on GetMovieRatingRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *GetMovieRatingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMovieRatingRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// GetMovieRatingRequestMultiError, or nil if none found.
+func (m *GetMovieRatingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMovieRatingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetMovieId()); err != nil {
+		err = GetMovieRatingRequestValidationError{
+			field:  "MovieId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetMovieRatingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetMovieRatingRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetMovieRatingRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMovieRatingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMovieRatingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMovieRatingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMovieRatingRequestMultiError) AllErrors() []error { return m }
+
+// GetMovieRatingRequestValidationError is the validation error returned by
+// GetMovieRatingRequest.Validate if the designated constraints aren't met.
+type GetMovieRatingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMovieRatingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMovieRatingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMovieRatingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMovieRatingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMovieRatingRequestValidationError) ErrorName() string {
+	return "GetMovieRatingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMovieRatingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMovieRatingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMovieRatingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMovieRatingRequestValidationError{}
+
+// Validate checks the field values on RatingBucket with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RatingBucket) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RatingBucket with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RatingBucketMultiError, or
+// nil if none found.
+func (m *RatingBucket) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RatingBucket) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Rating
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return RatingBucketMultiError(errors)
+	}
+
+	return nil
+}
+
+// RatingBucketMultiError is an error wrapping multiple validation errors
+// returned by RatingBucket.ValidateAll() if the designated constraints aren't
+// met.
+type RatingBucketMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RatingBucketMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RatingBucketMultiError) AllErrors() []error { return m }
+
+// RatingBucketValidationError is the validation error returned by
+// RatingBucket.Validate if the designated constraints aren't met.
+type RatingBucketValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RatingBucketValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RatingBucketValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RatingBucketValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RatingBucketValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RatingBucketValidationError) ErrorName() string { return "RatingBucketValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RatingBucketValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRatingBucket.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RatingBucketValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RatingBucketValidationError{}
+
+// Validate checks the field values on GetMovieRatingResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *GetMovieRatingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMovieRatingResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// GetMovieRatingResponseMultiError, or nil if none found.
+func (m *GetMovieRatingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMovieRatingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MovieId
+
+	// no validation rules for Average
+
+	// no validation rules for Count
+
+	for idx, item := range m.GetHistogram() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMovieRatingResponseValidationError{
+						field:  fmt.Sprintf("Histogram[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMovieRatingResponseValidationError{
+						field:  fmt.Sprintf("Histogram[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMovieRatingResponseValidationError{
+					field:  fmt.Sprintf("Histogram[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetMovieRatingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMovieRatingResponseMultiError is an error wrapping multiple validation
+// errors returned by GetMovieRatingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetMovieRatingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMovieRatingResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMovieRatingResponseMultiError) AllErrors() []error { return m }
+
+// GetMovieRatingResponseValidationError is the validation error returned by
+// GetMovieRatingResponse.Validate if the designated constraints aren't met.
+type GetMovieRatingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMovieRatingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMovieRatingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMovieRatingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMovieRatingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMovieRatingResponseValidationError) ErrorName() string {
+	return "GetMovieRatingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMovieRatingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMovieRatingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMovieRatingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMovieRatingResponseValidationError{}
+
+// Validate checks the field values on BatchGetMovieReviewSummariesRequest with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *BatchGetMovieReviewSummariesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetMovieReviewSummariesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// BatchGetMovieReviewSummariesRequestMultiError, or nil if none found.
+func (m *BatchGetMovieReviewSummariesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetMovieReviewSummariesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetMovieIds()); l < 1 || l > 100 {
+		err := BatchGetMovieReviewSummariesRequestValidationError{
+			field:  "MovieIds",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_BatchGetMovieReviewSummariesRequest_MovieIds_Unique := make(map[string]struct{}, len(m.GetMovieIds()))
+
+	for idx, item := range m.GetMovieIds() {
+		_, _ = idx, item
+
+		if _, exists := _BatchGetMovieReviewSummariesRequest_MovieIds_Unique[item]; exists {
+			err := BatchGetMovieReviewSummariesRequestValidationError{
+				field:  fmt.Sprintf("MovieIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_BatchGetMovieReviewSummariesRequest_MovieIds_Unique[item] = struct{}{}
+		}
+
+		if err := m._validateUuid(item); err != nil {
+			err = BatchGetMovieReviewSummariesRequestValidationError{
+				field:  fmt.Sprintf("MovieIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetMovieReviewSummariesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *BatchGetMovieReviewSummariesRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// BatchGetMovieReviewSummariesRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// BatchGetMovieReviewSummariesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetMovieReviewSummariesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetMovieReviewSummariesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetMovieReviewSummariesRequestMultiError) AllErrors() []error { return m }
+
+// BatchGetMovieReviewSummariesRequestValidationError is the validation error
+// returned by BatchGetMovieReviewSummariesRequest.Validate if the designated
+// constraints aren't met.
+type BatchGetMovieReviewSummariesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetMovieReviewSummariesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetMovieReviewSummariesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetMovieReviewSummariesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetMovieReviewSummariesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetMovieReviewSummariesRequestValidationError) ErrorName() string {
+	return "BatchGetMovieReviewSummariesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetMovieReviewSummariesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetMovieReviewSummariesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetMovieReviewSummariesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetMovieReviewSummariesRequestValidationError{}
+
+// Validate checks the field values on MovieReviewSummary with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *MovieReviewSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MovieReviewSummary with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// MovieReviewSummaryMultiError, or nil if none found.
+func (m *MovieReviewSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MovieReviewSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MovieId
+
+	// no validation rules for ReviewCount
+
+	// no validation rules for AverageRating
+
+	if len(errors) > 0 {
+		return MovieReviewSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// MovieReviewSummaryMultiError is an error wrapping multiple validation errors
+// returned by MovieReviewSummary.ValidateAll() if the designated constraints
+// aren't met.
+type MovieReviewSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MovieReviewSummaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MovieReviewSummaryMultiError) AllErrors() []error { return m }
+
+// MovieReviewSummaryValidationError is the validation error returned by
+// MovieReviewSummary.Validate if the designated constraints aren't met.
+type MovieReviewSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MovieReviewSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MovieReviewSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MovieReviewSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MovieReviewSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MovieReviewSummaryValidationError) ErrorName() string {
+	return "MovieReviewSummaryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MovieReviewSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMovieReviewSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MovieReviewSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MovieReviewSummaryValidationError{}
+
+// Validate checks the field values on BatchGetMovieReviewSummariesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if there
+// are no violations.
+func (m *BatchGetMovieReviewSummariesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetMovieReviewSummariesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// BatchGetMovieReviewSummariesResponseMultiError, or nil if none found.
+func (m *BatchGetMovieReviewSummariesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetMovieReviewSummariesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSummaries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetMovieReviewSummariesResponseValidationError{
+						field:  fmt.Sprintf("Summaries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetMovieReviewSummariesResponseValidationError{
+						field:  fmt.Sprintf("Summaries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetMovieReviewSummariesResponseValidationError{
+					field:  fmt.Sprintf("Summaries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetMovieReviewSummariesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetMovieReviewSummariesResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// BatchGetMovieReviewSummariesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetMovieReviewSummariesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetMovieReviewSummariesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetMovieReviewSummariesResponseMultiError) AllErrors() []error { return m }
+
+// BatchGetMovieReviewSummariesResponseValidationError is the validation error
+// returned by BatchGetMovieReviewSummariesResponse.Validate if the designated
+// constraints aren't met.
+type BatchGetMovieReviewSummariesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetMovieReviewSummariesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetMovieReviewSummariesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetMovieReviewSummariesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetMovieReviewSummariesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetMovieReviewSummariesResponseValidationError) ErrorName() string {
+	return "BatchGetMovieReviewSummariesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetMovieReviewSummariesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetMovieReviewSummariesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetMovieReviewSummariesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetMovieReviewSummariesResponseValidationError{}
+
+// Validate checks the field values on SearchReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *SearchReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// SearchReviewsRequestMultiError, or nil if none found.
+func (m *SearchReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 200 {
+		err := SearchReviewsRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() < 0 {
+		err := SearchReviewsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	switch v := m.Filter.(type) {
+	case *SearchReviewsRequest_MovieId:
+		if v == nil {
+			err := SearchReviewsRequestValidationError{
+				field:  "Filter",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if err := m._validateUuid(m.GetMovieId()); err != nil {
+			err = SearchReviewsRequestValidationError{
+				field:  "MovieId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *SearchReviewsRequest_UserId:
+		if v == nil {
+			err := SearchReviewsRequestValidationError{
+				field:  "Filter",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if err := m._validateUuid(m.GetUserId()); err != nil {
+			err = SearchReviewsRequestValidationError{
+				field:  "UserId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return SearchReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SearchReviewsRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SearchReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchReviewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchReviewsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchReviewsRequestMultiError) AllErrors() []error { return m }
+
+// SearchReviewsRequestValidationError is the validation error returned by
+// SearchReviewsRequest.Validate if the designated constraints aren't met.
+type SearchReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchReviewsRequestValidationError) ErrorName() string {
+	return "SearchReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchReviewsRequestValidationError{}
+
+// Validate checks the field values on SearchResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchResultMultiError, or
+// nil if none found.
+func (m *SearchResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReview()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchResultValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchResultValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReview()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchResultValidationError{
+				field:  "Review",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Snippet
+
+	// no validation rules for Score
+
+	if len(errors) > 0 {
+		return SearchResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchResultMultiError is an error wrapping multiple validation errors
+// returned by SearchResult.ValidateAll() if the designated constraints aren't
+// met.
+type SearchResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchResultMultiError) AllErrors() []error { return m }
+
+// SearchResultValidationError is the validation error returned by
+// SearchResult.Validate if the designated constraints aren't met.
+type SearchResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchResultValidationError) ErrorName() string { return "SearchResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchResultValidationError{}
+
+// Validate checks the field values on SearchReviewsResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *SearchReviewsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchReviewsResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// SearchReviewsResponseMultiError, or nil if none found.
+func (m *SearchReviewsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchReviewsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchReviewsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchReviewsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchReviewsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return SearchReviewsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchReviewsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchReviewsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchReviewsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchReviewsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchReviewsResponseMultiError) AllErrors() []error { return m }
+
+// SearchReviewsResponseValidationError is the validation error returned by
+// SearchReviewsResponse.Validate if the designated constraints aren't met.
+type SearchReviewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchReviewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchReviewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchReviewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchReviewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchReviewsResponseValidationError) ErrorName() string {
+	return "SearchReviewsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchReviewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchReviewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchReviewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchReviewsResponseValidationError{}
+
+// Validate checks the field values on WatchMovieReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *WatchMovieReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchMovieReviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchMovieReviewsRequestMultiError, or nil if none found.
+func (m *WatchMovieReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchMovieReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetMovieId()); err != nil {
+		err = WatchMovieReviewsRequestValidationError{
+			field:  "MovieId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ResumeToken
+
+	if len(errors) > 0 {
+		return WatchMovieReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *WatchMovieReviewsRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// WatchMovieReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchMovieReviewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchMovieReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchMovieReviewsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchMovieReviewsRequestMultiError) AllErrors() []error { return m }
+
+// WatchMovieReviewsRequestValidationError is the validation error returned by
+// WatchMovieReviewsRequest.Validate if the designated constraints aren't met.
+type WatchMovieReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchMovieReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchMovieReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchMovieReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchMovieReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchMovieReviewsRequestValidationError) ErrorName() string {
+	return "WatchMovieReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchMovieReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchMovieReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchMovieReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchMovieReviewsRequestValidationError{}
+
+// Validate checks the field values on ReviewEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReviewEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in ReviewEventMultiError, or nil if
+// none found.
+func (m *ReviewEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetReview()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewEventValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewEventValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReview()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewEventValidationError{
+				field:  "Review",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResumeToken
+
+	if len(errors) > 0 {
+		return ReviewEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewEventMultiError is an error wrapping multiple validation errors
+// returned by ReviewEvent.ValidateAll() if the designated constraints aren't
+// met.
+type ReviewEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewEventMultiError) AllErrors() []error { return m }
+
+// ReviewEventValidationError is the validation error returned by
+// ReviewEvent.Validate if the designated constraints aren't met.
+type ReviewEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewEventValidationError) ErrorName() string { return "ReviewEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReviewEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewEventValidationError{}
+
+// Validate checks the field values on ImportReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ImportReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ImportReviewsRequestMultiError, or nil if none found.
+func (m *ImportReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// skipping validation for review
+
+	if _, ok := ImportMode_name[int32(m.GetMode())]; !ok {
+		err := ImportReviewsRequestValidationError{
+			field:  "Mode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ImportReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportReviewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportReviewsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportReviewsRequestMultiError) AllErrors() []error { return m }
+
+// ImportReviewsRequestValidationError is the validation error returned by
+// ImportReviewsRequest.Validate if the designated constraints aren't met.
+type ImportReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportReviewsRequestValidationError) ErrorName() string {
+	return "ImportReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportReviewsRequestValidationError{}
+
+// Validate checks the field values on ImportError with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportError with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in ImportErrorMultiError, or nil if
+// none found.
+func (m *ImportError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Index
+
+	// no validation rules for UserId
+
+	// no validation rules for MovieId
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return ImportErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportErrorMultiError is an error wrapping multiple validation errors
+// returned by ImportError.ValidateAll() if the designated constraints aren't
+// met.
+type ImportErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportErrorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportErrorMultiError) AllErrors() []error { return m }
+
+// ImportErrorValidationError is the validation error returned by
+// ImportError.Validate if the designated constraints aren't met.
+type ImportErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportErrorValidationError) ErrorName() string { return "ImportErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportErrorValidationError{}
+
+// Validate checks the field values on ImportReviewsResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ImportReviewsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportReviewsResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ImportReviewsResponseMultiError, or nil if none found.
+func (m *ImportReviewsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportReviewsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Created
+
+	// no validation rules for Overwritten
+
+	// no validation rules for Skipped
+
+	// no validation rules for Failed
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportReviewsResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportReviewsResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportReviewsResponseValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportReviewsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportReviewsResponseMultiError is an error wrapping multiple validation
+// errors returned by ImportReviewsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ImportReviewsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportReviewsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportReviewsResponseMultiError) AllErrors() []error { return m }
+
+// ImportReviewsResponseValidationError is the validation error returned by
+// ImportReviewsResponse.Validate if the designated constraints aren't met.
+type ImportReviewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportReviewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportReviewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportReviewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportReviewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportReviewsResponseValidationError) ErrorName() string {
+	return "ImportReviewsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportReviewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportReviewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportReviewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportReviewsResponseValidationError{}
+
+// Validate checks the field values on ReportReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ReportReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ReportReviewRequestMultiError, or nil if none found.
+func (m *ReportReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetReporterId()); err != nil {
+		err = ReportReviewRequestValidationError{
+			field:  "ReporterId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ReportReviewRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetMovieId()); err != nil {
+		err = ReportReviewRequestValidationError{
+			field:  "MovieId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ReportReviewRequest_Reason_NotInLookup[m.GetReason()]; ok {
+		err := ReportReviewRequestValidationError{
+			field:  "Reason",
+			reason: "value must not be in list [REPORT_REASON_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ReportReason_name[int32(m.GetReason())]; !ok {
+		err := ReportReviewRequestValidationError{
+			field:  "Reason",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetText()) > 1000 {
+		err := ReportReviewRequestValidationError{
+			field:  "Text",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReportReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReportReviewRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReportReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by ReportReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReportReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportReviewRequestMultiError) AllErrors() []error { return m }
+
+// ReportReviewRequestValidationError is the validation error returned by
+// ReportReviewRequest.Validate if the designated constraints aren't met.
+type ReportReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportReviewRequestValidationError) ErrorName() string {
+	return "ReportReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportReviewRequestValidationError{}
+
+var _ReportReviewRequest_Reason_NotInLookup = map[ReportReason]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ListPendingReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ListPendingReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPendingReviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPendingReviewsRequestMultiError, or nil if none found.
+func (m *ListPendingReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPendingReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPageSize() < 0 {
+		err := ListPendingReviewsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListPendingReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPendingReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPendingReviewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPendingReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPendingReviewsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPendingReviewsRequestMultiError) AllErrors() []error { return m }
+
+// ListPendingReviewsRequestValidationError is the validation error returned by
+// ListPendingReviewsRequest.Validate if the designated constraints aren't met.
+type ListPendingReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingReviewsRequestValidationError) ErrorName() string {
+	return "ListPendingReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingReviewsRequestValidationError{}
+
+// Validate checks the field values on ListPendingReviewsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListPendingReviewsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPendingReviewsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPendingReviewsResponseMultiError, or nil if none found.
+func (m *ListPendingReviewsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPendingReviewsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReviews() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPendingReviewsResponseValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPendingReviewsResponseValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPendingReviewsResponseValidationError{
+					field:  fmt.Sprintf("Reviews[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListPendingReviewsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPendingReviewsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListPendingReviewsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListPendingReviewsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPendingReviewsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPendingReviewsResponseMultiError) AllErrors() []error { return m }
+
+// ListPendingReviewsResponseValidationError is the validation error returned
+// by ListPendingReviewsResponse.Validate if the designated constraints aren't
+// met.
+type ListPendingReviewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingReviewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingReviewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingReviewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingReviewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingReviewsResponseValidationError) ErrorName() string {
+	return "ListPendingReviewsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingReviewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingReviewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingReviewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingReviewsResponseValidationError{}
+
+// Validate checks the field values on ApproveReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ApproveReviewRequestMultiError, or nil if none found.
+func (m *ApproveReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ApproveReviewRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetMovieId()); err != nil {
+		err = ApproveReviewRequestValidationError{
+			field:  "MovieId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ApproveReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ApproveReviewRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ApproveReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by ApproveReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveReviewRequestMultiError) AllErrors() []error { return m }
+
+// ApproveReviewRequestValidationError is the validation error returned by
+// ApproveReviewRequest.Validate if the designated constraints aren't met.
+type ApproveReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveReviewRequestValidationError) ErrorName() string {
+	return "ApproveReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveReviewRequestValidationError{}
+
+// Validate checks the field values on RejectReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *RejectReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// RejectReviewRequestMultiError, or nil if none found.
+func (m *RejectReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RejectReviewRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetMovieId()); err != nil {
+		err = RejectReviewRequestValidationError{
+			field:  "MovieId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 500 {
+		err := RejectReviewRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 500 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RejectReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RejectReviewRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RejectReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by RejectReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RejectReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectReviewRequestMultiError) AllErrors() []error { return m }
+
+// RejectReviewRequestValidationError is the validation error returned by
+// RejectReviewRequest.Validate if the designated constraints aren't met.
+type RejectReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectReviewRequestValidationError) ErrorName() string {
+	return "RejectReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectReviewRequestValidationError{}
+
+// Validate checks the field values on RestoreReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// RestoreReviewRequestMultiError, or nil if none found.
+func (m *RestoreReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RestoreReviewRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetMovieId()); err != nil {
+		err = RestoreReviewRequestValidationError{
+			field:  "MovieId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RestoreReviewRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RestoreReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreReviewRequestMultiError) AllErrors() []error { return m }
+
+// RestoreReviewRequestValidationError is the validation error returned by
+// RestoreReviewRequest.Validate if the designated constraints aren't met.
+type RestoreReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreReviewRequestValidationError) ErrorName() string {
+	return "RestoreReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreReviewRequestValidationError{}
+
+// Validate checks the field values on ListReviewRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListReviewRevisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReviewRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReviewRevisionsRequestMultiError, or nil if none found.
+func (m *ListReviewRevisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReviewRevisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ListReviewRevisionsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetMovieId()); err != nil {
+		err = ListReviewRevisionsRequestValidationError{
+			field:  "MovieId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() < 0 {
+		err := ListReviewRevisionsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListReviewRevisionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListReviewRevisionsRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListReviewRevisionsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListReviewRevisionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListReviewRevisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReviewRevisionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReviewRevisionsRequestMultiError) AllErrors() []error { return m }
+
+// ListReviewRevisionsRequestValidationError is the validation error returned
+// by ListReviewRevisionsRequest.Validate if the designated constraints aren't
+// met.
+type ListReviewRevisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReviewRevisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReviewRevisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReviewRevisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReviewRevisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReviewRevisionsRequestValidationError) ErrorName() string {
+	return "ListReviewRevisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReviewRevisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReviewRevisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReviewRevisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReviewRevisionsRequestValidationError{}
+
+// Validate checks the field values on ReviewRevision with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReviewRevision) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewRevision with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReviewRevisionMultiError, or
+// nil if none found.
+func (m *ReviewRevision) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewRevision) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	// no validation rules for Text
+
+	// no validation rules for Rating
+
+	// no validation rules for EditorId
+
+	if all {
+		switch v := interface{}(m.GetEditedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewRevisionValidationError{
+					field:  "EditedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewRevisionValidationError{
+					field:  "EditedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEditedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewRevisionValidationError{
+				field:  "EditedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReviewRevisionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewRevisionMultiError is an error wrapping multiple validation errors
+// returned by ReviewRevision.ValidateAll() if the designated constraints
+// aren't met.
+type ReviewRevisionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewRevisionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewRevisionMultiError) AllErrors() []error { return m }
+
+// ReviewRevisionValidationError is the validation error returned by
+// ReviewRevision.Validate if the designated constraints aren't met.
+type ReviewRevisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewRevisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewRevisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewRevisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewRevisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewRevisionValidationError) ErrorName() string { return "ReviewRevisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReviewRevisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewRevision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewRevisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewRevisionValidationError{}
+
+// Validate checks the field values on ListReviewRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListReviewRevisionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReviewRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReviewRevisionsResponseMultiError, or nil if none found.
+func (m *ListReviewRevisionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReviewRevisionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRevisions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReviewRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReviewRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReviewRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Revisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListReviewRevisionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReviewRevisionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListReviewRevisionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListReviewRevisionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReviewRevisionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReviewRevisionsResponseMultiError) AllErrors() []error { return m }
+
+// ListReviewRevisionsResponseValidationError is the validation error returned
+// by ListReviewRevisionsResponse.Validate if the designated constraints aren't
+// met.
+type ListReviewRevisionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReviewRevisionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReviewRevisionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReviewRevisionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReviewRevisionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReviewRevisionsResponseValidationError) ErrorName() string {
+	return "ListReviewRevisionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReviewRevisionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReviewRevisionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReviewRevisionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReviewRevisionsResponseValidationError{}
+
+// Validate checks the field values on EraseUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EraseUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EraseUserRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// EraseUserRequestMultiError, or nil if none found.
+func (m *EraseUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EraseUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = EraseUserRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return EraseUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *EraseUserRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// EraseUserRequestMultiError is an error wrapping multiple validation errors
+// returned by EraseUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EraseUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EraseUserRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EraseUserRequestMultiError) AllErrors() []error { return m }
+
+// EraseUserRequestValidationError is the validation error returned by
+// EraseUserRequest.Validate if the designated constraints aren't met.
+type EraseUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EraseUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EraseUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EraseUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EraseUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EraseUserRequestValidationError) ErrorName() string { return "EraseUserRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EraseUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEraseUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EraseUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EraseUserRequestValidationError{}
+
+// Validate checks the field values on ExportUserDataRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ExportUserDataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportUserDataRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ExportUserDataRequestMultiError, or nil if none found.
+func (m *ExportUserDataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportUserDataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ExportUserDataRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportUserDataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ExportUserDataRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ExportUserDataRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportUserDataRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportUserDataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportUserDataRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportUserDataRequestMultiError) AllErrors() []error { return m }
+
+// ExportUserDataRequestValidationError is the validation error returned by
+// ExportUserDataRequest.Validate if the designated constraints aren't met.
+type ExportUserDataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportUserDataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportUserDataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportUserDataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportUserDataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportUserDataRequestValidationError) ErrorName() string {
+	return "ExportUserDataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportUserDataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportUserDataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportUserDataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportUserDataRequestValidationError{}
+
+// Validate checks the field values on ExportedVote with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExportedVote) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportedVote with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExportedVoteMultiError, or
+// nil if none found.
+func (m *ExportedVote) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportedVote) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for MovieId
+
+	// no validation rules for Vote
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportedVoteValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportedVoteValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportedVoteValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExportedVoteMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportedVoteMultiError is an error wrapping multiple validation errors
+// returned by ExportedVote.ValidateAll() if the designated constraints aren't
+// met.
+type ExportedVoteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportedVoteMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportedVoteMultiError) AllErrors() []error { return m }
+
+// ExportedVoteValidationError is the validation error returned by
+// ExportedVote.Validate if the designated constraints aren't met.
+type ExportedVoteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportedVoteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportedVoteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportedVoteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportedVoteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportedVoteValidationError) ErrorName() string { return "ExportedVoteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExportedVoteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportedVote.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportedVoteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportedVoteValidationError{}
+
+// Validate checks the field values on ExportedReport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExportedReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportedReport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExportedReportMultiError, or
+// nil if none found.
+func (m *ExportedReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportedReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for MovieId
+
+	// no validation rules for Reason
+
+	// no validation rules for Text
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportedReportValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportedReportValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportedReportValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExportedReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportedReportMultiError is an error wrapping multiple validation errors
+// returned by ExportedReport.ValidateAll() if the designated constraints
+// aren't met.
+type ExportedReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportedReportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportedReportMultiError) AllErrors() []error { return m }
+
+// ExportedReportValidationError is the validation error returned by
+// ExportedReport.Validate if the designated constraints aren't met.
+type ExportedReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportedReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportedReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportedReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportedReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportedReportValidationError) ErrorName() string { return "ExportedReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExportedReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportedReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportedReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportedReportValidationError{}
+
+// Validate checks the field values on AnalyticsEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AnalyticsEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnalyticsEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AnalyticsEventMultiError, or
+// nil if none found.
+func (m *AnalyticsEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnalyticsEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MovieId
+
+	// no validation rules for Event
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AnalyticsEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AnalyticsEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AnalyticsEventValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AnalyticsEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnalyticsEventMultiError is an error wrapping multiple validation errors
+// returned by AnalyticsEvent.ValidateAll() if the designated constraints
+// aren't met.
+type AnalyticsEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnalyticsEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnalyticsEventMultiError) AllErrors() []error { return m }
+
+// AnalyticsEventValidationError is the validation error returned by
+// AnalyticsEvent.Validate if the designated constraints aren't met.
+type AnalyticsEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnalyticsEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnalyticsEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnalyticsEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnalyticsEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnalyticsEventValidationError) ErrorName() string { return "AnalyticsEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AnalyticsEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnalyticsEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnalyticsEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnalyticsEventValidationError{}
+
+// Validate checks the field values on UserDataRecord with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserDataRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserDataRecord with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserDataRecordMultiError, or
+// nil if none found.
+func (m *UserDataRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserDataRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Record.(type) {
+	case *UserDataRecord_Review:
+		if v == nil {
+			err := UserDataRecordValidationError{
+				field:  "Record",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetReview()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserDataRecordValidationError{
+						field:  "Review",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserDataRecordValidationError{
+						field:  "Review",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetReview()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserDataRecordValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UserDataRecord_Vote:
+		if v == nil {
+			err := UserDataRecordValidationError{
+				field:  "Record",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetVote()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserDataRecordValidationError{
+						field:  "Vote",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserDataRecordValidationError{
+						field:  "Vote",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetVote()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserDataRecordValidationError{
+					field:  "Vote",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UserDataRecord_Comment:
+		if v == nil {
+			err := UserDataRecordValidationError{
+				field:  "Record",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetComment()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserDataRecordValidationError{
+						field:  "Comment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserDataRecordValidationError{
+						field:  "Comment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetComment()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserDataRecordValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UserDataRecord_Report:
+		if v == nil {
+			err := UserDataRecordValidationError{
+				field:  "Record",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetReport()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserDataRecordValidationError{
+						field:  "Report",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserDataRecordValidationError{
+						field:  "Report",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserDataRecordValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UserDataRecord_AnalyticsEvent:
+		if v == nil {
+			err := UserDataRecordValidationError{
+				field:  "Record",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAnalyticsEvent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserDataRecordValidationError{
+						field:  "AnalyticsEvent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserDataRecordValidationError{
+						field:  "AnalyticsEvent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAnalyticsEvent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserDataRecordValidationError{
+					field:  "AnalyticsEvent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return UserDataRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserDataRecordMultiError is an error wrapping multiple validation errors
+// returned by UserDataRecord.ValidateAll() if the designated constraints
+// aren't met.
+type UserDataRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserDataRecordMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserDataRecordMultiError) AllErrors() []error { return m }
+
+// UserDataRecordValidationError is the validation error returned by
+// UserDataRecord.Validate if the designated constraints aren't met.
+type UserDataRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserDataRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserDataRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserDataRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserDataRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserDataRecordValidationError) ErrorName() string { return "UserDataRecordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserDataRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserDataRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserDataRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserDataRecordValidationError{}
+
+// Validate checks the field values on PlaybackProgress with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PlaybackProgress) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlaybackProgress with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// PlaybackProgressMultiError, or nil if none found.
+func (m *PlaybackProgress) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlaybackProgress) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MovieId
+
+	// no validation rules for PositionMs
+
+	// no validation rules for DurationMs
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlaybackProgressValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlaybackProgressValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlaybackProgressValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PlaybackProgressMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlaybackProgressMultiError is an error wrapping multiple validation errors
+// returned by PlaybackProgress.ValidateAll() if the designated constraints
+// aren't met.
+type PlaybackProgressMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlaybackProgressMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlaybackProgressMultiError) AllErrors() []error { return m }
+
+// PlaybackProgressValidationError is the validation error returned by
+// PlaybackProgress.Validate if the designated constraints aren't met.
+type PlaybackProgressValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlaybackProgressValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlaybackProgressValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlaybackProgressValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlaybackProgressValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlaybackProgressValidationError) ErrorName() string { return "PlaybackProgressValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PlaybackProgressValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlaybackProgress.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlaybackProgressValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlaybackProgressValidationError{}
+
+// Validate checks the field values on ReportProgressRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ReportProgressRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportProgressRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ReportProgressRequestMultiError, or nil if none found.
+func (m *ReportProgressRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportProgressRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ReportProgressRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetMovieId()); err != nil {
+		err = ReportProgressRequestValidationError{
+			field:  "MovieId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPositionMs() < 0 {
+		err := ReportProgressRequestValidationError{
+			field:  "PositionMs",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDurationMs() <= 0 {
+		err := ReportProgressRequestValidationError{
+			field:  "DurationMs",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReportProgressRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReportProgressRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReportProgressRequestMultiError is an error wrapping multiple validation
+// errors returned by ReportProgressRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReportProgressRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportProgressRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportProgressRequestMultiError) AllErrors() []error { return m }
+
+// ReportProgressRequestValidationError is the validation error returned by
+// ReportProgressRequest.Validate if the designated constraints aren't met.
+type ReportProgressRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportProgressRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportProgressRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportProgressRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportProgressRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportProgressRequestValidationError) ErrorName() string {
+	return "ReportProgressRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportProgressRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportProgressRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportProgressRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportProgressRequestValidationError{}
+
+// Validate checks the field values on GetProgressRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *GetProgressRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProgressRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// GetProgressRequestMultiError, or nil if none found.
+func (m *GetProgressRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProgressRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = GetProgressRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetMovieId()); err != nil {
+		err = GetProgressRequestValidationError{
+			field:  "MovieId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetProgressRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetProgressRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetProgressRequestMultiError is an error wrapping multiple validation errors
+// returned by GetProgressRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetProgressRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProgressRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProgressRequestMultiError) AllErrors() []error { return m }
+
+// GetProgressRequestValidationError is the validation error returned by
+// GetProgressRequest.Validate if the designated constraints aren't met.
+type GetProgressRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProgressRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProgressRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProgressRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProgressRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProgressRequestValidationError) ErrorName() string {
+	return "GetProgressRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProgressRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProgressRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProgressRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProgressRequestValidationError{}
+
+// Validate checks the field values on ListInProgressRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ListInProgressRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInProgressRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ListInProgressRequestMultiError, or nil if none found.
+func (m *ListInProgressRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInProgressRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ListInProgressRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListInProgressRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListInProgressRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListInProgressRequestMultiError is an error wrapping multiple validation
+// errors returned by ListInProgressRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListInProgressRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInProgressRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInProgressRequestMultiError) AllErrors() []error { return m }
+
+// ListInProgressRequestValidationError is the validation error returned by
+// ListInProgressRequest.Validate if the designated constraints aren't met.
+type ListInProgressRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInProgressRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInProgressRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInProgressRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInProgressRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInProgressRequestValidationError) ErrorName() string {
+	return "ListInProgressRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInProgressRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInProgressRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInProgressRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInProgressRequestValidationError{}
+
+// Validate checks the field values on ListInProgressResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ListInProgressResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInProgressResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ListInProgressResponseMultiError, or nil if none found.
+func (m *ListInProgressResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInProgressResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListInProgressResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListInProgressResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListInProgressResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListInProgressResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInProgressResponseMultiError is an error wrapping multiple validation
+// errors returned by ListInProgressResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListInProgressResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInProgressResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInProgressResponseMultiError) AllErrors() []error { return m }
+
+// ListInProgressResponseValidationError is the validation error returned by
+// ListInProgressResponse.Validate if the designated constraints aren't met.
+type ListInProgressResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInProgressResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInProgressResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInProgressResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInProgressResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInProgressResponseValidationError) ErrorName() string {
+	return "ListInProgressResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInProgressResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInProgressResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInProgressResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInProgressResponseValidationError{}
+
+// Validate checks the field values on Bookmark with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Bookmark) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Bookmark with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in BookmarkMultiError, or nil if none
+// found.
+func (m *Bookmark) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Bookmark) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MovieId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookmarkValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookmarkValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookmarkValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BookmarkMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookmarkMultiError is an error wrapping multiple validation errors returned
+// by Bookmark.ValidateAll() if the designated constraints aren't met.
+type BookmarkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookmarkMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookmarkMultiError) AllErrors() []error { return m }
+
+// BookmarkValidationError is the validation error returned by
+// Bookmark.Validate if the designated constraints aren't met.
+type BookmarkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookmarkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookmarkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookmarkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookmarkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookmarkValidationError) ErrorName() string { return "BookmarkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BookmarkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookmark.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookmarkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookmarkValidationError{}
+
+// Validate checks the field values on AddBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AddBookmarkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// AddBookmarkRequestMultiError, or nil if none found.
+func (m *AddBookmarkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddBookmarkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = AddBookmarkRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetMovieId()); err != nil {
+		err = AddBookmarkRequestValidationError{
+			field:  "MovieId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddBookmarkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AddBookmarkRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AddBookmarkRequestMultiError is an error wrapping multiple validation errors
+// returned by AddBookmarkRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddBookmarkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddBookmarkRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddBookmarkRequestMultiError) AllErrors() []error { return m }
+
+// AddBookmarkRequestValidationError is the validation error returned by
+// AddBookmarkRequest.Validate if the designated constraints aren't met.
+type AddBookmarkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddBookmarkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddBookmarkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddBookmarkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddBookmarkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddBookmarkRequestValidationError) ErrorName() string {
+	return "AddBookmarkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddBookmarkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddBookmarkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddBookmarkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddBookmarkRequestValidationError{}
+
+// Validate checks the field values on RemoveBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveBookmarkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// RemoveBookmarkRequestMultiError, or nil if none found.
+func (m *RemoveBookmarkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveBookmarkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RemoveBookmarkRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetMovieId()); err != nil {
+		err = RemoveBookmarkRequestValidationError{
+			field:  "MovieId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveBookmarkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RemoveBookmarkRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RemoveBookmarkRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveBookmarkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveBookmarkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveBookmarkRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveBookmarkRequestMultiError) AllErrors() []error { return m }
+
+// RemoveBookmarkRequestValidationError is the validation error returned by
+// RemoveBookmarkRequest.Validate if the designated constraints aren't met.
+type RemoveBookmarkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveBookmarkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveBookmarkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveBookmarkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveBookmarkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveBookmarkRequestValidationError) ErrorName() string {
+	return "RemoveBookmarkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveBookmarkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveBookmarkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveBookmarkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveBookmarkRequestValidationError{}
+
+// Validate checks the field values on ListBookmarksRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ListBookmarksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBookmarksRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ListBookmarksRequestMultiError, or nil if none found.
+func (m *ListBookmarksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBookmarksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ListBookmarksRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() < 0 {
+		err := ListBookmarksRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListBookmarksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListBookmarksRequest) _validateUuid(uuid string) error {
+	if matched := _ugc_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListBookmarksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBookmarksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListBookmarksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBookmarksRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBookmarksRequestMultiError) AllErrors() []error { return m }
+
+// ListBookmarksRequestValidationError is the validation error returned by
+// ListBookmarksRequest.Validate if the designated constraints aren't met.
+type ListBookmarksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBookmarksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBookmarksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBookmarksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBookmarksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBookmarksRequestValidationError) ErrorName() string {
+	return "ListBookmarksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBookmarksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBookmarksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBookmarksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBookmarksRequestValidationError{}
+
+// Validate checks the field values on ListBookmarksResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ListBookmarksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBookmarksResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ListBookmarksResponseMultiError, or nil if none found.
+func (m *ListBookmarksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBookmarksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBookmarks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBookmarksResponseValidationError{
+						field:  fmt.Sprintf("Bookmarks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBookmarksResponseValidationError{
+						field:  fmt.Sprintf("Bookmarks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBookmarksResponseValidationError{
+					field:  fmt.Sprintf("Bookmarks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListBookmarksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBookmarksResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBookmarksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListBookmarksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBookmarksResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBookmarksResponseMultiError) AllErrors() []error { return m }
+
+// ListBookmarksResponseValidationError is the validation error returned by
+// ListBookmarksResponse.Validate if the designated constraints aren't met.
+type ListBookmarksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBookmarksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBookmarksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBookmarksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBookmarksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBookmarksResponseValidationError) ErrorName() string {
+	return "ListBookmarksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBookmarksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBookmarksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBookmarksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBookmarksResponseValidationError{}
@@ -20,9 +20,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UGCService_GetReviews_FullMethodName   = "/github.com.maisiq.go_ugc_service.v1.UGCService/GetReviews"
-	UGCService_CreateReview_FullMethodName = "/github.com.maisiq.go_ugc_service.v1.UGCService/CreateReview"
-	UGCService_UpdateReview_FullMethodName = "/github.com.maisiq.go_ugc_service.v1.UGCService/UpdateReview"
+	UGCService_GetReviews_FullMethodName                   = "/github.com.maisiq.go_ugc_service.v1.UGCService/GetReviews"
+	UGCService_GetReview_FullMethodName                    = "/github.com.maisiq.go_ugc_service.v1.UGCService/GetReview"
+	UGCService_CreateReview_FullMethodName                 = "/github.com.maisiq.go_ugc_service.v1.UGCService/CreateReview"
+	UGCService_UpdateReview_FullMethodName                 = "/github.com.maisiq.go_ugc_service.v1.UGCService/UpdateReview"
+	UGCService_DeleteReview_FullMethodName                 = "/github.com.maisiq.go_ugc_service.v1.UGCService/DeleteReview"
+	UGCService_VoteReview_FullMethodName                   = "/github.com.maisiq.go_ugc_service.v1.UGCService/VoteReview"
+	UGCService_RemoveVote_FullMethodName                   = "/github.com.maisiq.go_ugc_service.v1.UGCService/RemoveVote"
+	UGCService_AddComment_FullMethodName                   = "/github.com.maisiq.go_ugc_service.v1.UGCService/AddComment"
+	UGCService_ListComments_FullMethodName                 = "/github.com.maisiq.go_ugc_service.v1.UGCService/ListComments"
+	UGCService_EditComment_FullMethodName                  = "/github.com.maisiq.go_ugc_service.v1.UGCService/EditComment"
+	UGCService_DeleteComment_FullMethodName                = "/github.com.maisiq.go_ugc_service.v1.UGCService/DeleteComment"
+	UGCService_GetMovieRating_FullMethodName               = "/github.com.maisiq.go_ugc_service.v1.UGCService/GetMovieRating"
+	UGCService_BatchGetMovieReviewSummaries_FullMethodName = "/github.com.maisiq.go_ugc_service.v1.UGCService/BatchGetMovieReviewSummaries"
+	UGCService_ReportReview_FullMethodName                 = "/github.com.maisiq.go_ugc_service.v1.UGCService/ReportReview"
+	UGCService_SearchReviews_FullMethodName                = "/github.com.maisiq.go_ugc_service.v1.UGCService/SearchReviews"
+	UGCService_WatchMovieReviews_FullMethodName            = "/github.com.maisiq.go_ugc_service.v1.UGCService/WatchMovieReviews"
+	UGCService_ImportReviews_FullMethodName                = "/github.com.maisiq.go_ugc_service.v1.UGCService/ImportReviews"
 )
 
 // UGCServiceClient is the client API for UGCService service.
@@ -30,8 +44,26 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type UGCServiceClient interface {
 	GetReviews(ctx context.Context, in *GetReviewsRequest, opts ...grpc.CallOption) (*GetReviewsResponse, error)
+	// GetReview returns the review of the user for the movie. Pending reviews
+	// are only returned to their author.
+	GetReview(ctx context.Context, in *GetReviewRequest, opts ...grpc.CallOption) (*GetReviewResponse, error)
 	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	VoteReview(ctx context.Context, in *VoteReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveVote(ctx context.Context, in *RemoveVoteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*Comment, error)
+	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error)
+	EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*Comment, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetMovieRating(ctx context.Context, in *GetMovieRatingRequest, opts ...grpc.CallOption) (*GetMovieRatingResponse, error)
+	// BatchGetMovieReviewSummaries returns the review count and average rating
+	// of every requested movie, in request order. Movies without reviews have zero counts.
+	BatchGetMovieReviewSummaries(ctx context.Context, in *BatchGetMovieReviewSummariesRequest, opts ...grpc.CallOption) (*BatchGetMovieReviewSummariesResponse, error)
+	ReportReview(ctx context.Context, in *ReportReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SearchReviews(ctx context.Context, in *SearchReviewsRequest, opts ...grpc.CallOption) (*SearchReviewsResponse, error)
+	WatchMovieReviews(ctx context.Context, in *WatchMovieReviewsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReviewEvent], error)
+	ImportReviews(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportReviewsRequest, ImportReviewsResponse], error)
 }
 
 type uGCServiceClient struct {
@@ -52,6 +84,16 @@ func (c *uGCServiceClient) GetReviews(ctx context.Context, in *GetReviewsRequest
 	return out, nil
 }
 
+func (c *uGCServiceClient) GetReview(ctx context.Context, in *GetReviewRequest, opts ...grpc.CallOption) (*GetReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReviewResponse)
+	err := c.cc.Invoke(ctx, UGCService_GetReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *uGCServiceClient) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -72,13 +114,173 @@ func (c *uGCServiceClient) UpdateReview(ctx context.Context, in *UpdateReviewReq
 	return out, nil
 }
 
+func (c *uGCServiceClient) DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UGCService_DeleteReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uGCServiceClient) VoteReview(ctx context.Context, in *VoteReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UGCService_VoteReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uGCServiceClient) RemoveVote(ctx context.Context, in *RemoveVoteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UGCService_RemoveVote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uGCServiceClient) AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, UGCService_AddComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uGCServiceClient) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentsResponse)
+	err := c.cc.Invoke(ctx, UGCService_ListComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uGCServiceClient) EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, UGCService_EditComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uGCServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UGCService_DeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uGCServiceClient) GetMovieRating(ctx context.Context, in *GetMovieRatingRequest, opts ...grpc.CallOption) (*GetMovieRatingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMovieRatingResponse)
+	err := c.cc.Invoke(ctx, UGCService_GetMovieRating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uGCServiceClient) BatchGetMovieReviewSummaries(ctx context.Context, in *BatchGetMovieReviewSummariesRequest, opts ...grpc.CallOption) (*BatchGetMovieReviewSummariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetMovieReviewSummariesResponse)
+	err := c.cc.Invoke(ctx, UGCService_BatchGetMovieReviewSummaries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uGCServiceClient) ReportReview(ctx context.Context, in *ReportReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UGCService_ReportReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uGCServiceClient) SearchReviews(ctx context.Context, in *SearchReviewsRequest, opts ...grpc.CallOption) (*SearchReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchReviewsResponse)
+	err := c.cc.Invoke(ctx, UGCService_SearchReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uGCServiceClient) WatchMovieReviews(ctx context.Context, in *WatchMovieReviewsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReviewEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UGCService_ServiceDesc.Streams[0], UGCService_WatchMovieReviews_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchMovieReviewsRequest, ReviewEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UGCService_WatchMovieReviewsClient = grpc.ServerStreamingClient[ReviewEvent]
+
+func (c *uGCServiceClient) ImportReviews(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportReviewsRequest, ImportReviewsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UGCService_ServiceDesc.Streams[1], UGCService_ImportReviews_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportReviewsRequest, ImportReviewsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UGCService_ImportReviewsClient = grpc.ClientStreamingClient[ImportReviewsRequest, ImportReviewsResponse]
+
 // UGCServiceServer is the server API for UGCService service.
 // All implementations must embed UnimplementedUGCServiceServer
 // for forward compatibility.
 type UGCServiceServer interface {
 	GetReviews(context.Context, *GetReviewsRequest) (*GetReviewsResponse, error)
+	// GetReview returns the review of the user for the movie. Pending reviews
+	// are only returned to their author.
+	GetReview(context.Context, *GetReviewRequest) (*GetReviewResponse, error)
 	CreateReview(context.Context, *CreateReviewRequest) (*emptypb.Empty, error)
 	UpdateReview(context.Context, *UpdateReviewRequest) (*emptypb.Empty, error)
+	DeleteReview(context.Context, *DeleteReviewRequest) (*emptypb.Empty, error)
+	VoteReview(context.Context, *VoteReviewRequest) (*emptypb.Empty, error)
+	RemoveVote(context.Context, *RemoveVoteRequest) (*emptypb.Empty, error)
+	AddComment(context.Context, *AddCommentRequest) (*Comment, error)
+	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error)
+	EditComment(context.Context, *EditCommentRequest) (*Comment, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*emptypb.Empty, error)
+	GetMovieRating(context.Context, *GetMovieRatingRequest) (*GetMovieRatingResponse, error)
+	// BatchGetMovieReviewSummaries returns the review count and average rating
+	// of every requested movie, in request order. Movies without reviews have zero counts.
+	BatchGetMovieReviewSummaries(context.Context, *BatchGetMovieReviewSummariesRequest) (*BatchGetMovieReviewSummariesResponse, error)
+	ReportReview(context.Context, *ReportReviewRequest) (*emptypb.Empty, error)
+	SearchReviews(context.Context, *SearchReviewsRequest) (*SearchReviewsResponse, error)
+	WatchMovieReviews(*WatchMovieReviewsRequest, grpc.ServerStreamingServer[ReviewEvent]) error
+	ImportReviews(grpc.ClientStreamingServer[ImportReviewsRequest, ImportReviewsResponse]) error
 	mustEmbedUnimplementedUGCServiceServer()
 }
 
@@ -92,12 +294,54 @@ type UnimplementedUGCServiceServer struct{}
 func (UnimplementedUGCServiceServer) GetReviews(context.Context, *GetReviewsRequest) (*GetReviewsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReviews not implemented")
 }
+func (UnimplementedUGCServiceServer) GetReview(context.Context, *GetReviewRequest) (*GetReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReview not implemented")
+}
 func (UnimplementedUGCServiceServer) CreateReview(context.Context, *CreateReviewRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
 }
 func (UnimplementedUGCServiceServer) UpdateReview(context.Context, *UpdateReviewRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
 }
+func (UnimplementedUGCServiceServer) DeleteReview(context.Context, *DeleteReviewRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReview not implemented")
+}
+func (UnimplementedUGCServiceServer) VoteReview(context.Context, *VoteReviewRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteReview not implemented")
+}
+func (UnimplementedUGCServiceServer) RemoveVote(context.Context, *RemoveVoteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveVote not implemented")
+}
+func (UnimplementedUGCServiceServer) AddComment(context.Context, *AddCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddComment not implemented")
+}
+func (UnimplementedUGCServiceServer) ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedUGCServiceServer) EditComment(context.Context, *EditCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditComment not implemented")
+}
+func (UnimplementedUGCServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedUGCServiceServer) GetMovieRating(context.Context, *GetMovieRatingRequest) (*GetMovieRatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMovieRating not implemented")
+}
+func (UnimplementedUGCServiceServer) BatchGetMovieReviewSummaries(context.Context, *BatchGetMovieReviewSummariesRequest) (*BatchGetMovieReviewSummariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetMovieReviewSummaries not implemented")
+}
+func (UnimplementedUGCServiceServer) ReportReview(context.Context, *ReportReviewRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportReview not implemented")
+}
+func (UnimplementedUGCServiceServer) SearchReviews(context.Context, *SearchReviewsRequest) (*SearchReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchReviews not implemented")
+}
+func (UnimplementedUGCServiceServer) WatchMovieReviews(*WatchMovieReviewsRequest, grpc.ServerStreamingServer[ReviewEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMovieReviews not implemented")
+}
+func (UnimplementedUGCServiceServer) ImportReviews(grpc.ClientStreamingServer[ImportReviewsRequest, ImportReviewsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportReviews not implemented")
+}
 func (UnimplementedUGCServiceServer) mustEmbedUnimplementedUGCServiceServer() {}
 func (UnimplementedUGCServiceServer) testEmbeddedByValue()                    {}
 
@@ -137,6 +381,24 @@ func _UGCService_GetReviews_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UGCService_GetReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UGCServiceServer).GetReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UGCService_GetReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UGCServiceServer).GetReview(ctx, req.(*GetReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UGCService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateReviewRequest)
 	if err := dec(in); err != nil {
@@ -173,24 +435,1017 @@ func _UGCService_UpdateReview_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-// UGCService_ServiceDesc is the grpc.ServiceDesc for UGCService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var UGCService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "github.com.maisiq.go_ugc_service.v1.UGCService",
-	HandlerType: (*UGCServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetReviews",
-			Handler:    _UGCService_GetReviews_Handler,
-		},
-		{
-			MethodName: "CreateReview",
-			Handler:    _UGCService_CreateReview_Handler,
-		},
-		{
-			MethodName: "UpdateReview",
-			Handler:    _UGCService_UpdateReview_Handler,
+func _UGCService_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UGCServiceServer).DeleteReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UGCService_DeleteReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UGCServiceServer).DeleteReview(ctx, req.(*DeleteReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UGCService_VoteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UGCServiceServer).VoteReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UGCService_VoteReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UGCServiceServer).VoteReview(ctx, req.(*VoteReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UGCService_RemoveVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UGCServiceServer).RemoveVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UGCService_RemoveVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UGCServiceServer).RemoveVote(ctx, req.(*RemoveVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UGCService_AddComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UGCServiceServer).AddComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UGCService_AddComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UGCServiceServer).AddComment(ctx, req.(*AddCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UGCService_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UGCServiceServer).ListComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UGCService_ListComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UGCServiceServer).ListComments(ctx, req.(*ListCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UGCService_EditComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UGCServiceServer).EditComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UGCService_EditComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UGCServiceServer).EditComment(ctx, req.(*EditCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UGCService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UGCServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UGCService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UGCServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UGCService_GetMovieRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMovieRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UGCServiceServer).GetMovieRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UGCService_GetMovieRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UGCServiceServer).GetMovieRating(ctx, req.(*GetMovieRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UGCService_BatchGetMovieReviewSummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetMovieReviewSummariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UGCServiceServer).BatchGetMovieReviewSummaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UGCService_BatchGetMovieReviewSummaries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UGCServiceServer).BatchGetMovieReviewSummaries(ctx, req.(*BatchGetMovieReviewSummariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UGCService_ReportReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UGCServiceServer).ReportReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UGCService_ReportReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UGCServiceServer).ReportReview(ctx, req.(*ReportReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UGCService_SearchReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UGCServiceServer).SearchReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UGCService_SearchReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UGCServiceServer).SearchReviews(ctx, req.(*SearchReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UGCService_WatchMovieReviews_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMovieReviewsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UGCServiceServer).WatchMovieReviews(m, &grpc.GenericServerStream[WatchMovieReviewsRequest, ReviewEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UGCService_WatchMovieReviewsServer = grpc.ServerStreamingServer[ReviewEvent]
+
+func _UGCService_ImportReviews_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UGCServiceServer).ImportReviews(&grpc.GenericServerStream[ImportReviewsRequest, ImportReviewsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UGCService_ImportReviewsServer = grpc.ClientStreamingServer[ImportReviewsRequest, ImportReviewsResponse]
+
+// UGCService_ServiceDesc is the grpc.ServiceDesc for UGCService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UGCService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "github.com.maisiq.go_ugc_service.v1.UGCService",
+	HandlerType: (*UGCServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetReviews",
+			Handler:    _UGCService_GetReviews_Handler,
+		},
+		{
+			MethodName: "GetReview",
+			Handler:    _UGCService_GetReview_Handler,
+		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _UGCService_CreateReview_Handler,
+		},
+		{
+			MethodName: "UpdateReview",
+			Handler:    _UGCService_UpdateReview_Handler,
+		},
+		{
+			MethodName: "DeleteReview",
+			Handler:    _UGCService_DeleteReview_Handler,
+		},
+		{
+			MethodName: "VoteReview",
+			Handler:    _UGCService_VoteReview_Handler,
+		},
+		{
+			MethodName: "RemoveVote",
+			Handler:    _UGCService_RemoveVote_Handler,
+		},
+		{
+			MethodName: "AddComment",
+			Handler:    _UGCService_AddComment_Handler,
+		},
+		{
+			MethodName: "ListComments",
+			Handler:    _UGCService_ListComments_Handler,
+		},
+		{
+			MethodName: "EditComment",
+			Handler:    _UGCService_EditComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _UGCService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "GetMovieRating",
+			Handler:    _UGCService_GetMovieRating_Handler,
+		},
+		{
+			MethodName: "BatchGetMovieReviewSummaries",
+			Handler:    _UGCService_BatchGetMovieReviewSummaries_Handler,
+		},
+		{
+			MethodName: "ReportReview",
+			Handler:    _UGCService_ReportReview_Handler,
+		},
+		{
+			MethodName: "SearchReviews",
+			Handler:    _UGCService_SearchReviews_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchMovieReviews",
+			Handler:       _UGCService_WatchMovieReviews_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportReviews",
+			Handler:       _UGCService_ImportReviews_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "ugcservice/v1/ugc.proto",
+}
+
+const (
+	AdminService_ListPendingReviews_FullMethodName  = "/github.com.maisiq.go_ugc_service.v1.AdminService/ListPendingReviews"
+	AdminService_ApproveReview_FullMethodName       = "/github.com.maisiq.go_ugc_service.v1.AdminService/ApproveReview"
+	AdminService_RejectReview_FullMethodName        = "/github.com.maisiq.go_ugc_service.v1.AdminService/RejectReview"
+	AdminService_RestoreReview_FullMethodName       = "/github.com.maisiq.go_ugc_service.v1.AdminService/RestoreReview"
+	AdminService_ListReviewRevisions_FullMethodName = "/github.com.maisiq.go_ugc_service.v1.AdminService/ListReviewRevisions"
+	AdminService_EraseUser_FullMethodName           = "/github.com.maisiq.go_ugc_service.v1.AdminService/EraseUser"
+	AdminService_ExportUserData_FullMethodName      = "/github.com.maisiq.go_ugc_service.v1.AdminService/ExportUserData"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	// ListPendingReviews returns reviews waiting for a moderator: new pending
+	// ones and those hidden after user reports.
+	ListPendingReviews(ctx context.Context, in *ListPendingReviewsRequest, opts ...grpc.CallOption) (*ListPendingReviewsResponse, error)
+	ApproveReview(ctx context.Context, in *ApproveReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RejectReview(ctx context.Context, in *RejectReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RestoreReview brings back a soft deleted review before it is purged.
+	RestoreReview(ctx context.Context, in *RestoreReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListReviewRevisions returns past revisions of the review, newest first.
+	ListReviewRevisions(ctx context.Context, in *ListReviewRevisionsRequest, opts ...grpc.CallOption) (*ListReviewRevisionsResponse, error)
+	// EraseUser removes the reviews of the user from every store and tells
+	// downstream consumers to do the same. A failed erasure is resumed by
+	// calling it again.
+	EraseUser(ctx context.Context, in *EraseUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ExportUserData streams everything stored about the user, one record per
+	// message: reviews, votes, comments, reports and analytics events, in that order.
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[UserDataRecord], error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) ListPendingReviews(ctx context.Context, in *ListPendingReviewsRequest, opts ...grpc.CallOption) (*ListPendingReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingReviewsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListPendingReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ApproveReview(ctx context.Context, in *ApproveReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AdminService_ApproveReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RejectReview(ctx context.Context, in *RejectReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AdminService_RejectReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RestoreReview(ctx context.Context, in *RestoreReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AdminService_RestoreReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListReviewRevisions(ctx context.Context, in *ListReviewRevisionsRequest, opts ...grpc.CallOption) (*ListReviewRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewRevisionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListReviewRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) EraseUser(ctx context.Context, in *EraseUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AdminService_EraseUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[UserDataRecord], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[0], AdminService_ExportUserData_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportUserDataRequest, UserDataRecord]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdminService_ExportUserDataClient = grpc.ServerStreamingClient[UserDataRecord]
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+type AdminServiceServer interface {
+	// ListPendingReviews returns reviews waiting for a moderator: new pending
+	// ones and those hidden after user reports.
+	ListPendingReviews(context.Context, *ListPendingReviewsRequest) (*ListPendingReviewsResponse, error)
+	ApproveReview(context.Context, *ApproveReviewRequest) (*emptypb.Empty, error)
+	RejectReview(context.Context, *RejectReviewRequest) (*emptypb.Empty, error)
+	// RestoreReview brings back a soft deleted review before it is purged.
+	RestoreReview(context.Context, *RestoreReviewRequest) (*emptypb.Empty, error)
+	// ListReviewRevisions returns past revisions of the review, newest first.
+	ListReviewRevisions(context.Context, *ListReviewRevisionsRequest) (*ListReviewRevisionsResponse, error)
+	// EraseUser removes the reviews of the user from every store and tells
+	// downstream consumers to do the same. A failed erasure is resumed by
+	// calling it again.
+	EraseUser(context.Context, *EraseUserRequest) (*emptypb.Empty, error)
+	// ExportUserData streams everything stored about the user, one record per
+	// message: reviews, votes, comments, reports and analytics events, in that order.
+	ExportUserData(*ExportUserDataRequest, grpc.ServerStreamingServer[UserDataRecord]) error
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) ListPendingReviews(context.Context, *ListPendingReviewsRequest) (*ListPendingReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingReviews not implemented")
+}
+func (UnimplementedAdminServiceServer) ApproveReview(context.Context, *ApproveReviewRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveReview not implemented")
+}
+func (UnimplementedAdminServiceServer) RejectReview(context.Context, *RejectReviewRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectReview not implemented")
+}
+func (UnimplementedAdminServiceServer) RestoreReview(context.Context, *RestoreReviewRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreReview not implemented")
+}
+func (UnimplementedAdminServiceServer) ListReviewRevisions(context.Context, *ListReviewRevisionsRequest) (*ListReviewRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewRevisions not implemented")
+}
+func (UnimplementedAdminServiceServer) EraseUser(context.Context, *EraseUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseUser not implemented")
+}
+func (UnimplementedAdminServiceServer) ExportUserData(*ExportUserDataRequest, grpc.ServerStreamingServer[UserDataRecord]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_ListPendingReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListPendingReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListPendingReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListPendingReviews(ctx, req.(*ListPendingReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ApproveReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ApproveReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ApproveReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ApproveReview(ctx, req.(*ApproveReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RejectReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RejectReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RejectReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RejectReview(ctx, req.(*RejectReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RestoreReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RestoreReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RestoreReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RestoreReview(ctx, req.(*RestoreReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListReviewRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListReviewRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListReviewRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListReviewRevisions(ctx, req.(*ListReviewRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_EraseUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).EraseUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_EraseUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).EraseUser(ctx, req.(*EraseUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ExportUserData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportUserDataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).ExportUserData(m, &grpc.GenericServerStream[ExportUserDataRequest, UserDataRecord]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdminService_ExportUserDataServer = grpc.ServerStreamingServer[UserDataRecord]
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "github.com.maisiq.go_ugc_service.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPendingReviews",
+			Handler:    _AdminService_ListPendingReviews_Handler,
+		},
+		{
+			MethodName: "ApproveReview",
+			Handler:    _AdminService_ApproveReview_Handler,
+		},
+		{
+			MethodName: "RejectReview",
+			Handler:    _AdminService_RejectReview_Handler,
+		},
+		{
+			MethodName: "RestoreReview",
+			Handler:    _AdminService_RestoreReview_Handler,
+		},
+		{
+			MethodName: "ListReviewRevisions",
+			Handler:    _AdminService_ListReviewRevisions_Handler,
+		},
+		{
+			MethodName: "EraseUser",
+			Handler:    _AdminService_EraseUser_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportUserData",
+			Handler:       _AdminService_ExportUserData_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ugcservice/v1/ugc.proto",
+}
+
+const (
+	ProgressService_ReportProgress_FullMethodName = "/github.com.maisiq.go_ugc_service.v1.ProgressService/ReportProgress"
+	ProgressService_GetProgress_FullMethodName    = "/github.com.maisiq.go_ugc_service.v1.ProgressService/GetProgress"
+	ProgressService_ListInProgress_FullMethodName = "/github.com.maisiq.go_ugc_service.v1.ProgressService/ListInProgress"
+)
+
+// ProgressServiceClient is the client API for ProgressService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProgressServiceClient interface {
+	// ReportProgress records where the user is in the movie, players call it
+	// periodically during playback.
+	ReportProgress(ctx context.Context, in *ReportProgressRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetProgress(ctx context.Context, in *GetProgressRequest, opts ...grpc.CallOption) (*PlaybackProgress, error)
+	// ListInProgress returns the movies the user started and did not finish,
+	// most recently watched first.
+	ListInProgress(ctx context.Context, in *ListInProgressRequest, opts ...grpc.CallOption) (*ListInProgressResponse, error)
+}
+
+type progressServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProgressServiceClient(cc grpc.ClientConnInterface) ProgressServiceClient {
+	return &progressServiceClient{cc}
+}
+
+func (c *progressServiceClient) ReportProgress(ctx context.Context, in *ReportProgressRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ProgressService_ReportProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *progressServiceClient) GetProgress(ctx context.Context, in *GetProgressRequest, opts ...grpc.CallOption) (*PlaybackProgress, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaybackProgress)
+	err := c.cc.Invoke(ctx, ProgressService_GetProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *progressServiceClient) ListInProgress(ctx context.Context, in *ListInProgressRequest, opts ...grpc.CallOption) (*ListInProgressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInProgressResponse)
+	err := c.cc.Invoke(ctx, ProgressService_ListInProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProgressServiceServer is the server API for ProgressService service.
+// All implementations must embed UnimplementedProgressServiceServer
+// for forward compatibility.
+type ProgressServiceServer interface {
+	// ReportProgress records where the user is in the movie, players call it
+	// periodically during playback.
+	ReportProgress(context.Context, *ReportProgressRequest) (*emptypb.Empty, error)
+	GetProgress(context.Context, *GetProgressRequest) (*PlaybackProgress, error)
+	// ListInProgress returns the movies the user started and did not finish,
+	// most recently watched first.
+	ListInProgress(context.Context, *ListInProgressRequest) (*ListInProgressResponse, error)
+	mustEmbedUnimplementedProgressServiceServer()
+}
+
+// UnimplementedProgressServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProgressServiceServer struct{}
+
+func (UnimplementedProgressServiceServer) ReportProgress(context.Context, *ReportProgressRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportProgress not implemented")
+}
+func (UnimplementedProgressServiceServer) GetProgress(context.Context, *GetProgressRequest) (*PlaybackProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProgress not implemented")
+}
+func (UnimplementedProgressServiceServer) ListInProgress(context.Context, *ListInProgressRequest) (*ListInProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInProgress not implemented")
+}
+func (UnimplementedProgressServiceServer) mustEmbedUnimplementedProgressServiceServer() {}
+func (UnimplementedProgressServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeProgressServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProgressServiceServer will
+// result in compilation errors.
+type UnsafeProgressServiceServer interface {
+	mustEmbedUnimplementedProgressServiceServer()
+}
+
+func RegisterProgressServiceServer(s grpc.ServiceRegistrar, srv ProgressServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProgressServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProgressService_ServiceDesc, srv)
+}
+
+func _ProgressService_ReportProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgressServiceServer).ReportProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProgressService_ReportProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgressServiceServer).ReportProgress(ctx, req.(*ReportProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProgressService_GetProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgressServiceServer).GetProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProgressService_GetProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgressServiceServer).GetProgress(ctx, req.(*GetProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProgressService_ListInProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgressServiceServer).ListInProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProgressService_ListInProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgressServiceServer).ListInProgress(ctx, req.(*ListInProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProgressService_ServiceDesc is the grpc.ServiceDesc for ProgressService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProgressService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "github.com.maisiq.go_ugc_service.v1.ProgressService",
+	HandlerType: (*ProgressServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReportProgress",
+			Handler:    _ProgressService_ReportProgress_Handler,
+		},
+		{
+			MethodName: "GetProgress",
+			Handler:    _ProgressService_GetProgress_Handler,
+		},
+		{
+			MethodName: "ListInProgress",
+			Handler:    _ProgressService_ListInProgress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ugcservice/v1/ugc.proto",
+}
+
+const (
+	BookmarkService_AddBookmark_FullMethodName    = "/github.com.maisiq.go_ugc_service.v1.BookmarkService/AddBookmark"
+	BookmarkService_RemoveBookmark_FullMethodName = "/github.com.maisiq.go_ugc_service.v1.BookmarkService/RemoveBookmark"
+	BookmarkService_ListBookmarks_FullMethodName  = "/github.com.maisiq.go_ugc_service.v1.BookmarkService/ListBookmarks"
+)
+
+// BookmarkServiceClient is the client API for BookmarkService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BookmarkServiceClient interface {
+	AddBookmark(ctx context.Context, in *AddBookmarkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveBookmark(ctx context.Context, in *RemoveBookmarkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListBookmarks returns the movies the user saved to watch later, newest first.
+	ListBookmarks(ctx context.Context, in *ListBookmarksRequest, opts ...grpc.CallOption) (*ListBookmarksResponse, error)
+}
+
+type bookmarkServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBookmarkServiceClient(cc grpc.ClientConnInterface) BookmarkServiceClient {
+	return &bookmarkServiceClient{cc}
+}
+
+func (c *bookmarkServiceClient) AddBookmark(ctx context.Context, in *AddBookmarkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BookmarkService_AddBookmark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkServiceClient) RemoveBookmark(ctx context.Context, in *RemoveBookmarkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BookmarkService_RemoveBookmark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkServiceClient) ListBookmarks(ctx context.Context, in *ListBookmarksRequest, opts ...grpc.CallOption) (*ListBookmarksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBookmarksResponse)
+	err := c.cc.Invoke(ctx, BookmarkService_ListBookmarks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BookmarkServiceServer is the server API for BookmarkService service.
+// All implementations must embed UnimplementedBookmarkServiceServer
+// for forward compatibility.
+type BookmarkServiceServer interface {
+	AddBookmark(context.Context, *AddBookmarkRequest) (*emptypb.Empty, error)
+	RemoveBookmark(context.Context, *RemoveBookmarkRequest) (*emptypb.Empty, error)
+	// ListBookmarks returns the movies the user saved to watch later, newest first.
+	ListBookmarks(context.Context, *ListBookmarksRequest) (*ListBookmarksResponse, error)
+	mustEmbedUnimplementedBookmarkServiceServer()
+}
+
+// UnimplementedBookmarkServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBookmarkServiceServer struct{}
+
+func (UnimplementedBookmarkServiceServer) AddBookmark(context.Context, *AddBookmarkRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBookmark not implemented")
+}
+func (UnimplementedBookmarkServiceServer) RemoveBookmark(context.Context, *RemoveBookmarkRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBookmark not implemented")
+}
+func (UnimplementedBookmarkServiceServer) ListBookmarks(context.Context, *ListBookmarksRequest) (*ListBookmarksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookmarks not implemented")
+}
+func (UnimplementedBookmarkServiceServer) mustEmbedUnimplementedBookmarkServiceServer() {}
+func (UnimplementedBookmarkServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeBookmarkServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BookmarkServiceServer will
+// result in compilation errors.
+type UnsafeBookmarkServiceServer interface {
+	mustEmbedUnimplementedBookmarkServiceServer()
+}
+
+func RegisterBookmarkServiceServer(s grpc.ServiceRegistrar, srv BookmarkServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBookmarkServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BookmarkService_ServiceDesc, srv)
+}
+
+func _BookmarkService_AddBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkServiceServer).AddBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookmarkService_AddBookmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkServiceServer).AddBookmark(ctx, req.(*AddBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookmarkService_RemoveBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkServiceServer).RemoveBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookmarkService_RemoveBookmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkServiceServer).RemoveBookmark(ctx, req.(*RemoveBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookmarkService_ListBookmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookmarksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkServiceServer).ListBookmarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookmarkService_ListBookmarks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkServiceServer).ListBookmarks(ctx, req.(*ListBookmarksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BookmarkService_ServiceDesc is the grpc.ServiceDesc for BookmarkService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BookmarkService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "github.com.maisiq.go_ugc_service.v1.BookmarkService",
+	HandlerType: (*BookmarkServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddBookmark",
+			Handler:    _BookmarkService_AddBookmark_Handler,
+		},
+		{
+			MethodName: "RemoveBookmark",
+			Handler:    _BookmarkService_RemoveBookmark_Handler,
+		},
+		{
+			MethodName: "ListBookmarks",
+			Handler:    _BookmarkService_ListBookmarks_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},